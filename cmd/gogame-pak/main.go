@@ -0,0 +1,162 @@
+// Package main is the asset packing CLI.
+//
+// Usage:
+//
+//	gogame-pak build [-o game.pak] [-store] [-key secret] assets/
+//	gogame-pak list game.pak
+//	gogame-pak extract [-key secret] [-o out/] game.pak
+//
+// -key encrypts entries with AES using secure.DeriveKey(secret, "assets");
+// games open the archive with the same derived key.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/dshills/gogame/engine/pak"
+	"github.com/dshills/gogame/engine/secure"
+)
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "build":
+		err = build(os.Args[2:])
+	case "list":
+		err = list(os.Args[2:])
+	case "extract":
+		err = extract(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	log.Fatal("usage: gogame-pak build|list|extract [flags] <path>")
+}
+
+// build packs a directory into a pak file.
+func build(args []string) error {
+	flags := flag.NewFlagSet("build", flag.ExitOnError)
+	output := flags.String("o", "game.pak", "output pak file")
+	store := flags.Bool("store", false, "store entries without compression")
+	key := flags.String("key", "", "secret for AES encryption (optional)")
+	_ = flags.Parse(args) // ExitOnError
+	if flags.NArg() != 1 {
+		return fmt.Errorf("build: expected one asset directory")
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("failed to create pak: %w", err)
+	}
+	writer, err := pak.NewWriter(file)
+	if err != nil {
+		_ = file.Close() // Best effort cleanup
+		return err
+	}
+	writer.Compress = !*store
+	if *key != "" {
+		if writer.Sealer, err = secure.NewAES(secure.DeriveKey(*key, "assets")); err != nil {
+			_ = file.Close() // Best effort cleanup
+			return err
+		}
+	}
+	if err := writer.AddDir(flags.Arg(0)); err != nil {
+		_ = file.Close() // Best effort cleanup
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		_ = file.Close() // Best effort cleanup
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write pak: %w", err)
+	}
+	log.Printf("✓ Packed %s into %s", flags.Arg(0), *output)
+	return nil
+}
+
+// list prints a pak's entries.
+func list(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("list: expected one pak file")
+	}
+	archive, err := pak.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() { _ = archive.Close() }() // Read-only
+
+	var total, stored int64
+	for _, entry := range archive.Entries() {
+		fmt.Printf("%10d %10d %s%s\n", entry.Size, entry.StoredSize, entry.Name, entryFlags(entry))
+		total += entry.Size
+		stored += entry.StoredSize
+	}
+	fmt.Printf("%10d %10d (%d entries)\n", total, stored, len(archive.Entries()))
+	return nil
+}
+
+// extract unpacks a pak into a directory.
+func extract(args []string) error {
+	flags := flag.NewFlagSet("extract", flag.ExitOnError)
+	output := flags.String("o", ".", "output directory")
+	key := flags.String("key", "", "secret for encrypted paks")
+	_ = flags.Parse(args) // ExitOnError
+	if flags.NArg() != 1 {
+		return fmt.Errorf("extract: expected one pak file")
+	}
+
+	archive, err := pak.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = archive.Close() }() // Read-only
+	if *key != "" {
+		if archive.Sealer, err = secure.NewAES(secure.DeriveKey(*key, "assets")); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range archive.Entries() {
+		data, err := archive.ReadFile(entry.Name)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(*output, filepath.FromSlash(entry.Name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// entryFlags formats an entry's storage flags.
+func entryFlags(entry pak.Entry) string {
+	switch {
+	case entry.Compressed && entry.Sealed:
+		return " [deflate, sealed]"
+	case entry.Compressed:
+		return " [deflate]"
+	case entry.Sealed:
+		return " [sealed]"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,253 @@
+// Package analytics provides pluggable gameplay event tracking with batching
+// file and HTTP backends.
+package analytics
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// Props are event properties; values must be JSON-encodable.
+type Props map[string]any
+
+// Event is a recorded analytics event.
+type Event struct {
+	Name    string    `json:"name"`
+	Time    time.Time `json:"time"`
+	Session string    `json:"session"`
+	Props   Props     `json:"props,omitempty"`
+}
+
+// Tracker records named events. Games depend on this interface so the
+// backend can be swapped (or disabled with Nop) without touching call sites.
+type Tracker interface {
+	TrackEvent(name string, props Props)
+}
+
+// Backend delivers batches of events.
+type Backend interface {
+	Send(events []Event) error
+}
+
+// Nop is a Tracker that discards events (e.g. when the player opts out).
+type Nop struct{}
+
+// TrackEvent does nothing.
+func (Nop) TrackEvent(string, Props) {}
+
+// Standard event names.
+const (
+	EventSessionStart  = "session_start"
+	EventSessionEnd    = "session_end"
+	EventLevelComplete = "level_complete"
+	EventDeath         = "death"
+)
+
+// Client is a batching Tracker and a Behavior that flushes periodically.
+//
+// Events are queued in memory and sent when BatchSize is reached or every
+// FlushInterval. Sends from Update run on a background goroutine so slow
+// backends never stall a frame; failed batches are re-queued up to MaxQueued.
+type Client struct {
+	Backend       Backend
+	BatchSize     int           // Events per send (flush early when reached)
+	FlushInterval time.Duration // Maximum time events wait in the queue
+	MaxQueued     int           // Oldest events are dropped beyond this (offline play)
+	Common        Props         // Properties merged into every event (build, platform...)
+
+	// OnError is called (from any goroutine) when a send fails
+	OnError func(err error)
+
+	session    string
+	started    time.Time
+	mu         sync.Mutex
+	queue      []Event
+	sending    bool
+	sinceFlush time.Duration
+	now        func() time.Time
+}
+
+// NewClient creates a client and records a session_start event.
+//
+// Example:
+//
+//	tracker := analytics.NewClient(analytics.NewFileBackend("analytics.jsonl"))
+//	tracker.Common = analytics.Props{"build": version}
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: tracker})
+//	defer tracker.EndSession()
+//
+//	tracker.TrackLevelComplete("1-1", 93.5, analytics.Props{"stars": 3})
+func NewClient(backend Backend) *Client {
+	c := &Client{
+		Backend:       backend,
+		BatchSize:     20,
+		FlushInterval: 10 * time.Second,
+		MaxQueued:     1000,
+		session:       newSessionID(),
+		queue:         make([]Event, 0),
+		now:           time.Now,
+	}
+	c.started = c.now()
+	c.TrackEvent(EventSessionStart, nil)
+	return c
+}
+
+// Session returns the session identifier attached to every event.
+func (c *Client) Session() string {
+	return c.session
+}
+
+// SessionLength returns time since the session started.
+func (c *Client) SessionLength() time.Duration {
+	return c.now().Sub(c.started)
+}
+
+// TrackEvent queues an event (implements Tracker). Safe for concurrent use.
+func (c *Client) TrackEvent(name string, props Props) {
+	merged := make(Props, len(c.Common)+len(props))
+	for k, v := range c.Common {
+		merged[k] = v
+	}
+	for k, v := range props {
+		merged[k] = v
+	}
+
+	c.mu.Lock()
+	c.queue = append(c.queue, Event{Name: name, Time: c.now(), Session: c.session, Props: merged})
+	c.trim()
+	full := c.BatchSize > 0 && len(c.queue) >= c.BatchSize
+	c.mu.Unlock()
+
+	if full {
+		c.flushAsync()
+	}
+}
+
+// TrackLevelComplete records a level_complete event with its duration.
+func (c *Client) TrackLevelComplete(level string, seconds float64, props Props) {
+	c.TrackEvent(EventLevelComplete, with(props, Props{"level": level, "seconds": seconds}))
+}
+
+// TrackDeath records a death event with a cause and world position.
+func (c *Client) TrackDeath(cause string, x, y float64, props Props) {
+	c.TrackEvent(EventDeath, with(props, Props{"cause": cause, "x": x, "y": y}))
+}
+
+// Queued returns the number of unsent events.
+func (c *Client) Queued() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queue)
+}
+
+// Update flushes on the interval (implements core.Behavior).
+func (c *Client) Update(_ *core.Entity, dt float64) {
+	c.sinceFlush += time.Duration(dt * float64(time.Second))
+	if c.FlushInterval > 0 && c.sinceFlush >= c.FlushInterval {
+		c.sinceFlush = 0
+		c.flushAsync()
+	}
+}
+
+// Flush sends all queued events synchronously.
+func (c *Client) Flush() error {
+	for {
+		c.mu.Lock()
+		if len(c.queue) == 0 {
+			c.mu.Unlock()
+			return nil
+		}
+		batch := c.take()
+		c.mu.Unlock()
+
+		if err := c.send(batch); err != nil {
+			return err
+		}
+	}
+}
+
+// EndSession records session_end with the session length and flushes.
+func (c *Client) EndSession() error {
+	c.TrackEvent(EventSessionEnd, Props{"seconds": c.SessionLength().Seconds()})
+	return c.Flush()
+}
+
+// flushAsync sends one batch on a goroutine unless a send is in flight.
+func (c *Client) flushAsync() {
+	c.mu.Lock()
+	if c.sending || len(c.queue) == 0 {
+		c.mu.Unlock()
+		return
+	}
+	c.sending = true
+	batch := c.take()
+	c.mu.Unlock()
+
+	go func() {
+		_ = c.send(batch) // Errors are reported via OnError
+		c.mu.Lock()
+		c.sending = false
+		c.mu.Unlock()
+	}()
+}
+
+// send delivers a batch, re-queuing it on failure.
+func (c *Client) send(batch []Event) error {
+	if c.Backend == nil {
+		return nil
+	}
+	err := c.Backend.Send(batch)
+	if err != nil {
+		c.mu.Lock()
+		c.queue = append(batch, c.queue...)
+		c.trim()
+		c.mu.Unlock()
+		if c.OnError != nil {
+			c.OnError(err)
+		}
+	}
+	return err
+}
+
+// take removes up to BatchSize events from the queue. Caller holds mu.
+func (c *Client) take() []Event {
+	n := len(c.queue)
+	if c.BatchSize > 0 && n > c.BatchSize {
+		n = c.BatchSize
+	}
+	batch := append([]Event(nil), c.queue[:n]...)
+	c.queue = c.queue[n:]
+	return batch
+}
+
+// trim drops the oldest events beyond MaxQueued. Caller holds mu.
+func (c *Client) trim() {
+	if c.MaxQueued > 0 && len(c.queue) > c.MaxQueued {
+		c.queue = c.queue[len(c.queue)-c.MaxQueued:]
+	}
+}
+
+// with merges extra properties over base without mutating either.
+func with(base, extra Props) Props {
+	merged := make(Props, len(base)+len(extra))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	return merged
+}
+
+// newSessionID returns a random 16-character hex identifier.
+func newSessionID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000")
+	}
+	return hex.EncodeToString(b)
+}
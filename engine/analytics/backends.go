@@ -0,0 +1,90 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileBackend appends events to a file as JSON lines.
+type FileBackend struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileBackend creates a backend writing to path (created if missing).
+func NewFileBackend(path string) *FileBackend {
+	return &FileBackend{Path: path}
+}
+
+// Send appends a batch, one JSON object per line.
+func (b *FileBackend) Send(events []Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	file, err := os.OpenFile(b.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open analytics file: %w", err)
+	}
+	encoder := json.NewEncoder(file)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			_ = file.Close() // Best effort cleanup
+			return fmt.Errorf("failed to write analytics event: %w", err)
+		}
+	}
+	return file.Close()
+}
+
+// HTTPBackend POSTs batches as a JSON array to a collection endpoint.
+type HTTPBackend struct {
+	URL     string
+	Headers map[string]string // Extra headers (e.g. API keys)
+	Client  *http.Client
+}
+
+// NewHTTPBackend creates a backend posting to url with a 10 second timeout.
+//
+// Example:
+//
+//	backend := analytics.NewHTTPBackend("https://collect.example.com/v1/events")
+//	backend.Headers["X-Api-Key"] = key
+//	tracker := analytics.NewClient(backend)
+func NewHTTPBackend(url string) *HTTPBackend {
+	return &HTTPBackend{
+		URL:     url,
+		Headers: make(map[string]string),
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Send posts a batch. Non-2xx responses are errors (the batch is retried).
+func (b *HTTPBackend) Send(events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("failed to encode analytics batch: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, b.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create analytics request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range b.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send analytics batch: %w", err)
+	}
+	_ = resp.Body.Close() // Response body is not used
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("analytics endpoint returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Clip is a decoded in-memory sound (interleaved 16-bit PCM).
+type Clip struct {
+	Format  Format
+	Samples []int16
+}
+
+// LoadClip decodes a 16-bit PCM WAV file into memory.
+func LoadClip(path string) (*Clip, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load clip: %s: %w", path, err)
+	}
+	return DecodeClip(bytes.NewReader(data))
+}
+
+// DecodeClip decodes a whole WAV stream into memory.
+func DecodeClip(r io.ReadSeeker) (*Clip, error) {
+	stream, err := NewWAVStream(r)
+	if err != nil {
+		return nil, err
+	}
+	samples := make([]int16, stream.Frames()*int64(stream.Format().Channels))
+	read := 0
+	for read < len(samples) {
+		n, err := stream.Read(samples[read:])
+		read += n
+		if errors.Is(err, io.EOF) || n == 0 {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Clip{Format: stream.Format(), Samples: samples[:read]}, nil
+}
+
+// Frames returns the clip length in frames.
+func (c *Clip) Frames() int {
+	if c.Format.Channels == 0 {
+		return 0
+	}
+	return len(c.Samples) / c.Format.Channels
+}
@@ -0,0 +1,265 @@
+package audio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/veandco/go-sdl2/mix"
+)
+
+// Effect processes interleaved 16-bit samples in place.
+//
+// Process runs on SDL's audio thread; effects guard parameters changed from
+// the game thread with their own locks.
+type Effect interface {
+	Process(samples []int16, channels int)
+}
+
+// Attach runs effects on a mixer channel (mix.CHANNEL_POST for the final mix).
+//
+// Effects stay attached to a normal channel until the sound on it stops;
+// effects on mix.CHANNEL_POST last until the audio device is closed.
+//
+// Example:
+//
+//	underwater := audio.NewLowPass(44100, 600)
+//	if err := audio.Attach(mix.CHANNEL_POST, underwater); err != nil {
+//	    log.Printf("audio effects disabled: %v", err)
+//	}
+//	underwater.SetActive(player.Submerged) // Fades in/out without clicks
+func Attach(channel int, effects ...Effect) error {
+	_, format, channels, _, err := mix.QuerySpec()
+	if err != nil {
+		return fmt.Errorf("audio device not open: %w", err)
+	}
+	if format != mix.DEFAULT_FORMAT {
+		return fmt.Errorf("%w: effects require signed 16-bit samples", ErrUnsupportedFormat)
+	}
+
+	var scratch []int16
+	process := func(_ int, buf []byte) {
+		n := len(buf) / 2
+		if cap(scratch) < n {
+			scratch = make([]int16, n)
+		}
+		samples := scratch[:n]
+		for i := range samples {
+			samples[i] = int16(binary.LittleEndian.Uint16(buf[i*2:]))
+		}
+		for _, effect := range effects {
+			effect.Process(samples, channels)
+		}
+		for i, sample := range samples {
+			binary.LittleEndian.PutUint16(buf[i*2:], uint16(sample))
+		}
+	}
+	if err := mix.RegisterEffect(channel, process, func(int) {}); err != nil {
+		return fmt.Errorf("failed to attach audio effect: %w", err)
+	}
+	return nil
+}
+
+// LowPass is a one-pole low-pass filter with a smooth on/off fade.
+//
+// Use it to muffle the mix while the game is paused, underwater, or the
+// player is stunned.
+type LowPass struct {
+	mu         sync.Mutex
+	sampleRate float64
+	cutoff     float64   // Hz
+	active     bool      // Target state
+	wet        float64   // Current blend (0 = dry, 1 = filtered)
+	fadeTime   float64   // Seconds to fully engage/disengage
+	state      []float64 // Filter memory per channel
+}
+
+// NewLowPass creates an inactive filter with a 0.25 second fade.
+func NewLowPass(sampleRate int, cutoff float64) *LowPass {
+	return &LowPass{sampleRate: float64(sampleRate), cutoff: cutoff, fadeTime: 0.25}
+}
+
+// SetActive engages or releases the filter.
+func (f *LowPass) SetActive(active bool) {
+	f.mu.Lock()
+	f.active = active
+	f.mu.Unlock()
+}
+
+// Active reports whether the filter is engaged (or fading in).
+func (f *LowPass) Active() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active
+}
+
+// SetCutoff changes the cutoff frequency in Hz.
+func (f *LowPass) SetCutoff(hz float64) {
+	f.mu.Lock()
+	f.cutoff = hz
+	f.mu.Unlock()
+}
+
+// SetFadeTime sets how long engaging or releasing takes (0 = instant).
+func (f *LowPass) SetFadeTime(seconds float64) {
+	f.mu.Lock()
+	f.fadeTime = seconds
+	f.mu.Unlock()
+}
+
+// Process filters samples in place (implements Effect).
+func (f *LowPass) Process(samples []int16, channels int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.state) != channels {
+		f.state = make([]float64, channels)
+	}
+	if !f.active && f.wet == 0 {
+		for i, sample := range samples {
+			f.state[i%channels] = float64(sample) // Track input so engaging doesn't pop
+		}
+		return
+	}
+
+	alpha := 1 - math.Exp(-2*math.Pi*f.cutoff/f.sampleRate)
+	step := 1.0
+	if f.fadeTime > 0 {
+		step = 1 / (f.fadeTime * f.sampleRate)
+	}
+	for i, sample := range samples {
+		ch := i % channels
+		if ch == 0 {
+			f.wet = moveToward(f.wet, boolToFloat(f.active), step)
+		}
+		x := float64(sample)
+		f.state[ch] += alpha * (x - f.state[ch])
+		samples[i] = clampSample(x + (f.state[ch]-x)*f.wet)
+	}
+}
+
+// Reverb is a small Schroeder-style reverb (parallel feedback combs).
+type Reverb struct {
+	mu         sync.Mutex
+	sampleRate int
+	params     ReverbParams // Target parameters
+	wet        float64      // Current wet level (ramps to params.Wet)
+	lines      [][]float64  // Comb delay lines, per channel × comb
+	positions  []int
+	channels   int
+}
+
+// ReverbParams describes a space.
+type ReverbParams struct {
+	Wet      float64 // Reverb level mixed in (0-1)
+	Decay    float64 // Comb feedback (0-0.95); higher = longer tail
+	RoomSize float64 // Delay length scale (1 = medium room)
+}
+
+// Reverb presets.
+var (
+	ReverbNone   = ReverbParams{Wet: 0, Decay: 0.5, RoomSize: 1}
+	ReverbRoom   = ReverbParams{Wet: 0.2, Decay: 0.6, RoomSize: 0.8}
+	ReverbHall   = ReverbParams{Wet: 0.35, Decay: 0.8, RoomSize: 1.6}
+	ReverbCave   = ReverbParams{Wet: 0.45, Decay: 0.88, RoomSize: 2.2}
+	ReverbSewers = ReverbParams{Wet: 0.4, Decay: 0.85, RoomSize: 1.2}
+)
+
+// combDelays are the base comb lengths in milliseconds (mutually prime-ish).
+var combDelays = []float64{29.7, 37.1, 41.1, 43.7}
+
+// NewReverb creates a dry reverb; set parameters with SetParams.
+func NewReverb(sampleRate int) *Reverb {
+	return &Reverb{sampleRate: sampleRate, params: ReverbNone}
+}
+
+// SetParams changes the space. Wet ramps over ~50 ms; a RoomSize change
+// resizes the delay lines (and clears the tail) on the next buffer.
+func (r *Reverb) SetParams(params ReverbParams) {
+	r.mu.Lock()
+	if params.RoomSize != r.params.RoomSize {
+		r.lines = nil
+	}
+	r.params = params
+	r.mu.Unlock()
+}
+
+// Params returns the target parameters.
+func (r *Reverb) Params() ReverbParams {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.params
+}
+
+// Process adds reverb in place (implements Effect).
+func (r *Reverb) Process(samples []int16, channels int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.params.Wet == 0 && r.wet == 0 {
+		return
+	}
+	if r.lines == nil || r.channels != channels {
+		r.allocate(channels)
+	}
+
+	decay := math.Min(math.Max(r.params.Decay, 0), 0.95)
+	step := 1 / (0.05 * float64(r.sampleRate))
+	combs := len(combDelays)
+	for i, sample := range samples {
+		ch := i % channels
+		if ch == 0 {
+			r.wet = moveToward(r.wet, r.params.Wet, step)
+		}
+		x := float64(sample)
+		sum := 0.0
+		for c := 0; c < combs; c++ {
+			index := ch*combs + c
+			line := r.lines[index]
+			pos := r.positions[index]
+			delayed := line[pos]
+			line[pos] = x + delayed*decay
+			r.positions[index] = (pos + 1) % len(line)
+			sum += delayed
+		}
+		samples[i] = clampSample(x + sum/float64(combs)*r.wet)
+	}
+}
+
+// allocate sizes delay lines for the room.
+func (r *Reverb) allocate(channels int) {
+	combs := len(combDelays)
+	r.channels = channels
+	r.lines = make([][]float64, channels*combs)
+	r.positions = make([]int, channels*combs)
+	room := r.params.RoomSize
+	if room <= 0 {
+		room = 1
+	}
+	for ch := 0; ch < channels; ch++ {
+		for c, ms := range combDelays {
+			// Offset channels slightly for stereo width
+			length := int((ms*room + float64(ch)*1.3) * float64(r.sampleRate) / 1000)
+			r.lines[ch*combs+c] = make([]float64, max(length, 1))
+		}
+	}
+}
+
+// moveToward moves value toward target by at most step.
+func moveToward(value, target, step float64) float64 {
+	if value < target {
+		return math.Min(value+step, target)
+	}
+	return math.Max(value-step, target)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// clampSample rounds and clamps to the int16 range.
+func clampSample(x float64) int16 {
+	return int16(math.Max(math.MinInt16, math.Min(math.MaxInt16, math.Round(x))))
+}
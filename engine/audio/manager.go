@@ -0,0 +1,477 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/mix"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Mixer defaults used by the engine.
+const (
+	DefaultFrequency = 44100 // Device sample rate in Hz
+	DefaultChannels  = 2     // Stereo output
+	DefaultVoices    = 32    // Mixer channels for sound effects and music
+)
+
+// music uses the two lowest mixer channels, reserved away from sound effects.
+const musicChannels = 2
+
+// ErrDeviceClosed is returned when audio is used without an open device.
+var ErrDeviceClosed = errors.New("audio device not open")
+
+// Sound is a sound effect decoded into memory (WAV or OGG).
+type Sound struct {
+	Path  string
+	chunk *mix.Chunk
+}
+
+// AudioManager owns the SDL_mixer device, sound effects, and music.
+//
+// Sound effects are decoded once and played on any free channel. Music is
+// always streamed from disk: WAV a buffer at a time on two reserved
+// channels, so one track can fade out while the next fades in, and other
+// formats (OGG, MP3, FLAC, and WAV at a sample rate StreamPlayer can't
+// convert) as a Track on SDL_mixer's single music stream. Since there is
+// only one music stream, a crossfade between two Tracks fades the old one
+// out before the new one fades in.
+type AudioManager struct {
+	MasterVolume float64 // 0-1, applied to everything
+	SoundVolume  float64 // 0-1, applied to sound effects when they start
+	MusicVolume  float64 // 0-1, applied to music every Update
+
+	// MusicFadeCurve shapes music fades: it maps the linear fade level
+	// (0-1) to a volume factor. Nil fades linearly; an ease-in-out curve
+	// such as gamemath.EaseInOutCurve(0, 0, 1, 1) gives softer fades.
+	MusicFadeCurve *gamemath.AnimationCurve
+
+	open    bool
+	sounds  map[string]*Sound
+	music   [musicChannels]musicSlot
+	current int // Index of the slot playing (or fading in) the current track
+}
+
+// musicSlot is one of the two music channels.
+type musicSlot struct {
+	channel int
+	path    string
+	loop    bool
+	player  *StreamPlayer // Streaming WAV playback
+	file    *os.File
+	track   *Track  // SDL_mixer music stream (compressed, or WAV at another sample rate)
+	waiting bool    // Track queued until the other slot frees the music stream
+	level   float64 // Fade level 0-1
+	target  float64
+	speed   float64 // Level change per second (0 = instant)
+	applied int     // Last channel volume set (-1 = none)
+}
+
+// NewAudioManager creates a manager with no device open.
+//
+// Example:
+//
+//	audioMgr := audio.NewAudioManager()
+//	if err := audioMgr.Open(audio.DefaultFrequency, audio.DefaultChannels); err != nil {
+//	    log.Printf("audio disabled: %v", err)
+//	}
+//	defer audioMgr.Close()
+func NewAudioManager() *AudioManager {
+	m := &AudioManager{
+		MasterVolume: 1,
+		SoundVolume:  1,
+		MusicVolume:  1,
+		sounds:       make(map[string]*Sound),
+	}
+	for i := range m.music {
+		m.music[i] = musicSlot{channel: i, applied: -1}
+	}
+	return m
+}
+
+// Open opens the audio device in signed 16-bit format.
+//
+// Parameters:
+//
+//	frequency: Sample rate in Hz (DefaultFrequency)
+//	channels: Output channels (1 = mono, 2 = stereo)
+//
+// Returns:
+//
+//	error: Non-nil if SDL's audio subsystem can't start (no driver, or
+//	       SDL_AUDIODRIVER names one that isn't available) or the device
+//	       cannot be opened; the manager stays usable and its Load and
+//	       Play calls return ErrDeviceClosed
+func (m *AudioManager) Open(frequency, channels int) error {
+	if m.open {
+		return nil
+	}
+	if err := sdl.InitSubSystem(sdl.INIT_AUDIO); err != nil {
+		return fmt.Errorf("failed to initialize audio: %w", err)
+	}
+	_ = mix.Init(mix.INIT_OGG) // Optional: WAV works without the OGG decoder
+	if err := mix.OpenAudio(frequency, mix.DEFAULT_FORMAT, channels, 1024); err != nil {
+		mix.Quit()
+		sdl.QuitSubSystem(sdl.INIT_AUDIO)
+		return fmt.Errorf("failed to open audio device: %w", err)
+	}
+	mix.AllocateChannels(DefaultVoices)
+	mix.ReserveChannels(musicChannels)
+	m.open = true
+	return nil
+}
+
+// Enabled reports whether the audio device is open.
+func (m *AudioManager) Enabled() bool {
+	return m.open
+}
+
+// Close stops all audio, frees loaded sounds, and closes the device.
+func (m *AudioManager) Close() {
+	if !m.open {
+		return
+	}
+	mix.HaltChannel(-1)
+	for i := range m.music {
+		m.music[i].release()
+	}
+	for path, sound := range m.sounds {
+		sound.chunk.Free()
+		delete(m.sounds, path)
+	}
+	for format, carrier := range carriers {
+		carrier.Free()
+		delete(carriers, format)
+	}
+	mix.CloseAudio()
+	mix.Quit()
+	sdl.QuitSubSystem(sdl.INIT_AUDIO)
+	m.open = false
+}
+
+// LoadSound decodes a WAV or OGG file, returning the cached sound if the
+// path was loaded before.
+func (m *AudioManager) LoadSound(path string) (*Sound, error) {
+	if sound, ok := m.sounds[path]; ok {
+		return sound, nil
+	}
+	if !m.open {
+		return nil, fmt.Errorf("failed to load sound: %s: %w", path, ErrDeviceClosed)
+	}
+	chunk, err := mix.LoadWAV(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sound: %s: %w", path, err)
+	}
+	sound := &Sound{Path: path, chunk: chunk}
+	m.sounds[path] = sound
+	return sound, nil
+}
+
+// UnloadSound stops channels playing a sound and frees it.
+func (m *AudioManager) UnloadSound(path string) {
+	sound, ok := m.sounds[path]
+	if !ok {
+		return
+	}
+	for channel := musicChannels; channel < DefaultVoices; channel++ {
+		if mix.GetChunk(channel) == sound.chunk {
+			mix.HaltChannel(channel)
+		}
+	}
+	sound.chunk.Free()
+	delete(m.sounds, path)
+}
+
+// PlaySound plays a sound effect once.
+//
+// Parameters:
+//
+//	sound: Sound from LoadSound
+//	volume: 0-1, scaled by SoundVolume and MasterVolume
+//	pan: -1 (left) to 1 (right), 0 = center
+//
+// Returns:
+//
+//	int: Mixer channel playing the sound
+//	error: Non-nil if the device is closed or every channel is busy
+//
+// Example:
+//
+//	jump, _ := engine.Audio().LoadSound("sfx/jump.wav")
+//	_, _ = engine.Audio().PlaySound(jump, 0.8, -0.3)
+func (m *AudioManager) PlaySound(sound *Sound, volume, pan float64) (int, error) {
+	if !m.open || sound == nil || sound.chunk == nil {
+		return -1, ErrDeviceClosed
+	}
+	channel, err := sound.chunk.Play(-1, 0)
+	if err != nil {
+		return -1, fmt.Errorf("failed to play sound: %s: %w", sound.Path, err)
+	}
+	mix.Volume(channel, mixerVolume(volume*m.SoundVolume*m.MasterVolume))
+	left, right := PanGains(pan)
+	_ = mix.SetPanning(channel, left, right) // Fails only on mono devices, where pan has no meaning
+	return channel, nil
+}
+
+// StopSounds halts all sound effects (music keeps playing).
+func (m *AudioManager) StopSounds() {
+	if !m.open {
+		return
+	}
+	for channel := musicChannels; channel < DefaultVoices; channel++ {
+		mix.HaltChannel(channel)
+	}
+}
+
+// PlayMusic starts a looping (or one-shot) music track.
+//
+// Parameters:
+//
+//	path: WAV, OGG, MP3, or FLAC file
+//	loop: Repeat the track until stopped
+//	crossfade: Seconds to fade out the current track while the new one
+//	           fades in (0 = cut)
+//
+// Returns:
+//
+//	error: Non-nil if the device is closed or the file cannot be loaded;
+//	       the current track keeps playing
+//
+// Behavior:
+//   - Playing the track that is already current does nothing
+//   - Starting a third track mid-crossfade cuts the oldest one
+//   - Between two compressed tracks, which share SDL_mixer's single music
+//     stream, the crossfade is sequential: the current track fades out
+//     over the first half and the new one fades in over the second
+//
+// Example:
+//
+//	_ = engine.Audio().PlayMusic("music/town.ogg", true, 0)
+//	// Later, entering the dungeon
+//	_ = engine.Audio().PlayMusic("music/dungeon.ogg", true, 2)
+func (m *AudioManager) PlayMusic(path string, loop bool, crossfade float64) error {
+	if !m.open {
+		return fmt.Errorf("failed to play music: %s: %w", path, ErrDeviceClosed)
+	}
+	current := &m.music[m.current]
+	if current.path == path && current.target > 0 {
+		return nil
+	}
+
+	next := &m.music[1-m.current]
+	next.release()
+	mix.Volume(next.channel, 0)
+	if err := next.start(path, loop, current.track != nil); err != nil {
+		return fmt.Errorf("failed to play music: %s: %w", path, err)
+	}
+
+	speed := 0.0
+	if crossfade > 0 {
+		speed = 1 / crossfade
+		if next.waiting {
+			speed *= 2 // Fade out, then in, within the crossfade
+		}
+	}
+	next.fadeTo(1, speed)
+	current.fadeTo(0, speed)
+	m.current = 1 - m.current
+	m.Update(0)
+	return nil
+}
+
+// StopMusic fades out the current track.
+func (m *AudioManager) StopMusic(fade float64) {
+	speed := 0.0
+	if fade > 0 {
+		speed = 1 / fade
+	}
+	for i := range m.music {
+		m.music[i].fadeTo(0, speed)
+	}
+	m.Update(0)
+}
+
+// Music returns the path of the current track ("" if none or fading out).
+func (m *AudioManager) Music() string {
+	if slot := m.music[m.current]; slot.target > 0 {
+		return slot.path
+	}
+	return ""
+}
+
+// Update advances music fades and applies volume changes. The engine calls
+// it once per frame.
+func (m *AudioManager) Update(dt float64) {
+	if !m.open {
+		return
+	}
+	for i := range m.music {
+		slot := &m.music[i]
+		if slot.path == "" {
+			continue
+		}
+		if slot.waiting {
+			if slot.target <= 0 {
+				slot.release()
+			}
+			continue
+		}
+		if slot.speed <= 0 {
+			slot.level = slot.target
+		} else {
+			slot.level = moveToward(slot.level, slot.target, dt*slot.speed)
+		}
+		if slot.player != nil {
+			slot.player.Poll()
+		}
+		if slot.track != nil {
+			slot.track.Poll()
+		}
+		finished := slot.player != nil && !slot.player.Playing() ||
+			slot.track != nil && !slot.loop && !mix.PlayingMusic()
+		if (slot.level <= 0 && slot.target <= 0) || finished {
+			slot.release()
+			continue
+		}
+		level := slot.level
+		if m.MusicFadeCurve != nil {
+			level = math.Max(0, math.Min(1, m.MusicFadeCurve.Evaluate(level)))
+		}
+		volume := mixerVolume(level * m.MusicVolume * m.MasterVolume)
+		if volume != slot.applied {
+			if slot.track != nil {
+				mix.VolumeMusic(volume)
+			} else {
+				mix.Volume(slot.channel, volume)
+			}
+			slot.applied = volume
+		}
+	}
+
+	// A queued Track takes over the music stream once the old one is gone,
+	// starting silent and fading in from the next update
+	for i := range m.music {
+		slot := &m.music[i]
+		if slot.waiting && m.music[1-i].track == nil {
+			if err := slot.play(); err != nil {
+				slot.release()
+			}
+		}
+	}
+}
+
+// start loads a track into the slot and starts it silent. A Track waits
+// instead when the music stream is busy with the other slot's track.
+func (s *musicSlot) start(path string, loop, streamBusy bool) error {
+	if strings.EqualFold(filepath.Ext(path), ".wav") {
+		if err := s.startStream(path, loop); err == nil {
+			return nil
+		} else if !errors.Is(err, ErrUnsupportedFormat) {
+			return err
+		}
+		// Fall through: SDL_mixer converts formats the stream player cannot
+	}
+	track, err := LoadTrack(path)
+	if err != nil {
+		return err
+	}
+	track.Loop = loop
+	s.track, s.path, s.loop = track, path, loop
+	if streamBusy {
+		s.waiting = true
+		return nil
+	}
+	if err := s.play(); err != nil {
+		track.Free()
+		*s = musicSlot{channel: s.channel, applied: -1}
+		return err
+	}
+	return nil
+}
+
+// play starts the slot's Track silent on the music stream.
+func (s *musicSlot) play() error {
+	mix.VolumeMusic(0)
+	if err := s.track.Play(); err != nil {
+		return err
+	}
+	s.waiting, s.applied = false, -1
+	return nil
+}
+
+// startStream streams a WAV file from disk.
+func (s *musicSlot) startStream(path string, loop bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	wav, err := NewWAVStream(file)
+	if err != nil {
+		_ = file.Close() // Best effort cleanup
+		return err
+	}
+	var stream Stream = wav
+	if loop {
+		stream = NewLoopStream(wav, 0, wav.Frames())
+	}
+	player := NewStreamPlayer(s.channel)
+	if err := player.Play(stream); err != nil {
+		player.Free()
+		_ = file.Close() // Best effort cleanup
+		return err
+	}
+	s.player, s.file, s.path, s.loop = player, file, path, loop
+	return nil
+}
+
+// fadeTo sets the slot's fade target.
+func (s *musicSlot) fadeTo(target, speed float64) {
+	if s.path == "" {
+		return
+	}
+	s.target, s.speed = target, speed
+}
+
+// release stops the slot and frees its track.
+func (s *musicSlot) release() {
+	if s.path == "" {
+		return
+	}
+	mix.HaltChannel(s.channel)
+	if s.player != nil {
+		s.player.Stop()
+		s.player.Free()
+	}
+	if s.track != nil {
+		if !s.waiting {
+			s.track.Stop()
+		}
+		s.track.Free()
+	}
+	if s.file != nil {
+		_ = s.file.Close() // Best effort cleanup
+	}
+	*s = musicSlot{channel: s.channel, applied: -1}
+}
+
+// PanGains converts a pan position (-1 left to 1 right) into SDL_mixer
+// channel gains. Uses an equal-power curve normalized so center is full
+// volume on both sides.
+func PanGains(pan float64) (left, right uint8) {
+	pan = math.Max(-1, math.Min(1, pan))
+	angle := (pan + 1) * math.Pi / 4
+	gain := func(g float64) uint8 {
+		return uint8(math.Round(math.Min(1, g*math.Sqrt2) * 255))
+	}
+	return gain(math.Cos(angle)), gain(math.Sin(angle))
+}
+
+// mixerVolume converts 0-1 to SDL_mixer's 0-128 volume.
+func mixerVolume(volume float64) int {
+	return int(math.Round(math.Max(0, math.Min(1, volume)) * mix.MAX_VOLUME))
+}
@@ -0,0 +1,349 @@
+package audio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/veandco/go-sdl2/mix"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// StreamPlayer feeds a Stream to an SDL_mixer channel.
+//
+// Samples are pulled from the stream on SDL's audio thread a buffer at a
+// time, so only the mixer's buffer is held in memory. The player loops a
+// silent chunk on its channel and overwrites it with stream audio from a
+// channel effect, so channel volume, panning, and further effects apply as
+// they do to sound effects. The mixer must be opened with mix.OpenAudio at
+// the stream's sample rate; mono streams are duplicated to stereo devices.
+type StreamPlayer struct {
+	Channel int // Mixer channel used for playback (reserve it with mix.ReserveChannels)
+
+	mu      sync.Mutex
+	stream  Stream
+	device  Format
+	paused  bool
+	scratch []int16
+	err     error
+	silence *mix.Chunk
+}
+
+// NewStreamPlayer creates an idle player on a mixer channel.
+//
+// Example:
+//
+//	mix.ReserveChannels(1) // Keep channel 0 away from sound effects
+//	music := audio.NewStreamPlayer(0)
+//	if err := music.Play(loop); err != nil {
+//	    log.Printf("music disabled: %v", err)
+//	}
+func NewStreamPlayer(channel int) *StreamPlayer {
+	return &StreamPlayer{Channel: channel}
+}
+
+// Play starts streaming, replacing any current stream.
+func (p *StreamPlayer) Play(stream Stream) error {
+	frequency, format, channels, _, err := mix.QuerySpec()
+	if err != nil {
+		return fmt.Errorf("audio device not open: %w", err)
+	}
+	if format != mix.DEFAULT_FORMAT {
+		return fmt.Errorf("%w: device must use signed 16-bit samples", ErrUnsupportedFormat)
+	}
+	if stream.Format().SampleRate != frequency {
+		return fmt.Errorf("%w: stream is %d Hz, device is %d Hz", ErrUnsupportedFormat, stream.Format().SampleRate, frequency)
+	}
+	if stream.Format().Channels != channels && stream.Format().Channels != 1 {
+		return fmt.Errorf("%w: %d channel stream on %d channel device", ErrUnsupportedFormat, stream.Format().Channels, channels)
+	}
+	if p.silence == nil {
+		p.silence, err = silentChunk(frequency, channels)
+		if err != nil {
+			return err
+		}
+	}
+
+	mix.HaltChannel(p.Channel) // Also removes the previous stream's effect
+	p.mu.Lock()
+	p.stream = stream
+	p.device = Format{SampleRate: frequency, Channels: channels}
+	p.paused = false
+	p.err = nil
+	p.mu.Unlock()
+
+	if _, err := p.silence.Play(p.Channel, -1); err != nil {
+		return fmt.Errorf("failed to start stream channel: %w", err)
+	}
+	if err := mix.RegisterEffect(p.Channel, func(_ int, buf []byte) { p.fill(buf) }, func(int) {}); err != nil {
+		mix.HaltChannel(p.Channel)
+		return fmt.Errorf("failed to attach stream: %w", err)
+	}
+	return nil
+}
+
+// Stop ends playback.
+func (p *StreamPlayer) Stop() {
+	p.mu.Lock()
+	playing := p.stream != nil
+	p.stream = nil
+	p.mu.Unlock()
+	if playing {
+		mix.HaltChannel(p.Channel)
+	}
+}
+
+// SetPaused pauses or resumes the channel.
+func (p *StreamPlayer) SetPaused(paused bool) {
+	p.mu.Lock()
+	p.paused = paused
+	p.mu.Unlock()
+	if paused {
+		mix.Pause(p.Channel)
+	} else {
+		mix.Resume(p.Channel)
+	}
+}
+
+// SeekFrame moves the current stream to a frame.
+func (p *StreamPlayer) SeekFrame(frame int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stream == nil {
+		return nil
+	}
+	return p.stream.SeekFrame(frame)
+}
+
+// Playing reports whether a stream is active and has not ended.
+func (p *StreamPlayer) Playing() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stream != nil
+}
+
+// Err returns the decode error that stopped playback, if any.
+func (p *StreamPlayer) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Poll halts the channel once the stream ends. Call it once per frame on
+// the game thread (see world.Polling).
+func (p *StreamPlayer) Poll() {
+	if !p.Playing() && mix.Playing(p.Channel) != 0 {
+		mix.HaltChannel(p.Channel)
+	}
+}
+
+// Free releases the silent carrier chunk. The player must be stopped.
+func (p *StreamPlayer) Free() {
+	if p.silence != nil {
+		p.silence.Free()
+		p.silence = nil
+	}
+}
+
+// fill is the channel effect; buf is little-endian S16 device audio.
+// It runs on the audio thread and must not call SDL_mixer.
+func (p *StreamPlayer) fill(buf []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clear(buf)
+	if p.stream == nil || p.paused {
+		return
+	}
+
+	mono := p.stream.Format().Channels == 1 && p.device.Channels == 2
+	want := len(buf) / 2
+	if mono {
+		want /= 2
+	}
+	if cap(p.scratch) < want {
+		p.scratch = make([]int16, want)
+	}
+	samples := p.scratch[:want]
+
+	filled := 0
+	for filled < want {
+		n, err := p.stream.Read(samples[filled:])
+		filled += n
+		if err == io.EOF || (err == nil && n == 0) {
+			p.stream = nil
+			break
+		}
+		if err != nil {
+			p.err = err
+			p.stream = nil
+			break
+		}
+	}
+
+	for i := 0; i < filled; i++ {
+		if mono {
+			binary.LittleEndian.PutUint16(buf[i*4:], uint16(samples[i]))
+			binary.LittleEndian.PutUint16(buf[i*4+2:], uint16(samples[i]))
+		} else {
+			binary.LittleEndian.PutUint16(buf[i*2:], uint16(samples[i]))
+		}
+	}
+}
+
+// carriers caches one silent chunk per device format for PlayGenerator.
+var carriers = make(map[Format]*mix.Chunk)
+
+// PlayGenerator plays audio produced by fill on a mixer channel.
+//
+// fill receives each device buffer (little-endian S16 in the device format)
+// on SDL's audio thread and must not call SDL_mixer. Channel volume and
+// panning apply to the generated audio as they do to sound effects.
+//
+// Parameters:
+//
+//	channel: Mixer channel, or -1 for the first free channel
+//	fill: Writes one buffer of audio; the buffer holds stale data on entry
+//
+// Returns:
+//
+//	int: Channel playing the generator (stop it with mix.HaltChannel)
+//	error: Non-nil if the device is closed or no channel is free
+func PlayGenerator(channel int, fill func(buf []byte)) (int, error) {
+	frequency, _, channels, _, err := mix.QuerySpec()
+	if err != nil {
+		return -1, fmt.Errorf("audio device not open: %w", err)
+	}
+	device := Format{SampleRate: frequency, Channels: channels}
+	carrier := carriers[device]
+	if carrier == nil {
+		if carrier, err = silentChunk(frequency, channels); err != nil {
+			return -1, err
+		}
+		carriers[device] = carrier
+	}
+	channel, err = carrier.Play(channel, -1)
+	if err != nil {
+		return -1, fmt.Errorf("no free channel: %w", err)
+	}
+	if err := mix.RegisterEffect(channel, func(_ int, buf []byte) { fill(buf) }, func(int) {}); err != nil {
+		mix.HaltChannel(channel)
+		return -1, fmt.Errorf("failed to attach generator: %w", err)
+	}
+	return channel, nil
+}
+
+// silentChunk builds a short silent WAV in the device format; SDL_mixer copies it.
+func silentChunk(frequency, channels int) (*mix.Chunk, error) {
+	frames := 1024
+	dataSize := frames * channels * 2
+	wav := make([]byte, 44+dataSize)
+	le := binary.LittleEndian
+	copy(wav[0:], "RIFF")
+	le.PutUint32(wav[4:], uint32(36+dataSize))
+	copy(wav[8:], "WAVEfmt ")
+	le.PutUint32(wav[16:], 16)
+	le.PutUint16(wav[20:], 1)
+	le.PutUint16(wav[22:], uint16(channels))
+	le.PutUint32(wav[24:], uint32(frequency))
+	le.PutUint32(wav[28:], uint32(frequency*channels*2))
+	le.PutUint16(wav[32:], uint16(channels*2))
+	le.PutUint16(wav[34:], 16)
+	copy(wav[36:], "data")
+	le.PutUint32(wav[40:], uint32(dataSize))
+
+	rw, err := sdl.RWFromMem(wav)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream carrier: %w", err)
+	}
+	chunk, err := mix.LoadWAVRW(rw, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream carrier: %w", err)
+	}
+	return chunk, nil
+}
+
+// Track plays compressed music (OGG, MP3, FLAC...) streamed by SDL_mixer.
+//
+// SDL_mixer decodes music incrementally from disk, so long tracks stay small
+// in memory. LoopStart gives an intro + loop structure: after the first play
+// reaches the end, playback restarts at LoopStart instead of the beginning.
+// OGG files tagged with LOOPSTART/LOOPLENGTH loop sample-accurately inside
+// SDL_mixer 2.6+; for other files the restart happens on the next Update.
+type Track struct {
+	Path      string
+	LoopStart float64 // Seconds; where repeats begin (0 = whole track)
+	Loop      bool
+
+	music    *mix.Music
+	finished chan struct{}
+}
+
+// LoadTrack opens a music file for streaming.
+//
+// Example:
+//
+//	track, err := audio.LoadTrack("music/boss.ogg")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	track.LoopStart = 12.5 // Skip the intro when repeating
+//	track.Loop = true
+//	_ = track.Play()
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: world.Polling{track}})
+func LoadTrack(path string) (*Track, error) {
+	music, err := mix.LoadMUS(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load music: %s: %w", path, err)
+	}
+	return &Track{Path: path, music: music, finished: make(chan struct{}, 1)}, nil
+}
+
+// Play starts the track from the beginning.
+func (t *Track) Play() error {
+	mix.HookMusicFinished(func() {
+		// Called on the audio thread; SDL_mixer must not be re-entered here
+		select {
+		case t.finished <- struct{}{}:
+		default:
+		}
+	})
+	if err := t.music.Play(1); err != nil {
+		return fmt.Errorf("failed to play music: %w", err)
+	}
+	return nil
+}
+
+// Stop halts the track.
+func (t *Track) Stop() {
+	mix.HookMusicFinished(nil)
+	mix.HaltMusic()
+}
+
+// SetPosition jumps to a position in whole seconds (OGG/MP3; meaning varies by format).
+func (t *Track) SetPosition(seconds int64) error {
+	if err := mix.SetMusicPosition(seconds); err != nil {
+		return fmt.Errorf("failed to seek music: %w", err)
+	}
+	return nil
+}
+
+// Poll restarts the loop section when the track ends. Call it once per
+// frame on the game thread (see world.Polling).
+func (t *Track) Poll() {
+	select {
+	case <-t.finished:
+		if t.Loop {
+			_ = t.music.FadeInPos(1, 0, t.LoopStart) // Errors leave the track stopped
+		}
+	default:
+	}
+}
+
+// Free releases the decoder. The track must not be playing.
+func (t *Track) Free() {
+	if t.music != nil {
+		t.music.Free()
+		t.music = nil
+	}
+}
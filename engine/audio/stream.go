@@ -0,0 +1,243 @@
+// Package audio provides music streaming and sound playback on SDL_mixer.
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Stream errors.
+var (
+	ErrUnsupportedFormat = errors.New("unsupported audio format")
+	ErrSeekOutOfRange    = errors.New("seek out of range")
+)
+
+// Format describes interleaved signed 16-bit PCM.
+type Format struct {
+	SampleRate int // Frames per second (e.g. 44100)
+	Channels   int // 1 = mono, 2 = stereo
+}
+
+// Stream is a seekable source of interleaved 16-bit PCM decoded on demand.
+//
+// Streams decode in small chunks as they are read, so a ten minute track
+// costs a few kilobytes of buffer rather than tens of megabytes of samples.
+type Stream interface {
+	Format() Format
+	// Read fills samples (interleaved, len a multiple of Channels) and returns
+	// the number of samples written; io.EOF at the end of the stream.
+	Read(samples []int16) (int, error)
+	// SeekFrame moves to a frame (one sample per channel).
+	SeekFrame(frame int64) error
+	// Frames returns the total length in frames.
+	Frames() int64
+}
+
+// WAVStream decodes a PCM16 WAV file incrementally.
+type WAVStream struct {
+	r         io.ReadSeeker
+	format    Format
+	dataStart int64 // Byte offset of the first sample
+	frames    int64 // Total frames in the data chunk
+	position  int64 // Current frame
+	buf       []byte
+}
+
+// NewWAVStream parses a WAV header and prepares to stream its samples.
+//
+// Only uncompressed 16-bit PCM is supported; OGG and MP3 music should be
+// played with Track, which streams through SDL_mixer's decoders.
+//
+// Example:
+//
+//	file, err := os.Open("music/theme.wav")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	stream, err := audio.NewWAVStream(file)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	loop := audio.NewLoopStream(stream, 4*44100, stream.Frames()) // 4s intro
+func NewWAVStream(r io.ReadSeeker) (*WAVStream, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read WAV header: %w", err)
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("%w: not a WAV file", ErrUnsupportedFormat)
+	}
+
+	s := &WAVStream{r: r, buf: make([]byte, 4096)}
+	offset := int64(12)
+	haveFormat := false
+	for {
+		chunk := make([]byte, 8)
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return nil, fmt.Errorf("%w: missing data chunk", ErrUnsupportedFormat)
+		}
+		id := string(chunk[0:4])
+		size := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+		offset += 8
+
+		switch id {
+		case "fmt ":
+			fmtChunk := make([]byte, size)
+			if _, err := io.ReadFull(r, fmtChunk); err != nil || size < 16 {
+				return nil, fmt.Errorf("%w: bad fmt chunk", ErrUnsupportedFormat)
+			}
+			audioFormat := binary.LittleEndian.Uint16(fmtChunk[0:2])
+			bits := binary.LittleEndian.Uint16(fmtChunk[14:16])
+			if audioFormat != 1 || bits != 16 {
+				return nil, fmt.Errorf("%w: only 16-bit PCM WAV is supported", ErrUnsupportedFormat)
+			}
+			s.format = Format{
+				Channels:   int(binary.LittleEndian.Uint16(fmtChunk[2:4])),
+				SampleRate: int(binary.LittleEndian.Uint32(fmtChunk[4:8])),
+			}
+			haveFormat = s.format.Channels > 0
+		case "data":
+			if !haveFormat {
+				return nil, fmt.Errorf("%w: data before fmt chunk", ErrUnsupportedFormat)
+			}
+			s.dataStart = offset
+			s.frames = size / int64(2*s.format.Channels)
+			return s, nil
+		default:
+			if _, err := r.Seek(size+size%2, io.SeekCurrent); err != nil {
+				return nil, fmt.Errorf("failed to skip WAV chunk: %w", err)
+			}
+		}
+		offset += size + size%2
+	}
+}
+
+// Format returns the stream format.
+func (s *WAVStream) Format() Format {
+	return s.format
+}
+
+// Frames returns the total length in frames.
+func (s *WAVStream) Frames() int64 {
+	return s.frames
+}
+
+// Read decodes up to len(samples) samples.
+func (s *WAVStream) Read(samples []int16) (int, error) {
+	remaining := (s.frames - s.position) * int64(s.format.Channels)
+	if remaining <= 0 {
+		return 0, io.EOF
+	}
+	want := int64(len(samples))
+	want -= want % int64(s.format.Channels)
+	want = min(want, remaining, int64(len(s.buf)/2))
+
+	n, err := io.ReadFull(s.r, s.buf[:want*2])
+	count := n / 2
+	count -= count % s.format.Channels
+	for i := 0; i < count; i++ {
+		samples[i] = int16(binary.LittleEndian.Uint16(s.buf[i*2:]))
+	}
+	s.position += int64(count / s.format.Channels)
+	if err != nil && count == 0 {
+		return 0, fmt.Errorf("failed to read WAV samples: %w", err)
+	}
+	return count, nil
+}
+
+// SeekFrame moves to a frame.
+func (s *WAVStream) SeekFrame(frame int64) error {
+	if frame < 0 || frame > s.frames {
+		return fmt.Errorf("%w: frame %d of %d", ErrSeekOutOfRange, frame, s.frames)
+	}
+	if _, err := s.r.Seek(s.dataStart+frame*int64(2*s.format.Channels), io.SeekStart); err != nil {
+		return fmt.Errorf("failed to seek WAV: %w", err)
+	}
+	s.position = frame
+	return nil
+}
+
+// LoopStream plays an intro once, then repeats a loop section seamlessly.
+//
+// Frames [0, LoopStart) are the intro; [LoopStart, LoopEnd) repeats forever.
+// The jump back happens mid-buffer, so there is no gap at the seam.
+type LoopStream struct {
+	Source    Stream
+	LoopStart int64 // First frame of the loop section
+	LoopEnd   int64 // Frame after the last looped frame (0 = end of source)
+	Loop      bool  // Disable to let the stream end at LoopEnd
+
+	position int64
+}
+
+// NewLoopStream wraps a source with an intro and a looping section.
+func NewLoopStream(source Stream, loopStart, loopEnd int64) *LoopStream {
+	return &LoopStream{Source: source, LoopStart: loopStart, LoopEnd: loopEnd, Loop: true}
+}
+
+// Format returns the source format.
+func (l *LoopStream) Format() Format {
+	return l.Source.Format()
+}
+
+// Frames returns the intro plus one pass of the loop.
+func (l *LoopStream) Frames() int64 {
+	return l.end()
+}
+
+// Position returns the current frame.
+func (l *LoopStream) Position() int64 {
+	return l.position
+}
+
+// Read fills samples, wrapping to LoopStart at LoopEnd.
+func (l *LoopStream) Read(samples []int16) (int, error) {
+	channels := int64(l.Source.Format().Channels)
+	total := 0
+	for total < len(samples) {
+		if l.position >= l.end() {
+			if !l.Loop || l.end() <= l.LoopStart {
+				break
+			}
+			if err := l.SeekFrame(l.LoopStart); err != nil {
+				return total, err
+			}
+		}
+		limit := min(int64(len(samples)-total), (l.end()-l.position)*channels)
+		n, err := l.Source.Read(samples[total : total+int(limit)])
+		total += n
+		l.position += int64(n) / channels
+		if err == io.EOF {
+			l.position = l.end() // Source shorter than LoopEnd
+			continue
+		}
+		if err != nil {
+			return total, err
+		}
+		if n == 0 {
+			break
+		}
+	}
+	if total == 0 {
+		return 0, io.EOF
+	}
+	return total, nil
+}
+
+// SeekFrame moves to a frame.
+func (l *LoopStream) SeekFrame(frame int64) error {
+	if err := l.Source.SeekFrame(frame); err != nil {
+		return err
+	}
+	l.position = frame
+	return nil
+}
+
+func (l *LoopStream) end() int64 {
+	if l.LoopEnd <= 0 || l.LoopEnd > l.Source.Frames() {
+		return l.Source.Frames()
+	}
+	return l.LoopEnd
+}
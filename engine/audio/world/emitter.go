@@ -0,0 +1,346 @@
+// Package world provides audio behaviors driven by scene entities:
+// positional emitters, reverb zones, and music ducking.
+package world
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/dshills/gogame/engine/audio"
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/mix"
+)
+
+// Emitter is a positional sound attached to an entity.
+type Emitter struct {
+	Entity  *core.Entity
+	Clip    *audio.Clip
+	Loop    bool
+	Volume  float64 // 0-1
+	Range   float64 // Distance at which the sound is silent (0 = not positional)
+	Doppler bool    // Shift pitch by relative velocity to the listener
+
+	playing  bool
+	gain     float64
+	pan      float64 // -1 left .. 1 right
+	pitch    float64
+	velocity gamemath.Vector2
+	lastPos  gamemath.Vector2
+	tracked  bool
+	voice    *voice
+	channel  int
+}
+
+// Gain returns the current distance-attenuated volume.
+func (e *Emitter) Gain() float64 {
+	return e.gain
+}
+
+// Pan returns the current stereo position (-1 left to 1 right).
+func (e *Emitter) Pan() float64 {
+	return e.pan
+}
+
+// Pitch returns the current doppler pitch multiplier (1 = unshifted).
+func (e *Emitter) Pitch() float64 {
+	return e.pitch
+}
+
+// Playing reports whether the emitter is playing (audible or out of range).
+func (e *Emitter) Playing() bool {
+	return e.playing
+}
+
+// Emitters manages positional sounds relative to a listener.
+//
+// Each audible emitter gets a mixer channel carrying its clip, resampled on
+// the audio thread so doppler pitch can change continuously. Emitters out of
+// range release their channel; looping emitters start again from the top
+// when the listener comes back in range.
+type Emitters struct {
+	Listener     *core.Entity
+	SpeedOfSound float64 // World units per second (doppler strength; lower = stronger)
+	MaxPitch     float64 // Doppler clamp (e.g. 2 = one octave up, 1/2 down)
+	PanWidth     float64 // Horizontal distance for full left/right pan
+
+	// OnError is called when a voice fails to start (e.g. audio device closed)
+	OnError func(err error)
+
+	emitters     []*Emitter
+	listenerPos  gamemath.Vector2
+	listenerVel  gamemath.Vector2
+	listenerSeen bool
+}
+
+// NewEmitters creates an emitter manager.
+//
+// Example:
+//
+//	emitters := world.NewEmitters(player)
+//	siren, _ := audio.LoadClip("sfx/siren.wav")
+//	emitters.Add(&world.Emitter{Entity: police, Clip: siren, Loop: true, Volume: 1, Range: 800, Doppler: true})
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: emitters})
+func NewEmitters(listener *core.Entity) *Emitters {
+	return &Emitters{
+		Listener:     listener,
+		SpeedOfSound: 1500,
+		MaxPitch:     2,
+		PanWidth:     400,
+		emitters:     make([]*Emitter, 0),
+	}
+}
+
+// Add registers an emitter and starts it.
+func (m *Emitters) Add(emitter *Emitter) *Emitter {
+	emitter.playing = true
+	emitter.pitch = 1
+	emitter.channel = -1
+	m.emitters = append(m.emitters, emitter)
+	return emitter
+}
+
+// Remove stops and unregisters an emitter.
+func (m *Emitters) Remove(emitter *Emitter) {
+	for i, existing := range m.emitters {
+		if existing == emitter {
+			m.release(emitter)
+			emitter.playing = false
+			m.emitters = append(m.emitters[:i], m.emitters[i+1:]...)
+			return
+		}
+	}
+}
+
+// Play restarts an emitter from the beginning.
+func (m *Emitters) Play(emitter *Emitter) {
+	emitter.playing = true
+	if emitter.voice != nil {
+		emitter.voice.restart()
+	}
+}
+
+// Stop silences an emitter (it stays registered).
+func (m *Emitters) Stop(emitter *Emitter) {
+	emitter.playing = false
+	m.release(emitter)
+}
+
+// Emitters returns the registered emitters.
+func (m *Emitters) Emitters() []*Emitter {
+	return m.emitters
+}
+
+// Update positions, attenuates, and doppler-shifts emitters (implements core.Behavior).
+func (m *Emitters) Update(_ *core.Entity, dt float64) {
+	if m.Listener != nil {
+		position := m.Listener.WorldTransform().Position
+		if m.listenerSeen && dt > 0 {
+			m.listenerVel = position.Sub(m.listenerPos).Scale(1 / dt)
+		}
+		m.listenerPos, m.listenerSeen = position, true
+	}
+
+	for _, emitter := range m.emitters {
+		m.track(emitter, dt)
+		if emitter.voice != nil && emitter.voice.finished() {
+			emitter.playing = false
+		}
+		if !emitter.playing || emitter.Entity == nil || !emitter.Entity.Active || emitter.gain <= 0 {
+			m.release(emitter)
+			continue
+		}
+		if emitter.voice == nil {
+			if err := m.start(emitter); err != nil {
+				if m.OnError != nil {
+					m.OnError(err)
+				}
+				continue
+			}
+		}
+		emitter.voice.set(emitter.gain, emitter.pan, emitter.pitch)
+	}
+}
+
+// track computes gain, pan, and pitch for an emitter.
+func (m *Emitters) track(e *Emitter, dt float64) {
+	if e.Entity == nil {
+		e.gain = 0
+		return
+	}
+	position := e.Entity.WorldTransform().Position
+	if e.tracked && dt > 0 {
+		e.velocity = position.Sub(e.lastPos).Scale(1 / dt)
+	}
+	e.lastPos, e.tracked = position, true
+
+	e.gain, e.pan, e.pitch = e.Volume, 0, 1
+	if e.Range <= 0 || m.Listener == nil {
+		return
+	}
+	offset := position.Sub(m.listenerPos)
+	distance := offset.Length()
+	e.gain = e.Volume * Attenuation(distance, e.Range)
+	if m.PanWidth > 0 {
+		e.pan = math.Max(-1, math.Min(1, offset.X/m.PanWidth))
+	}
+	if e.Doppler {
+		e.pitch = DopplerFactor(position, e.velocity, m.listenerPos, m.listenerVel, m.SpeedOfSound)
+		if m.MaxPitch > 1 {
+			e.pitch = math.Max(1/m.MaxPitch, math.Min(m.MaxPitch, e.pitch))
+		}
+	}
+}
+
+// start claims a channel and attaches a voice to it.
+func (m *Emitters) start(e *Emitter) error {
+	if e.Clip == nil || e.Clip.Frames() == 0 {
+		return fmt.Errorf("emitter has no clip")
+	}
+	frequency, _, channels, _, err := mix.QuerySpec()
+	if err != nil {
+		return fmt.Errorf("audio device not open: %w", err)
+	}
+	v := newVoice(e.Clip, e.Loop, frequency, channels)
+	channel, err := audio.PlayGenerator(-1, v.fill)
+	if err != nil {
+		return fmt.Errorf("failed to start emitter: %w", err)
+	}
+	e.voice, e.channel = v, channel
+	return nil
+}
+
+// release halts an emitter's channel and drops its voice.
+func (m *Emitters) release(e *Emitter) {
+	if e.voice == nil {
+		return
+	}
+	mix.HaltChannel(e.channel)
+	e.voice, e.channel = nil, -1
+}
+
+// Attenuation returns linear distance falloff: 1 at the source, 0 at maxRange.
+func Attenuation(distance, maxRange float64) float64 {
+	if maxRange <= 0 {
+		return 1
+	}
+	return math.Max(0, 1-distance/maxRange)
+}
+
+// DopplerFactor returns the pitch multiplier for a moving source and listener.
+//
+// Uses f' = f (c + vL) / (c - vS), where vL and vS are the listener and
+// source velocities along the line between them (positive = approaching).
+func DopplerFactor(sourcePos, sourceVel, listenerPos, listenerVel gamemath.Vector2, speedOfSound float64) float64 {
+	direction := listenerPos.Sub(sourcePos)
+	if direction.Length() == 0 || speedOfSound <= 0 {
+		return 1
+	}
+	direction = direction.Normalize()
+	sourceToward := sourceVel.Dot(direction)
+	listenerToward := -listenerVel.Dot(direction)
+	// Keep the denominator positive for supersonic sources
+	sourceToward = math.Min(sourceToward, speedOfSound*0.9)
+	listenerToward = math.Max(listenerToward, -speedOfSound*0.9)
+	return (speedOfSound + listenerToward) / (speedOfSound - sourceToward)
+}
+
+// voice resamples a clip onto a mixer channel. Fields are shared with the audio thread.
+type voice struct {
+	mu       sync.Mutex
+	clip     *audio.Clip
+	loop     bool
+	rate     float64 // Clip frames per device frame at pitch 1
+	channels int     // Device channels
+	position float64 // Clip frame
+	left     float64
+	right    float64
+	pitch    float64
+	done     bool
+}
+
+func newVoice(clip *audio.Clip, loop bool, frequency, channels int) *voice {
+	return &voice{
+		clip:     clip,
+		loop:     loop,
+		rate:     float64(clip.Format.SampleRate) / float64(frequency),
+		channels: channels,
+		pitch:    1,
+	}
+}
+
+// set updates gain, pan, and pitch from the game thread.
+func (v *voice) set(gain, pan, pitch float64) {
+	v.mu.Lock()
+	// Equal-power pan
+	angle := (pan + 1) * math.Pi / 4
+	v.left, v.right = gain*math.Cos(angle)*math.Sqrt2, gain*math.Sin(angle)*math.Sqrt2
+	v.left, v.right = math.Min(v.left, gain), math.Min(v.right, gain)
+	v.pitch = pitch
+	v.mu.Unlock()
+}
+
+func (v *voice) restart() {
+	v.mu.Lock()
+	v.position, v.done = 0, false
+	v.mu.Unlock()
+}
+
+func (v *voice) finished() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.done
+}
+
+// fill writes resampled audio into a device buffer (audio thread).
+func (v *voice) fill(buf []byte) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	clear(buf)
+	clipChannels := v.clip.Format.Channels
+	frames := v.clip.Frames()
+	step := v.rate * v.pitch
+	deviceFrames := len(buf) / (2 * v.channels)
+
+	for i := 0; i < deviceFrames && !v.done; i++ {
+		index := int(v.position)
+		if index >= frames {
+			if !v.loop {
+				v.done = true
+				break
+			}
+			v.position -= float64(frames)
+			index = int(v.position)
+		}
+		next := index + 1
+		if next >= frames {
+			next = 0
+			if !v.loop {
+				next = index
+			}
+		}
+		frac := v.position - float64(index)
+
+		sampleAt := func(frame, ch int) float64 {
+			return float64(v.clip.Samples[frame*clipChannels+min(ch, clipChannels-1)])
+		}
+		for ch := 0; ch < v.channels; ch++ {
+			a, b := sampleAt(index, ch), sampleAt(next, ch)
+			gain := v.left
+			if ch == 1 {
+				gain = v.right
+			}
+			sample := clampSample((a + (b-a)*frac) * gain)
+			offset := (i*v.channels + ch) * 2
+			buf[offset] = byte(uint16(sample))
+			buf[offset+1] = byte(uint16(sample) >> 8)
+		}
+		v.position += step
+	}
+}
+
+// clampSample rounds and clamps to the int16 range.
+func clampSample(x float64) int16 {
+	return int16(math.Max(math.MinInt16, math.Min(math.MaxInt16, math.Round(x))))
+}
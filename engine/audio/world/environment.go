@@ -0,0 +1,214 @@
+package world
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/audio"
+	"github.com/dshills/gogame/engine/core"
+	"github.com/veandco/go-sdl2/mix"
+)
+
+// ReverbZone applies reverb while the listener is inside a trigger volume.
+type ReverbZone struct {
+	Entity   *core.Entity // Zone entity; its collider's world bounds are the volume
+	Params   audio.ReverbParams
+	Priority int // Higher wins where zones overlap
+}
+
+// ReverbZones switches a Reverb between zones as the listener moves.
+//
+// Zones are ordinary trigger entities (a Collider with IsTrigger set), so
+// they can be placed in a level editor alongside gameplay triggers. Outside
+// every zone the Default parameters apply.
+type ReverbZones struct {
+	Reverb   *audio.Reverb
+	Listener *core.Entity // Usually the player or camera target
+	Default  audio.ReverbParams
+
+	// OnEnter is called when the active zone changes (nil = left all zones)
+	OnEnter func(zone *ReverbZone)
+
+	zones   []*ReverbZone
+	current *ReverbZone
+}
+
+// NewReverbZones creates a zone tracker driving reverb.
+//
+// Example:
+//
+//	reverb := audio.NewReverb(44100)
+//	_ = audio.Attach(mix.CHANNEL_POST, reverb)
+//	zones := world.NewReverbZones(reverb, player)
+//	zones.Add(&world.ReverbZone{Entity: caveTrigger, Params: audio.ReverbCave})
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: zones})
+func NewReverbZones(reverb *audio.Reverb, listener *core.Entity) *ReverbZones {
+	return &ReverbZones{
+		Reverb:   reverb,
+		Listener: listener,
+		Default:  audio.ReverbNone,
+		zones:    make([]*ReverbZone, 0),
+	}
+}
+
+// Add registers a zone.
+func (z *ReverbZones) Add(zone *ReverbZone) {
+	z.zones = append(z.zones, zone)
+}
+
+// Remove unregisters a zone.
+func (z *ReverbZones) Remove(zone *ReverbZone) {
+	for i, existing := range z.zones {
+		if existing == zone {
+			z.zones = append(z.zones[:i], z.zones[i+1:]...)
+			break
+		}
+	}
+	if z.current == zone {
+		z.current = nil
+	}
+}
+
+// Current returns the zone the listener is in (nil if none).
+func (z *ReverbZones) Current() *ReverbZone {
+	return z.current
+}
+
+// ZoneAt returns the highest-priority active zone containing a point.
+func (z *ReverbZones) ZoneAt(x, y float64) *ReverbZone {
+	var best *ReverbZone
+	for _, zone := range z.zones {
+		entity := zone.Entity
+		if entity == nil || !entity.Active || entity.Collider == nil {
+			continue
+		}
+		if !entity.GetBounds().Contains(x, y) {
+			continue
+		}
+		if best == nil || zone.Priority > best.Priority {
+			best = zone
+		}
+	}
+	return best
+}
+
+// Update switches reverb when the listener changes zone (implements core.Behavior).
+func (z *ReverbZones) Update(_ *core.Entity, _ float64) {
+	if z.Listener == nil {
+		return
+	}
+	position := z.Listener.WorldTransform().Position
+	zone := z.ZoneAt(position.X, position.Y)
+	if zone == z.current {
+		return
+	}
+	z.current = zone
+	if z.Reverb != nil {
+		if zone != nil {
+			z.Reverb.SetParams(zone.Params)
+		} else {
+			z.Reverb.SetParams(z.Default)
+		}
+	}
+	if z.OnEnter != nil {
+		z.OnEnter(zone)
+	}
+}
+
+// Ducker lowers music while dialogue (or any priority audio) plays.
+//
+// Call Begin when a line starts and End when it finishes; overlapping lines
+// are counted, so music returns only after the last one ends. The level
+// ramps down over Attack seconds and back up over Release seconds.
+type Ducker struct {
+	Level   float64 // Volume multiplier while ducked (e.g. 0.3)
+	Attack  float64 // Seconds to duck
+	Release float64 // Seconds to recover
+	Volume  float64 // Music volume when not ducked (0-1)
+
+	// Apply sets the actual volume (default: mix.VolumeMusic)
+	Apply func(volume float64)
+
+	active  int
+	current float64 // Current multiplier (1 = not ducked)
+	applied float64
+}
+
+// NewDucker creates a ducker driving SDL_mixer's music volume (set Apply to
+// duck music played by audio.AudioManager instead).
+//
+// Example:
+//
+//	ducker := world.NewDucker()
+//	ducker.Apply = func(volume float64) { engine.Audio().MusicVolume = volume }
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: ducker})
+//
+//	ducker.Begin()
+//	voice, _ := line.Play(-1, 0)
+//	mix.ChannelFinished(func(channel int) { // Audio thread: only signal here
+//	    if channel == voice {
+//	        finished <- struct{}{}
+//	    }
+//	})
+//	// ... on the game thread when finished fires: ducker.End()
+func NewDucker() *Ducker {
+	return &Ducker{
+		Level:   0.3,
+		Attack:  0.15,
+		Release: 0.6,
+		Volume:  1,
+		Apply: func(volume float64) {
+			mix.VolumeMusic(int(volume * mix.MAX_VOLUME))
+		},
+		current: 1,
+		applied: -1,
+	}
+}
+
+// Begin starts ducking (counted).
+func (d *Ducker) Begin() {
+	d.active++
+}
+
+// End releases one Begin.
+func (d *Ducker) End() {
+	if d.active > 0 {
+		d.active--
+	}
+}
+
+// Ducked reports whether any dialogue is active.
+func (d *Ducker) Ducked() bool {
+	return d.active > 0
+}
+
+// Multiplier returns the current duck multiplier (1 = full volume).
+func (d *Ducker) Multiplier() float64 {
+	return d.current
+}
+
+// Update ramps the volume (implements core.Behavior).
+func (d *Ducker) Update(_ *core.Entity, dt float64) {
+	target, duration := 1.0, d.Release
+	if d.active > 0 {
+		target, duration = d.Level, d.Attack
+	}
+	if duration <= 0 {
+		d.current = target
+	} else {
+		d.current = moveToward(d.current, target, dt*(1-d.Level)/duration)
+	}
+
+	volume := d.Volume * d.current
+	if volume != d.applied && d.Apply != nil {
+		d.Apply(volume)
+		d.applied = volume
+	}
+}
+
+// moveToward moves value toward target by at most step.
+func moveToward(value, target, step float64) float64 {
+	if value < target {
+		return math.Min(value+step, target)
+	}
+	return math.Max(value-step, target)
+}
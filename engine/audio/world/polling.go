@@ -0,0 +1,26 @@
+package world
+
+import "github.com/dshills/gogame/engine/core"
+
+// Poller is audio state that needs a per-frame check on the game thread,
+// such as audio.Track and audio.StreamPlayer.
+type Poller interface {
+	Poll()
+}
+
+// Polling polls audio players from a scene entity.
+//
+// Example:
+//
+//	track, _ := audio.LoadTrack("music/boss.ogg")
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: world.Polling{track}})
+type Polling []Poller
+
+// Update polls each non-nil player in order (implements core.Behavior).
+func (p Polling) Update(_ *core.Entity, _ float64) {
+	for _, poller := range p {
+		if poller != nil {
+			poller.Poll()
+		}
+	}
+}
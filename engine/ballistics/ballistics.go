@@ -0,0 +1,189 @@
+// Package ballistics provides projectile launch solving, target leading, and arc prediction.
+//
+// Coordinates follow screen conventions: +Y is down, so gravity is usually a
+// positive Y acceleration (e.g. 980 pixels per second squared).
+package ballistics
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// LaunchVelocities solves for the launch velocities that hit a target at a fixed speed.
+//
+// Parameters:
+//
+//	from: Launch position
+//	to: Target position
+//	speed: Launch speed in pixels per second
+//	gravity: Downward acceleration (+Y) in pixels per second squared
+//
+// Returns:
+//
+//	low: Flat trajectory velocity (fastest flight)
+//	high: Lobbed trajectory velocity (mortar style)
+//	ok: False if the target is out of range at this speed
+//
+// Example:
+//
+//	low, high, ok := ballistics.LaunchVelocities(cannon, target, 500, 980)
+//	if ok {
+//	    shell.Velocity = high // Lob over walls
+//	}
+func LaunchVelocities(from, to gamemath.Vector2, speed, gravity float64) (low, high gamemath.Vector2, ok bool) {
+	dx := to.X - from.X
+	dy := from.Y - to.Y // Height gain (up is positive)
+	distance := math.Abs(dx)
+	direction := 1.0
+	if dx < 0 {
+		direction = -1
+	}
+
+	if gravity == 0 {
+		aim := to.Sub(from).Normalize().Scale(speed)
+		return aim, aim, to != from
+	}
+	if distance == 0 {
+		// Straight up or down
+		if dy > 0 && speed*speed < 2*gravity*dy {
+			return low, high, false
+		}
+		vertical := gamemath.Vector2{X: 0, Y: -speed}
+		if dy < 0 {
+			vertical.Y = speed
+		}
+		return vertical, vertical, true
+	}
+
+	speedSq := speed * speed
+	root := speedSq*speedSq - gravity*(gravity*distance*distance+2*dy*speedSq)
+	if root < 0 {
+		return low, high, false
+	}
+	root = math.Sqrt(root)
+
+	lowAngle := math.Atan2(speedSq-root, gravity*distance)
+	highAngle := math.Atan2(speedSq+root, gravity*distance)
+	low = gamemath.Vector2{X: direction * speed * math.Cos(lowAngle), Y: -speed * math.Sin(lowAngle)}
+	high = gamemath.Vector2{X: direction * speed * math.Cos(highAngle), Y: -speed * math.Sin(highAngle)}
+	return low, high, true
+}
+
+// MaxRange returns the farthest horizontal distance reachable on flat ground.
+func MaxRange(speed, gravity float64) float64 {
+	if gravity <= 0 {
+		return math.Inf(1)
+	}
+	return speed * speed / gravity
+}
+
+// InterceptTime returns when a projectile fired now at a fixed speed can meet a moving target.
+//
+// Parameters:
+//
+//	shooter: Firing position
+//	target: Current target position
+//	targetVelocity: Target velocity (assumed constant)
+//	speed: Projectile speed (gravity is ignored)
+//
+// Returns:
+//
+//	float64: Earliest positive intercept time in seconds
+//	bool: False if the projectile can never catch the target
+func InterceptTime(shooter, target, targetVelocity gamemath.Vector2, speed float64) (float64, bool) {
+	offset := target.Sub(shooter)
+	a := targetVelocity.Dot(targetVelocity) - speed*speed
+	b := 2 * offset.Dot(targetVelocity)
+	c := offset.Dot(offset)
+
+	if math.Abs(a) < 1e-9 {
+		// Same speed as the target: linear equation
+		if b >= 0 {
+			return 0, false
+		}
+		return -c / b, true
+	}
+
+	disc := b*b - 4*a*c
+	if disc < 0 {
+		return 0, false
+	}
+	sqrtDisc := math.Sqrt(disc)
+	t1 := (-b - sqrtDisc) / (2 * a)
+	t2 := (-b + sqrtDisc) / (2 * a)
+
+	t := math.Inf(1)
+	if t1 > 0 {
+		t = t1
+	}
+	if t2 > 0 && t2 < t {
+		t = t2
+	}
+	if math.IsInf(t, 1) {
+		return 0, false
+	}
+	return t, true
+}
+
+// LeadTarget returns the point to aim at so a projectile meets a moving target.
+//
+// Example:
+//
+//	if aim, ok := ballistics.LeadTarget(tower, enemy.Transform.Position, enemyVelocity, 300); ok {
+//	    bullet.Velocity = aim.Sub(tower).Normalize().Scale(300)
+//	}
+func LeadTarget(shooter, target, targetVelocity gamemath.Vector2, speed float64) (gamemath.Vector2, bool) {
+	t, ok := InterceptTime(shooter, target, targetVelocity, speed)
+	if !ok {
+		return target, false
+	}
+	return target.Add(targetVelocity.Scale(t)), true
+}
+
+// PositionAt returns a projectile's position after t seconds.
+func PositionAt(from, velocity, gravity gamemath.Vector2, t float64) gamemath.Vector2 {
+	return from.Add(velocity.Scale(t)).Add(gravity.Scale(0.5 * t * t))
+}
+
+// PredictArc samples a gravity-affected trajectory.
+//
+// Parameters:
+//
+//	from: Launch position
+//	velocity: Launch velocity
+//	gravity: Acceleration vector (e.g. {0, 980})
+//	step: Seconds between samples
+//	maxSteps: Maximum number of samples after from (negative = 0)
+//	hit: Optional predicate that stops the arc (e.g. terrain.Solid); nil = never
+//
+// Returns:
+//
+//	[]gamemath.Vector2: Sampled positions starting at from
+//	bool: True if hit stopped the arc (last point is the impact sample)
+func PredictArc(from, velocity, gravity gamemath.Vector2, step float64, maxSteps int, hit func(gamemath.Vector2) bool) ([]gamemath.Vector2, bool) {
+	maxSteps = max(maxSteps, 0)
+	points := make([]gamemath.Vector2, 0, maxSteps+1)
+	points = append(points, from)
+	for i := 1; i <= maxSteps; i++ {
+		point := PositionAt(from, velocity, gravity, float64(i)*step)
+		points = append(points, point)
+		if hit != nil && hit(point) {
+			return points, true
+		}
+	}
+	return points, false
+}
+
+// DrawTrajectory renders a predicted arc as dashed line segments (every other segment drawn).
+func DrawTrajectory(renderer *graphics.Renderer, camera *graphics.Camera, points []gamemath.Vector2, color gamemath.Color) error {
+	for i := 0; i+1 < len(points); i += 2 {
+		x1, y1 := camera.WorldToScreen(points[i].X, points[i].Y)
+		x2, y2 := camera.WorldToScreen(points[i+1].X, points[i+1].Y)
+		if err := renderer.DrawLine(float64(x1), float64(y1), float64(x2), float64(y2), color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Package cards provides card game building blocks: card entities with front/back
+// sprites, deck/hand/discard piles, and a table that fans the hand, zooms the
+// hovered card, and plays cards dragged onto drop zones.
+package cards
+
+import (
+	"math/rand"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Card is a playing card backed by an entity.
+type Card struct {
+	Name   string           // Display or lookup name
+	Data   any              // Game-specific card definition (cost, effects, ...)
+	Front  *graphics.Sprite // Sprite shown face up
+	Back   *graphics.Sprite // Sprite shown face down
+	Entity *core.Entity     // Entity that renders the card
+
+	faceUp bool
+}
+
+// NewCard creates a face-down card with its own entity.
+//
+// Example:
+//
+//	fireball := cards.NewCard("Fireball", fireballDef, fireballSprite, cardBack)
+//	deck.Push(fireball)
+//	scene.AddEntity(fireball.Entity)
+func NewCard(name string, data any, front, back *graphics.Sprite) *Card {
+	c := &Card{
+		Name:  name,
+		Data:  data,
+		Front: front,
+		Back:  back,
+		Entity: &core.Entity{
+			Active:    true,
+			Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}},
+		},
+	}
+	c.SetFaceUp(false)
+	return c
+}
+
+// FaceUp reports whether the card shows its front.
+func (c *Card) FaceUp() bool {
+	return c.faceUp
+}
+
+// SetFaceUp turns the card and swaps the entity's sprite.
+func (c *Card) SetFaceUp(faceUp bool) {
+	c.faceUp = faceUp
+	if faceUp {
+		c.Entity.Sprite = c.Front
+	} else {
+		c.Entity.Sprite = c.Back
+	}
+}
+
+// Flip turns the card over.
+func (c *Card) Flip() {
+	c.SetFaceUp(!c.faceUp)
+}
+
+// Pile is an ordered stack of cards (deck, hand, discard, exile...).
+//
+// Index 0 is the bottom; the last card is the top.
+type Pile struct {
+	Name    string
+	MaxSize int // Maximum number of cards (0 = unlimited)
+
+	cards []*Card
+}
+
+// NewPile creates an empty pile.
+func NewPile(name string) *Pile {
+	return &Pile{
+		Name:  name,
+		cards: make([]*Card, 0),
+	}
+}
+
+// Len returns the number of cards in the pile.
+func (p *Pile) Len() int {
+	return len(p.cards)
+}
+
+// Cards returns the cards from bottom to top. The slice must not be modified.
+func (p *Pile) Cards() []*Card {
+	return p.cards
+}
+
+// Full reports whether the pile has reached MaxSize.
+func (p *Pile) Full() bool {
+	return p.MaxSize > 0 && len(p.cards) >= p.MaxSize
+}
+
+// Top returns the top card without removing it (nil if empty).
+func (p *Pile) Top() *Card {
+	if len(p.cards) == 0 {
+		return nil
+	}
+	return p.cards[len(p.cards)-1]
+}
+
+// Push adds cards to the top. Cards that don't fit under MaxSize are not added.
+//
+// Returns:
+//
+//	int: Number of cards added
+func (p *Pile) Push(cards ...*Card) int {
+	added := 0
+	for _, card := range cards {
+		if p.Full() {
+			break
+		}
+		p.cards = append(p.cards, card)
+		added++
+	}
+	return added
+}
+
+// Insert places a card at an index (clamped to the pile).
+func (p *Pile) Insert(index int, card *Card) bool {
+	if p.Full() {
+		return false
+	}
+	index = max(0, min(index, len(p.cards)))
+	p.cards = append(p.cards, nil)
+	copy(p.cards[index+1:], p.cards[index:])
+	p.cards[index] = card
+	return true
+}
+
+// Draw removes and returns the top card (nil if empty).
+func (p *Pile) Draw() *Card {
+	card := p.Top()
+	if card != nil {
+		p.cards = p.cards[:len(p.cards)-1]
+	}
+	return card
+}
+
+// Remove removes a specific card and reports whether it was in the pile.
+func (p *Pile) Remove(card *Card) bool {
+	i := p.IndexOf(card)
+	if i < 0 {
+		return false
+	}
+	p.cards = append(p.cards[:i], p.cards[i+1:]...)
+	return true
+}
+
+// IndexOf returns a card's index (-1 if absent).
+func (p *Pile) IndexOf(card *Card) int {
+	for i, c := range p.cards {
+		if c == card {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether a card is in the pile.
+func (p *Pile) Contains(card *Card) bool {
+	return p.IndexOf(card) >= 0
+}
+
+// Shuffle randomizes the pile order.
+//
+// Parameters:
+//
+//	rng: Random source (nil = math/rand global source); pass a seeded source for replays
+func (p *Pile) Shuffle(rng *rand.Rand) {
+	swap := func(i, j int) { p.cards[i], p.cards[j] = p.cards[j], p.cards[i] }
+	if rng != nil {
+		rng.Shuffle(len(p.cards), swap)
+	} else {
+		rand.Shuffle(len(p.cards), swap)
+	}
+}
+
+// Clear removes and returns all cards.
+func (p *Pile) Clear() []*Card {
+	cards := p.cards
+	p.cards = make([]*Card, 0)
+	return cards
+}
+
+// Deal moves up to n cards from the top of one pile to another.
+//
+// Returns:
+//
+//	int: Number of cards moved (stops early if from empties or to fills)
+//
+// Example:
+//
+//	cards.Deal(deck, hand, 5)
+func Deal(from, to *Pile, n int) int {
+	moved := 0
+	for moved < n && from.Len() > 0 && !to.Full() {
+		to.Push(from.Draw())
+		moved++
+	}
+	return moved
+}
+
+// Move transfers a specific card between piles (e.g. discarding from hand).
+//
+// Returns:
+//
+//	bool: False if the card isn't in from or to is full
+func Move(card *Card, from, to *Pile) bool {
+	if to.Full() || !from.Remove(card) {
+		return false
+	}
+	to.Push(card)
+	return true
+}
+
+// Reshuffle moves every card from a discard pile into a deck and shuffles it.
+func Reshuffle(discard, deck *Pile, rng *rand.Rand) {
+	for _, card := range discard.Clear() {
+		card.SetFaceUp(false)
+		deck.cards = append(deck.cards, card)
+	}
+	deck.Shuffle(rng)
+}
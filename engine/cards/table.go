@@ -0,0 +1,271 @@
+package cards
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/picking"
+)
+
+// DropZone is a world-space region where hand cards can be played.
+type DropZone struct {
+	Name   string
+	Bounds gamemath.Rectangle    // World rectangle that accepts drops
+	Accept func(card *Card) bool // Optional filter (e.g. mana cost check); nil = accept all
+	Target *Pile                 // Optional pile played cards move to (e.g. board, discard)
+}
+
+// HandLayout fans hand cards around a center point.
+type HandLayout struct {
+	Center   gamemath.Vector2 // World position of the hand's center
+	Spacing  float64          // Distance between card centers
+	MaxWidth float64          // Spacing shrinks so the hand fits (0 = unlimited)
+	FanAngle float64          // Total rotation spread in degrees across the hand
+	Curve    float64          // Pixels the outermost cards drop below the center
+	Scale    float64          // Card scale in the hand
+	Layer    int              // Render layer of the leftmost card (others stack above)
+}
+
+// Slot returns the resting transform of card i in a hand of n cards.
+func (l HandLayout) Slot(i, n int) gamemath.Transform {
+	transform := gamemath.Transform{
+		Position: l.Center,
+		Scale:    gamemath.Vector2{X: l.Scale, Y: l.Scale},
+	}
+	if n <= 1 {
+		return transform
+	}
+
+	spacing := l.Spacing
+	if l.MaxWidth > 0 && spacing*float64(n-1) > l.MaxWidth {
+		spacing = l.MaxWidth / float64(n-1)
+	}
+	offset := float64(i) - float64(n-1)/2 // -half .. +half
+	t := offset / (float64(n-1) / 2)      // -1 .. 1
+
+	transform.Position.X += offset * spacing
+	transform.Position.Y += t * t * l.Curve
+	transform.Rotation = t * l.FanAngle / 2
+	return transform
+}
+
+// Table is a Behavior that lays out a hand, zooms the hovered card, and plays dragged cards.
+//
+// Hand cards ease toward their layout slots each frame. The card under the
+// cursor is raised, scaled by HoverScale, and straightened for readability.
+// Dragging uses a picking.DragController; dropping over an accepting DropZone
+// removes the card from the hand and calls OnPlay, while any other drop lets
+// the card slide back into the hand.
+type Table struct {
+	Hand       *Pile
+	Layout     HandLayout
+	Zones      []*DropZone
+	HoverScale float64 // Scale multiplier for the hovered card
+	HoverLift  float64 // Pixels the hovered card rises above its slot
+	Smoothing  float64 // Layout easing rate per second (0 = snap to slots)
+
+	Input  *input.InputManager
+	Camera *graphics.Camera
+	Drag   *picking.DragController
+
+	// OnPlay is called after a card is dropped on an accepting zone
+	OnPlay func(card *Card, zone *DropZone)
+
+	hovered    *Card
+	dragged    *Card
+	draggables map[*Card]*picking.Draggable
+}
+
+// NewTable creates a table managing a hand.
+//
+// Example:
+//
+//	table := cards.NewTable(engine.Input(), scene.Camera(), hand)
+//	table.Layout.Center = gamemath.Vector2{X: 640, Y: 660}
+//	table.Zones = append(table.Zones, &cards.DropZone{Name: "board", Bounds: boardRect, Target: board})
+//	table.OnPlay = func(card *cards.Card, zone *cards.DropZone) { resolve(card) }
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: table})
+//	for _, card := range drawn {
+//	    table.AddCard(card)
+//	}
+func NewTable(im *input.InputManager, camera *graphics.Camera, hand *Pile) *Table {
+	t := &Table{
+		Hand: hand,
+		Layout: HandLayout{
+			Spacing:  80,
+			MaxWidth: 600,
+			FanAngle: 20,
+			Curve:    20,
+			Scale:    1,
+			Layer:    100,
+		},
+		HoverScale: 1.5,
+		HoverLift:  60,
+		Smoothing:  12,
+		Input:      im,
+		Camera:     camera,
+		Drag:       picking.NewDragController(im, camera),
+		draggables: make(map[*Card]*picking.Draggable),
+	}
+	for _, card := range hand.Cards() {
+		t.register(card)
+	}
+	return t
+}
+
+// AddCard puts a card face up into the hand and makes it draggable.
+//
+// Returns:
+//
+//	bool: False if the hand is full
+func (t *Table) AddCard(card *Card) bool {
+	if t.Hand.Push(card) == 0 {
+		return false
+	}
+	card.SetFaceUp(true)
+	t.register(card)
+	return true
+}
+
+// RemoveCard takes a card out of the hand and stops tracking it.
+func (t *Table) RemoveCard(card *Card) bool {
+	if !t.Hand.Remove(card) {
+		return false
+	}
+	t.unregister(card)
+	return true
+}
+
+// Hovered returns the hand card under the cursor (nil if none).
+func (t *Table) Hovered() *Card {
+	return t.hovered
+}
+
+// Dragged returns the card being dragged (nil if none).
+func (t *Table) Dragged() *Card {
+	return t.dragged
+}
+
+// Update handles hover, dragging, and layout (implements core.Behavior).
+func (t *Table) Update(_ *core.Entity, dt float64) {
+	if t.Input != nil && t.Camera != nil {
+		t.Drag.Update(nil, dt)
+		if t.dragged == nil {
+			mouseX, mouseY := t.Input.MousePosition()
+			t.HoverAt(picking.ScreenToWorld(t.Camera, mouseX, mouseY))
+		}
+	}
+	t.Arrange(dt)
+}
+
+// HoverAt updates the hovered card for a world cursor position.
+//
+// The topmost hand card whose resting slot contains the cursor is chosen, so
+// the zoomed card doesn't block its neighbors.
+func (t *Table) HoverAt(cursor gamemath.Vector2) *Card {
+	t.hovered = nil
+	cards := t.Hand.Cards()
+	for i := len(cards) - 1; i >= 0; i-- {
+		card := cards[i]
+		if card == t.dragged {
+			continue
+		}
+		entity := *card.Entity
+		entity.Transform = t.Layout.Slot(i, len(cards))
+		if picking.HitBounds(&entity).Contains(cursor.X, cursor.Y) {
+			t.hovered = card
+			break
+		}
+	}
+	return t.hovered
+}
+
+// Arrange eases hand cards toward their slots (dt = 0 snaps immediately).
+func (t *Table) Arrange(dt float64) {
+	cards := t.Hand.Cards()
+	blend := 1.0
+	if t.Smoothing > 0 && dt > 0 {
+		blend = 1 - math.Exp(-t.Smoothing*dt)
+	}
+
+	for i, card := range cards {
+		if card == t.dragged {
+			continue
+		}
+		target := t.Layout.Slot(i, len(cards))
+		card.Entity.Layer = t.Layout.Layer + i
+		if card == t.hovered {
+			target.Position.Y -= t.HoverLift
+			target.Scale = target.Scale.Scale(t.HoverScale)
+			target.Rotation = 0
+			card.Entity.Layer = t.Layout.Layer + len(cards)
+		}
+
+		transform := &card.Entity.Transform
+		transform.Position = transform.Position.Add(target.Position.Sub(transform.Position).Scale(blend))
+		transform.Scale = transform.Scale.Add(target.Scale.Sub(transform.Scale).Scale(blend))
+		transform.Rotation += (target.Rotation - transform.Rotation) * blend
+	}
+}
+
+// Drop plays a card at a world position if an accepting zone is under it.
+//
+// Returns:
+//
+//	*DropZone: Zone the card was played into, or nil (card stays in hand)
+func (t *Table) Drop(card *Card, cursor gamemath.Vector2) *DropZone {
+	for _, zone := range t.Zones {
+		if !zone.Bounds.Contains(cursor.X, cursor.Y) {
+			continue
+		}
+		if zone.Accept != nil && !zone.Accept(card) {
+			continue
+		}
+		if zone.Target != nil && zone.Target.Full() {
+			continue
+		}
+		t.RemoveCard(card)
+		if zone.Target != nil {
+			zone.Target.Push(card)
+		}
+		if t.OnPlay != nil {
+			t.OnPlay(card, zone)
+		}
+		return zone
+	}
+	return nil
+}
+
+// register creates the draggable for a hand card.
+func (t *Table) register(card *Card) {
+	draggable := picking.NewDraggable(card.Entity)
+	draggable.OnDragStart = func(entity *core.Entity, _ gamemath.Vector2) {
+		t.dragged = card
+		t.hovered = nil
+		entity.Transform.Rotation = 0
+		entity.Layer = t.Layout.Layer + t.Hand.Len() + 1
+	}
+	draggable.OnDrop = func(_ *core.Entity, cursor gamemath.Vector2) {
+		t.dragged = nil
+		t.Drop(card, cursor)
+	}
+	t.draggables[card] = draggable
+	t.Drag.Add(draggable)
+}
+
+// unregister removes a card's draggable.
+func (t *Table) unregister(card *Card) {
+	if draggable, ok := t.draggables[card]; ok {
+		t.Drag.Remove(draggable)
+		delete(t.draggables, card)
+	}
+	if t.hovered == card {
+		t.hovered = nil
+	}
+	if t.dragged == card {
+		t.dragged = nil
+	}
+}
@@ -0,0 +1,356 @@
+// Package combo provides input buffering and command recognition (motions, double-taps, button sequences) for action games.
+package combo
+
+import (
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/input"
+)
+
+// Direction is a stick direction in numpad notation, relative to facing.
+//
+//	7 8 9
+//	4 5 6   (6 = forward, 4 = back, 5 = neutral)
+//	1 2 3
+type Direction int
+
+// Numpad directions.
+const (
+	AnyDirection Direction = 0
+	DownBack     Direction = 1
+	Down         Direction = 2
+	DownForward  Direction = 3
+	Back         Direction = 4
+	Neutral      Direction = 5
+	Forward      Direction = 6
+	UpBack       Direction = 7
+	Up           Direction = 8
+	UpForward    Direction = 9
+)
+
+// DirectionFromAxes converts axis values (-1, 0, 1; y up positive) to a numpad direction.
+func DirectionFromAxes(x, y int) Direction {
+	return Direction(5 + clampAxis(x) + 3*clampAxis(y))
+}
+
+func clampAxis(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
+
+// Frame is one sampled input frame.
+type Frame struct {
+	Index     int            // Monotonic frame number
+	Direction Direction      // Direction held this frame
+	Pressed   []input.Action // Buttons pressed (edge) this frame
+
+	consumedAt int // Newest frame index when a command used this input (-1 = unused)
+}
+
+// hasPressed reports whether a button was pressed on this frame.
+func (f Frame) hasPressed(button input.Action) bool {
+	for _, pressed := range f.Pressed {
+		if pressed == button {
+			return true
+		}
+	}
+	return false
+}
+
+// Step is one element of a command.
+type Step struct {
+	Direction Direction    // Required direction (AnyDirection = don't care)
+	Button    input.Action // Required button press (ActionNone = none)
+	MaxGap    int          // Max frames after the previous step (0 = no limit besides Window)
+}
+
+// matches reports whether a frame satisfies the step.
+func (s Step) matches(f Frame) bool {
+	if s.Direction != AnyDirection && f.Direction != s.Direction {
+		return false
+	}
+	if s.Button != input.ActionNone && !f.hasPressed(s.Button) {
+		return false
+	}
+	return true
+}
+
+// Command is a named input sequence such as a quarter-circle or double-tap.
+type Command struct {
+	Name     string
+	Steps    []Step
+	Window   int // Max frames from first to last step (0 = buffer length)
+	Priority int // Higher priority commands are checked first
+}
+
+// QuarterCircleForward returns the 2, 3, 6 + button motion.
+func QuarterCircleForward(name string, button input.Action) *Command {
+	return &Command{
+		Name: name,
+		Steps: []Step{
+			{Direction: Down},
+			{Direction: DownForward, MaxGap: 8},
+			{Direction: Forward, Button: button, MaxGap: 8},
+		},
+		Window:   20,
+		Priority: 2,
+	}
+}
+
+// DoubleTap returns a tap, release, tap motion on a direction (e.g. a dash).
+func DoubleTap(name string, direction Direction) *Command {
+	return &Command{
+		Name: name,
+		Steps: []Step{
+			{Direction: direction},
+			{Direction: Neutral, MaxGap: 8},
+			{Direction: direction, MaxGap: 8},
+		},
+		Window:   12,
+		Priority: 1,
+	}
+}
+
+// Buffer records recent input frames and recognizes commands.
+//
+// Buffer implements core.Behavior: each Update samples the InputManager once,
+// so commands are measured in frames (typically 60 Hz).
+type Buffer struct {
+	Input      *input.InputManager // Source for Update (nil = use Push manually)
+	Buttons    []input.Action      // Buttons to record
+	Length     int                 // Frames kept in history (default 30)
+	Leniency   int                 // Frames a final input stays buffered (default 8)
+	FacingLeft bool                // Mirror left/right so Forward follows facing
+	Commands   []*Command          // Commands checked by Update
+
+	// OnCommand is called from Update when a registered command is recognized.
+	OnCommand func(cmd *Command)
+
+	// Directional actions read from Input
+	UpAction, DownAction, LeftAction, RightAction input.Action
+
+	frames    []Frame
+	nextIndex int
+	consumed  int // Frames at or before this index can't complete commands
+}
+
+// NewBuffer creates an input buffer reading movement actions and the given buttons.
+//
+// Example:
+//
+//	buffer := combo.NewBuffer(inputManager, input.ActionAttack)
+//	buffer.Commands = []*combo.Command{
+//	    combo.QuarterCircleForward("fireball", input.ActionAttack),
+//	    combo.DoubleTap("dash", combo.Forward),
+//	}
+//	buffer.OnCommand = func(cmd *combo.Command) { player.Perform(cmd.Name) }
+//	player.Behavior = core.Behaviors{buffer, controller}
+func NewBuffer(im *input.InputManager, buttons ...input.Action) *Buffer {
+	return &Buffer{
+		Input:       im,
+		Buttons:     buttons,
+		Length:      30,
+		Leniency:    8,
+		UpAction:    input.ActionMoveUp,
+		DownAction:  input.ActionMoveDown,
+		LeftAction:  input.ActionMoveLeft,
+		RightAction: input.ActionMoveRight,
+		frames:      make([]Frame, 0, 30),
+		consumed:    -1,
+	}
+}
+
+// Push records a frame of input directly (for replays, AI, or network input).
+//
+// Directions are absolute (6 = right) and are mirrored when FacingLeft is set.
+func (b *Buffer) Push(direction Direction, pressed ...input.Action) {
+	if b.FacingLeft {
+		direction = mirror(direction)
+	}
+	b.frames = append(b.frames, Frame{
+		Index:      b.nextIndex,
+		Direction:  direction,
+		Pressed:    pressed,
+		consumedAt: -1,
+	})
+	b.nextIndex++
+
+	if length := b.length(); len(b.frames) > length {
+		b.frames = append(b.frames[:0], b.frames[len(b.frames)-length:]...)
+	}
+}
+
+// Sample records the current InputManager state as one frame.
+func (b *Buffer) Sample() {
+	if b.Input == nil {
+		return
+	}
+
+	x, y := 0, 0
+	if b.Input.ActionHeld(b.LeftAction) {
+		x--
+	}
+	if b.Input.ActionHeld(b.RightAction) {
+		x++
+	}
+	if b.Input.ActionHeld(b.UpAction) {
+		y++
+	}
+	if b.Input.ActionHeld(b.DownAction) {
+		y--
+	}
+
+	var pressed []input.Action
+	for _, button := range b.Buttons {
+		if b.Input.ActionPressed(button) {
+			pressed = append(pressed, button)
+		}
+	}
+	b.Push(DirectionFromAxes(x, y), pressed...)
+}
+
+// Update samples input and fires OnCommand for the highest-priority match.
+func (b *Buffer) Update(_ *core.Entity, _ float64) {
+	b.Sample()
+	if cmd := b.Detect(); cmd != nil && b.OnCommand != nil {
+		b.OnCommand(cmd)
+	}
+}
+
+// Frames returns the recorded history, oldest first.
+func (b *Buffer) Frames() []Frame {
+	return b.frames
+}
+
+// Check reports whether a command is in the buffer without consuming it.
+func (b *Buffer) Check(cmd *Command) bool {
+	_, ok := b.match(cmd)
+	return ok
+}
+
+// Consume reports whether a command is in the buffer and, if so, consumes its inputs
+// so it doesn't trigger again. Consumed inputs can't be part of any other command
+// either, so a press that finishes one combo doesn't also start or continue another.
+func (b *Buffer) Consume(cmd *Command) bool {
+	used, ok := b.match(cmd)
+	if !ok {
+		return false
+	}
+	newest := b.frames[len(b.frames)-1].Index
+	for _, pos := range used {
+		b.frames[pos].consumedAt = newest
+	}
+	b.consumed = b.frames[used[len(used)-1]].Index
+	return true
+}
+
+// Detect consumes and returns the highest-priority registered command, or nil.
+func (b *Buffer) Detect() *Command {
+	commands := make([]*Command, len(b.Commands))
+	copy(commands, b.Commands)
+	sort.SliceStable(commands, func(i, j int) bool {
+		return commands[i].Priority > commands[j].Priority
+	})
+
+	for _, cmd := range commands {
+		if b.Consume(cmd) {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// Clear empties the buffer.
+func (b *Buffer) Clear() {
+	b.frames = b.frames[:0]
+	b.consumed = b.nextIndex - 1
+}
+
+// match searches backwards for the command's steps, returning the
+// positions in frames matched by each step.
+//
+// The last step must occur within Leniency frames of the newest frame and
+// after the last consumed frame. Earlier steps are matched in reverse order,
+// each within its MaxGap, with the whole sequence inside Window. Frames
+// consumed by another command are skipped.
+func (b *Buffer) match(cmd *Command) ([]int, bool) {
+	if len(cmd.Steps) == 0 || len(b.frames) == 0 {
+		return nil, false
+	}
+
+	newest := b.frames[len(b.frames)-1].Index
+	last := cmd.Steps[len(cmd.Steps)-1]
+	used := make([]int, len(cmd.Steps))
+
+	for i := len(b.frames) - 1; i >= 0; i-- {
+		frame := b.frames[i]
+		if newest-frame.Index > b.Leniency || frame.Index <= b.consumed {
+			break
+		}
+		if frame.consumedAt >= 0 || !last.matches(frame) {
+			continue
+		}
+		used[len(used)-1] = i
+		if b.matchBefore(cmd, len(cmd.Steps)-2, i, frame.Index, used) {
+			return used, true
+		}
+	}
+	return nil, false
+}
+
+// matchBefore matches steps[step] and earlier, searching frames before pos
+// and recording matched positions in used.
+func (b *Buffer) matchBefore(cmd *Command, step, pos, endIndex int, used []int) bool {
+	if step < 0 {
+		return true
+	}
+
+	window := cmd.Window
+	if window <= 0 {
+		window = b.length()
+	}
+	next := cmd.Steps[step+1]
+	current := cmd.Steps[step]
+	nextIndex := b.frames[pos].Index
+
+	for i := pos - 1; i >= 0; i-- {
+		frame := b.frames[i]
+		if endIndex-frame.Index > window {
+			return false
+		}
+		if next.MaxGap > 0 && nextIndex-frame.Index > next.MaxGap {
+			return false
+		}
+		if frame.consumedAt >= 0 || !current.matches(frame) {
+			continue
+		}
+		used[step] = i
+		if b.matchBefore(cmd, step-1, i, endIndex, used) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Buffer) length() int {
+	if b.Length <= 0 {
+		return 30
+	}
+	return b.Length
+}
+
+// mirror swaps left and right in a numpad direction.
+func mirror(direction Direction) Direction {
+	if direction == AnyDirection {
+		return direction
+	}
+	row := (int(direction) - 1) / 3
+	col := (int(direction) - 1) % 3
+	return Direction(row*3 + (2 - col) + 1)
+}
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"reflect"
+	"sort"
+)
+
+// BlackboardCallback is called after a blackboard value changes.
+// Parameters:
+//   - key: The changed key
+//   - old: Previous value (nil if the key was unset)
+//   - value: New value (nil if the key was deleted)
+type BlackboardCallback func(key string, old, value any)
+
+// Blackboard is a key-value store for state shared between behaviors.
+//
+// Every Scene has one for level state (switches, collected keys, wave
+// number) and the Engine has a global one for state that outlives scenes
+// (score, settings, unlocked levels). Watchers are notified when a value
+// actually changes, so HUDs and scripts can react without polling.
+//
+// Values are read back with typed accessors that fall back to a default,
+// so a missing or mistyped key never panics.
+type Blackboard struct {
+	values   map[string]any
+	watchers []blackboardWatcher
+	nextID   int
+}
+
+// blackboardWatcher is a registered callback ("" key = every key).
+type blackboardWatcher struct {
+	id       int
+	key      string
+	callback BlackboardCallback
+}
+
+// NewBlackboard creates an empty blackboard.
+func NewBlackboard() *Blackboard {
+	return &Blackboard{values: make(map[string]any)}
+}
+
+// Set stores a value and notifies watchers if it changed.
+//
+// Example:
+//
+//	scene.Blackboard().Set("door_open", true)
+//	engine.Blackboard().Set("score", engine.Blackboard().Int("score", 0)+100)
+func (b *Blackboard) Set(key string, value any) {
+	old, existed := b.values[key]
+	b.values[key] = value
+	if !existed || !sameValue(old, value) {
+		b.notify(key, old, value)
+	}
+}
+
+// Get returns a value and whether the key is set.
+func (b *Blackboard) Get(key string) (any, bool) {
+	value, ok := b.values[key]
+	return value, ok
+}
+
+// Has reports whether a key is set.
+func (b *Blackboard) Has(key string) bool {
+	_, ok := b.values[key]
+	return ok
+}
+
+// Delete removes a key, notifying watchers with a nil value.
+func (b *Blackboard) Delete(key string) {
+	old, ok := b.values[key]
+	if !ok {
+		return
+	}
+	delete(b.values, key)
+	b.notify(key, old, nil)
+}
+
+// Clear removes every key (watchers are notified per key, in key order).
+func (b *Blackboard) Clear() {
+	for _, key := range b.Keys() {
+		b.Delete(key)
+	}
+}
+
+// Keys returns the set keys in sorted order.
+func (b *Blackboard) Keys() []string {
+	keys := make([]string, 0, len(b.values))
+	for key := range b.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Int returns an integer value, or def if the key is unset or not an int.
+func (b *Blackboard) Int(key string, def int) int {
+	if value, ok := b.values[key].(int); ok {
+		return value
+	}
+	return def
+}
+
+// Float returns a numeric value (float64 or int), or def.
+func (b *Blackboard) Float(key string, def float64) float64 {
+	switch value := b.values[key].(type) {
+	case float64:
+		return value
+	case int:
+		return float64(value)
+	}
+	return def
+}
+
+// String returns a string value, or def.
+func (b *Blackboard) String(key string, def string) string {
+	if value, ok := b.values[key].(string); ok {
+		return value
+	}
+	return def
+}
+
+// Bool returns a boolean value, or def.
+func (b *Blackboard) Bool(key string, def bool) bool {
+	if value, ok := b.values[key].(bool); ok {
+		return value
+	}
+	return def
+}
+
+// AddInt adds delta to an integer value (unset counts as 0) and returns the result.
+func (b *Blackboard) AddInt(key string, delta int) int {
+	value := b.Int(key, 0) + delta
+	b.Set(key, value)
+	return value
+}
+
+// Watch registers a callback for changes to one key ("" = every key).
+//
+// Returns:
+//
+//	func(): Call to stop watching
+//
+// Example:
+//
+//	stop := engine.Blackboard().Watch("score", func(_ string, _, value any) {
+//	    scoreLabel = fmt.Sprintf("Score: %d", value)
+//	})
+//	defer stop()
+func (b *Blackboard) Watch(key string, callback BlackboardCallback) func() {
+	b.nextID++
+	id := b.nextID
+	b.watchers = append(b.watchers, blackboardWatcher{id: id, key: key, callback: callback})
+	return func() {
+		for i, watcher := range b.watchers {
+			if watcher.id == id {
+				b.watchers = append(b.watchers[:i:i], b.watchers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notify calls matching watchers; watchers added or removed during
+// notification take effect on the next change.
+func (b *Blackboard) notify(key string, old, value any) {
+	watchers := b.watchers
+	for _, watcher := range watchers {
+		if watcher.key == "" || watcher.key == key {
+			watcher.callback(key, old, value)
+		}
+	}
+}
+
+// sameValue compares values without panicking on uncomparable types
+// (slices and maps always count as changed).
+func sameValue(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+	return a == b
+}
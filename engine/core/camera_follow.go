@@ -0,0 +1,39 @@
+package core
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// CameraFollow is a behavior that moves a camera toward its entity during
+// the late update phase, after movement and collision response.
+//
+// Example:
+//
+//	player.Behavior = core.Behaviors{
+//	    &PlayerController{Speed: 200},
+//	    &core.CameraFollow{Camera: scene.Camera(), SmoothTime: 0.15},
+//	}
+type CameraFollow struct {
+	Camera     *graphics.Camera // Camera to move (nil = no-op)
+	SmoothTime float64          // Time constant in seconds (0 = locked to target)
+	Offset     gamemath.Vector2 // Added to the entity position (look-ahead, framing)
+	Deadzone   gamemath.Vector2 // Window the target moves in without scrolling (zero = always follow)
+}
+
+// Update does nothing; the camera moves in LateUpdate.
+func (f *CameraFollow) Update(entity *Entity, dt float64) {}
+
+// LateUpdate moves the camera toward the entity's world position plus
+// Offset, or only as far as needed to keep it inside Deadzone.
+func (f *CameraFollow) LateUpdate(entity *Entity, dt float64) {
+	if f.Camera == nil {
+		return
+	}
+	target := entity.WorldTransform().Position.Add(f.Offset)
+	if f.Deadzone != (gamemath.Vector2{}) {
+		f.Camera.FollowDeadzone(target.X, target.Y, f.Deadzone, f.SmoothTime, dt)
+		return
+	}
+	f.Camera.Follow(target.X, target.Y, f.SmoothTime, dt)
+}
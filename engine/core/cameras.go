@@ -0,0 +1,127 @@
+package core
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// AddCamera adds a camera that renders the scene after the main camera
+//
+// Parameters:
+//
+//	camera: Camera with a Viewport (an unset viewport covers the window)
+//
+// Behavior:
+//   - Each camera draws every visible entity once, into its own viewport,
+//     in the order added; later cameras draw on top (e.g. a minimap)
+//   - The camera takes the main camera's window size, and all cameras are
+//     resized by Engine.SetScene and on window resize
+//   - Update advances every camera's shake and bounds
+//
+// Example:
+//
+//	// Two-player split-screen
+//	scene.Camera().Viewport = gamemath.Rectangle{Width: 0.5, Height: 1}
+//	second := graphics.NewCamera()
+//	second.Viewport = gamemath.Rectangle{X: 0.5, Width: 0.5, Height: 1}
+//	scene.AddCamera(second)
+//
+//	// Minimap in the top-right corner, without the HUD
+//	minimap := graphics.NewCamera()
+//	minimap.Viewport = gamemath.Rectangle{X: 0.75, Y: 0.05, Width: 0.2, Height: 0.2}
+//	minimap.Zoom = 0.1
+//	minimap.HiddenLayers = []int{hudLayer}
+//	scene.AddCamera(minimap)
+func (s *Scene) AddCamera(camera *graphics.Camera) {
+	if camera == nil || camera == s.camera {
+		return
+	}
+	for _, existing := range s.cameras {
+		if existing == camera {
+			return
+		}
+	}
+	if s.camera != nil {
+		camera.SetScreenSize(s.camera.WindowSize())
+	}
+	s.cameras = append(s.cameras, camera)
+}
+
+// RemoveCamera removes a camera added with AddCamera (the main camera stays).
+func (s *Scene) RemoveCamera(camera *graphics.Camera) {
+	for i, existing := range s.cameras {
+		if existing == camera {
+			s.cameras = append(s.cameras[:i:i], s.cameras[i+1:]...)
+			return
+		}
+	}
+}
+
+// Cameras returns the main camera followed by cameras added with AddCamera.
+func (s *Scene) Cameras() []*graphics.Camera {
+	cameras := make([]*graphics.Camera, 0, len(s.cameras)+1)
+	if s.camera != nil {
+		cameras = append(cameras, s.camera)
+	}
+	return append(cameras, s.cameras...)
+}
+
+// CameraAt returns the topmost camera whose viewport contains a window
+// point, e.g. to find which player's view was clicked.
+//
+// Returns:
+//
+//	*graphics.Camera: Camera under the point (nil if none)
+//
+// Example:
+//
+//	mouseX, mouseY := input.MousePosition()
+//	if camera := scene.CameraAt(mouseX, mouseY); camera != nil {
+//	    x, y, _ := camera.WindowToViewport(mouseX, mouseY)
+//	    worldX, worldY := camera.ScreenToWorld(x, y)
+//	}
+func (s *Scene) CameraAt(windowX, windowY int32) *graphics.Camera {
+	cameras := s.Cameras()
+	for i := len(cameras) - 1; i >= 0; i-- {
+		if _, _, ok := cameras[i].WindowToViewport(windowX, windowY); ok {
+			return cameras[i]
+		}
+	}
+	return nil
+}
+
+// forEachView calls draw once per camera with drawing confined to the
+// camera's viewport. A lone full-window camera draws without viewport calls.
+func (s *Scene) forEachView(renderer *graphics.Renderer, draw func(camera *graphics.Camera) error) error {
+	if len(s.cameras) == 0 && s.camera.Viewport == (gamemath.Rectangle{}) {
+		return draw(s.camera)
+	}
+	for _, camera := range s.Cameras() {
+		if err := renderer.SetViewport(camera.ViewportRect()); err != nil {
+			return err
+		}
+		if err := draw(camera); err != nil {
+			_ = renderer.SetViewport(gamemath.Rectangle{}) // Best effort restore
+			return err
+		}
+	}
+	return renderer.SetViewport(gamemath.Rectangle{})
+}
+
+// setScreenSize sizes every camera to the window.
+func (s *Scene) setScreenSize(width, height int) {
+	for _, camera := range s.Cameras() {
+		camera.SetScreenSize(width, height)
+	}
+}
+
+// cameraDistance returns the distance from a world point to the nearest camera.
+func (s *Scene) cameraDistance(point gamemath.Vector2) float64 {
+	nearest := math.Inf(1)
+	for _, camera := range s.Cameras() {
+		nearest = math.Min(nearest, point.Distance(camera.Position))
+	}
+	return nearest
+}
@@ -0,0 +1,224 @@
+package core
+
+import (
+	"reflect"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// AddComponent attaches a component to the entity
+//
+// Parameters:
+//
+//	component: Any value, usually a pointer to game data or logic
+//
+// Behavior:
+//   - *graphics.Sprite, *graphics.TextSprite, *physics.Collider, and
+//     *physics.RigidBody are built-in components: they are stored in the
+//     Sprite, Text, Collider, and Body fields (replacing what was there)
+//   - A component implementing Behavior is appended to the entity's
+//     behaviors and updated after those added before it (Behavior becomes
+//     a Behaviors list once there are several)
+//   - Anything else is stored for GetComponent; the scene doesn't
+//     otherwise touch it
+//   - Behaviors and custom components are saved by Scene.Save only if
+//     their types are registered (see RegisterBehavior)
+//
+// Example:
+//
+//	type Health struct{ HP, Max int }
+//
+//	enemy.AddComponent(&Health{HP: 30, Max: 30})
+//	enemy.AddComponent(&Patrol{Speed: 40})    // Behavior: updated first
+//	enemy.AddComponent(&FlashOnDamage{})      // Behavior: updated second
+//
+//	if health, ok := core.GetComponent[*Health](enemy); ok {
+//	    health.HP -= 10
+//	}
+func (e *Entity) AddComponent(component any) {
+	switch c := component.(type) {
+	case nil:
+		return
+	case *graphics.Sprite:
+		e.Sprite = c
+	case *graphics.TextSprite:
+		e.Text = c
+	case *physics.Collider:
+		e.Collider = c
+	case *physics.RigidBody:
+		e.Body = c
+	case Behavior:
+		switch existing := e.Behavior.(type) {
+		case nil:
+			e.Behavior = c
+		case Behaviors:
+			e.Behavior = append(existing, c)
+		default:
+			e.Behavior = Behaviors{existing, c}
+		}
+	default:
+		e.components = append(e.components, component)
+	}
+}
+
+// RemoveComponent detaches a component added with AddComponent (or set
+// through a built-in field).
+//
+// Returns:
+//
+//	bool: True if the component was attached
+func (e *Entity) RemoveComponent(component any) bool {
+	switch c := component.(type) {
+	case nil:
+		return false
+	case *graphics.Sprite:
+		return clearField(&e.Sprite, c)
+	case *graphics.TextSprite:
+		return clearField(&e.Text, c)
+	case *physics.Collider:
+		return clearField(&e.Collider, c)
+	case *physics.RigidBody:
+		return clearField(&e.Body, c)
+	case Behavior:
+		if sameComponent(e.Behavior, c) {
+			e.Behavior = nil
+			return true
+		}
+		if behaviors, ok := e.Behavior.(Behaviors); ok {
+			for i, behavior := range behaviors {
+				if sameComponent(behavior, c) {
+					e.Behavior = append(behaviors[:i:i], behaviors[i+1:]...)
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for i, existing := range e.components {
+		if sameComponent(existing, component) {
+			e.components = append(e.components[:i:i], e.components[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Components returns every component attached to the entity: the non-nil
+// built-in fields, then behaviors in update order, then custom components.
+func (e *Entity) Components() []any {
+	components := make([]any, 0, 4+len(e.components))
+	if e.Sprite != nil {
+		components = append(components, e.Sprite)
+	}
+	if e.Text != nil {
+		components = append(components, e.Text)
+	}
+	if e.Collider != nil {
+		components = append(components, e.Collider)
+	}
+	if e.Body != nil {
+		components = append(components, e.Body)
+	}
+	components = appendBehaviors(components, e.Behavior)
+	return append(components, e.components...)
+}
+
+// GetComponent returns the first component of type T attached to an entity
+//
+// Parameters:
+//
+//	entity: Entity to search
+//
+// Returns:
+//
+//	T: First matching component in Components order
+//	bool: False if the entity has none
+//
+// Behavior:
+//   - T may be a concrete type (*Health) or an interface (MessageHandler);
+//     built-in fields and behaviors are searched as well
+//
+// Example:
+//
+//	if body, ok := core.GetComponent[*physics.RigidBody](entity); ok {
+//	    body.ApplyImpulse(knockback)
+//	}
+func GetComponent[T any](entity *Entity) (T, bool) {
+	for _, component := range entity.Components() {
+		if match, ok := component.(T); ok {
+			return match, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// GetComponents returns every component of type T attached to an entity,
+// in Components order.
+func GetComponents[T any](entity *Entity) []T {
+	matches := make([]T, 0)
+	for _, component := range entity.Components() {
+		if match, ok := component.(T); ok {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// HasComponent reports whether an entity has a component of type T.
+func HasComponent[T any](entity *Entity) bool {
+	_, ok := GetComponent[T](entity)
+	return ok
+}
+
+// EntitiesWith returns the scene's entities that have a component of type
+// T, in insertion order.
+//
+// Example:
+//
+//	for _, entity := range core.EntitiesWith[*Health](scene) {
+//	    health, _ := core.GetComponent[*Health](entity)
+//	    health.HP = min(health.Max, health.HP+1) // Regenerate
+//	}
+func EntitiesWith[T any](scene *Scene) []*Entity {
+	found := make([]*Entity, 0)
+	for _, entity := range scene.entities {
+		if HasComponent[T](entity) {
+			found = append(found, entity)
+		}
+	}
+	return found
+}
+
+// appendBehaviors appends a behavior, expanding nested Behaviors lists.
+func appendBehaviors(components []any, behavior Behavior) []any {
+	switch b := behavior.(type) {
+	case nil:
+		return components
+	case Behaviors:
+		for _, child := range b {
+			components = appendBehaviors(components, child)
+		}
+		return components
+	}
+	return append(components, behavior)
+}
+
+// clearField nils a built-in component field if it holds component.
+func clearField[T comparable](field *T, component T) bool {
+	var zero T
+	if *field == zero || *field != component {
+		return false
+	}
+	*field = zero
+	return true
+}
+
+// sameComponent compares components without panicking on uncomparable types.
+func sameComponent(a, b any) bool {
+	if a == nil || b == nil || reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+	return a == b
+}
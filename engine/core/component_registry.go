@@ -0,0 +1,238 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ErrComponentField is returned by SetComponentField for unknown fields and
+// values that don't fit them.
+var ErrComponentField = errors.New("invalid component field")
+
+// ComponentField describes a persisted field of a component struct.
+type ComponentField struct {
+	Name  string       // Serialized name: the json tag, else the Go field name
+	Type  reflect.Type // Go type of the field
+	index []int        // Field path for reflect.Value.FieldByIndex
+}
+
+// RegisterBehavior registers a behavior struct type by name in the default
+// registry, so scene files, prefabs, entity definitions, and tools can
+// create and persist it
+//
+// Parameters:
+//
+//	name: Type name written to files (keep it stable once levels use it)
+//
+// Behavior:
+//   - *T must implement Behavior; components are created as new(T) with
+//     params decoded into its fields
+//   - Exported fields are persisted under their json tag names; tag a field
+//     `json:"-"` to keep it out, and unexported fields, funcs, and channels
+//     are never persisted (rebuild them in Update on first use)
+//   - Scene.Save and NewPrefab write the behavior with its field values,
+//     and LoadScene and Prefab.Instantiate recreate it
+//
+// Example:
+//
+//	type EnemyPatrol struct {
+//	    Speed float64 `json:"speed"`
+//	    Range float64 `json:"range"`
+//	    start *gamemath.Vector2
+//	}
+//
+//	func (p *EnemyPatrol) Update(entity *core.Entity, dt float64) { ... }
+//
+//	func init() {
+//	    core.RegisterBehavior[EnemyPatrol]("EnemyPatrol")
+//	}
+func RegisterBehavior[T any, PT interface {
+	*T
+	Behavior
+}](name string) {
+	RegisterComponentType[T](defaultComponents, name)
+}
+
+// RegisterComponentType registers a struct type by name, for behaviors and
+// data components alike (see RegisterBehavior for how fields persist).
+//
+// Example:
+//
+//	type Health struct {
+//	    HP  int `json:"hp"`
+//	    Max int `json:"max"`
+//	}
+//
+//	core.RegisterComponentType[Health](core.DefaultComponentRegistry(), "Health")
+func RegisterComponentType[T any](registry *ComponentRegistry, name string) {
+	registry.Register(name, func(params ComponentParams) (any, error) {
+		component := new(T)
+		if err := params.Decode(component); err != nil {
+			return nil, err
+		}
+		return component, nil
+	})
+	registry.types[reflect.TypeFor[*T]()] = name
+}
+
+// NameOf returns the registered name of a component's type.
+//
+// Returns:
+//
+//	string: Name given to RegisterComponentType or RegisterBehavior
+//	bool: False for unregistered types and factory-only registrations
+func (r *ComponentRegistry) NameOf(component any) (string, bool) {
+	name, ok := r.types[reflect.TypeOf(component)]
+	return name, ok
+}
+
+// Encode converts a registered component to its persisted form
+//
+// Returns:
+//
+//	ComponentDefinition: Type name and field values; Build recreates the
+//	                     component from it
+//	error: ErrUnknownComponent if the component's type isn't registered
+func (r *ComponentRegistry) Encode(component any) (ComponentDefinition, error) {
+	name, ok := r.NameOf(component)
+	if !ok {
+		return ComponentDefinition{}, fmt.Errorf("%w: %T", ErrUnknownComponent, component)
+	}
+	value := reflect.ValueOf(component).Elem()
+	params := make(ComponentParams)
+	for _, field := range ComponentFields(component) {
+		encoded, err := json.Marshal(value.FieldByIndex(field.index).Interface())
+		if err != nil {
+			continue // Values JSON can't hold are code, like callbacks
+		}
+		var generic any
+		if err := json.Unmarshal(encoded, &generic); err != nil {
+			continue
+		}
+		params[field.Name] = generic
+	}
+	if len(params) == 0 {
+		params = nil
+	}
+	return ComponentDefinition{Type: name, Params: params}, nil
+}
+
+// ComponentFields lists a component's persisted fields, in declaration
+// order, for inspectors and scripting.
+//
+// Parameters:
+//
+//	component: Pointer to a struct (any other value has no fields)
+//
+// Behavior:
+//   - Follows encoding/json naming: json tags, fields of untagged embedded
+//     structs inline, `json:"-"` and unexported fields skipped
+//   - Func and channel fields are skipped
+//
+// Example:
+//
+//	for _, component := range entity.Components() {
+//	    for _, field := range core.ComponentFields(component) {
+//	        value, _ := core.ComponentFieldValue(component, field.Name)
+//	        panel.AddRow(field.Name, fmt.Sprint(value))
+//	    }
+//	}
+func ComponentFields(component any) []ComponentField {
+	value := reflect.ValueOf(component)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	return structFields(value.Elem().Type(), nil)
+}
+
+// ComponentFieldValue returns a persisted field's value by serialized name.
+func ComponentFieldValue(component any, name string) (any, bool) {
+	field, err := componentField(component, name)
+	if err != nil {
+		return nil, false
+	}
+	return field.Interface(), true
+}
+
+// SetComponentField sets a persisted field by serialized name
+//
+// Parameters:
+//
+//	component: Pointer to a struct
+//	name: Serialized field name (see ComponentFields)
+//	value: New value; numbers convert between numeric types (3.0 sets an
+//	       int field to 3) and nil sets the zero value
+//
+// Returns:
+//
+//	error: ErrComponentField for unknown fields or values of another type
+//
+// Example:
+//
+//	// Console command: set <entity> EnemyPatrol speed 120
+//	if err := core.SetComponentField(patrol, "speed", 120.0); err != nil {
+//	    console.Print(err.Error())
+//	}
+func SetComponentField(component any, name string, value any) error {
+	field, err := componentField(component, name)
+	if err != nil {
+		return err
+	}
+	newValue := reflect.ValueOf(value)
+	switch {
+	case !newValue.IsValid():
+		field.SetZero()
+	case newValue.Type().AssignableTo(field.Type()):
+		field.Set(newValue)
+	case isNumberKind(newValue.Kind()) && isNumberKind(field.Kind()):
+		field.Set(newValue.Convert(field.Type()))
+	default:
+		return fmt.Errorf("%w: %s is %s, not %T", ErrComponentField, name, field.Type(), value)
+	}
+	return nil
+}
+
+// componentField finds a settable field by serialized name.
+func componentField(component any, name string) (reflect.Value, error) {
+	for _, field := range ComponentFields(component) {
+		if field.Name == name {
+			return reflect.ValueOf(component).Elem().FieldByIndex(field.index), nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("%w: %T has no field %q", ErrComponentField, component, name)
+}
+
+// structFields lists a struct's persisted fields, inlining untagged
+// embedded structs.
+func structFields(structType reflect.Type, index []int) []ComponentField {
+	var fields []ComponentField
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		path := append(slices.Clone(index), i)
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			fields = append(fields, structFields(field.Type, path)...)
+			continue
+		}
+		if !field.IsExported() || field.Type.Kind() == reflect.Func || field.Type.Kind() == reflect.Chan {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, ComponentField{Name: name, Type: field.Type, index: path})
+	}
+	return fields
+}
+
+// isNumberKind reports whether a kind is an integer or float.
+func isNumberKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64
+}
@@ -0,0 +1,240 @@
+package core
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// maxDebugRays bounds the raycasts remembered per step for debug drawing.
+const maxDebugRays = 256
+
+// maxDebugGridLines caps grid lines per axis when zoomed far out.
+const maxDebugGridLines = 200
+
+// DebugRay is a raycast remembered for debug drawing.
+type DebugRay struct {
+	Origin gamemath.Vector2
+	End    gamemath.Vector2 // Hit point, or origin + direction * maxDist on a miss
+	Hit    bool
+	Normal gamemath.Vector2 // Surface normal at the hit
+}
+
+// DebugDraw renders physics state as wireframes over the scene.
+//
+// The engine draws it after the scene (unaffected by post-processing) and
+// before UI widgets. For custom views, see the debug package.
+type DebugDraw struct {
+	Enabled   bool          // Draw the enabled layers
+	ToggleKey input.KeyCode // Key that flips Enabled at runtime (KeyNone = no key)
+
+	ShowColliders  bool    // Collider AABBs, plus outlines of circles and rotated boxes
+	ShowTriggers   bool    // Shaded trigger zones
+	ShowVelocities bool    // Rigid body velocity arrows
+	ShowRaycasts   bool    // Rays cast through Scene.Raycast/RaycastAll during the last step
+	ShowGrid       bool    // World grid every GridSize units
+	GridSize       float64 // World units between grid lines
+	VelocityScale  float64 // Screen pixels per world unit/second
+
+	ColliderColor gamemath.Color
+	TriggerColor  gamemath.Color
+	VelocityColor gamemath.Color
+	RayColor      gamemath.Color // Rays that missed
+	RayHitColor   gamemath.Color // Rays that hit, with the hit normal
+	GridColor     gamemath.Color
+}
+
+// NewDebugDraw creates a disabled debug draw with every layer shown and F3
+// as the toggle key.
+func NewDebugDraw() *DebugDraw {
+	return &DebugDraw{
+		ToggleKey:      input.KeyF3,
+		ShowColliders:  true,
+		ShowTriggers:   true,
+		ShowVelocities: true,
+		ShowRaycasts:   true,
+		ShowGrid:       true,
+		GridSize:       64,
+		VelocityScale:  0.1,
+		ColliderColor:  gamemath.Color{R: 80, G: 220, B: 80, A: 255},
+		TriggerColor:   gamemath.Color{R: 60, G: 180, B: 230, A: 70},
+		VelocityColor:  gamemath.Color{R: 255, G: 140, B: 40, A: 255},
+		RayColor:       gamemath.Color{R: 200, G: 200, B: 200, A: 160},
+		RayHitColor:    gamemath.Color{R: 255, G: 60, B: 60, A: 255},
+		GridColor:      gamemath.Color{R: 255, G: 255, B: 255, A: 25},
+	}
+}
+
+// HandleInput flips Enabled when ToggleKey was pressed this frame.
+func (d *DebugDraw) HandleInput(inputMgr *input.InputManager) {
+	if d.ToggleKey != input.KeyNone && inputMgr.KeyPressed(d.ToggleKey) {
+		d.Enabled = !d.Enabled
+	}
+}
+
+// recordingRays reports whether raycasts should be remembered.
+func (d *DebugDraw) recordingRays() bool {
+	return d != nil && d.Enabled && d.ShowRaycasts
+}
+
+// Draw draws the enabled layers for every scene camera: grid, triggers,
+// colliders, velocities, then raycasts.
+//
+// Returns:
+//
+//	error: First drawing error
+func (d *DebugDraw) Draw(renderer *graphics.Renderer, scene *Scene) error {
+	if !d.Enabled || scene == nil {
+		return nil
+	}
+	return scene.forEachView(renderer, func(camera *graphics.Camera) error {
+		if d.ShowGrid {
+			if err := d.drawGrid(renderer, camera); err != nil {
+				return err
+			}
+		}
+		for _, entity := range scene.entities {
+			if !entity.Active || !scene.layerVisible(entity.Layer) || !camera.DrawsLayer(entity.Layer) {
+				continue
+			}
+			if err := d.drawEntity(renderer, scene.layerCamera(camera, entity.Layer), entity); err != nil {
+				return err
+			}
+		}
+		if d.ShowRaycasts {
+			for _, ray := range scene.debugRays {
+				if err := d.drawRay(renderer, camera, ray); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// drawEntity draws an entity's collider and velocity.
+func (d *DebugDraw) drawEntity(renderer *graphics.Renderer, camera *graphics.Camera, entity *Entity) error {
+	if collider := entity.Collider; collider != nil {
+		bounds := entity.GetBounds()
+		x, y := debugScreenPoint(camera, gamemath.Vector2{X: bounds.X, Y: bounds.Y})
+		rect := gamemath.Rectangle{X: x, Y: y, Width: bounds.Width * camera.Zoom, Height: bounds.Height * camera.Zoom}
+		switch {
+		case collider.IsTrigger && d.ShowTriggers:
+			if err := renderer.FillRect(rect, d.TriggerColor); err != nil {
+				return err
+			}
+		case !collider.IsTrigger && d.ShowColliders:
+			if err := renderer.DrawRect(rect, d.ColliderColor); err != nil {
+				return err
+			}
+			if collider.Shape != nil {
+				if err := debugPolygon(renderer, camera, collider.Outline(entity.WorldTransform()), d.ColliderColor); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if d.ShowVelocities && entity.Body != nil && entity.Body.Velocity != (gamemath.Vector2{}) {
+		x, y := debugScreenPoint(camera, entity.WorldTransform().Position)
+		v := entity.Body.Velocity.Scale(d.VelocityScale * camera.Zoom)
+		return debugArrow(renderer, x, y, x+v.X, y+v.Y, d.VelocityColor)
+	}
+	return nil
+}
+
+// drawRay draws a ray, with a hit marker and normal for hits.
+func (d *DebugDraw) drawRay(renderer *graphics.Renderer, camera *graphics.Camera, ray DebugRay) error {
+	x1, y1 := debugScreenPoint(camera, ray.Origin)
+	x2, y2 := debugScreenPoint(camera, ray.End)
+	if !ray.Hit {
+		return renderer.DrawLine(x1, y1, x2, y2, d.RayColor)
+	}
+	if err := renderer.DrawLine(x1, y1, x2, y2, d.RayHitColor); err != nil {
+		return err
+	}
+	marker := gamemath.Rectangle{X: x2 - 2, Y: y2 - 2, Width: 5, Height: 5}
+	if err := renderer.FillRect(marker, d.RayHitColor); err != nil {
+		return err
+	}
+	n := ray.Normal.Scale(12)
+	return debugArrow(renderer, x2, y2, x2+n.X, y2+n.Y, d.RayHitColor)
+}
+
+// drawGrid draws world grid lines visible through the camera.
+func (d *DebugDraw) drawGrid(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	if d.GridSize <= 0 || camera.Zoom <= 0 {
+		return nil
+	}
+	width, height := camera.ScreenSize()
+	w, h := float64(width), float64(height)
+	left, top := camera.ScreenToWorld(0, 0)
+	right, bottom := camera.ScreenToWorld(width, height)
+
+	step := d.GridSize
+	for (right-left)/step > maxDebugGridLines || (bottom-top)/step > maxDebugGridLines {
+		step *= 2
+	}
+	for wx := math.Ceil(left/step) * step; wx <= right; wx += step {
+		x, _ := debugScreenPoint(camera, gamemath.Vector2{X: wx})
+		if err := renderer.DrawLine(x, 0, x, h, d.GridColor); err != nil {
+			return err
+		}
+	}
+	for wy := math.Ceil(top/step) * step; wy <= bottom; wy += step {
+		_, y := debugScreenPoint(camera, gamemath.Vector2{Y: wy})
+		if err := renderer.DrawLine(0, y, w, y, d.GridColor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordRay remembers a raycast for debug drawing.
+func (s *Scene) recordRay(origin, direction gamemath.Vector2, maxDist float64, hit *RaycastHit) {
+	if !s.debugDraw.recordingRays() || len(s.debugRays) >= maxDebugRays {
+		return
+	}
+	ray := DebugRay{Origin: origin, End: origin.Add(direction.Normalize().Scale(maxDist))}
+	if hit != nil {
+		ray.End, ray.Hit, ray.Normal = hit.Point, true, hit.Normal
+	}
+	s.debugRays = append(s.debugRays, ray)
+}
+
+// debugScreenPoint converts a world point to fractional viewport pixels.
+func debugScreenPoint(camera *graphics.Camera, world gamemath.Vector2) (x, y float64) {
+	width, height := camera.ScreenSize()
+	view := camera.ViewPosition()
+	x = (world.X-view.X)*camera.Zoom + float64(width)/2
+	y = (world.Y-view.Y)*camera.Zoom + float64(height)/2
+	return x, y
+}
+
+// debugPolygon outlines a closed world-space polygon.
+func debugPolygon(renderer *graphics.Renderer, camera *graphics.Camera, points []gamemath.Vector2, color gamemath.Color) error {
+	for i, point := range points {
+		x1, y1 := debugScreenPoint(camera, point)
+		x2, y2 := debugScreenPoint(camera, points[(i+1)%len(points)])
+		if err := renderer.DrawLine(x1, y1, x2, y2, color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// debugArrow draws a line with a small head at its end.
+func debugArrow(renderer *graphics.Renderer, x1, y1, x2, y2 float64, color gamemath.Color) error {
+	if err := renderer.DrawLine(x1, y1, x2, y2, color); err != nil {
+		return err
+	}
+	dir := gamemath.Vector2{X: x2 - x1, Y: y2 - y1}.Normalize().Scale(5)
+	side := gamemath.Vector2{X: -dir.Y, Y: dir.X}
+	for _, s := range []float64{1, -1} {
+		if err := renderer.DrawLine(x2, y2, x2-dir.X+side.X*s*0.6, y2-dir.Y+side.Y*s*0.6, color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,369 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Entity definition errors.
+var (
+	ErrInvalidDefinition = errors.New("invalid entity definition")
+	ErrUnknownComponent  = errors.New("unknown component type")
+)
+
+// EntityDefinition is a data-driven entity template read from YAML or JSON:
+// the prefab entity format plus components built by name from a
+// ComponentRegistry, so enemies and items can be rebalanced by editing a
+// file instead of recompiling.
+//
+// Example file (YAML; the same structure works as JSON):
+//
+//	# data/enemies.yaml
+//	- name: goblin
+//	  entity:
+//	    layer: 2
+//	    sprite: {texture: sprites/goblin.png}
+//	    collider: {bounds: {x: -8, y: -8, width: 16, height: 16}}
+//	    tags: [enemy]
+//	  properties: {gold: 5}
+//	  components:
+//	    - type: Patrol
+//	      params: {speed: 80, range: 120}
+//	    - type: Health
+//	      params: {max: 30}
+//
+// Omitted fields get usable defaults: entities are active with scale 1,
+// sprites are untinted and opaque and show the whole texture, colliders
+// collide with every layer, and bodies are dynamic with mass 1 and
+// gravity scale 1.
+type EntityDefinition struct {
+	Name       string                `json:"name"`
+	Entity     EntityData            `json:"entity"`
+	Properties map[string]float64    `json:"properties,omitempty"` // Tuning values (see Prefab.Property)
+	Components []ComponentDefinition `json:"components,omitempty"`
+}
+
+// ComponentDefinition names a registered component and its parameters.
+type ComponentDefinition struct {
+	Type   string          `json:"type"`
+	Params ComponentParams `json:"params,omitempty"`
+}
+
+// ComponentParams are a component's parameters from a definition file.
+//
+// Values are read with typed accessors that fall back to a default, like
+// Blackboard, or decoded into a struct with Decode.
+type ComponentParams map[string]any
+
+// Float returns a number parameter, or fallback if missing or not a number.
+func (p ComponentParams) Float(key string, fallback float64) float64 {
+	if value, ok := p[key].(float64); ok {
+		return value
+	}
+	return fallback
+}
+
+// Int returns a number parameter truncated to an int, or fallback.
+func (p ComponentParams) Int(key string, fallback int) int {
+	if value, ok := p[key].(float64); ok {
+		return int(value)
+	}
+	return fallback
+}
+
+// String returns a string parameter, or fallback.
+func (p ComponentParams) String(key string, fallback string) string {
+	if value, ok := p[key].(string); ok {
+		return value
+	}
+	return fallback
+}
+
+// Bool returns a boolean parameter, or fallback.
+func (p ComponentParams) Bool(key string, fallback bool) bool {
+	if value, ok := p[key].(bool); ok {
+		return value
+	}
+	return fallback
+}
+
+// Decode copies the parameters into a struct through its JSON field names
+//
+// Returns:
+//
+//	error: Non-nil for parameters the struct doesn't have (catching typos
+//	       in balancing files) or values of the wrong type
+//
+// Example:
+//
+//	var patrol Patrol // struct{ Speed float64 `json:"speed"`; Range float64 `json:"range"` }
+//	if err := params.Decode(&patrol); err != nil {
+//	    return nil, err
+//	}
+func (p ComponentParams) Decode(target any) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to encode component params: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("failed to decode component params: %w", err)
+	}
+	return nil
+}
+
+// ComponentFactory builds a new component from definition parameters.
+//
+// The result is attached with Entity.AddComponent, so it can be a
+// Behavior, a built-in component, or any custom data.
+type ComponentFactory func(params ComponentParams) (any, error)
+
+// ComponentRegistry maps component type names used in definition files to
+// factories.
+type ComponentRegistry struct {
+	factories map[string]ComponentFactory
+	types     map[reflect.Type]string // Names of types registered with RegisterComponentType
+}
+
+// defaultComponents is the registry used when none is given.
+var defaultComponents = NewComponentRegistry()
+
+// NewComponentRegistry creates an empty registry.
+func NewComponentRegistry() *ComponentRegistry {
+	return &ComponentRegistry{
+		factories: make(map[string]ComponentFactory),
+		types:     make(map[reflect.Type]string),
+	}
+}
+
+// DefaultComponentRegistry returns the registry used by RegisterComponent
+// and by EntityDefinition.Prefab when given nil.
+func DefaultComponentRegistry() *ComponentRegistry {
+	return defaultComponents
+}
+
+// RegisterComponent registers a factory in the default registry.
+//
+// Example:
+//
+//	core.RegisterComponent("Health", func(params core.ComponentParams) (any, error) {
+//	    maxHP := params.Int("max", 10)
+//	    return &Health{HP: maxHP, Max: maxHP}, nil
+//	})
+func RegisterComponent(name string, factory ComponentFactory) {
+	defaultComponents.Register(name, factory)
+}
+
+// Register adds or replaces the factory for a component type name.
+//
+// Components built by a plain factory can't be saved, since the registry
+// can't tell their type's name; use RegisterComponentType or
+// RegisterBehavior for components that scene files and prefabs persist.
+func (r *ComponentRegistry) Register(name string, factory ComponentFactory) {
+	for componentType, registered := range r.types {
+		if registered == name {
+			delete(r.types, componentType)
+		}
+	}
+	r.factories[name] = factory
+}
+
+// Registered reports whether a component type name has a factory.
+func (r *ComponentRegistry) Registered(name string) bool {
+	_, ok := r.factories[name]
+	return ok
+}
+
+// Names returns every registered component type name, sorted.
+func (r *ComponentRegistry) Names() []string {
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Build creates a component by type name
+//
+// Returns:
+//
+//	any: New component
+//	error: ErrUnknownComponent if the name isn't registered, or the
+//	       factory's error
+func (r *ComponentRegistry) Build(name string, params ComponentParams) (any, error) {
+	factory, ok := r.factories[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownComponent, name)
+	}
+	component, err := factory(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build component %q: %w", name, err)
+	}
+	return component, nil
+}
+
+// ParseEntityDefinitions parses definitions from YAML or JSON
+//
+// Parameters:
+//
+//	data: One definition, or a list of definitions (JSON is parsed as
+//	      YAML, which it is a subset of)
+//
+// Returns:
+//
+//	[]*EntityDefinition: Definitions in file order, with defaults applied
+//	error: ErrInvalidDefinition for malformed data, unknown fields, or
+//	       missing or duplicate names
+func ParseEntityDefinitions(data []byte) ([]*EntityDefinition, error) {
+	var document any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+	items, isList := document.([]any)
+	if !isList {
+		items = []any{document}
+	}
+
+	definitions := make([]*EntityDefinition, 0, len(items))
+	names := make(map[string]bool, len(items))
+	for i, item := range items {
+		definition, err := parseEntityDefinition(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w: definition %d: %w", ErrInvalidDefinition, i, err)
+		}
+		if names[definition.Name] {
+			return nil, fmt.Errorf("%w: duplicate name %q", ErrInvalidDefinition, definition.Name)
+		}
+		names[definition.Name] = true
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+// LoadEntityDefinitions reads definitions from YAML or JSON (see
+// ParseEntityDefinitions).
+//
+// Example:
+//
+//	file, err := os.Open("data/enemies.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer file.Close()
+//	definitions, err := core.LoadEntityDefinitions(file)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	prefabs := make(map[string]*core.Prefab)
+//	for _, definition := range definitions {
+//	    if prefabs[definition.Name], err = definition.Prefab(nil); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+func LoadEntityDefinitions(r io.Reader) ([]*EntityDefinition, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read entity definitions: %w", err)
+	}
+	return ParseEntityDefinitions(data)
+}
+
+// parseEntityDefinition decodes one definition over the defaults.
+func parseEntityDefinition(item any) (*EntityDefinition, error) {
+	fields, ok := item.(map[string]any)
+	if !ok {
+		return nil, errors.New("expected a mapping")
+	}
+
+	definition := &EntityDefinition{Entity: EntityData{Active: true, ScaleX: 1, ScaleY: 1}}
+	if entity, ok := fields["entity"].(map[string]any); ok {
+		if _, ok := entity["sprite"]; ok {
+			definition.Entity.Sprite = &SpriteData{Color: ColorData{R: 255, G: 255, B: 255, A: 255}, Alpha: 1}
+		}
+		if _, ok := entity["collider"]; ok {
+			definition.Entity.Collider = &ColliderData{Mask: 0xFFFFFFFF}
+		}
+		if _, ok := entity["body"]; ok {
+			definition.Entity.Body = &BodyData{Type: "dynamic", Mass: 1, GravityScale: 1}
+		}
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(definition); err != nil {
+		return nil, err
+	}
+	if definition.Name == "" {
+		return nil, errors.New("missing name")
+	}
+	for _, component := range definition.Components {
+		if component.Type == "" {
+			return nil, fmt.Errorf("%s: component without a type", definition.Name)
+		}
+	}
+	return definition, nil
+}
+
+// Prefab turns the definition into a prefab whose instances get freshly
+// built components
+//
+// Parameters:
+//
+//	registry: Component factories (nil = DefaultComponentRegistry)
+//
+// Returns:
+//
+//	*Prefab: Prefab named after the definition
+//	error: ErrUnknownComponent or a factory error, found by building each
+//	       component once up front
+//
+// Behavior:
+//   - OnInstantiate adds the components in file order; wrap it to attach
+//     more code
+//   - The prefab is a snapshot: reparse the file and call Prefab again to
+//     pick up balancing changes
+func (d *EntityDefinition) Prefab(registry *ComponentRegistry) (*Prefab, error) {
+	if registry == nil {
+		registry = defaultComponents
+	}
+	for _, component := range d.Components {
+		if _, err := registry.Build(component.Type, component.Params); err != nil {
+			return nil, fmt.Errorf("definition %q: %w", d.Name, err)
+		}
+	}
+	entity, err := mergeEntityData(d.Entity, nil)
+	if err != nil {
+		return nil, fmt.Errorf("definition %q: %w", d.Name, err)
+	}
+
+	components := slices.Clone(d.Components)
+	return &Prefab{
+		Version:    SceneFormatVersion,
+		Name:       d.Name,
+		Entity:     entity,
+		Properties: maps.Clone(d.Properties),
+		OnInstantiate: func(instance *Entity) {
+			for _, definition := range components {
+				// Each type and its params built successfully above
+				if component, err := registry.Build(definition.Type, definition.Params); err == nil {
+					instance.AddComponent(component)
+				}
+			}
+		},
+	}, nil
+}
@@ -3,29 +3,57 @@ package core
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
+	"github.com/dshills/gogame/engine/audio"
 	"github.com/dshills/gogame/engine/graphics"
 	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/ui"
 	"github.com/veandco/go-sdl2/sdl"
 	"github.com/veandco/go-sdl2/ttf"
 )
 
+// Game loop limits.
+const (
+	maxUpdateSteps    = 8 // Fixed updates per frame (prevents the spiral of death)
+	maxTextureUploads = 4 // Background-decoded textures uploaded per frame
+)
+
 // Engine is the root game engine managing window, rendering, and game loop.
 type Engine struct {
 	window       *sdl.Window
 	renderer     *graphics.Renderer
 	scene        *Scene
+	scenes       *SceneManager // Named scene stack; drives scene when used
 	time         *Time
 	inputMgr     *input.InputManager
-	running      bool
-	width        int
+	running      atomic.Bool // Written by Stop from any goroutine
+	paused       bool        // Only pause-exempt entities update (see SetPaused)
+	width        int         // Render size: the logical size if set, else the window size
 	height       int
+	logicalW     int // Design resolution (0 = follow the window)
+	logicalH     int
+	windowW      int // Headless window size (a real window reports its own)
+	windowH      int
 	assetMgr     *graphics.AssetManager
+	audioMgr     *audio.AudioManager
+	blackboard   *Blackboard // Global state that outlives scenes
 	initialized  bool
-	renderUIFunc func()  // Optional UI rendering callback
-	fps          float64 // Current frames per second
-	frameCount   int     // Frame counter for FPS calculation
-	fpsTimer     float64 // Timer for FPS updates
+	headless     bool                    // No window or renderer (see NewHeadlessEngine)
+	renderUIFunc func()                  // Optional UI rendering callback
+	onResize     func(width, height int) // Optional window resize callback
+	ui           *ui.UI                  // Screen-space widgets drawn over the scene
+	postProcess  *graphics.PostProcessor
+	profiler     *Profiler
+	perf         *PerfMonitor
+	quality      *QualityScaler // Automatic detail reduction on slow hardware
+	debugDraw    *DebugDraw     // Physics wireframes over the scene
+	perfHUD      *PerfHUD       // On-screen frame statistics
+	fps          float64        // Current frames per second
+	frameCount   int            // Frame counter for FPS calculation
+	fpsTimer     float64        // Timer for FPS updates
 }
 
 // NewEngine creates a new game engine instance
@@ -60,7 +88,7 @@ type Engine struct {
 //	}
 func NewEngine(title string, width, height int, fullscreen bool) (*Engine, error) {
 	// Initialize SDL
-	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+	if err := sdl.Init(sdl.INIT_VIDEO | sdl.INIT_GAMECONTROLLER); err != nil {
 		return nil, fmt.Errorf("failed to initialize SDL: %w", err)
 	}
 
@@ -90,12 +118,16 @@ func NewEngine(title string, width, height int, fullscreen bool) (*Engine, error
 		return nil, fmt.Errorf("failed to create SDL window: %w", err)
 	}
 
-	// Create hardware-accelerated renderer with vsync
+	// Create hardware-accelerated renderer with vsync, falling back to
+	// software rendering (virtual machines, CI, SDL's dummy video driver)
 	sdlRenderer, err := sdl.CreateRenderer(
 		window,
 		-1,
 		sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC,
 	)
+	if err != nil {
+		sdlRenderer, err = sdl.CreateRenderer(window, -1, sdl.RENDERER_SOFTWARE)
+	}
 	if err != nil {
 		_ = window.Destroy() // Best effort cleanup
 		sdl.Quit()
@@ -111,18 +143,32 @@ func NewEngine(title string, width, height int, fullscreen bool) (*Engine, error
 	// Create input manager
 	inputMgr := input.NewInputManager()
 
-	return &Engine{
+	// Open audio; without a driver or device the game runs silently (Audio().Enabled() is false)
+	audioMgr := audio.NewAudioManager()
+	_ = audioMgr.Open(audio.DefaultFrequency, audio.DefaultChannels)
+
+	engine := &Engine{
 		window:      window,
 		renderer:    renderer,
 		scene:       nil,
 		time:        NewTime(),
 		inputMgr:    inputMgr,
-		running:     false,
 		width:       width,
 		height:      height,
 		assetMgr:    assetMgr,
+		audioMgr:    audioMgr,
+		blackboard:  NewBlackboard(),
+		postProcess: graphics.NewPostProcessor(),
+		profiler:    NewProfiler(),
+		perf:        NewPerfMonitor(),
+		quality:     NewQualityScaler(),
+		debugDraw:   NewDebugDraw(),
+		perfHUD:     NewPerfHUD(),
+		ui:          ui.New(),
 		initialized: true,
-	}, nil
+	}
+	engine.scenes = NewSceneManager(engine.SetScene)
+	return engine, nil
 }
 
 // SetScene sets the active scene
@@ -133,6 +179,7 @@ func NewEngine(title string, width, height int, fullscreen bool) (*Engine, error
 //
 // Behavior:
 //   - Previous scene (if any) is not destroyed (developer must manage)
+//   - Bypasses the scene manager; prefer Scenes() for stacks and transitions
 //   - New scene begins updating/rendering immediately
 //
 // Example:
@@ -141,12 +188,34 @@ func NewEngine(title string, width, height int, fullscreen bool) (*Engine, error
 //	engine.SetScene(menuScene)
 func (e *Engine) SetScene(scene *Scene) {
 	e.scene = scene
-	// Update camera screen size
-	if scene != nil && scene.camera != nil {
-		scene.camera.SetScreenSize(e.width, e.height)
+	if scene != nil {
+		scene.setScreenSize(e.width, e.height)
+		scene.perf = e.perf
+		scene.quality = e.quality
+		scene.debugDraw = e.debugDraw
 	}
 }
 
+// Scenes returns the scene manager
+//
+// The manager's active scene becomes the engine's scene after every push,
+// pop, or replace, and Run plays its transitions.
+//
+// Returns:
+//
+//	*SceneManager: Named scene stack with transitions
+//
+// Example:
+//
+//	engine.Scenes().Register("menu", newMenuScene)
+//	engine.Scenes().Register("game", newGameScene)
+//	_, _ = engine.Scenes().Push("menu", core.Transition{})
+//	// Later, from a menu button:
+//	_, _ = engine.Scenes().Replace("game", core.Transition{Effect: core.TransitionFade, Duration: 0.5})
+func (e *Engine) Scenes() *SceneManager {
+	return e.scenes
+}
+
 // GetScene returns the currently active scene
 //
 // Returns:
@@ -166,6 +235,8 @@ func (e *Engine) GetScene() *Scene {
 //   - Fixed 60 FPS update rate
 //   - Variable rendering rate (vsync if enabled)
 //   - Calls scene Update() and Render() each frame
+//   - A headless engine (see NewHeadlessEngine) only updates, sleeping
+//     between fixed updates
 //   - Returns error if rendering fails
 //
 // Example:
@@ -185,52 +256,115 @@ func (e *Engine) Run() error {
 		return nil
 	}
 
-	e.running = true
-	defer func() { e.running = false }()
+	e.running.Store(true)
+	defer e.running.Store(false)
 
-	const maxUpdateSteps = 8 // Prevent spiral of death
+	if e.headless {
+		return e.runHeadless()
+	}
 
-	for e.running {
+	for e.running.Load() {
 		// Handle SDL events
 		if !e.handleEvents() {
 			break
 		}
 
+		// Upload textures decoded in the background (see LoadTextureAsync)
+		e.assetMgr.ProcessLoads(maxTextureUploads)
+
+		// Reload changed texture files (see AssetManager.WatchFiles)
+		e.assetMgr.ReloadChanged()
+
 		// Prevent busy loop when no scene is active
-		if e.scene == nil {
+		transitioning := e.scenes.Transitioning()
+		if e.scene == nil && !transitioning {
 			sdl.Delay(1) // Sleep 1ms to avoid maxing CPU
 			continue
 		}
 
+		// Debug draw and perf HUD toggle keys
+		e.debugDraw.HandleInput(e.inputMgr)
+		e.perfHUD.HandleInput(e.inputMgr)
+
+		// Lay out widgets and route the mouse before gameplay sees it
+		e.ui.Layout(e.width, e.height)
+		e.ui.Update(e.inputMgr)
+
 		// Update with fixed timestep (capped to prevent spiral of death)
 		updateCount, dt := e.time.Tick()
 		if updateCount > maxUpdateSteps {
 			updateCount = maxUpdateSteps
 		}
 
-		for i := 0; i < updateCount; i++ {
-			e.scene.Update(dt)
-		}
+		// Scenes are frozen while a transition plays
+		frameStart := time.Now()
+		endUpdate := e.profiler.Begin("update")
+		e.update(updateCount, dt, transitioning)
+		endUpdate()
+
+		// Advance music fades by the simulated time
+		e.audioMgr.Update(dt * float64(updateCount))
 
 		// Render
-		// Clear screen with background color
-		bgColor := e.scene.GetBackgroundColor()
-		if err := e.renderer.Clear(bgColor); err != nil {
-			return fmt.Errorf("failed to clear screen: %w", err)
+		endRender := e.profiler.Begin("render")
+		e.renderer.ResetDrawCalls()
+		// Redirect to the offscreen target when post effects are active
+		// (and the quality level allows them)
+		postActive := e.postProcess.Active() && e.quality.Current().PostProcessing
+		if postActive {
+			if err := e.postProcess.Begin(e.renderer, e.width, e.height); err != nil {
+				return fmt.Errorf("failed to begin post-processing: %w", err)
+			}
+		}
+
+		// Clear to the background color and render the scene (or both
+		// scenes of a transition)
+		if transitioning {
+			if err := e.scenes.Render(e.renderer, e.width, e.height); err != nil {
+				return err
+			}
+		} else if err := renderScene(e.renderer, e.scene, gamemath.Black); err != nil {
+			return err
+		}
+
+		// Apply post effects to the scene (UI overlay is drawn unprocessed)
+		if postActive {
+			if err := e.postProcess.End(e.renderer); err != nil {
+				return fmt.Errorf("failed to apply post-processing: %w", err)
+			}
 		}
 
-		// Render scene
-		if err := e.scene.Render(e.renderer); err != nil {
-			return fmt.Errorf("failed to render scene: %w", err)
+		// Physics wireframes over the processed scene
+		if !transitioning {
+			if err := e.debugDraw.Draw(e.renderer, e.scene); err != nil {
+				return fmt.Errorf("failed to render debug draw: %w", err)
+			}
 		}
 
-		// Render UI overlay (if callback set)
+		// Draw widgets, then the UI callback (debug overlays) on top
+		if err := e.ui.Draw(e.renderer); err != nil {
+			return fmt.Errorf("failed to render UI: %w", err)
+		}
 		if e.renderUIFunc != nil {
 			e.renderUIFunc()
 		}
 
-		// Present frame
+		// Frame statistics on top of everything (its own draws not counted)
+		if e.perfHUD.Enabled {
+			e.perfHUD.Record(e.collectPerfStats())
+			if err := e.perfHUD.Draw(e.renderer, e.width, e.height); err != nil {
+				return fmt.Errorf("failed to render perf HUD: %w", err)
+			}
+		}
+		endRender()
+		work := time.Since(frameStart)
+		e.perf.RecordFrame(work)
+		e.quality.RecordFrame(work)
+
+		// Present frame (includes vsync wait)
+		endPresent := e.profiler.Begin("present")
 		e.renderer.Present()
+		endPresent()
 
 		// Update FPS counter
 		e.frameCount++
@@ -248,6 +382,23 @@ func (e *Engine) Run() error {
 	return nil
 }
 
+// update runs the frame's fixed updates, or advances a scene transition
+// (scenes are frozen while one plays). The catch-up updates stop early if a
+// behavior pops the last scene or starts a transition.
+func (e *Engine) update(updateCount int, dt float64, transitioning bool) {
+	if transitioning {
+		e.scenes.Update(dt * float64(updateCount))
+		return
+	}
+	for i := 0; i < updateCount && e.scene != nil && !e.scenes.Transitioning(); i++ {
+		if e.paused {
+			e.scene.UpdatePaused(dt)
+		} else {
+			e.scene.Update(dt)
+		}
+	}
+}
+
 // handleEvents processes SDL events and returns false if should quit.
 func (e *Engine) handleEvents() bool {
 	for event := sdl.PollEvent(); event != nil; event = sdl.PollEvent() {
@@ -256,13 +407,9 @@ func (e *Engine) handleEvents() bool {
 			return false
 
 		case *sdl.WindowEvent:
-			if evt.Event == sdl.WINDOWEVENT_RESIZED {
-				e.width = int(evt.Data1)
-				e.height = int(evt.Data2)
-				// Update camera dimensions
-				if e.scene != nil && e.scene.camera != nil {
-					e.scene.camera.SetScreenSize(e.width, e.height)
-				}
+			// Sent for user resizes and for SetWindowSize/SetFullscreen
+			if evt.Event == sdl.WINDOWEVENT_SIZE_CHANGED {
+				e.resize(int(evt.Data1), int(evt.Data2))
 			}
 
 		case *sdl.KeyboardEvent:
@@ -273,6 +420,12 @@ func (e *Engine) handleEvents() bool {
 
 		case *sdl.MouseMotionEvent:
 			e.inputMgr.ProcessMouseMotionEvent(evt)
+
+		case *sdl.ControllerButtonEvent:
+			e.inputMgr.ProcessControllerButtonEvent(evt)
+
+		case *sdl.ControllerDeviceEvent:
+			e.inputMgr.ProcessControllerDeviceEvent(evt)
 		}
 	}
 	return true
@@ -282,13 +435,44 @@ func (e *Engine) handleEvents() bool {
 //
 // Behavior:
 //   - Game loop exits after current frame completes
+//   - Safe to call from any goroutine, such as a signal handler stopping a
+//     dedicated server
 //   - Resources remain allocated (call Shutdown to cleanup)
 //
 // Example:
 //
 //	engine.Stop()
 func (e *Engine) Stop() {
-	e.running = false
+	e.running.Store(false)
+}
+
+// SetPaused freezes or resumes the game
+//
+// Parameters:
+//
+//	paused: True to freeze the scene
+//
+// Behavior:
+//   - While paused the scene runs Scene.UpdatePaused: only entities with
+//     UpdateWhenPaused and behaviors implementing PauseExempt tick
+//   - Rendering, input, UI, audio, and scene transitions keep running
+//   - Pausing persists across scene changes
+//
+// Example:
+//
+//	menu := &core.Entity{Active: true, Behavior: &PauseMenu{}, UpdateWhenPaused: true}
+//	scene.AddEntity(menu)
+//	// In PauseMenu.Update:
+//	if engine.Input().KeyPressed(input.KeyEscape) {
+//	    engine.SetPaused(!engine.Paused())
+//	}
+func (e *Engine) SetPaused(paused bool) {
+	e.paused = paused
+}
+
+// Paused reports whether the game is paused.
+func (e *Engine) Paused() bool {
+	return e.paused
 }
 
 // GetFPS returns the current frames per second.
@@ -303,6 +487,136 @@ func (e *Engine) GetFPS() float64 {
 	return e.fps
 }
 
+// FrameTimeStats returns the observed frame times in seconds.
+//
+// Returns:
+//
+//	min: Fastest frame
+//	max: Slowest frame
+//	avg: Rolling average frame time
+func (e *Engine) FrameTimeStats() (min, max, avg float64) {
+	return e.time.GetFrameTimeStats()
+}
+
+// Perf returns the performance monitor
+//
+// Returns:
+//
+//	*PerfMonitor: Frame budget, collision pair, and slow behavior warnings
+//
+// Example:
+//
+//	engine.Perf().OnWarning = func(w core.PerfWarning) {
+//	    log.Printf("perf [%s]: %s", w.Kind, w.Message)
+//	}
+func (e *Engine) Perf() *PerfMonitor {
+	return e.perf
+}
+
+// Quality returns the automatic quality scaler
+//
+// Returns:
+//
+//	*QualityScaler: Lowers detail when frames run over budget
+//
+// Behavior:
+//   - Post-processing is skipped at levels whose settings disable it
+//   - Scenes throttle far entities according to the current level
+//   - ParticleScale is advisory; particle systems read it themselves
+//
+// Example:
+//
+//	// Options menu: let the player pick, or hand control back to the engine
+//	engine.Quality().SetOverride(core.QualityHigh)
+//	engine.Quality().ClearOverride()
+//
+//	// Particle system
+//	count := int(float64(burst) * engine.Quality().Current().ParticleScale)
+func (e *Engine) Quality() *QualityScaler {
+	return e.quality
+}
+
+// SetDebugDraw shows or hides physics wireframes over the scene
+//
+// Behavior:
+//   - Draws collider AABBs, trigger zones, velocity vectors, the last
+//     step's raycasts, and a world grid through every scene camera
+//   - F3 toggles it at runtime (change DebugDraw().ToggleKey, or set it
+//     to input.KeyNone to disable the key)
+//
+// Example:
+//
+//	engine.SetDebugDraw(true)
+//	engine.DebugDraw().ShowGrid = false
+func (e *Engine) SetDebugDraw(enabled bool) {
+	e.debugDraw.Enabled = enabled
+}
+
+// DebugDraw returns the debug wireframe settings (layers, colors, toggle key).
+func (e *Engine) DebugDraw() *DebugDraw {
+	return e.debugDraw
+}
+
+// SetPerfHUD shows or hides the on-screen performance panel
+//
+// Behavior:
+//   - Shows FPS, frame time min/avg/max, entity count, collision pairs,
+//     draw calls, and heap/GC statistics in the top-left corner
+//   - Text needs a font: set PerfHUD().Font before enabling
+//   - F2 toggles it at runtime (change PerfHUD().ToggleKey, or set it to
+//     input.KeyNone to disable the key)
+//
+// Example:
+//
+//	engine.PerfHUD().Font = font
+//	engine.SetPerfHUD(true)
+func (e *Engine) SetPerfHUD(enabled bool) {
+	e.perfHUD.Enabled = enabled
+}
+
+// PerfHUD returns the performance panel settings (font, colors, toggle key).
+func (e *Engine) PerfHUD() *PerfHUD {
+	return e.perfHUD
+}
+
+// collectPerfStats gathers this frame's numbers for the perf HUD.
+func (e *Engine) collectPerfStats() PerfStats {
+	frameMin, frameMax, frameAvg := e.time.GetFrameTimeStats()
+	stats := PerfStats{
+		FPS:       e.fps,
+		FrameMin:  frameMin,
+		FrameMax:  frameMax,
+		FrameAvg:  frameAvg,
+		DrawCalls: e.renderer.DrawCalls(),
+	}
+	if e.scene != nil {
+		stats.Entities = len(e.scene.GetAllEntities())
+		stats.CollisionPairs = len(e.scene.Contacts())
+	}
+	return stats
+}
+
+// UI returns the root of the engine's widget tree
+//
+// Widgets added here are laid out against the window, receive mouse input,
+// and are drawn each frame after the scene (and post effects) but before
+// the SetRenderUICallback callback.
+//
+// Example:
+//
+//	pause := ui.NewButton("Pause", font, togglePause)
+//	pause.Anchor = ui.AnchorTopRight
+//	pause.Offset = gamemath.Vector2{X: -10, Y: 10}
+//	engine.UI().Add(pause)
+func (e *Engine) UI() *ui.UI {
+	return e.ui
+}
+
+// Profiler returns the engine's section profiler ("update", "render", "present").
+func (e *Engine) Profiler() *Profiler {
+	return e.profiler
+}
+
 // Shutdown releases all engine resources
 //
 // Behavior:
@@ -324,6 +638,34 @@ func (e *Engine) Shutdown() {
 		e.assetMgr.Destroy()
 	}
 
+	// Release widget text
+	if e.ui != nil {
+		e.ui.Destroy()
+	}
+
+	// Release scene transition frames
+	if e.scenes != nil {
+		e.scenes.Destroy()
+	}
+
+	// Stop audio and close the device
+	if e.audioMgr != nil {
+		e.audioMgr.Close()
+	}
+
+	// Destroy post-processing targets and HUD text before the renderer
+	if e.postProcess != nil {
+		e.postProcess.Destroy()
+	}
+	if e.perfHUD != nil {
+		e.perfHUD.Destroy()
+	}
+
+	// Report textures the game never destroyed
+	if diagnostics := graphics.ActiveTextureDiagnostics(); diagnostics != nil && diagnostics.Output != nil {
+		diagnostics.Report(diagnostics.Output)
+	}
+
 	// Destroy renderer
 	if e.renderer != nil {
 		_ = e.renderer.Destroy() // Best effort cleanup
@@ -334,11 +676,11 @@ func (e *Engine) Shutdown() {
 		_ = e.window.Destroy() // Best effort cleanup
 	}
 
-	// Quit SDL_ttf
-	ttf.Quit()
-
-	// Quit SDL
-	sdl.Quit()
+	// Quit SDL_ttf and SDL (a headless engine never started them)
+	if !e.headless {
+		ttf.Quit()
+		sdl.Quit()
+	}
 
 	e.initialized = false
 }
@@ -352,6 +694,37 @@ func (e *Engine) Assets() *graphics.AssetManager {
 	return e.assetMgr
 }
 
+// Audio returns the audio manager
+//
+// Returns:
+//
+//	*audio.AudioManager: Sound effect and music subsystem
+//
+// Example:
+//
+//	hit, err := engine.Audio().LoadSound("sfx/hit.ogg")
+//	if err != nil {
+//	    log.Printf("missing sound: %v", err)
+//	}
+//	_, _ = engine.Audio().PlaySound(hit, 1, 0)
+//	_ = engine.Audio().PlayMusic("music/theme.ogg", true, 1.5)
+func (e *Engine) Audio() *audio.AudioManager {
+	return e.audioMgr
+}
+
+// Blackboard returns the global shared state, kept across scene changes
+//
+// Returns:
+//
+//	*Blackboard: Game-wide key-value store (score, settings, progress)
+//
+// Example:
+//
+//	engine.Blackboard().AddInt("coins", 1)
+func (e *Engine) Blackboard() *Blackboard {
+	return e.blackboard
+}
+
 // Input returns the input manager for keyboard and mouse input.
 //
 // Returns:
@@ -367,12 +740,14 @@ func (e *Engine) Input() *input.InputManager {
 	return e.inputMgr
 }
 
-// Width returns the window width.
+// Width returns the render width: the logical width if set (see
+// SetLogicalSize), else the window width.
 func (e *Engine) Width() int {
 	return e.width
 }
 
-// Height returns the window height.
+// Height returns the render height: the logical height if set, else the
+// window height.
 func (e *Engine) Height() int {
 	return e.height
 }
@@ -389,8 +764,19 @@ func (e *Engine) Renderer() *graphics.Renderer {
 	return e.renderer
 }
 
+// PostProcess returns the post-processing stage applied to the rendered scene.
+//
+// Example:
+//
+//	lut, _ := graphics.LoadColorLUT("assets/luts/underwater.png")
+//	engine.PostProcess().Add(lut)
+func (e *Engine) PostProcess() *graphics.PostProcessor {
+	return e.postProcess
+}
+
 // SetRenderUICallback sets a callback for rendering UI overlays.
-// The callback is called after scene rendering, before Present().
+// The callback is called after scene rendering and the UI() widgets,
+// before Present(). Prefer the ui package for HUDs and menus.
 func (e *Engine) SetRenderUICallback(callback func()) {
 	e.renderUIFunc = callback
 }
@@ -23,26 +23,189 @@ type Behavior interface {
 	Update(entity *Entity, dt float64)
 }
 
+// LateUpdater is an optional Behavior extension for work that must see the
+// final positions of the frame, such as camera follow.
+//
+// Scene.Update runs in phases each step:
+//  1. Update on every active entity
+//  2. Rigid body integration
+//  3. Collision detection and callbacks
+//  4. LateUpdate on every active entity whose Behavior implements LateUpdater
+//
+// Deferred removals are processed after the late phase.
+type LateUpdater interface {
+	// LateUpdate is called after all entities have updated and collisions
+	// have been resolved
+	//
+	// Parameters:
+	//   entity: The entity this behavior is attached to
+	//   dt: Delta time in seconds (same value passed to Update)
+	LateUpdate(entity *Entity, dt float64)
+}
+
+// PauseExempt is an optional Behavior extension for behaviors that keep
+// running while the engine is paused (see Engine.SetPaused), such as a pause
+// menu controller or music manager. Entity.UpdateWhenPaused exempts every
+// behavior of an entity instead.
+type PauseExempt interface {
+	// UpdateWhenPaused reports whether the behavior runs while paused
+	UpdateWhenPaused() bool
+}
+
+// MessageHandler is an optional Behavior extension that receives messages
+// sent with Entity.SendMessage.
+//
+// Messages let entities interact without importing each other's concrete
+// behavior types: a bullet sends "damage" to whatever it hits, and only
+// behaviors that care about damage handle it.
+//
+// Example:
+//
+//	func (h *Health) HandleMessage(entity *core.Entity, name string, payload any) {
+//	    if name == "damage" {
+//	        h.HP -= payload.(int)
+//	    }
+//	}
+type MessageHandler interface {
+	// HandleMessage is called for every message sent to the entity
+	//
+	// Parameters:
+	//   entity: The entity this behavior is attached to
+	//   name: Message name (handlers ignore names they don't know)
+	//   payload: Message data (may be nil)
+	HandleMessage(entity *Entity, name string, payload any)
+}
+
+// Behaviors combines multiple behaviors into one, updated in slice order.
+//
+// Example:
+//
+//	entity.Behavior = core.Behaviors{&PlayerController{}, statusEffects}
+type Behaviors []Behavior
+
+// Update calls Update on each non-nil behavior in order.
+func (b Behaviors) Update(entity *Entity, dt float64) {
+	for _, behavior := range b {
+		if behavior != nil {
+			behavior.Update(entity, dt)
+		}
+	}
+}
+
+// LateUpdate calls LateUpdate on each behavior that implements LateUpdater, in order.
+func (b Behaviors) LateUpdate(entity *Entity, dt float64) {
+	for _, behavior := range b {
+		if late, ok := behavior.(LateUpdater); ok {
+			late.LateUpdate(entity, dt)
+		}
+	}
+}
+
+// HandleMessage delivers a message to each behavior that implements
+// MessageHandler, in order.
+func (b Behaviors) HandleMessage(entity *Entity, name string, payload any) {
+	b.deliver(entity, name, payload)
+}
+
+// deliver sends a message to each handler and reports whether any received it.
+func (b Behaviors) deliver(entity *Entity, name string, payload any) bool {
+	handled := false
+	for _, behavior := range b {
+		if deliverMessage(behavior, entity, name, payload) {
+			handled = true
+		}
+	}
+	return handled
+}
+
+// deliverMessage sends a message to one behavior (expanding nested Behaviors).
+func deliverMessage(behavior Behavior, entity *Entity, name string, payload any) bool {
+	switch handler := behavior.(type) {
+	case Behaviors:
+		return handler.deliver(entity, name, payload)
+	case MessageHandler:
+		handler.HandleMessage(entity, name, payload)
+		return true
+	}
+	return false
+}
+
+// pausedBehavior returns the part of an entity's behavior that runs while
+// paused (nil if none).
+func (e *Entity) pausedBehavior() Behavior {
+	if e.UpdateWhenPaused {
+		return e.Behavior
+	}
+	return exemptBehavior(e.Behavior)
+}
+
+// exemptBehavior filters a behavior (or Behaviors list) to its pause-exempt members.
+func exemptBehavior(behavior Behavior) Behavior {
+	switch b := behavior.(type) {
+	case Behaviors:
+		exempt := make(Behaviors, 0, len(b))
+		for _, child := range b {
+			if kept := exemptBehavior(child); kept != nil {
+				exempt = append(exempt, kept)
+			}
+		}
+		if len(exempt) == 0 {
+			return nil
+		}
+		return exempt
+	case PauseExempt:
+		if b.UpdateWhenPaused() {
+			return behavior
+		}
+	}
+	return nil
+}
+
+// CollisionInfo describes a contact from the receiving entity's point of view.
+type CollisionInfo struct {
+	Normal  gamemath.Vector2 // Unit direction pushing self away from other
+	Depth   float64          // Overlap in world units, before resolution
+	Point   gamemath.Vector2 // Center of the overlap region
+	Trigger bool             // Either collider is a trigger (not resolved)
+}
+
 // CollisionCallback is called when collision events occur.
 // Parameters:
 //   - self: The entity this callback is attached to
 //   - other: The entity we collided with
-type CollisionCallback func(self, other *Entity)
+//   - info: Contact normal and depth (zero value for exit events)
+type CollisionCallback func(self, other *Entity, info CollisionInfo)
 
 // Entity represents a game object with position, optional visuals, and behavior.
+//
+// Sprite, Text, Collider, Body, and Behavior are built-in components; attach
+// any other data or logic with AddComponent and look it up with GetComponent.
 type Entity struct {
-	ID        uint64             // Unique identifier (assigned by Scene)
-	Active    bool               // Update/render only if true
-	Transform gamemath.Transform // Position, rotation, scale (required)
-	Sprite    *graphics.Sprite   // Optional visual representation
-	Collider  *physics.Collider  // Optional collision detection
-	Behavior  Behavior           // Optional custom update logic
-	Layer     int                // Z-order (higher renders on top)
+	ID        uint64               // Unique identifier (assigned by Scene)
+	Active    bool                 // Update/render only if true
+	Transform gamemath.Transform   // Position, rotation, scale (required)
+	Sprite    *graphics.Sprite     // Optional visual representation
+	Text      *graphics.TextSprite // Optional cached text, drawn over the sprite (not saved by Scene.Save)
+	Collider  *physics.Collider    // Optional collision detection
+	Body      *physics.RigidBody   // Optional velocity-based movement (integrated by Scene)
+	Behavior  Behavior             // Optional custom update logic
+	Layer     int                  // Z-order (higher renders on top)
+
+	Tags             []string // Optional labels for lookup (see HasTag, Scene.FindByTag)
+	UpdateWhenPaused bool     // Keep updating while Engine.SetPaused freezes the scene
 
 	// Collision callbacks (optional)
 	OnCollisionEnter CollisionCallback // Called when collision starts
 	OnCollisionStay  CollisionCallback // Called while collision continues
 	OnCollisionExit  CollisionCallback // Called when collision ends
+
+	parent     *Entity     // Transform parent (see SetParent)
+	children   []*Entity   // Entities parented to this one
+	components []any       // Custom components (see AddComponent)
+	skippedDT  float64     // Time owed from steps skipped by quality throttling
+	pool       *EntityPool // Pool the entity returns to on removal (see EntityPool)
+	pooled     bool        // Released and waiting in its pool
+	prefab     *Prefab     // Prefab the entity was instantiated from
 }
 
 // Update updates the entity's transform and behavior
@@ -65,6 +228,82 @@ func (e *Entity) Update(dt float64) {
 	}
 }
 
+// LateUpdate runs the behavior's late phase if it implements LateUpdater
+//
+// Parameters:
+//
+//	dt: Delta time in seconds
+//
+// Behavior:
+//   - Called automatically by Scene after Update and collision detection
+func (e *Entity) LateUpdate(dt float64) {
+	if late, ok := e.Behavior.(LateUpdater); ok {
+		late.LateUpdate(e, dt)
+	}
+}
+
+// Prefab returns the prefab (or resolved variant) the entity was
+// instantiated from, or nil.
+func (e *Entity) Prefab() *Prefab {
+	return e.prefab
+}
+
+// HasTag reports whether the entity has a tag.
+func (e *Entity) HasTag(tag string) bool {
+	for _, t := range e.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds a tag if the entity doesn't already have it.
+func (e *Entity) AddTag(tag string) {
+	if !e.HasTag(tag) {
+		e.Tags = append(e.Tags, tag)
+	}
+}
+
+// RemoveTag removes a tag.
+func (e *Entity) RemoveTag(tag string) {
+	for i, t := range e.Tags {
+		if t == tag {
+			e.Tags = append(e.Tags[:i:i], e.Tags[i+1:]...)
+			return
+		}
+	}
+}
+
+// SendMessage delivers a named message to the entity's behaviors
+//
+// Parameters:
+//
+//	name: Message name, e.g. "damage"
+//	payload: Message data (any type the handlers agree on; may be nil)
+//
+// Returns:
+//
+//	bool: True if at least one behavior implements MessageHandler
+//
+// Behavior:
+//   - Delivered immediately to every MessageHandler in Behavior (each
+//     member of a Behaviors list, in order)
+//   - Inactive entities ignore messages
+//
+// Example:
+//
+//	bullet.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
+//	    other.SendMessage("damage", 10)
+//	    scene.RemoveEntity(self.ID)
+//	}
+func (e *Entity) SendMessage(name string, payload any) bool {
+	if !e.Active {
+		return false
+	}
+	return deliverMessage(e.Behavior, e, name, payload)
+}
+
 // Render draws the entity's sprite
 //
 // Parameters:
@@ -73,8 +312,8 @@ func (e *Entity) Update(dt float64) {
 //	camera: Camera for view transform
 //
 // Behavior:
-//   - Renders Sprite if non-nil
-//   - Applies transform (position, rotation, scale)
+//   - Renders Sprite if non-nil, then Text if non-nil
+//   - Applies the world transform (composed with any parent's)
 //   - Called automatically by Scene during render phase
 //
 // Example:
@@ -83,7 +322,12 @@ func (e *Entity) Update(dt float64) {
 //	entity.Render(renderer, camera)
 func (e *Entity) Render(renderer *graphics.Renderer, camera *graphics.Camera) error {
 	if e.Sprite != nil {
-		return renderer.DrawSprite(e.Sprite, e.Transform, camera)
+		if err := renderer.DrawSprite(e.Sprite, e.WorldTransform(), camera); err != nil {
+			return err
+		}
+	}
+	if e.Text != nil {
+		return renderer.DrawTextSprite(e.Text, e.WorldTransform(), camera)
 	}
 	return nil
 }
@@ -101,14 +345,15 @@ func (e *Entity) Render(renderer *graphics.Renderer, camera *graphics.Camera) er
 //	    fmt.Println("Entity clicked!")
 //	}
 func (e *Entity) GetBounds() gamemath.Rectangle {
+	transform := e.WorldTransform()
 	if e.Collider != nil {
-		return e.Collider.GetWorldBounds(e.Transform)
+		return e.Collider.GetWorldBounds(transform)
 	}
 
 	// No collider - return zero-size rectangle at entity position
 	return gamemath.Rectangle{
-		X:      e.Transform.Position.X,
-		Y:      e.Transform.Position.Y,
+		X:      transform.Position.X,
+		Y:      transform.Position.Y,
 		Width:  0,
 		Height: 0,
 	}
@@ -119,9 +364,9 @@ func (e *Entity) GetID() uint64 {
 	return e.ID
 }
 
-// GetTransform returns the entity's transform.
+// GetTransform returns the entity's world transform (see WorldTransform).
 func (e *Entity) GetTransform() gamemath.Transform {
-	return e.Transform
+	return e.WorldTransform()
 }
 
 // GetCollider returns the entity's collider.
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"time"
+
+	"github.com/dshills/gogame/engine/audio"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	"github.com/dshills/gogame/engine/ui"
+)
+
+// Default screen size of a headless engine, used by scene cameras.
+const (
+	headlessWidth  = 800
+	headlessHeight = 600
+)
+
+// NewHeadlessEngine creates an engine with no window, renderer, or audio
+// device, for tests, CI, and dedicated servers
+//
+// Returns:
+//
+//	*Engine: Engine that runs game logic only
+//
+// Behavior:
+//   - Needs no display and no SDL initialization, and may be created from
+//     any goroutine
+//   - Scenes, physics, timers, coroutines, the blackboard, and the scene
+//     manager behave as in a windowed engine
+//   - Run updates at the fixed 60 per second in real time without drawing;
+//     Step advances an exact number of updates for tests
+//   - Assets load and report texture sizes but have no GPU data; Renderer
+//     returns a renderer that discards draw calls
+//   - Audio is disabled (Audio().Enabled() is false) and input only
+//     changes through the InputManager's Inject methods
+//   - Cameras see an 800x600 screen until SetWindowSize
+//
+// Example:
+//
+//	func TestEnemyReachesGoal(t *testing.T) {
+//	    engine := core.NewHeadlessEngine()
+//	    defer engine.Shutdown()
+//	    engine.SetScene(buildLevel())
+//	    engine.Step(600) // 10 seconds
+//	    if !goalReached {
+//	        t.Error("Expected the enemy to reach the goal")
+//	    }
+//	}
+func NewHeadlessEngine() *Engine {
+	engine := &Engine{
+		renderer:    graphics.NewRecordingRenderer(func(graphics.DrawCall) {}),
+		time:        NewTime(),
+		inputMgr:    input.NewInputManager(),
+		width:       headlessWidth,
+		height:      headlessHeight,
+		windowW:     headlessWidth,
+		windowH:     headlessHeight,
+		assetMgr:    graphics.NewAssetManager(nil),
+		audioMgr:    audio.NewAudioManager(),
+		blackboard:  NewBlackboard(),
+		postProcess: graphics.NewPostProcessor(),
+		profiler:    NewProfiler(),
+		perf:        NewPerfMonitor(),
+		quality:     NewQualityScaler(),
+		debugDraw:   NewDebugDraw(),
+		perfHUD:     NewPerfHUD(),
+		ui:          ui.New(),
+		initialized: true,
+		headless:    true,
+	}
+	engine.scenes = NewSceneManager(engine.SetScene)
+	return engine
+}
+
+// Headless reports whether the engine was created by NewHeadlessEngine.
+func (e *Engine) Headless() bool {
+	return e.headless
+}
+
+// Step advances the game by a number of fixed updates, ignoring the clock
+//
+// Parameters:
+//
+//	frames: Fixed updates to run (1/60 second each)
+//
+// Behavior:
+//   - Each frame uploads finished background loads, routes input to UI
+//     widgets, updates the scene (or its transition), audio, and then
+//     input, like a frame of Run without rendering
+//   - Input injected before Step is seen as pressed in the first frame
+//   - Works on windowed engines too, but window events aren't processed
+//
+// Example:
+//
+//	engine.Input().InjectKey(input.KeySpace, true)
+//	engine.Step(1)
+//	if player.Body.Velocity.Y >= 0 {
+//	    t.Error("Expected the player to jump")
+//	}
+func (e *Engine) Step(frames int) {
+	dt := e.time.DeltaTime()
+	for range frames {
+		e.frame(1, dt)
+	}
+}
+
+// runHeadless is Run without events or rendering, sleeping until each
+// fixed update is due.
+func (e *Engine) runHeadless() error {
+	for e.running.Load() {
+		updateCount, dt := e.time.Tick()
+		e.frame(min(updateCount, maxUpdateSteps), dt)
+		time.Sleep(e.time.untilNextUpdate())
+	}
+	return nil
+}
+
+// frame runs one headless frame of updates.
+func (e *Engine) frame(updateCount int, dt float64) {
+	e.assetMgr.ProcessLoads(maxTextureUploads)
+	transitioning := e.scenes.Transitioning()
+	if e.scene != nil || transitioning {
+		e.ui.Layout(e.width, e.height)
+		e.ui.Update(e.inputMgr)
+		e.update(updateCount, dt, transitioning)
+	}
+	e.audioMgr.Update(dt * float64(updateCount))
+	e.inputMgr.Update()
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"errors"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ErrHierarchyCycle is returned when parenting would make an entity its own ancestor.
+var ErrHierarchyCycle = errors.New("entity cannot be parented to itself or a descendant")
+
+// SetParent attaches the entity to a parent, or detaches it when parent is nil
+//
+// Parameters:
+//
+//	parent: New parent (nil = root entity)
+//
+// Returns:
+//
+//	error: ErrHierarchyCycle if parent is the entity or one of its descendants
+//
+// Behavior:
+//   - Transform becomes local to the parent: the world transform is the
+//     parent's world transform composed with it (see WorldTransform)
+//   - Transform is not adjusted, so the entity jumps if the parent isn't at
+//     the origin; use SetWorldPosition afterwards to keep it in place
+//   - Only the transform is inherited; Active, Layer, and behaviors stay
+//     per entity
+//   - A RigidBody on a child integrates in the parent's local space
+//   - Colliders in the same hierarchy don't collide with each other
+//
+// Example:
+//
+//	turret := &core.Entity{Active: true, Transform: gamemath.Transform{
+//	    Position: gamemath.Vector2{X: 0, Y: -12}, // Nose of the ship
+//	    Scale:    gamemath.Vector2{X: 1, Y: 1},
+//	}}
+//	_ = turret.SetParent(ship)
+func (e *Entity) SetParent(parent *Entity) error {
+	if parent == e || parent != nil && e.isAncestorOf(parent) {
+		return ErrHierarchyCycle
+	}
+	if e.parent != nil {
+		siblings := e.parent.children
+		for i, child := range siblings {
+			if child == e {
+				e.parent.children = append(siblings[:i:i], siblings[i+1:]...)
+				break
+			}
+		}
+	}
+	e.parent = parent
+	if parent != nil {
+		parent.children = append(parent.children, e)
+	}
+	return nil
+}
+
+// AddChild attaches a child to the entity (see SetParent).
+//
+// Children not yet in a scene are added with the parent by Scene.AddEntity;
+// when the parent is already in a scene, add the child to it as well.
+func (e *Entity) AddChild(child *Entity) error {
+	return child.SetParent(e)
+}
+
+// RemoveChild detaches a child, making it a root entity (no-op for non-children).
+func (e *Entity) RemoveChild(child *Entity) {
+	if child.parent == e {
+		_ = child.SetParent(nil) // Detaching can't form a cycle
+	}
+}
+
+// Parent returns the entity's parent (nil for root entities).
+func (e *Entity) Parent() *Entity {
+	return e.parent
+}
+
+// Children returns the entity's direct children in the order attached.
+func (e *Entity) Children() []*Entity {
+	return e.children
+}
+
+// WorldTransform returns the entity's transform in world space
+//
+// Returns:
+//
+//	gamemath.Transform: Transform composed with every ancestor's (equal to
+//	                    Transform for root entities)
+//
+// Behavior:
+//   - Used for rendering, collision detection, and spatial queries
+//   - Behaviors keep reading and writing the local Transform
+func (e *Entity) WorldTransform() gamemath.Transform {
+	if e.parent == nil {
+		return e.Transform
+	}
+	return e.parent.WorldTransform().Compose(e.Transform)
+}
+
+// SetWorldPosition moves the entity so its world position is position.
+func (e *Entity) SetWorldPosition(position gamemath.Vector2) {
+	if e.parent == nil {
+		e.Transform.Position = position
+		return
+	}
+	e.Transform.Position = e.parent.WorldTransform().InverseTransformPoint(position)
+}
+
+// descendants appends the entity's children, grandchildren, and so on.
+func (e *Entity) descendants(list []*Entity) []*Entity {
+	for _, child := range e.children {
+		list = append(list, child)
+		list = child.descendants(list)
+	}
+	return list
+}
+
+// isAncestorOf reports whether other is below the entity in its hierarchy.
+func (e *Entity) isAncestorOf(other *Entity) bool {
+	for ancestor := other.parent; ancestor != nil; ancestor = ancestor.parent {
+		if ancestor == e {
+			return true
+		}
+	}
+	return false
+}
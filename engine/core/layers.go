@@ -0,0 +1,141 @@
+package core
+
+import (
+	"sort"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// SceneLayer is a named render layer with per-layer properties.
+//
+// Entities join a layer by setting Entity.Layer to the layer's Z value.
+// Layers that aren't registered render with default properties.
+type SceneLayer struct {
+	Name        string           // Unique layer name (e.g. "background", "ui")
+	Z           int              // Z-order value stored in Entity.Layer
+	Visible     bool             // Rendered only if true
+	Locked      bool             // Excluded from picking/editing if true
+	Parallax    gamemath.Vector2 // Camera movement factor (1,1 = world, 0,0 = fixed)
+	ScreenSpace bool             // Positions are screen pixels (ignores camera)
+	Batched     bool             // Group sprites by texture and draw each group in one call (see graphics.SpriteBatch)
+}
+
+// AddLayer registers a named layer at a z-order value.
+//
+// Parameters:
+//
+//	name: Layer name used for lookup
+//	z: Z-order value (entities with Layer == z belong to this layer)
+//
+// Returns:
+//
+//	*SceneLayer: The layer, visible with parallax (1,1); modify fields directly
+//
+// Behavior:
+//   - Replaces any existing layer with the same name or Z value
+//
+// Example:
+//
+//	far := scene.AddLayer("mountains", -10)
+//	far.Parallax = gamemath.Vector2{X: 0.3, Y: 0.3}
+//	hud := scene.AddLayer("hud", 100)
+//	hud.ScreenSpace = true
+//	healthBar.Layer = hud.Z
+func (s *Scene) AddLayer(name string, z int) *SceneLayer {
+	if existing := s.Layer(name); existing != nil {
+		delete(s.layers, existing.Z)
+	}
+
+	layer := &SceneLayer{
+		Name:     name,
+		Z:        z,
+		Visible:  true,
+		Parallax: gamemath.Vector2{X: 1, Y: 1},
+	}
+	s.layers[z] = layer
+	return layer
+}
+
+// Layer returns a registered layer by name, or nil.
+func (s *Scene) Layer(name string) *SceneLayer {
+	for _, layer := range s.layers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// LayerAt returns the layer registered at a z-order value, or nil.
+func (s *Scene) LayerAt(z int) *SceneLayer {
+	return s.layers[z]
+}
+
+// Layers returns registered layers in ascending z-order.
+func (s *Scene) Layers() []*SceneLayer {
+	result := make([]*SceneLayer, 0, len(s.layers))
+	for _, layer := range s.layers {
+		result = append(result, layer)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Z < result[j].Z })
+	return result
+}
+
+// LayerPickable reports whether entities at a z-order value may be picked or edited.
+func (s *Scene) LayerPickable(z int) bool {
+	layer := s.layers[z]
+	return layer == nil || (layer.Visible && !layer.Locked)
+}
+
+// LayerCamera returns the camera used to render a z-order value.
+//
+// Returns:
+//
+//	*graphics.Camera: The scene camera for world layers, or a derived camera
+//	for parallax and screen-space layers
+func (s *Scene) LayerCamera(z int) *graphics.Camera {
+	return s.layerCamera(s.camera, z)
+}
+
+// layerCamera derives a layer's camera from any scene camera.
+func (s *Scene) layerCamera(base *graphics.Camera, z int) *graphics.Camera {
+	layer := s.layers[z]
+	if layer == nil {
+		return base
+	}
+
+	switch {
+	case layer.ScreenSpace:
+		camera := *base
+		width, height := base.ScreenSize()
+		camera.Position = gamemath.Vector2{X: float64(width) / 2, Y: float64(height) / 2}
+		camera.Zoom = 1
+		camera.Bounds = gamemath.Rectangle{}
+		camera.StopShake() // HUDs stay steady
+		return &camera
+	case layer.Parallax.X != 1 || layer.Parallax.Y != 1:
+		camera := *base
+		view := base.ViewPosition() // Includes shake and bounds
+		camera.Position = gamemath.Vector2{
+			X: view.X * layer.Parallax.X,
+			Y: view.Y * layer.Parallax.Y,
+		}
+		camera.Bounds = gamemath.Rectangle{}
+		camera.StopShake()
+		return &camera
+	}
+	return base
+}
+
+// layerVisible reports whether a z-order value should render.
+func (s *Scene) layerVisible(z int) bool {
+	layer := s.layers[z]
+	return layer == nil || layer.Visible
+}
+
+// layerBatched reports whether sprites at a z-order value are batched.
+func (s *Scene) layerBatched(z int) bool {
+	layer := s.layers[z]
+	return layer != nil && layer.Batched
+}
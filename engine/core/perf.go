@@ -0,0 +1,212 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// PerfWarningKind identifies what a performance warning is about.
+type PerfWarningKind int
+
+const (
+	// PerfFrameBudget: frame work exceeded the budget for several frames in a row.
+	PerfFrameBudget PerfWarningKind = iota
+	// PerfCollisionPairs: a step produced more collision pairs than allowed.
+	PerfCollisionPairs
+	// PerfSlowBehavior: a sampled behavior update exceeded its budget.
+	PerfSlowBehavior
+)
+
+// String returns the kind name.
+func (k PerfWarningKind) String() string {
+	switch k {
+	case PerfFrameBudget:
+		return "frame_budget"
+	case PerfCollisionPairs:
+		return "collision_pairs"
+	case PerfSlowBehavior:
+		return "slow_behavior"
+	}
+	return "unknown"
+}
+
+// PerfWarning is a structured performance warning.
+type PerfWarning struct {
+	Kind     PerfWarningKind
+	Message  string        // Human-readable summary
+	Duration time.Duration // Frame or behavior time that triggered the warning
+	Budget   time.Duration // Budget that was exceeded
+	Frames   int           // Consecutive frames over budget (PerfFrameBudget)
+	Count    int           // Collision pairs (PerfCollisionPairs)
+	Culprit  string        // Behavior Go type, e.g. "*main.Pathfinder" (PerfSlowBehavior)
+	EntityID uint64        // Entity whose behavior was slow (PerfSlowBehavior)
+}
+
+// maxRecentWarnings bounds the history returned by PerfMonitor.Warnings.
+const maxRecentWarnings = 32
+
+// PerfMonitor watches frame time, collision pair counts, and behavior cost
+// and emits a warning when a limit is crossed.
+//
+// Each condition warns once when it starts and re-arms after it clears, so
+// a sustained slowdown produces one warning rather than one per frame.
+// Behavior updates are timed only on every SampleEvery-th scene update to
+// keep the overhead negligible.
+type PerfMonitor struct {
+	Enabled           bool
+	FrameBudget       time.Duration // Update+render time allowed per frame (vsync wait excluded)
+	BudgetFrames      int           // Consecutive frames over budget before warning
+	MaxCollisionPairs int           // Collision pairs per step before warning (0 = unchecked)
+	BehaviorBudget    time.Duration // Time one behavior's Update may take (0 = unchecked)
+	SampleEvery       int           // Time behaviors every N scene updates
+	OnWarning         func(warning PerfWarning)
+
+	overBudget    int
+	frameWarned   bool
+	pairsWarned   bool
+	slowBehaviors map[string]bool // Types already reported (re-armed when fast again)
+	updates       int
+	recent        []PerfWarning
+}
+
+// NewPerfMonitor creates a monitor for a 60 FPS target.
+//
+// Returns:
+//
+//	*PerfMonitor: Enabled with a 16.7ms frame budget over 30 frames,
+//	              2000 collision pairs, and a 2ms behavior budget sampled
+//	              every 30 updates
+func NewPerfMonitor() *PerfMonitor {
+	return &PerfMonitor{
+		Enabled:           true,
+		FrameBudget:       time.Second / 60,
+		BudgetFrames:      30,
+		MaxCollisionPairs: 2000,
+		BehaviorBudget:    2 * time.Millisecond,
+		SampleEvery:       30,
+		slowBehaviors:     make(map[string]bool),
+	}
+}
+
+// Warnings returns recent warnings, oldest first.
+func (m *PerfMonitor) Warnings() []PerfWarning {
+	return m.recent
+}
+
+// RecordFrame checks one frame's work time against FrameBudget.
+//
+// Parameters:
+//
+//	work: Update and render time for the frame
+func (m *PerfMonitor) RecordFrame(work time.Duration) {
+	if !m.Enabled || m.FrameBudget <= 0 {
+		return
+	}
+	if work <= m.FrameBudget {
+		m.overBudget = 0
+		m.frameWarned = false
+		return
+	}
+	m.overBudget++
+	if m.overBudget >= m.BudgetFrames && !m.frameWarned {
+		m.frameWarned = true
+		m.emit(PerfWarning{
+			Kind:     PerfFrameBudget,
+			Message:  fmt.Sprintf("frame time %v over %v budget for %d frames", work, m.FrameBudget, m.overBudget),
+			Duration: work,
+			Budget:   m.FrameBudget,
+			Frames:   m.overBudget,
+		})
+	}
+}
+
+// RecordCollisionPairs checks one step's collision pair count.
+func (m *PerfMonitor) RecordCollisionPairs(pairs int) {
+	if !m.Enabled || m.MaxCollisionPairs <= 0 {
+		return
+	}
+	if pairs <= m.MaxCollisionPairs {
+		m.pairsWarned = false
+		return
+	}
+	if !m.pairsWarned {
+		m.pairsWarned = true
+		m.emit(PerfWarning{
+			Kind:    PerfCollisionPairs,
+			Message: fmt.Sprintf("%d collision pairs (limit %d)", pairs, m.MaxCollisionPairs),
+			Count:   pairs,
+		})
+	}
+}
+
+// RecordBehavior checks one sampled behavior update.
+//
+// Parameters:
+//
+//	behavior: Behavior that ran (its Go type names the culprit)
+//	entityID: Entity it belongs to
+//	elapsed: Time its Update took
+func (m *PerfMonitor) RecordBehavior(behavior Behavior, entityID uint64, elapsed time.Duration) {
+	if !m.Enabled || m.BehaviorBudget <= 0 {
+		return
+	}
+	name := fmt.Sprintf("%T", behavior)
+	if elapsed <= m.BehaviorBudget {
+		delete(m.slowBehaviors, name)
+		return
+	}
+	if m.slowBehaviors[name] {
+		return
+	}
+	if m.slowBehaviors == nil {
+		m.slowBehaviors = make(map[string]bool)
+	}
+	m.slowBehaviors[name] = true
+	m.emit(PerfWarning{
+		Kind:     PerfSlowBehavior,
+		Message:  fmt.Sprintf("%s.Update took %v on entity %d (budget %v)", name, elapsed, entityID, m.BehaviorBudget),
+		Duration: elapsed,
+		Budget:   m.BehaviorBudget,
+		Culprit:  name,
+		EntityID: entityID,
+	})
+}
+
+// sampling reports whether this scene update should time behaviors.
+func (m *PerfMonitor) sampling() bool {
+	if !m.Enabled || m.BehaviorBudget <= 0 {
+		return false
+	}
+	m.updates++
+	return m.SampleEvery <= 1 || m.updates%m.SampleEvery == 0
+}
+
+// emit records a warning and passes it to OnWarning.
+func (m *PerfMonitor) emit(warning PerfWarning) {
+	if len(m.recent) == maxRecentWarnings {
+		m.recent = append(m.recent[:0], m.recent[1:]...)
+	}
+	m.recent = append(m.recent, warning)
+	if m.OnWarning != nil {
+		m.OnWarning(warning)
+	}
+}
+
+// updateSampled runs an entity's behaviors, timing each one.
+func (m *PerfMonitor) updateSampled(entity *Entity, dt float64) {
+	if behaviors, ok := entity.Behavior.(Behaviors); ok {
+		for _, behavior := range behaviors {
+			if behavior != nil {
+				start := time.Now()
+				behavior.Update(entity, dt)
+				m.RecordBehavior(behavior, entity.ID, time.Since(start))
+			}
+		}
+		return
+	}
+	if entity.Behavior != nil {
+		start := time.Now()
+		entity.Behavior.Update(entity, dt)
+		m.RecordBehavior(entity.Behavior, entity.ID, time.Since(start))
+	}
+}
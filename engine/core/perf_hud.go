@@ -0,0 +1,162 @@
+package core
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// PerfStats is one snapshot of the numbers shown by the performance HUD.
+type PerfStats struct {
+	FPS            float64
+	FrameMin       float64 // Fastest frame in seconds
+	FrameMax       float64 // Slowest frame in seconds
+	FrameAvg       float64 // Rolling average frame time in seconds
+	Entities       int
+	CollisionPairs int // Contacts in the last step
+	DrawCalls      int // Draw calls in the last frame
+	HeapBytes      uint64
+	NumGC          uint32
+	LastGCPause    time.Duration
+}
+
+// PerfHUD is an on-screen diagnostics panel drawn over everything else.
+//
+// Memory statistics are read every GCInterval rather than every frame,
+// since reading them briefly stops the world.
+type PerfHUD struct {
+	Enabled    bool             // Draw the panel
+	ToggleKey  input.KeyCode    // Key that flips Enabled at runtime (KeyNone = no key)
+	Font       *graphics.Font   // Panel text (nil = nothing drawn)
+	Position   gamemath.Vector2 // Top-left corner in screen pixels
+	Color      gamemath.Color   // Text color
+	Background gamemath.Color   // Panel color
+	GCInterval time.Duration    // Time between memory statistic reads
+
+	stats   PerfStats
+	lastGC  time.Time
+	lines   []*graphics.TextSprite // One cached text per line
+	camera  *graphics.Camera       // Screen-space camera for the text
+	lineGap float64
+}
+
+// NewPerfHUD creates a hidden HUD in the top-left corner toggled with F2.
+func NewPerfHUD() *PerfHUD {
+	return &PerfHUD{
+		ToggleKey:  input.KeyF2,
+		Position:   gamemath.Vector2{X: 8, Y: 8},
+		Color:      gamemath.Color{R: 230, G: 255, B: 230, A: 255},
+		Background: gamemath.Color{R: 0, G: 0, B: 0, A: 170},
+		GCInterval: 500 * time.Millisecond,
+		camera:     graphics.NewCamera(),
+		lineGap:    2,
+	}
+}
+
+// HandleInput flips Enabled when ToggleKey was pressed this frame.
+func (h *PerfHUD) HandleInput(inputMgr *input.InputManager) {
+	if h.ToggleKey != input.KeyNone && inputMgr.KeyPressed(h.ToggleKey) {
+		h.Enabled = !h.Enabled
+	}
+}
+
+// Record stores a frame's statistics, filling in memory statistics when
+// GCInterval has passed (otherwise the previous values are kept).
+func (h *PerfHUD) Record(stats PerfStats) {
+	if now := time.Now(); now.Sub(h.lastGC) >= h.GCInterval {
+		h.lastGC = now
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+		h.stats.HeapBytes = mem.HeapAlloc
+		h.stats.NumGC = mem.NumGC
+		if mem.NumGC > 0 {
+			h.stats.LastGCPause = time.Duration(mem.PauseNs[(mem.NumGC+255)%256])
+		}
+	}
+	stats.HeapBytes, stats.NumGC, stats.LastGCPause = h.stats.HeapBytes, h.stats.NumGC, h.stats.LastGCPause
+	h.stats = stats
+}
+
+// Stats returns the last recorded statistics.
+func (h *PerfHUD) Stats() PerfStats {
+	return h.stats
+}
+
+// Lines returns the panel text, one entry per line.
+func (h *PerfHUD) Lines() []string {
+	s := h.stats
+	return []string{
+		fmt.Sprintf("FPS %.0f", s.FPS),
+		fmt.Sprintf("Frame %.1f / %.1f / %.1f ms (min/avg/max)", s.FrameMin*1000, s.FrameAvg*1000, s.FrameMax*1000),
+		fmt.Sprintf("Entities %d  Pairs %d  Draws %d", s.Entities, s.CollisionPairs, s.DrawCalls),
+		fmt.Sprintf("Heap %.1f MB  GC %d  Pause %v", float64(s.HeapBytes)/(1<<20), s.NumGC, s.LastGCPause.Round(time.Microsecond)),
+	}
+}
+
+// Draw draws the panel if enabled and a font is set.
+//
+// Parameters:
+//
+//	renderer: Renderer to draw with
+//	width, height: Screen size in pixels
+//
+// Returns:
+//
+//	error: Non-nil if drawing fails
+func (h *PerfHUD) Draw(renderer *graphics.Renderer, width, height int) error {
+	if !h.Enabled || h.Font == nil {
+		return nil
+	}
+	h.camera.SetScreenSize(width, height)
+	h.camera.Position = gamemath.Vector2{X: float64(width) / 2, Y: float64(height) / 2}
+
+	lines := h.Lines()
+	for len(h.lines) < len(lines) {
+		text := graphics.NewTextSprite("", h.Font)
+		text.Anchor = gamemath.Vector2{}
+		h.lines = append(h.lines, text)
+	}
+
+	lineHeight, panelWidth := 0.0, 0.0
+	for i, line := range lines {
+		w, lh, err := h.Font.MeasureText(line)
+		if err != nil {
+			return fmt.Errorf("failed to measure perf HUD text: %w", err)
+		}
+		panelWidth = max(panelWidth, float64(w))
+		lineHeight = max(lineHeight, float64(lh))
+		h.lines[i].Text, h.lines[i].Font, h.lines[i].Color = line, h.Font, h.Color
+	}
+	step := lineHeight + h.lineGap
+	panel := gamemath.Rectangle{
+		X:      h.Position.X - 4,
+		Y:      h.Position.Y - 4,
+		Width:  panelWidth + 8,
+		Height: step*float64(len(lines)) + 8 - h.lineGap,
+	}
+	if err := renderer.FillRect(panel, h.Background); err != nil {
+		return err
+	}
+	for i := range lines {
+		transform := gamemath.Transform{
+			Position: gamemath.Vector2{X: h.Position.X, Y: h.Position.Y + step*float64(i)},
+			Scale:    gamemath.Vector2{X: 1, Y: 1},
+		}
+		if err := renderer.DrawTextSprite(h.lines[i], transform, h.camera); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Destroy releases cached text textures.
+func (h *PerfHUD) Destroy() {
+	for _, line := range h.lines {
+		line.Destroy()
+	}
+	h.lines = nil
+}
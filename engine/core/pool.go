@@ -0,0 +1,135 @@
+package core
+
+// EntityPool recycles entities that are spawned and destroyed often, such
+// as bullets, particles, and pickups.
+//
+// Pooled entities stay in the scene's entity list while released (inactive),
+// so reusing one reactivates it in place: no allocation, no ID change, and
+// no churn of the scene's slices. Scene.RemoveEntity on a pooled entity
+// releases it instead of removing it, so generic "destroy on hit" code works
+// with pooled and ordinary entities alike.
+type EntityPool struct {
+	New   func() *Entity       // Creates an entity when none is free (required)
+	Reset func(entity *Entity) // Prepares an entity for reuse, before it is activated (optional)
+	Limit int                  // Entities the pool may create (0 = unlimited)
+
+	scene   *Scene
+	free    []*Entity // Released entities, most recent last
+	created int
+}
+
+// NewEntityPool creates a pool of entities in a scene
+//
+// Parameters:
+//
+//	scene: Scene the pooled entities live in
+//	newEntity: Factory for new entities (Active is set by Acquire)
+//
+// Example:
+//
+//	bullets := core.NewEntityPool(scene, func() *core.Entity {
+//	    return &core.Entity{Sprite: graphics.NewSprite(bulletTexture), Body: physics.NewRigidBody(0.1)}
+//	})
+//	bullets.Reset = func(b *core.Entity) { b.Body.Velocity = gamemath.Vector2{} }
+//	bullets.Prewarm(64)
+//
+//	bullet := bullets.Acquire()
+//	bullet.Transform.Position = muzzle
+//	bullet.Body.Velocity = aim.Scale(600)
+//	// Later, on hit or when off-screen:
+//	scene.RemoveEntity(bullet.ID) // Returns it to the pool
+func NewEntityPool(scene *Scene, newEntity func() *Entity) *EntityPool {
+	return &EntityPool{New: newEntity, scene: scene}
+}
+
+// Prewarm creates inactive entities up front (e.g. during level load) until
+// n are free, so the first spawns don't allocate.
+func (p *EntityPool) Prewarm(n int) {
+	for len(p.free) < n && (p.Limit <= 0 || p.created < p.Limit) {
+		entity := p.create()
+		entity.Active = false
+		entity.pooled = true
+		p.free = append(p.free, entity)
+	}
+}
+
+// Acquire returns an active entity from the pool
+//
+// Returns:
+//
+//	*Entity: A released entity reactivated in place, or a new one added to
+//	         the scene; nil if Limit entities are all in use
+//
+// Behavior:
+//   - Reset (if set) runs before the entity is activated
+func (p *EntityPool) Acquire() *Entity {
+	var entity *Entity
+	if n := len(p.free); n > 0 {
+		entity = p.free[n-1]
+		p.free[n-1] = nil
+		p.free = p.free[:n-1]
+	} else if p.Limit <= 0 || p.created < p.Limit {
+		entity = p.create()
+	} else {
+		return nil
+	}
+	entity.pooled = false
+	entity.skippedDT = 0
+	if p.Reset != nil {
+		p.Reset(entity)
+	}
+	entity.Active = true
+	return entity
+}
+
+// create makes a new pooled entity and adds it to the scene.
+func (p *EntityPool) create() *Entity {
+	entity := p.New()
+	entity.pool = p
+	p.scene.AddEntity(entity)
+	p.created++
+	return entity
+}
+
+// Release deactivates an entity and returns it to the pool
+//
+// Behavior:
+//   - Safe to call during Update: the entity stops updating, colliding,
+//     and rendering immediately
+//   - Scheduler tasks tied to the entity (Scheduler.For) are cancelled
+//   - No-op for entities from other pools or already released
+func (p *EntityPool) Release(entity *Entity) {
+	if entity == nil || entity.pool != p || entity.pooled {
+		return
+	}
+	entity.Active = false
+	entity.pooled = true
+	if p.scene.scheduler != nil {
+		p.scene.scheduler.cancelOwned(entity)
+	}
+	p.free = append(p.free, entity)
+}
+
+// Trim removes released entities from the scene until at most keep are
+// free, giving back memory after a burst (e.g. a boss fight).
+func (p *EntityPool) Trim(keep int) {
+	for len(p.free) > max(keep, 0) {
+		n := len(p.free)
+		entity := p.free[n-1]
+		p.free[n-1] = nil
+		p.free = p.free[:n-1]
+		entity.pool, entity.pooled = nil, false
+		p.created--
+		p.scene.RemoveEntity(entity.ID)
+	}
+}
+
+// InUse returns the number of acquired entities.
+func (p *EntityPool) InUse() int {
+	return p.created - len(p.free)
+}
+
+// Free returns the number of released entities waiting for reuse.
+func (p *EntityPool) Free() int {
+	return len(p.free)
+}
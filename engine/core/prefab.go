@@ -0,0 +1,131 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Prefab is an entity template that can be instantiated many times.
+//
+// The template uses the scene file's entity format (its ID is ignored), so a
+// prefab can be cut from a saved level or written by hand. Registered
+// behaviors (see RegisterBehavior) are part of the template; others are code
+// and are attached by OnInstantiate, which can read tuning values from
+// Properties (see PrefabVariant for overriding them).
+type Prefab struct {
+	Version    int                `json:"version"`
+	Name       string             `json:"name"`
+	Entity     EntityData         `json:"entity"`
+	Properties map[string]float64 `json:"properties,omitempty"` // Tuning values for behaviors (speed, health)
+
+	// OnInstantiate is called for each new instance before it is added to
+	// the scene (attach behaviors and callbacks here)
+	OnInstantiate func(entity *Entity) `json:"-"`
+}
+
+// NewPrefab captures an entity as a template.
+//
+// Parameters:
+//
+//	name: Prefab name (informational)
+//	entity: Entity to copy (its ID and position are kept in the template;
+//	        Instantiate replaces both)
+//
+// Example:
+//
+//	prefab := core.NewPrefab("crate", crate)
+//	file, _ := os.Create("prefabs/crate.json")
+//	defer file.Close()
+//	_ = prefab.Save(file)
+func NewPrefab(name string, entity *Entity) *Prefab {
+	data := entityData(entity)
+	data.ID = 0
+	data.Parent = 0
+	return &Prefab{Version: SceneFormatVersion, Name: name, Entity: data}
+}
+
+// LoadPrefab reads a prefab written by Prefab.Save.
+//
+// Returns:
+//
+//	*Prefab: Loaded template
+//	error: Non-nil for malformed JSON or a newer version
+func LoadPrefab(r io.Reader) (*Prefab, error) {
+	var prefab Prefab
+	if err := json.NewDecoder(r).Decode(&prefab); err != nil {
+		return nil, fmt.Errorf("failed to decode prefab: %w", err)
+	}
+	if prefab.Version > SceneFormatVersion {
+		return nil, fmt.Errorf("%w: version %d (supported: %d)", ErrSceneVersion, prefab.Version, SceneFormatVersion)
+	}
+	return &prefab, nil
+}
+
+// Save writes the prefab as indented JSON.
+func (p *Prefab) Save(w io.Writer) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode prefab: %w", err)
+	}
+	data = append(data, '\n')
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write prefab: %w", err)
+	}
+	return nil
+}
+
+// Instantiate creates a new entity from the template and adds it to a scene
+//
+// Parameters:
+//
+//	scene: Scene to add the instance to
+//	position: World position of the instance
+//	assets: Asset manager for the sprite texture (nil = placeholder texture)
+//
+// Returns:
+//
+//	*Entity: New instance with a fresh ID and its own copies of every component
+//	error: Non-nil if the sprite texture or body type fails to load
+//
+// Example:
+//
+//	bullet, _ := core.LoadPrefab(file)
+//	bullet.OnInstantiate = func(e *core.Entity) { e.Behavior = &BulletBehavior{} }
+//	for _, muzzle := range muzzles {
+//	    _, _ = bullet.Instantiate(scene, muzzle, engine.Assets())
+//	}
+func (p *Prefab) Instantiate(scene *Scene, position gamemath.Vector2, assets *graphics.AssetManager) (*Entity, error) {
+	entity, err := newEntityFromData(p.Entity, assets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate prefab %q: %w", p.Name, err)
+	}
+	entity.Transform.Position = position
+	entity.prefab = p
+	if p.OnInstantiate != nil {
+		p.OnInstantiate(entity)
+	}
+	scene.AddEntity(entity)
+	return entity, nil
+}
+
+// Property returns a tuning value, or fallback if the prefab (or a nil
+// prefab) doesn't set it
+//
+// Example:
+//
+//	enemy.OnInstantiate = func(e *core.Entity) {
+//	    e.Behavior = &Chase{Speed: e.Prefab().Property("speed", 80)}
+//	}
+func (p *Prefab) Property(name string, fallback float64) float64 {
+	if p == nil {
+		return fallback
+	}
+	if value, ok := p.Properties[name]; ok {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,217 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ErrPrefabBase is returned when a variant is resolved against the wrong
+// base prefab, or has none.
+var ErrPrefabBase = errors.New("prefab variant base mismatch")
+
+// PrefabVariant is a prefab defined as changes to a base prefab, so a
+// "fast red enemy" only lists what differs from "enemy".
+//
+// Overrides use the prefab entity format: each key replaces the base
+// entity's field, and nested objects merge, so {"sprite": {"color": ...}}
+// changes the tint and keeps the texture. Properties replace or add
+// tuning values.
+//
+// Example file:
+//
+//	{
+//	  "version": 1,
+//	  "name": "fast_red_enemy",
+//	  "base": "enemy",
+//	  "overrides": {
+//	    "scale_x": 1.25, "scale_y": 1.25,
+//	    "sprite": {"color": {"r": 255, "g": 60, "b": 60, "a": 255}}
+//	  },
+//	  "properties": {"speed": 240}
+//	}
+type PrefabVariant struct {
+	Version    int                `json:"version"`
+	Name       string             `json:"name"`
+	Base       string             `json:"base"`                 // Name of the base prefab
+	Overrides  map[string]any     `json:"overrides,omitempty"`  // Entity fields replacing the base's
+	Properties map[string]float64 `json:"properties,omitempty"` // Tuning values replacing or adding to the base's
+}
+
+// NewPrefabVariant creates a variant of a base prefab with no changes yet.
+//
+// Example:
+//
+//	fastRed := core.NewPrefabVariant("fast_red_enemy", enemy).
+//	    Override("sprite.color", gamemath.Color{R: 255, G: 60, B: 60, A: 255}).
+//	    SetProperty("speed", 240)
+//	prefab, err := fastRed.Resolve(enemy)
+func NewPrefabVariant(name string, base *Prefab) *PrefabVariant {
+	return &PrefabVariant{Version: SceneFormatVersion, Name: name, Base: base.Name}
+}
+
+// LoadPrefabVariant reads a variant written by PrefabVariant.Save.
+//
+// Returns:
+//
+//	*PrefabVariant: Loaded variant (resolve it against its base to use it)
+//	error: Non-nil for malformed JSON, a newer version, or a missing base
+func LoadPrefabVariant(r io.Reader) (*PrefabVariant, error) {
+	var variant PrefabVariant
+	if err := json.NewDecoder(r).Decode(&variant); err != nil {
+		return nil, fmt.Errorf("failed to decode prefab variant: %w", err)
+	}
+	if variant.Version > SceneFormatVersion {
+		return nil, fmt.Errorf("%w: version %d (supported: %d)", ErrSceneVersion, variant.Version, SceneFormatVersion)
+	}
+	if variant.Base == "" {
+		return nil, fmt.Errorf("%w: variant %q names no base", ErrPrefabBase, variant.Name)
+	}
+	return &variant, nil
+}
+
+// Save writes the variant as indented JSON.
+func (v *PrefabVariant) Save(w io.Writer) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode prefab variant: %w", err)
+	}
+	data = append(data, '\n')
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write prefab variant: %w", err)
+	}
+	return nil
+}
+
+// Override sets an entity field by its dotted path in the prefab entity
+// format ("layer", "scale_x", "sprite.color", "body.velocity_x") and
+// returns the variant for chaining.
+//
+// Values are stored as given and converted to JSON by Resolve, so colors
+// can be passed as gamemath.Color or ColorData.
+func (v *PrefabVariant) Override(path string, value any) *PrefabVariant {
+	if v.Overrides == nil {
+		v.Overrides = make(map[string]any)
+	}
+	keys := strings.Split(path, ".")
+	fields := v.Overrides
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := fields[key].(map[string]any)
+		if !ok {
+			nested = make(map[string]any)
+			fields[key] = nested
+		}
+		fields = nested
+	}
+	if color, ok := value.(gamemath.Color); ok {
+		value = colorData(color)
+	}
+	fields[keys[len(keys)-1]] = value
+	return v
+}
+
+// SetProperty sets a tuning value and returns the variant for chaining.
+func (v *PrefabVariant) SetProperty(name string, value float64) *PrefabVariant {
+	if v.Properties == nil {
+		v.Properties = make(map[string]float64)
+	}
+	v.Properties[name] = value
+	return v
+}
+
+// Resolve applies the variant to its base prefab
+//
+// Parameters:
+//
+//	base: Prefab named by Base (a resolved variant can be the base of
+//	      another variant)
+//
+// Returns:
+//
+//	*Prefab: New prefab named after the variant, sharing the base's
+//	         OnInstantiate; the base is not modified
+//	error: ErrPrefabBase if base isn't the variant's base, or an error if
+//	       an override doesn't fit its field
+//
+// Behavior:
+//   - Resolve again after changing the base; the result is a snapshot
+//   - OnInstantiate sees the variant's properties through
+//     entity.Prefab().Property
+func (v *PrefabVariant) Resolve(base *Prefab) (*Prefab, error) {
+	if base == nil || base.Name != v.Base {
+		return nil, fmt.Errorf("%w: variant %q needs base %q", ErrPrefabBase, v.Name, v.Base)
+	}
+
+	entity, err := mergeEntityData(base.Entity, v.Overrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve prefab variant %q: %w", v.Name, err)
+	}
+	properties := maps.Clone(base.Properties)
+	if len(v.Properties) > 0 && properties == nil {
+		properties = make(map[string]float64, len(v.Properties))
+	}
+	maps.Copy(properties, v.Properties)
+
+	return &Prefab{
+		Version:       base.Version,
+		Name:          v.Name,
+		Entity:        entity,
+		Properties:    properties,
+		OnInstantiate: base.OnInstantiate,
+	}, nil
+}
+
+// mergeEntityData applies JSON overrides to a deep copy of entity data,
+// merging nested objects.
+func mergeEntityData(data EntityData, overrides map[string]any) (EntityData, error) {
+	fields, err := jsonObject(data)
+	if err != nil {
+		return EntityData{}, err
+	}
+	changes, err := jsonObject(overrides)
+	if err != nil {
+		return EntityData{}, err
+	}
+	mergeObjects(fields, changes)
+
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return EntityData{}, err
+	}
+	var merged EntityData
+	if err := json.Unmarshal(encoded, &merged); err != nil {
+		return EntityData{}, err
+	}
+	return merged, nil
+}
+
+// jsonObject converts a value to generic JSON object form.
+func jsonObject(value any) (map[string]any, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]any
+	if err := json.Unmarshal(encoded, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// mergeObjects copies changes into fields, merging objects present in both.
+func mergeObjects(fields, changes map[string]any) {
+	for key, change := range changes {
+		nested, isObject := change.(map[string]any)
+		existing, hasObject := fields[key].(map[string]any)
+		if isObject && hasObject {
+			mergeObjects(existing, nested)
+			continue
+		}
+		fields[key] = change
+	}
+}
@@ -0,0 +1,81 @@
+package core
+
+import "time"
+
+// ProfileSample is the timing summary for one named section.
+type ProfileSample struct {
+	Name  string        // Section name
+	Last  time.Duration // Most recent duration
+	Avg   time.Duration // Exponential moving average (alpha 0.1)
+	Max   time.Duration // Worst duration since the last Reset
+	Calls int           // Number of recorded runs
+}
+
+// Profiler records per-section timings on the game thread.
+//
+// The engine records "update", "render", and "present" each frame; games can
+// add their own sections. Not safe for concurrent use.
+type Profiler struct {
+	sections map[string]*ProfileSample
+	order    []string
+}
+
+// NewProfiler creates an empty profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{
+		sections: make(map[string]*ProfileSample),
+		order:    make([]string, 0),
+	}
+}
+
+// Begin starts timing a section and returns the function that ends it.
+//
+// Example:
+//
+//	defer engine.Profiler().Begin("pathfinding")()
+func (p *Profiler) Begin(name string) func() {
+	start := time.Now()
+	return func() {
+		p.Record(name, time.Since(start))
+	}
+}
+
+// Record adds a measured duration to a section.
+func (p *Profiler) Record(name string, duration time.Duration) {
+	sample, ok := p.sections[name]
+	if !ok {
+		sample = &ProfileSample{Name: name, Avg: duration}
+		p.sections[name] = sample
+		p.order = append(p.order, name)
+	}
+	sample.Last = duration
+	sample.Avg += (duration - sample.Avg) / 10
+	if duration > sample.Max {
+		sample.Max = duration
+	}
+	sample.Calls++
+}
+
+// Sample returns one section's summary.
+func (p *Profiler) Sample(name string) (ProfileSample, bool) {
+	sample, ok := p.sections[name]
+	if !ok {
+		return ProfileSample{}, false
+	}
+	return *sample, true
+}
+
+// Samples returns all sections in the order they were first recorded.
+func (p *Profiler) Samples() []ProfileSample {
+	samples := make([]ProfileSample, 0, len(p.order))
+	for _, name := range p.order {
+		samples = append(samples, *p.sections[name])
+	}
+	return samples
+}
+
+// Reset clears all recorded sections.
+func (p *Profiler) Reset() {
+	p.sections = make(map[string]*ProfileSample)
+	p.order = p.order[:0]
+}
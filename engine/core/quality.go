@@ -0,0 +1,164 @@
+package core
+
+import "time"
+
+// QualityLevel is a rendering and simulation detail level.
+type QualityLevel int
+
+const (
+	// QualityLow is the cheapest level for hardware that can't hold the budget.
+	QualityLow QualityLevel = iota
+	// QualityMedium drops the most expensive effects.
+	QualityMedium
+	// QualityHigh is full detail.
+	QualityHigh
+)
+
+// String returns the level name.
+func (l QualityLevel) String() string {
+	switch l {
+	case QualityLow:
+		return "low"
+	case QualityMedium:
+		return "medium"
+	case QualityHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+// QualitySettings is what a quality level turns on or off.
+type QualitySettings struct {
+	PostProcessing    bool    // Apply Engine.PostProcess effects
+	ParticleScale     float64 // Fraction of particles games should spawn (read by particle systems)
+	FarUpdateDistance float64 // Entities farther than this from the camera update less often (0 = off)
+	FarUpdateEvery    int     // Far entities update every N steps with the accumulated dt
+}
+
+// QualityScaler lowers detail when frames run over budget and raises it
+// again once there is headroom.
+//
+// Frame work time is smoothed, so a single slow frame (level load, GC)
+// doesn't change the level. Downgrades need DowngradeFrames smoothed frames
+// over FrameBudget; upgrades need UpgradeFrames frames under
+// UpgradeHeadroom of the budget, so the level doesn't flip back and forth
+// at the edge. A user-chosen level (SetOverride) always wins.
+type QualityScaler struct {
+	Enabled         bool              // Adjust automatically (false = stay at the current level)
+	FrameBudget     time.Duration     // Update+render time allowed per frame
+	DowngradeFrames int               // Smoothed frames over budget before lowering
+	UpgradeFrames   int               // Smoothed frames with headroom before raising
+	UpgradeHeadroom float64           // Fraction of the budget a frame must stay under to count toward upgrading
+	Settings        []QualitySettings // Settings per level, indexed by QualityLevel
+	OnChange        func(level QualityLevel, settings QualitySettings)
+
+	level      QualityLevel
+	override   bool
+	average    float64 // Smoothed frame work in seconds
+	overFrames int
+	easyFrames int
+}
+
+// NewQualityScaler creates an enabled scaler at QualityHigh for a 60 FPS target.
+//
+// Returns:
+//
+//	*QualityScaler: Lowers detail after 1 second over a 16.7ms budget and
+//	                raises it after 5 seconds under 60% of it
+//
+// Default levels:
+//
+//	High:   post-processing, all particles, every entity at full rate
+//	Medium: no post-processing, half particles, entities beyond 1200 units
+//	        update every 2nd step
+//	Low:    no post-processing, quarter particles, entities beyond 700 units
+//	        update every 4th step
+func NewQualityScaler() *QualityScaler {
+	return &QualityScaler{
+		Enabled:         true,
+		FrameBudget:     time.Second / 60,
+		DowngradeFrames: 60,
+		UpgradeFrames:   300,
+		UpgradeHeadroom: 0.6,
+		Settings: []QualitySettings{
+			QualityLow:    {PostProcessing: false, ParticleScale: 0.25, FarUpdateDistance: 700, FarUpdateEvery: 4},
+			QualityMedium: {PostProcessing: false, ParticleScale: 0.5, FarUpdateDistance: 1200, FarUpdateEvery: 2},
+			QualityHigh:   {PostProcessing: true, ParticleScale: 1, FarUpdateEvery: 1},
+		},
+		level: QualityHigh,
+	}
+}
+
+// Level returns the current quality level.
+func (q *QualityScaler) Level() QualityLevel {
+	return q.level
+}
+
+// Current returns the settings for the current level.
+func (q *QualityScaler) Current() QualitySettings {
+	if int(q.level) < 0 || int(q.level) >= len(q.Settings) {
+		return QualitySettings{PostProcessing: true, ParticleScale: 1}
+	}
+	return q.Settings[q.level]
+}
+
+// SetOverride pins a level chosen by the player (e.g. in an options menu);
+// automatic changes stop until ClearOverride.
+func (q *QualityScaler) SetOverride(level QualityLevel) {
+	q.override = true
+	q.setLevel(level)
+}
+
+// ClearOverride resumes automatic adjustment from the current level.
+func (q *QualityScaler) ClearOverride() {
+	q.override = false
+	q.overFrames, q.easyFrames = 0, 0
+}
+
+// Overridden reports whether a user override is active.
+func (q *QualityScaler) Overridden() bool {
+	return q.override
+}
+
+// RecordFrame feeds one frame's work time and adjusts the level if needed.
+//
+// Parameters:
+//
+//	work: Update and render time for the frame (vsync wait excluded)
+func (q *QualityScaler) RecordFrame(work time.Duration) {
+	const smoothing = 0.05
+	q.average += (work.Seconds() - q.average) * smoothing
+	if !q.Enabled || q.override || q.FrameBudget <= 0 {
+		return
+	}
+
+	budget := q.FrameBudget.Seconds()
+	switch {
+	case q.average > budget:
+		q.overFrames++
+		q.easyFrames = 0
+	case q.average < budget*q.UpgradeHeadroom:
+		q.easyFrames++
+		q.overFrames = 0
+	default:
+		q.overFrames, q.easyFrames = 0, 0
+	}
+
+	if q.overFrames >= q.DowngradeFrames && q.level > QualityLow {
+		q.setLevel(q.level - 1)
+	} else if q.easyFrames >= q.UpgradeFrames && int(q.level) < len(q.Settings)-1 {
+		q.setLevel(q.level + 1)
+	}
+}
+
+// setLevel changes the level, restarts the hysteresis counters, and notifies OnChange.
+func (q *QualityScaler) setLevel(level QualityLevel) {
+	q.overFrames, q.easyFrames = 0, 0
+	if level == q.level {
+		return
+	}
+	q.level = level
+	if q.OnChange != nil {
+		q.OnChange(level, q.Current())
+	}
+}
@@ -1,6 +1,10 @@
 package core
 
 import (
+	"math"
+	"slices"
+	"sort"
+
 	"github.com/dshills/gogame/engine/graphics"
 	gamemath "github.com/dshills/gogame/engine/math"
 	"github.com/dshills/gogame/engine/physics"
@@ -9,15 +13,44 @@ import (
 // Scene represents a container for entities (game level or screen).
 type Scene struct {
 	entities         []*Entity
+	entityIndex      map[uint64]*Entity // ID lookup for GetEntity
 	nextEntityID     uint64
 	camera           *graphics.Camera
+	cameras          []*graphics.Camera // Extra cameras drawn after camera (split-screen, minimaps)
 	backgroundColor  gamemath.Color
-	entitiesToRemove []uint64 // Deferred removal during Update
+	gravity          gamemath.Vector2 // Acceleration applied to dynamic rigid bodies
+	entitiesToRemove []uint64         // Deferred removal during Update
+	doubleBuffered   bool             // Behaviors see last-frame transforms of other entities
 
 	// Collision tracking for enter/stay/exit events
 	previousCollisions map[collisionPairKey]bool
+	contacts           []Contact // Contacts detected in the last step
+
+	perf    *PerfMonitor   // Optional performance warnings (set by Engine.SetScene)
+	quality *QualityScaler // Optional far-entity update throttling (set by Engine.SetScene)
+	steps   uint64         // Update calls so far (staggers throttled entities)
+
+	debugDraw *DebugDraw // Optional wireframe view (set by Engine.SetScene)
+	debugRays []DebugRay // Raycasts in the last step while debugDraw shows rays
+
+	// Named layers keyed by z-order value
+	layers map[int]*SceneLayer
+
+	// Lifecycle listeners
+	addedListeners   []EntityCallback
+	removedListeners []EntityCallback
+	hooks            sceneHooks // SceneManager enter/exit/pause/resume
+
+	blackboard *Blackboard // Level state shared between behaviors
+	scheduler  *Scheduler  // Timers and scripts (created on first use)
+
+	batch         *graphics.SpriteBatch // Draws batched layers (see SceneLayer.Batched)
+	batchRenderer *graphics.Renderer    // Renderer batch draws through
 }
 
+// EntityCallback is called on entity lifecycle events.
+type EntityCallback func(entity *Entity)
+
 // collisionPairKey uniquely identifies a collision pair (order-independent).
 type collisionPairKey struct {
 	a, b uint64
@@ -31,6 +64,14 @@ func newCollisionPairKey(id1, id2 uint64) collisionPairKey {
 	return collisionPairKey{a: id2, b: id1}
 }
 
+// less orders pair keys by (a, b) for deterministic callback dispatch.
+func (k collisionPairKey) less(other collisionPairKey) bool {
+	if k.a != other.a {
+		return k.a < other.a
+	}
+	return k.b < other.b
+}
+
 // NewScene creates an empty scene
 //
 // Returns:
@@ -43,11 +84,13 @@ func newCollisionPairKey(id1, id2 uint64) collisionPairKey {
 func NewScene() *Scene {
 	return &Scene{
 		entities:           make([]*Entity, 0),
+		entityIndex:        make(map[uint64]*Entity),
 		nextEntityID:       1,
 		camera:             graphics.NewCamera(),
 		backgroundColor:    gamemath.Black,
 		entitiesToRemove:   make([]uint64, 0),
 		previousCollisions: make(map[collisionPairKey]bool),
+		layers:             make(map[int]*SceneLayer),
 	}
 }
 
@@ -64,6 +107,7 @@ func NewScene() *Scene {
 // Behavior:
 //   - Entity begins updating/rendering immediately if Active
 //   - ID assigned sequentially starting from 1
+//   - Children (see Entity.AddChild) not already in the scene are added too
 //
 // Example:
 //
@@ -73,6 +117,15 @@ func (s *Scene) AddEntity(entity *Entity) uint64 {
 	entity.ID = s.nextEntityID
 	s.nextEntityID++
 	s.entities = append(s.entities, entity)
+	s.entityIndex[entity.ID] = entity
+	for _, listener := range s.addedListeners {
+		listener(entity)
+	}
+	for _, child := range entity.children {
+		if s.entityIndex[child.ID] != child {
+			s.AddEntity(child)
+		}
+	}
 	return entity.ID
 }
 
@@ -86,6 +139,10 @@ func (s *Scene) AddEntity(entity *Entity) uint64 {
 //   - Entity removed immediately (doesn't update/render next frame)
 //   - Safe to call during Update() (deferred removal)
 //   - No-op if ID not found
+//   - Children are removed with their parent; the removed entity is
+//     detached from its own parent
+//   - Pooled entities (and pooled children) are released to their
+//     EntityPool instead of removed
 //
 // Example:
 //
@@ -95,28 +152,104 @@ func (s *Scene) RemoveEntity(id uint64) {
 	s.entitiesToRemove = append(s.entitiesToRemove, id)
 }
 
+// markChildrenRemoved marks an entity's descendants for removal; pooled
+// descendants (and their subtrees) are detached and released instead.
+func (s *Scene) markChildrenRemoved(entity *Entity, toRemove map[uint64]bool) {
+	for _, child := range slices.Clone(entity.children) {
+		if s.entityIndex[child.ID] != child {
+			continue
+		}
+		if child.pool != nil {
+			entity.RemoveChild(child)
+			child.pool.Release(child)
+			continue
+		}
+		toRemove[child.ID] = true
+		s.markChildrenRemoved(child, toRemove)
+	}
+}
+
 // processDeferredRemovals removes queued entities after update phase.
 func (s *Scene) processDeferredRemovals() {
 	if len(s.entitiesToRemove) == 0 {
 		return
 	}
 
-	// Create a map for O(1) lookup
+	// Create a map for O(1) lookup; removing a parent removes its children.
+	// Pooled entities go back to their pool instead (see EntityPool).
 	toRemove := make(map[uint64]bool)
 	for _, id := range s.entitiesToRemove {
+		entity := s.entityIndex[id]
+		if entity != nil && entity.pool != nil {
+			entity.pool.Release(entity)
+			continue
+		}
 		toRemove[id] = true
+		if entity != nil {
+			s.markChildrenRemoved(entity, toRemove)
+		}
 	}
 
 	// Filter out entities to remove
 	filtered := make([]*Entity, 0, len(s.entities))
+	var removed []*Entity
 	for _, entity := range s.entities {
-		if !toRemove[entity.ID] {
-			filtered = append(filtered, entity)
+		if toRemove[entity.ID] {
+			delete(s.entityIndex, entity.ID)
+			if entity.Text != nil {
+				entity.Text.Destroy() // Re-rendered if the entity is added again
+			}
+			if entity.parent != nil && !toRemove[entity.parent.ID] {
+				entity.parent.RemoveChild(entity) // Subtree roots leave surviving parents
+			}
+			if s.scheduler != nil {
+				s.scheduler.cancelOwned(entity)
+			}
+			removed = append(removed, entity)
+			continue
 		}
+		filtered = append(filtered, entity)
 	}
 
 	s.entities = filtered
 	s.entitiesToRemove = s.entitiesToRemove[:0] // Clear removal queue
+
+	// Notify after the scene is consistent so listeners may add/remove entities
+	for _, entity := range removed {
+		for _, listener := range s.removedListeners {
+			listener(entity)
+		}
+	}
+}
+
+// OnEntityAdded registers a callback invoked after an entity is added.
+//
+// Parameters:
+//
+//	callback: Called with the new entity (ID already assigned)
+//
+// Example:
+//
+//	scene.OnEntityAdded(func(e *core.Entity) { minimap.Track(e) })
+func (s *Scene) OnEntityAdded(callback EntityCallback) {
+	s.addedListeners = append(s.addedListeners, callback)
+}
+
+// OnEntityRemoved registers a callback invoked when an entity leaves the scene.
+//
+// Parameters:
+//
+//	callback: Called with the removed entity
+//
+// Behavior:
+//   - Fires when deferred removal is processed (end of Update), not on RemoveEntity
+//   - GetEntity no longer finds the entity during the callback
+//
+// Example:
+//
+//	scene.OnEntityRemoved(func(e *core.Entity) { replication.Despawn(e.ID) })
+func (s *Scene) OnEntityRemoved(callback EntityCallback) {
+	s.removedListeners = append(s.removedListeners, callback)
 }
 
 // GetEntity retrieves an entity by ID
@@ -129,6 +262,9 @@ func (s *Scene) processDeferredRemovals() {
 //
 //	*Entity: Entity with matching ID, or nil if not found
 //
+// Behavior:
+//   - O(1) lookup via the scene's ID index
+//
 // Example:
 //
 //	entity := scene.GetEntity(playerID)
@@ -136,12 +272,7 @@ func (s *Scene) processDeferredRemovals() {
 //	    entity.Transform.Position.X += 10
 //	}
 func (s *Scene) GetEntity(id uint64) *Entity {
-	for _, entity := range s.entities {
-		if entity.ID == id {
-			return entity
-		}
-	}
-	return nil
+	return s.entityIndex[id]
 }
 
 // GetAllEntities returns all entities in the scene.
@@ -159,6 +290,23 @@ func (s *Scene) GetAllEntities() []*Entity {
 	return s.entities
 }
 
+// FindByTag returns entities with a tag, in scene order
+//
+// Example:
+//
+//	for _, enemy := range scene.FindByTag("enemy") {
+//	    enemy.SendMessage("alert", player)
+//	}
+func (s *Scene) FindByTag(tag string) []*Entity {
+	found := make([]*Entity, 0)
+	for _, entity := range s.entities {
+		if entity.HasTag(tag) {
+			found = append(found, entity)
+		}
+	}
+	return found
+}
+
 // GetEntitiesAt finds all entities at a world position
 //
 // Parameters:
@@ -190,6 +338,46 @@ func (s *Scene) GetEntitiesAt(x, y float64) []*Entity {
 	return result
 }
 
+// GetEntitiesInRect finds all active entities whose bounds intersect a world rectangle
+//
+// Parameters:
+//
+//	rect: World-space region to query
+//
+// Returns:
+//
+//	[]*Entity: Entities whose bounds overlap or lie inside rect (may be empty)
+//
+// Behavior:
+//   - Entities without colliders are tested as points at their position
+//   - Returns entities in scene order
+//
+// Example:
+//
+//	region := gamemath.Rectangle{X: 0, Y: 0, Width: 200, Height: 100}
+//	for _, unit := range scene.GetEntitiesInRect(region) {
+//	    selection.Add(unit)
+//	}
+func (s *Scene) GetEntitiesInRect(rect gamemath.Rectangle) []*Entity {
+	result := make([]*Entity, 0)
+	for _, entity := range s.entities {
+		if !entity.Active {
+			continue
+		}
+		bounds := entity.GetBounds()
+		if bounds.Width == 0 && bounds.Height == 0 {
+			if rect.Contains(bounds.X, bounds.Y) {
+				result = append(result, entity)
+			}
+			continue
+		}
+		if rect.Intersects(bounds) {
+			result = append(result, entity)
+		}
+	}
+	return result
+}
+
 // Camera returns the scene's camera
 //
 // Returns:
@@ -199,6 +387,50 @@ func (s *Scene) Camera() *graphics.Camera {
 	return s.camera
 }
 
+// Blackboard returns the scene's shared state (discarded with the scene)
+//
+// Returns:
+//
+//	*Blackboard: Level-scoped key-value store
+//
+// Example:
+//
+//	scene.Blackboard().Set("lever_pulled", true)
+//	if scene.Blackboard().Bool("lever_pulled", false) {
+//	    door.Active = false
+//	}
+func (s *Scene) Blackboard() *Blackboard {
+	if s.blackboard == nil {
+		s.blackboard = NewBlackboard()
+	}
+	return s.blackboard
+}
+
+// Scheduler returns the scene's timers and scripts (discarded with the scene)
+//
+// Returns:
+//
+//	*Scheduler: Tasks advanced at the start of each Update
+//
+// Example:
+//
+//	scene.Scheduler().After(3*time.Second, func() { scene.RemoveEntity(explosion.ID) })
+//	scene.Scheduler().For(enemy).Every(time.Second, func() { enemy.SendMessage("think", nil) })
+func (s *Scene) Scheduler() *Scheduler {
+	if s.scheduler == nil {
+		s.scheduler = NewScheduler()
+	}
+	return s.scheduler
+}
+
+// stopScheduler cancels pending tasks, unwinding suspended scripts, when
+// the scene is discarded.
+func (s *Scene) stopScheduler() {
+	if s.scheduler != nil {
+		s.scheduler.Clear()
+	}
+}
+
 // SetBackgroundColor sets the clear color
 //
 // Parameters:
@@ -218,39 +450,249 @@ func (s *Scene) GetBackgroundColor() gamemath.Color {
 }
 
 // Update updates all active entities.
+//
+// Behavior:
+//   - Fires the scene's scheduled timers and resumes its scripts (see
+//     Scheduler)
+//   - Calls Update on every active entity (see SetDoubleBuffered); with a
+//     QualityScaler attached, entities far from the camera may update every
+//     few steps instead, receiving the accumulated dt
+//   - Integrates rigid bodies (see physics.RigidBody)
+//   - Detects collisions and fires collision callbacks
+//   - Calls LateUpdate on every active entity (see LateUpdater); cameras
+//     follow their targets here so they see the frame's final positions
+//   - Advances each camera's shake and clamps it to its bounds
+//   - Removes entities queued for removal during the step
 func (s *Scene) Update(dt float64) {
-	// Update all active entities
+	// Update all active entities (timing behaviors on sampled updates)
+	s.steps++
+	s.debugRays = s.debugRays[:0]
+	if s.scheduler != nil {
+		s.scheduler.Update(dt)
+	}
+	sample := s.perf != nil && s.perf.sampling()
+	if s.doubleBuffered {
+		s.updateDoubleBuffered(dt, sample)
+	} else {
+		for _, entity := range s.entities {
+			if entity.Active {
+				s.updateEntity(entity, dt, sample)
+			}
+		}
+	}
+
+	// Move rigid bodies by the velocities behaviors just set
+	s.integrateBodies(dt)
+
+	// Detect collisions after all entities have moved
+	s.detectCollisions()
+	if s.perf != nil {
+		s.perf.RecordCollisionPairs(len(s.contacts))
+	}
+
+	// Late phase: camera follow and anything else that tracks final positions
 	for _, entity := range s.entities {
 		if entity.Active {
-			entity.Update(dt)
+			entity.LateUpdate(dt)
 		}
 	}
 
-	// Detect collisions after all entities have updated
-	s.detectCollisions()
+	// Advance camera shake and keep the view inside its bounds
+	for _, camera := range s.Cameras() {
+		camera.Update(dt)
+	}
 
 	// Process any entities queued for removal during Update
 	s.processDeferredRemovals()
 }
 
-// detectCollisions performs collision detection on all entities.
-func (s *Scene) detectCollisions() {
-	// Convert entities to physics.Entity interface
-	physicsEntities := make([]physics.Entity, len(s.entities))
-	for i, entity := range s.entities {
-		physicsEntities[i] = entity
+// UpdatePaused advances only pause-exempt entities and behaviors
+//
+// Parameters:
+//
+//	dt: Delta time in seconds
+//
+// Behavior:
+//   - Called by the engine instead of Update while Engine.SetPaused is on
+//   - Runs Update and LateUpdate for entities with UpdateWhenPaused set and
+//     for behaviors implementing PauseExempt; everything else is frozen
+//   - Rigid bodies and collisions don't advance
+//   - Removals queued by exempt behaviors are still processed
+func (s *Scene) UpdatePaused(dt float64) {
+	running := make([]*Entity, 0)
+	behaviors := make([]Behavior, 0)
+	for _, entity := range s.entities {
+		if !entity.Active {
+			continue
+		}
+		if behavior := entity.pausedBehavior(); behavior != nil {
+			running = append(running, entity)
+			behaviors = append(behaviors, behavior)
+			behavior.Update(entity, dt)
+		}
+	}
+	for i, entity := range running {
+		if late, ok := behaviors[i].(LateUpdater); ok && entity.Active {
+			late.LateUpdate(entity, dt)
+		}
+	}
+	s.processDeferredRemovals()
+}
+
+// updateEntity runs one entity's behaviors.
+func (s *Scene) updateEntity(entity *Entity, dt float64, sample bool) {
+	dt, due := s.throttle(entity, dt)
+	if !due {
+		return
+	}
+	if sample {
+		s.perf.updateSampled(entity, dt)
+	} else {
+		entity.Update(dt)
+	}
+}
+
+// throttle decides whether an entity updates this step under the quality
+// settings, returning the dt to pass (including steps it skipped).
+func (s *Scene) throttle(entity *Entity, dt float64) (float64, bool) {
+	far := false
+	if s.quality != nil && s.camera != nil {
+		settings := s.quality.Current()
+		if settings.FarUpdateDistance > 0 && settings.FarUpdateEvery > 1 {
+			far = s.cameraDistance(entity.WorldTransform().Position) > settings.FarUpdateDistance
+			if far && (s.steps+entity.ID)%uint64(settings.FarUpdateEvery) != 0 {
+				entity.skippedDT += dt
+				return 0, false
+			}
+		}
+	}
+	dt += entity.skippedDT
+	entity.skippedDT = 0
+	return dt, true
+}
+
+// SetDoubleBuffered enables or disables double-buffered transforms
+//
+// Parameters:
+//
+//	enabled: True to give behaviors a read-only view of last frame's world
+//
+// Behavior:
+//   - While behaviors run, every other entity's Transform holds its value
+//     from the start of the step, so results don't depend on insertion
+//     order (A no longer sees B's new position just because B updated first)
+//   - An entity's own Transform writes are kept and applied to everyone
+//     once all behaviors have run
+//   - Writes to another entity's Transform are discarded; send a message or
+//     set a flag the other entity applies itself
+//   - Only transforms are buffered; rigid bodies and other fields are live
+//
+// Example:
+//
+//	// Flocking reads neighbors' positions; keep it order-independent
+//	scene.SetDoubleBuffered(true)
+func (s *Scene) SetDoubleBuffered(enabled bool) {
+	s.doubleBuffered = enabled
+}
+
+// DoubleBuffered reports whether transforms are double-buffered.
+func (s *Scene) DoubleBuffered() bool {
+	return s.doubleBuffered
+}
+
+// updateDoubleBuffered runs behaviors against last frame's transforms and
+// commits each entity's own writes afterwards.
+func (s *Scene) updateDoubleBuffered(dt float64, sample bool) {
+	entities := s.entities // Entities added during the phase start next step
+	previous := make([]gamemath.Transform, len(entities))
+	for i, entity := range entities {
+		previous[i] = entity.Transform
 	}
+	next := make([]gamemath.Transform, len(entities))
+	copy(next, previous)
+
+	for i, entity := range entities {
+		if !entity.Active {
+			continue
+		}
+		entity.Transform = previous[i] // Drop writes made by other entities
+		s.updateEntity(entity, dt, sample)
+		next[i] = entity.Transform
+		entity.Transform = previous[i]
+	}
+	for i, entity := range entities {
+		entity.Transform = next[i]
+	}
+}
 
+// SetGravity sets the acceleration applied to dynamic rigid bodies
+//
+// Parameters:
+//
+//	gravity: World units per second squared (Y grows downward on screen)
+//
+// Example:
+//
+//	scene.SetGravity(gamemath.Vector2{X: 0, Y: 980}) // Platformer
+//	scene.SetGravity(gamemath.Vector2{})              // Top-down (default)
+func (s *Scene) SetGravity(gravity gamemath.Vector2) {
+	s.gravity = gravity
+}
+
+// Gravity returns the acceleration applied to dynamic rigid bodies.
+func (s *Scene) Gravity() gamemath.Vector2 {
+	return s.gravity
+}
+
+// integrateBodies advances every active entity's rigid body by one step.
+func (s *Scene) integrateBodies(dt float64) {
+	for _, entity := range s.entities {
+		if entity.Active && entity.Body != nil {
+			entity.Body.Integrate(&entity.Transform, s.gravity, dt)
+		}
+	}
+}
+
+// detectCollisions performs collision detection on all entities.
+//
+// Pairs are resolved and dispatched sorted by (lower ID, higher ID), and
+// exits in the same order, so callback order is reproducible regardless of
+// entity slice order or map iteration.
+func (s *Scene) detectCollisions() {
 	// Detect all collisions
-	collisions := physics.DetectCollisions(physicsEntities)
+	collisions := physics.DetectCollisions(s.physicsEntities())
+	sort.SliceStable(collisions, func(i, j int) bool {
+		keyI := newCollisionPairKey(collisions[i].EntityA.GetID(), collisions[i].EntityB.GetID())
+		keyJ := newCollisionPairKey(collisions[j].EntityA.GetID(), collisions[j].EntityB.GetID())
+		return keyI.less(keyJ)
+	})
 
 	// Track current frame collisions
 	currentCollisions := make(map[collisionPairKey]bool)
+	s.contacts = s.contacts[:0]
 
 	// Process each collision
 	for _, collision := range collisions {
 		entityA := collision.EntityA.(*Entity)
 		entityB := collision.EntityB.(*Entity)
+		if entityA.isAncestorOf(entityB) || entityB.isAncestorOf(entityA) {
+			continue // Parts of one hierarchy don't collide with each other
+		}
+
+		// Push solid colliders apart; callbacks see the contact as detected
+		trigger := entityA.Collider.IsTrigger || entityB.Collider.IsTrigger
+		if !trigger {
+			s.resolveCollision(entityA, entityB)
+		}
+		infoA := CollisionInfo{
+			Normal:  collision.Contact.Normal,
+			Depth:   collision.Contact.Depth,
+			Point:   collision.Contact.Point,
+			Trigger: trigger,
+		}
+		infoB := infoA
+		infoB.Normal = infoA.Normal.Scale(-1)
+		s.contacts = append(s.contacts, Contact{A: entityA, B: entityB, Info: infoA})
 
 		// Create collision pair key (order-independent)
 		pairKey := newCollisionPairKey(entityA.ID, entityB.ID)
@@ -260,43 +702,42 @@ func (s *Scene) detectCollisions() {
 		if s.previousCollisions[pairKey] {
 			// OnCollisionStay - collision continuing
 			if entityA.OnCollisionStay != nil {
-				entityA.OnCollisionStay(entityA, entityB)
+				entityA.OnCollisionStay(entityA, entityB, infoA)
 			}
 			if entityB.OnCollisionStay != nil {
-				entityB.OnCollisionStay(entityB, entityA)
+				entityB.OnCollisionStay(entityB, entityA, infoB)
 			}
 		} else {
 			// OnCollisionEnter - new collision
 			if entityA.OnCollisionEnter != nil {
-				entityA.OnCollisionEnter(entityA, entityB)
+				entityA.OnCollisionEnter(entityA, entityB, infoA)
 			}
 			if entityB.OnCollisionEnter != nil {
-				entityB.OnCollisionEnter(entityB, entityA)
+				entityB.OnCollisionEnter(entityB, entityA, infoB)
 			}
 		}
 	}
 
-	// Check for collisions that ended (OnCollisionExit)
+	// Check for collisions that ended (OnCollisionExit), in sorted order
+	ended := make([]collisionPairKey, 0)
 	for pairKey := range s.previousCollisions {
 		if !currentCollisions[pairKey] {
-			// Find entities by ID
-			var entityA, entityB *Entity
-			for _, entity := range s.entities {
-				if entity.ID == pairKey.a {
-					entityA = entity
-				} else if entity.ID == pairKey.b {
-					entityB = entity
-				}
+			ended = append(ended, pairKey)
+		}
+	}
+	sort.Slice(ended, func(i, j int) bool { return ended[i].less(ended[j]) })
+	for _, pairKey := range ended {
+		// Look up entities by ID (O(1) per ended pair)
+		entityA := s.entityIndex[pairKey.a]
+		entityB := s.entityIndex[pairKey.b]
+
+		// Call exit callbacks if entities still exist
+		if entityA != nil && entityB != nil {
+			if entityA.OnCollisionExit != nil {
+				entityA.OnCollisionExit(entityA, entityB, CollisionInfo{})
 			}
-
-			// Call exit callbacks if entities still exist
-			if entityA != nil && entityB != nil {
-				if entityA.OnCollisionExit != nil {
-					entityA.OnCollisionExit(entityA, entityB)
-				}
-				if entityB.OnCollisionExit != nil {
-					entityB.OnCollisionExit(entityB, entityA)
-				}
+			if entityB.OnCollisionExit != nil {
+				entityB.OnCollisionExit(entityB, entityA, CollisionInfo{})
 			}
 		}
 	}
@@ -305,18 +746,149 @@ func (s *Scene) detectCollisions() {
 	s.previousCollisions = currentCollisions
 }
 
+// SetPerfMonitor attaches a performance monitor (nil = none); Engine.SetScene
+// attaches the engine's monitor automatically.
+func (s *Scene) SetPerfMonitor(monitor *PerfMonitor) {
+	s.perf = monitor
+}
+
+// SetQualityScaler attaches a quality scaler whose settings throttle far
+// entities (nil = none); Engine.SetScene attaches the engine's automatically.
+func (s *Scene) SetQualityScaler(quality *QualityScaler) {
+	s.quality = quality
+}
+
+// SetDebugDraw attaches a debug draw whose settings decide whether raycasts
+// are remembered for drawing (nil = none); Engine.SetScene attaches the
+// engine's automatically.
+func (s *Scene) SetDebugDraw(debugDraw *DebugDraw) {
+	s.debugDraw = debugDraw
+}
+
+// Contact is a collision detected during the last Scene.Update.
+type Contact struct {
+	A, B *Entity
+	Info CollisionInfo // From A's point of view (Normal points from B toward A)
+}
+
+// Contacts returns the collisions detected during the last Update
+//
+// Returns:
+//
+//	[]Contact: Overlapping pairs (valid until the next Update)
+//
+// Example:
+//
+//	for _, contact := range scene.Contacts() {
+//	    log.Printf("%d touches %d at %v", contact.A.ID, contact.B.ID, contact.Info.Point)
+//	}
+func (s *Scene) Contacts() []Contact {
+	return s.contacts
+}
+
+// resolveCollision pushes two solid entities apart along the minimum
+// translation vector. Only entities with dynamic rigid bodies move; the
+// overlap is re-measured so earlier resolutions this step aren't applied twice.
+// Separation happens in world space and is written back to each entity's
+// local transform.
+func (s *Scene) resolveCollision(a, b *Entity) {
+	worldA, worldB := a.WorldTransform(), b.WorldTransform()
+	contact, ok := a.Collider.Contact(b.Collider, worldA, worldB)
+	if !ok {
+		return
+	}
+	restitution := math.Max(physics.MaterialOf(a.Collider).Restitution, physics.MaterialOf(b.Collider).Restitution)
+	physics.Separate(a.Body, &worldA, b.Body, &worldB, contact, restitution)
+	a.SetWorldPosition(worldA.Position)
+	b.SetWorldPosition(worldB.Position)
+}
+
 // Render renders all active entities.
+//
+// Behavior:
+//   - Entities are drawn in ascending Layer order (insertion order within a layer)
+//   - Entities on hidden layers are skipped
+//   - Each layer is drawn with its parallax or screen-space camera (see LayerCamera)
+//   - With several cameras (see AddCamera) or a camera viewport, the
+//     entities are drawn once per camera into its viewport, in camera order
 func (s *Scene) Render(renderer *graphics.Renderer) error {
-	// Sort entities by layer for correct draw order (lower layers first)
-	// For now, we'll render in the order they were added (simple implementation)
-	// TODO: Add layer sorting for proper z-ordering
-
+	visible := make([]*Entity, 0, len(s.entities))
 	for _, entity := range s.entities {
-		if entity.Active {
-			if err := entity.Render(renderer, s.camera); err != nil {
+		if entity.Active && s.layerVisible(entity.Layer) {
+			visible = append(visible, entity)
+		}
+	}
+	textureOrder := s.batchTextureOrder(visible)
+	sort.SliceStable(visible, func(i, j int) bool {
+		a, b := visible[i], visible[j]
+		if a.Layer != b.Layer {
+			return a.Layer < b.Layer
+		}
+		if textureOrder != nil && s.layerBatched(a.Layer) {
+			return textureOrder[spriteTexture(a)] < textureOrder[spriteTexture(b)]
+		}
+		return false
+	})
+
+	if s.batch == nil || s.batchRenderer != renderer {
+		s.batch, s.batchRenderer = graphics.NewSpriteBatch(renderer), renderer
+	}
+	return s.forEachView(renderer, func(camera *graphics.Camera) error {
+		return s.renderCamera(renderer, camera, visible)
+	})
+}
+
+// batchTextureOrder ranks textures by first use in batched layers, so
+// sorting groups each batched layer's sprites by texture (nil if no layer
+// is batched).
+func (s *Scene) batchTextureOrder(visible []*Entity) map[*graphics.Texture]int {
+	var order map[*graphics.Texture]int
+	for _, entity := range visible {
+		if !s.layerBatched(entity.Layer) {
+			continue
+		}
+		if order == nil {
+			order = make(map[*graphics.Texture]int)
+		}
+		if texture := spriteTexture(entity); order[texture] == 0 {
+			order[texture] = len(order) + 1
+		}
+	}
+	return order
+}
+
+// spriteTexture returns an entity's sprite texture (nil without one).
+func spriteTexture(entity *Entity) *graphics.Texture {
+	if entity.Sprite == nil {
+		return nil
+	}
+	return entity.Sprite.Texture
+}
+
+// renderCamera draws sorted entities through one camera.
+func (s *Scene) renderCamera(renderer *graphics.Renderer, base *graphics.Camera, visible []*Entity) error {
+	cameras := make(map[int]*graphics.Camera)
+	for _, entity := range visible {
+		if !base.DrawsLayer(entity.Layer) {
+			continue
+		}
+		camera, ok := cameras[entity.Layer]
+		if !ok {
+			camera = s.layerCamera(base, entity.Layer)
+			cameras[entity.Layer] = camera
+		}
+		if s.layerBatched(entity.Layer) && entity.Sprite != nil && entity.Text == nil {
+			if err := s.batch.Draw(entity.Sprite, entity.WorldTransform(), camera); err != nil {
 				return err
 			}
+			continue
+		}
+		if err := s.batch.Flush(); err != nil {
+			return err
+		}
+		if err := entity.Render(renderer, camera); err != nil {
+			return err
 		}
 	}
-	return nil
+	return s.batch.Flush()
 }
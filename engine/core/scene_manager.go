@@ -0,0 +1,439 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+var (
+	// ErrUnknownScene is returned when pushing a name that was never registered.
+	ErrUnknownScene = errors.New("unknown scene")
+	// ErrSceneStackEmpty is returned when popping with no scene on the stack.
+	ErrSceneStackEmpty = errors.New("scene stack is empty")
+)
+
+// SceneFactory builds a fresh scene each time it is pushed.
+type SceneFactory func() *Scene
+
+// SceneCallback is called on scene lifecycle events.
+type SceneCallback func(scene *Scene)
+
+// sceneHooks holds a scene's lifecycle listeners.
+type sceneHooks struct {
+	enter  []SceneCallback
+	exit   []SceneCallback
+	pause  []SceneCallback
+	resume []SceneCallback
+}
+
+// OnEnter registers a callback for when the scene is pushed or replaces
+// another scene on a SceneManager.
+func (s *Scene) OnEnter(callback SceneCallback) {
+	s.hooks.enter = append(s.hooks.enter, callback)
+}
+
+// OnExit registers a callback for when the scene is popped or replaced.
+func (s *Scene) OnExit(callback SceneCallback) {
+	s.hooks.exit = append(s.hooks.exit, callback)
+}
+
+// OnPause registers a callback for when another scene is pushed on top.
+//
+// Example:
+//
+//	level.OnPause(func(*core.Scene) { engine.Audio().MusicVolume = 0.3 })
+//	level.OnResume(func(*core.Scene) { engine.Audio().MusicVolume = 1 })
+func (s *Scene) OnPause(callback SceneCallback) {
+	s.hooks.pause = append(s.hooks.pause, callback)
+}
+
+// OnResume registers a callback for when the scene above it is popped.
+func (s *Scene) OnResume(callback SceneCallback) {
+	s.hooks.resume = append(s.hooks.resume, callback)
+}
+
+// fireSceneHooks calls each callback with the scene.
+func fireSceneHooks(scene *Scene, callbacks []SceneCallback) {
+	for _, callback := range callbacks {
+		callback(scene)
+	}
+}
+
+// TransitionEffect selects how one scene gives way to the next.
+type TransitionEffect int
+
+const (
+	// TransitionNone switches scenes immediately.
+	TransitionNone TransitionEffect = iota
+	// TransitionFade fades the old scene out to Color, then the new one in.
+	TransitionFade
+	// TransitionSlideLeft slides the new scene in from the right edge.
+	TransitionSlideLeft
+	// TransitionSlideRight slides the new scene in from the left edge.
+	TransitionSlideRight
+	// TransitionSlideUp slides the new scene in from the bottom edge.
+	TransitionSlideUp
+	// TransitionSlideDown slides the new scene in from the top edge.
+	TransitionSlideDown
+)
+
+// Transition describes a scene change effect.
+//
+// Example:
+//
+//	fade := core.Transition{Effect: core.TransitionFade, Duration: 0.6}
+//	_, _ = engine.Scenes().Replace("level2", fade)
+type Transition struct {
+	Effect   TransitionEffect
+	Duration float64        // Seconds (0 = immediate)
+	Color    gamemath.Color // Fade color (zero value = black)
+}
+
+// managedScene is a stack entry.
+type managedScene struct {
+	name  string
+	scene *Scene
+}
+
+// activeTransition is a transition in progress.
+type activeTransition struct {
+	Transition
+	from    *Scene
+	elapsed float64
+}
+
+// SceneManager keeps a stack of named scenes and plays transitions between them.
+//
+// The top of the stack is the active scene. Push pauses it and enters a
+// new one (pause menus, dialogs); Pop exits the top and resumes the one
+// below; Replace exits the top and enters a new one (level changes).
+//
+// While a transition plays, scene updates are frozen and the manager
+// renders both scenes; Engine.Run does this automatically for the engine's
+// manager (see Engine.Scenes).
+type SceneManager struct {
+	factories  map[string]SceneFactory
+	stack      []managedScene
+	transition *activeTransition
+	onChange   SceneCallback        // Called with the new top scene (nil when empty)
+	targets    [2]*graphics.Texture // Offscreen frames for slide transitions
+	targetW    int
+	targetH    int
+}
+
+// NewSceneManager creates an empty scene manager.
+//
+// Parameters:
+//
+//	onChange: Optional callback with the new active scene after each change
+func NewSceneManager(onChange SceneCallback) *SceneManager {
+	return &SceneManager{
+		factories: make(map[string]SceneFactory),
+		stack:     make([]managedScene, 0),
+		onChange:  onChange,
+	}
+}
+
+// Register associates a name with a scene factory (replacing any previous one).
+//
+// Example:
+//
+//	engine.Scenes().Register("title", newTitleScene)
+//	engine.Scenes().Register("pause", newPauseScene)
+//	_, _ = engine.Scenes().Push("title", core.Transition{})
+func (m *SceneManager) Register(name string, factory SceneFactory) {
+	m.factories[name] = factory
+}
+
+// Push builds a registered scene and makes it active, pausing the current one.
+//
+// Parameters:
+//
+//	name: Registered scene name
+//	transition: Effect to play (zero value = immediate)
+//
+// Returns:
+//
+//	*Scene: The new active scene
+//	error: ErrUnknownScene if name isn't registered
+//
+// Behavior:
+//   - Finishes any transition already in progress
+//   - Calls OnPause on the previous scene, then OnEnter on the new one
+func (m *SceneManager) Push(name string, transition Transition) (*Scene, error) {
+	scene, err := m.build(name)
+	if err != nil {
+		return nil, err
+	}
+	m.finishTransition()
+	previous := m.Current()
+	if previous != nil {
+		fireSceneHooks(previous, previous.hooks.pause)
+	}
+	m.stack = append(m.stack, managedScene{name: name, scene: scene})
+	fireSceneHooks(scene, scene.hooks.enter)
+	m.changed(previous, transition)
+	return scene, nil
+}
+
+// Pop exits the active scene and resumes the one below it.
+//
+// Returns:
+//
+//	error: ErrSceneStackEmpty if there is no scene to pop
+//
+// Behavior:
+//   - Calls OnExit on the popped scene, then OnResume on the new top
+//   - Popping the last scene leaves the manager empty (the transition
+//     fades or slides to the fade color)
+func (m *SceneManager) Pop(transition Transition) error {
+	if len(m.stack) == 0 {
+		return ErrSceneStackEmpty
+	}
+	m.finishTransition()
+	previous := m.stack[len(m.stack)-1].scene
+	m.stack[len(m.stack)-1] = managedScene{}
+	m.stack = m.stack[:len(m.stack)-1]
+	fireSceneHooks(previous, previous.hooks.exit)
+	previous.stopScheduler()
+	if current := m.Current(); current != nil {
+		fireSceneHooks(current, current.hooks.resume)
+	}
+	m.changed(previous, transition)
+	return nil
+}
+
+// Replace exits the active scene and enters a new one in its place.
+//
+// Returns:
+//
+//	*Scene: The new active scene
+//	error: ErrUnknownScene if name isn't registered
+//
+// Behavior:
+//   - Calls OnExit on the replaced scene, then OnEnter on the new one
+//   - With an empty stack, behaves like Push
+func (m *SceneManager) Replace(name string, transition Transition) (*Scene, error) {
+	scene, err := m.build(name)
+	if err != nil {
+		return nil, err
+	}
+	m.finishTransition()
+	previous := m.Current()
+	if previous != nil {
+		m.stack = m.stack[:len(m.stack)-1]
+		fireSceneHooks(previous, previous.hooks.exit)
+		previous.stopScheduler()
+	}
+	m.stack = append(m.stack, managedScene{name: name, scene: scene})
+	fireSceneHooks(scene, scene.hooks.enter)
+	m.changed(previous, transition)
+	return scene, nil
+}
+
+// Current returns the active scene, or nil if the stack is empty.
+func (m *SceneManager) Current() *Scene {
+	if len(m.stack) == 0 {
+		return nil
+	}
+	return m.stack[len(m.stack)-1].scene
+}
+
+// CurrentName returns the active scene's registered name ("" if empty).
+func (m *SceneManager) CurrentName() string {
+	if len(m.stack) == 0 {
+		return ""
+	}
+	return m.stack[len(m.stack)-1].name
+}
+
+// Depth returns the number of scenes on the stack.
+func (m *SceneManager) Depth() int {
+	return len(m.stack)
+}
+
+// Transitioning reports whether a transition is playing.
+func (m *SceneManager) Transitioning() bool {
+	return m.transition != nil
+}
+
+// Progress returns the active transition's progress (0-1), or 1 if none.
+func (m *SceneManager) Progress() float64 {
+	if m.transition == nil {
+		return 1
+	}
+	return math.Min(m.transition.elapsed/m.transition.Duration, 1)
+}
+
+// Update advances the active transition.
+//
+// Parameters:
+//
+//	dt: Delta time in seconds
+func (m *SceneManager) Update(dt float64) {
+	if m.transition == nil {
+		return
+	}
+	m.transition.elapsed += dt
+	if m.transition.elapsed >= m.transition.Duration {
+		m.transition = nil
+	}
+}
+
+// Render draws the current frame of the active transition, or the active
+// scene if none is playing.
+//
+// Parameters:
+//
+//	renderer: Renderer to draw with
+//	width, height: Screen size in pixels
+//
+// Returns:
+//
+//	error: Non-nil if rendering fails
+func (m *SceneManager) Render(renderer *graphics.Renderer, width, height int) error {
+	if m.transition == nil {
+		return renderScene(renderer, m.Current(), gamemath.Black)
+	}
+	t := m.transition
+	color := t.Color
+	if color == (gamemath.Color{}) {
+		color = gamemath.Black
+	}
+	progress := m.Progress()
+
+	if t.Effect == TransitionFade {
+		scene, alpha := t.from, progress*2
+		if progress >= 0.5 {
+			scene, alpha = m.Current(), (1-progress)*2
+		}
+		if err := renderScene(renderer, scene, color); err != nil {
+			return err
+		}
+		overlay := color
+		overlay.A = uint8(math.Round(math.Min(alpha, 1) * float64(color.A)))
+		return renderer.FillRect(gamemath.Rectangle{Width: float64(width), Height: float64(height)}, overlay)
+	}
+	return m.renderSlide(renderer, width, height, color, progress)
+}
+
+// Destroy releases the offscreen frames used by slide transitions.
+func (m *SceneManager) Destroy() {
+	for i, target := range m.targets {
+		if target != nil {
+			_ = target.Destroy() // Best effort cleanup
+			m.targets[i] = nil
+		}
+	}
+}
+
+// build creates a scene from its factory.
+func (m *SceneManager) build(name string) (*Scene, error) {
+	factory, ok := m.factories[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownScene, name)
+	}
+	return factory(), nil
+}
+
+// changed starts the transition away from previous and notifies onChange.
+func (m *SceneManager) changed(previous *Scene, transition Transition) {
+	if transition.Effect != TransitionNone && transition.Duration > 0 {
+		m.transition = &activeTransition{Transition: transition, from: previous}
+	}
+	if m.onChange != nil {
+		m.onChange(m.Current())
+	}
+}
+
+// finishTransition skips the rest of a playing transition.
+func (m *SceneManager) finishTransition() {
+	m.transition = nil
+}
+
+// renderSlide draws both scenes offscreen and composites them offset by progress.
+func (m *SceneManager) renderSlide(renderer *graphics.Renderer, width, height int, color gamemath.Color, progress float64) error {
+	if err := m.ensureTargets(renderer, width, height); err != nil {
+		return err
+	}
+
+	// Draw each scene into its own frame, restoring the previous target
+	// afterwards (it may be the post-processing buffer)
+	sdlRenderer := renderer.GetSDLRenderer()
+	previous := sdlRenderer.GetRenderTarget()
+	for i, scene := range []*Scene{m.transition.from, m.Current()} {
+		if err := renderer.SetRenderTarget(m.targets[i]); err != nil {
+			_ = sdlRenderer.SetRenderTarget(previous) // Best effort restore
+			return err
+		}
+		if err := renderScene(renderer, scene, color); err != nil {
+			_ = sdlRenderer.SetRenderTarget(previous) // Best effort restore
+			return err
+		}
+	}
+	if err := sdlRenderer.SetRenderTarget(previous); err != nil {
+		return fmt.Errorf("failed to restore render target: %w", err)
+	}
+
+	// Offset of the outgoing frame; the incoming frame follows one screen behind
+	var dirX, dirY float64
+	switch m.transition.Effect {
+	case TransitionSlideLeft:
+		dirX = -1
+	case TransitionSlideRight:
+		dirX = 1
+	case TransitionSlideUp:
+		dirY = -1
+	case TransitionSlideDown:
+		dirY = 1
+	}
+	w, h := float64(width), float64(height)
+	offsets := [2]gamemath.Vector2{
+		{X: dirX * w * progress, Y: dirY * h * progress},
+		{X: dirX * w * (progress - 1), Y: dirY * h * (progress - 1)},
+	}
+	for i, offset := range offsets {
+		dst := sdl.Rect{X: int32(math.Round(offset.X)), Y: int32(math.Round(offset.Y)), W: int32(width), H: int32(height)}
+		if err := sdlRenderer.Copy(m.targets[i].GetSDLTexture(), nil, &dst); err != nil {
+			return fmt.Errorf("failed to draw transition frame: %w", err)
+		}
+	}
+	return nil
+}
+
+// ensureTargets (re)creates the slide frames for the screen size.
+func (m *SceneManager) ensureTargets(renderer *graphics.Renderer, width, height int) error {
+	if m.targets[0] != nil && m.targetW == width && m.targetH == height {
+		return nil
+	}
+	m.Destroy()
+	for i := range m.targets {
+		target, err := renderer.NewRenderTarget(width, height)
+		if err != nil {
+			m.Destroy()
+			return err
+		}
+		m.targets[i] = target
+	}
+	m.targetW, m.targetH = width, height
+	return nil
+}
+
+// renderScene clears to the scene's background (or color for no scene) and draws it.
+func renderScene(renderer *graphics.Renderer, scene *Scene, color gamemath.Color) error {
+	if scene == nil {
+		return renderer.Clear(color)
+	}
+	if err := renderer.Clear(scene.GetBackgroundColor()); err != nil {
+		return fmt.Errorf("failed to clear screen: %w", err)
+	}
+	if err := scene.Render(renderer); err != nil {
+		return fmt.Errorf("failed to render scene: %w", err)
+	}
+	return nil
+}
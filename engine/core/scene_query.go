@@ -0,0 +1,100 @@
+package core
+
+import (
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// RaycastHit is a physics.RaycastHit resolved to a scene entity.
+type RaycastHit struct {
+	Entity   *Entity
+	Point    gamemath.Vector2 // World position of the hit
+	Normal   gamemath.Vector2 // Surface normal at the hit (unit, facing the ray)
+	Distance float64          // Distance from the ray origin
+}
+
+// Raycast finds the closest solid collider along a ray (see physics.Raycast)
+//
+// Parameters:
+//
+//	origin: Ray start in world space
+//	direction: Ray direction (normalized internally)
+//	maxDist: Maximum distance to test
+//	layerMask: Bitmask of collision layers to hit (physics.AllLayers = any)
+//
+// Returns:
+//
+//	RaycastHit: Closest hit
+//	bool: False if nothing was hit
+//
+// Example:
+//
+//	// Line of sight from guard to player
+//	toPlayer := player.Transform.Position.Sub(guard.Transform.Position)
+//	hit, ok := scene.Raycast(guard.Transform.Position, toPlayer, toPlayer.Length(), physics.AllLayers)
+//	canSee := ok && hit.Entity == player
+func (s *Scene) Raycast(origin, direction gamemath.Vector2, maxDist float64, layerMask int) (RaycastHit, bool) {
+	hit, ok := physics.Raycast(s.physicsEntities(), origin, direction, maxDist, layerMask)
+	if !ok {
+		s.recordRay(origin, direction, maxDist, nil)
+		return RaycastHit{}, false
+	}
+	result := sceneHit(hit)
+	s.recordRay(origin, direction, maxDist, &result)
+	return result, true
+}
+
+// RaycastAll returns every solid collider along a ray, nearest first.
+func (s *Scene) RaycastAll(origin, direction gamemath.Vector2, maxDist float64, layerMask int) []RaycastHit {
+	hits := physics.RaycastAll(s.physicsEntities(), origin, direction, maxDist, layerMask)
+	result := make([]RaycastHit, len(hits))
+	for i, hit := range hits {
+		result[i] = sceneHit(hit)
+	}
+	if len(result) == 0 {
+		s.recordRay(origin, direction, maxDist, nil)
+	}
+	for i := range result {
+		s.recordRay(origin, direction, maxDist, &result[i])
+	}
+	return result
+}
+
+// OverlapBox returns entities whose solid colliders overlap a world rectangle.
+func (s *Scene) OverlapBox(box gamemath.Rectangle, layerMask int) []*Entity {
+	return sceneEntities(physics.OverlapBox(s.physicsEntities(), box, layerMask))
+}
+
+// OverlapCircle returns entities whose solid colliders overlap a world circle.
+//
+// Example:
+//
+//	for _, entity := range scene.OverlapCircle(blast, 96, physics.AllLayers) {
+//	    damage(entity)
+//	}
+func (s *Scene) OverlapCircle(center gamemath.Vector2, radius float64, layerMask int) []*Entity {
+	return sceneEntities(physics.OverlapCircle(s.physicsEntities(), center, radius, layerMask))
+}
+
+// physicsEntities converts the entity list to the physics.Entity interface.
+func (s *Scene) physicsEntities() []physics.Entity {
+	entities := make([]physics.Entity, len(s.entities))
+	for i, entity := range s.entities {
+		entities[i] = entity
+	}
+	return entities
+}
+
+// sceneHit converts a physics hit to a scene hit.
+func sceneHit(hit physics.RaycastHit) RaycastHit {
+	return RaycastHit{Entity: hit.Entity.(*Entity), Point: hit.Point, Normal: hit.Normal, Distance: hit.Distance}
+}
+
+// sceneEntities converts physics entities back to scene entities.
+func sceneEntities(found []physics.Entity) []*Entity {
+	entities := make([]*Entity, len(found))
+	for i, entity := range found {
+		entities[i] = entity.(*Entity)
+	}
+	return entities
+}
@@ -0,0 +1,76 @@
+package core
+
+import "fmt"
+
+// SceneStats is a snapshot of scene contents for debug overlays and tests.
+type SceneStats struct {
+	Entities        int            // Total entities (active and inactive)
+	Active          int            // Active entities
+	Inactive        int            // Inactive entities
+	ByLayer         map[int]int    // Entity count per render layer
+	Sprites         int            // Entities with a sprite
+	Texts           int            // Entities with a text sprite
+	Colliders       int            // Entities with a collider
+	Triggers        int            // Colliders with IsTrigger set
+	BehaviorsByType map[string]int // Behavior count keyed by Go type (Behaviors are expanded)
+	CollisionPairs  int            // Colliding pairs detected last update
+	PendingRemovals int            // Entities queued for removal
+}
+
+// Stats reports entity, collider, and behavior counts for the scene.
+//
+// Returns:
+//
+//	SceneStats: Counts computed from the current entity list
+//
+// Example:
+//
+//	stats := scene.Stats()
+//	fmt.Printf("%d/%d active, %d collision pairs\n", stats.Active, stats.Entities, stats.CollisionPairs)
+func (s *Scene) Stats() SceneStats {
+	stats := SceneStats{
+		Entities:        len(s.entities),
+		ByLayer:         make(map[int]int),
+		BehaviorsByType: make(map[string]int),
+		CollisionPairs:  len(s.previousCollisions),
+		PendingRemovals: len(s.entitiesToRemove),
+	}
+
+	for _, entity := range s.entities {
+		if entity.Active {
+			stats.Active++
+		} else {
+			stats.Inactive++
+		}
+		stats.ByLayer[entity.Layer]++
+
+		if entity.Sprite != nil {
+			stats.Sprites++
+		}
+		if entity.Text != nil {
+			stats.Texts++
+		}
+		if entity.Collider != nil {
+			stats.Colliders++
+			if entity.Collider.IsTrigger {
+				stats.Triggers++
+			}
+		}
+		countBehaviors(entity.Behavior, stats.BehaviorsByType)
+	}
+
+	return stats
+}
+
+// countBehaviors tallies a behavior by type, expanding composite Behaviors.
+func countBehaviors(behavior Behavior, counts map[string]int) {
+	switch b := behavior.(type) {
+	case nil:
+	case Behaviors:
+		for _, child := range b {
+			countBehaviors(child, counts)
+		}
+	default:
+		counts[fmt.Sprintf("%T", b)]++
+	}
+}
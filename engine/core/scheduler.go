@@ -0,0 +1,348 @@
+package core
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// timeEpsilon absorbs floating-point drift when summing fixed steps, so a
+// 1 second timer fires on the 60th 1/60 s update rather than the 61st.
+const timeEpsilon = 1e-9
+
+// Scheduler runs delayed callbacks, repeating timers, and coroutine-style
+// scripts on the fixed update, so cooldowns and spawn timers don't have to
+// be hand-accumulated in every behavior.
+//
+// Every scene has one (see Scene.Scheduler), advanced at the start of each
+// Scene.Update and frozen while the engine is paused. Tasks created through
+// For(entity) wait while their entity is inactive and are cancelled when
+// it is removed from the scene.
+//
+// All callbacks and scripts run on the goroutine calling Update, one at a
+// time, so they may touch the scene freely.
+type Scheduler struct {
+	root  *Scheduler // Scheduler that owns the tasks (self for the root)
+	owner *Entity    // Entity tasks are tied to (nil = the scene)
+	tasks []*scheduledTask
+}
+
+// scheduledTask is a timer or coroutine.
+type scheduledTask struct {
+	owner     *Entity
+	elapsed   float64 // Seconds this task has been advanced
+	cancelled bool
+
+	// Timers
+	fn       func()
+	due      float64 // Elapsed time of the next call
+	interval float64 // Seconds between calls (0 = one-shot)
+	repeat   bool
+
+	// Per-update callbacks
+	tick func(dt float64)
+
+	// Coroutines
+	co *Coroutine
+}
+
+// NewScheduler creates an empty scheduler.
+func NewScheduler() *Scheduler {
+	s := &Scheduler{}
+	s.root = s
+	return s
+}
+
+// For returns a view of the scheduler whose tasks belong to an entity
+//
+// Behavior:
+//   - Tasks don't advance while the entity is inactive
+//   - Tasks are cancelled when the entity is removed from the scene (for
+//     the scene's own scheduler)
+//
+// Example:
+//
+//	scene.Scheduler().For(turret).Every(2*time.Second, func() { fire(turret) })
+func (s *Scheduler) For(entity *Entity) *Scheduler {
+	return &Scheduler{root: s.root, owner: entity}
+}
+
+// After calls fn once after a delay of update time
+//
+// Parameters:
+//
+//	delay: Time to wait (0 = on the next Update)
+//	fn: Callback
+//
+// Returns:
+//
+//	func(): Call to cancel (no effect once fired)
+//
+// Example:
+//
+//	scene.Scheduler().After(2*time.Second, func() { door.Active = false })
+func (s *Scheduler) After(delay time.Duration, fn func()) func() {
+	return s.add(&scheduledTask{fn: fn, due: delay.Seconds()})
+}
+
+// Every calls fn each interval of update time until cancelled
+//
+// Parameters:
+//
+//	interval: Time between calls (0 or less = every Update)
+//	fn: Callback
+//
+// Returns:
+//
+//	func(): Call to stop the timer
+//
+// Behavior:
+//   - The first call is one interval from now
+//   - A long update fires every interval it covered, keeping a steady rate
+//
+// Example:
+//
+//	stop := scene.Scheduler().Every(5*time.Second, spawnWave)
+//	defer stop()
+func (s *Scheduler) Every(interval time.Duration, fn func()) func() {
+	seconds := max(interval.Seconds(), 0)
+	return s.add(&scheduledTask{fn: fn, due: seconds, interval: seconds, repeat: true})
+}
+
+// OnUpdate calls fn on every Update with its dt until cancelled, for
+// systems that advance themselves (e.g. tween managers)
+//
+// Returns:
+//
+//	func(): Call to stop the callback
+func (s *Scheduler) OnUpdate(fn func(dt float64)) func() {
+	return s.add(&scheduledTask{tick: fn})
+}
+
+// Run starts a coroutine-style script
+//
+// Parameters:
+//
+//	script: Sequence of steps; call co.Wait, co.Yield, or co.WaitUntil to
+//	        pause until a later update
+//
+// Returns:
+//
+//	func(): Call to stop the script at its current wait
+//
+// Behavior:
+//   - The script runs immediately up to its first wait
+//   - Each script runs on its own goroutine, but only while Run or Update
+//     is waiting for it, so it behaves like a plain function call
+//   - A panic in the script is re-raised from Update (or Run)
+//   - Stopping a script unwinds it at its wait, running its deferred calls
+//
+// Example:
+//
+//	scene.Scheduler().Run(func(co *core.Coroutine) {
+//	    boss.SendMessage("roar", nil)
+//	    co.Wait(time.Second)
+//	    for i := 0; i < 3; i++ {
+//	        spawnMinion()
+//	        co.Wait(500 * time.Millisecond)
+//	    }
+//	    co.WaitUntil(func() bool { return len(scene.FindByTag("minion")) == 0 })
+//	    boss.SendMessage("enrage", nil)
+//	})
+func (s *Scheduler) Run(script func(co *Coroutine)) func() {
+	task := &scheduledTask{}
+	task.co = &Coroutine{
+		task:   task,
+		resume: make(chan bool),
+		yield:  make(chan struct{}),
+	}
+	cancel := s.add(task)
+	go task.co.start(script)
+	task.co.step(true)
+	return cancel
+}
+
+// add registers a task and returns its cancel function.
+func (s *Scheduler) add(task *scheduledTask) func() {
+	task.owner = s.owner
+	s.root.tasks = append(s.root.tasks, task)
+	return task.cancel
+}
+
+// Len returns the number of pending tasks.
+func (s *Scheduler) Len() int {
+	count := 0
+	for _, task := range s.root.tasks {
+		if !task.cancelled && (s.owner == nil || task.owner == s.owner) {
+			count++
+		}
+	}
+	return count
+}
+
+// Clear cancels every pending task (of this view's entity, for views
+// returned by For).
+func (s *Scheduler) Clear() {
+	for _, task := range s.root.tasks {
+		if s.owner == nil || task.owner == s.owner {
+			task.cancel()
+		}
+	}
+}
+
+// Update advances tasks by dt seconds
+//
+// Behavior:
+//   - Timers fire and coroutines resume in the order they were created
+//   - Tasks added during Update first advance on the next Update
+func (s *Scheduler) Update(dt float64) {
+	root := s.root
+	tasks := root.tasks
+	for _, task := range tasks {
+		if task.cancelled || (task.owner != nil && !task.owner.Active) {
+			continue
+		}
+		task.elapsed += dt
+		if task.tick != nil {
+			task.tick(dt)
+			continue
+		}
+		if task.co != nil {
+			if task.co.ready() {
+				task.co.step(true)
+			}
+			continue
+		}
+		task.fire()
+	}
+
+	// Drop finished tasks (keeping any added during the loop)
+	live := root.tasks[:0]
+	for _, task := range root.tasks {
+		if !task.cancelled {
+			live = append(live, task)
+		}
+	}
+	clear(root.tasks[len(live):])
+	root.tasks = live
+}
+
+// fire calls a timer's callback for each interval it has reached.
+func (t *scheduledTask) fire() {
+	if t.repeat && t.interval <= 0 {
+		t.fn()
+		return
+	}
+	for !t.cancelled && t.elapsed+timeEpsilon >= t.due {
+		if !t.repeat {
+			t.cancelled = true
+		}
+		t.fn()
+		t.due += t.interval
+	}
+}
+
+// cancel stops the task; a suspended coroutine is unwound now.
+func (t *scheduledTask) cancel() {
+	if t.cancelled {
+		return
+	}
+	t.cancelled = true
+	if t.co != nil && !t.co.running && !t.co.done {
+		t.co.step(false)
+	}
+}
+
+// cancelOwned cancels every task belonging to an entity.
+func (s *Scheduler) cancelOwned(entity *Entity) {
+	for _, task := range s.root.tasks {
+		if task.owner == entity {
+			task.cancel()
+		}
+	}
+}
+
+// Coroutine is a running script started by Scheduler.Run.
+type Coroutine struct {
+	task    *scheduledTask
+	resume  chan bool     // true = continue, false = unwind
+	yield   chan struct{} // Script paused or finished
+	running bool
+	done    bool
+	panic   any // Recovered script panic, re-raised by step
+
+	wakeAt float64     // Task elapsed time to resume at
+	until  func() bool // Condition to resume on (nil = time only)
+}
+
+// Wait pauses the script for a duration of update time.
+func (co *Coroutine) Wait(d time.Duration) {
+	co.wakeAt, co.until = co.task.elapsed+d.Seconds(), nil
+	co.suspend()
+}
+
+// Yield pauses the script until the next update.
+func (co *Coroutine) Yield() {
+	co.wakeAt, co.until = co.task.elapsed, nil
+	co.suspend()
+}
+
+// WaitUntil pauses the script until cond returns true, checking once per
+// update (starting with the next one).
+func (co *Coroutine) WaitUntil(cond func() bool) {
+	co.wakeAt, co.until = co.task.elapsed, cond
+	co.suspend()
+}
+
+// Elapsed returns the update time the script has run for, in seconds.
+func (co *Coroutine) Elapsed() float64 {
+	return co.task.elapsed
+}
+
+// ready reports whether the script's wait is over.
+func (co *Coroutine) ready() bool {
+	if co.task.elapsed+timeEpsilon < co.wakeAt {
+		return false
+	}
+	return co.until == nil || co.until()
+}
+
+// start runs the script on its goroutine once first resumed.
+func (co *Coroutine) start(script func(co *Coroutine)) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			co.panic = recovered
+		}
+		co.done = true
+		co.task.cancelled = true
+		co.yield <- struct{}{}
+	}()
+	if !<-co.resume {
+		return
+	}
+	script(co)
+}
+
+// suspend hands control back to the scheduler until resumed, exiting the
+// goroutine (running deferred calls) if the script was stopped.
+func (co *Coroutine) suspend() {
+	if co.task.cancelled {
+		runtime.Goexit()
+	}
+	co.yield <- struct{}{}
+	if !<-co.resume {
+		runtime.Goexit()
+	}
+}
+
+// step resumes (or unwinds) the script and waits until it pauses again.
+func (co *Coroutine) step(resume bool) {
+	co.running = true
+	co.resume <- resume
+	<-co.yield
+	co.running = false
+	if recovered := co.panic; recovered != nil {
+		co.panic = nil
+		panic(fmt.Sprintf("core: coroutine panic: %v", recovered))
+	}
+}
@@ -0,0 +1,439 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// SceneFormatVersion is the scene file version written by Scene.Save.
+const SceneFormatVersion = 1
+
+// Scene serialization errors.
+var (
+	ErrSceneVersion      = errors.New("scene file was written by a newer version")
+	ErrDuplicateEntityID = errors.New("duplicate entity ID")
+)
+
+// SceneFile is the JSON document written by Scene.Save.
+//
+// Output is canonical: entities are sorted by ID, layers by Z, every field is
+// always written in a fixed order, and floats use Go's shortest exact
+// representation. Saving a loaded scene therefore reproduces the original
+// bytes, so level files diff cleanly under version control.
+type SceneFile struct {
+	Version    int          `json:"version"`
+	Background ColorData    `json:"background"`
+	NextID     uint64       `json:"next_id"` // ID the next added entity receives
+	Layers     []LayerData  `json:"layers"`
+	Entities   []EntityData `json:"entities"`
+}
+
+// ColorData is a serialized color.
+type ColorData struct {
+	R uint8 `json:"r"`
+	G uint8 `json:"g"`
+	B uint8 `json:"b"`
+	A uint8 `json:"a"`
+}
+
+// LayerData is a serialized SceneLayer.
+type LayerData struct {
+	Name        string  `json:"name"`
+	Z           int     `json:"z"`
+	Visible     bool    `json:"visible"`
+	Locked      bool    `json:"locked"`
+	ParallaxX   float64 `json:"parallax_x"`
+	ParallaxY   float64 `json:"parallax_y"`
+	ScreenSpace bool    `json:"screen_space"`
+}
+
+// EntityData is a serialized entity. Behaviors and components registered
+// with RegisterBehavior or RegisterComponentType are saved with their
+// fields; other behaviors and callbacks are code and are not serialized, so
+// attach them after loading (look entities up by their stable IDs with
+// Scene.GetEntity).
+type EntityData struct {
+	ID       uint64        `json:"id"`
+	Active   bool          `json:"active"`
+	Layer    int           `json:"layer"`
+	X        float64       `json:"x"`
+	Y        float64       `json:"y"`
+	Rotation float64       `json:"rotation"`
+	ScaleX   float64       `json:"scale_x"`
+	ScaleY   float64       `json:"scale_y"`
+	Sprite   *SpriteData   `json:"sprite,omitempty"`
+	Collider *ColliderData `json:"collider,omitempty"`
+	Body     *BodyData     `json:"body,omitempty"`
+
+	Components []ComponentDefinition `json:"components,omitempty"` // Registered behaviors and components, in update order
+
+	Tags             []string `json:"tags,omitempty"`
+	UpdateWhenPaused bool     `json:"update_when_paused,omitempty"`
+	Parent           uint64   `json:"parent,omitempty"` // Parent entity ID (0 = root; X/Y are then local)
+}
+
+// SpriteData is a serialized sprite; the texture is referenced by path.
+type SpriteData struct {
+	Texture string             `json:"texture"`
+	Source  gamemath.Rectangle `json:"source"`
+	Color   ColorData          `json:"color"`
+	Alpha   float64            `json:"alpha"`
+	FlipH   bool               `json:"flip_h"`
+	FlipV   bool               `json:"flip_v"`
+	PivotX  float64            `json:"pivot_x"`
+	PivotY  float64            `json:"pivot_y"`
+}
+
+// ColliderData is a serialized collider.
+type ColliderData struct {
+	Bounds    gamemath.Rectangle `json:"bounds"`
+	OffsetX   float64            `json:"offset_x"`
+	OffsetY   float64            `json:"offset_y"`
+	IsTrigger bool               `json:"is_trigger"`
+	Layer     int                `json:"layer"`
+	Mask      int                `json:"mask"`
+	Material  *MaterialData      `json:"material,omitempty"`
+	Shape     *ShapeData         `json:"shape,omitempty"`
+}
+
+// ShapeData is a serialized collider shape.
+type ShapeData struct {
+	Type   string  `json:"type"` // "circle" or "box"
+	Radius float64 `json:"radius,omitempty"`
+	Width  float64 `json:"width,omitempty"`
+	Height float64 `json:"height,omitempty"`
+}
+
+// BodyData is a serialized rigid body.
+type BodyData struct {
+	Type          string  `json:"type"`
+	VelocityX     float64 `json:"velocity_x"`
+	VelocityY     float64 `json:"velocity_y"`
+	AccelerationX float64 `json:"acceleration_x"`
+	AccelerationY float64 `json:"acceleration_y"`
+	Mass          float64 `json:"mass"`
+	Drag          float64 `json:"drag"`
+	GravityScale  float64 `json:"gravity_scale"`
+}
+
+// MaterialData is a serialized physics material.
+type MaterialData struct {
+	Name        string  `json:"name"`
+	Friction    float64 `json:"friction"`
+	Restitution float64 `json:"restitution"`
+}
+
+// Save writes the scene as canonical, indented JSON.
+//
+// Parameters:
+//
+//	w: Destination (file, buffer)
+//
+// Returns:
+//
+//	error: Non-nil if encoding or writing fails
+//
+// Behavior:
+//   - Entities pending removal are still written (call after Update to exclude them)
+//   - Save → LoadScene → Save produces byte-identical output, including IDs
+//
+// Example:
+//
+//	file, err := os.Create("levels/level1.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer file.Close()
+//	if err := scene.Save(file); err != nil {
+//	    log.Fatal(err)
+//	}
+func (s *Scene) Save(w io.Writer) error {
+	data, err := json.MarshalIndent(s.File(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode scene: %w", err)
+	}
+	data = append(data, '\n')
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write scene: %w", err)
+	}
+	return nil
+}
+
+// File converts the scene to its serializable form.
+func (s *Scene) File() SceneFile {
+	file := SceneFile{
+		Version:    SceneFormatVersion,
+		Background: colorData(s.backgroundColor),
+		NextID:     s.nextEntityID,
+		Layers:     make([]LayerData, 0, len(s.layers)),
+		Entities:   make([]EntityData, 0, len(s.entities)),
+	}
+	for _, layer := range s.layers {
+		file.Layers = append(file.Layers, LayerData{
+			Name:        layer.Name,
+			Z:           layer.Z,
+			Visible:     layer.Visible,
+			Locked:      layer.Locked,
+			ParallaxX:   layer.Parallax.X,
+			ParallaxY:   layer.Parallax.Y,
+			ScreenSpace: layer.ScreenSpace,
+		})
+	}
+	sort.Slice(file.Layers, func(i, j int) bool { return file.Layers[i].Z < file.Layers[j].Z })
+
+	for _, entity := range s.entities {
+		file.Entities = append(file.Entities, entityData(entity))
+	}
+	sort.Slice(file.Entities, func(i, j int) bool { return file.Entities[i].ID < file.Entities[j].ID })
+	return file
+}
+
+// LoadScene reads a scene written by Scene.Save.
+//
+// Parameters:
+//
+//	r: Source of scene JSON
+//	assets: Asset manager used to load sprite textures (nil = keep texture
+//	        paths on placeholder textures, for tools that run without a renderer)
+//
+// Returns:
+//
+//	*Scene: Scene with entities in ID order and their saved IDs
+//	error: Non-nil for malformed JSON, newer versions, duplicate IDs, or missing textures
+//
+// Example:
+//
+//	file, err := os.Open("levels/level1.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer file.Close()
+//	scene, err := core.LoadScene(file, engine.Assets())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	engine.SetScene(scene)
+func LoadScene(r io.Reader, assets *graphics.AssetManager) (*Scene, error) {
+	var file SceneFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to decode scene: %w", err)
+	}
+	return NewSceneFromFile(file, assets)
+}
+
+// NewSceneFromFile builds a scene from its serializable form.
+func NewSceneFromFile(file SceneFile, assets *graphics.AssetManager) (*Scene, error) {
+	if file.Version > SceneFormatVersion {
+		return nil, fmt.Errorf("%w: version %d (supported: %d)", ErrSceneVersion, file.Version, SceneFormatVersion)
+	}
+
+	scene := NewScene()
+	scene.backgroundColor = gamemath.Color(file.Background)
+	for _, data := range file.Layers {
+		layer := scene.AddLayer(data.Name, data.Z)
+		layer.Visible = data.Visible
+		layer.Locked = data.Locked
+		layer.Parallax = gamemath.Vector2{X: data.ParallaxX, Y: data.ParallaxY}
+		layer.ScreenSpace = data.ScreenSpace
+	}
+
+	entities := append([]EntityData(nil), file.Entities...)
+	sort.SliceStable(entities, func(i, j int) bool { return entities[i].ID < entities[j].ID })
+	for _, data := range entities {
+		if data.ID == 0 {
+			return nil, fmt.Errorf("invalid entity ID 0 in scene file")
+		}
+		if _, exists := scene.entityIndex[data.ID]; exists {
+			return nil, fmt.Errorf("%w: %d", ErrDuplicateEntityID, data.ID)
+		}
+		entity, err := newEntityFromData(data, assets)
+		if err != nil {
+			return nil, err
+		}
+		scene.entities = append(scene.entities, entity)
+		scene.entityIndex[entity.ID] = entity
+		scene.nextEntityID = max(scene.nextEntityID, entity.ID+1)
+	}
+	for _, data := range entities {
+		if data.Parent == 0 {
+			continue
+		}
+		parent := scene.entityIndex[data.Parent]
+		if parent == nil {
+			return nil, fmt.Errorf("entity %d has unknown parent %d", data.ID, data.Parent)
+		}
+		if err := scene.entityIndex[data.ID].SetParent(parent); err != nil {
+			return nil, fmt.Errorf("invalid parent for entity %d: %w", data.ID, err)
+		}
+	}
+	scene.nextEntityID = max(scene.nextEntityID, file.NextID)
+	return scene, nil
+}
+
+// entityData converts an entity to its serializable form.
+func entityData(entity *Entity) EntityData {
+	data := EntityData{
+		ID:       entity.ID,
+		Active:   entity.Active,
+		Layer:    entity.Layer,
+		X:        entity.Transform.Position.X,
+		Y:        entity.Transform.Position.Y,
+		Rotation: entity.Transform.Rotation,
+		ScaleX:   entity.Transform.Scale.X,
+		ScaleY:   entity.Transform.Scale.Y,
+
+		Tags:             append([]string(nil), entity.Tags...),
+		UpdateWhenPaused: entity.UpdateWhenPaused,
+	}
+	if entity.parent != nil {
+		data.Parent = entity.parent.ID
+	}
+	if sprite := entity.Sprite; sprite != nil {
+		data.Sprite = &SpriteData{
+			Source: sprite.SourceRect,
+			Color:  colorData(sprite.Color),
+			Alpha:  sprite.Alpha,
+			FlipH:  sprite.FlipH,
+			FlipV:  sprite.FlipV,
+			PivotX: sprite.Pivot.X,
+			PivotY: sprite.Pivot.Y,
+		}
+		if sprite.Texture != nil {
+			data.Sprite.Texture = sprite.Texture.Path
+		}
+	}
+	if collider := entity.Collider; collider != nil {
+		data.Collider = &ColliderData{
+			Bounds:    collider.Bounds,
+			OffsetX:   collider.Offset.X,
+			OffsetY:   collider.Offset.Y,
+			IsTrigger: collider.IsTrigger,
+			Layer:     collider.CollisionLayer,
+			Mask:      collider.CollisionMask,
+		}
+		if material := collider.Material; material != nil {
+			data.Collider.Material = &MaterialData{Name: material.Name, Friction: material.Friction, Restitution: material.Restitution}
+		}
+		switch shape := collider.Shape.(type) {
+		case physics.Circle:
+			data.Collider.Shape = &ShapeData{Type: "circle", Radius: shape.Radius}
+		case physics.OrientedBox:
+			data.Collider.Shape = &ShapeData{Type: "box", Width: shape.Width, Height: shape.Height}
+		}
+	}
+	if body := entity.Body; body != nil {
+		data.Body = &BodyData{
+			Type:          body.Type.String(),
+			VelocityX:     body.Velocity.X,
+			VelocityY:     body.Velocity.Y,
+			AccelerationX: body.Acceleration.X,
+			AccelerationY: body.Acceleration.Y,
+			Mass:          body.Mass,
+			Drag:          body.Drag,
+			GravityScale:  body.GravityScale,
+		}
+	}
+	// Unregistered components are code the game reattaches
+	for _, component := range entity.Components() {
+		if definition, err := defaultComponents.Encode(component); err == nil {
+			data.Components = append(data.Components, definition)
+		}
+	}
+	return data
+}
+
+// newEntityFromData rebuilds an entity, loading its texture through assets.
+func newEntityFromData(data EntityData, assets *graphics.AssetManager) (*Entity, error) {
+	entity := &Entity{
+		ID:     data.ID,
+		Active: data.Active,
+		Layer:  data.Layer,
+		Transform: gamemath.Transform{
+			Position: gamemath.Vector2{X: data.X, Y: data.Y},
+			Rotation: data.Rotation,
+			Scale:    gamemath.Vector2{X: data.ScaleX, Y: data.ScaleY},
+		},
+		Tags:             append([]string(nil), data.Tags...),
+		UpdateWhenPaused: data.UpdateWhenPaused,
+	}
+	if data.Sprite != nil {
+		sprite := &graphics.Sprite{
+			SourceRect: data.Sprite.Source,
+			Color:      gamemath.Color(data.Sprite.Color),
+			Alpha:      data.Sprite.Alpha,
+			FlipH:      data.Sprite.FlipH,
+			FlipV:      data.Sprite.FlipV,
+			Pivot:      gamemath.Vector2{X: data.Sprite.PivotX, Y: data.Sprite.PivotY},
+		}
+		if path := data.Sprite.Texture; path != "" {
+			if assets != nil {
+				texture, err := assets.LoadTexture(path)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load sprite for entity %d: %w", data.ID, err)
+				}
+				sprite.Texture = texture
+				if sprite.SourceRect.Width == 0 && sprite.SourceRect.Height == 0 {
+					sprite.SourceRect = gamemath.Rectangle{Width: float64(texture.Width), Height: float64(texture.Height)}
+				}
+			} else {
+				sprite.Texture = graphics.NewTexture(nil, 0, 0, path)
+			}
+		}
+		entity.Sprite = sprite
+	}
+	if data.Collider != nil {
+		entity.Collider = &physics.Collider{
+			Bounds:         data.Collider.Bounds,
+			Offset:         gamemath.Vector2{X: data.Collider.OffsetX, Y: data.Collider.OffsetY},
+			IsTrigger:      data.Collider.IsTrigger,
+			CollisionLayer: data.Collider.Layer,
+			CollisionMask:  data.Collider.Mask,
+		}
+		if m := data.Collider.Material; m != nil {
+			entity.Collider.Material = &physics.Material{Name: m.Name, Friction: m.Friction, Restitution: m.Restitution}
+		}
+		if shape := data.Collider.Shape; shape != nil {
+			switch shape.Type {
+			case "circle":
+				entity.Collider.Shape = physics.Circle{Radius: shape.Radius}
+			case "box":
+				entity.Collider.Shape = physics.OrientedBox{Width: shape.Width, Height: shape.Height}
+			default:
+				return nil, fmt.Errorf("invalid collider shape %q for entity %d", shape.Type, data.ID)
+			}
+		}
+	}
+	if data.Body != nil {
+		bodyType, err := physics.ParseBodyType(data.Body.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid body for entity %d: %w", data.ID, err)
+		}
+		entity.Body = &physics.RigidBody{
+			Type:         bodyType,
+			Velocity:     gamemath.Vector2{X: data.Body.VelocityX, Y: data.Body.VelocityY},
+			Acceleration: gamemath.Vector2{X: data.Body.AccelerationX, Y: data.Body.AccelerationY},
+			Mass:         data.Body.Mass,
+			Drag:         data.Body.Drag,
+			GravityScale: data.Body.GravityScale,
+		}
+	}
+	for _, definition := range data.Components {
+		component, err := defaultComponents.Build(definition.Type, definition.Params)
+		if err != nil {
+			return nil, fmt.Errorf("invalid component for entity %d: %w", data.ID, err)
+		}
+		entity.AddComponent(component)
+	}
+	return entity, nil
+}
+
+func colorData(c gamemath.Color) ColorData {
+	return ColorData(c)
+}
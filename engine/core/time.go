@@ -9,14 +9,15 @@ import "time"
 // - Variable render rate (as fast as possible with vsync)
 // - Accumulator prevents spiral of death.
 type Time struct {
-	dt           float64   // Fixed delta time in seconds (1/60 = 0.016667)
-	accumulator  float64   // Time accumulated since last update
-	lastTime     time.Time // Last frame timestamp
-	targetFPS    float64   // Target updates per second (60.0)
-	maxFrameTime float64   // Maximum frame time to prevent spiral of death (0.25 seconds)
-	minFrameTime float64   // Minimum frame time observed (best performance)
-	maxObserved  float64   // Maximum frame time observed (worst performance)
-	avgFrameTime float64   // Rolling average frame time (EMA with alpha=0.1)
+	dt           float64          // Fixed delta time in seconds (1/60 = 0.016667)
+	accumulator  float64          // Time accumulated since last update
+	lastTime     time.Time        // Last frame timestamp
+	targetFPS    float64          // Target updates per second (60.0)
+	maxFrameTime float64          // Maximum frame time to prevent spiral of death (0.25 seconds)
+	minFrameTime float64          // Minimum frame time observed (best performance)
+	maxObserved  float64          // Maximum frame time observed (worst performance)
+	avgFrameTime float64          // Rolling average frame time (EMA with alpha=0.1)
+	now          func() time.Time // Clock source (time.Now unless replaced by SetClock)
 }
 
 // NewTime creates a new time manager with 60 FPS target.
@@ -31,9 +32,34 @@ func NewTime() *Time {
 		minFrameTime: 1.0,    // Start at 1 second, will be replaced by first frame
 		maxObserved:  0.0,    // Start at 0, will increase
 		avgFrameTime: 0.0167, // Start at ~60 FPS (1/60 seconds)
+		now:          time.Now,
 	}
 }
 
+// SetClock replaces the clock Tick reads, for deterministic tests
+//
+// Parameters:
+//
+//	now: Clock source (nil restores time.Now)
+//
+// Behavior:
+//   - The next Tick measures from now() at the time of the call
+//
+// Example:
+//
+//	clock := gogametest.NewFakeClock()
+//	timer := core.NewTime()
+//	timer.SetClock(clock.Now)
+//	clock.Advance(60 * time.Millisecond)
+//	updates, _ := timer.Tick() // 3 fixed updates
+func (t *Time) SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	t.now = now
+	t.lastTime = now()
+}
+
 // Tick advances the timer and returns how many fixed updates should run
 //
 // Returns:
@@ -48,7 +74,7 @@ func NewTime() *Time {
 //	    scene.Update(dt)
 //	}
 func (t *Time) Tick() (updateCount int, dt float64) {
-	now := time.Now()
+	now := t.now()
 	frameTime := now.Sub(t.lastTime).Seconds()
 	t.lastTime = now
 
@@ -81,6 +107,12 @@ func (t *Time) Tick() (updateCount int, dt float64) {
 	return updateCount, t.dt
 }
 
+// untilNextUpdate returns the time left before another fixed update is due.
+func (t *Time) untilNextUpdate() time.Duration {
+	remaining := t.dt - t.accumulator - t.now().Sub(t.lastTime).Seconds()
+	return time.Duration(max(remaining, 0) * float64(time.Second))
+}
+
 // DeltaTime returns the fixed delta time in seconds.
 func (t *Time) DeltaTime() float64 {
 	return t.dt
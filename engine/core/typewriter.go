@@ -0,0 +1,130 @@
+package core
+
+import (
+	"unicode"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+)
+
+// Typewriter reveals an entity's text a few characters at a time, for
+// dialogue and intro text.
+//
+// Attach it as (or alongside) the entity's behavior; it drives the
+// entity's Text through TextSprite.HiddenChars, so the text keeps its full
+// layout while revealing. Spaces appear with the next character and don't
+// count toward the rate. Changing Text.Text restarts the effect.
+//
+// Example:
+//
+//	box := &core.Entity{Active: true, Text: graphics.NewTextSprite(line, font)}
+//	box.Text.Layout = graphics.TextLayout{Width: 560, MaxLines: 3}
+//	writer := core.NewTypewriter(30)
+//	writer.Input, writer.SkipKeys = inputMgr, []input.KeyCode{input.KeySpace}
+//	writer.OnChar = func(rune) { audioMgr.PlaySound(blip) }
+//	box.Behavior = writer
+type Typewriter struct {
+	CharsPerSecond float64              // Reveal rate (0 or less = everything at once)
+	Input          *input.InputManager  // Manager SkipKeys are read from (nil = no skip keys)
+	SkipKeys       []input.KeyCode      // Pressing any reveals the rest at once
+	OnChar         func(ch rune)        // Called per revealed character (sound ticks); not called on skip
+	OnComplete     func(entity *Entity) // Called once the whole text shows
+
+	text     string  // Text being revealed
+	total    int     // Characters to reveal
+	progress float64 // Characters revealed, fractional
+	shown    int     // Characters revealed
+	done     bool
+}
+
+// NewTypewriter creates a typewriter revealing charsPerSecond characters
+// per second.
+func NewTypewriter(charsPerSecond float64) *Typewriter {
+	return &Typewriter{CharsPerSecond: charsPerSecond}
+}
+
+// Update reveals characters for this step.
+func (t *Typewriter) Update(entity *Entity, dt float64) {
+	text := entity.Text
+	if text == nil {
+		return
+	}
+	if text.Text != t.text {
+		t.start(text.Text)
+	}
+	if t.done {
+		return
+	}
+
+	if t.skipPressed() || t.CharsPerSecond <= 0 {
+		t.shown = t.total
+	} else {
+		t.progress += t.CharsPerSecond * dt
+		t.reveal(min(int(t.progress+timeEpsilon), t.total))
+	}
+	text.HiddenChars = t.total - t.shown
+	if t.shown == t.total {
+		t.done = true
+		if t.OnComplete != nil {
+			t.OnComplete(entity)
+		}
+	}
+}
+
+// start resets the effect for new text.
+func (t *Typewriter) start(text string) {
+	t.text = text
+	t.total = graphics.GlyphCount(text)
+	t.progress, t.shown, t.done = 0, 0, false
+}
+
+// reveal advances to shown characters, calling OnChar for each new one.
+func (t *Typewriter) reveal(shown int) {
+	if t.OnChar == nil {
+		t.shown = shown
+		return
+	}
+	index := 0
+	for _, ch := range t.text {
+		if unicode.IsSpace(ch) {
+			continue
+		}
+		if index >= shown {
+			break
+		}
+		if index >= t.shown {
+			t.OnChar(ch)
+		}
+		index++
+	}
+	t.shown = shown
+}
+
+// skipPressed reports whether a skip key was pressed this frame.
+func (t *Typewriter) skipPressed() bool {
+	if t.Input == nil {
+		return false
+	}
+	for _, key := range t.SkipKeys {
+		if t.Input.KeyPressed(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Skip reveals the rest of the text on the next update.
+func (t *Typewriter) Skip() {
+	t.progress = float64(t.total)
+	t.shown = t.total
+}
+
+// Restart reveals the text again from the beginning.
+func (t *Typewriter) Restart() {
+	t.text = ""
+}
+
+// Done reports whether the whole text is showing.
+func (t *Typewriter) Done() bool {
+	return t.done
+}
@@ -0,0 +1,251 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// ErrInvalidWindowSize is returned by SetWindowSize and SetLogicalSize for
+// sizes they can't use.
+var ErrInvalidWindowSize = errors.New("invalid window size")
+
+// SetFullscreen switches between desktop fullscreen and windowed mode
+//
+// Parameters:
+//
+//	fullscreen: True for fullscreen at the desktop resolution, false for
+//	            the previous window size
+//
+// Returns:
+//
+//	error: Non-nil if SDL can't change the mode
+//
+// Behavior:
+//   - Cameras, UI layout, and the resize callback see the new size
+//     immediately
+//
+// Example:
+//
+//	if engine.Input().KeyPressed(input.KeyF11) {
+//	    _ = engine.SetFullscreen(!engine.Fullscreen())
+//	}
+func (e *Engine) SetFullscreen(fullscreen bool) error {
+	if e.window == nil {
+		return nil
+	}
+	var flags uint32
+	if fullscreen {
+		flags = sdl.WINDOW_FULLSCREEN_DESKTOP
+	}
+	if err := e.window.SetFullscreen(flags); err != nil {
+		return fmt.Errorf("failed to set fullscreen: %w", err)
+	}
+	e.syncWindowSize()
+	return nil
+}
+
+// Fullscreen reports whether the window is fullscreen.
+func (e *Engine) Fullscreen() bool {
+	if e.window == nil {
+		return false
+	}
+	return e.window.GetFlags()&sdl.WINDOW_FULLSCREEN != 0
+}
+
+// SetWindowSize resizes the window
+//
+// Parameters:
+//
+//	width, height: New size in pixels (must be positive)
+//
+// Returns:
+//
+//	error: ErrInvalidWindowSize for non-positive sizes
+//
+// Behavior:
+//   - Keeps the window centered on its display
+//   - In fullscreen the size applies when returning to windowed mode
+func (e *Engine) SetWindowSize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("%w: %dx%d", ErrInvalidWindowSize, width, height)
+	}
+	if e.window == nil {
+		e.resize(width, height) // Headless: only cameras and UI are sized
+		return nil
+	}
+	e.window.SetSize(int32(width), int32(height))
+	if !e.Fullscreen() {
+		e.window.SetPosition(sdl.WINDOWPOS_CENTERED, sdl.WINDOWPOS_CENTERED)
+	}
+	e.syncWindowSize()
+	return nil
+}
+
+// WindowSize returns the window size in pixels, which differs from
+// Width and Height when a logical size is set.
+func (e *Engine) WindowSize() (int, int) {
+	if e.window == nil {
+		return e.windowW, e.windowH
+	}
+	width, height := e.window.GetSize()
+	return int(width), int(height)
+}
+
+// SetLogicalSize renders at a fixed design resolution scaled to fit the
+// window.
+//
+// Parameters:
+//
+//	width, height: Design resolution in pixels (0, 0 = follow the window
+//	               size again)
+//
+// Returns:
+//
+//	error: ErrInvalidWindowSize for negative sizes or only one zero, or an
+//	       error if SDL can't scale
+//
+// Behavior:
+//   - The scene, UI, post effects, and perf HUD all work in logical pixels:
+//     Width, Height, and camera screen sizes report the logical size
+//   - The image keeps its aspect ratio, with bars above and below
+//     (letterbox) or at the sides (pillarbox) when the window's differs;
+//     the bars show the scene's clear color
+//   - Mouse events are converted to logical pixels, so MousePosition and
+//     Camera.ScreenToWorld need no changes; positions over the bars fall
+//     outside 0..width and 0..height
+//   - Window resizes no longer change the render size, so the resize
+//     callback only fires when the logical size itself changes
+//
+// Example:
+//
+//	// Designed at 800x600, shown at any window size
+//	if err := engine.SetLogicalSize(800, 600); err != nil {
+//	    log.Fatal(err)
+//	}
+//	engine.SetResizable(true)
+func (e *Engine) SetLogicalSize(width, height int) error {
+	if width < 0 || height < 0 || (width == 0) != (height == 0) {
+		return fmt.Errorf("%w: logical %dx%d", ErrInvalidWindowSize, width, height)
+	}
+	if e.renderer != nil && e.renderer.GetSDLRenderer() != nil {
+		if err := e.renderer.GetSDLRenderer().SetLogicalSize(int32(width), int32(height)); err != nil {
+			return fmt.Errorf("failed to set logical size: %w", err)
+		}
+	}
+	e.logicalW, e.logicalH = width, height
+	windowWidth, windowHeight := e.WindowSize()
+	e.resize(windowWidth, windowHeight)
+	return nil
+}
+
+// LogicalSize returns the design resolution (0, 0 when rendering at the
+// window size).
+func (e *Engine) LogicalSize() (int, int) {
+	return e.logicalW, e.logicalH
+}
+
+// SetResizable allows or prevents resizing the window by its border.
+func (e *Engine) SetResizable(resizable bool) {
+	if e.window != nil {
+		e.window.SetResizable(resizable)
+	}
+}
+
+// SetVSync turns vertical sync on or off (on by default)
+//
+// Returns:
+//
+//	error: Non-nil if the renderer can't change it (SDL before 2.0.18 can
+//	       only set vsync when the engine is created)
+//
+// Behavior:
+//   - Without vsync the loop renders as fast as it can; the fixed
+//     timestep keeps updates at 60 per second either way
+func (e *Engine) SetVSync(vsync bool) error {
+	if e.renderer == nil || e.renderer.GetSDLRenderer() == nil {
+		return nil
+	}
+	if err := e.renderer.GetSDLRenderer().RenderSetVSync(vsync); err != nil {
+		return fmt.Errorf("failed to set vsync: %w", err)
+	}
+	return nil
+}
+
+// SetWindowIcon sets the window icon from an image file
+//
+// Parameters:
+//
+//	path: PNG or JPEG file, read through the asset manager (so from the
+//	      mounted filesystem if any)
+//
+// Returns:
+//
+//	error: Non-nil if the image can't be loaded
+//
+// Example:
+//
+//	if err := engine.SetWindowIcon("assets/icon.png"); err != nil {
+//	    log.Printf("no icon: %v", err)
+//	}
+func (e *Engine) SetWindowIcon(path string) error {
+	if e.window == nil {
+		return nil
+	}
+	surface, err := e.assetMgr.LoadSurface(path)
+	if err != nil {
+		return fmt.Errorf("failed to set window icon: %w", err)
+	}
+	defer surface.Free()
+	e.window.SetIcon(surface)
+	return nil
+}
+
+// SetResizeCallback sets a callback for window size changes
+//
+// Parameters:
+//
+//	callback: Called with the new render size (see Width) after cameras
+//	          and UI layout have been updated (nil = none)
+//
+// Behavior:
+//   - Called for user resizes, SetWindowSize, and SetFullscreen, once per
+//     actual change
+//   - With a logical size set, called only by SetLogicalSize
+//
+// Example:
+//
+//	engine.SetResizeCallback(func(width, height int) {
+//	    minimap.Viewport = gamemath.Rectangle{X: 1 - 160/float64(width), Width: 160 / float64(width), Height: 120 / float64(height)}
+//	})
+func (e *Engine) SetResizeCallback(callback func(width, height int)) {
+	e.onResize = callback
+}
+
+// syncWindowSize applies the window's current size.
+func (e *Engine) syncWindowSize() {
+	width, height := e.window.GetSize()
+	e.resize(int(width), int(height))
+}
+
+// resize applies a new window size and updates everything sized by the
+// render size (the logical size when set).
+func (e *Engine) resize(windowWidth, windowHeight int) {
+	e.windowW, e.windowH = windowWidth, windowHeight
+	width, height := windowWidth, windowHeight
+	if e.logicalW > 0 {
+		width, height = e.logicalW, e.logicalH
+	}
+	if width == e.width && height == e.height {
+		return
+	}
+	e.width, e.height = width, height
+	if e.scene != nil {
+		e.scene.setScreenSize(width, height)
+	}
+	e.ui.Layout(width, height)
+	if e.onResize != nil {
+		e.onResize(width, height)
+	}
+}
@@ -0,0 +1,212 @@
+// Package crafting provides recipe definitions and a resolver that crafts items from an inventory.
+package crafting
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dshills/gogame/engine/inventory"
+)
+
+// Errors returned by the crafting resolver.
+var (
+	ErrUnknownRecipe      = errors.New("unknown recipe")
+	ErrWrongStation       = errors.New("wrong crafting station")
+	ErrMissingRequirement = errors.New("missing requirement")
+	ErrMissingIngredients = errors.New("missing ingredients")
+	ErrInvalidCraftTimes  = errors.New("invalid craft count")
+	ErrOutputsDoNotFit    = errors.New("outputs do not fit in inventory")
+)
+
+// Recipe converts input items into output items.
+type Recipe struct {
+	ID           string                // Unique recipe identifier
+	Name         string                // Display name
+	Inputs       []inventory.ItemStack // Consumed items
+	Outputs      []inventory.ItemStack // Produced items
+	Station      string                // Required station tag (e.g. "forge"); empty = anywhere
+	Requirements []string              // Tags the crafter must have (e.g. "smithing_2")
+}
+
+// Context describes where and by whom crafting happens.
+type Context struct {
+	Station string   // Station the crafter is using ("" = none)
+	Tags    []string // Unlocked skills, perks, or flags
+}
+
+// hasTag reports whether the context carries a tag.
+func (c Context) hasTag(tag string) bool {
+	for _, t := range c.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// RecipeBook is an ordered collection of recipes and the crafting resolver.
+type RecipeBook struct {
+	recipes []*Recipe
+	index   map[string]*Recipe
+
+	// OnCraft is called after a recipe is crafted.
+	OnCraft func(recipe *Recipe, times int)
+}
+
+// NewRecipeBook creates a recipe book.
+//
+// Example:
+//
+//	book := crafting.NewRecipeBook(&crafting.Recipe{
+//	    ID:      "iron_sword",
+//	    Inputs:  []inventory.ItemStack{{ID: "iron", Quantity: 3}, {ID: "wood", Quantity: 1}},
+//	    Outputs: []inventory.ItemStack{{ID: "iron_sword", Quantity: 1}},
+//	    Station: "forge",
+//	})
+//	err := book.Craft("iron_sword", bag, crafting.Context{Station: "forge"}, 1)
+func NewRecipeBook(recipes ...*Recipe) *RecipeBook {
+	book := &RecipeBook{
+		recipes: make([]*Recipe, 0, len(recipes)),
+		index:   make(map[string]*Recipe, len(recipes)),
+	}
+	for _, recipe := range recipes {
+		book.Add(recipe)
+	}
+	return book
+}
+
+// Add adds a recipe (replacing any recipe with the same ID).
+func (b *RecipeBook) Add(recipe *Recipe) {
+	if _, exists := b.index[recipe.ID]; exists {
+		for i, r := range b.recipes {
+			if r.ID == recipe.ID {
+				b.recipes[i] = recipe
+			}
+		}
+	} else {
+		b.recipes = append(b.recipes, recipe)
+	}
+	b.index[recipe.ID] = recipe
+}
+
+// Get returns a recipe by ID, or nil.
+func (b *RecipeBook) Get(id string) *Recipe {
+	return b.index[id]
+}
+
+// Recipes returns all recipes in insertion order.
+func (b *RecipeBook) Recipes() []*Recipe {
+	return b.recipes
+}
+
+// Check reports why a recipe cannot be crafted the given number of times (nil if it can).
+func (b *RecipeBook) Check(recipe *Recipe, inv *inventory.Inventory, ctx Context, times int) error {
+	if times <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidCraftTimes, times)
+	}
+	if recipe.Station != "" && recipe.Station != ctx.Station {
+		return fmt.Errorf("%w: %s requires %s", ErrWrongStation, recipe.ID, recipe.Station)
+	}
+	for _, tag := range recipe.Requirements {
+		if !ctx.hasTag(tag) {
+			return fmt.Errorf("%w: %s requires %s", ErrMissingRequirement, recipe.ID, tag)
+		}
+	}
+	if !inv.HasAll(scale(recipe.Inputs, times)) {
+		return fmt.Errorf("%w: %s", ErrMissingIngredients, recipe.ID)
+	}
+	return nil
+}
+
+// Craftable returns recipes that can be crafted at least once in a context.
+func (b *RecipeBook) Craftable(inv *inventory.Inventory, ctx Context) []*Recipe {
+	result := make([]*Recipe, 0)
+	for _, recipe := range b.recipes {
+		if b.Check(recipe, inv, ctx, 1) == nil {
+			result = append(result, recipe)
+		}
+	}
+	return result
+}
+
+// MaxCraftable returns how many times a recipe can be crafted from the inventory.
+func (b *RecipeBook) MaxCraftable(recipe *Recipe, inv *inventory.Inventory, ctx Context) int {
+	if b.Check(recipe, inv, ctx, 1) != nil {
+		return 0
+	}
+	maxTimes := -1
+	for _, input := range scale(recipe.Inputs, 1) {
+		if input.Quantity <= 0 {
+			continue
+		}
+		n := inv.Count(input.ID) / input.Quantity
+		if maxTimes < 0 || n < maxTimes {
+			maxTimes = n
+		}
+	}
+	if maxTimes < 0 {
+		return 1 // No consumed inputs; callers decide their own cap
+	}
+	return maxTimes
+}
+
+// Craft consumes inputs and produces outputs for a recipe.
+//
+// Returns:
+//
+//	error: Wrapped ErrUnknownRecipe, ErrWrongStation, ErrMissingRequirement,
+//	       ErrMissingIngredients, or ErrOutputsDoNotFit; the inventory is unchanged on error
+func (b *RecipeBook) Craft(recipeID string, inv *inventory.Inventory, ctx Context, times int) error {
+	recipe := b.index[recipeID]
+	if recipe == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownRecipe, recipeID)
+	}
+	if err := b.Check(recipe, inv, ctx, times); err != nil {
+		return err
+	}
+
+	inputs := scale(recipe.Inputs, times)
+	for _, input := range inputs {
+		if err := inv.Remove(input.ID, input.Quantity); err != nil {
+			return fmt.Errorf("failed to consume %s: %w", input.ID, err)
+		}
+	}
+
+	outputs := scale(recipe.Outputs, times)
+	for i, output := range outputs {
+		if err := inv.Add(output.ID, output.Quantity); err != nil {
+			rollback(inv, inputs, outputs[:i])
+			return fmt.Errorf("%w: %s: %w", ErrOutputsDoNotFit, recipe.ID, err)
+		}
+	}
+
+	if b.OnCraft != nil {
+		b.OnCraft(recipe, times)
+	}
+	return nil
+}
+
+// rollback restores consumed inputs and removes already-added outputs.
+func rollback(inv *inventory.Inventory, inputs, added []inventory.ItemStack) {
+	for _, output := range added {
+		_ = inv.Remove(output.ID, output.Quantity) // Just added, cannot fail
+	}
+	for _, input := range inputs {
+		_ = inv.Add(input.ID, input.Quantity) // Slot was freed by removal
+	}
+}
+
+// scale merges duplicate stacks and multiplies quantities by times.
+func scale(stacks []inventory.ItemStack, times int) []inventory.ItemStack {
+	merged := make([]inventory.ItemStack, 0, len(stacks))
+	positions := make(map[string]int, len(stacks))
+	for _, stack := range stacks {
+		if i, ok := positions[stack.ID]; ok {
+			merged[i].Quantity += stack.Quantity * times
+			continue
+		}
+		positions[stack.ID] = len(merged)
+		merged = append(merged, inventory.ItemStack{ID: stack.ID, Quantity: stack.Quantity * times})
+	}
+	return merged
+}
@@ -0,0 +1,248 @@
+package debug
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// maxGridLines caps lines per axis so extreme zoom-out can't stall a frame.
+const maxGridLines = 1000
+
+// rulerSize is the thickness in pixels of the ruler strips.
+const rulerSize = 18
+
+// GridLineKind classifies a grid line for styling.
+type GridLineKind int
+
+const (
+	// GridMinor is an ordinary grid line.
+	GridMinor GridLineKind = iota
+	// GridMajor is every MajorEvery-th line.
+	GridMajor
+	// GridAxis is the world X or Y axis (coordinate 0).
+	GridAxis
+)
+
+// GridLine is one visible grid line in world coordinates.
+type GridLine struct {
+	Vertical bool    // True for lines of constant X
+	World    float64 // X for vertical lines, Y for horizontal lines
+	Kind     GridLineKind
+}
+
+// Grid draws world-space grid lines, highlighted axes, and coordinate labels.
+//
+// When zoomed out far enough that lines would be closer than MinPixelSpacing
+// on screen, the grid thins out (first to major lines, then doubling) so it
+// stays readable and cheap.
+type Grid struct {
+	Spacing         float64        // World units between lines
+	MajorEvery      int            // Lines between major lines (0 = no major lines)
+	MinPixelSpacing float64        // Thin the grid below this on-screen spacing
+	Color           gamemath.Color // Minor lines
+	MajorColor      gamemath.Color // Major lines
+	AxisXColor      gamemath.Color // Horizontal line at Y = 0
+	AxisYColor      gamemath.Color // Vertical line at X = 0
+	Font            *graphics.Font // Coordinate labels (nil = no labels)
+	LabelColor      gamemath.Color
+	Rulers          bool // Draw ruler strips with ticks along the top and left edges
+
+	labels labelCache
+}
+
+// NewGrid creates a grid with major lines every 4 cells and red/green axes.
+//
+// Parameters:
+//
+//	spacing: World units between lines (e.g. the tile size)
+//
+// Example:
+//
+//	grid := debug.NewGrid(32)
+//	grid.Font = debugFont // Show coordinates
+//	grid.Rulers = true
+//	overlay.Add(grid)
+func NewGrid(spacing float64) *Grid {
+	return &Grid{
+		Spacing:         spacing,
+		MajorEvery:      4,
+		MinPixelSpacing: 8,
+		Color:           gamemath.Color{R: 255, G: 255, B: 255, A: 30},
+		MajorColor:      gamemath.Color{R: 255, G: 255, B: 255, A: 70},
+		AxisXColor:      gamemath.Color{R: 230, G: 60, B: 60, A: 200},
+		AxisYColor:      gamemath.Color{R: 60, G: 200, B: 60, A: 200},
+		LabelColor:      gamemath.Color{R: 255, G: 255, B: 255, A: 200},
+	}
+}
+
+// Step returns the world spacing actually drawn at the camera's zoom.
+func (g *Grid) Step(camera *graphics.Camera) float64 {
+	step := g.Spacing
+	if step <= 0 || camera.Zoom <= 0 {
+		return 0
+	}
+	if step*camera.Zoom >= g.MinPixelSpacing {
+		return step
+	}
+	if g.MajorEvery > 1 {
+		step *= float64(g.MajorEvery)
+	}
+	for step*camera.Zoom < g.MinPixelSpacing {
+		step *= 2
+	}
+	return step
+}
+
+// Lines returns the grid lines visible through the camera.
+func (g *Grid) Lines(camera *graphics.Camera) []GridLine {
+	step := g.Step(camera)
+	if step <= 0 {
+		return nil
+	}
+	width, height := camera.ScreenSize()
+	left, top := camera.ScreenToWorld(0, 0)
+	right, bottom := camera.ScreenToWorld(width, height)
+
+	lines := make([]GridLine, 0)
+	lines = g.appendLines(lines, true, left, right, step)
+	lines = g.appendLines(lines, false, top, bottom, step)
+	return lines
+}
+
+// appendLines adds lines at multiples of step within [from, to].
+func (g *Grid) appendLines(lines []GridLine, vertical bool, from, to, step float64) []GridLine {
+	first := math.Ceil(from / step)
+	last := math.Floor(to / step)
+	if last-first >= maxGridLines {
+		last = first + maxGridLines - 1
+	}
+	for i := first; i <= last; i++ {
+		world := i * step
+		lines = append(lines, GridLine{Vertical: vertical, World: world, Kind: g.kind(world)})
+	}
+	return lines
+}
+
+// kind classifies a line by its world coordinate.
+func (g *Grid) kind(world float64) GridLineKind {
+	if world == 0 {
+		return GridAxis
+	}
+	if g.MajorEvery > 0 {
+		index := math.Round(world / g.Spacing)
+		if math.Mod(index, float64(g.MajorEvery)) == 0 {
+			return GridMajor
+		}
+	}
+	return GridMinor
+}
+
+// Draw draws the grid, then rulers and labels.
+func (g *Grid) Draw(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	lines := g.Lines(camera)
+	width, height := camera.ScreenSize()
+	w, h := float64(width), float64(height)
+
+	for _, line := range lines {
+		color := g.lineColor(line)
+		x, y := g.screenPosition(camera, line)
+		var err error
+		if line.Vertical {
+			err = renderer.DrawLine(x, 0, x, h, color)
+		} else {
+			err = renderer.DrawLine(0, y, w, y, color)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.Rulers {
+		if err := g.drawRulers(renderer, camera, lines, w, h); err != nil {
+			return err
+		}
+	}
+	if g.Font == nil {
+		return nil
+	}
+	for _, line := range lines {
+		if line.Kind == GridMinor && g.MajorEvery > 0 && g.Step(camera) == g.Spacing {
+			continue // Label major lines only until the grid thins out
+		}
+		x, y := g.screenPosition(camera, line)
+		text := strconv.FormatFloat(line.World, 'f', -1, 64)
+		if line.Vertical {
+			x, y = x+3, 2
+		} else {
+			x, y = 3, y+2
+			if g.Rulers {
+				x = rulerSize + 3
+			}
+		}
+		if err := g.labels.draw(renderer, g.Font, text, x, y, g.LabelColor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawRulers draws strips along the top and left edges with a tick per line.
+func (g *Grid) drawRulers(renderer *graphics.Renderer, camera *graphics.Camera, lines []GridLine, w, h float64) error {
+	background := gamemath.Color{R: 0, G: 0, B: 0, A: 150}
+	if err := renderer.FillRect(gamemath.Rectangle{Width: w, Height: rulerSize}, background); err != nil {
+		return err
+	}
+	if err := renderer.FillRect(gamemath.Rectangle{Y: rulerSize, Width: rulerSize, Height: h - rulerSize}, background); err != nil {
+		return err
+	}
+	for _, line := range lines {
+		length := rulerSize / 3.0
+		if line.Kind != GridMinor {
+			length = rulerSize
+		}
+		x, y := g.screenPosition(camera, line)
+		color := g.lineColor(line)
+		color.A = 255
+		var err error
+		if line.Vertical {
+			err = renderer.DrawLine(x, rulerSize-length, x, rulerSize, color)
+		} else {
+			err = renderer.DrawLine(rulerSize-length, y, rulerSize, y, color)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lineColor returns the color for a line.
+func (g *Grid) lineColor(line GridLine) gamemath.Color {
+	switch {
+	case line.Kind == GridAxis && line.Vertical:
+		return g.AxisYColor
+	case line.Kind == GridAxis:
+		return g.AxisXColor
+	case line.Kind == GridMajor:
+		return g.MajorColor
+	}
+	return g.Color
+}
+
+// screenPosition returns the line's screen X (vertical) or Y (horizontal).
+func (g *Grid) screenPosition(camera *graphics.Camera, line GridLine) (x, y float64) {
+	if line.Vertical {
+		x, _ = screenPoint(camera, gamemath.Vector2{X: line.World})
+		return x, 0
+	}
+	_, y = screenPoint(camera, gamemath.Vector2{Y: line.World})
+	return 0, y
+}
+
+// Destroy releases cached label textures.
+func (g *Grid) Destroy() {
+	g.labels.destroy()
+}
@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// maxCachedLabels bounds the label cache; it is flushed when full.
+const maxCachedLabels = 512
+
+// labelCache keeps rasterized label text so per-frame labels (coordinates,
+// distances) aren't re-rendered every frame. Text is rendered white and
+// tinted when drawn.
+type labelCache struct {
+	font     *graphics.Font
+	textures map[string]cachedLabel
+}
+
+// cachedLabel is a rasterized label.
+type cachedLabel struct {
+	texture       *sdl.Texture
+	width, height int32
+}
+
+// draw draws text with its top-left corner at x, y.
+func (c *labelCache) draw(renderer *graphics.Renderer, font *graphics.Font, text string, x, y float64, color gamemath.Color) error {
+	label, err := c.get(renderer, font, text)
+	if err != nil || label.texture == nil {
+		return err
+	}
+	_ = label.texture.SetColorMod(color.R, color.G, color.B) // Best effort tint
+	_ = label.texture.SetAlphaMod(color.A)                   // Best effort tint
+	dst := sdl.Rect{X: int32(x), Y: int32(y), W: label.width, H: label.height}
+	return renderer.GetSDLRenderer().Copy(label.texture, nil, &dst)
+}
+
+// get returns the cached label, rendering it on first use.
+func (c *labelCache) get(renderer *graphics.Renderer, font *graphics.Font, text string) (cachedLabel, error) {
+	if font != c.font {
+		c.destroy()
+		c.font = font
+	}
+	if label, ok := c.textures[text]; ok {
+		return label, nil
+	}
+	if c.textures == nil || len(c.textures) >= maxCachedLabels {
+		c.destroy()
+		c.textures = make(map[string]cachedLabel)
+	}
+	texture, width, height, err := font.RenderText(renderer.GetSDLRenderer(), text, gamemath.White)
+	if err != nil {
+		return cachedLabel{}, err
+	}
+	label := cachedLabel{texture: texture, width: width, height: height}
+	c.textures[text] = label
+	return label, nil
+}
+
+// destroy releases every cached texture.
+func (c *labelCache) destroy() {
+	for _, label := range c.textures {
+		_ = label.texture.Destroy() // Best effort cleanup
+	}
+	c.textures = nil
+}
@@ -0,0 +1,88 @@
+// Package debug provides developer overlays drawn over the scene: world
+// grids, rulers, and physics views for positioning and tuning.
+//
+// Overlays are drawn from the engine's UI callback so they sit on top of
+// the scene and are not post-processed:
+//
+//	overlay := debug.NewOverlay()
+//	overlay.Add(debug.NewGrid(32))
+//	engine.SetRenderUICallback(func() {
+//	    if engine.Input().KeyPressed(input.KeyTab) {
+//	        overlay.Toggle()
+//	    }
+//	    _ = overlay.Draw(engine.Renderer(), scene.Camera())
+//	})
+package debug
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Drawer is a debug visualization drawn in screen space through a camera.
+type Drawer interface {
+	Draw(renderer *graphics.Renderer, camera *graphics.Camera) error
+}
+
+// Overlay is a switchable list of debug drawers.
+type Overlay struct {
+	Enabled bool // Draw does nothing when false
+	drawers []Drawer
+}
+
+// NewOverlay creates an enabled, empty overlay.
+func NewOverlay() *Overlay {
+	return &Overlay{Enabled: true}
+}
+
+// Add appends a drawer; drawers are drawn in the order added.
+func (o *Overlay) Add(drawer Drawer) {
+	o.drawers = append(o.drawers, drawer)
+}
+
+// Remove removes a drawer.
+func (o *Overlay) Remove(drawer Drawer) {
+	for i, d := range o.drawers {
+		if d == drawer {
+			o.drawers = append(o.drawers[:i:i], o.drawers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Drawers returns the registered drawers.
+func (o *Overlay) Drawers() []Drawer {
+	return o.drawers
+}
+
+// Toggle flips Enabled.
+func (o *Overlay) Toggle() {
+	o.Enabled = !o.Enabled
+}
+
+// Draw draws every drawer if the overlay is enabled.
+//
+// Behavior:
+//   - Drawing is confined to the camera's viewport if it has one, so each
+//     split-screen view can carry its own overlay
+//
+// Returns:
+//
+//	error: First drawer error (remaining drawers are skipped)
+func (o *Overlay) Draw(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	if !o.Enabled {
+		return nil
+	}
+	if camera.Viewport != (gamemath.Rectangle{}) {
+		if err := renderer.SetViewport(camera.ViewportRect()); err != nil {
+			return err
+		}
+		defer func() { _ = renderer.SetViewport(gamemath.Rectangle{}) }() // Best effort restore
+	}
+	for _, drawer := range o.drawers {
+		if err := drawer.Draw(renderer, camera); err != nil {
+			return err
+		}
+	}
+	return nil
+}
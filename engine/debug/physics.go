@@ -0,0 +1,164 @@
+package debug
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// Physics draws colliders, rigid body velocities, and the last step's
+// contacts for a scene.
+//
+// Colliders are outlined by body state: dynamic (awake), sleeping,
+// kinematic, and static or body-less. Triggers are shaded instead of
+// outlined so sensors stand out from solid geometry.
+type Physics struct {
+	Scene          *core.Scene
+	ShowColliders  bool
+	ShowVelocities bool
+	ShowContacts   bool
+	VelocityScale  float64 // Screen pixels per world unit/second (e.g. 0.1)
+	NormalLength   float64 // Contact normal length in screen pixels
+
+	DynamicColor   gamemath.Color
+	SleepingColor  gamemath.Color
+	KinematicColor gamemath.Color
+	StaticColor    gamemath.Color // Static bodies and colliders without a body
+	TriggerColor   gamemath.Color // Fill for trigger colliders
+	VelocityColor  gamemath.Color
+	ContactColor   gamemath.Color
+}
+
+// NewPhysics creates a physics view with everything enabled.
+//
+// Example:
+//
+//	overlay.Add(debug.NewPhysics(scene))
+func NewPhysics(scene *core.Scene) *Physics {
+	return &Physics{
+		Scene:          scene,
+		ShowColliders:  true,
+		ShowVelocities: true,
+		ShowContacts:   true,
+		VelocityScale:  0.1,
+		NormalLength:   16,
+		DynamicColor:   gamemath.Color{R: 80, G: 220, B: 80, A: 255},
+		SleepingColor:  gamemath.Color{R: 90, G: 110, B: 200, A: 255},
+		KinematicColor: gamemath.Color{R: 230, G: 200, B: 60, A: 255},
+		StaticColor:    gamemath.Color{R: 200, G: 200, B: 200, A: 255},
+		TriggerColor:   gamemath.Color{R: 60, G: 180, B: 230, A: 70},
+		VelocityColor:  gamemath.Color{R: 255, G: 140, B: 40, A: 255},
+		ContactColor:   gamemath.Color{R: 255, G: 50, B: 50, A: 255},
+	}
+}
+
+// BodyColor returns the outline color for an entity's body state.
+func (p *Physics) BodyColor(entity *core.Entity) gamemath.Color {
+	body := entity.Body
+	switch {
+	case body == nil || body.Type == physics.BodyStatic:
+		return p.StaticColor
+	case body.Type == physics.BodyKinematic:
+		return p.KinematicColor
+	case body.Sleeping():
+		return p.SleepingColor
+	}
+	return p.DynamicColor
+}
+
+// Draw draws the enabled layers: colliders, then velocities, then contacts.
+func (p *Physics) Draw(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	if p.Scene == nil {
+		return nil
+	}
+	for _, entity := range p.Scene.GetAllEntities() {
+		if !entity.Active {
+			continue
+		}
+		if p.ShowColliders && entity.Collider != nil {
+			if err := p.drawCollider(renderer, camera, entity); err != nil {
+				return err
+			}
+		}
+		if p.ShowVelocities && entity.Body != nil && entity.Body.Velocity != (gamemath.Vector2{}) {
+			x, y := screenPoint(camera, entity.WorldTransform().Position)
+			v := entity.Body.Velocity.Scale(p.VelocityScale * camera.Zoom)
+			if err := drawArrow(renderer, x, y, x+v.X, y+v.Y, p.VelocityColor); err != nil {
+				return err
+			}
+		}
+	}
+	if !p.ShowContacts {
+		return nil
+	}
+	for _, contact := range p.Scene.Contacts() {
+		x, y := screenPoint(camera, contact.Info.Point)
+		marker := gamemath.Rectangle{X: x - 2, Y: y - 2, Width: 5, Height: 5}
+		if err := renderer.FillRect(marker, p.ContactColor); err != nil {
+			return err
+		}
+		n := contact.Info.Normal.Scale(p.NormalLength)
+		if err := drawArrow(renderer, x, y, x+n.X, y+n.Y, p.ContactColor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawCollider outlines a solid collider or shades a trigger. Circle and
+// rotated box shapes are always outlined (in TriggerColor for triggers).
+func (p *Physics) drawCollider(renderer *graphics.Renderer, camera *graphics.Camera, entity *core.Entity) error {
+	if entity.Collider.Shape != nil {
+		color := p.BodyColor(entity)
+		if entity.Collider.IsTrigger {
+			color = p.TriggerColor
+		}
+		return drawPolygon(renderer, camera, entity.Collider.Outline(entity.WorldTransform()), color)
+	}
+	bounds := entity.GetBounds()
+	x, y := screenPoint(camera, gamemath.Vector2{X: bounds.X, Y: bounds.Y})
+	rect := gamemath.Rectangle{X: x, Y: y, Width: bounds.Width * camera.Zoom, Height: bounds.Height * camera.Zoom}
+	if entity.Collider.IsTrigger {
+		return renderer.FillRect(rect, p.TriggerColor)
+	}
+	return renderer.DrawRect(rect, p.BodyColor(entity))
+}
+
+// screenPoint converts a world point to fractional screen pixels.
+func screenPoint(camera *graphics.Camera, world gamemath.Vector2) (x, y float64) {
+	width, height := camera.ScreenSize()
+	view := camera.ViewPosition()
+	x = (world.X-view.X)*camera.Zoom + float64(width)/2
+	y = (world.Y-view.Y)*camera.Zoom + float64(height)/2
+	return x, y
+}
+
+// drawPolygon outlines a closed world-space polygon.
+func drawPolygon(renderer *graphics.Renderer, camera *graphics.Camera, points []gamemath.Vector2, color gamemath.Color) error {
+	for i, point := range points {
+		next := points[(i+1)%len(points)]
+		x1, y1 := screenPoint(camera, point)
+		x2, y2 := screenPoint(camera, next)
+		if err := renderer.DrawLine(x1, y1, x2, y2, color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawArrow draws a line with a small head at its end.
+func drawArrow(renderer *graphics.Renderer, x1, y1, x2, y2 float64, color gamemath.Color) error {
+	if err := renderer.DrawLine(x1, y1, x2, y2, color); err != nil {
+		return err
+	}
+	dir := gamemath.Vector2{X: x2 - x1, Y: y2 - y1}.Normalize().Scale(5)
+	side := gamemath.Vector2{X: -dir.Y, Y: dir.X}
+	for _, s := range []float64{1, -1} {
+		hx, hy := x2-dir.X+side.X*s*0.6, y2-dir.Y+side.Y*s*0.6
+		if err := renderer.DrawLine(x2, y2, hx, hy, color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
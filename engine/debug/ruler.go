@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"strconv"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Ruler measures the distance between two world points.
+//
+// Example:
+//
+//	ruler := &debug.Ruler{Font: debugFont, Color: gamemath.Green}
+//	overlay.Add(ruler)
+//	// Drag with the right mouse button to measure
+//	if engine.Input().KeyPressed(input.KeyMouseRight) {
+//	    ruler.From = mouseWorld
+//	}
+//	if engine.Input().KeyHeld(input.KeyMouseRight) {
+//	    ruler.To = mouseWorld
+//	}
+type Ruler struct {
+	From, To gamemath.Vector2 // World endpoints
+	Color    gamemath.Color
+	Font     *graphics.Font // Distance label (nil = no label)
+
+	labels labelCache
+}
+
+// Length returns the distance between the endpoints in world units.
+func (r *Ruler) Length() float64 {
+	return r.From.Distance(r.To)
+}
+
+// Draw draws the measured line with end ticks and a distance label.
+func (r *Ruler) Draw(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	fromX, fromY := camera.WorldToScreen(r.From.X, r.From.Y)
+	toX, toY := camera.WorldToScreen(r.To.X, r.To.Y)
+	x1, y1, x2, y2 := float64(fromX), float64(fromY), float64(toX), float64(toY)
+	if err := renderer.DrawLine(x1, y1, x2, y2, r.Color); err != nil {
+		return err
+	}
+
+	// Perpendicular end ticks
+	dir := gamemath.Vector2{X: x2 - x1, Y: y2 - y1}.Normalize()
+	tick := gamemath.Vector2{X: -dir.Y * 5, Y: dir.X * 5}
+	for _, end := range []gamemath.Vector2{{X: x1, Y: y1}, {X: x2, Y: y2}} {
+		if err := renderer.DrawLine(end.X-tick.X, end.Y-tick.Y, end.X+tick.X, end.Y+tick.Y, r.Color); err != nil {
+			return err
+		}
+	}
+
+	if r.Font == nil {
+		return nil
+	}
+	delta := r.To.Sub(r.From)
+	text := strconv.FormatFloat(r.Length(), 'f', 1, 64) +
+		" (" + strconv.FormatFloat(delta.X, 'f', 1, 64) + ", " + strconv.FormatFloat(delta.Y, 'f', 1, 64) + ")"
+	return r.labels.draw(renderer, r.Font, text, (x1+x2)/2+6, (y1+y2)/2+6, r.Color)
+}
+
+// Destroy releases the cached label texture.
+func (r *Ruler) Destroy() {
+	r.labels.destroy()
+}
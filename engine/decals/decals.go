@@ -0,0 +1,255 @@
+// Package decals stamps persistent textures (bullet holes, blood, scorch marks) onto an offscreen layer.
+package decals
+
+import (
+	"fmt"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Decal is one stamped image.
+type Decal struct {
+	Sprite    *graphics.Sprite   // Texture region, tint, and alpha
+	Transform gamemath.Transform // World position, rotation, and scale
+	Age       float64            // Seconds since stamped
+
+	fading    bool
+	fadeLeft  float64 // Seconds until removal once fading
+	fadeAlpha float64 // Current fade multiplier (1 = opaque)
+}
+
+// Fading reports whether the decal is fading out.
+func (d *Decal) Fading() bool {
+	return d.fading
+}
+
+// Layer is a Behavior that owns the decal render target and its entity.
+//
+// Decals are drawn into one texture covering Region; the texture is shown by
+// an entity on a low z-order so it composites under other entities. New
+// stamps are drawn incrementally. The texture is only rebuilt when decals are
+// removed or fading, so thousands of settled decals cost a single sprite draw.
+type Layer struct {
+	Region    gamemath.Rectangle // World area covered by the layer
+	MaxDecals int                // Cap on non-fading decals; oldest fade out beyond it (0 = unlimited)
+	Lifetime  float64            // Seconds before a decal starts fading (0 = permanent)
+	FadeTime  float64            // Seconds to fade out (0 = remove immediately)
+
+	renderer *graphics.Renderer
+	target   *graphics.Texture
+	entity   *core.Entity
+	camera   *graphics.Camera // Maps Region to target pixels
+	decals   []*Decal
+	pending  []*Decal // Stamped since the last draw
+	rebuild  bool
+}
+
+// New creates a decal layer covering a world region.
+//
+// Parameters:
+//
+//	renderer: Renderer used to draw into the layer (nil = bookkeeping only, e.g. tests/headless)
+//	region: World rectangle that decals can appear in
+//	z: Render layer for the decal entity (below gameplay entities)
+//
+// Returns:
+//
+//	*Layer: Layer with a 256-decal cap and 1 second fade
+//	error: Non-nil if the render target can't be created
+//
+// Example:
+//
+//	splats, err := decals.New(engine.Renderer(), gamemath.Rectangle{Width: 2048, Height: 2048}, -50)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	scene.AddEntity(splats.Entity())
+//	splats.Stamp(bulletHole, hit.Position, rand.Float64()*360, 1)
+func New(renderer *graphics.Renderer, region gamemath.Rectangle, z int) (*Layer, error) {
+	layer := &Layer{
+		Region:    region,
+		MaxDecals: 256,
+		FadeTime:  1,
+		renderer:  renderer,
+		decals:    make([]*Decal, 0),
+	}
+
+	layer.camera = graphics.NewCamera()
+	layer.camera.SetScreenSize(int(region.Width), int(region.Height))
+	layer.camera.Position = gamemath.Vector2{X: region.X + region.Width/2, Y: region.Y + region.Height/2}
+
+	layer.entity = &core.Entity{
+		Active: true,
+		Transform: gamemath.Transform{
+			Position: layer.camera.Position,
+			Scale:    gamemath.Vector2{X: 1, Y: 1},
+		},
+		Behavior: layer,
+		Layer:    z,
+	}
+
+	if renderer != nil {
+		target, err := renderer.NewRenderTarget(int(region.Width), int(region.Height))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create decal layer: %w", err)
+		}
+		layer.target = target
+		layer.entity.Sprite = graphics.NewSprite(target)
+		layer.rebuild = true // Clear the new texture
+	}
+
+	return layer, nil
+}
+
+// Entity returns the entity that displays the layer. Add it to the scene.
+func (l *Layer) Entity() *core.Entity {
+	return l.entity
+}
+
+// Stamp adds a decal.
+//
+// Parameters:
+//
+//	sprite: Image to stamp (shared sprites are fine; the decal keeps a copy)
+//	position: World position (decal is centered here)
+//	rotation: Rotation in degrees
+//	scale: Uniform scale
+//
+// Returns:
+//
+//	*Decal: The new decal, or nil if position is outside Region
+func (l *Layer) Stamp(sprite *graphics.Sprite, position gamemath.Vector2, rotation, scale float64) *Decal {
+	if !l.Region.Contains(position.X, position.Y) {
+		return nil
+	}
+
+	spriteCopy := *sprite
+	decal := &Decal{
+		Sprite: &spriteCopy,
+		Transform: gamemath.Transform{
+			Position: position,
+			Rotation: rotation,
+			Scale:    gamemath.Vector2{X: scale, Y: scale},
+		},
+		fadeAlpha: 1,
+	}
+	l.decals = append(l.decals, decal)
+	l.pending = append(l.pending, decal)
+	l.enforceCap()
+	return decal
+}
+
+// Decals returns all decals, oldest first (including fading ones).
+func (l *Layer) Decals() []*Decal {
+	return l.decals
+}
+
+// Len returns the number of decals (including fading ones).
+func (l *Layer) Len() int {
+	return len(l.decals)
+}
+
+// Clear removes all decals immediately.
+func (l *Layer) Clear() {
+	l.decals = l.decals[:0]
+	l.pending = l.pending[:0]
+	l.rebuild = true
+}
+
+// Update ages decals, applies the fade policy, and redraws the layer texture.
+func (l *Layer) Update(_ *core.Entity, dt float64) {
+	alive := l.decals[:0]
+	for _, decal := range l.decals {
+		decal.Age += dt
+		if !decal.fading && l.Lifetime > 0 && decal.Age >= l.Lifetime {
+			l.startFade(decal)
+		}
+		if decal.fading {
+			decal.fadeLeft -= dt
+			if decal.fadeLeft <= 0 {
+				l.rebuild = true
+				continue
+			}
+			decal.fadeAlpha = decal.fadeLeft / l.FadeTime
+			l.rebuild = true
+		}
+		alive = append(alive, decal)
+	}
+	l.decals = alive
+
+	if err := l.draw(); err != nil {
+		// Drawing only fails if the renderer is lost; keep state and retry next frame
+		l.rebuild = true
+	}
+}
+
+// Destroy releases the layer texture.
+func (l *Layer) Destroy() {
+	if l.target != nil {
+		_ = l.target.Destroy() // Best effort cleanup
+		l.target = nil
+	}
+	l.entity.Sprite = nil
+}
+
+// enforceCap starts fading the oldest decals beyond MaxDecals.
+func (l *Layer) enforceCap() {
+	if l.MaxDecals <= 0 {
+		return
+	}
+	solid := 0
+	for i := len(l.decals) - 1; i >= 0; i-- {
+		if l.decals[i].fading {
+			continue
+		}
+		solid++
+		if solid > l.MaxDecals {
+			l.startFade(l.decals[i])
+		}
+	}
+}
+
+// startFade begins fading a decal (removed at the next Update if FadeTime is 0).
+func (l *Layer) startFade(decal *Decal) {
+	decal.fading = true
+	decal.fadeLeft = l.FadeTime
+}
+
+// draw stamps pending decals, or rebuilds the texture when decals changed.
+func (l *Layer) draw() error {
+	if l.renderer == nil || l.target == nil {
+		l.pending = l.pending[:0]
+		l.rebuild = false
+		return nil
+	}
+	if !l.rebuild && len(l.pending) == 0 {
+		return nil
+	}
+
+	if err := l.renderer.SetRenderTarget(l.target); err != nil {
+		return err
+	}
+	defer func() { _ = l.renderer.SetRenderTarget(nil) }() // Always restore the screen
+
+	toDraw := l.pending
+	if l.rebuild {
+		if err := l.renderer.Clear(gamemath.Color{}); err != nil {
+			return err
+		}
+		toDraw = l.decals
+	}
+
+	for _, decal := range toDraw {
+		sprite := *decal.Sprite
+		sprite.Alpha *= decal.fadeAlpha
+		if err := l.renderer.DrawSprite(&sprite, decal.Transform, l.camera); err != nil {
+			return err
+		}
+	}
+
+	l.pending = l.pending[:0]
+	l.rebuild = false
+	return nil
+}
@@ -0,0 +1,242 @@
+// Package difficulty provides named tuning parameters driven by curves over
+// game time or player performance, so challenge ramps live in data instead
+// of being scattered through behaviors.
+package difficulty
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Common parameter names.
+const (
+	EnemySpeed    = "enemy_speed"    // Enemy speed multiplier
+	SpawnInterval = "spawn_interval" // Seconds between spawns
+)
+
+// ErrUnknownParameter is returned when a parameter has not been defined.
+var ErrUnknownParameter = errors.New("unknown difficulty parameter")
+
+// Input selects what a parameter's curve is evaluated against.
+type Input int
+
+const (
+	// ByTime evaluates the curve at elapsed game time in seconds.
+	ByTime Input = iota
+	// ByPerformance evaluates the curve at the player performance rating (0-1).
+	ByPerformance
+)
+
+// String returns the input's data name.
+func (i Input) String() string {
+	switch i {
+	case ByTime:
+		return "time"
+	case ByPerformance:
+		return "performance"
+	}
+	return "unknown"
+}
+
+// MarshalText writes the input's data name.
+func (i Input) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText parses a data name ("time" or "performance").
+func (i *Input) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "time":
+		*i = ByTime
+	case "performance":
+		*i = ByPerformance
+	default:
+		return fmt.Errorf("unknown difficulty input %q", text)
+	}
+	return nil
+}
+
+// Parameter is a named value read from a curve.
+type Parameter struct {
+	Input Input                    `json:"input"`
+	Curve *gamemath.AnimationCurve `json:"curve"`
+}
+
+// Manager tracks game time and a player performance rating and evaluates
+// parameters against them.
+//
+// Performance is a 0-1 rating (0.5 = neutral) smoothed from samples the game
+// reports, such as 1 for a kill and 0 for a hit taken. Manager implements
+// core.Behavior so it advances with the scene when attached to an entity;
+// it can also be ticked manually with Tick.
+type Manager struct {
+	Smoothing float64 // Weight of each performance sample, 0-1 (default 0.1)
+
+	params      map[string]Parameter
+	elapsed     float64
+	performance float64
+}
+
+// New creates a manager with no parameters at time 0 and neutral performance.
+//
+// Example:
+//
+//	diff := difficulty.New()
+//	diff.Define(difficulty.EnemySpeed, difficulty.ByTime, gamemath.LinearCurve(0, 1, 180, 2.5))
+//	diff.Define(difficulty.SpawnInterval, difficulty.ByPerformance, gamemath.LinearCurve(0, 2, 1, 0.6))
+//	manager.Behavior = core.Behaviors{diff, &GameManagerBehavior{}}
+//
+//	// In an enemy behavior
+//	speed := EnemySpeed * diff.Get(difficulty.EnemySpeed)
+func New() *Manager {
+	return &Manager{
+		Smoothing:   0.1,
+		params:      make(map[string]Parameter),
+		performance: 0.5,
+	}
+}
+
+// Define adds or replaces a parameter.
+//
+// Parameters:
+//
+//	name: Parameter name (e.g. EnemySpeed)
+//	input: What the curve is evaluated against
+//	curve: Value over time in seconds (ByTime) or performance 0-1 (ByPerformance)
+func (m *Manager) Define(name string, input Input, curve *gamemath.AnimationCurve) {
+	m.params[name] = Parameter{Input: input, Curve: curve}
+}
+
+// Load defines parameters from JSON, replacing any with the same names.
+//
+// Data format:
+//
+//	{
+//	  "enemy_speed": {
+//	    "input": "time",
+//	    "curve": {"keys": [{"time": 0, "value": 1}, {"time": 180, "value": 2.5}]}
+//	  },
+//	  "spawn_interval": {
+//	    "input": "performance",
+//	    "curve": {"keys": [{"time": 0, "value": 2}, {"time": 1, "value": 0.6}]}
+//	  }
+//	}
+//
+// Returns:
+//
+//	error: Non-nil for malformed JSON or a parameter without keys (nothing is
+//	       defined on error)
+func (m *Manager) Load(data []byte) error {
+	var params map[string]Parameter
+	if err := json.Unmarshal(data, &params); err != nil {
+		return fmt.Errorf("failed to decode difficulty parameters: %w", err)
+	}
+	for name, param := range params {
+		if param.Curve == nil || len(param.Curve.Keys) == 0 {
+			return fmt.Errorf("difficulty parameter %q: %w", name, gamemath.ErrEmptyCurve)
+		}
+		// Keys from data may be in any order
+		param.Curve = gamemath.NewAnimationCurve(param.Curve.Keys...)
+		params[name] = param
+	}
+	for name, param := range params {
+		m.params[name] = param
+	}
+	return nil
+}
+
+// Has reports whether a parameter is defined.
+func (m *Manager) Has(name string) bool {
+	_, ok := m.params[name]
+	return ok
+}
+
+// Get returns a parameter's current value (0 if undefined; see Value).
+func (m *Manager) Get(name string) float64 {
+	value, _ := m.Value(name)
+	return value
+}
+
+// Value returns a parameter's current value.
+//
+// Returns:
+//
+//	float64: Curve value at the current time or performance
+//	error: ErrUnknownParameter if the parameter is not defined
+func (m *Manager) Value(name string) (float64, error) {
+	param, ok := m.params[name]
+	if !ok || param.Curve == nil {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownParameter, name)
+	}
+	if param.Input == ByPerformance {
+		return param.Curve.Evaluate(m.performance), nil
+	}
+	return param.Curve.Evaluate(m.elapsed), nil
+}
+
+// Names returns the defined parameter names, sorted.
+func (m *Manager) Names() []string {
+	names := make([]string, 0, len(m.params))
+	for name := range m.params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Elapsed returns game time in seconds since New or Reset.
+func (m *Manager) Elapsed() float64 {
+	return m.elapsed
+}
+
+// SetElapsed jumps to a game time (e.g. when loading a save).
+func (m *Manager) SetElapsed(seconds float64) {
+	m.elapsed = max(0, seconds)
+}
+
+// Performance returns the player performance rating (0-1).
+func (m *Manager) Performance() float64 {
+	return m.performance
+}
+
+// SetPerformance sets the performance rating directly, clamped to 0-1.
+func (m *Manager) SetPerformance(rating float64) {
+	m.performance = min(1, max(0, rating))
+}
+
+// RecordPerformance blends a sample into the performance rating.
+//
+// Parameters:
+//
+//	sample: How well the player just did, 0 (badly) to 1 (well)
+//
+// Example:
+//
+//	diff.RecordPerformance(1) // Enemy destroyed
+//	diff.RecordPerformance(0) // Enemy escaped
+func (m *Manager) RecordPerformance(sample float64) {
+	weight := min(1, max(0, m.Smoothing))
+	sample = min(1, max(0, sample))
+	m.performance += (sample - m.performance) * weight
+}
+
+// Reset returns to time 0 and neutral performance, keeping parameters.
+func (m *Manager) Reset() {
+	m.elapsed = 0
+	m.performance = 0.5
+}
+
+// Tick advances game time.
+func (m *Manager) Tick(dt float64) {
+	m.elapsed += dt
+}
+
+// Update implements core.Behavior by advancing game time.
+func (m *Manager) Update(_ *core.Entity, dt float64) {
+	m.Tick(dt)
+}
@@ -0,0 +1,215 @@
+package economy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dshills/gogame/engine/inventory"
+)
+
+// Errors returned by shop transactions.
+var (
+	ErrUnknownItem  = errors.New("unknown item")
+	ErrOutOfStock   = errors.New("out of stock")
+	ErrNotForSale   = errors.New("item not for sale")
+	ErrNotPurchased = errors.New("shop does not buy item")
+)
+
+// Unlimited marks an item as having infinite stock.
+const Unlimited = -1
+
+// CatalogItem is an item offered by a shop.
+type CatalogItem struct {
+	ID          string // Item identifier (matches inventory item IDs)
+	Name        string // Display name
+	Description string // Display description
+	BuyPrice    Price  // Price the player pays per unit (Amount 0 = not for sale)
+	SellPrice   Price  // Price the shop pays per unit (Amount 0 = shop won't buy)
+	Stock       int    // Units available (Unlimited = infinite)
+}
+
+// Catalog is an ordered list of priced items.
+type Catalog struct {
+	items []*CatalogItem
+	index map[string]*CatalogItem
+}
+
+// NewCatalog creates a catalog from items (order is preserved for display).
+func NewCatalog(items ...*CatalogItem) *Catalog {
+	c := &Catalog{
+		items: make([]*CatalogItem, 0, len(items)),
+		index: make(map[string]*CatalogItem, len(items)),
+	}
+	for _, item := range items {
+		c.Add(item)
+	}
+	return c
+}
+
+// Add adds or replaces an item.
+func (c *Catalog) Add(item *CatalogItem) {
+	if _, exists := c.index[item.ID]; exists {
+		for i, existing := range c.items {
+			if existing.ID == item.ID {
+				c.items[i] = item
+			}
+		}
+	} else {
+		c.items = append(c.items, item)
+	}
+	c.index[item.ID] = item
+}
+
+// Get returns an item by ID, or nil.
+func (c *Catalog) Get(id string) *CatalogItem {
+	return c.index[id]
+}
+
+// Items returns the catalog items in display order.
+func (c *Catalog) Items() []*CatalogItem {
+	return c.items
+}
+
+// TransactionKind identifies a buy or sell.
+type TransactionKind int
+
+const (
+	// Buy is the player purchasing from the shop.
+	Buy TransactionKind = iota
+	// Sell is the player selling to the shop.
+	Sell
+)
+
+// Transaction describes a completed shop transaction.
+type Transaction struct {
+	Kind     TransactionKind
+	Item     *CatalogItem
+	Quantity int
+	Total    Price
+}
+
+// Shop executes buy and sell transactions against a catalog.
+type Shop struct {
+	Catalog *Catalog
+	Funds   *Wallet // Optional shop wallet; nil means the shop has unlimited money
+
+	// OnTransaction is called after every successful transaction.
+	OnTransaction func(tx Transaction)
+}
+
+// NewShop creates a shop with unlimited funds.
+//
+// Example:
+//
+//	shop := economy.NewShop(economy.NewCatalog(
+//	    &economy.CatalogItem{ID: "potion", Name: "Potion", BuyPrice: economy.Price{Currency: "gold", Amount: 10},
+//	        SellPrice: economy.Price{Currency: "gold", Amount: 4}, Stock: economy.Unlimited},
+//	))
+//	if err := shop.Buy(playerWallet, playerBag, "potion", 2); err != nil {
+//	    showMessage(err.Error())
+//	}
+func NewShop(catalog *Catalog) *Shop {
+	return &Shop{Catalog: catalog}
+}
+
+// Quote returns the total cost of buying qty units of an item.
+func (s *Shop) Quote(itemID string, qty int) (Price, error) {
+	item := s.Catalog.Get(itemID)
+	if item == nil {
+		return Price{}, fmt.Errorf("%w: %s", ErrUnknownItem, itemID)
+	}
+	return item.BuyPrice.Times(qty), nil
+}
+
+// Buy transfers qty units from the shop to the buyer.
+//
+// Returns:
+//
+//	error: Wrapped ErrUnknownItem, ErrNotForSale, ErrOutOfStock, ErrInsufficientFunds,
+//	       ErrInvalidAmount (total overflows), or an inventory error; nothing
+//	       changes (and no OnChanged callback fires) on error
+func (s *Shop) Buy(buyer *Wallet, bag *inventory.Inventory, itemID string, qty int) error {
+	if qty <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, qty)
+	}
+	item := s.Catalog.Get(itemID)
+	if item == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownItem, itemID)
+	}
+	if item.BuyPrice.Amount <= 0 {
+		return fmt.Errorf("%w: %s", ErrNotForSale, itemID)
+	}
+	if item.Stock != Unlimited && item.Stock < qty {
+		return fmt.Errorf("%w: %s (%d left)", ErrOutOfStock, itemID, item.Stock)
+	}
+
+	total := item.BuyPrice.Times(qty)
+	if total.Amount/int64(qty) != item.BuyPrice.Amount {
+		return fmt.Errorf("%w: %d x %s overflows", ErrInvalidAmount, qty, item.BuyPrice)
+	}
+	if !buyer.CanAfford(total) {
+		return fmt.Errorf("%w: %s costs %s", ErrInsufficientFunds, itemID, total)
+	}
+	if err := bag.Add(itemID, qty); err != nil {
+		return fmt.Errorf("failed to buy %s: %w", itemID, err)
+	}
+	_ = buyer.Withdraw(total.Currency, total.Amount) // Checked by CanAfford above
+	if s.Funds != nil {
+		_ = s.Funds.Deposit(total.Currency, total.Amount) // Amount already validated non-negative
+	}
+	if item.Stock != Unlimited {
+		item.Stock -= qty
+	}
+
+	s.notify(Transaction{Kind: Buy, Item: item, Quantity: qty, Total: total})
+	return nil
+}
+
+// Sell transfers qty units from the seller to the shop.
+//
+// Returns:
+//
+//	error: Wrapped ErrUnknownItem, ErrNotPurchased, ErrInsufficientFunds (shop funds),
+//	       ErrInvalidAmount (total overflows), or inventory.ErrInsufficientItems;
+//	       nothing changes on error
+func (s *Shop) Sell(seller *Wallet, bag *inventory.Inventory, itemID string, qty int) error {
+	if qty <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, qty)
+	}
+	item := s.Catalog.Get(itemID)
+	if item == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownItem, itemID)
+	}
+	if item.SellPrice.Amount <= 0 {
+		return fmt.Errorf("%w: %s", ErrNotPurchased, itemID)
+	}
+
+	total := item.SellPrice.Times(qty)
+	if total.Amount/int64(qty) != item.SellPrice.Amount {
+		return fmt.Errorf("%w: %d x %s overflows", ErrInvalidAmount, qty, item.SellPrice)
+	}
+	if s.Funds != nil && !s.Funds.CanAfford(total) {
+		return fmt.Errorf("%w: shop cannot pay %s", ErrInsufficientFunds, total)
+	}
+	if err := bag.Remove(itemID, qty); err != nil {
+		return fmt.Errorf("failed to sell %s: %w", itemID, err)
+	}
+	if s.Funds != nil {
+		_ = s.Funds.Withdraw(total.Currency, total.Amount) // Checked by CanAfford above
+	}
+	if err := seller.Deposit(total.Currency, total.Amount); err != nil {
+		return fmt.Errorf("failed to pay for %s: %w", itemID, err)
+	}
+	if item.Stock != Unlimited {
+		item.Stock += qty
+	}
+
+	s.notify(Transaction{Kind: Sell, Item: item, Quantity: qty, Total: total})
+	return nil
+}
+
+func (s *Shop) notify(tx Transaction) {
+	if s.OnTransaction != nil {
+		s.OnTransaction(tx)
+	}
+}
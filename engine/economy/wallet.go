@@ -0,0 +1,129 @@
+// Package economy provides currency wallets, priced item catalogs, and shop transactions.
+package economy
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Errors returned by wallet and shop operations.
+var (
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrInvalidAmount     = errors.New("invalid amount")
+)
+
+// Price is an amount in a named currency.
+type Price struct {
+	Currency string // Currency name (e.g. "gold", "gems")
+	Amount   int64  // Amount in whole units
+}
+
+// Times returns the price multiplied by a quantity.
+func (p Price) Times(qty int) Price {
+	return Price{Currency: p.Currency, Amount: p.Amount * int64(qty)}
+}
+
+// String formats the price as "<amount> <currency>".
+func (p Price) String() string {
+	return fmt.Sprintf("%d %s", p.Amount, p.Currency)
+}
+
+// BalanceCallback receives a currency balance (used for change events and UI bindings).
+type BalanceCallback func(currency string, balance int64)
+
+// Wallet holds balances in one or more currencies.
+type Wallet struct {
+	balances map[string]int64
+	bindings map[string][]BalanceCallback
+
+	// OnChanged is called after any balance changes.
+	OnChanged func(currency string, oldBalance, newBalance int64)
+}
+
+// NewWallet creates an empty wallet.
+//
+// Example:
+//
+//	wallet := economy.NewWallet()
+//	wallet.Deposit("gold", 100)
+//	wallet.Bind("gold", func(_ string, balance int64) {
+//	    goldText = fmt.Sprintf("Gold: %d", balance)
+//	})
+func NewWallet() *Wallet {
+	return &Wallet{
+		balances: make(map[string]int64),
+		bindings: make(map[string][]BalanceCallback),
+	}
+}
+
+// Balance returns the balance of a currency.
+func (w *Wallet) Balance(currency string) int64 {
+	return w.balances[currency]
+}
+
+// CanAfford reports whether the wallet holds at least the given price.
+func (w *Wallet) CanAfford(price Price) bool {
+	return w.balances[price.Currency] >= price.Amount
+}
+
+// Deposit adds funds.
+//
+// Returns:
+//
+//	error: ErrInvalidAmount if amount is negative
+func (w *Wallet) Deposit(currency string, amount int64) error {
+	if amount < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, amount)
+	}
+	w.set(currency, w.balances[currency]+amount)
+	return nil
+}
+
+// Withdraw removes funds.
+//
+// Returns:
+//
+//	error: ErrInvalidAmount if amount is negative, ErrInsufficientFunds if balance is too low
+func (w *Wallet) Withdraw(currency string, amount int64) error {
+	if amount < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, amount)
+	}
+	balance := w.balances[currency]
+	if balance < amount {
+		return fmt.Errorf("%w: have %d %s, need %d", ErrInsufficientFunds, balance, currency, amount)
+	}
+	w.set(currency, balance-amount)
+	return nil
+}
+
+// Currencies returns the currencies with a recorded balance, sorted.
+func (w *Wallet) Currencies() []string {
+	names := make([]string, 0, len(w.balances))
+	for name := range w.balances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Bind registers a UI binding that is called immediately and after every change to a currency.
+func (w *Wallet) Bind(currency string, callback BalanceCallback) {
+	w.bindings[currency] = append(w.bindings[currency], callback)
+	callback(currency, w.balances[currency])
+}
+
+// set updates a balance and notifies listeners.
+func (w *Wallet) set(currency string, balance int64) {
+	old := w.balances[currency]
+	w.balances[currency] = balance
+	if old == balance {
+		return
+	}
+	if w.OnChanged != nil {
+		w.OnChanged(currency, old, balance)
+	}
+	for _, callback := range w.bindings[currency] {
+		callback(currency, balance)
+	}
+}
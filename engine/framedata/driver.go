@@ -0,0 +1,241 @@
+package framedata
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// FrameSource reports the animation and frame an entity is currently showing.
+type FrameSource interface {
+	Animation() string
+	Frame() int
+}
+
+// HitEvent describes a hitbox overlapping another entity's hurtbox.
+type HitEvent struct {
+	Attacker *core.Entity // Owner of the hitbox
+	Defender *core.Entity // Owner of the hurtbox
+	Hitbox   Box
+	Hurtbox  Box
+}
+
+// Default collision layers for box entities.
+const (
+	DefaultHitLayer  = 29
+	DefaultHurtLayer = 30
+)
+
+// Driver is a Behavior that activates trigger colliders for the boxes of the current frame.
+//
+// Each active box is represented by a pooled trigger entity added to the scene
+// and positioned relative to the owner every update. When one driver's hitbox
+// overlaps another driver's hurtbox, OnHit is called on the attacking driver
+// (at most once per defender per animation play).
+type Driver struct {
+	Data      *Data
+	Source    FrameSource
+	Scene     *core.Scene
+	HitLayer  int // Collision layer for hitboxes (default DefaultHitLayer)
+	HurtLayer int // Collision layer for hurtboxes (default DefaultHurtLayer)
+
+	// OnHit is called when this driver's hitbox touches another driver's hurtbox.
+	OnHit func(hit HitEvent)
+
+	owner       *core.Entity
+	pool        []*core.Entity
+	lastAnim    string
+	lastFrame   int
+	hitThisPlay map[uint64]bool
+}
+
+// boxBehavior tags pooled box entities with their driver and current box.
+type boxBehavior struct {
+	driver *Driver
+	box    Box
+}
+
+// Update is a no-op; box entities are positioned by their driver.
+func (bb *boxBehavior) Update(_ *core.Entity, _ float64) {}
+
+// NewDriver creates a driver that adds its box entities to a scene.
+//
+// Example:
+//
+//	frames := framedata.NewFrameCounter(12)
+//	driver := framedata.NewDriver(data, frames, scene)
+//	driver.OnHit = func(hit framedata.HitEvent) { applyDamage(hit.Defender, hit.Hitbox.Damage) }
+//	fighter.Behavior = core.Behaviors{controller, frames, driver}
+//	frames.Play("punch")
+func NewDriver(data *Data, source FrameSource, scene *core.Scene) *Driver {
+	return &Driver{
+		Data:        data,
+		Source:      source,
+		Scene:       scene,
+		HitLayer:    DefaultHitLayer,
+		HurtLayer:   DefaultHurtLayer,
+		pool:        make([]*core.Entity, 0),
+		lastFrame:   -1,
+		hitThisPlay: make(map[uint64]bool),
+	}
+}
+
+// Owner returns the entity the driver is attached to (nil before the first update).
+func (d *Driver) Owner() *core.Entity {
+	return d.owner
+}
+
+// Update syncs box entities with the current animation frame.
+func (d *Driver) Update(entity *core.Entity, _ float64) {
+	d.owner = entity
+
+	animName, frame := "", -1
+	if d.Source != nil {
+		animName, frame = d.Source.Animation(), d.Source.Frame()
+	}
+	if animName != d.lastAnim || frame < d.lastFrame {
+		d.hitThisPlay = make(map[uint64]bool) // New play: allow hits again
+	}
+	d.lastAnim, d.lastFrame = animName, frame
+
+	var active []Box
+	if anim := d.Data.Get(animName); anim != nil && entity.Active {
+		active = anim.ActiveBoxes(frame)
+	}
+
+	for len(d.pool) < len(active) {
+		d.pool = append(d.pool, d.newBoxEntity())
+	}
+
+	flipped := entity.Sprite != nil && entity.Sprite.FlipH
+	for i, boxEntity := range d.pool {
+		if i >= len(active) {
+			boxEntity.Active = false
+			continue
+		}
+		d.place(boxEntity, active[i], entity, flipped)
+	}
+}
+
+// Destroy removes the driver's box entities from the scene.
+func (d *Driver) Destroy() {
+	for _, boxEntity := range d.pool {
+		if d.Scene != nil {
+			d.Scene.RemoveEntity(boxEntity.ID)
+		}
+	}
+	d.pool = d.pool[:0]
+}
+
+// place positions a pooled entity for a box.
+func (d *Driver) place(boxEntity *core.Entity, box Box, owner *core.Entity, flipped bool) {
+	behavior := boxEntity.Behavior.(*boxBehavior)
+	behavior.box = box
+
+	rect := box.Rect(flipped)
+	world := owner.WorldTransform()
+	boxEntity.Active = true
+	boxEntity.Transform.Position = world.Position
+	boxEntity.Transform.Scale = gamemath.Vector2{X: 1, Y: 1}
+	boxEntity.Collider.Bounds = gamemath.Rectangle{
+		X:      rect.X * world.Scale.X,
+		Y:      rect.Y * world.Scale.Y,
+		Width:  rect.Width * world.Scale.X,
+		Height: rect.Height * world.Scale.Y,
+	}
+
+	if box.Kind == Hitbox {
+		boxEntity.Collider.CollisionLayer = d.HitLayer
+		boxEntity.Collider.CollisionMask = 1 << d.HurtLayer
+	} else {
+		boxEntity.Collider.CollisionLayer = d.HurtLayer
+		boxEntity.Collider.CollisionMask = 1 << d.HitLayer
+	}
+}
+
+// newBoxEntity creates and registers a pooled trigger entity.
+func (d *Driver) newBoxEntity() *core.Entity {
+	collider := physics.NewCollider(0, 0)
+	collider.IsTrigger = true
+
+	boxEntity := &core.Entity{
+		Collider: collider,
+		Behavior: &boxBehavior{driver: d},
+	}
+	boxEntity.OnCollisionEnter = d.handleContact
+	if d.Scene != nil {
+		d.Scene.AddEntity(boxEntity)
+	}
+	return boxEntity
+}
+
+// handleContact converts box collisions into hit events.
+func (d *Driver) handleContact(self, other *core.Entity, _ core.CollisionInfo) {
+	selfBox, ok := self.Behavior.(*boxBehavior)
+	if !ok || selfBox.box.Kind != Hitbox {
+		return
+	}
+	otherBox, ok := other.Behavior.(*boxBehavior)
+	if !ok || otherBox.box.Kind != Hurtbox || otherBox.driver == d {
+		return
+	}
+
+	defender := otherBox.driver.owner
+	if defender == nil || d.owner == nil || d.hitThisPlay[defender.ID] {
+		return
+	}
+	d.hitThisPlay[defender.ID] = true
+
+	if d.OnHit != nil {
+		d.OnHit(HitEvent{
+			Attacker: d.owner,
+			Defender: defender,
+			Hitbox:   selfBox.box,
+			Hurtbox:  otherBox.box,
+		})
+	}
+}
+
+// FrameCounter is a simple FrameSource that advances at a fixed frame rate.
+type FrameCounter struct {
+	FPS     float64 // Animation frames per second
+	Loop    bool    // Wrap around at the animation's frame count
+	Data    *Data   // Optional; used for frame counts when looping or stopping
+	anim    string
+	elapsed float64
+}
+
+// NewFrameCounter creates a frame counter at the given frames per second.
+func NewFrameCounter(fps float64) *FrameCounter {
+	return &FrameCounter{FPS: fps}
+}
+
+// Play starts an animation from frame 0.
+func (fc *FrameCounter) Play(anim string) {
+	fc.anim = anim
+	fc.elapsed = 0
+}
+
+// Animation returns the playing animation name.
+func (fc *FrameCounter) Animation() string {
+	return fc.anim
+}
+
+// Frame returns the current frame index.
+func (fc *FrameCounter) Frame() int {
+	frame := int(fc.elapsed * fc.FPS)
+	if anim := fc.Data.Get(fc.anim); anim != nil && anim.Frames > 0 {
+		if fc.Loop {
+			return frame % anim.Frames
+		}
+		if frame >= anim.Frames {
+			return anim.Frames - 1
+		}
+	}
+	return frame
+}
+
+// Update advances the counter (implements core.Behavior).
+func (fc *FrameCounter) Update(_ *core.Entity, dt float64) {
+	fc.elapsed += dt
+}
@@ -0,0 +1,113 @@
+// Package framedata provides per-animation-frame hitbox and hurtbox definitions for melee and fighting games.
+package framedata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// BoxKind distinguishes attacking boxes from vulnerable boxes.
+type BoxKind string
+
+const (
+	// Hitbox deals damage to overlapping hurtboxes.
+	Hitbox BoxKind = "hit"
+	// Hurtbox receives hits.
+	Hurtbox BoxKind = "hurt"
+)
+
+// Box is a hitbox or hurtbox active for a range of animation frames.
+//
+// Coordinates are local to the entity position (facing right) and are mirrored
+// horizontally when the entity's sprite is flipped.
+type Box struct {
+	Kind   BoxKind `json:"kind"`
+	Start  int     `json:"start"` // First active frame (inclusive)
+	End    int     `json:"end"`   // Last active frame (inclusive)
+	X      float64 `json:"x"`     // Left edge relative to entity position
+	Y      float64 `json:"y"`     // Top edge relative to entity position
+	Width  float64 `json:"w"`
+	Height float64 `json:"h"`
+	Damage float64 `json:"damage,omitempty"` // Damage dealt (hitboxes)
+	Tag    string  `json:"tag,omitempty"`    // Free-form tag (e.g. "launcher", "head")
+}
+
+// Rect returns the local rectangle, mirrored horizontally if flipped.
+func (b Box) Rect(flipped bool) gamemath.Rectangle {
+	x := b.X
+	if flipped {
+		x = -b.X - b.Width
+	}
+	return gamemath.Rectangle{X: x, Y: b.Y, Width: b.Width, Height: b.Height}
+}
+
+// ActiveOn reports whether the box is active on a frame.
+func (b Box) ActiveOn(frame int) bool {
+	return frame >= b.Start && frame <= b.End
+}
+
+// Animation holds the boxes for one animation.
+type Animation struct {
+	Frames int   `json:"frames"` // Total frame count
+	Boxes  []Box `json:"boxes"`
+}
+
+// ActiveBoxes returns boxes active on a frame.
+func (a *Animation) ActiveBoxes(frame int) []Box {
+	active := make([]Box, 0, len(a.Boxes))
+	for _, box := range a.Boxes {
+		if box.ActiveOn(frame) {
+			active = append(active, box)
+		}
+	}
+	return active
+}
+
+// Data is a set of animations keyed by name.
+type Data struct {
+	Animations map[string]*Animation `json:"animations"`
+}
+
+// Load reads frame data from JSON.
+//
+// Format:
+//
+//	{"animations": {
+//	    "punch": {"frames": 8, "boxes": [
+//	        {"kind": "hurt", "start": 0, "end": 7, "x": -10, "y": -30, "w": 20, "h": 60},
+//	        {"kind": "hit", "start": 3, "end": 4, "x": 10, "y": -20, "w": 25, "h": 10, "damage": 8}
+//	    ]}
+//	}}
+//
+// Returns:
+//
+//	*Data: Parsed frame data
+//	error: Non-nil if decoding fails or a box is malformed
+func Load(r io.Reader) (*Data, error) {
+	var data Data
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode frame data: %w", err)
+	}
+	for name, anim := range data.Animations {
+		for i, box := range anim.Boxes {
+			if box.Kind != Hitbox && box.Kind != Hurtbox {
+				return nil, fmt.Errorf("animation %s box %d: unknown kind %q", name, i, box.Kind)
+			}
+			if box.End < box.Start {
+				return nil, fmt.Errorf("animation %s box %d: end frame %d before start %d", name, i, box.End, box.Start)
+			}
+		}
+	}
+	return &data, nil
+}
+
+// Get returns an animation by name, or nil.
+func (d *Data) Get(name string) *Animation {
+	if d == nil {
+		return nil
+	}
+	return d.Animations[name]
+}
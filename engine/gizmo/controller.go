@@ -0,0 +1,87 @@
+package gizmo
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	"github.com/dshills/gogame/engine/picking"
+)
+
+// Controller is a Behavior that routes mouse input to a gizmo: it
+// highlights handles under the cursor, drags them, and optionally picks a
+// new target when a click misses the gizmo.
+//
+// Attach it to any always-active entity, or call Update manually once per
+// frame.
+type Controller struct {
+	Input     *input.InputManager       // Input manager used for mouse state
+	Camera    *graphics.Camera          // Camera the gizmo is drawn through
+	Gizmo     *Gizmo                    // Gizmo driven by the mouse
+	Button    input.KeyCode             // Mouse button that drags (default KeyMouseLeft)
+	CancelKey input.KeyCode             // Key that cancels a drag (default KeyEscape, KeyNone = none)
+	Scene     *core.Scene               // Clicks off the gizmo pick a target here (nil = target set by the caller)
+	Selection *picking.SelectionSet     // Set to the picked target (optional)
+	OnPick    func(target *core.Entity) // Called when a click picks a target or empty space (nil target) (optional)
+}
+
+// NewController creates a controller dragging with the left mouse button
+//
+// Example:
+//
+//	handles := gizmo.New()
+//	controller := gizmo.NewController(engine.Input(), scene.Camera(), handles)
+//	controller.Scene = scene
+//	controller.Selection = inspectorSelection
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: controller})
+func NewController(inputMgr *input.InputManager, camera *graphics.Camera, gizmo *Gizmo) *Controller {
+	return &Controller{
+		Input:     inputMgr,
+		Camera:    camera,
+		Gizmo:     gizmo,
+		Button:    input.KeyMouseLeft,
+		CancelKey: input.KeyEscape,
+	}
+}
+
+// Update processes mouse input for one frame.
+func (c *Controller) Update(_ *core.Entity, _ float64) {
+	if c.Input == nil || c.Camera == nil || c.Gizmo == nil {
+		return
+	}
+	mouseX, mouseY := c.Input.MousePosition()
+	x, y := int(mouseX), int(mouseY)
+	g := c.Gizmo
+
+	switch {
+	case g.Dragging() && c.CancelKey != input.KeyNone && c.Input.KeyPressed(c.CancelKey):
+		g.Cancel()
+	case g.Dragging() && c.Input.KeyReleased(c.Button):
+		g.DragTo(c.Camera, x, y)
+		g.End()
+	case g.Dragging():
+		g.DragTo(c.Camera, x, y)
+	case c.Input.KeyPressed(c.Button):
+		if !g.Begin(c.Camera, x, y) && c.Scene != nil {
+			c.pick(x, y)
+		}
+	default:
+		g.Hover(c.Camera, x, y)
+	}
+}
+
+// pick makes the topmost entity under the cursor the target (nil on empty
+// space).
+func (c *Controller) pick(screenX, screenY int) {
+	target := picking.Pick(c.Scene, screenX, screenY)
+	c.Gizmo.Target = target
+	if c.Selection != nil {
+		if target != nil {
+			c.Selection.Set(target)
+		} else {
+			c.Selection.Clear()
+		}
+	}
+	if c.OnPick != nil {
+		c.OnPick(target)
+	}
+}
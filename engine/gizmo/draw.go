@@ -0,0 +1,102 @@
+package gizmo
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ringSegments is the number of lines approximating the rotation ring.
+const ringSegments = 48
+
+// Draw draws the gizmo's handles over its target (implements debug.Drawer).
+//
+// Behavior:
+//   - Does nothing without a target
+//   - The hovered or dragged handle is drawn in HighlightColor
+//   - The rotation ring shows a spoke along the target's world rotation
+func (g *Gizmo) Draw(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	if g.Target == nil {
+		return nil
+	}
+	origin := g.origin(camera)
+	size := g.size()
+
+	if g.Mode == ModeRotate {
+		color := g.color(HandleRotate, g.FreeColor)
+		spoke := origin.Add(gamemath.Vector2{X: size}.Rotate(g.Target.WorldTransform().Rotation))
+		if err := line(renderer, origin, spoke, color); err != nil {
+			return err
+		}
+		previous := origin.Add(gamemath.Vector2{X: size})
+		for i := 1; i <= ringSegments; i++ {
+			next := origin.Add(gamemath.Vector2{X: size}.Rotate(float64(i) * 360 / ringSegments))
+			if err := line(renderer, previous, next, color); err != nil {
+				return err
+			}
+			previous = next
+		}
+		return nil
+	}
+
+	xAxis, yAxis := g.axes()
+	handles := [3]Handle{HandleMoveX, HandleMoveY, HandleMoveXY}
+	if g.Mode == ModeScale {
+		handles = [3]Handle{HandleScaleX, HandleScaleY, HandleScaleXY}
+	}
+	colors := [2]gamemath.Color{g.color(handles[0], g.XColor), g.color(handles[1], g.YColor)}
+	half := g.centerHalf()
+	for i, axis := range [2]gamemath.Vector2{xAxis, yAxis} {
+		start, end := origin.Add(axis.Scale(half)), origin.Add(axis.Scale(size))
+		if err := line(renderer, start, end, colors[i]); err != nil {
+			return err
+		}
+		if err := g.drawTip(renderer, end, axis, colors[i]); err != nil {
+			return err
+		}
+	}
+	return square(renderer, origin, xAxis, yAxis, half, g.color(handles[2], g.FreeColor))
+}
+
+// drawTip draws an arrowhead (move) or box (scale) at the end of an axis.
+func (g *Gizmo) drawTip(renderer *graphics.Renderer, end, axis gamemath.Vector2, color gamemath.Color) error {
+	tip := g.size() / 8
+	if g.Mode == ModeScale {
+		return square(renderer, end, axis, gamemath.Vector2{X: -axis.Y, Y: axis.X}, tip/2, color)
+	}
+	for _, side := range []float64{150, -150} {
+		if err := line(renderer, end, end.Add(axis.Rotate(side).Scale(tip)), color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// color returns HighlightColor for the hovered or dragged handle, base otherwise.
+func (g *Gizmo) color(handle Handle, base gamemath.Color) gamemath.Color {
+	if g.drag.handle == handle || (!g.Dragging() && g.hovered == handle) {
+		return g.HighlightColor
+	}
+	return base
+}
+
+// square draws the outline of a square centered on center with sides along
+// two axes.
+func square(renderer *graphics.Renderer, center, xAxis, yAxis gamemath.Vector2, half float64, color gamemath.Color) error {
+	x, y := xAxis.Scale(half), yAxis.Scale(half)
+	corners := [4]gamemath.Vector2{
+		center.Sub(x).Sub(y), center.Add(x).Sub(y), center.Add(x).Add(y), center.Sub(x).Add(y),
+	}
+	for i, corner := range corners {
+		if err := line(renderer, corner, corners[(i+1)%4], color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// line draws a screen-space line rounded to whole pixels.
+func line(renderer *graphics.Renderer, from, to gamemath.Vector2, color gamemath.Color) error {
+	return renderer.DrawLine(math.Round(from.X), math.Round(from.Y), math.Round(to.X), math.Round(to.Y), color)
+}
@@ -0,0 +1,384 @@
+// Package gizmo provides move, rotate, and scale handles for manipulating
+// entities with the mouse, shared by in-game inspectors and editors so every
+// tool built on gogame handles the same way.
+//
+// A Gizmo is drawn over one target entity in screen space (handles keep
+// their size at any zoom) and is picked and dragged with screen
+// coordinates. A Controller routes mouse input to it and picks targets:
+//
+//	handles := gizmo.New()
+//	controller := gizmo.NewController(engine.Input(), scene.Camera(), handles)
+//	controller.Scene = scene // Click entities to select them
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: controller})
+//	engine.SetRenderUICallback(func() {
+//	    _ = handles.Draw(engine.Renderer(), scene.Camera())
+//	})
+//
+// Gizmo also implements debug.Drawer, so it can be added to a debug.Overlay.
+package gizmo
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// minScale keeps scale handles from collapsing an axis to zero.
+const minScale = 0.01
+
+// Mode selects which handles a gizmo shows.
+type Mode int
+
+// Gizmo modes.
+const (
+	ModeMove   Mode = iota // Axis arrows and a free-move square
+	ModeRotate             // A ring around the target
+	ModeScale              // Axis handles along the target's rotation and a uniform-scale square
+)
+
+// String returns the mode name.
+func (m Mode) String() string {
+	switch m {
+	case ModeMove:
+		return "move"
+	case ModeRotate:
+		return "rotate"
+	case ModeScale:
+		return "scale"
+	}
+	return "unknown"
+}
+
+// Handle identifies a part of a gizmo under the cursor or being dragged.
+type Handle int
+
+// Gizmo handles.
+const (
+	HandleNone    Handle = iota
+	HandleMoveX          // Move along world X
+	HandleMoveY          // Move along world Y
+	HandleMoveXY         // Move freely
+	HandleRotate         // Rotate about the target's position
+	HandleScaleX         // Scale the target's local X
+	HandleScaleY         // Scale the target's local Y
+	HandleScaleXY        // Scale both axes uniformly
+)
+
+// Gizmo manipulates one entity's transform through mouse-driven handles.
+//
+// Moving changes the world position (SetWorldPosition, so children of
+// rotated or scaled parents follow the cursor); rotating and scaling change
+// the local Transform.
+type Gizmo struct {
+	Mode      Mode
+	Target    *core.Entity // Entity manipulated (nil = hidden)
+	Size      float64      // Axis length and ring radius in screen pixels (0 = 64)
+	HitRadius float64      // Pick distance from a handle in screen pixels (0 = 6)
+
+	MoveSnap   float64 // Grid step for moved positions in world units (0 = none)
+	RotateSnap float64 // Rotation step in degrees (0 = none)
+	ScaleSnap  float64 // Scale step (0 = none)
+
+	XColor         gamemath.Color // X axis handles
+	YColor         gamemath.Color // Y axis handles
+	FreeColor      gamemath.Color // Free-move and uniform-scale squares, rotation ring
+	HighlightColor gamemath.Color // Hovered or dragged handle
+
+	OnBegin  func(target *core.Entity, handle Handle)             // Drag started (optional)
+	OnChange func(target *core.Entity)                            // Transform changed while dragging (optional)
+	OnEnd    func(target *core.Entity, before gamemath.Transform) // Drag finished; before is the local transform at the start, for undo (optional)
+
+	hovered Handle
+	drag    dragState
+}
+
+// dragState is an in-progress drag.
+type dragState struct {
+	handle      Handle
+	startScreen gamemath.Vector2   // Cursor screen position at the start
+	startWorld  gamemath.Vector2   // Cursor world position at the start
+	before      gamemath.Transform // Target local transform at the start
+	world       gamemath.Transform // Target world transform at the start
+	origin      gamemath.Vector2   // Target screen position at the start
+	angle       float64            // Cursor angle about the origin at the last update (degrees)
+	turned      float64            // Unwrapped rotation since the start (degrees)
+}
+
+// New creates a move gizmo with red/green axes and yellow highlights.
+func New() *Gizmo {
+	return &Gizmo{
+		Mode:           ModeMove,
+		XColor:         gamemath.Color{R: 230, G: 70, B: 70, A: 255},
+		YColor:         gamemath.Color{R: 80, G: 210, B: 90, A: 255},
+		FreeColor:      gamemath.Color{R: 90, G: 150, B: 255, A: 255},
+		HighlightColor: gamemath.Color{R: 255, G: 220, B: 60, A: 255},
+	}
+}
+
+// size returns the handle length in pixels.
+func (g *Gizmo) size() float64 {
+	if g.Size <= 0 {
+		return 64
+	}
+	return g.Size
+}
+
+// hitRadius returns the pick distance in pixels.
+func (g *Gizmo) hitRadius() float64 {
+	if g.HitRadius <= 0 {
+		return 6
+	}
+	return g.HitRadius
+}
+
+// centerHalf returns half the side of the center square in pixels.
+func (g *Gizmo) centerHalf() float64 {
+	return max(g.hitRadius(), g.size()/8)
+}
+
+// Hovered returns the handle under the cursor at the last Hover call.
+func (g *Gizmo) Hovered() Handle {
+	return g.hovered
+}
+
+// Active returns the handle being dragged (HandleNone when idle).
+func (g *Gizmo) Active() Handle {
+	return g.drag.handle
+}
+
+// Dragging reports whether a handle is being dragged.
+func (g *Gizmo) Dragging() bool {
+	return g.drag.handle != HandleNone
+}
+
+// origin returns the target's position on screen.
+func (g *Gizmo) origin(camera *graphics.Camera) gamemath.Vector2 {
+	position := g.Target.WorldTransform().Position
+	x, y := camera.WorldToScreen(position.X, position.Y)
+	return gamemath.Vector2{X: float64(x), Y: float64(y)}
+}
+
+// axes returns the screen directions of the X and Y handles.
+func (g *Gizmo) axes() (gamemath.Vector2, gamemath.Vector2) {
+	x, y := gamemath.Vector2{X: 1}, gamemath.Vector2{Y: 1}
+	if g.Mode == ModeScale {
+		rotation := g.Target.WorldTransform().Rotation
+		return x.Rotate(rotation), y.Rotate(rotation)
+	}
+	return x, y
+}
+
+// HandleAt returns the handle at a screen position
+//
+// Parameters:
+//
+//	camera: Camera the gizmo is drawn through
+//	screenX, screenY: Screen pixel coordinates (e.g. the mouse position)
+//
+// Returns:
+//
+//	Handle: Handle under the point, or HandleNone (also when there's no
+//	        target)
+//
+// Behavior:
+//   - The center square wins over axes, so it stays grabbable when axes
+//     overlap it
+func (g *Gizmo) HandleAt(camera *graphics.Camera, screenX, screenY int) Handle {
+	if g.Target == nil {
+		return HandleNone
+	}
+	origin := g.origin(camera)
+	point := gamemath.Vector2{X: float64(screenX), Y: float64(screenY)}
+	offset := point.Sub(origin)
+	size, hit, half := g.size(), g.hitRadius(), g.centerHalf()
+
+	if g.Mode == ModeRotate {
+		if math.Abs(offset.Length()-size) <= hit {
+			return HandleRotate
+		}
+		return HandleNone
+	}
+
+	xAxis, yAxis := g.axes()
+	if math.Abs(offset.Dot(xAxis)) <= half && math.Abs(offset.Dot(yAxis)) <= half {
+		if g.Mode == ModeScale {
+			return HandleScaleXY
+		}
+		return HandleMoveXY
+	}
+	handles := [2]Handle{HandleMoveX, HandleMoveY}
+	if g.Mode == ModeScale {
+		handles = [2]Handle{HandleScaleX, HandleScaleY}
+	}
+	for i, axis := range [2]gamemath.Vector2{xAxis, yAxis} {
+		along := offset.Dot(axis)
+		across := offset.Sub(axis.Scale(along)).Length()
+		if along >= half && along <= size+hit && across <= hit {
+			return handles[i]
+		}
+	}
+	return HandleNone
+}
+
+// Hover updates the highlighted handle for a cursor position and returns it.
+func (g *Gizmo) Hover(camera *graphics.Camera, screenX, screenY int) Handle {
+	if g.Dragging() {
+		return g.hovered
+	}
+	g.hovered = g.HandleAt(camera, screenX, screenY)
+	return g.hovered
+}
+
+// Begin starts dragging the handle at a screen position
+//
+// Parameters:
+//
+//	camera: Camera the gizmo is drawn through
+//	screenX, screenY: Cursor position when the mouse button went down
+//
+// Returns:
+//
+//	bool: True if a handle was grabbed (false = the click missed the gizmo,
+//	      e.g. to pick a new target)
+func (g *Gizmo) Begin(camera *graphics.Camera, screenX, screenY int) bool {
+	handle := g.HandleAt(camera, screenX, screenY)
+	if handle == HandleNone {
+		return false
+	}
+	worldX, worldY := camera.ScreenToWorld(screenX, screenY)
+	start := gamemath.Vector2{X: float64(screenX), Y: float64(screenY)}
+	origin := g.origin(camera)
+	g.drag = dragState{
+		handle:      handle,
+		startScreen: start,
+		startWorld:  gamemath.Vector2{X: worldX, Y: worldY},
+		before:      g.Target.Transform,
+		world:       g.Target.WorldTransform(),
+		origin:      origin,
+		angle:       angleOf(start.Sub(origin)),
+	}
+	g.hovered = handle
+	if g.OnBegin != nil {
+		g.OnBegin(g.Target, handle)
+	}
+	return true
+}
+
+// DragTo applies the active drag for a cursor position.
+func (g *Gizmo) DragTo(camera *graphics.Camera, screenX, screenY int) {
+	if !g.Dragging() || g.Target == nil {
+		return
+	}
+	cursor := gamemath.Vector2{X: float64(screenX), Y: float64(screenY)}
+	switch g.drag.handle {
+	case HandleMoveX, HandleMoveY, HandleMoveXY:
+		worldX, worldY := camera.ScreenToWorld(screenX, screenY)
+		g.Target.SetWorldPosition(g.movedPosition(gamemath.Vector2{X: worldX, Y: worldY}))
+	case HandleRotate:
+		angle := angleOf(cursor.Sub(g.drag.origin))
+		g.drag.turned += math.Remainder(angle-g.drag.angle, 360)
+		g.drag.angle = angle
+		g.Target.Transform.Rotation = g.drag.before.Rotation + snap(g.drag.turned, g.RotateSnap)
+	case HandleScaleX, HandleScaleY, HandleScaleXY:
+		g.Target.Transform.Scale = g.scaled(cursor)
+	}
+	if g.OnChange != nil {
+		g.OnChange(g.Target)
+	}
+}
+
+// movedPosition returns the target's new world position for a cursor
+// world position, constrained to the dragged axis and snapped.
+func (g *Gizmo) movedPosition(cursor gamemath.Vector2) gamemath.Vector2 {
+	position := g.drag.world.Position.Add(cursor.Sub(g.drag.startWorld))
+	if g.drag.handle != HandleMoveY {
+		position.X = snap(position.X, g.MoveSnap)
+	} else {
+		position.X = g.drag.world.Position.X
+	}
+	if g.drag.handle != HandleMoveX {
+		position.Y = snap(position.Y, g.MoveSnap)
+	} else {
+		position.Y = g.drag.world.Position.Y
+	}
+	return position
+}
+
+// scaled returns the target's new local scale for a cursor screen position:
+// dragging one handle length outward doubles the scale.
+func (g *Gizmo) scaled(cursor gamemath.Vector2) gamemath.Vector2 {
+	xAxis, yAxis := gamemath.Vector2{X: 1}.Rotate(g.drag.world.Rotation), gamemath.Vector2{Y: 1}.Rotate(g.drag.world.Rotation)
+	axis := xAxis
+	switch g.drag.handle {
+	case HandleScaleY:
+		axis = yAxis
+	case HandleScaleXY:
+		axis = xAxis.Add(yAxis).Normalize()
+	}
+	moved := cursor.Sub(g.drag.startScreen).Dot(axis)
+	factor := 1 + moved/g.size()
+
+	scale := g.drag.before.Scale
+	if g.drag.handle != HandleScaleY {
+		scale.X = clampScale(snap(scale.X*factor, g.ScaleSnap))
+	}
+	if g.drag.handle != HandleScaleX {
+		scale.Y = clampScale(snap(scale.Y*factor, g.ScaleSnap))
+	}
+	return scale
+}
+
+// End finishes the active drag, calling OnEnd.
+func (g *Gizmo) End() {
+	if !g.Dragging() {
+		return
+	}
+	before := g.drag.before
+	g.drag = dragState{}
+	if g.OnEnd != nil && g.Target != nil {
+		g.OnEnd(g.Target, before)
+	}
+}
+
+// Cancel abandons the active drag, restoring the target's transform
+// (OnChange is called, OnEnd is not).
+func (g *Gizmo) Cancel() {
+	if !g.Dragging() {
+		return
+	}
+	before := g.drag.before
+	g.drag = dragState{}
+	if g.Target == nil {
+		return
+	}
+	g.Target.Transform = before
+	if g.OnChange != nil {
+		g.OnChange(g.Target)
+	}
+}
+
+// angleOf returns a screen direction's angle in degrees (clockwise from +X).
+func angleOf(v gamemath.Vector2) float64 {
+	return math.Atan2(v.Y, v.X) * 180 / math.Pi
+}
+
+// snap rounds value to a multiple of step (step <= 0 leaves it unchanged).
+func snap(value, step float64) float64 {
+	if step <= 0 {
+		return value
+	}
+	return math.Round(value/step) * step
+}
+
+// clampScale keeps a scale at least minScale from zero, preserving its sign.
+func clampScale(scale float64) float64 {
+	if math.Abs(scale) >= minScale {
+		return scale
+	}
+	if scale < 0 {
+		return -minScale
+	}
+	return minScale
+}
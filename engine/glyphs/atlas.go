@@ -0,0 +1,395 @@
+package glyphs
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+	"unsafe"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// IconSize is the width and height of each bundled icon in pixels.
+const IconSize = 32
+
+// atlasColumns is the number of icons per atlas row.
+const atlasColumns = 16
+
+// Atlas is a texture of button icons with named regions.
+type Atlas struct {
+	Texture *graphics.Texture
+	Regions map[string]gamemath.Rectangle // Icon name -> texture region
+}
+
+// NewAtlas wraps a custom icon texture (e.g. hand-drawn art laid out to match
+// the names returned by Glyph.Icon).
+func NewAtlas(texture *graphics.Texture, regions map[string]gamemath.Rectangle) *Atlas {
+	return &Atlas{Texture: texture, Regions: regions}
+}
+
+// LoadAtlas uploads the bundled icon atlas to a texture.
+//
+// The bundled icons are drawn procedurally at load time: keycaps for the
+// keyboard, labelled mouse buttons, and face, shoulder, stick, and menu
+// buttons for Xbox, PlayStation, and Nintendo controllers.
+//
+// Example:
+//
+//	prompts := glyphs.NewService(engine.Input())
+//	atlas, err := glyphs.LoadAtlas(engine.Renderer())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	prompts.Atlas = atlas
+func LoadAtlas(renderer *graphics.Renderer) (*Atlas, error) {
+	img, regions := AtlasImage()
+	bounds := img.Bounds()
+	texture, err := renderer.NewStreamingTexture(bounds.Dx(), bounds.Dy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create glyph atlas: %w", err)
+	}
+	if err := texture.GetSDLTexture().Update(nil, unsafe.Pointer(&img.Pix[0]), img.Stride); err != nil {
+		_ = texture.Destroy() // Best effort cleanup
+		return nil, fmt.Errorf("failed to upload glyph atlas: %w", err)
+	}
+	return NewAtlas(texture, regions), nil
+}
+
+// Sprite returns a sprite showing one icon (nil if the atlas has no such icon).
+func (a *Atlas) Sprite(icon string) *graphics.Sprite {
+	region, ok := a.Regions[icon]
+	if !ok || a.Texture == nil {
+		return nil
+	}
+	sprite := graphics.NewSprite(a.Texture)
+	sprite.SourceRect = region
+	return sprite
+}
+
+// Destroy frees the atlas texture.
+func (a *Atlas) Destroy() error {
+	if a.Texture == nil {
+		return nil
+	}
+	return a.Texture.Destroy()
+}
+
+// AtlasImage draws the bundled icons and returns the image with its regions.
+//
+// Useful for exporting the default art as a starting point for a custom atlas
+// (png.Encode the image and keep the region names).
+func AtlasImage() (*image.RGBA, map[string]gamemath.Rectangle) {
+	icons := bundledIcons()
+	rows := (len(icons) + atlasColumns - 1) / atlasColumns
+	img := image.NewRGBA(image.Rect(0, 0, atlasColumns*IconSize, rows*IconSize))
+	regions := make(map[string]gamemath.Rectangle, len(icons))
+	for i, icon := range icons {
+		x, y := (i%atlasColumns)*IconSize, (i/atlasColumns)*IconSize
+		icon.draw(&canvas{img: img, x: x, y: y})
+		regions[icon.name] = gamemath.Rectangle{X: float64(x), Y: float64(y), Width: IconSize, Height: IconSize}
+	}
+	return img, regions
+}
+
+// icon is one bundled atlas entry.
+type icon struct {
+	name string
+	draw func(c *canvas)
+}
+
+// Palette for the bundled icons.
+var (
+	keyFace   = color.RGBA{232, 232, 236, 255}
+	keyEdge   = color.RGBA{120, 120, 130, 255}
+	darkFace  = color.RGBA{40, 42, 48, 255}
+	lightText = color.RGBA{245, 245, 245, 255}
+	darkText  = color.RGBA{30, 30, 34, 255}
+)
+
+// bundledIcons lists every icon in atlas order.
+func bundledIcons() []icon {
+	icons := make([]icon, 0, 96)
+
+	// Keyboard keys, sorted for a stable layout
+	keys := make([]string, 0, len(keyLabels)+36)
+	for key, label := range keyLabels {
+		if key < input.KeyMouseLeft {
+			keys = append(keys, label)
+		}
+	}
+	sort.Strings(keys)
+	for c := 'A'; c <= 'Z'; c++ {
+		keys = append(keys, string(c))
+	}
+	for c := '0'; c <= '9'; c++ {
+		keys = append(keys, string(c))
+	}
+	arrows := map[string]float64{"Up": -90, "Down": 90, "Left": 180, "Right": 0}
+	for _, label := range keys {
+		caption := strings.ToUpper(label)
+		angle, isArrow := arrows[label]
+		icons = append(icons, icon{name: iconName("key", label), draw: func(c *canvas) {
+			c.roundRect(2, 4, 28, 24, 5, keyEdge)
+			c.roundRect(3, 5, 26, 21, 4, keyFace)
+			if isArrow {
+				c.arrow(16, 15, 6, angle, darkText)
+			} else {
+				c.text(caption, 16, 15, 22, darkText)
+			}
+		}})
+	}
+
+	// Mouse buttons: a mouse body with the pressed button highlighted
+	for _, button := range []string{"LMB", "MMB", "RMB"} {
+		icons = append(icons, icon{name: iconName("mouse", button), draw: func(c *canvas) {
+			c.roundRect(8, 3, 16, 26, 8, keyEdge)
+			c.roundRect(9, 4, 14, 24, 7, keyFace)
+			switch button {
+			case "LMB":
+				c.roundRect(9, 4, 7, 10, 3, color.RGBA{230, 90, 60, 255})
+			case "RMB":
+				c.roundRect(16, 4, 7, 10, 3, color.RGBA{230, 90, 60, 255})
+			default:
+				c.roundRect(14, 6, 4, 7, 2, color.RGBA{230, 90, 60, 255})
+			}
+		}})
+	}
+
+	// D-pad (shared by every controller family)
+	for _, dir := range []struct {
+		label string
+		angle float64
+	}{{"D-Pad Up", -90}, {"D-Pad Down", 90}, {"D-Pad Left", 180}, {"D-Pad Right", 0}} {
+		label, angle := dir.label, dir.angle
+		icons = append(icons, icon{name: iconName("pad", label), draw: func(c *canvas) {
+			c.roundRect(11, 2, 10, 28, 2, darkFace)
+			c.roundRect(2, 11, 28, 10, 2, darkFace)
+			dx, dy := math.Cos(angle*math.Pi/180), math.Sin(angle*math.Pi/180)
+			c.arrow(16+dx*9, 16+dy*9, 4, angle, lightText)
+		}})
+	}
+
+	// Controller buttons per family
+	faceColors := map[string]color.RGBA{
+		"xbox_south": {96, 176, 64, 255}, "xbox_east": {214, 64, 56, 255},
+		"xbox_west": {48, 120, 220, 255}, "xbox_north": {236, 180, 40, 255},
+	}
+	for _, style := range []input.GamepadStyle{input.GamepadXbox, input.GamepadPlayStation, input.GamepadNintendo} {
+		for _, key := range []input.KeyCode{
+			input.KeyPadA, input.KeyPadB, input.KeyPadX, input.KeyPadY,
+			input.KeyPadBack, input.KeyPadGuide, input.KeyPadStart,
+			input.KeyPadLeftStick, input.KeyPadRightStick,
+			input.KeyPadLeftShoulder, input.KeyPadRightShoulder,
+		} {
+			glyph := GlyphFor(key, style)
+			caption := strings.ToUpper(glyph.Label)
+			var draw func(c *canvas)
+			switch {
+			case key <= input.KeyPadY && style == input.GamepadPlayStation:
+				shape := glyph.Label
+				draw = func(c *canvas) {
+					c.circle(16, 16, 14, darkFace)
+					c.psShape(shape)
+				}
+			case key <= input.KeyPadY:
+				face, ok := faceColors[glyph.Icon]
+				if !ok {
+					face = darkFace
+				}
+				draw = func(c *canvas) {
+					c.circle(16, 16, 14, face)
+					c.text(caption, 16, 16, 16, lightText)
+				}
+			case key == input.KeyPadLeftStick || key == input.KeyPadRightStick:
+				draw = func(c *canvas) {
+					c.circle(16, 16, 14, keyEdge)
+					c.circle(16, 16, 11, darkFace)
+					c.text(caption, 16, 16, 18, lightText)
+				}
+			default:
+				draw = func(c *canvas) {
+					c.roundRect(1, 8, 30, 16, 6, darkFace)
+					c.text(caption, 16, 16, 16, lightText) // Scale 2 at most so text stays inside the pill
+				}
+			}
+			icons = append(icons, icon{name: glyph.Icon, draw: draw})
+		}
+	}
+
+	return icons
+}
+
+// canvas draws into one atlas cell.
+type canvas struct {
+	img  *image.RGBA
+	x, y int
+}
+
+// fill sets every pixel in the cell for which inside returns true.
+func (c *canvas) fill(col color.RGBA, inside func(px, py float64) bool) {
+	for py := 0; py < IconSize; py++ {
+		for px := 0; px < IconSize; px++ {
+			if inside(float64(px)+0.5, float64(py)+0.5) {
+				c.img.SetRGBA(c.x+px, c.y+py, col)
+			}
+		}
+	}
+}
+
+func (c *canvas) roundRect(x, y, w, h, r float64, col color.RGBA) {
+	c.fill(col, func(px, py float64) bool {
+		if px < x || px > x+w || py < y || py > y+h {
+			return false
+		}
+		cx := math.Max(x+r, math.Min(px, x+w-r))
+		cy := math.Max(y+r, math.Min(py, y+h-r))
+		return math.Hypot(px-cx, py-cy) <= r
+	})
+}
+
+func (c *canvas) circle(cx, cy, r float64, col color.RGBA) {
+	c.fill(col, func(px, py float64) bool {
+		return math.Hypot(px-cx, py-cy) <= r
+	})
+}
+
+// stroke draws line segments ([x1, y1, x2, y2] each) with a given width.
+func (c *canvas) stroke(width float64, col color.RGBA, segments ...[4]float64) {
+	c.fill(col, func(px, py float64) bool {
+		for _, s := range segments {
+			if segmentDistance(px, py, s) <= width/2 {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// arrow draws a filled triangle of a given radius pointing along angle (degrees).
+func (c *canvas) arrow(cx, cy, r, angle float64, col color.RGBA) {
+	var points [3][2]float64
+	for i := range points {
+		a := (angle + float64(i)*120) * math.Pi / 180
+		points[i] = [2]float64{cx + math.Cos(a)*r, cy + math.Sin(a)*r}
+	}
+	c.fill(col, func(px, py float64) bool {
+		sign := 0.0
+		for i := range points {
+			a, b := points[i], points[(i+1)%3]
+			cross := (b[0]-a[0])*(py-a[1]) - (b[1]-a[1])*(px-a[0])
+			if cross != 0 {
+				if sign != 0 && (cross > 0) != (sign > 0) {
+					return false
+				}
+				sign = cross
+			}
+		}
+		return true
+	})
+}
+
+// psShape draws a PlayStation face symbol.
+func (c *canvas) psShape(shape string) {
+	switch shape {
+	case "Cross":
+		c.stroke(3, color.RGBA{120, 160, 240, 255}, [4]float64{10, 10, 22, 22}, [4]float64{22, 10, 10, 22})
+	case "Circle":
+		c.fill(color.RGBA{240, 90, 90, 255}, func(px, py float64) bool {
+			d := math.Hypot(px-16, py-16)
+			return d <= 8 && d >= 5.5
+		})
+	case "Square":
+		c.stroke(2.5, color.RGBA{230, 130, 200, 255},
+			[4]float64{10, 10, 22, 10}, [4]float64{22, 10, 22, 22}, [4]float64{22, 22, 10, 22}, [4]float64{10, 22, 10, 10})
+	case "Triangle":
+		c.stroke(2.5, color.RGBA{70, 200, 160, 255},
+			[4]float64{16, 8, 24, 22}, [4]float64{24, 22, 8, 22}, [4]float64{8, 22, 16, 8})
+	}
+}
+
+// text draws a caption centred on (cx, cy), scaled to fit maxWidth.
+func (c *canvas) text(caption string, cx, cy, maxWidth float64, col color.RGBA) {
+	width := float64(len(caption)*4 - 1)
+	scale := math.Max(1, math.Min(3, math.Floor(maxWidth/width)))
+	left := int(math.Round(cx - width*scale/2))
+	top := int(math.Round(cy - 5*scale/2))
+	for i, ch := range caption {
+		rows, ok := tinyFont[ch]
+		if !ok {
+			continue
+		}
+		for row, bits := range rows {
+			for column, bit := range bits {
+				if bit != '#' {
+					continue
+				}
+				for sy := 0; sy < int(scale); sy++ {
+					for sx := 0; sx < int(scale); sx++ {
+						px := left + (i*4+column)*int(scale) + sx
+						py := top + row*int(scale) + sy
+						if px >= 0 && px < IconSize && py >= 0 && py < IconSize {
+							c.img.SetRGBA(c.x+px, c.y+py, col)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// segmentDistance returns the distance from a point to a line segment.
+func segmentDistance(px, py float64, s [4]float64) float64 {
+	dx, dy := s[2]-s[0], s[3]-s[1]
+	t := 0.0
+	if lengthSq := dx*dx + dy*dy; lengthSq > 0 {
+		t = math.Max(0, math.Min(1, ((px-s[0])*dx+(py-s[1])*dy)/lengthSq))
+	}
+	return math.Hypot(px-(s[0]+t*dx), py-(s[1]+t*dy))
+}
+
+// tinyFont is a 3x5 pixel font for icon captions.
+var tinyFont = map[rune][5]string{
+	'A': {".#.", "#.#", "###", "#.#", "#.#"},
+	'B': {"##.", "#.#", "##.", "#.#", "##."},
+	'C': {".##", "#..", "#..", "#..", ".##"},
+	'D': {"##.", "#.#", "#.#", "#.#", "##."},
+	'E': {"###", "#..", "##.", "#..", "###"},
+	'F': {"###", "#..", "##.", "#..", "#.."},
+	'G': {".##", "#..", "#.#", "#.#", ".##"},
+	'H': {"#.#", "#.#", "###", "#.#", "#.#"},
+	'I': {"###", ".#.", ".#.", ".#.", "###"},
+	'J': {"..#", "..#", "..#", "#.#", ".#."},
+	'K': {"#.#", "#.#", "##.", "#.#", "#.#"},
+	'L': {"#..", "#..", "#..", "#..", "###"},
+	'M': {"#.#", "###", "###", "#.#", "#.#"},
+	'N': {"##.", "#.#", "#.#", "#.#", "#.#"},
+	'O': {".#.", "#.#", "#.#", "#.#", ".#."},
+	'P': {"##.", "#.#", "##.", "#..", "#.."},
+	'Q': {".#.", "#.#", "#.#", "##.", ".##"},
+	'R': {"##.", "#.#", "##.", "#.#", "#.#"},
+	'S': {".##", "#..", ".#.", "..#", "##."},
+	'T': {"###", ".#.", ".#.", ".#.", ".#."},
+	'U': {"#.#", "#.#", "#.#", "#.#", "###"},
+	'V': {"#.#", "#.#", "#.#", "#.#", ".#."},
+	'W': {"#.#", "#.#", "###", "###", "#.#"},
+	'X': {"#.#", "#.#", ".#.", "#.#", "#.#"},
+	'Y': {"#.#", "#.#", ".#.", ".#.", ".#."},
+	'Z': {"###", "..#", ".#.", "#..", "###"},
+	'0': {"###", "#.#", "#.#", "#.#", "###"},
+	'1': {".#.", "##.", ".#.", ".#.", "###"},
+	'2': {"##.", "..#", ".#.", "#..", "###"},
+	'3': {"##.", "..#", ".#.", "..#", "##."},
+	'4': {"#.#", "#.#", "###", "..#", "..#"},
+	'5': {"###", "#..", "##.", "..#", "##."},
+	'6': {".##", "#..", "###", "#.#", "###"},
+	'7': {"###", "..#", ".#.", ".#.", ".#."},
+	'8': {"###", "#.#", "###", "#.#", "###"},
+	'9': {"###", "#.#", "###", "..#", "##."},
+	'+': {"...", ".#.", "###", ".#.", "..."},
+	'-': {"...", "...", "###", "...", "..."},
+}
@@ -0,0 +1,246 @@
+// Package glyphs maps bound input actions to button prompts for the player's current device.
+package glyphs
+
+import (
+	"strings"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Glyph describes how to show one input binding.
+type Glyph struct {
+	Key   input.KeyCode // Bound key or button
+	Label string        // Display text (e.g. "Space", "A", "Cross")
+	Icon  string        // Atlas region name ("" if the atlas has no icon)
+}
+
+// Service resolves action prompts against the most recently used device.
+//
+// An action bound to both a key and a gamepad button shows the key while the
+// player uses keyboard and mouse, and the button (labelled for the
+// controller family) as soon as they press anything on a gamepad.
+type Service struct {
+	Input *input.InputManager
+	Atlas *Atlas // Optional icons (see LoadAtlas)
+
+	names map[string]input.Action // Placeholder names used by Expand
+}
+
+// NewService creates a glyph service with placeholder names for the built-in actions.
+//
+// Example:
+//
+//	prompts := glyphs.NewService(engine.Input())
+//	hint := prompts.Expand("Press {jump} to climb") // "Press [Space] to climb" or "Press [A] to climb"
+func NewService(im *input.InputManager) *Service {
+	return &Service{
+		Input: im,
+		names: map[string]input.Action{
+			"up":       input.ActionMoveUp,
+			"down":     input.ActionMoveDown,
+			"left":     input.ActionMoveLeft,
+			"right":    input.ActionMoveRight,
+			"jump":     input.ActionJump,
+			"attack":   input.ActionAttack,
+			"interact": input.ActionInteract,
+			"pause":    input.ActionPause,
+			"confirm":  input.ActionConfirm,
+			"cancel":   input.ActionCancel,
+			"menu":     input.ActionMenu,
+		},
+	}
+}
+
+// Name registers a placeholder name for an action (for custom actions).
+func (s *Service) Name(name string, action input.Action) {
+	s.names[strings.ToLower(name)] = action
+}
+
+// Glyph returns the binding to show for an action.
+//
+// Returns:
+//
+//	Glyph: First binding matching the last used device, else the first binding
+//	bool: False if the action has no bindings
+func (s *Service) Glyph(action input.Action) (Glyph, bool) {
+	keys := s.Input.Bindings(action)
+	if len(keys) == 0 {
+		return Glyph{}, false
+	}
+	gamepad := s.Input.LastDevice() == input.DeviceGamepad
+	key := keys[0]
+	for _, candidate := range keys {
+		if candidate.IsGamepad() == gamepad {
+			key = candidate
+			break
+		}
+	}
+	return GlyphFor(key, s.Input.GamepadStyle()), true
+}
+
+// Label returns a bracketed prompt for an action, e.g. "[Space]" or "[A]".
+//
+// Unbound actions return "[?]".
+func (s *Service) Label(action input.Action) string {
+	glyph, ok := s.Glyph(action)
+	if !ok {
+		return "[?]"
+	}
+	return "[" + glyph.Label + "]"
+}
+
+// Expand replaces {name} placeholders with action labels.
+//
+// Unknown names are left unchanged so typos are visible in game.
+//
+// Example:
+//
+//	prompts.Expand("{interact} Open   {cancel} Back") // "[E] Open   [Esc] Back"
+func (s *Service) Expand(text string) string {
+	var out strings.Builder
+	for {
+		start := strings.IndexByte(text, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		out.WriteString(text[:start])
+		if action, ok := s.names[strings.ToLower(text[start+1:end])]; ok {
+			out.WriteString(s.Label(action))
+		} else {
+			out.WriteString(text[start : end+1])
+		}
+		text = text[end+1:]
+	}
+	out.WriteString(text)
+	return out.String()
+}
+
+// Sprite returns an icon sprite for an action (nil without an atlas or icon).
+//
+// Example:
+//
+//	if icon := prompts.Sprite(input.ActionJump); icon != nil {
+//	    icon.Alpha = promptFade
+//	    _ = renderer.DrawSprite(icon, promptTransform, hudCamera)
+//	}
+func (s *Service) Sprite(action input.Action) *graphics.Sprite {
+	glyph, ok := s.Glyph(action)
+	if !ok || s.Atlas == nil {
+		return nil
+	}
+	return s.Atlas.Sprite(glyph.Icon)
+}
+
+// keyLabels are display names for keyboard and mouse keys.
+var keyLabels = map[input.KeyCode]string{
+	input.KeyArrowUp:     "Up",
+	input.KeyArrowDown:   "Down",
+	input.KeyArrowLeft:   "Left",
+	input.KeyArrowRight:  "Right",
+	input.KeySpace:       "Space",
+	input.KeyEnter:       "Enter",
+	input.KeyEscape:      "Esc",
+	input.KeyTab:         "Tab",
+	input.KeyShift:       "Shift",
+	input.KeyCtrl:        "Ctrl",
+	input.KeyAlt:         "Alt",
+	input.KeyMouseLeft:   "LMB",
+	input.KeyMouseRight:  "RMB",
+	input.KeyMouseMiddle: "MMB",
+}
+
+// padLabels are gamepad button names per controller family.
+var padLabels = map[input.GamepadStyle]map[input.KeyCode]string{
+	input.GamepadXbox: {
+		input.KeyPadA: "A", input.KeyPadB: "B", input.KeyPadX: "X", input.KeyPadY: "Y",
+		input.KeyPadBack: "View", input.KeyPadGuide: "Xbox", input.KeyPadStart: "Menu",
+		input.KeyPadLeftStick: "LS", input.KeyPadRightStick: "RS",
+		input.KeyPadLeftShoulder: "LB", input.KeyPadRightShoulder: "RB",
+	},
+	input.GamepadPlayStation: {
+		input.KeyPadA: "Cross", input.KeyPadB: "Circle", input.KeyPadX: "Square", input.KeyPadY: "Triangle",
+		input.KeyPadBack: "Share", input.KeyPadGuide: "PS", input.KeyPadStart: "Options",
+		input.KeyPadLeftStick: "L3", input.KeyPadRightStick: "R3",
+		input.KeyPadLeftShoulder: "L1", input.KeyPadRightShoulder: "R1",
+	},
+	input.GamepadNintendo: {
+		input.KeyPadA: "B", input.KeyPadB: "A", input.KeyPadX: "Y", input.KeyPadY: "X",
+		input.KeyPadBack: "-", input.KeyPadGuide: "Home", input.KeyPadStart: "+",
+		input.KeyPadLeftStick: "LS", input.KeyPadRightStick: "RS",
+		input.KeyPadLeftShoulder: "L", input.KeyPadRightShoulder: "R",
+	},
+}
+
+// dpadLabels are shared by every controller family.
+var dpadLabels = map[input.KeyCode]string{
+	input.KeyPadDPadUp:    "D-Pad Up",
+	input.KeyPadDPadDown:  "D-Pad Down",
+	input.KeyPadDPadLeft:  "D-Pad Left",
+	input.KeyPadDPadRight: "D-Pad Right",
+}
+
+// stylePrefixes name each family's atlas icons.
+var stylePrefixes = map[input.GamepadStyle]string{
+	input.GamepadXbox:        "xbox",
+	input.GamepadPlayStation: "ps",
+	input.GamepadNintendo:    "switch",
+}
+
+// GlyphFor returns the glyph for a single key with a given controller labelling.
+func GlyphFor(key input.KeyCode, style input.GamepadStyle) Glyph {
+	if label, ok := dpadLabels[key]; ok {
+		return Glyph{Key: key, Label: label, Icon: iconName("pad", label)}
+	}
+	if key.IsGamepad() {
+		labels, ok := padLabels[style]
+		if !ok {
+			style, labels = input.GamepadXbox, padLabels[input.GamepadXbox]
+		}
+		label := labels[key]
+		return Glyph{Key: key, Label: label, Icon: iconName(stylePrefixes[style], padIconIDs[key])}
+	}
+	if label, ok := keyLabels[key]; ok {
+		prefix := "key"
+		if key >= input.KeyMouseLeft && key <= input.KeyMouseMiddle {
+			prefix = "mouse"
+		}
+		return Glyph{Key: key, Label: label, Icon: iconName(prefix, label)}
+	}
+	switch {
+	case key >= input.KeyA && key <= input.KeyZ:
+		label := string(rune('A' + key - input.KeyA))
+		return Glyph{Key: key, Label: label, Icon: iconName("key", label)}
+	case key == input.Key0:
+		return Glyph{Key: key, Label: "0", Icon: "key_0"}
+	case key >= input.Key1 && key <= input.Key9:
+		label := string(rune('1' + key - input.Key1))
+		return Glyph{Key: key, Label: label, Icon: iconName("key", label)}
+	}
+	// Keys without a built-in icon fall back to SDL's name
+	label := sdl.GetScancodeName(sdl.Scancode(key))
+	if label == "" {
+		label = "?"
+	}
+	return Glyph{Key: key, Label: label}
+}
+
+// padIconIDs name gamepad icons by button position, so each family's
+// atlas entries line up with the physical button.
+var padIconIDs = map[input.KeyCode]string{
+	input.KeyPadA: "south", input.KeyPadB: "east", input.KeyPadX: "west", input.KeyPadY: "north",
+	input.KeyPadBack: "back", input.KeyPadGuide: "guide", input.KeyPadStart: "start",
+	input.KeyPadLeftStick: "ls", input.KeyPadRightStick: "rs",
+	input.KeyPadLeftShoulder: "lb", input.KeyPadRightShoulder: "rb",
+}
+
+// iconName builds an atlas region name such as "key_space" or "pad_d-pad_up".
+func iconName(prefix, id string) string {
+	return prefix + "_" + strings.ReplaceAll(strings.ToLower(id), " ", "_")
+}
@@ -0,0 +1,33 @@
+package gogametest
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a clock that only moves when Advance is called.
+//
+// Install it with core.Time.SetClock to make frame timing deterministic.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a clock starting at a fixed instant.
+func NewFakeClock() *FakeClock {
+	return &FakeClock{now: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,22 @@
+// Package gogametest provides fakes for testing games and engine code
+// without a window, audio device, or real-time clock.
+//
+//   - FakeInput drives an InputManager with SetKeyState and SetMousePosition
+//   - FakeRenderer records draw calls for assertions instead of drawing
+//   - FakeClock makes core.Time advance only when told to
+//
+// Example:
+//
+//	in := gogametest.NewFakeInput()
+//	in.BindAction(input.ActionJump, input.KeySpace)
+//	in.SetKeyState(input.KeySpace, true)
+//	if !in.ActionPressed(input.ActionJump) {
+//	    t.Error("Expected jump")
+//	}
+//
+//	renderer := gogametest.NewFakeRenderer()
+//	_ = scene.Render(renderer.Renderer)
+//	if len(renderer.CallsOf(graphics.DrawKindSprite)) != 3 {
+//	    t.Error("Expected 3 sprites")
+//	}
+package gogametest
@@ -0,0 +1,45 @@
+package gogametest
+
+import (
+	"github.com/dshills/gogame/engine/input"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// FakeInput is an InputManager whose state is set directly by tests.
+//
+// It embeds the real manager, so action bindings and queries behave
+// exactly as they do in a running game; state is set through the
+// manager's InjectKey rather than SDL events.
+type FakeInput struct {
+	*input.InputManager
+}
+
+// NewFakeInput creates a fake input with no bindings and nothing pressed.
+func NewFakeInput() *FakeInput {
+	return &FakeInput{InputManager: input.NewInputManager()}
+}
+
+// SetKeyState presses or releases a key or mouse button.
+//
+// Parameters:
+//
+//	key: Keyboard key or KeyMouseLeft/Right/Middle
+//	down: True to press, false to release
+//
+// Behavior:
+//   - The change is visible immediately: a key set down this frame is
+//     pressed and held; call NextFrame to make it held only
+func (f *FakeInput) SetKeyState(key input.KeyCode, down bool) {
+	f.InjectKey(key, down)
+}
+
+// SetMousePosition moves the mouse to screen coordinates.
+func (f *FakeInput) SetMousePosition(x, y int32) {
+	f.ProcessMouseMotionEvent(&sdl.MouseMotionEvent{X: x, Y: y})
+}
+
+// NextFrame ends the frame, as the engine does after update and render,
+// so pressed keys become held and mouse delta resets.
+func (f *FakeInput) NextFrame() {
+	f.Update()
+}
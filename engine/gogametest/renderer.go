@@ -0,0 +1,43 @@
+package gogametest
+
+import "github.com/dshills/gogame/engine/graphics"
+
+// FakeRenderer records draw calls made through its Renderer.
+//
+// Pass Renderer anywhere a *graphics.Renderer is expected (Scene.Render,
+// debug overlays, UI). Sprites still need a Texture to be drawn, but it
+// can be a zero-value one: graphics.NewTexture(nil, 32, 32, "").
+type FakeRenderer struct {
+	*graphics.Renderer
+	calls []graphics.DrawCall
+}
+
+// NewFakeRenderer creates a fake renderer with no recorded calls.
+func NewFakeRenderer() *FakeRenderer {
+	f := &FakeRenderer{}
+	f.Renderer = graphics.NewRecordingRenderer(func(call graphics.DrawCall) {
+		f.calls = append(f.calls, call)
+	})
+	return f
+}
+
+// Calls returns every recorded call in draw order.
+func (f *FakeRenderer) Calls() []graphics.DrawCall {
+	return f.calls
+}
+
+// CallsOf returns recorded calls of one kind in draw order.
+func (f *FakeRenderer) CallsOf(kind graphics.DrawKind) []graphics.DrawCall {
+	matching := make([]graphics.DrawCall, 0)
+	for _, call := range f.calls {
+		if call.Kind == kind {
+			matching = append(matching, call)
+		}
+	}
+	return matching
+}
+
+// Reset discards recorded calls, e.g. between frames.
+func (f *FakeRenderer) Reset() {
+	f.calls = f.calls[:0]
+}
@@ -6,7 +6,11 @@ import (
 	"image"
 	_ "image/jpeg" // Register JPEG decoder
 	_ "image/png"  // Register PNG decoder
+	"io"
+	"io/fs"
 	"os"
+	"path"
+	"strings"
 
 	"github.com/veandco/go-sdl2/sdl"
 )
@@ -16,18 +20,53 @@ type AssetManager struct {
 	renderer *sdl.Renderer
 	textures map[string]*Texture // Cache of loaded textures
 	refCount map[string]int      // Reference counting
+	fsys     fs.FS               // Mounted virtual filesystem (nil = load from disk)
+	watch    *fileWatch          // Hot reload state (nil = not watching)
+
+	// OnReload is called after WatchFiles reloads a changed texture, with
+	// the load error if the new file couldn't be used (the old image is
+	// kept) (optional).
+	OnReload func(texture *Texture, err error)
+
+	pending     map[string]*pendingLoad // Background decodes by path
+	loadQueue   []*pendingLoad          // Background decodes in request order
+	decodeSlots chan struct{}           // Limits concurrent decodes
+	surfaces    surfacePool             // Upload surfaces reused between loads
 }
 
 // NewAssetManager creates a new asset manager.
+//
+// With a nil renderer (headless servers and tests) textures still decode and
+// report their sizes, but have no GPU data and draw nothing.
 func NewAssetManager(renderer *sdl.Renderer) *AssetManager {
 	return &AssetManager{
-		renderer: renderer,
-		textures: make(map[string]*Texture),
-		refCount: make(map[string]int),
+		renderer:    renderer,
+		textures:    make(map[string]*Texture),
+		refCount:    make(map[string]int),
+		pending:     make(map[string]*pendingLoad),
+		decodeSlots: make(chan struct{}, decodeSlotCount()),
 	}
 }
 
-// LoadTexture loads a texture from disk or returns cached
+// Mount loads assets from a virtual filesystem (e.g. a pak.Archive or embed.FS)
+// instead of the disk. Pass nil to load from disk again.
+//
+// Paths given to LoadTexture are normalized to fs form, so "assets/player.png"
+// and "./assets/player.png" name the same entry. Already cached textures are
+// unaffected.
+//
+// Example:
+//
+//	archive, err := pak.Open("game.pak")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	assets.Mount(archive)
+func (am *AssetManager) Mount(fsys fs.FS) {
+	am.fsys = fsys
+}
+
+// LoadTexture loads a texture from disk (or the mounted filesystem) or returns cached
 //
 // Parameters:
 //
@@ -51,83 +90,92 @@ func NewAssetManager(renderer *sdl.Renderer) *AssetManager {
 //	}
 func (am *AssetManager) LoadTexture(path string) (*Texture, error) {
 	// Check if already loaded
-	if texture, exists := am.textures[path]; exists {
+	if texture, exists := am.cached(path); exists {
 		am.refCount[path]++
 		return texture, nil
 	}
 
-	// Load image file
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load texture: file not found: %s: %w", path, err)
-	}
-	defer func() { _ = file.Close() }() // Best effort cleanup for read-only file
-
-	// Decode image
-	img, format, err := image.Decode(file)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode image: %s: %w", path, err)
-	}
-
-	// Get image dimensions
-	bounds := img.Bounds()
-	width := bounds.Dx()
-	height := bounds.Dy()
-
-	// Create SDL surface from image data
-	surface, err := sdl.CreateRGBSurface(
-		0,
-		int32(width),
-		int32(height),
-		32,
-		0x000000ff,
-		0x0000ff00,
-		0x00ff0000,
-		0xff000000,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create surface: %w", err)
-	}
-	defer surface.Free()
-
-	// Copy image data to surface
-	pixels := surface.Pixels()
-	pixelIndex := 0
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
-			r, g, b, a := img.At(x, y).RGBA()
-			// Convert from 16-bit to 8-bit
-			pixels[pixelIndex] = uint8(r >> 8)   // R
-			pixels[pixelIndex+1] = uint8(g >> 8) // G
-			pixels[pixelIndex+2] = uint8(b >> 8) // B
-			pixels[pixelIndex+3] = uint8(a >> 8) // A
-			pixelIndex += 4
+	// Finish a background load of the same file instead of decoding twice
+	if load, exists := am.pending[path]; exists {
+		<-load.done
+		am.finish(load)
+		if texture, exists := am.textures[path]; exists {
+			am.refCount[path]++
+			return texture, nil
 		}
+		return nil, load.requests[0].err
 	}
 
-	// Create SDL texture from surface
-	sdlTexture, err := am.renderer.CreateTextureFromSurface(surface)
+	// Decode and convert pixels, then upload
+	img, err := decodeTexture(am.fsys, path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create texture: %w", err)
+		return nil, err
 	}
-
-	// Set blend mode for alpha transparency
-	if err := sdlTexture.SetBlendMode(sdl.BLENDMODE_BLEND); err != nil {
-		_ = sdlTexture.Destroy() // Best effort cleanup
-		return nil, fmt.Errorf("failed to set blend mode: %w", err)
+	texture, err := am.upload(path, img)
+	if err != nil {
+		return nil, err
 	}
 
-	// Wrap in our Texture type
-	texture := NewTexture(sdlTexture, width, height, path)
-
 	// Cache texture
 	am.textures[path] = texture
 	am.refCount[path] = 1
+	return texture, nil
+}
 
-	_ = format // Suppress unused variable warning
+// GenerateTexture creates a texture from a generated image, cached and
+// reference counted under a name like a loaded file
+//
+// Parameters:
+//
+//	name: Cache key (any string not used by a file path, e.g. "gen:wall")
+//	generate: Builds the image (e.g. with SolidImage, GradientImage,
+//	          CheckerImage, NoiseImage, or GenerateImage); only called when
+//	          the name isn't cached
+//
+// Returns:
+//
+//	*Texture: Generated or cached texture
+//	error: Non-nil if upload fails
+//
+// Behavior:
+//   - Each call holds one reference; release it with UnloadTexture(name)
+//
+// Example:
+//
+//	floor, err := assets.GenerateTexture("gen:floor", func() image.Image {
+//	    return graphics.CheckerImage(64, 64, 16, gamemath.Color{R: 60, G: 60, B: 70, A: 255}, gamemath.Color{R: 80, G: 80, B: 90, A: 255})
+//	})
+func (am *AssetManager) GenerateTexture(name string, generate func() image.Image) (*Texture, error) {
+	if texture, exists := am.cached(name); exists {
+		am.refCount[name]++
+		return texture, nil
+	}
+
+	img := generate()
+	if img == nil || img.Bounds().Empty() {
+		return nil, fmt.Errorf("failed to generate texture: %s: empty image", name)
+	}
+	texture, err := am.upload(name, convertImage(img))
+	if err != nil {
+		return nil, err
+	}
+	am.textures[name] = texture
+	am.refCount[name] = 1
 	return texture, nil
 }
 
+// cached returns a cached texture, dropping it if it was destroyed
+// directly (so it is loaded again instead of handed out freed).
+func (am *AssetManager) cached(path string) (*Texture, bool) {
+	texture, exists := am.textures[path]
+	if exists && texture.Destroyed() {
+		delete(am.textures, path)
+		delete(am.refCount, path)
+		return nil, false
+	}
+	return texture, exists
+}
+
 // UnloadTexture decrements reference count
 //
 // Parameters:
@@ -161,10 +209,37 @@ func (am *AssetManager) UnloadTexture(path string) {
 }
 
 // Destroy unloads all textures.
+//
+// Background loads still running are abandoned; their requests never
+// complete.
 func (am *AssetManager) Destroy() {
 	for path, texture := range am.textures {
-		_ = texture.Destroy() // Best effort cleanup
+		_ = texture.destroy(false) // Best effort cleanup; sprites go with the cache
 		delete(am.textures, path)
 		delete(am.refCount, path)
 	}
+	clear(am.pending)
+	am.loadQueue = nil
+	am.surfaces.destroy()
+}
+
+// openAsset opens an asset from a mounted filesystem, or disk if nil.
+func openAsset(fsys fs.FS, name string) (io.ReadCloser, error) {
+	if fsys == nil {
+		return os.Open(name)
+	}
+	return fsys.Open(fsName(name))
+}
+
+// statAsset describes an asset in a mounted filesystem, or disk if nil.
+func statAsset(fsys fs.FS, name string) (fs.FileInfo, error) {
+	if fsys == nil {
+		return os.Stat(name)
+	}
+	return fs.Stat(fsys, fsName(name))
+}
+
+// fsName normalizes a path to fs form ("./assets\\a.png" -> "assets/a.png").
+func fsName(name string) string {
+	return strings.TrimPrefix(path.Clean(strings.ReplaceAll(name, "\\", "/")), "/")
 }
@@ -0,0 +1,253 @@
+package graphics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ErrInvalidAtlas is returned when atlas data can't be parsed.
+var ErrInvalidAtlas = errors.New("invalid texture atlas")
+
+// AtlasRegion is a named image packed into an atlas texture.
+type AtlasRegion struct {
+	Rect  gamemath.Rectangle // Region of the atlas texture
+	Pivot gamemath.Vector2   // Sprite pivot placing the region where it sat before trimming
+}
+
+// Atlas is a texture packed with many named images (sprite sheets from
+// TexturePacker or similar tools).
+//
+// Sprites made from one atlas share its texture, so a batched layer (see
+// SceneLayer.Batched) draws them all with one call.
+type Atlas struct {
+	Texture *Texture               // Packed texture (nil until loaded)
+	Image   string                 // Image file named by the atlas data
+	Regions map[string]AtlasRegion // Region by name (image extensions removed)
+}
+
+// atlasFrame is a frame in TexturePacker's JSON formats.
+type atlasFrame struct {
+	Filename         string    `json:"filename"` // Array format only
+	Frame            atlasRect `json:"frame"`
+	Rotated          bool      `json:"rotated"`
+	Trimmed          bool      `json:"trimmed"`
+	SpriteSourceSize atlasRect `json:"spriteSourceSize"`
+	SourceSize       struct {
+		W float64 `json:"w"`
+		H float64 `json:"h"`
+	} `json:"sourceSize"`
+	Pivot *struct {
+		X float64 `json:"x"`
+		Y float64 `json:"y"`
+	} `json:"pivot"`
+}
+
+// atlasRect is a rectangle in TexturePacker's JSON formats.
+type atlasRect struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	W float64 `json:"w"`
+	H float64 `json:"h"`
+}
+
+// atlasFile is an atlas in either TexturePacker format or the built-in one.
+type atlasFile struct {
+	Frames json.RawMessage `json:"frames"` // TexturePacker: hash or array of frames
+	Meta   struct {
+		Image string `json:"image"`
+	} `json:"meta"`
+
+	Image   string                `json:"image"`   // Built-in format
+	Regions map[string][4]float64 `json:"regions"` // Built-in: name -> [x, y, w, h]
+}
+
+// ParseAtlas parses atlas data without loading its texture
+//
+// Parameters:
+//
+//	data: JSON in TexturePacker's "JSON (Hash)" or "JSON (Array)" format,
+//	      or the built-in format:
+//	      {"image": "sheet.png", "regions": {"player_idle_0": [0, 0, 32, 32]}}
+//
+// Returns:
+//
+//	*Atlas: Atlas with Image and Regions set (Texture is nil)
+//	error: ErrInvalidAtlas if the data is malformed or uses rotated frames
+//
+// Behavior:
+//   - Image extensions are removed from names ("player_idle_0.png"
+//     becomes "player_idle_0")
+//   - Trimmed frames get a Pivot so they draw where the untrimmed image
+//     would; TexturePacker pivots are honored the same way
+func ParseAtlas(data []byte) (*Atlas, error) {
+	var file atlasFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAtlas, err)
+	}
+
+	atlas := &Atlas{Image: file.Image, Regions: make(map[string]AtlasRegion)}
+	if file.Frames == nil {
+		for name, r := range file.Regions {
+			atlas.Regions[atlasName(name)] = AtlasRegion{Rect: gamemath.Rectangle{X: r[0], Y: r[1], Width: r[2], Height: r[3]}}
+		}
+	} else {
+		atlas.Image = file.Meta.Image
+		frames, err := atlasFrames(file.Frames)
+		if err != nil {
+			return nil, err
+		}
+		for name, frame := range frames {
+			if frame.Rotated {
+				return nil, fmt.Errorf("%w: %s: rotated frames are not supported", ErrInvalidAtlas, name)
+			}
+			atlas.Regions[atlasName(name)] = frame.region()
+		}
+	}
+	if atlas.Image == "" {
+		return nil, fmt.Errorf("%w: no image", ErrInvalidAtlas)
+	}
+	return atlas, nil
+}
+
+// atlasFrames decodes TexturePacker frames in hash or array form.
+func atlasFrames(raw json.RawMessage) (map[string]atlasFrame, error) {
+	var hash map[string]atlasFrame
+	if err := json.Unmarshal(raw, &hash); err == nil {
+		return hash, nil
+	}
+	var list []atlasFrame
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, fmt.Errorf("%w: frames: %w", ErrInvalidAtlas, err)
+	}
+	frames := make(map[string]atlasFrame, len(list))
+	for _, frame := range list {
+		frames[frame.Filename] = frame
+	}
+	return frames, nil
+}
+
+// region converts a frame, computing the pivot that undoes trimming.
+func (f atlasFrame) region() AtlasRegion {
+	region := AtlasRegion{Rect: gamemath.Rectangle{X: f.Frame.X, Y: f.Frame.Y, Width: f.Frame.W, Height: f.Frame.H}}
+	if !f.Trimmed && f.Pivot == nil {
+		return region
+	}
+
+	// Pivot point in untrimmed source pixels, relative to the trimmed center
+	sourceW, sourceH := f.SourceSize.W, f.SourceSize.H
+	offset := f.SpriteSourceSize
+	if !f.Trimmed || sourceW == 0 || sourceH == 0 {
+		sourceW, sourceH = f.Frame.W, f.Frame.H
+		offset = atlasRect{W: f.Frame.W, H: f.Frame.H}
+	}
+	pivotX, pivotY := 0.5, 0.5
+	if f.Pivot != nil {
+		pivotX, pivotY = f.Pivot.X, f.Pivot.Y
+	}
+	region.Pivot = gamemath.Vector2{
+		X: pivotX*sourceW - (offset.X + offset.W/2),
+		Y: pivotY*sourceH - (offset.Y + offset.H/2),
+	}
+	return region
+}
+
+// atlasName removes an image extension from a frame name.
+func atlasName(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".png", ".jpg", ".jpeg", ".bmp", ".gif", ".tga", ".webp":
+		return strings.TrimSuffix(name, path.Ext(name))
+	}
+	return name
+}
+
+// Region returns a named region.
+func (a *Atlas) Region(name string) (AtlasRegion, bool) {
+	region, ok := a.Regions[name]
+	return region, ok
+}
+
+// Names returns every region name, sorted.
+func (a *Atlas) Names() []string {
+	names := make([]string, 0, len(a.Regions))
+	for name := range a.Regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewSpriteFromAtlas creates a sprite showing one region of an atlas
+//
+// Parameters:
+//
+//	atlas: Loaded atlas (see AssetManager.LoadAtlas)
+//	name: Region name
+//
+// Returns:
+//
+//	*Sprite: Sprite with SourceRect and Pivot set from the region, or nil
+//	         if the atlas has no such region or no texture
+//
+// Example:
+//
+//	sheet, err := assets.LoadAtlas("assets/characters.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	player.Sprite = graphics.NewSpriteFromAtlas(sheet, "player_idle_0")
+func NewSpriteFromAtlas(atlas *Atlas, name string) *Sprite {
+	region, ok := atlas.Regions[name]
+	if !ok || atlas.Texture == nil {
+		return nil
+	}
+	sprite := NewSprite(atlas.Texture)
+	sprite.SourceRect = region.Rect
+	sprite.Pivot = region.Pivot
+	return sprite
+}
+
+// LoadAtlas loads atlas data and its texture
+//
+// Parameters:
+//
+//	path: Atlas JSON file (see ParseAtlas for formats); its image is
+//	      resolved relative to the file's directory
+//
+// Returns:
+//
+//	*Atlas: Atlas with its texture loaded
+//	error: Non-nil if the data or image can't be loaded
+//
+// Behavior:
+//   - The texture is loaded with LoadTexture, so it is cached and holds
+//     one reference; release it with UnloadTexture(atlas.Texture.Path)
+//   - Reads from the mounted filesystem, if any
+func (am *AssetManager) LoadAtlas(atlasPath string) (*Atlas, error) {
+	file, err := openAsset(am.fsys, atlasPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load atlas: %s: %w", atlasPath, err)
+	}
+	data, err := io.ReadAll(file)
+	_ = file.Close() // Best effort cleanup for read-only file
+	if err != nil {
+		return nil, fmt.Errorf("failed to read atlas: %s: %w", atlasPath, err)
+	}
+
+	atlas, err := ParseAtlas(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse atlas: %s: %w", atlasPath, err)
+	}
+	texture, err := am.LoadTexture(path.Join(path.Dir(strings.ReplaceAll(atlasPath, "\\", "/")), atlas.Image))
+	if err != nil {
+		return nil, err
+	}
+	atlas.Texture = texture
+	return atlas, nil
+}
@@ -0,0 +1,168 @@
+package graphics
+
+import (
+	"fmt"
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// maxBatchSprites bounds the sprites drawn by one geometry call.
+const maxBatchSprites = 4096
+
+// SpriteBatch draws consecutive sprites that share a texture with a single
+// SDL geometry call instead of one copy per sprite.
+//
+// Sprites appear exactly as DrawSprite draws them (tint, alpha, flips,
+// pivot, rotation) and in the order added; a sprite with a different
+// texture flushes the batch first. Scenes batch layers whose
+// SceneLayer.Batched is set; use a SpriteBatch directly for custom drawing.
+//
+// Batching needs SDL 2.0.18 or later; with older SDL each sprite is drawn
+// with DrawSprite as it is added.
+//
+// Example:
+//
+//	batch := graphics.NewSpriteBatch(renderer)
+//	for _, p := range particles {
+//	    _ = batch.Draw(p.Sprite, p.Transform, camera)
+//	}
+//	_ = batch.Flush()
+type SpriteBatch struct {
+	renderer *Renderer
+	texture  *Texture     // Texture of the pending sprites
+	vertices []sdl.Vertex // Four per pending sprite
+	indices  []int32      // Six per pending sprite
+	count    int          // Pending sprites
+	geometry bool         // SDL supports geometry (false = draw sprites one by one)
+}
+
+// NewSpriteBatch creates a batch drawing through a renderer.
+func NewSpriteBatch(renderer *Renderer) *SpriteBatch {
+	return &SpriteBatch{renderer: renderer, geometry: geometrySupported()}
+}
+
+// geometrySupported reports whether SDL (compiled and linked) has
+// SDL_RenderGeometry.
+func geometrySupported() bool {
+	var linked sdl.Version
+	sdl.GetVersion(&linked)
+	return sdl.VERSION_ATLEAST(2, 0, 18) && sdl.VERSIONNUM(int(linked.Major), int(linked.Minor), int(linked.Patch)) >= sdl.VERSIONNUM(2, 0, 18)
+}
+
+// Geometry reports whether sprites are drawn in batches; false when SDL is
+// older than 2.0.18 and each sprite is drawn on its own.
+func (b *SpriteBatch) Geometry() bool {
+	return b.geometry
+}
+
+// Draw adds a sprite to the batch
+//
+// Parameters:
+//
+//	sprite: Sprite to draw (nil or texture-less sprites are skipped)
+//	transform: World transform, as for Renderer.DrawSprite
+//	camera: Camera converting world to screen positions
+//
+// Returns:
+//
+//	error: Non-nil if flushing the previous texture's sprites fails
+//
+// Behavior:
+//   - Recording renderers record each sprite as a DrawKindSprite call
+func (b *SpriteBatch) Draw(sprite *Sprite, transform gamemath.Transform, camera *Camera) error {
+	if sprite == nil || sprite.Texture == nil {
+		return nil
+	}
+	if sprite.Texture.destroyed {
+		b.renderer.skipDestroyed(sprite)
+		return nil
+	}
+	if !b.geometry && b.renderer.record == nil {
+		return b.renderer.DrawSprite(sprite, transform, camera)
+	}
+	if b.count > 0 && (sprite.Texture != b.texture || b.count >= maxBatchSprites) {
+		if err := b.Flush(); err != nil {
+			return err
+		}
+	}
+	b.texture = sprite.Texture
+	b.count++
+
+	dst, center := spritePlacement(sprite, transform, camera)
+	if b.renderer.record != nil {
+		b.renderer.record(DrawCall{
+			Kind:      DrawKindSprite,
+			Sprite:    sprite,
+			Transform: transform,
+			Screen:    gamemath.Rectangle{X: float64(dst.X), Y: float64(dst.Y), Width: float64(dst.W), Height: float64(dst.H)},
+			Color:     sprite.Color,
+		})
+		return nil
+	}
+	b.addQuad(sprite, transform.Rotation, dst, center)
+	return nil
+}
+
+// addQuad appends a sprite's corners, rotated about its pivot.
+func (b *SpriteBatch) addQuad(sprite *Sprite, rotation float64, dst *sdl.Rect, center *sdl.Point) {
+	// Texture coordinates, swapped along flipped axes
+	texW, texH := float32(max(sprite.Texture.Width, 1)), float32(max(sprite.Texture.Height, 1))
+	u0 := float32(int32(sprite.SourceRect.X)) / texW
+	v0 := float32(int32(sprite.SourceRect.Y)) / texH
+	u1 := u0 + float32(int32(sprite.SourceRect.Width))/texW
+	v1 := v0 + float32(int32(sprite.SourceRect.Height))/texH
+	if sprite.FlipH {
+		u0, u1 = u1, u0
+	}
+	if sprite.FlipV {
+		v0, v1 = v1, v0
+	}
+
+	// Corners relative to the pivot, rotated clockwise as CopyEx does
+	pivotX, pivotY := float64(dst.X+center.X), float64(dst.Y+center.Y)
+	left, top := float64(dst.X)-pivotX, float64(dst.Y)-pivotY
+	right, bottom := left+float64(dst.W), top+float64(dst.H)
+	sin, cos := math.Sincos(rotation * math.Pi / 180)
+	corner := func(x, y float64, u, v float32) sdl.Vertex {
+		return sdl.Vertex{
+			Position: sdl.FPoint{X: float32(pivotX + x*cos - y*sin), Y: float32(pivotY + x*sin + y*cos)},
+			Color:    sdl.Color{R: sprite.Color.R, G: sprite.Color.G, B: sprite.Color.B, A: uint8(sprite.Alpha * 255)},
+			TexCoord: sdl.FPoint{X: u, Y: v},
+		}
+	}
+
+	base := int32(len(b.vertices))
+	b.vertices = append(b.vertices,
+		corner(left, top, u0, v0),
+		corner(right, top, u1, v0),
+		corner(right, bottom, u1, v1),
+		corner(left, bottom, u0, v1),
+	)
+	b.indices = append(b.indices, base, base+1, base+2, base, base+2, base+3)
+}
+
+// Flush draws the pending sprites (one draw call) and empties the batch.
+func (b *SpriteBatch) Flush() error {
+	if b.count == 0 {
+		return nil
+	}
+	texture, vertices, indices := b.texture, b.vertices, b.indices
+	b.vertices, b.indices = b.vertices[:0], b.indices[:0]
+	b.count, b.texture = 0, nil
+	b.renderer.drawCalls++
+
+	if b.renderer.record != nil {
+		return nil
+	}
+	if err := b.renderer.sdlRenderer.RenderGeometry(texture.GetSDLTexture(), vertices, indices); err != nil {
+		return fmt.Errorf("failed to render sprite batch: %w", err)
+	}
+	return nil
+}
+
+// Len returns the number of sprites waiting to be drawn.
+func (b *SpriteBatch) Len() int {
+	return b.count
+}
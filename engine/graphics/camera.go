@@ -1,13 +1,28 @@
 package graphics
 
-import gamemath "github.com/dshills/gogame/engine/math"
+import (
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
 
 // Camera defines view transformation from world to screen space.
 type Camera struct {
-	Position     gamemath.Vector2 // Camera center in world space
-	Zoom         float64          // Zoom factor (1.0 = normal, >1.0 = zoomed in)
-	screenWidth  int              // Cached screen dimensions
-	screenHeight int              // Cached screen dimensions
+	Position gamemath.Vector2   // Camera center in world space
+	Zoom     float64            // Zoom factor (1.0 = normal, >1.0 = zoomed in)
+	MinZoom  float64            // Lower zoom limit for ZoomToFit/FocusOn (0 = none)
+	MaxZoom  float64            // Upper zoom limit for ZoomToFit/FocusOn (0 = none)
+	Bounds   gamemath.Rectangle // World area the view stays inside (zero size = unbounded)
+	Viewport gamemath.Rectangle // Window area drawn into, normalized 0-1 (zero size = whole window)
+
+	HiddenLayers []int // Z-order values this camera doesn't draw (e.g. HUD layers in a minimap)
+
+	ShakeEnvelope  *gamemath.AnimationCurve // Shake strength over normalized time 0-1 (nil = quadratic decay)
+	ShakeFrequency float64                  // Shake oscillations per second (0 = 20)
+
+	shake        cameraShake
+	screenWidth  int // Cached window dimensions
+	screenHeight int // Cached window dimensions
 }
 
 // NewCamera creates a camera at origin with no zoom
@@ -24,12 +39,22 @@ func NewCamera() *Camera {
 	}
 }
 
-// SetScreenSize updates the camera's screen dimensions (called by engine on resize).
+// SetScreenSize updates the camera's window dimensions (called by engine on resize).
 func (c *Camera) SetScreenSize(width, height int) {
 	c.screenWidth = width
 	c.screenHeight = height
 }
 
+// ScreenSize returns the size in pixels of the area the camera draws into:
+// its viewport, or the whole window when Viewport is unset.
+func (c *Camera) ScreenSize() (width, height int) {
+	if c.Viewport.Width <= 0 || c.Viewport.Height <= 0 {
+		return c.screenWidth, c.screenHeight
+	}
+	rect := c.ViewportRect()
+	return int(rect.Width), int(rect.Height)
+}
+
 // WorldToScreen transforms world coordinates to screen pixels
 //
 // Parameters:
@@ -38,18 +63,20 @@ func (c *Camera) SetScreenSize(width, height int) {
 //
 // Returns:
 //
-//	screenX, screenY: Screen pixel coordinates
+//	screenX, screenY: Screen pixel coordinates, relative to the viewport
 //
 // Example:
 //
 //	screenX, screenY := camera.WorldToScreen(entity.Transform.Position.X, entity.Transform.Position.Y)
 func (c *Camera) WorldToScreen(worldX, worldY float64) (screenX, screenY int) {
 	// Transform: world position - camera position, then apply zoom, then add screen center
-	relX := (worldX - c.Position.X) * c.Zoom
-	relY := (worldY - c.Position.Y) * c.Zoom
+	view := c.ViewPosition()
+	relX := (worldX - view.X) * c.Zoom
+	relY := (worldY - view.Y) * c.Zoom
 
-	screenX = int(relX + float64(c.screenWidth)/2)
-	screenY = int(relY + float64(c.screenHeight)/2)
+	width, height := c.ScreenSize()
+	screenX = int(relX + float64(width)/2)
+	screenY = int(relY + float64(height)/2)
 	return
 }
 
@@ -57,7 +84,8 @@ func (c *Camera) WorldToScreen(worldX, worldY float64) (screenX, screenY int) {
 //
 // Parameters:
 //
-//	screenX, screenY: Screen pixel coordinates
+//	screenX, screenY: Screen pixel coordinates, relative to the viewport
+//	                  (see WindowToViewport for mouse positions)
 //
 // Returns:
 //
@@ -69,26 +97,57 @@ func (c *Camera) WorldToScreen(worldX, worldY float64) (screenX, screenY int) {
 //	entities := scene.GetEntitiesAt(worldX, worldY)
 func (c *Camera) ScreenToWorld(screenX, screenY int) (worldX, worldY float64) {
 	// Inverse transform: remove screen center, reverse zoom, then add camera position
-	relX := (float64(screenX) - float64(c.screenWidth)/2) / c.Zoom
-	relY := (float64(screenY) - float64(c.screenHeight)/2) / c.Zoom
+	width, height := c.ScreenSize()
+	relX := (float64(screenX) - float64(width)/2) / c.Zoom
+	relY := (float64(screenY) - float64(height)/2) / c.Zoom
 
-	worldX = relX + c.Position.X
-	worldY = relY + c.Position.Y
+	view := c.ViewPosition()
+	worldX = relX + view.X
+	worldY = relY + view.Y
 	return
 }
 
 // Follow smoothly moves camera toward target
 //
+// Smoothing is exponential with a time constant, so the camera covers the
+// same fraction of the remaining distance per second regardless of frame
+// rate: after smoothTime seconds it has closed ~63% of the gap, after
+// 3*smoothTime ~95%.
+//
 // Parameters:
 //
 //	targetX, targetY: Target world position
-//	smoothing: Interpolation factor (0.0 = instant, 1.0 = no follow)
+//	smoothTime: Time constant in seconds (0 = snap to target)
+//	dt: Delta time in seconds since the last Follow call
+//
+// Behavior:
+//   - Call once per update, after the target has moved (see core.LateUpdater)
+//   - Two steps of dt/2 land on the same position as one step of dt
+//   - The result is kept inside Bounds (see FollowDeadzone for a deadzone)
+//
+// Example:
+//
+//	camera.Follow(player.Transform.Position.X, player.Transform.Position.Y, 0.15, dt)
+func (c *Camera) Follow(targetX, targetY, smoothTime, dt float64) {
+	t := SmoothingFactor(smoothTime, dt)
+	c.Position.X += (targetX - c.Position.X) * t
+	c.Position.Y += (targetY - c.Position.Y) * t
+	c.ClampToBounds()
+}
+
+// SmoothingFactor returns the interpolation amount (0-1) for exponential
+// smoothing with time constant smoothTime over dt seconds: 1 - e^(-dt/smoothTime).
 //
 // Example:
 //
-//	camera.Follow(player.Transform.Position.X, player.Transform.Position.Y, 0.1)
-func (c *Camera) Follow(targetX, targetY float64, smoothing float64) {
-	// Linear interpolation: camera position moves toward target
-	c.Position.X += (targetX - c.Position.X) * (1.0 - smoothing)
-	c.Position.Y += (targetY - c.Position.Y) * (1.0 - smoothing)
+//	// Frame-rate independent lerp toward a target value
+//	value += (target - value) * graphics.SmoothingFactor(0.2, dt)
+func SmoothingFactor(smoothTime, dt float64) float64 {
+	if smoothTime <= 0 {
+		return 1
+	}
+	if dt <= 0 {
+		return 0
+	}
+	return 1 - math.Exp(-dt/smoothTime)
 }
@@ -0,0 +1,132 @@
+package graphics
+
+import (
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Bounded is anything with world-space bounds that a camera can frame
+// (core.Entity implements it; interface avoids circular imports).
+type Bounded interface {
+	GetBounds() gamemath.Rectangle
+}
+
+// FitRect computes the camera position and zoom that frame rect on screen
+// without changing the camera.
+//
+// Parameters:
+//
+//	rect: World-space rectangle to frame
+//	padding: Screen pixels to keep free on every side
+//
+// Returns:
+//
+//	position: Rectangle center
+//	zoom: Largest zoom that fits rect, clamped to MinZoom/MaxZoom
+//
+// Behavior:
+//   - A rectangle with zero width and height keeps the current zoom
+//   - A zero width or height is fitted on the other axis only
+//
+// Example:
+//
+//	// Ease toward the framing instead of cutting
+//	position, zoom := camera.FitRect(arena, 32)
+//	t := graphics.SmoothingFactor(0.5, dt)
+//	camera.Position = camera.Position.Add(position.Sub(camera.Position).Scale(t))
+//	camera.Zoom += (zoom - camera.Zoom) * t
+func (c *Camera) FitRect(rect gamemath.Rectangle, padding float64) (position gamemath.Vector2, zoom float64) {
+	position = rect.Center()
+	zoom = c.Zoom
+
+	width, height := c.ScreenSize()
+	availableW := math.Max(float64(width)-2*padding, 1)
+	availableH := math.Max(float64(height)-2*padding, 1)
+	switch {
+	case rect.Width > 0 && rect.Height > 0:
+		zoom = math.Min(availableW/rect.Width, availableH/rect.Height)
+	case rect.Width > 0:
+		zoom = availableW / rect.Width
+	case rect.Height > 0:
+		zoom = availableH / rect.Height
+	}
+	return position, c.clampZoom(zoom)
+}
+
+// ZoomToFit centers the camera on rect and zooms so it fills the screen.
+//
+// Parameters:
+//
+//	rect: World-space rectangle to frame
+//	padding: Screen pixels to keep free on every side
+//
+// Example:
+//
+//	// Show the whole 2000x1200 arena
+//	camera.ZoomToFit(gamemath.Rectangle{Width: 2000, Height: 1200}, 0)
+func (c *Camera) ZoomToFit(rect gamemath.Rectangle, padding float64) {
+	c.Position, c.Zoom = c.FitRect(rect, padding)
+}
+
+// FocusOn frames every target on screen at once.
+//
+// Parameters:
+//
+//	padding: Screen pixels to keep free on every side
+//	targets: Entities (or other bounded values) to frame
+//
+// Returns:
+//
+//	bool: False if there were no targets (camera unchanged)
+//
+// Behavior:
+//   - Frames the union of the targets' bounds (see ZoomToFit)
+//   - Set MaxZoom so targets standing together don't zoom in too far
+//
+// Example:
+//
+//	// Shared-screen multiplayer
+//	camera.MaxZoom = 2
+//	camera.FocusOn(64, player1, player2)
+func (c *Camera) FocusOn(padding float64, targets ...Bounded) bool {
+	bounds, ok := unionBounds(targets)
+	if !ok {
+		return false
+	}
+	c.ZoomToFit(bounds, padding)
+	return true
+}
+
+// unionBounds returns the smallest rectangle containing every target's bounds.
+func unionBounds(targets []Bounded) (gamemath.Rectangle, bool) {
+	found := false
+	var minX, minY, maxX, maxY float64
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		b := target.GetBounds()
+		if !found {
+			minX, minY, maxX, maxY = b.X, b.Y, b.X+b.Width, b.Y+b.Height
+			found = true
+			continue
+		}
+		minX = math.Min(minX, b.X)
+		minY = math.Min(minY, b.Y)
+		maxX = math.Max(maxX, b.X+b.Width)
+		maxY = math.Max(maxY, b.Y+b.Height)
+	}
+	return gamemath.Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}, found
+}
+
+// clampZoom applies MinZoom and MaxZoom (zero = no limit).
+func (c *Camera) clampZoom(zoom float64) float64 {
+	if c.MaxZoom > 0 && zoom > c.MaxZoom {
+		zoom = c.MaxZoom
+	}
+	if c.MinZoom > 0 && zoom < c.MinZoom {
+		zoom = c.MinZoom
+	}
+	return zoom
+}
@@ -0,0 +1,175 @@
+package graphics
+
+import (
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// defaultShakeFrequency is used when Camera.ShakeFrequency is 0.
+const defaultShakeFrequency = 20
+
+// cameraShake is the state of the current shake.
+type cameraShake struct {
+	magnitude float64 // Peak offset in screen pixels
+	duration  float64
+	elapsed   float64
+	offset    gamemath.Vector2 // Current offset in world units
+}
+
+// ViewPosition returns the center actually rendered: Position plus the
+// current shake offset, kept inside Bounds.
+func (c *Camera) ViewPosition() gamemath.Vector2 {
+	return c.clampView(c.Position.Add(c.shake.offset))
+}
+
+// ClampToBounds moves Position so the view stays inside Bounds
+//
+// Behavior:
+//   - No-op when Bounds has zero size
+//   - On an axis where the view is larger than Bounds, the view is
+//     centered on Bounds
+//   - Follow, FollowDeadzone, and Update clamp automatically; call it after
+//     setting Position or Zoom directly
+func (c *Camera) ClampToBounds() {
+	c.Position = c.clampView(c.Position)
+}
+
+// clampView keeps a view center inside Bounds.
+func (c *Camera) clampView(center gamemath.Vector2) gamemath.Vector2 {
+	if c.Bounds.Width <= 0 && c.Bounds.Height <= 0 || c.Zoom <= 0 {
+		return center
+	}
+	width, height := c.ScreenSize()
+	halfWidth := float64(width) / c.Zoom / 2
+	halfHeight := float64(height) / c.Zoom / 2
+	center.X = clampAxis(center.X, halfWidth, c.Bounds.X, c.Bounds.Width)
+	center.Y = clampAxis(center.Y, halfHeight, c.Bounds.Y, c.Bounds.Height)
+	return center
+}
+
+// clampAxis keeps [center-half, center+half] inside [start, start+size].
+func clampAxis(center, half, start, size float64) float64 {
+	if size <= 0 {
+		return center
+	}
+	if 2*half >= size {
+		return start + size/2
+	}
+	return math.Max(start+half, math.Min(start+size-half, center))
+}
+
+// FollowDeadzone follows a target only when it leaves a window around the
+// view center
+//
+// Parameters:
+//
+//	targetX, targetY: Target world position
+//	deadzone: Window width and height in world units the target moves
+//	          freely in (zero = always follow, like Follow)
+//	smoothTime: Time constant in seconds for catching up (0 = snap)
+//	dt: Delta time in seconds since the last call
+//
+// Behavior:
+//   - The camera moves just enough to bring the target back to the window
+//     edge, so small movements (idle animation, jitter) don't scroll the view
+//
+// Example:
+//
+//	// Platformer: free horizontal movement in the middle third of the screen
+//	camera.FollowDeadzone(player.X, player.Y, gamemath.Vector2{X: 260, Y: 120}, 0.1, dt)
+func (c *Camera) FollowDeadzone(targetX, targetY float64, deadzone gamemath.Vector2, smoothTime, dt float64) {
+	desired := c.Position
+	desired.X += deadzoneExcess(targetX-c.Position.X, deadzone.X/2)
+	desired.Y += deadzoneExcess(targetY-c.Position.Y, deadzone.Y/2)
+	c.Follow(desired.X, desired.Y, smoothTime, dt)
+}
+
+// deadzoneExcess returns how far an offset lies outside [-half, half].
+func deadzoneExcess(offset, half float64) float64 {
+	switch {
+	case offset > half:
+		return offset - half
+	case offset < -half:
+		return offset + half
+	}
+	return 0
+}
+
+// Shake starts a screen shake that decays over its duration
+//
+// Parameters:
+//
+//	magnitude: Peak offset in screen pixels
+//	duration: Seconds until the shake has faded out
+//
+// Behavior:
+//   - Strength follows ShakeEnvelope over normalized time 0-1, or decays
+//     quadratically when nil
+//   - A weaker shake doesn't interrupt a stronger one in progress
+//   - Advanced by Update (scenes update their camera every step)
+//   - Only the rendered view moves; Position is unchanged
+//
+// Example:
+//
+//	player.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
+//	    scene.Camera().Shake(8, 0.3)
+//	}
+func (c *Camera) Shake(magnitude, duration float64) {
+	if duration <= 0 || magnitude <= 0 {
+		return
+	}
+	if c.ShakeStrength() > magnitude {
+		return
+	}
+	c.shake = cameraShake{magnitude: magnitude, duration: duration}
+}
+
+// StopShake ends any shake immediately.
+func (c *Camera) StopShake() {
+	c.shake = cameraShake{}
+}
+
+// ShakeStrength returns the current shake amplitude in screen pixels (0 when still).
+func (c *Camera) ShakeStrength() float64 {
+	s := c.shake
+	if s.duration <= 0 || s.elapsed >= s.duration {
+		return 0
+	}
+	progress := s.elapsed / s.duration
+	if c.ShakeEnvelope != nil {
+		return s.magnitude * c.ShakeEnvelope.Evaluate(progress)
+	}
+	remaining := 1 - progress
+	return s.magnitude * remaining * remaining
+}
+
+// Update advances the shake and keeps Position inside Bounds.
+//
+// Parameters:
+//
+//	dt: Delta time in seconds
+func (c *Camera) Update(dt float64) {
+	if c.shake.duration > 0 {
+		c.shake.elapsed += dt
+		strength := c.ShakeStrength()
+		if strength <= 0 {
+			c.StopShake()
+		} else {
+			frequency := c.ShakeFrequency
+			if frequency <= 0 {
+				frequency = defaultShakeFrequency
+			}
+			// Sums of sines at unrelated rates: smooth, non-repeating wobble
+			phase := c.shake.elapsed * frequency * 2 * math.Pi
+			x := (math.Sin(phase) + math.Sin(phase*1.73+1.1)) / 2
+			y := (math.Sin(phase*1.31+2.3) + math.Sin(phase*2.17+0.4)) / 2
+			zoom := c.Zoom
+			if zoom <= 0 {
+				zoom = 1
+			}
+			c.shake.offset = gamemath.Vector2{X: x, Y: y}.Scale(strength / zoom)
+		}
+	}
+	c.ClampToBounds()
+}
@@ -0,0 +1,193 @@
+package graphics
+
+import (
+	"image"
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Shockwave is an expanding ring that displaces pixels outward.
+type Shockwave struct {
+	Center   gamemath.Vector2 // World position (screen pixels if the Distortion has no camera)
+	Speed    float64          // Ring expansion in pixels per second
+	Width    float64          // Ring thickness in pixels
+	Strength float64          // Peak displacement in pixels
+	Duration float64          // Lifetime in seconds (strength fades to zero)
+	elapsed  float64
+}
+
+// HeatHaze is a rectangular region with a rippling horizontal shimmer.
+type HeatHaze struct {
+	Region    gamemath.Rectangle // World rectangle (screen pixels if the Distortion has no camera)
+	Amplitude float64            // Max horizontal displacement in pixels
+	Frequency float64            // Ripples per pixel of height (e.g. 0.1)
+	Speed     float64            // Ripple scroll speed in radians per second
+}
+
+// Distortion is a post effect that displaces screen pixels for shockwaves and heat haze.
+//
+// Displacement runs on the CPU over the bounding boxes of active emitters
+// only, so idle distortion costs nothing.
+type Distortion struct {
+	Camera     *Camera // Converts emitter world positions to screen (nil = screen space)
+	shockwaves []*Shockwave
+	hazes      []*HeatHaze
+	time       float64
+	scratch    []uint8
+}
+
+// NewDistortion creates a distortion effect using a camera for world positions.
+//
+// Example:
+//
+//	distortion := graphics.NewDistortion(scene.Camera())
+//	engine.PostProcess().Add(distortion)
+//	distortion.AddShockwave(explosion.Transform.Position, 400, 24, 12, 0.6)
+func NewDistortion(camera *Camera) *Distortion {
+	return &Distortion{Camera: camera}
+}
+
+// AddShockwave starts an expanding ripple.
+//
+// Parameters:
+//
+//	center: Ripple origin
+//	speed: Expansion speed in pixels per second
+//	width: Ring thickness in pixels
+//	strength: Peak displacement in pixels
+//	duration: Lifetime in seconds
+func (d *Distortion) AddShockwave(center gamemath.Vector2, speed, width, strength, duration float64) *Shockwave {
+	wave := &Shockwave{Center: center, Speed: speed, Width: width, Strength: strength, Duration: duration}
+	d.shockwaves = append(d.shockwaves, wave)
+	return wave
+}
+
+// AddHeatHaze adds a persistent shimmer region. Remove it with RemoveHeatHaze.
+func (d *Distortion) AddHeatHaze(haze *HeatHaze) {
+	d.hazes = append(d.hazes, haze)
+}
+
+// RemoveHeatHaze removes a shimmer region. No-op if not present.
+func (d *Distortion) RemoveHeatHaze(haze *HeatHaze) {
+	for i, existing := range d.hazes {
+		if existing == haze {
+			d.hazes = append(d.hazes[:i], d.hazes[i+1:]...)
+			return
+		}
+	}
+}
+
+// Shockwaves returns the active shockwaves.
+func (d *Distortion) Shockwaves() []*Shockwave {
+	return d.shockwaves
+}
+
+// Update advances emitters and drops expired shockwaves. Call once per frame.
+func (d *Distortion) Update(dt float64) {
+	d.time += dt
+	alive := d.shockwaves[:0]
+	for _, wave := range d.shockwaves {
+		wave.elapsed += dt
+		if wave.elapsed < wave.Duration {
+			alive = append(alive, wave)
+		}
+	}
+	d.shockwaves = alive
+}
+
+// Apply displaces frame pixels (implements PostEffect).
+func (d *Distortion) Apply(frame *image.RGBA) {
+	if len(d.shockwaves) == 0 && len(d.hazes) == 0 {
+		return
+	}
+
+	area := image.Rectangle{}
+	for _, wave := range d.shockwaves {
+		cx, cy, scale := d.toScreen(wave.Center)
+		reach := (wave.Speed*wave.elapsed + wave.Width/2) * scale
+		area = area.Union(image.Rect(int(cx-reach), int(cy-reach), int(cx+reach)+1, int(cy+reach)+1))
+	}
+	for _, haze := range d.hazes {
+		x, y, scale := d.toScreen(gamemath.Vector2{X: haze.Region.X, Y: haze.Region.Y})
+		area = area.Union(image.Rect(int(x), int(y), int(x+haze.Region.Width*scale)+1, int(y+haze.Region.Height*scale)+1))
+	}
+	area = area.Intersect(frame.Bounds())
+	if area.Empty() {
+		return
+	}
+
+	// Sample from an unmodified copy so displacement doesn't compound
+	if cap(d.scratch) < len(frame.Pix) {
+		d.scratch = make([]uint8, len(frame.Pix))
+	}
+	source := d.scratch[:len(frame.Pix)]
+	copy(source, frame.Pix)
+
+	bounds := frame.Bounds()
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			dx, dy := d.Displacement(float64(x), float64(y))
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			sx := clampInt(int(math.Round(float64(x)-dx)), bounds.Min.X, bounds.Max.X-1)
+			sy := clampInt(int(math.Round(float64(y)-dy)), bounds.Min.Y, bounds.Max.Y-1)
+			dst := frame.PixOffset(x, y)
+			src := frame.PixOffset(sx, sy)
+			copy(frame.Pix[dst:dst+4], source[src:src+4])
+		}
+	}
+}
+
+// Displacement returns the total pixel offset at a screen position.
+func (d *Distortion) Displacement(x, y float64) (dx, dy float64) {
+	for _, wave := range d.shockwaves {
+		cx, cy, scale := d.toScreen(wave.Center)
+		ox, oy := x-cx, y-cy
+		dist := math.Hypot(ox, oy)
+		radius := wave.Speed * wave.elapsed * scale
+		half := wave.Width * scale / 2
+		if dist == 0 || half <= 0 || math.Abs(dist-radius) >= half {
+			continue
+		}
+
+		// Smooth bump across the ring, fading out over the wave's lifetime
+		bump := math.Cos((dist - radius) / half * math.Pi / 2)
+		fade := 1.0
+		if wave.Duration > 0 {
+			fade = 1 - wave.elapsed/wave.Duration
+		}
+		magnitude := wave.Strength * scale * bump * fade
+		dx += ox / dist * magnitude
+		dy += oy / dist * magnitude
+	}
+
+	for _, haze := range d.hazes {
+		left, top, scale := d.toScreen(gamemath.Vector2{X: haze.Region.X, Y: haze.Region.Y})
+		if x < left || y < top || x >= left+haze.Region.Width*scale || y >= top+haze.Region.Height*scale {
+			continue
+		}
+		dx += haze.Amplitude * scale * math.Sin((y-top)/scale*haze.Frequency*2*math.Pi+d.time*haze.Speed)
+	}
+	return dx, dy
+}
+
+// toScreen converts a position to screen pixels, returning the zoom scale.
+func (d *Distortion) toScreen(pos gamemath.Vector2) (x, y, scale float64) {
+	if d.Camera == nil {
+		return pos.X, pos.Y, 1
+	}
+	sx, sy := d.Camera.WorldToScreen(pos.X, pos.Y)
+	return float64(sx), float64(sy), d.Camera.Zoom
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,150 @@
+package graphics
+
+import "time"
+
+// fileStamp identifies one version of a file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// fileWatch is the hot reload state of an AssetManager.
+type fileWatch struct {
+	interval time.Duration        // Minimum time between checks
+	next     time.Time            // Earliest time of the next check
+	stamps   map[string]fileStamp // Last seen version by texture path
+}
+
+// WatchFiles turns on hot reloading: loaded texture files are checked for
+// changes and reloaded in place
+//
+// Parameters:
+//
+//	interval: Minimum time between checks (0 = every ReloadChanged call)
+//
+// Behavior:
+//   - Files are compared by modification time and size, on disk or in the
+//     mounted filesystem
+//   - A changed file is decoded again and its SDL texture re-created inside
+//     the same *Texture, so sprites, atlases, and caches pick it up without
+//     being touched
+//   - Width and Height follow the new image; sprite SourceRects are kept,
+//     so update them from OnReload if an image changes size
+//   - Generated textures have no file and are never reloaded
+//   - Intended for development: the engine checks once per frame, and
+//     reloading decodes on the main thread
+//
+// Example:
+//
+//	if *devMode {
+//	    engine.Assets().WatchFiles(500 * time.Millisecond)
+//	    engine.Assets().OnReload = func(texture *graphics.Texture, err error) {
+//	        if err != nil {
+//	            log.Printf("reload %s: %v", texture.Path, err)
+//	        }
+//	    }
+//	}
+func (am *AssetManager) WatchFiles(interval time.Duration) {
+	am.watch = &fileWatch{interval: interval, stamps: make(map[string]fileStamp)}
+	for path := range am.textures {
+		if stamp, ok := am.stamp(path); ok {
+			am.watch.stamps[path] = stamp
+		}
+	}
+}
+
+// StopWatching turns off hot reloading.
+func (am *AssetManager) StopWatching() {
+	am.watch = nil
+}
+
+// Watching reports whether hot reloading is on.
+func (am *AssetManager) Watching() bool {
+	return am.watch != nil
+}
+
+// ReloadChanged reloads textures whose files changed since they were loaded
+// or last checked (main thread only)
+//
+// Returns:
+//
+//	int: Textures reloaded (0 when not watching or before the interval
+//	     has passed)
+//
+// Behavior:
+//   - The engine calls this once per frame; call it yourself for asset
+//     managers you create
+//   - Textures loaded after WatchFiles are compared from their first check
+//   - A missing file is skipped until it reappears (editors often save
+//     through a temporary file)
+//   - An undecodable file leaves the old image in place and reports the
+//     error to OnReload; the texture reloads when the file changes again
+func (am *AssetManager) ReloadChanged() int {
+	watch := am.watch
+	if watch == nil {
+		return 0
+	}
+	now := time.Now()
+	if now.Before(watch.next) {
+		return 0
+	}
+	watch.next = now.Add(watch.interval)
+
+	for path := range watch.stamps {
+		if _, loaded := am.textures[path]; !loaded {
+			delete(watch.stamps, path)
+		}
+	}
+
+	reloaded := 0
+	for path, texture := range am.textures {
+		if texture.Destroyed() {
+			continue
+		}
+		stamp, ok := am.stamp(path)
+		if !ok {
+			continue // Generated, or missing while being saved
+		}
+		previous, seen := watch.stamps[path]
+		watch.stamps[path] = stamp
+		if !seen || (previous.modTime.Equal(stamp.modTime) && previous.size == stamp.size) {
+			continue
+		}
+		err := am.reload(path, texture)
+		if err == nil {
+			reloaded++
+		}
+		if am.OnReload != nil {
+			am.OnReload(texture, err)
+		}
+	}
+	return reloaded
+}
+
+// stamp returns the current version of an asset file.
+func (am *AssetManager) stamp(path string) (fileStamp, bool) {
+	info, err := statAsset(am.fsys, path)
+	if err != nil || info.IsDir() {
+		return fileStamp{}, false
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, true
+}
+
+// reload decodes a texture's file again and swaps in a new SDL texture.
+func (am *AssetManager) reload(path string, texture *Texture) error {
+	img, err := decodeTexture(am.fsys, path)
+	if err != nil {
+		return err
+	}
+	width, height := img.width, img.height
+	sdlTexture, err := am.uploadSDL(img)
+	if err != nil {
+		return err
+	}
+	if texture.sdlTexture != nil {
+		_ = texture.sdlTexture.Destroy() // Best effort cleanup
+	}
+	texture.sdlTexture = sdlTexture
+	texture.Width, texture.Height = width, height
+	return nil
+}
@@ -0,0 +1,155 @@
+package graphics
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"os"
+)
+
+// ErrInvalidLUT is returned when a LUT image doesn't have strip dimensions.
+var ErrInvalidLUT = errors.New("invalid color LUT")
+
+// ColorLUT is a 3D color lookup table used for color grading.
+//
+// LUTs are authored as a horizontal strip of Size slices, each Size x Size
+// pixels: red increases left to right within a slice, green top to bottom,
+// and blue from slice to slice (the common 256x16 / 1024x32 layout). Grade a
+// screenshot in any image editor, apply the same adjustments to the identity
+// strip from IdentityLUTImage, and load the result.
+type ColorLUT struct {
+	Size     int     // Entries per channel (e.g. 16 or 32)
+	Strength float64 // Blend between original (0) and graded (1) colors
+	table    []uint8 // Size^3 RGB triples indexed [b][g][r]
+}
+
+// NewColorLUT creates a LUT from a strip image.
+//
+// Returns:
+//
+//	*ColorLUT: LUT at full strength
+//	error: ErrInvalidLUT if the image isn't Size*Size wide and Size tall
+func NewColorLUT(img image.Image) (*ColorLUT, error) {
+	bounds := img.Bounds()
+	size := bounds.Dy()
+	if size < 2 || bounds.Dx() != size*size {
+		return nil, fmt.Errorf("%w: expected %dx%d strip, got %dx%d", ErrInvalidLUT, size*size, size, bounds.Dx(), bounds.Dy())
+	}
+
+	table := make([]uint8, size*size*size*3)
+	for b := 0; b < size; b++ {
+		for g := 0; g < size; g++ {
+			for r := 0; r < size; r++ {
+				cr, cg, cb, _ := img.At(bounds.Min.X+b*size+r, bounds.Min.Y+g).RGBA()
+				i := ((b*size+g)*size + r) * 3
+				table[i] = uint8(cr >> 8)
+				table[i+1] = uint8(cg >> 8)
+				table[i+2] = uint8(cb >> 8)
+			}
+		}
+	}
+
+	return &ColorLUT{Size: size, Strength: 1, table: table}, nil
+}
+
+// LoadColorLUT loads a LUT strip from a PNG or JPEG file.
+//
+// Example:
+//
+//	night, err := graphics.LoadColorLUT("assets/luts/night.png")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	engine.PostProcess().Add(night)
+func LoadColorLUT(path string) (*ColorLUT, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load LUT: %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }() // Best effort cleanup for read-only file
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode LUT: %s: %w", path, err)
+	}
+	return NewColorLUT(img)
+}
+
+// IdentityLUTImage returns a strip that maps every color to itself.
+func IdentityLUTImage(size int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, size*size, size))
+	scale := 255.0 / float64(size-1)
+	for b := 0; b < size; b++ {
+		for g := 0; g < size; g++ {
+			for r := 0; r < size; r++ {
+				img.SetRGBA(b*size+r, g, color.RGBA{
+					R: uint8(float64(r)*scale + 0.5),
+					G: uint8(float64(g)*scale + 0.5),
+					B: uint8(float64(b)*scale + 0.5),
+					A: 255,
+				})
+			}
+		}
+	}
+	return img
+}
+
+// Transform maps a color through the LUT with trilinear interpolation and Strength blending.
+func (lut *ColorLUT) Transform(r, g, b uint8) (uint8, uint8, uint8) {
+	last := float64(lut.Size - 1)
+	fr, fg, fb := float64(r)/255*last, float64(g)/255*last, float64(b)/255*last
+	r0, g0, b0 := int(fr), int(fg), int(fb)
+	r1, g1, b1 := min(r0+1, lut.Size-1), min(g0+1, lut.Size-1), min(b0+1, lut.Size-1)
+	tr, tg, tb := fr-float64(r0), fg-float64(g0), fb-float64(b0)
+
+	var out [3]float64
+	for c := 0; c < 3; c++ {
+		c00 := lerp(lut.at(r0, g0, b0, c), lut.at(r1, g0, b0, c), tr)
+		c10 := lerp(lut.at(r0, g1, b0, c), lut.at(r1, g1, b0, c), tr)
+		c01 := lerp(lut.at(r0, g0, b1, c), lut.at(r1, g0, b1, c), tr)
+		c11 := lerp(lut.at(r0, g1, b1, c), lut.at(r1, g1, b1, c), tr)
+		out[c] = lerp(lerp(c00, c10, tg), lerp(c01, c11, tg), tb)
+	}
+
+	strength := lut.Strength
+	if strength < 1 {
+		out[0] = lerp(float64(r), out[0], strength)
+		out[1] = lerp(float64(g), out[1], strength)
+		out[2] = lerp(float64(b), out[2], strength)
+	}
+	return clampByte(out[0]), clampByte(out[1]), clampByte(out[2])
+}
+
+// Apply grades every pixel of the frame (implements PostEffect).
+func (lut *ColorLUT) Apply(frame *image.RGBA) {
+	if lut.Strength <= 0 {
+		return
+	}
+	bounds := frame.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := frame.Pix[frame.PixOffset(bounds.Min.X, y):frame.PixOffset(bounds.Max.X, y)]
+		for i := 0; i+3 < len(row); i += 4 {
+			row[i], row[i+1], row[i+2] = lut.Transform(row[i], row[i+1], row[i+2])
+		}
+	}
+}
+
+// at returns channel c of the table entry at (r, g, b).
+func (lut *ColorLUT) at(r, g, b, c int) float64 {
+	return float64(lut.table[((b*lut.Size+g)*lut.Size+r)*3+c])
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+func clampByte(v float64) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 255:
+		return 255
+	}
+	return uint8(v + 0.5)
+}
@@ -0,0 +1,165 @@
+package graphics
+
+import (
+	"fmt"
+	"image"
+	"unsafe"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// PostEffect is a full-screen effect applied to the rendered frame.
+//
+// Frames are RGBA images (R, G, B, A byte order) so effects can be written and
+// tested without a renderer.
+type PostEffect interface {
+	Apply(frame *image.RGBA)
+}
+
+// PostProcessor renders the scene into an offscreen target and runs effects on it.
+//
+// Effects run on the CPU in the order they were added. When no effects are
+// registered the engine renders directly to the screen with no overhead.
+type PostProcessor struct {
+	effects []PostEffect
+	target  *sdl.Texture // Offscreen render target
+	output  *sdl.Texture // Streaming texture holding the processed frame
+	frame   *image.RGBA  // CPU copy of the frame
+	width   int
+	height  int
+}
+
+// NewPostProcessor creates an empty post-processing stage.
+func NewPostProcessor() *PostProcessor {
+	return &PostProcessor{
+		effects: make([]PostEffect, 0),
+	}
+}
+
+// Add appends an effect to the chain.
+//
+// Example:
+//
+//	lut, _ := graphics.LoadColorLUT("assets/luts/night.png")
+//	engine.PostProcess().Add(lut)
+func (pp *PostProcessor) Add(effect PostEffect) {
+	pp.effects = append(pp.effects, effect)
+}
+
+// Remove removes an effect from the chain. No-op if not present.
+func (pp *PostProcessor) Remove(effect PostEffect) {
+	for i, existing := range pp.effects {
+		if existing == effect {
+			pp.effects = append(pp.effects[:i], pp.effects[i+1:]...)
+			return
+		}
+	}
+}
+
+// Effects returns the effect chain in application order.
+func (pp *PostProcessor) Effects() []PostEffect {
+	return pp.effects
+}
+
+// Active reports whether any effects are registered.
+func (pp *PostProcessor) Active() bool {
+	return len(pp.effects) > 0
+}
+
+// Process runs the effect chain on a frame (useful for screenshots and tests).
+func (pp *PostProcessor) Process(frame *image.RGBA) {
+	for _, effect := range pp.effects {
+		effect.Apply(frame)
+	}
+}
+
+// Begin redirects rendering to the offscreen target.
+//
+// Parameters:
+//
+//	renderer: Renderer to redirect
+//	width, height: Frame size in pixels (targets are recreated on resize)
+//
+// Returns:
+//
+//	error: Non-nil if targets can't be created or bound
+func (pp *PostProcessor) Begin(renderer *Renderer, width, height int) error {
+	if renderer.sdlRenderer == nil {
+		return ErrNoSDLRenderer
+	}
+	if err := pp.ensureTargets(renderer, width, height); err != nil {
+		return err
+	}
+	if err := renderer.sdlRenderer.SetRenderTarget(pp.target); err != nil {
+		return fmt.Errorf("failed to set post-process render target: %w", err)
+	}
+	return nil
+}
+
+// End applies the effect chain and draws the processed frame to the screen.
+//
+// Returns:
+//
+//	error: Non-nil if reading back or presenting the frame fails
+func (pp *PostProcessor) End(renderer *Renderer) error {
+	sdlRenderer := renderer.sdlRenderer
+	if sdlRenderer == nil {
+		return ErrNoSDLRenderer
+	}
+	if err := sdlRenderer.ReadPixels(nil, uint32(sdl.PIXELFORMAT_ABGR8888), unsafe.Pointer(&pp.frame.Pix[0]), pp.frame.Stride); err != nil {
+		_ = sdlRenderer.SetRenderTarget(nil) // Best effort restore
+		return fmt.Errorf("failed to read frame pixels: %w", err)
+	}
+	if err := sdlRenderer.SetRenderTarget(nil); err != nil {
+		return fmt.Errorf("failed to restore render target: %w", err)
+	}
+
+	pp.Process(pp.frame)
+
+	if err := pp.output.Update(nil, unsafe.Pointer(&pp.frame.Pix[0]), pp.frame.Stride); err != nil {
+		return fmt.Errorf("failed to upload processed frame: %w", err)
+	}
+	if err := sdlRenderer.Copy(pp.output, nil, nil); err != nil {
+		return fmt.Errorf("failed to draw processed frame: %w", err)
+	}
+	return nil
+}
+
+// Destroy releases the offscreen textures.
+func (pp *PostProcessor) Destroy() {
+	if pp.target != nil {
+		_ = pp.target.Destroy() // Best effort cleanup
+		pp.target = nil
+	}
+	if pp.output != nil {
+		_ = pp.output.Destroy() // Best effort cleanup
+		pp.output = nil
+	}
+	pp.frame = nil
+}
+
+// ensureTargets (re)creates the offscreen textures for the frame size.
+func (pp *PostProcessor) ensureTargets(renderer *Renderer, width, height int) error {
+	if pp.target != nil && pp.width == width && pp.height == height {
+		return nil
+	}
+	pp.Destroy()
+
+	format := uint32(sdl.PIXELFORMAT_ABGR8888) // RGBA byte order on little-endian
+	target, err := renderer.sdlRenderer.CreateTexture(format, sdl.TEXTUREACCESS_TARGET, int32(width), int32(height))
+	if err != nil {
+		return fmt.Errorf("failed to create post-process target: %w", err)
+	}
+	output, err := renderer.sdlRenderer.CreateTexture(format, sdl.TEXTUREACCESS_STREAMING, int32(width), int32(height))
+	if err != nil {
+		_ = target.Destroy() // Best effort cleanup
+		return fmt.Errorf("failed to create post-process output: %w", err)
+	}
+
+	pp.target = target
+	pp.output = output
+	pp.frame = image.NewRGBA(image.Rect(0, 0, width, height))
+	pp.width = width
+	pp.height = height
+	return nil
+}
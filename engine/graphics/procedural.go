@@ -0,0 +1,168 @@
+package graphics
+
+import (
+	"image"
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// GradientDirection selects how a gradient runs across an image.
+type GradientDirection int
+
+// Gradient directions.
+const (
+	GradientHorizontal GradientDirection = iota // From the left edge to the right
+	GradientVertical                            // From the top edge to the bottom
+	GradientRadial                              // From the center to the corners
+)
+
+// NoiseOptions configures NoiseImage.
+type NoiseOptions struct {
+	Scale   float64        // Feature size in pixels (0 = 16)
+	Octaves int            // Detail layers, each half the size (0 = 1)
+	Seed    int64          // Same seed, same image
+	Low     gamemath.Color // Color at noise value 0
+	High    gamemath.Color // Color at noise value 1
+}
+
+// GenerateImage creates an image by calling pixel for every pixel
+//
+// Parameters:
+//
+//	width, height: Image size in pixels
+//	pixel: Color at (x, y), from the top-left corner
+//
+// Returns:
+//
+//	*image.RGBA: Generated image with straight (non-premultiplied) alpha, as
+//	             textures expect; upload it with Renderer.NewTextureFromImage
+//
+// Example:
+//
+//	// Placeholder ship: a triangle pointing up
+//	ship := graphics.GenerateImage(32, 32, func(x, y int) gamemath.Color {
+//	    if y >= 4 && math.Abs(float64(x)-16) <= float64(y-4)/2 {
+//	        return gamemath.Color{R: 100, G: 150, B: 255, A: 255}
+//	    }
+//	    return gamemath.Transparent
+//	})
+func GenerateImage(width, height int, pixel func(x, y int) gamemath.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, max(width, 0), max(height, 0)))
+	for y := 0; y < img.Rect.Dy(); y++ {
+		row := img.Pix[y*img.Stride:]
+		for x := 0; x < img.Rect.Dx(); x++ {
+			c := pixel(x, y)
+			row[x*4], row[x*4+1], row[x*4+2], row[x*4+3] = c.R, c.G, c.B, c.A
+		}
+	}
+	return img
+}
+
+// SolidImage creates an image of a single color.
+func SolidImage(width, height int, color gamemath.Color) *image.RGBA {
+	return GenerateImage(width, height, func(int, int) gamemath.Color { return color })
+}
+
+// GradientImage creates an image blending from one color to another
+// (sky backdrops, health bar fills, vignettes).
+func GradientImage(width, height int, from, to gamemath.Color, direction GradientDirection) *image.RGBA {
+	cx, cy := float64(width)/2, float64(height)/2
+	maxDist := math.Hypot(cx, cy)
+	return GenerateImage(width, height, func(x, y int) gamemath.Color {
+		var t float64
+		switch direction {
+		case GradientVertical:
+			t = gradientPosition(y, height)
+		case GradientRadial:
+			if maxDist > 0 {
+				t = math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy) / maxDist
+			}
+		default:
+			t = gradientPosition(x, width)
+		}
+		return lerpColor(from, to, t)
+	})
+}
+
+// gradientPosition maps a pixel index to 0-1 so the first pixel is exactly
+// the start color and the last exactly the end color.
+func gradientPosition(i, size int) float64 {
+	if size <= 1 {
+		return 0
+	}
+	return float64(i) / float64(size-1)
+}
+
+// CheckerImage creates a checkerboard of cellSize-pixel squares starting
+// with color a in the top-left (missing-texture markers, debug floors).
+func CheckerImage(width, height, cellSize int, a, b gamemath.Color) *image.RGBA {
+	cellSize = max(cellSize, 1)
+	return GenerateImage(width, height, func(x, y int) gamemath.Color {
+		if (x/cellSize+y/cellSize)%2 == 0 {
+			return a
+		}
+		return b
+	})
+}
+
+// NoiseImage creates smooth value noise blended between two colors
+// (clouds, terrain, fog, dissolve masks).
+//
+// Example:
+//
+//	clouds := graphics.NoiseImage(256, 256, graphics.NoiseOptions{
+//	    Scale: 64, Octaves: 4, Seed: 7,
+//	    Low: gamemath.Color{R: 90, G: 140, B: 220, A: 255}, High: gamemath.White,
+//	})
+func NoiseImage(width, height int, options NoiseOptions) *image.RGBA {
+	scale := options.Scale
+	if scale <= 0 {
+		scale = 16
+	}
+	octaves := max(options.Octaves, 1)
+	return GenerateImage(width, height, func(x, y int) gamemath.Color {
+		value, amplitude, total := 0.0, 1.0, 0.0
+		frequency := 1 / scale
+		for octave := range octaves {
+			value += amplitude * valueNoise(float64(x)*frequency, float64(y)*frequency, options.Seed+int64(octave))
+			total += amplitude
+			amplitude /= 2
+			frequency *= 2
+		}
+		return lerpColor(options.Low, options.High, value/total)
+	})
+}
+
+// valueNoise interpolates hashed lattice values smoothly (0-1).
+func valueNoise(x, y float64, seed int64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	fx, fy := smoothstep(x-x0), smoothstep(y-y0)
+	ix, iy := int64(x0), int64(y0)
+	top := lerp(latticeValue(ix, iy, seed), latticeValue(ix+1, iy, seed), fx)
+	bottom := lerp(latticeValue(ix, iy+1, seed), latticeValue(ix+1, iy+1, seed), fx)
+	return lerp(top, bottom, fy)
+}
+
+// latticeValue hashes a lattice point to 0-1.
+func latticeValue(x, y, seed int64) float64 {
+	h := uint64(x)*0x9E3779B97F4A7C15 ^ uint64(y)*0xC2B2AE3D27D4EB4F ^ uint64(seed)*0x165667B19E3779F9
+	h ^= h >> 33
+	h *= 0xFF51AFD7ED558CCD
+	h ^= h >> 33
+	return float64(h>>11) / float64(1<<53)
+}
+
+// smoothstep eases lattice interpolation so noise has no visible grid.
+func smoothstep(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// lerpColor blends two colors channel by channel (t clamped to 0-1).
+func lerpColor(from, to gamemath.Color, t float64) gamemath.Color {
+	t = min(max(t, 0), 1)
+	channel := func(a, b uint8) uint8 {
+		return uint8(math.Round(lerp(float64(a), float64(b), t)))
+	}
+	return gamemath.Color{R: channel(from.R, to.R), G: channel(from.G, to.G), B: channel(from.B, to.B), A: channel(from.A, to.A)}
+}
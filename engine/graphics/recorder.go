@@ -0,0 +1,94 @@
+package graphics
+
+import (
+	"errors"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ErrNoSDLRenderer is returned by operations that need an SDL renderer when
+// called on a recording renderer.
+var ErrNoSDLRenderer = errors.New("renderer has no SDL renderer")
+
+// DrawKind identifies a recorded draw call.
+type DrawKind int
+
+const (
+	// DrawKindClear is a Clear call.
+	DrawKindClear DrawKind = iota
+	// DrawKindSprite is a DrawSprite call.
+	DrawKindSprite
+	// DrawKindText is a DrawTextSprite call.
+	DrawKindText
+	// DrawKindRect is a DrawRect call.
+	DrawKindRect
+	// DrawKindFillRect is a FillRect call.
+	DrawKindFillRect
+	// DrawKindLine is a DrawLine call.
+	DrawKindLine
+	// DrawKindViewport is a SetViewport call.
+	DrawKindViewport
+)
+
+// String returns the kind name.
+func (k DrawKind) String() string {
+	switch k {
+	case DrawKindClear:
+		return "clear"
+	case DrawKindSprite:
+		return "sprite"
+	case DrawKindText:
+		return "text"
+	case DrawKindRect:
+		return "rect"
+	case DrawKindFillRect:
+		return "fill_rect"
+	case DrawKindLine:
+		return "line"
+	case DrawKindViewport:
+		return "viewport"
+	}
+	return "unknown"
+}
+
+// DrawCall is one draw made through a recording renderer.
+type DrawCall struct {
+	Kind      DrawKind
+	Sprite    *Sprite            // Sprite drawn (DrawKindSprite)
+	Text      string             // Text drawn (DrawKindText)
+	Transform gamemath.Transform // World transform (sprites and text)
+	Screen    gamemath.Rectangle // Screen destination (sprites, rects) or viewport area
+	From, To  gamemath.Vector2   // Screen end points (lines)
+	Color     gamemath.Color     // Clear, rect, line, or text color (sprites: tint)
+}
+
+// NewRecordingRenderer creates a renderer that reports draw calls instead
+// of drawing, so rendering code can be tested without a window.
+//
+// Parameters:
+//
+//	record: Called for every Clear, DrawSprite, DrawTextSprite, DrawRect,
+//	        FillRect, DrawLine, and SetViewport
+//
+// Behavior:
+//   - Sprites are recorded with their screen destination (camera, scale,
+//     and pivot applied) and need a Texture, but not an SDL texture
+//   - Text is recorded without rendering a texture
+//   - Present does nothing; render targets and streaming textures return
+//     ErrNoSDLRenderer
+//
+// Example:
+//
+//	var calls []graphics.DrawCall
+//	renderer := graphics.NewRecordingRenderer(func(call graphics.DrawCall) {
+//	    calls = append(calls, call)
+//	})
+//	_ = scene.Render(renderer)
+func NewRecordingRenderer(record func(call DrawCall)) *Renderer {
+	return &Renderer{record: record}
+}
+
+// Recording reports whether the renderer records draw calls instead of drawing.
+func (r *Renderer) Recording() bool {
+	return r.record != nil
+}
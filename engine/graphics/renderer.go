@@ -2,6 +2,7 @@ package graphics
 
 import (
 	"fmt"
+	"image"
 
 	gamemath "github.com/dshills/gogame/engine/math"
 	"github.com/veandco/go-sdl2/sdl"
@@ -9,7 +10,26 @@ import (
 
 // Renderer wraps SDL2 rendering operations.
 type Renderer struct {
+	// OnDestroyedTexture is called the first time a sprite using a destroyed
+	// texture is drawn (the sprite is skipped), e.g. to log the unload that
+	// came too early.
+	OnDestroyedTexture func(sprite *Sprite)
+
 	sdlRenderer *sdl.Renderer
+	record      func(call DrawCall) // Set by NewRecordingRenderer; replaces drawing
+	drawCalls   int                 // Draws since ResetDrawCalls
+	warned      map[*Texture]bool   // Destroyed textures already reported
+}
+
+// DrawCalls returns the sprites, text, rects, and lines drawn since the
+// last ResetDrawCalls (the engine resets it every frame).
+func (r *Renderer) DrawCalls() int {
+	return r.drawCalls
+}
+
+// ResetDrawCalls starts a new draw call count.
+func (r *Renderer) ResetDrawCalls() {
+	r.drawCalls = 0
 }
 
 // NewRenderer creates a renderer from an SDL renderer.
@@ -21,6 +41,10 @@ func NewRenderer(sdlRenderer *sdl.Renderer) *Renderer {
 
 // Clear clears the screen with the specified color.
 func (r *Renderer) Clear(color gamemath.Color) error {
+	if r.record != nil {
+		r.record(DrawCall{Kind: DrawKindClear, Color: color})
+		return nil
+	}
 	if err := r.sdlRenderer.SetDrawColor(color.R, color.G, color.B, color.A); err != nil {
 		return fmt.Errorf("failed to set draw color: %w", err)
 	}
@@ -32,21 +56,25 @@ func (r *Renderer) Clear(color gamemath.Color) error {
 
 // Present presents the rendered frame to the screen.
 func (r *Renderer) Present() {
+	if r.sdlRenderer == nil {
+		return
+	}
 	r.sdlRenderer.Present()
 }
 
 // DrawSprite renders a sprite at the specified transform with camera transform applied.
+//
+// The sprite's pivot is placed at the transform position and rotation is
+// applied around it.
 func (r *Renderer) DrawSprite(sprite *Sprite, transform gamemath.Transform, camera *Camera) error {
 	if sprite == nil || sprite.Texture == nil {
 		return nil // Nothing to render
 	}
-
-	// Convert world position to screen position via camera
-	screenX, screenY := camera.WorldToScreen(transform.Position.X, transform.Position.Y)
-
-	// Calculate final dimensions with scale
-	finalWidth := int(sprite.SourceRect.Width * transform.Scale.X * camera.Zoom)
-	finalHeight := int(sprite.SourceRect.Height * transform.Scale.Y * camera.Zoom)
+	if sprite.Texture.destroyed {
+		r.skipDestroyed(sprite)
+		return nil
+	}
+	r.drawCalls++
 
 	// Create source rectangle (region of texture to render)
 	srcRect := &sdl.Rect{
@@ -55,14 +83,16 @@ func (r *Renderer) DrawSprite(sprite *Sprite, transform gamemath.Transform, came
 		W: int32(sprite.SourceRect.Width),
 		H: int32(sprite.SourceRect.Height),
 	}
-
-	// Create destination rectangle (where to render on screen)
-	// Center the sprite at the screen position
-	dstRect := &sdl.Rect{
-		X: int32(screenX - finalWidth/2),
-		Y: int32(screenY - finalHeight/2),
-		W: int32(finalWidth),
-		H: int32(finalHeight),
+	dstRect, center := spritePlacement(sprite, transform, camera)
+	if r.record != nil {
+		r.record(DrawCall{
+			Kind:      DrawKindSprite,
+			Sprite:    sprite,
+			Transform: transform,
+			Screen:    gamemath.Rectangle{X: float64(dstRect.X), Y: float64(dstRect.Y), Width: float64(dstRect.W), Height: float64(dstRect.H)},
+			Color:     sprite.Color,
+		})
+		return nil
 	}
 
 	// Apply color tint
@@ -93,7 +123,7 @@ func (r *Renderer) DrawSprite(sprite *Sprite, transform gamemath.Transform, came
 		srcRect,
 		dstRect,
 		transform.Rotation, // Rotation angle in degrees
-		nil,                // Center point (nil = center of sprite)
+		center,             // Rotation point (the sprite pivot)
 		flip,
 	); err != nil {
 		return fmt.Errorf("failed to render sprite: %w", err)
@@ -102,6 +132,256 @@ func (r *Renderer) DrawSprite(sprite *Sprite, transform gamemath.Transform, came
 	return nil
 }
 
+// skipDestroyed reports a sprite whose texture was destroyed, once per texture.
+func (r *Renderer) skipDestroyed(sprite *Sprite) {
+	if r.warned[sprite.Texture] {
+		return
+	}
+	if r.warned == nil {
+		r.warned = make(map[*Texture]bool)
+	}
+	r.warned[sprite.Texture] = true
+	if r.OnDestroyedTexture != nil {
+		r.OnDestroyedTexture(sprite)
+	}
+}
+
+// spritePlacement returns a sprite's screen destination and its pivot
+// within the destination (the rotation point).
+func spritePlacement(sprite *Sprite, transform gamemath.Transform, camera *Camera) (*sdl.Rect, *sdl.Point) {
+	// Convert world position to screen position via camera
+	screenX, screenY := camera.WorldToScreen(transform.Position.X, transform.Position.Y)
+
+	// Calculate final dimensions with scale
+	finalWidth := int(sprite.SourceRect.Width * transform.Scale.X * camera.Zoom)
+	finalHeight := int(sprite.SourceRect.Height * transform.Scale.Y * camera.Zoom)
+
+	// Pivot offset in screen pixels (mirrored along flipped axes so the
+	// pivot stays on the same feature of the image)
+	pivotX := int(sprite.Pivot.X * transform.Scale.X * camera.Zoom)
+	pivotY := int(sprite.Pivot.Y * transform.Scale.Y * camera.Zoom)
+	if sprite.FlipH {
+		pivotX = -pivotX
+	}
+	if sprite.FlipV {
+		pivotY = -pivotY
+	}
+
+	// Create destination rectangle (where to render on screen)
+	// Place the pivot (the center by default) at the screen position
+	dstRect := &sdl.Rect{
+		X: int32(screenX - finalWidth/2 - pivotX),
+		Y: int32(screenY - finalHeight/2 - pivotY),
+		W: int32(finalWidth),
+		H: int32(finalHeight),
+	}
+	center := &sdl.Point{
+		X: int32(finalWidth/2 + pivotX),
+		Y: int32(finalHeight/2 + pivotY),
+	}
+	return dstRect, center
+}
+
+// DrawRect draws a rectangle outline in screen space.
+//
+// Parameters:
+//
+//	rect: Screen-space rectangle in pixels
+//	color: Outline color (alpha is blended)
+//
+// Example:
+//
+//	renderer.DrawRect(gamemath.Rectangle{X: 10, Y: 10, Width: 100, Height: 50}, gamemath.Green)
+func (r *Renderer) DrawRect(rect gamemath.Rectangle, color gamemath.Color) error {
+	r.drawCalls++
+	if r.record != nil {
+		r.record(DrawCall{Kind: DrawKindRect, Screen: rect, Color: color})
+		return nil
+	}
+	if err := r.setDrawColor(color); err != nil {
+		return err
+	}
+	sdlRect := toSDLRect(rect)
+	if err := r.sdlRenderer.DrawRect(&sdlRect); err != nil {
+		return fmt.Errorf("failed to draw rect: %w", err)
+	}
+	return nil
+}
+
+// FillRect draws a filled rectangle in screen space.
+//
+// Parameters:
+//
+//	rect: Screen-space rectangle in pixels
+//	color: Fill color (alpha is blended)
+func (r *Renderer) FillRect(rect gamemath.Rectangle, color gamemath.Color) error {
+	r.drawCalls++
+	if r.record != nil {
+		r.record(DrawCall{Kind: DrawKindFillRect, Screen: rect, Color: color})
+		return nil
+	}
+	if err := r.setDrawColor(color); err != nil {
+		return err
+	}
+	sdlRect := toSDLRect(rect)
+	if err := r.sdlRenderer.FillRect(&sdlRect); err != nil {
+		return fmt.Errorf("failed to fill rect: %w", err)
+	}
+	return nil
+}
+
+// DrawLine draws a line between two screen-space points.
+//
+// Parameters:
+//
+//	x1, y1: Start point in pixels
+//	x2, y2: End point in pixels
+//	color: Line color (alpha is blended)
+func (r *Renderer) DrawLine(x1, y1, x2, y2 float64, color gamemath.Color) error {
+	r.drawCalls++
+	if r.record != nil {
+		r.record(DrawCall{Kind: DrawKindLine, From: gamemath.Vector2{X: x1, Y: y1}, To: gamemath.Vector2{X: x2, Y: y2}, Color: color})
+		return nil
+	}
+	if err := r.setDrawColor(color); err != nil {
+		return err
+	}
+	if err := r.sdlRenderer.DrawLineF(float32(x1), float32(y1), float32(x2), float32(y2)); err != nil {
+		return fmt.Errorf("failed to draw line: %w", err)
+	}
+	return nil
+}
+
+// setDrawColor sets the draw color with alpha blending enabled.
+func (r *Renderer) setDrawColor(color gamemath.Color) error {
+	if err := r.sdlRenderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		return fmt.Errorf("failed to set blend mode: %w", err)
+	}
+	if err := r.sdlRenderer.SetDrawColor(color.R, color.G, color.B, color.A); err != nil {
+		return fmt.Errorf("failed to set draw color: %w", err)
+	}
+	return nil
+}
+
+// toSDLRect converts a float rectangle to an SDL integer rectangle.
+func toSDLRect(rect gamemath.Rectangle) sdl.Rect {
+	return sdl.Rect{
+		X: int32(rect.X),
+		Y: int32(rect.Y),
+		W: int32(rect.Width),
+		H: int32(rect.Height),
+	}
+}
+
+// NewRenderTarget creates a transparent texture that can be drawn into.
+//
+// Parameters:
+//
+//	width, height: Texture size in pixels
+//
+// Returns:
+//
+//	*Texture: Target texture (alpha blended when drawn as a sprite)
+//	error: Non-nil if the renderer doesn't support render targets
+//
+// Example:
+//
+//	minimap, _ := renderer.NewRenderTarget(128, 128)
+//	_ = renderer.SetRenderTarget(minimap)
+//	// ... draw ...
+//	_ = renderer.SetRenderTarget(nil)
+func (r *Renderer) NewRenderTarget(width, height int) (*Texture, error) {
+	if r.sdlRenderer == nil {
+		return nil, ErrNoSDLRenderer
+	}
+	sdlTexture, err := r.sdlRenderer.CreateTexture(
+		uint32(sdl.PIXELFORMAT_ABGR8888),
+		sdl.TEXTUREACCESS_TARGET,
+		int32(width),
+		int32(height),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create render target: %w", err)
+	}
+	if err := sdlTexture.SetBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		_ = sdlTexture.Destroy() // Best effort cleanup
+		return nil, fmt.Errorf("failed to set blend mode: %w", err)
+	}
+	return NewTexture(sdlTexture, width, height, ""), nil
+}
+
+// NewStreamingTexture creates a blank RGBA texture whose pixels are uploaded from the CPU.
+//
+// Pixel data uses R, G, B, A byte order (image.RGBA layout).
+//
+// Returns:
+//
+//	*Texture: Alpha-blended streaming texture
+//	error: Non-nil if texture creation fails
+func (r *Renderer) NewStreamingTexture(width, height int) (*Texture, error) {
+	if r.sdlRenderer == nil {
+		return nil, ErrNoSDLRenderer
+	}
+	sdlTexture, err := r.sdlRenderer.CreateTexture(
+		uint32(sdl.PIXELFORMAT_ABGR8888),
+		sdl.TEXTUREACCESS_STREAMING,
+		int32(width),
+		int32(height),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create streaming texture: %w", err)
+	}
+	if err := sdlTexture.SetBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		_ = sdlTexture.Destroy() // Best effort cleanup
+		return nil, fmt.Errorf("failed to set blend mode: %w", err)
+	}
+	return NewTexture(sdlTexture, width, height, ""), nil
+}
+
+// NewTextureFromImage uploads an image as an alpha-blended texture
+//
+// Parameters:
+//
+//	img: Image to upload; *image.RGBA pixels (e.g. from GenerateImage) are
+//	     used as-is, with straight alpha, and other images are converted
+//
+// Returns:
+//
+//	*Texture: Streaming texture the caller owns (Destroy it when done);
+//	          Texture.UpdateRegion can change it later
+//	error: Non-nil if texture creation or upload fails
+//
+// Example:
+//
+//	checker, err := renderer.NewTextureFromImage(graphics.CheckerImage(64, 64, 8, gamemath.White, gamemath.Black))
+func (r *Renderer) NewTextureFromImage(img image.Image) (*Texture, error) {
+	if img.Bounds().Empty() {
+		return nil, fmt.Errorf("failed to create texture: empty image")
+	}
+	rgba, ok := img.(*image.RGBA)
+	if !ok || rgba.Rect.Min != (image.Point{}) {
+		converted := convertImage(img)
+		defer converted.release()
+		rgba = &image.RGBA{Pix: converted.pixels, Stride: converted.width * 4, Rect: image.Rect(0, 0, converted.width, converted.height)}
+	}
+	return r.uploadImage(rgba)
+}
+
+// SetRenderTarget redirects drawing to a texture created by NewRenderTarget (nil = screen).
+func (r *Renderer) SetRenderTarget(texture *Texture) error {
+	if r.sdlRenderer == nil {
+		return ErrNoSDLRenderer
+	}
+	var sdlTexture *sdl.Texture
+	if texture != nil {
+		sdlTexture = texture.sdlTexture
+	}
+	if err := r.sdlRenderer.SetRenderTarget(sdlTexture); err != nil {
+		return fmt.Errorf("failed to set render target: %w", err)
+	}
+	return nil
+}
+
 // Destroy releases renderer resources.
 func (r *Renderer) Destroy() error {
 	if r.sdlRenderer != nil {
@@ -10,6 +10,7 @@ type Sprite struct {
 	Alpha      float64            // Opacity (0.0 = transparent, 1.0 = opaque)
 	FlipH      bool               // Flip horizontally
 	FlipV      bool               // Flip vertically
+	Pivot      gamemath.Vector2   // Rotation/placement point in source pixels from the center (0,0 = center)
 }
 
 // NewSprite creates a sprite from a texture
@@ -27,7 +28,7 @@ type Sprite struct {
 //	texture, _ := assets.LoadTexture("player.png")
 //	sprite := graphics.NewSprite(texture)
 func NewSprite(texture *Texture) *Sprite {
-	return &Sprite{
+	sprite := &Sprite{
 		Texture: texture,
 		SourceRect: gamemath.Rectangle{
 			X:      0,
@@ -40,6 +41,10 @@ func NewSprite(texture *Texture) *Sprite {
 		FlipH: false,
 		FlipV: false,
 	}
+	if d := textureDiagnostics.Load(); d != nil {
+		d.trackSprite(sprite)
+	}
+	return sprite
 }
 
 // SetSourceRect sets the sprite sheet region
@@ -74,3 +79,22 @@ func (s *Sprite) SetSourceRect(x, y, width, height int) {
 func (s *Sprite) SetColor(color gamemath.Color) {
 	s.Color = color
 }
+
+// SetPivot sets the pivot in normalized sprite coordinates.
+//
+// The pivot is drawn at the entity position and rotation happens around it.
+//
+// Parameters:
+//
+//	u, v: Pivot as a fraction of the source size (0,0 = top-left, 0.5,0.5 = center)
+//
+// Example:
+//
+//	door.Sprite.SetPivot(0, 0.5)      // Swing around the left edge (hinge)
+//	turret.Sprite.SetPivot(0.5, 0.8)  // Rotate around the mount near the base
+func (s *Sprite) SetPivot(u, v float64) {
+	s.Pivot = gamemath.Vector2{
+		X: (u - 0.5) * s.SourceRect.Width,
+		Y: (v - 0.5) * s.SourceRect.Height,
+	}
+}
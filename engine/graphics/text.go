@@ -10,8 +10,9 @@ import (
 
 // Font represents a loaded TTF font.
 type Font struct {
-	font *ttf.Font
-	size int
+	font   *ttf.Font
+	size   int
+	glyphs map[rune]cachedGlyph // Single-character masks for letter-spaced text
 }
 
 // LoadFont loads a TTF font from file.
@@ -46,6 +47,18 @@ func (f *Font) Close() {
 	if f.font != nil {
 		f.font.Close()
 	}
+	f.glyphs = nil
+}
+
+// MeasureText returns the size of text rendered with this font.
+//
+// Returns:
+//
+//	width: Text width in pixels
+//	height: Text height in pixels
+//	error: Non-nil if measurement fails
+func (f *Font) MeasureText(text string) (int, int, error) {
+	return f.font.SizeUTF8(text)
 }
 
 // RenderText renders text to a texture.
@@ -148,6 +161,5 @@ func (tr *TextRenderer) DrawText(text string, x, y int, color gamemath.Color) er
 //	height: Text height in pixels
 //	error: Non-nil if measurement fails
 func (tr *TextRenderer) MeasureText(text string) (int, int, error) {
-	w, h, err := tr.font.font.SizeUTF8(text)
-	return w, h, err
+	return tr.font.MeasureText(text)
 }
@@ -0,0 +1,313 @@
+package graphics
+
+import (
+	"image"
+	"image/draw"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// TextAlign is the horizontal alignment of lines in a text block.
+type TextAlign int
+
+const (
+	AlignLeft TextAlign = iota
+	AlignCenter
+	AlignRight
+)
+
+// defaultEllipsis marks text cut off by TextLayout.MaxLines.
+const defaultEllipsis = "..."
+
+// TextLayout describes how text is broken into lines, for dialogue boxes,
+// item descriptions, and other multi-line text.
+//
+// The zero value lays out text as-is: one line per "\n", left aligned.
+type TextLayout struct {
+	Width       int       // Wrap width in pixels (0 = break only at "\n")
+	Align       TextAlign // Line alignment within Width (or the widest line)
+	LineSpacing float64   // Line height multiplier (0 = 1, the font's line skip)
+	MaxLines    int       // Lines kept; the last one ends in Ellipsis (0 = unlimited)
+	Ellipsis    string    // Marker for cut-off text ("" = "...")
+}
+
+// TextLine is one laid-out line.
+type TextLine struct {
+	Text  string
+	X, Y  int // Top-left corner within the block
+	Width int
+}
+
+// TextBlock is laid-out text.
+type TextBlock struct {
+	Lines     []TextLine
+	Width     int  // Layout width, or the widest line without a wrap width
+	Height    int  // Top of the first line to bottom of the last
+	Truncated bool // Text was cut off at MaxLines
+}
+
+// Layout breaks text into positioned lines
+//
+// Parameters:
+//
+//	text: Text to lay out ("\n" forces a line break)
+//	lineHeight: Height of one line in pixels
+//	measure: Returns the width of a string in pixels
+//
+// Returns:
+//
+//	TextBlock: Lines with positions and the block size
+//
+// Behavior:
+//   - Lines wrap at spaces; a word wider than Width is broken between
+//     characters
+//   - Paragraphs that fit are kept as-is; wrapped ones have runs of
+//     spaces collapsed
+//   - With MaxLines, the last kept line is shortened until it fits with
+//     the ellipsis appended
+func (l TextLayout) Layout(text string, lineHeight int, measure func(string) int) TextBlock {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		lines = append(lines, l.wrap(paragraph, measure)...)
+	}
+
+	block := TextBlock{}
+	if l.MaxLines > 0 && len(lines) > l.MaxLines {
+		lines = lines[:l.MaxLines]
+		lines[len(lines)-1] = l.ellipsize(lines[len(lines)-1], measure)
+		block.Truncated = true
+	}
+
+	widths := make([]int, len(lines))
+	for i, line := range lines {
+		widths[i] = measure(line)
+		block.Width = max(block.Width, widths[i])
+	}
+	if l.Width > 0 {
+		block.Width = l.Width
+	}
+
+	spacing := l.LineSpacing
+	if spacing <= 0 {
+		spacing = 1
+	}
+	step := int(math.Round(float64(lineHeight) * spacing))
+	for i, line := range lines {
+		x := 0
+		switch l.Align {
+		case AlignCenter:
+			x = (block.Width - widths[i]) / 2
+		case AlignRight:
+			x = block.Width - widths[i]
+		}
+		block.Lines = append(block.Lines, TextLine{Text: line, X: x, Y: i * step, Width: widths[i]})
+	}
+	if len(lines) > 0 {
+		block.Height = (len(lines)-1)*step + lineHeight
+	}
+	return block
+}
+
+// wrap breaks one paragraph into lines no wider than Width.
+func (l TextLayout) wrap(paragraph string, measure func(string) int) []string {
+	if l.Width <= 0 || measure(paragraph) <= l.Width {
+		return []string{paragraph}
+	}
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(paragraph) {
+		if line != "" && measure(line+" "+word) <= l.Width {
+			line += " " + word
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		// Break words that don't fit on a line of their own
+		for measure(word) > l.Width && utf8.RuneCountInString(word) > 1 {
+			cut := l.fitRunes(word, measure)
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	return append(lines, line)
+}
+
+// fitRunes returns the byte length of the longest prefix of s (at least
+// one character) no wider than Width.
+func (l TextLayout) fitRunes(s string, measure func(string) int) int {
+	_, cut := utf8.DecodeRuneInString(s)
+	for i := range s {
+		if i <= cut {
+			continue
+		}
+		if measure(s[:i]) > l.Width {
+			break
+		}
+		cut = i
+	}
+	return cut
+}
+
+// ellipsize shortens a line until it fits Width with the ellipsis appended.
+func (l TextLayout) ellipsize(line string, measure func(string) int) string {
+	ellipsis := l.Ellipsis
+	if ellipsis == "" {
+		ellipsis = defaultEllipsis
+	}
+	for l.Width > 0 && line != "" && measure(line+ellipsis) > l.Width {
+		_, size := utf8.DecodeLastRuneInString(line)
+		line = strings.TrimRight(line[:len(line)-size], " ")
+	}
+	return line + ellipsis
+}
+
+// LayoutText lays out text with this font's metrics
+//
+// Parameters:
+//
+//	text: Text to lay out
+//	layout: Wrap width, alignment, spacing, and line limit
+//	letterSpacing: Extra pixels between characters (as in TextStyle)
+//
+// Returns:
+//
+//	TextBlock: Positioned lines
+//	error: Non-nil if measuring fails
+//
+// Example:
+//
+//	block, _ := font.LayoutText(description, graphics.TextLayout{Width: 240, MaxLines: 4}, 0)
+//	panelHeight := block.Height + 16
+func (f *Font) LayoutText(text string, layout TextLayout, letterSpacing int) (TextBlock, error) {
+	var measureErr error
+	measure := func(s string) int {
+		if s == "" {
+			return 0
+		}
+		width, _, err := f.MeasureText(s)
+		if err != nil && measureErr == nil {
+			measureErr = err
+		}
+		return width + letterSpacing*(utf8.RuneCountInString(s)-1)
+	}
+	block := layout.Layout(text, f.font.LineSkip(), measure)
+	return block, measureErr
+}
+
+// RenderTextBlock renders multi-line text to a texture
+//
+// Parameters:
+//
+//	renderer: Renderer that owns the texture
+//	text: Text to render
+//	color: Fill color
+//	layout: Wrap width, alignment, spacing, and line limit
+//	style: Outline, shadow, and other effects (zero = plain)
+//
+// Returns:
+//
+//	*Texture: The text block (grown by style.Padding)
+//	TextBlock: Line positions within the block, before padding
+//	error: Non-nil if rendering or upload fails
+func (f *Font) RenderTextBlock(renderer *Renderer, text string, color gamemath.Color, layout TextLayout, style TextStyle) (*Texture, TextBlock, error) {
+	return f.renderTextBlock(renderer, text, color, layout, style, -1)
+}
+
+// renderTextBlock renders a text block showing only its first visible
+// glyphs (negative = all), at their positions in the full layout.
+func (f *Font) renderTextBlock(renderer *Renderer, text string, color gamemath.Color, layout TextLayout, style TextStyle, visible int) (*Texture, TextBlock, error) {
+	block, err := f.LayoutText(text, layout, style.LetterSpacing)
+	if err != nil {
+		return nil, block, err
+	}
+	mask := image.NewAlpha(image.Rect(0, 0, max(block.Width, 1), max(block.Height, 1)))
+	for _, line := range block.Lines {
+		lineText := line.Text
+		if visible >= 0 {
+			var used int
+			lineText, used = glyphPrefix(lineText, visible)
+			visible -= used
+		}
+		if strings.TrimSpace(lineText) == "" {
+			continue
+		}
+		lineMask, err := f.TextMask(lineText, style.LetterSpacing)
+		if err != nil {
+			return nil, block, err
+		}
+		at := image.Pt(line.X, line.Y)
+		draw.Draw(mask, lineMask.Bounds().Add(at), lineMask, image.Point{}, draw.Over)
+	}
+	texture, err := renderer.uploadImage(style.Stylize(mask, color))
+	return texture, block, err
+}
+
+// GlyphCount returns the number of non-space characters in text, the
+// units revealed by typewriter effects (see TextSprite.HiddenChars).
+func GlyphCount(text string) int {
+	count := 0
+	for _, ch := range text {
+		if !unicode.IsSpace(ch) {
+			count++
+		}
+	}
+	return count
+}
+
+// glyphPrefix returns the longest prefix of text with at most n non-space
+// characters (without trailing spaces) and how many it has.
+func glyphPrefix(text string, n int) (string, int) {
+	count, end := 0, 0
+	for i, ch := range text {
+		if unicode.IsSpace(ch) {
+			continue
+		}
+		if count == n {
+			break
+		}
+		count++
+		end = i + utf8.RuneLen(ch)
+	}
+	return text[:end], count
+}
+
+// DrawTextBlock renders wrapped, aligned text at a screen position.
+//
+// Parameters:
+//
+//	text: Text to render
+//	x, y: Screen position of the block's top-left corner
+//	color: Fill color
+//	layout: Wrap width, alignment, spacing, and line limit
+//	style: Outline, shadow, and other effects (zero = plain)
+//
+// Example:
+//
+//	box := graphics.TextLayout{Width: 560, LineSpacing: 1.2, MaxLines: 3}
+//	err := textRenderer.DrawTextBlock(line.Text, 40, 420, gamemath.White, box, graphics.TextStyle{})
+func (tr *TextRenderer) DrawTextBlock(text string, x, y int, color gamemath.Color, layout TextLayout, style TextStyle) error {
+	if text == "" {
+		return nil
+	}
+	texture, _, err := tr.font.RenderTextBlock(NewRenderer(tr.renderer), text, color, layout, style)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = texture.Destroy() }() // Best effort cleanup
+
+	left, top, _, _ := style.Padding()
+	destRect := sdl.Rect{
+		X: int32(x - left),
+		Y: int32(y - top),
+		W: int32(texture.Width),
+		H: int32(texture.Height),
+	}
+	return tr.renderer.Copy(texture.sdlTexture, nil, &destRect)
+}
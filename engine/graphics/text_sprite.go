@@ -0,0 +1,173 @@
+package graphics
+
+import (
+	"fmt"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TextSprite is text attached to an entity and drawn like a sprite.
+//
+// The text is rasterized once and the texture reused every frame; it is
+// re-rendered only when Text or Font changes. Color is applied as a tint,
+// so color and alpha changes (flashes, fades) cost nothing.
+//
+// A Style (outline, shadow, ...) or Layout (wrapping, alignment) is baked
+// into the texture with the text color, so with either, changing Color's
+// RGB re-renders; alpha is still free.
+//
+// HiddenChars hides the end of the text while keeping the layout of the
+// full text, so words don't jump between lines as a typewriter effect
+// reveals them.
+type TextSprite struct {
+	Text   string
+	Font   *Font
+	Color  gamemath.Color   // Tint and opacity (A)
+	Anchor gamemath.Vector2 // Point placed at the entity position, as a fraction of the text size (0.5, 0.5 = center)
+	Style  TextStyle        // Outline, shadow, bold/italic, letter spacing (zero = plain)
+	Layout TextLayout       // Wrap width, alignment, line spacing, max lines (zero = single line)
+
+	HiddenChars int // Trailing non-space characters not drawn (see GlyphCount)
+
+	sprite         Sprite // Cached texture and source rect
+	renderedText   string
+	renderedFont   *Font
+	renderedStyle  TextStyle
+	renderedLayout TextLayout
+	renderedHidden int
+	renderedColor  gamemath.Color // Fill baked into a styled texture (alpha ignored)
+}
+
+// NewTextSprite creates white text centered on its entity.
+//
+// Example:
+//
+//	name := &core.Entity{Active: true, Layer: 5}
+//	name.Text = graphics.NewTextSprite("Player 1", font)
+//	name.Text.Anchor = gamemath.Vector2{X: 0.5, Y: 1} // Bottom edge at the entity
+func NewTextSprite(text string, font *Font) *TextSprite {
+	return &TextSprite{
+		Text:   text,
+		Font:   font,
+		Color:  gamemath.White,
+		Anchor: gamemath.Vector2{X: 0.5, Y: 0.5},
+	}
+}
+
+// Stale reports whether the next draw will re-render the text texture.
+func (t *TextSprite) Stale() bool {
+	if t.sprite.Texture == nil || t.Text != t.renderedText || t.Font != t.renderedFont ||
+		t.Style != t.renderedStyle || t.Layout != t.renderedLayout || t.HiddenChars != t.renderedHidden {
+		return true
+	}
+	return t.baked() && t.opaqueColor() != t.renderedColor
+}
+
+// baked reports whether the text color is rendered into the texture.
+func (t *TextSprite) baked() bool {
+	return t.Style != (TextStyle{}) || t.Layout != (TextLayout{}) || t.HiddenChars > 0
+}
+
+// VisibleText returns the part of Text left showing by HiddenChars.
+func (t *TextSprite) VisibleText() string {
+	if t.HiddenChars <= 0 {
+		return t.Text
+	}
+	visible, _ := glyphPrefix(t.Text, max(GlyphCount(t.Text)-t.HiddenChars, 0))
+	return visible
+}
+
+// opaqueColor returns Color with full alpha (the part baked into styled text).
+func (t *TextSprite) opaqueColor() gamemath.Color {
+	return gamemath.Color{R: t.Color.R, G: t.Color.G, B: t.Color.B, A: 255}
+}
+
+// Size returns the rendered text size in pixels (zero before the first draw).
+func (t *TextSprite) Size() (width, height int) {
+	if t.sprite.Texture == nil {
+		return 0, 0
+	}
+	return t.sprite.Texture.Width, t.sprite.Texture.Height
+}
+
+// Destroy releases the cached texture; the next draw renders it again.
+func (t *TextSprite) Destroy() {
+	if t.sprite.Texture != nil {
+		_ = t.sprite.Texture.Destroy() // Best effort cleanup
+		t.sprite.Texture = nil
+	}
+	t.renderedText, t.renderedFont = "", nil
+	t.renderedStyle, t.renderedColor = TextStyle{}, gamemath.Color{}
+	t.renderedLayout, t.renderedHidden = TextLayout{}, 0
+}
+
+// refresh re-renders the texture if the text or font changed.
+func (t *TextSprite) refresh(renderer *Renderer) error {
+	if !t.Stale() {
+		return nil
+	}
+	t.Destroy()
+	switch {
+	case t.Layout != (TextLayout{}) || t.HiddenChars > 0:
+		visible := -1
+		if t.HiddenChars > 0 {
+			visible = max(GlyphCount(t.Text)-t.HiddenChars, 0)
+		}
+		texture, _, err := t.Font.renderTextBlock(renderer, t.Text, t.opaqueColor(), t.Layout, t.Style, visible)
+		if err != nil {
+			return fmt.Errorf("failed to render text sprite: %w", err)
+		}
+		t.sprite.Texture = texture
+		t.renderedStyle, t.renderedLayout, t.renderedColor = t.Style, t.Layout, t.opaqueColor()
+		t.renderedHidden = t.HiddenChars
+	case t.Style != (TextStyle{}):
+		texture, err := t.Font.RenderStyledText(renderer, t.Text, t.opaqueColor(), t.Style)
+		if err != nil {
+			return fmt.Errorf("failed to render text sprite: %w", err)
+		}
+		t.sprite.Texture = texture
+		t.renderedStyle, t.renderedColor = t.Style, t.opaqueColor()
+	default:
+		texture, width, height, err := t.Font.RenderText(renderer.sdlRenderer, t.Text, gamemath.White)
+		if err != nil {
+			return fmt.Errorf("failed to render text sprite: %w", err)
+		}
+		t.sprite.Texture = NewTexture(texture, int(width), int(height), "")
+	}
+	t.sprite.SourceRect = gamemath.Rectangle{Width: float64(t.sprite.Texture.Width), Height: float64(t.sprite.Texture.Height)}
+	t.renderedText, t.renderedFont = t.Text, t.Font
+	return nil
+}
+
+// DrawTextSprite draws text through the camera like a sprite
+//
+// Parameters:
+//
+//	text: Text to draw (nothing is drawn for empty text or a nil font)
+//	transform: Position, rotation, and scale
+//	camera: Camera for view transform
+//
+// Returns:
+//
+//	error: Non-nil if rendering the text texture fails
+func (r *Renderer) DrawTextSprite(text *TextSprite, transform gamemath.Transform, camera *Camera) error {
+	if text == nil || text.Font == nil || text.Text == "" {
+		return nil
+	}
+	if r.record != nil {
+		r.drawCalls++
+		r.record(DrawCall{Kind: DrawKindText, Text: text.VisibleText(), Transform: transform, Color: text.Color})
+		return nil
+	}
+	if err := text.refresh(r); err != nil {
+		return err
+	}
+	sprite := &text.sprite
+	sprite.Color = text.opaqueColor()
+	if text.baked() {
+		sprite.Color = gamemath.White // Colors are baked in
+	}
+	sprite.Alpha = float64(text.Color.A) / 255
+	sprite.SetPivot(text.Anchor.X, text.Anchor.Y)
+	return r.DrawSprite(sprite, transform, camera)
+}
@@ -0,0 +1,312 @@
+package graphics
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"unsafe"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// italicSlant is the horizontal shear of synthesized italics (about 12 degrees).
+const italicSlant = 0.2
+
+// TextStyle adds legibility effects to rendered text.
+//
+// Effects are synthesized from the font's plain glyphs, so they work with
+// any TTF font. The zero value renders plain text.
+type TextStyle struct {
+	OutlineWidth  int              // Outline thickness in pixels (0 = none)
+	OutlineColor  gamemath.Color   // Outline color
+	ShadowOffset  gamemath.Vector2 // Drop shadow offset in pixels (zero = no shadow)
+	ShadowColor   gamemath.Color   // Shadow color (alpha for softness)
+	Bold          bool             // Thicken strokes by one pixel
+	Italic        bool             // Slant glyphs to the right
+	LetterSpacing int              // Extra pixels between characters (negative tightens)
+}
+
+// Padding returns how far the styled image extends past the plain text on
+// each side, in pixels.
+func (s TextStyle) Padding() (left, top, right, bottom int) {
+	left, top, right, bottom = s.OutlineWidth, s.OutlineWidth, s.OutlineWidth, s.OutlineWidth
+	dx, dy := int(math.Round(s.ShadowOffset.X)), int(math.Round(s.ShadowOffset.Y))
+	if dx > 0 {
+		right += dx
+	} else {
+		left -= dx
+	}
+	if dy > 0 {
+		bottom += dy
+	} else {
+		top -= dy
+	}
+	return left, top, right, bottom
+}
+
+// Stylize turns a coverage mask of plain text into a styled image
+//
+// Parameters:
+//
+//	mask: Text coverage (e.g. from Font.TextMask)
+//	fill: Text color
+//
+// Returns:
+//
+//	*image.RGBA: Shadow, outline, and fill composited back to front, grown
+//	             by Padding (plus the italic slant)
+//
+// Example:
+//
+//	mask, _ := font.TextMask("Score: 100", 0)
+//	img := graphics.TextStyle{OutlineWidth: 2, OutlineColor: gamemath.Black}.Stylize(mask, gamemath.White)
+func (s TextStyle) Stylize(mask *image.Alpha, fill gamemath.Color) *image.RGBA {
+	if s.Bold {
+		mask = dilateAlpha(mask, 1, 0)
+	}
+	if s.Italic {
+		mask = shearAlpha(mask, italicSlant)
+	}
+	left, top, right, bottom := s.Padding()
+	size := mask.Bounds().Size()
+	out := image.NewRGBA(image.Rect(0, 0, size.X+left+right, size.Y+top+bottom))
+	origin := image.Pt(left, top)
+
+	body := mask
+	if s.OutlineWidth > 0 {
+		body = dilateAlpha(mask, s.OutlineWidth, s.OutlineWidth)
+		origin = origin.Sub(image.Pt(s.OutlineWidth, s.OutlineWidth))
+	}
+	if s.ShadowOffset != (gamemath.Vector2{}) && s.ShadowColor.A > 0 {
+		offset := image.Pt(int(math.Round(s.ShadowOffset.X)), int(math.Round(s.ShadowOffset.Y)))
+		paintMask(out, body, origin.Add(offset), s.ShadowColor)
+	}
+	if s.OutlineWidth > 0 {
+		paintMask(out, body, origin, s.OutlineColor)
+	}
+	paintMask(out, mask, image.Pt(left, top), fill)
+	return out
+}
+
+// paintMask composites a solid color through a mask at an offset.
+func paintMask(dst *image.RGBA, mask *image.Alpha, at image.Point, c gamemath.Color) {
+	src := image.NewUniform(color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A})
+	rect := mask.Bounds().Add(at)
+	draw.DrawMask(dst, rect, src, image.Point{}, mask, mask.Bounds().Min, draw.Over)
+}
+
+// dilateAlpha grows coverage by rx, ry pixels (elliptical max filter).
+// The result is padded so nothing is clipped.
+func dilateAlpha(mask *image.Alpha, rx, ry int) *image.Alpha {
+	size := mask.Bounds().Size()
+	out := image.NewAlpha(image.Rect(0, 0, size.X+2*rx, size.Y+2*ry))
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			a := mask.Pix[y*mask.Stride+x]
+			if a == 0 {
+				continue
+			}
+			for dy := -ry; dy <= ry; dy++ {
+				for dx := -rx; dx <= rx; dx++ {
+					if rx > 0 && ry > 0 && float64(dx*dx)/float64(rx*rx)+float64(dy*dy)/float64(ry*ry) > 1.0001 {
+						continue
+					}
+					i := (y+ry+dy)*out.Stride + x + rx + dx
+					if a > out.Pix[i] {
+						out.Pix[i] = a
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// shearAlpha slants a mask to the right by slant pixels per row of height.
+func shearAlpha(mask *image.Alpha, slant float64) *image.Alpha {
+	size := mask.Bounds().Size()
+	extra := int(math.Ceil(float64(size.Y) * slant))
+	out := image.NewAlpha(image.Rect(0, 0, size.X+extra, size.Y))
+	for y := 0; y < size.Y; y++ {
+		shift := float64(size.Y-1-y) * slant
+		whole := int(shift)
+		frac := shift - float64(whole)
+		for x := 0; x < size.X; x++ {
+			a := float64(mask.Pix[y*mask.Stride+x])
+			if a == 0 {
+				continue
+			}
+			i := y*out.Stride + x + whole
+			out.Pix[i] = uint8(math.Min(255, float64(out.Pix[i])+a*(1-frac)))
+			out.Pix[i+1] = uint8(math.Min(255, float64(out.Pix[i+1])+a*frac))
+		}
+	}
+	return out
+}
+
+// TextMask renders text as a coverage mask
+//
+// Parameters:
+//
+//	text: Text to render
+//	letterSpacing: Extra pixels between characters (0 = the font's spacing)
+//
+// Returns:
+//
+//	*image.Alpha: Coverage, one byte per pixel
+//	error: Non-nil if rendering fails
+//
+// Behavior:
+//   - With letter spacing, characters are placed one by one from a
+//     per-font glyph cache, so repeated text renders only new characters
+func (f *Font) TextMask(text string, letterSpacing int) (*image.Alpha, error) {
+	if letterSpacing == 0 {
+		surface, err := f.font.RenderUTF8Blended(text, sdl.Color{R: 255, G: 255, B: 255, A: 255})
+		if err != nil {
+			return nil, fmt.Errorf("failed to render text surface: %w", err)
+		}
+		defer surface.Free()
+		return surfaceAlpha(surface), nil
+	}
+
+	glyphs := make([]*image.Alpha, 0, len(text))
+	advances := make([]int, 0, len(text))
+	width, height := 0, 0
+	for _, ch := range text {
+		glyph, advance, err := f.glyph(ch)
+		if err != nil {
+			return nil, err
+		}
+		glyphs = append(glyphs, glyph)
+		advances = append(advances, advance)
+		height = max(height, glyph.Bounds().Dy())
+	}
+	x := 0
+	for i, glyph := range glyphs {
+		width = max(width, x+glyph.Bounds().Dx())
+		x += advances[i]
+		if i < len(glyphs)-1 {
+			x += letterSpacing
+		}
+	}
+	out := image.NewAlpha(image.Rect(0, 0, max(width, 1), max(height, 1)))
+	x = 0
+	for i, glyph := range glyphs {
+		draw.Draw(out, glyph.Bounds().Add(image.Pt(x, 0)), glyph, image.Point{}, draw.Over)
+		x += advances[i] + letterSpacing
+	}
+	return out, nil
+}
+
+// glyph returns a cached single-character mask and its advance.
+func (f *Font) glyph(ch rune) (*image.Alpha, int, error) {
+	if cached, ok := f.glyphs[ch]; ok {
+		return cached.mask, cached.advance, nil
+	}
+	metrics, err := f.font.GlyphMetrics(ch)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to measure glyph %q: %w", ch, err)
+	}
+	surface, err := f.font.RenderUTF8Blended(string(ch), sdl.Color{R: 255, G: 255, B: 255, A: 255})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to render glyph %q: %w", ch, err)
+	}
+	defer surface.Free()
+	mask := surfaceAlpha(surface)
+	if f.glyphs == nil {
+		f.glyphs = make(map[rune]cachedGlyph)
+	}
+	f.glyphs[ch] = cachedGlyph{mask: mask, advance: metrics.Advance}
+	return mask, metrics.Advance, nil
+}
+
+// cachedGlyph is one rendered character in a font's glyph cache.
+type cachedGlyph struct {
+	mask    *image.Alpha
+	advance int
+}
+
+// surfaceAlpha copies a surface's alpha channel.
+func surfaceAlpha(surface *sdl.Surface) *image.Alpha {
+	width, height := int(surface.W), int(surface.H)
+	mask := image.NewAlpha(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			_, _, _, a := surface.At(x, y).RGBA()
+			mask.Pix[y*mask.Stride+x] = uint8(a >> 8)
+		}
+	}
+	return mask
+}
+
+// RenderStyledText renders text with a style to a texture
+//
+// Parameters:
+//
+//	renderer: Renderer that owns the texture
+//	text: Text to render
+//	color: Fill color
+//	style: Outline, shadow, synthesized bold/italic, and letter spacing
+//
+// Returns:
+//
+//	*Texture: Styled text (grown by style.Padding around the glyphs)
+//	error: Non-nil if rendering or upload fails
+func (f *Font) RenderStyledText(renderer *Renderer, text string, color gamemath.Color, style TextStyle) (*Texture, error) {
+	mask, err := f.TextMask(text, style.LetterSpacing)
+	if err != nil {
+		return nil, err
+	}
+	return renderer.uploadImage(style.Stylize(mask, color))
+}
+
+// uploadImage creates a texture holding an RGBA image.
+func (r *Renderer) uploadImage(img *image.RGBA) (*Texture, error) {
+	bounds := img.Bounds()
+	texture, err := r.NewStreamingTexture(bounds.Dx(), bounds.Dy())
+	if err != nil {
+		return nil, err
+	}
+	if err := texture.sdlTexture.Update(nil, unsafe.Pointer(&img.Pix[0]), img.Stride); err != nil {
+		_ = texture.Destroy() // Best effort cleanup
+		return nil, fmt.Errorf("failed to upload texture pixels: %w", err)
+	}
+	return texture, nil
+}
+
+// DrawStyledText renders styled text at a screen position.
+//
+// Parameters:
+//
+//	text: Text to render
+//	x, y: Screen position of the plain text's top-left corner (outline and
+//	      shadow extend around it)
+//	color: Fill color
+//	style: Outline, shadow, and other effects
+//
+// Example:
+//
+//	hud := graphics.TextStyle{OutlineWidth: 2, OutlineColor: gamemath.Black}
+//	err := textRenderer.DrawStyledText("Score: 100", 10, 10, gamemath.White, hud)
+func (tr *TextRenderer) DrawStyledText(text string, x, y int, color gamemath.Color, style TextStyle) error {
+	if text == "" {
+		return nil
+	}
+	texture, err := tr.font.RenderStyledText(NewRenderer(tr.renderer), text, color, style)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = texture.Destroy() }() // Best effort cleanup
+
+	left, top, _, _ := style.Padding()
+	destRect := sdl.Rect{
+		X: int32(x - left),
+		Y: int32(y - top),
+		W: int32(texture.Width),
+		H: int32(texture.Height),
+	}
+	return tr.renderer.Copy(texture.sdlTexture, nil, &destRect)
+}
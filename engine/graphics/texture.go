@@ -1,6 +1,20 @@
 package graphics
 
-import "github.com/veandco/go-sdl2/sdl"
+import (
+	"errors"
+	"fmt"
+	"image"
+	"unsafe"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// ErrInvalidRegion is returned when a texture region update doesn't fit the
+// texture or its pixel data.
+var ErrInvalidRegion = errors.New("invalid texture region")
+
+// ErrTextureDestroyed is returned when updating a destroyed texture.
+var ErrTextureDestroyed = errors.New("texture destroyed")
 
 // Texture represents a loaded image texture.
 type Texture struct {
@@ -8,27 +22,122 @@ type Texture struct {
 	Width      int          // Texture width in pixels
 	Height     int          // Texture height in pixels
 	Path       string       // Source file path
+	destroyed  bool         // Destroy was called (drawing skips it)
 }
 
 // NewTexture creates a new texture wrapper around an SDL texture.
 func NewTexture(sdlTexture *sdl.Texture, width, height int, path string) *Texture {
-	return &Texture{
+	texture := &Texture{
 		sdlTexture: sdlTexture,
 		Width:      width,
 		Height:     height,
 		Path:       path,
 	}
+	if d := textureDiagnostics.Load(); d != nil {
+		d.trackTexture(texture)
+	}
+	return texture
 }
 
 // Destroy releases the SDL texture resources.
+//
+// Sprites still using the texture are skipped when drawn (see
+// Renderer.OnDestroyedTexture) instead of reaching SDL with a freed
+// handle. Destroying twice is a no-op.
 func (t *Texture) Destroy() error {
+	return t.destroy(true)
+}
+
+// destroy releases the SDL texture; checkSprites reports destruction
+// while sprites still use it (off for whole-cache teardown).
+func (t *Texture) destroy(checkSprites bool) error {
+	if t.destroyed {
+		return nil
+	}
+	t.destroyed = true
+	if d := textureDiagnostics.Load(); d != nil {
+		d.untrackTexture(t, checkSprites)
+	}
 	if t.sdlTexture != nil {
-		return t.sdlTexture.Destroy()
+		sdlTexture := t.sdlTexture
+		t.sdlTexture = nil
+		return sdlTexture.Destroy()
 	}
 	return nil
 }
 
+// Destroyed reports whether Destroy was called.
+func (t *Texture) Destroyed() bool {
+	return t.destroyed
+}
+
 // GetSDLTexture returns the underlying SDL texture (for internal use).
 func (t *Texture) GetSDLTexture() *sdl.Texture {
 	return t.sdlTexture
 }
+
+// UpdateRegion replaces the pixels of part of the texture
+//
+// Parameters:
+//
+//	rect: Region in texture pixels (must lie inside the texture)
+//	pixels: R, G, B, A bytes for the region, rect.Dx()*4 bytes per row
+//
+// Returns:
+//
+//	error: ErrInvalidRegion if rect is outside the texture or pixels is
+//	       too short; non-nil if the upload fails
+//
+// Behavior:
+//   - Only the region is uploaded, so small changes (a minimap marker, a
+//     revealed fog cell, a crater) don't re-create the whole texture
+//   - Works on any texture; streaming textures (Renderer.NewStreamingTexture)
+//     are the fastest to update
+//
+// Example:
+//
+//	cell := image.Rect(x*8, y*8, x*8+8, y*8+8)
+//	_ = fog.UpdateRegion(cell, clearPixels) // 8*8*4 bytes
+func (t *Texture) UpdateRegion(rect image.Rectangle, pixels []byte) error {
+	return t.updateRegion(rect, pixels, rect.Dx()*4)
+}
+
+// UpdateFromImage uploads a region of a CPU-side copy of the texture (an
+// image the same size as the texture), e.g. the dirty rectangle of a
+// bitmap edited every frame.
+//
+// Returns ErrInvalidRegion if rect is outside the texture or the image.
+func (t *Texture) UpdateFromImage(img *image.RGBA, rect image.Rectangle) error {
+	if !rect.In(img.Rect) {
+		return fmt.Errorf("%w: %v outside image %v", ErrInvalidRegion, rect, img.Rect)
+	}
+	if rect.Empty() {
+		return nil
+	}
+	offset := img.PixOffset(rect.Min.X, rect.Min.Y)
+	return t.updateRegion(rect.Sub(img.Rect.Min), img.Pix[offset:], img.Stride)
+}
+
+// updateRegion uploads rows of stride bytes into a texture region.
+func (t *Texture) updateRegion(rect image.Rectangle, pixels []byte, stride int) error {
+	if rect.Empty() {
+		return nil
+	}
+	if !rect.In(image.Rect(0, 0, t.Width, t.Height)) {
+		return fmt.Errorf("%w: %v outside %dx%d texture", ErrInvalidRegion, rect, t.Width, t.Height)
+	}
+	if need := (rect.Dy()-1)*stride + rect.Dx()*4; len(pixels) < need {
+		return fmt.Errorf("%w: %d bytes for %v, need %d", ErrInvalidRegion, len(pixels), rect, need)
+	}
+	if t.destroyed {
+		return ErrTextureDestroyed
+	}
+	if t.sdlTexture == nil {
+		return ErrNoSDLRenderer
+	}
+	region := sdl.Rect{X: int32(rect.Min.X), Y: int32(rect.Min.Y), W: int32(rect.Dx()), H: int32(rect.Dy())}
+	if err := t.sdlTexture.Update(&region, unsafe.Pointer(&pixels[0]), stride); err != nil {
+		return fmt.Errorf("failed to update texture region: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package graphics
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"weak"
+)
+
+// maxStackFrames bounds the frames recorded per stack trace.
+const maxStackFrames = 16
+
+// TextureIssueKind classifies a texture lifetime problem.
+type TextureIssueKind int
+
+// Texture issue kinds.
+const (
+	TextureLeaked         TextureIssueKind = iota // Created but never destroyed
+	TextureDestroyedInUse                         // Destroyed while sprites still reference it
+)
+
+// String returns a short label for the kind.
+func (k TextureIssueKind) String() string {
+	switch k {
+	case TextureLeaked:
+		return "leaked"
+	case TextureDestroyedInUse:
+		return "destroyed in use"
+	}
+	return "unknown"
+}
+
+// TextureIssue is a texture lifetime problem found by TextureDiagnostics.
+type TextureIssue struct {
+	Kind      TextureIssueKind
+	Path      string // Source file path ("" for render targets and generated textures)
+	Width     int
+	Height    int
+	Sprites   int    // Sprites referencing the texture when destroyed (TextureDestroyedInUse)
+	Created   string // Stack trace where the texture was created
+	Destroyed string // Stack trace where it was destroyed (TextureDestroyedInUse)
+}
+
+// String formats the issue with its stack traces.
+func (i TextureIssue) String() string {
+	var b strings.Builder
+	name := i.Path
+	if name == "" {
+		name = "(unnamed)"
+	}
+	fmt.Fprintf(&b, "texture %s: %s %dx%d", i.Kind, name, i.Width, i.Height)
+	if i.Kind == TextureDestroyedInUse {
+		fmt.Fprintf(&b, " (%d sprites)", i.Sprites)
+	}
+	fmt.Fprintf(&b, "\ncreated at:\n%s", i.Created)
+	if i.Destroyed != "" {
+		fmt.Fprintf(&b, "destroyed at:\n%s", i.Destroyed)
+	}
+	return b.String()
+}
+
+// TextureDiagnostics tracks texture creation and destruction to find GPU
+// memory leaks and textures destroyed while sprites still draw them.
+//
+// Diagnostics record a stack trace per texture, so enable them in
+// development builds only. Sprites are tracked when made with NewSprite.
+type TextureDiagnostics struct {
+	Output  io.Writer                // Engine.Shutdown writes the report here (nil = no report)
+	OnIssue func(issue TextureIssue) // Called when a texture is destroyed in use (optional)
+
+	mu      sync.Mutex
+	live    map[*Texture]string    // Creation stack by live texture
+	sprites []weak.Pointer[Sprite] // Sprites made while enabled
+	issues  []TextureIssue         // Destroyed-in-use issues so far
+}
+
+// textureDiagnostics is the active tracker (nil = disabled).
+var textureDiagnostics atomic.Pointer[TextureDiagnostics]
+
+// EnableTextureDiagnostics starts tracking texture lifetimes
+//
+// Parameters:
+//
+//	output: Where Engine.Shutdown writes the leak report (nil = no report)
+//
+// Returns:
+//
+//	*TextureDiagnostics: Active tracker
+//
+// Behavior:
+//   - Only textures and sprites created after enabling are tracked, so
+//     enable before creating the engine
+//   - At shutdown the engine reports textures still alive after its own
+//     subsystems are destroyed (render targets, text, and generated
+//     textures the game never destroyed)
+//   - Textures freed by AssetManager.Destroy aren't reported as in use
+//
+// Example:
+//
+//	if *debugFlag {
+//	    graphics.EnableTextureDiagnostics(os.Stderr)
+//	}
+//	engine, err := core.NewEngine("Game", 800, 600, false)
+func EnableTextureDiagnostics(output io.Writer) *TextureDiagnostics {
+	d := &TextureDiagnostics{Output: output, live: make(map[*Texture]string)}
+	textureDiagnostics.Store(d)
+	return d
+}
+
+// DisableTextureDiagnostics stops tracking texture lifetimes.
+func DisableTextureDiagnostics() {
+	textureDiagnostics.Store(nil)
+}
+
+// ActiveTextureDiagnostics returns the active tracker (nil if disabled).
+func ActiveTextureDiagnostics() *TextureDiagnostics {
+	return textureDiagnostics.Load()
+}
+
+// LiveTextures returns the number of tracked textures not yet destroyed.
+func (d *TextureDiagnostics) LiveTextures() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.live)
+}
+
+// Issues returns textures destroyed while sprites referenced them.
+func (d *TextureDiagnostics) Issues() []TextureIssue {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]TextureIssue(nil), d.issues...)
+}
+
+// Leaks returns every tracked texture not yet destroyed.
+func (d *TextureDiagnostics) Leaks() []TextureIssue {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	leaks := make([]TextureIssue, 0, len(d.live))
+	for texture, created := range d.live {
+		leaks = append(leaks, TextureIssue{
+			Kind: TextureLeaked, Path: texture.Path, Width: texture.Width, Height: texture.Height, Created: created,
+		})
+	}
+	return leaks
+}
+
+// Report writes leaks and in-use destructions
+//
+// Returns:
+//
+//	int: Number of issues written (0 = clean)
+func (d *TextureDiagnostics) Report(w io.Writer) int {
+	issues := append(d.Issues(), d.Leaks()...)
+	if len(issues) == 0 {
+		return 0
+	}
+	fmt.Fprintf(w, "texture diagnostics: %d issues\n", len(issues))
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%s\n", issue)
+	}
+	return len(issues)
+}
+
+// trackTexture records a texture's creation.
+func (d *TextureDiagnostics) trackTexture(texture *Texture) {
+	stack := captureStack(4)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.live[texture] = stack
+}
+
+// trackSprite records a sprite so destroyed textures can be checked for users.
+func (d *TextureDiagnostics) trackSprite(sprite *Sprite) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sprites = append(d.sprites, weak.Make(sprite))
+}
+
+// untrackTexture records a texture's destruction, reporting it if live
+// sprites still reference it and checkSprites is set.
+func (d *TextureDiagnostics) untrackTexture(texture *Texture, checkSprites bool) {
+	d.mu.Lock()
+	created, tracked := d.live[texture]
+	delete(d.live, texture)
+	users := 0
+	alive := d.sprites[:0]
+	for _, ref := range d.sprites {
+		sprite := ref.Value()
+		if sprite == nil {
+			continue // Collected
+		}
+		alive = append(alive, ref)
+		if sprite.Texture == texture {
+			users++
+		}
+	}
+	clear(d.sprites[len(alive):])
+	d.sprites = alive
+	if !tracked || !checkSprites || users == 0 {
+		d.mu.Unlock()
+		return
+	}
+	issue := TextureIssue{
+		Kind: TextureDestroyedInUse, Path: texture.Path, Width: texture.Width, Height: texture.Height,
+		Sprites: users, Created: created, Destroyed: captureStack(5),
+	}
+	d.issues = append(d.issues, issue)
+	onIssue := d.OnIssue
+	d.mu.Unlock()
+
+	if onIssue != nil {
+		onIssue(issue)
+	}
+}
+
+// captureStack formats the caller's stack, skipping skip frames.
+func captureStack(skip int) string {
+	pcs := make([]uintptr, maxStackFrames)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,334 @@
+package graphics
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"io/fs"
+	"runtime"
+	"sync"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// maxPooledSurfaces bounds the upload surfaces kept for reuse.
+const maxPooledSurfaces = 8
+
+// pixelPool recycles decode buffers between loads.
+var pixelPool sync.Pool // *[]byte
+
+// decodedImage is an image converted to straight-alpha RGBA bytes, ready
+// to copy into an SDL surface.
+type decodedImage struct {
+	pixels []byte // Rows of width*4 bytes (from pixelPool)
+	width  int
+	height int
+}
+
+// release returns the pixel buffer to the pool.
+func (d *decodedImage) release() {
+	if d.pixels != nil {
+		pixels := d.pixels
+		pixelPool.Put(&pixels)
+		d.pixels = nil
+	}
+}
+
+// decodeTexture reads and converts an image file. Safe to call from any
+// goroutine: it touches no SDL state.
+func decodeTexture(fsys fs.FS, path string) (decodedImage, error) {
+	file, err := openAsset(fsys, path)
+	if err != nil {
+		return decodedImage{}, fmt.Errorf("failed to load texture: file not found: %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }() // Best effort cleanup for read-only file
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return decodedImage{}, fmt.Errorf("failed to decode image: %s: %w", path, err)
+	}
+	return convertImage(img), nil
+}
+
+// convertImage copies an image into a pooled straight-alpha buffer.
+func convertImage(img image.Image) decodedImage {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	size := width * height * 4
+	var pixels []byte
+	if pooled, ok := pixelPool.Get().(*[]byte); ok && cap(*pooled) >= size {
+		pixels = (*pooled)[:size]
+	} else {
+		pixels = make([]byte, size)
+	}
+	if src, ok := img.(*image.RGBA); ok {
+		// Engine RGBA images (see GenerateImage) hold straight alpha already
+		for y := 0; y < height; y++ {
+			offset := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(pixels[y*width*4:(y+1)*width*4], src.Pix[offset:offset+width*4])
+		}
+	} else {
+		dst := &image.NRGBA{Pix: pixels, Stride: width * 4, Rect: image.Rect(0, 0, width, height)}
+		draw.Draw(dst, dst.Rect, img, bounds.Min, draw.Src)
+	}
+	return decodedImage{pixels: pixels, width: width, height: height}
+}
+
+// surfacePool keeps upload surfaces for reuse by size, so loading a level
+// full of same-sized tiles doesn't allocate a surface per texture.
+type surfacePool struct {
+	free []*sdl.Surface // Oldest first
+}
+
+// get returns a pooled surface of the size or creates one.
+func (p *surfacePool) get(width, height int) (*sdl.Surface, error) {
+	for i, surface := range p.free {
+		if int(surface.W) == width && int(surface.H) == height {
+			p.free = append(p.free[:i], p.free[i+1:]...)
+			return surface, nil
+		}
+	}
+	surface, err := sdl.CreateRGBSurface(0, int32(width), int32(height), 32,
+		0x000000ff, 0x0000ff00, 0x00ff0000, 0xff000000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create surface: %w", err)
+	}
+	return surface, nil
+}
+
+// put returns a surface to the pool, freeing the oldest when full.
+func (p *surfacePool) put(surface *sdl.Surface) {
+	if len(p.free) >= maxPooledSurfaces {
+		p.free[0].Free()
+		p.free = p.free[1:]
+	}
+	p.free = append(p.free, surface)
+}
+
+// destroy frees every pooled surface.
+func (p *surfacePool) destroy() {
+	for _, surface := range p.free {
+		surface.Free()
+	}
+	p.free = nil
+}
+
+// upload creates a texture from decoded pixels (main thread only) and
+// releases the pixels.
+func (am *AssetManager) upload(path string, img decodedImage) (*Texture, error) {
+	width, height := img.width, img.height
+	sdlTexture, err := am.uploadSDL(img)
+	if err != nil {
+		return nil, err
+	}
+	return NewTexture(sdlTexture, width, height, path), nil
+}
+
+// uploadSDL creates an SDL texture from decoded pixels (main thread only)
+// and releases the pixels.
+func (am *AssetManager) uploadSDL(img decodedImage) (*sdl.Texture, error) {
+	defer img.release()
+	if am.renderer == nil {
+		return nil, nil // Headless: size only
+	}
+	surface, err := am.surfaces.get(img.width, img.height)
+	if err != nil {
+		return nil, err
+	}
+	defer am.surfaces.put(surface)
+	fillSurface(surface, img)
+
+	sdlTexture, err := am.renderer.CreateTextureFromSurface(surface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create texture: %w", err)
+	}
+	if err := sdlTexture.SetBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		_ = sdlTexture.Destroy() // Best effort cleanup
+		return nil, fmt.Errorf("failed to set blend mode: %w", err)
+	}
+	return sdlTexture, nil
+}
+
+// fillSurface copies decoded pixels into a surface of the same size.
+func fillSurface(surface *sdl.Surface, img decodedImage) {
+	// Copy rows (the surface pitch may include padding)
+	pixels := surface.Pixels()
+	pitch, rowBytes := int(surface.Pitch), img.width*4
+	for y := 0; y < img.height; y++ {
+		copy(pixels[y*pitch:y*pitch+rowBytes], img.pixels[y*rowBytes:(y+1)*rowBytes])
+	}
+}
+
+// LoadSurface decodes an image into a new SDL surface, for APIs that take
+// surfaces instead of textures (window icons, cursors)
+//
+// Parameters:
+//
+//	path: File path (PNG or JPEG), read from the mounted filesystem if any
+//
+// Returns:
+//
+//	*sdl.Surface: RGBA surface owned by the caller (free it with Free)
+//	error: Non-nil if the file can't be read or decoded
+//
+// Behavior:
+//   - Surfaces are not cached or reference counted
+func (am *AssetManager) LoadSurface(path string) (*sdl.Surface, error) {
+	img, err := decodeTexture(am.fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	defer img.release()
+	surface, err := sdl.CreateRGBSurface(0, int32(img.width), int32(img.height), 32,
+		0x000000ff, 0x0000ff00, 0x00ff0000, 0xff000000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create surface: %w", err)
+	}
+	fillSurface(surface, img)
+	return surface, nil
+}
+
+// TextureRequest is a texture loading in the background (see
+// AssetManager.LoadTextureAsync).
+type TextureRequest struct {
+	Path    string
+	done    bool
+	texture *Texture
+	err     error
+}
+
+// Done reports whether the texture is ready (or failed).
+func (r *TextureRequest) Done() bool {
+	return r.done
+}
+
+// Result returns the loaded texture, or the load error; both are nil
+// until Done.
+func (r *TextureRequest) Result() (*Texture, error) {
+	return r.texture, r.err
+}
+
+// pendingLoad is one file decoding in the background, shared by every
+// request for its path.
+type pendingLoad struct {
+	path     string
+	done     chan struct{} // Closed once image or err is set
+	image    decodedImage
+	err      error
+	requests []*TextureRequest
+}
+
+// LoadTextureAsync starts loading a texture without blocking the frame
+//
+// Parameters:
+//
+//	path: File path (PNG or JPEG), as for LoadTexture
+//
+// Returns:
+//
+//	*TextureRequest: Completes on a later ProcessLoads (immediately if cached)
+//
+// Behavior:
+//   - Reading, decoding, and pixel conversion run on a background
+//     goroutine (at most one per CPU at a time); only the GPU upload
+//     happens on the main thread, in ProcessLoads
+//   - Each request holds one reference, like a LoadTexture call, once it
+//     succeeds
+//   - Requests for a path already loading share the decode
+//   - LoadTexture on a loading path waits for its decode instead of
+//     starting another
+//
+// Example:
+//
+//	requests := make([]*graphics.TextureRequest, 0, len(paths))
+//	for _, path := range paths {
+//	    requests = append(requests, assets.LoadTextureAsync(path))
+//	}
+//	// Each frame (the engine does this for its asset manager):
+//	assets.ProcessLoads(4)
+func (am *AssetManager) LoadTextureAsync(path string) *TextureRequest {
+	request := &TextureRequest{Path: path}
+	if texture, exists := am.cached(path); exists {
+		am.refCount[path]++
+		request.texture, request.done = texture, true
+		return request
+	}
+	if load, exists := am.pending[path]; exists {
+		load.requests = append(load.requests, request)
+		return request
+	}
+
+	load := &pendingLoad{path: path, done: make(chan struct{}), requests: []*TextureRequest{request}}
+	am.pending[path] = load
+	am.loadQueue = append(am.loadQueue, load)
+	fsys := am.fsys
+	go func() {
+		am.decodeSlots <- struct{}{}
+		defer func() { <-am.decodeSlots }()
+		load.image, load.err = decodeTexture(fsys, path)
+		close(load.done)
+	}()
+	return request
+}
+
+// ProcessLoads uploads textures whose background decode has finished and
+// completes their requests (main thread only)
+//
+// Parameters:
+//
+//	maxUploads: Upload limit for this call, to spread a level's textures
+//	            over several frames (0 = no limit)
+//
+// Returns:
+//
+//	int: Loads completed, including failed ones
+func (am *AssetManager) ProcessLoads(maxUploads int) int {
+	completed := 0
+	for i := 0; i < len(am.loadQueue); {
+		if maxUploads > 0 && completed >= maxUploads {
+			break
+		}
+		load := am.loadQueue[i]
+		select {
+		case <-load.done:
+			am.finish(load)
+			completed++
+		default:
+			i++
+		}
+	}
+	return completed
+}
+
+// PendingLoads returns the number of textures still loading.
+func (am *AssetManager) PendingLoads() int {
+	return len(am.loadQueue)
+}
+
+// finish uploads a decoded load and completes its requests.
+func (am *AssetManager) finish(load *pendingLoad) {
+	delete(am.pending, load.path)
+	for i, queued := range am.loadQueue {
+		if queued == load {
+			am.loadQueue = append(am.loadQueue[:i], am.loadQueue[i+1:]...)
+			break
+		}
+	}
+
+	texture, err := (*Texture)(nil), load.err
+	if err == nil {
+		texture, err = am.upload(load.path, load.image)
+	}
+	if err == nil {
+		am.textures[load.path] = texture
+		am.refCount[load.path] = len(load.requests)
+	}
+	for _, request := range load.requests {
+		request.texture, request.err, request.done = texture, err, true
+	}
+}
+
+// decodeSlotCount is the number of images decoded at once.
+func decodeSlotCount() int {
+	return max(runtime.NumCPU(), 1)
+}
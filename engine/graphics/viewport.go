@@ -0,0 +1,94 @@
+package graphics
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// WindowSize returns the window dimensions the viewport is relative to.
+func (c *Camera) WindowSize() (width, height int) {
+	return c.screenWidth, c.screenHeight
+}
+
+// ViewportRect returns the window area the camera draws into, in pixels.
+//
+// Returns:
+//
+//	gamemath.Rectangle: Viewport scaled to the window size (the whole
+//	                    window when Viewport is unset), rounded to pixels
+//
+// Example:
+//
+//	// Left and right halves for two players
+//	left.Viewport = gamemath.Rectangle{Width: 0.5, Height: 1}
+//	right.Viewport = gamemath.Rectangle{X: 0.5, Width: 0.5, Height: 1}
+func (c *Camera) ViewportRect() gamemath.Rectangle {
+	width, height := float64(c.screenWidth), float64(c.screenHeight)
+	if c.Viewport.Width <= 0 || c.Viewport.Height <= 0 {
+		return gamemath.Rectangle{Width: width, Height: height}
+	}
+	x := math.Round(c.Viewport.X * width)
+	y := math.Round(c.Viewport.Y * height)
+	return gamemath.Rectangle{
+		X:      x,
+		Y:      y,
+		Width:  math.Round((c.Viewport.X+c.Viewport.Width)*width) - x,
+		Height: math.Round((c.Viewport.Y+c.Viewport.Height)*height) - y,
+	}
+}
+
+// WindowToViewport converts window pixels (e.g. the mouse position) to
+// pixels relative to the camera's viewport.
+//
+// Returns:
+//
+//	x, y: Viewport-relative coordinates for ScreenToWorld
+//	bool: False if the point is outside the viewport
+//
+// Example:
+//
+//	mouseX, mouseY := input.MousePosition()
+//	if x, y, ok := camera.WindowToViewport(mouseX, mouseY); ok {
+//	    worldX, worldY := camera.ScreenToWorld(x, y)
+//	}
+func (c *Camera) WindowToViewport(windowX, windowY int32) (x, y int, ok bool) {
+	rect := c.ViewportRect()
+	x = int(windowX) - int(rect.X)
+	y = int(windowY) - int(rect.Y)
+	ok = float64(x) >= 0 && float64(y) >= 0 && float64(x) < rect.Width && float64(y) < rect.Height
+	return x, y, ok
+}
+
+// DrawsLayer reports whether the camera draws a z-order value (see HiddenLayers).
+func (c *Camera) DrawsLayer(z int) bool {
+	return !slices.Contains(c.HiddenLayers, z)
+}
+
+// SetViewport restricts drawing to a window area in pixels; coordinates
+// passed to draw calls become relative to its top-left corner.
+//
+// Parameters:
+//
+//	rect: Pixel area, e.g. Camera.ViewportRect() (zero size = whole window)
+//
+// Behavior:
+//   - Drawing outside the area is clipped
+//   - Clear still clears the whole window
+func (r *Renderer) SetViewport(rect gamemath.Rectangle) error {
+	if r.record != nil {
+		r.record(DrawCall{Kind: DrawKindViewport, Screen: rect})
+		return nil
+	}
+	var sdlRect *sdl.Rect
+	if rect.Width > 0 && rect.Height > 0 {
+		sdlRect = &sdl.Rect{X: int32(rect.X), Y: int32(rect.Y), W: int32(rect.Width), H: int32(rect.Height)}
+	}
+	if err := r.sdlRenderer.SetViewport(sdlRect); err != nil {
+		return fmt.Errorf("failed to set viewport: %w", err)
+	}
+	return nil
+}
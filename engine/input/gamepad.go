@@ -0,0 +1,164 @@
+package input
+
+import (
+	"strings"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Gamepad buttons (positional, Xbox naming: KeyPadA is the bottom face button).
+const (
+	KeyPadA             KeyCode = 1100
+	KeyPadB             KeyCode = 1101
+	KeyPadX             KeyCode = 1102
+	KeyPadY             KeyCode = 1103
+	KeyPadBack          KeyCode = 1104
+	KeyPadGuide         KeyCode = 1105
+	KeyPadStart         KeyCode = 1106
+	KeyPadLeftStick     KeyCode = 1107
+	KeyPadRightStick    KeyCode = 1108
+	KeyPadLeftShoulder  KeyCode = 1109
+	KeyPadRightShoulder KeyCode = 1110
+	KeyPadDPadUp        KeyCode = 1111
+	KeyPadDPadDown      KeyCode = 1112
+	KeyPadDPadLeft      KeyCode = 1113
+	KeyPadDPadRight     KeyCode = 1114
+)
+
+// Device identifies the kind of input device the player is using.
+type Device int
+
+const (
+	DeviceKeyboard Device = iota // Keyboard and mouse
+	DeviceGamepad                // Game controller
+)
+
+// IsGamepad reports whether a key is a gamepad button.
+func (k KeyCode) IsGamepad() bool {
+	return k >= KeyPadA && k <= KeyPadDPadRight
+}
+
+// GamepadStyle is the face button labelling of a controller family.
+type GamepadStyle int
+
+const (
+	GamepadXbox        GamepadStyle = iota // A B X Y
+	GamepadPlayStation                     // Cross Circle Square Triangle
+	GamepadNintendo                        // B A Y X (labels swapped relative to position)
+)
+
+// GamepadStyleForName guesses the button labelling from a controller name.
+//
+// SDL reports names like "PS4 Controller" or "Nintendo Switch Pro Controller";
+// anything unrecognised is treated as an Xbox layout.
+func GamepadStyleForName(name string) GamepadStyle {
+	lower := strings.ToLower(name)
+	for _, hint := range []string{"playstation", "ps3", "ps4", "ps5", "dualshock", "dualsense"} {
+		if strings.Contains(lower, hint) {
+			return GamepadPlayStation
+		}
+	}
+	for _, hint := range []string{"nintendo", "switch", "joy-con"} {
+		if strings.Contains(lower, hint) {
+			return GamepadNintendo
+		}
+	}
+	return GamepadXbox
+}
+
+// gamepadButtons maps SDL controller buttons to key codes.
+var gamepadButtons = map[uint8]KeyCode{
+	sdl.CONTROLLER_BUTTON_A:             KeyPadA,
+	sdl.CONTROLLER_BUTTON_B:             KeyPadB,
+	sdl.CONTROLLER_BUTTON_X:             KeyPadX,
+	sdl.CONTROLLER_BUTTON_Y:             KeyPadY,
+	sdl.CONTROLLER_BUTTON_BACK:          KeyPadBack,
+	sdl.CONTROLLER_BUTTON_GUIDE:         KeyPadGuide,
+	sdl.CONTROLLER_BUTTON_START:         KeyPadStart,
+	sdl.CONTROLLER_BUTTON_LEFTSTICK:     KeyPadLeftStick,
+	sdl.CONTROLLER_BUTTON_RIGHTSTICK:    KeyPadRightStick,
+	sdl.CONTROLLER_BUTTON_LEFTSHOULDER:  KeyPadLeftShoulder,
+	sdl.CONTROLLER_BUTTON_RIGHTSHOULDER: KeyPadRightShoulder,
+	sdl.CONTROLLER_BUTTON_DPAD_UP:       KeyPadDPadUp,
+	sdl.CONTROLLER_BUTTON_DPAD_DOWN:     KeyPadDPadDown,
+	sdl.CONTROLLER_BUTTON_DPAD_LEFT:     KeyPadDPadLeft,
+	sdl.CONTROLLER_BUTTON_DPAD_RIGHT:    KeyPadDPadRight,
+}
+
+// LastDevice returns the device that most recently produced a button press.
+//
+// Example:
+//
+//	if input.LastDevice() == input.DeviceGamepad {
+//	    cursor.Hide()
+//	}
+func (im *InputManager) LastDevice() Device {
+	return im.lastDevice
+}
+
+// GamepadStyle returns the labelling of the most recently used controller.
+func (im *InputManager) GamepadStyle() GamepadStyle {
+	return im.gamepadStyle
+}
+
+// SetGamepadStyle overrides the detected controller labelling (e.g. from a settings menu).
+func (im *InputManager) SetGamepadStyle(style GamepadStyle) {
+	im.gamepadStyle = style
+}
+
+// SetDeviceChangedCallback registers a function called when LastDevice changes.
+func (im *InputManager) SetDeviceChangedCallback(callback func(device Device)) {
+	im.onDeviceChanged = callback
+}
+
+// Bindings returns the keys bound to an action, in binding order.
+func (im *InputManager) Bindings(action Action) []KeyCode {
+	return im.actionMap[action]
+}
+
+// ProcessControllerButtonEvent updates gamepad button state from SDL event.
+func (im *InputManager) ProcessControllerButtonEvent(event *sdl.ControllerButtonEvent) {
+	key, ok := gamepadButtons[event.Button]
+	if !ok {
+		return
+	}
+	pressed := event.State == sdl.PRESSED
+	im.currentKeys[key] = pressed
+	if pressed {
+		if style, known := im.controllerStyles[event.Which]; known {
+			im.gamepadStyle = style
+		}
+		im.useDevice(DeviceGamepad)
+	}
+}
+
+// ProcessControllerDeviceEvent opens newly connected controllers and closes removed ones.
+func (im *InputManager) ProcessControllerDeviceEvent(event *sdl.ControllerDeviceEvent) {
+	switch event.Type {
+	case sdl.CONTROLLERDEVICEADDED:
+		controller := sdl.GameControllerOpen(int(event.Which))
+		if controller == nil {
+			return
+		}
+		id := controller.Joystick().InstanceID()
+		im.controllers[id] = controller
+		im.controllerStyles[id] = GamepadStyleForName(controller.Name())
+	case sdl.CONTROLLERDEVICEREMOVED:
+		if controller, ok := im.controllers[event.Which]; ok {
+			controller.Close()
+			delete(im.controllers, event.Which)
+			delete(im.controllerStyles, event.Which)
+		}
+	}
+}
+
+// useDevice records the active device and notifies on change.
+func (im *InputManager) useDevice(device Device) {
+	if im.lastDevice == device {
+		return
+	}
+	im.lastDevice = device
+	if im.onDeviceChanged != nil {
+		im.onDeviceChanged(device)
+	}
+}
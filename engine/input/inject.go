@@ -0,0 +1,69 @@
+package input
+
+// mouseButtons lists the keys InjectMouse treats as mouse buttons.
+var mouseButtons = []KeyCode{KeyMouseLeft, KeyMouseRight, KeyMouseMiddle}
+
+// InjectKey presses or releases a key as if the player had.
+//
+// Parameters:
+//
+//	key: Keyboard key, mouse button, or gamepad button
+//	pressed: True to press, false to release
+//
+// Behavior:
+//   - Takes effect immediately and behaves exactly like a hardware event:
+//     pressed this frame, held after the next Update, until released
+//   - Presses switch LastDevice to the key's device (gamepad buttons
+//     select DeviceGamepad, everything else DeviceKeyboard)
+//   - Real events for the same key still apply, so an injected press can
+//     be released by the player and vice versa
+//
+// Example:
+//
+//	// Automated playthrough: walk right until the goal, then jump
+//	input.InjectKey(input.KeyD, true)
+//	if player.Transform.Position.X >= goalX {
+//	    input.InjectKey(input.KeyD, false)
+//	    input.InjectKey(input.KeySpace, true)
+//	}
+func (im *InputManager) InjectKey(key KeyCode, pressed bool) {
+	im.currentKeys[key] = pressed
+	if !pressed {
+		return
+	}
+	if key.IsGamepad() {
+		im.useDevice(DeviceGamepad)
+		return
+	}
+	im.useDevice(DeviceKeyboard)
+}
+
+// InjectMouse moves the mouse and sets which button is held.
+//
+// Parameters:
+//
+//	x, y: Screen position in pixels
+//	button: Mouse button held at this position (KeyNone = none)
+//
+// Behavior:
+//   - Mouse buttons other than button are released, so a sequence of
+//     calls describes a gesture: press, drag, release
+//   - MouseDelta reports the movement since the last Update, as for
+//     real motion
+//
+// Example:
+//
+//	// Click the "Play" button for the player
+//	input.InjectMouse(400, 300, input.KeyMouseLeft)
+//	// ... next frame ...
+//	input.InjectMouse(400, 300, input.KeyNone)
+func (im *InputManager) InjectMouse(x, y int32, button KeyCode) {
+	im.mouseX = x
+	im.mouseY = y
+	for _, key := range mouseButtons {
+		down := key == button
+		if im.currentKeys[key] != down {
+			im.InjectKey(key, down)
+		}
+	}
+}
@@ -2,7 +2,7 @@ package input
 
 import "github.com/veandco/go-sdl2/sdl"
 
-// InputManager manages keyboard and mouse input state with action mapping.
+// InputManager manages keyboard, mouse, and gamepad input state with action mapping.
 type InputManager struct {
 	currentKeys  map[KeyCode]bool     // Current frame key state
 	previousKeys map[KeyCode]bool     // Previous frame key state
@@ -11,6 +11,12 @@ type InputManager struct {
 	mouseY       int32                // Current mouse Y position
 	prevMouseX   int32                // Previous mouse X position
 	prevMouseY   int32                // Previous mouse Y position
+
+	lastDevice       Device                                 // Device that produced the latest press
+	gamepadStyle     GamepadStyle                           // Labelling of the latest controller used
+	onDeviceChanged  func(device Device)                    // Called when lastDevice changes
+	controllers      map[sdl.JoystickID]*sdl.GameController // Open controllers by instance ID
+	controllerStyles map[sdl.JoystickID]GamepadStyle        // Detected labelling per controller
 }
 
 // NewInputManager creates a new input manager.
@@ -32,6 +38,9 @@ func NewInputManager() *InputManager {
 		mouseY:       0,
 		prevMouseX:   0,
 		prevMouseY:   0,
+
+		controllers:      make(map[sdl.JoystickID]*sdl.GameController),
+		controllerStyles: make(map[sdl.JoystickID]GamepadStyle),
 	}
 }
 
@@ -234,8 +243,7 @@ func (im *InputManager) Update() {
 
 // ProcessKeyEvent updates key state from SDL event.
 func (im *InputManager) ProcessKeyEvent(event *sdl.KeyboardEvent) {
-	scancode := KeyCode(event.Keysym.Scancode)
-	im.currentKeys[scancode] = (event.State == sdl.PRESSED)
+	im.InjectKey(KeyCode(event.Keysym.Scancode), event.State == sdl.PRESSED)
 }
 
 // ProcessMouseButtonEvent updates mouse button state from SDL event.
@@ -251,7 +259,7 @@ func (im *InputManager) ProcessMouseButtonEvent(event *sdl.MouseButtonEvent) {
 	default:
 		return
 	}
-	im.currentKeys[key] = (event.State == sdl.PRESSED)
+	im.InjectKey(key, event.State == sdl.PRESSED)
 }
 
 // ProcessMouseMotionEvent updates mouse position from SDL event.
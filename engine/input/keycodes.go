@@ -2,11 +2,14 @@ package input
 
 import "github.com/veandco/go-sdl2/sdl"
 
-// KeyCode represents a keyboard key or mouse button.
+// KeyCode represents a keyboard key, mouse button, or gamepad button.
 type KeyCode int
 
 // Keyboard keys (wrapping SDL scancodes for type safety).
 const (
+	// KeyNone is no key (e.g. no mouse button held in InjectMouse).
+	KeyNone KeyCode = KeyCode(sdl.SCANCODE_UNKNOWN)
+
 	// Letters
 	KeyA KeyCode = KeyCode(sdl.SCANCODE_A)
 	KeyB KeyCode = KeyCode(sdl.SCANCODE_B)
@@ -53,6 +56,20 @@ const (
 	KeyArrowLeft  KeyCode = KeyCode(sdl.SCANCODE_LEFT)
 	KeyArrowRight KeyCode = KeyCode(sdl.SCANCODE_RIGHT)
 
+	// Function keys
+	KeyF1  KeyCode = KeyCode(sdl.SCANCODE_F1)
+	KeyF2  KeyCode = KeyCode(sdl.SCANCODE_F2)
+	KeyF3  KeyCode = KeyCode(sdl.SCANCODE_F3)
+	KeyF4  KeyCode = KeyCode(sdl.SCANCODE_F4)
+	KeyF5  KeyCode = KeyCode(sdl.SCANCODE_F5)
+	KeyF6  KeyCode = KeyCode(sdl.SCANCODE_F6)
+	KeyF7  KeyCode = KeyCode(sdl.SCANCODE_F7)
+	KeyF8  KeyCode = KeyCode(sdl.SCANCODE_F8)
+	KeyF9  KeyCode = KeyCode(sdl.SCANCODE_F9)
+	KeyF10 KeyCode = KeyCode(sdl.SCANCODE_F10)
+	KeyF11 KeyCode = KeyCode(sdl.SCANCODE_F11)
+	KeyF12 KeyCode = KeyCode(sdl.SCANCODE_F12)
+
 	// Special keys
 	KeySpace  KeyCode = KeyCode(sdl.SCANCODE_SPACE)
 	KeyEnter  KeyCode = KeyCode(sdl.SCANCODE_RETURN)
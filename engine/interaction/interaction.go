@@ -0,0 +1,225 @@
+// Package interaction provides interactable entities, nearest-target selection, and on-screen prompts.
+package interaction
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// InteractCallback is called when an interactor triggers an interactable.
+// Parameters:
+//   - interactor: The entity performing the interaction (usually the player)
+//   - target: The entity being interacted with
+type InteractCallback func(interactor, target *core.Entity)
+
+// Interactable marks an entity as something the player can interact with.
+type Interactable struct {
+	Entity          *core.Entity     // Entity this interactable belongs to
+	Prompt          string           // Prompt text shown when selected (e.g. "Open door")
+	Range           float64          // Maximum interaction distance in world units
+	FacingTolerance float64          // Max angle in degrees off the interactor's facing (0 = no facing check)
+	Enabled         bool             // Disabled interactables are never selected
+	OnInteract      InteractCallback // Called when the interact action is pressed
+}
+
+// NewInteractable creates an enabled interactable for an entity.
+//
+// Parameters:
+//
+//	entity: Entity that can be interacted with
+//	prompt: Text shown when the entity is the current target
+//	interactRange: Maximum interaction distance in world units
+//	onInteract: Callback fired on interaction
+//
+// Returns:
+//
+//	*Interactable: Interactable with no facing requirement
+//
+// Example:
+//
+//	chest := interaction.NewInteractable(chestEntity, "Open", 48, func(player, target *core.Entity) {
+//	    openChest(target)
+//	})
+func NewInteractable(entity *core.Entity, prompt string, interactRange float64, onInteract InteractCallback) *Interactable {
+	return &Interactable{
+		Entity:     entity,
+		Prompt:     prompt,
+		Range:      interactRange,
+		Enabled:    true,
+		OnInteract: onInteract,
+	}
+}
+
+// Interactor is a Behavior that selects the nearest interactable in range and
+// triggers it when the bound input action is pressed.
+type Interactor struct {
+	Input  *input.InputManager // Input manager used to poll the action
+	Action input.Action        // Action that triggers interaction (default ActionInteract)
+
+	// Facing overrides the facing direction used for FacingTolerance checks.
+	// If zero, the direction is derived from the entity's world rotation.
+	Facing gamemath.Vector2
+
+	// OnTargetChanged is called whenever the selected interactable changes (either may be nil).
+	OnTargetChanged func(previous, current *Interactable)
+
+	interactables []*Interactable
+	current       *Interactable
+	owner         *core.Entity
+}
+
+// NewInteractor creates an interactor bound to ActionInteract.
+//
+// Example:
+//
+//	interactor := interaction.NewInteractor(engine.Input())
+//	player.Behavior = interactor
+//	interactor.Add(interaction.NewInteractable(door, "Open door", 40, openDoor))
+func NewInteractor(inputMgr *input.InputManager) *Interactor {
+	return &Interactor{
+		Input:         inputMgr,
+		Action:        input.ActionInteract,
+		interactables: make([]*Interactable, 0),
+	}
+}
+
+// Add registers an interactable with this interactor.
+func (in *Interactor) Add(interactable *Interactable) {
+	in.interactables = append(in.interactables, interactable)
+}
+
+// Remove unregisters an interactable. No-op if not registered.
+func (in *Interactor) Remove(interactable *Interactable) {
+	for i, it := range in.interactables {
+		if it == interactable {
+			in.interactables = append(in.interactables[:i], in.interactables[i+1:]...)
+			break
+		}
+	}
+	if in.current == interactable {
+		in.setCurrent(nil)
+	}
+}
+
+// Current returns the currently selected interactable, or nil if none is in range.
+func (in *Interactor) Current() *Interactable {
+	return in.current
+}
+
+// Update selects the nearest valid interactable and fires its callback if the
+// interact action was pressed this frame.
+func (in *Interactor) Update(entity *core.Entity, _ float64) {
+	in.owner = entity
+	in.setCurrent(in.findNearest(entity))
+
+	if in.current == nil || in.Input == nil {
+		return
+	}
+	if in.Input.ActionPressed(in.Action) {
+		in.Interact()
+	}
+}
+
+// Interact triggers the current interactable directly (bypassing input).
+//
+// Returns:
+//
+//	bool: True if an interactable was triggered
+func (in *Interactor) Interact() bool {
+	if in.current == nil || in.owner == nil {
+		return false
+	}
+	if in.current.OnInteract != nil {
+		in.current.OnInteract(in.owner, in.current.Entity)
+	}
+	return true
+}
+
+// findNearest returns the closest enabled, in-range, faced interactable.
+func (in *Interactor) findNearest(entity *core.Entity) *Interactable {
+	origin := entity.WorldTransform().Position
+	facing := in.facingDirection(entity)
+
+	var best *Interactable
+	bestDist := math.MaxFloat64
+	for _, it := range in.interactables {
+		if !it.Enabled || it.Entity == nil || !it.Entity.Active || it.Entity == entity {
+			continue
+		}
+
+		toTarget := it.Entity.WorldTransform().Position.Sub(origin)
+		dist := toTarget.Length()
+		if dist > it.Range || dist >= bestDist {
+			continue
+		}
+
+		if it.FacingTolerance > 0 && dist > 0 {
+			cos := facing.Dot(toTarget.Scale(1 / dist))
+			if cos < math.Cos(it.FacingTolerance*math.Pi/180) {
+				continue
+			}
+		}
+
+		best = it
+		bestDist = dist
+	}
+	return best
+}
+
+// facingDirection returns the unit facing vector for the interactor.
+func (in *Interactor) facingDirection(entity *core.Entity) gamemath.Vector2 {
+	if in.Facing.X != 0 || in.Facing.Y != 0 {
+		return in.Facing.Normalize()
+	}
+	radians := entity.WorldTransform().Rotation * math.Pi / 180
+	return gamemath.Vector2{X: math.Cos(radians), Y: math.Sin(radians)}
+}
+
+// setCurrent updates the selection and fires OnTargetChanged on change.
+func (in *Interactor) setCurrent(next *Interactable) {
+	if next == in.current {
+		return
+	}
+	previous := in.current
+	in.current = next
+	if in.OnTargetChanged != nil {
+		in.OnTargetChanged(previous, next)
+	}
+}
+
+// RenderPrompt draws the current target's prompt above it in screen space.
+//
+// Parameters:
+//
+//	textRenderer: Text renderer used for the prompt
+//	camera: Camera used to project the target position
+//	offsetY: Vertical screen offset from the target (negative = above)
+//	color: Prompt color
+//
+// Returns:
+//
+//	error: Non-nil if text rendering fails
+//
+// Example:
+//
+//	engine.SetRenderUICallback(func() {
+//	    _ = interactor.RenderPrompt(textRenderer, scene.Camera(), -40, gamemath.White)
+//	})
+func (in *Interactor) RenderPrompt(textRenderer *graphics.TextRenderer, camera *graphics.Camera, offsetY int, color gamemath.Color) error {
+	if in.current == nil || in.current.Prompt == "" || textRenderer == nil {
+		return nil
+	}
+
+	pos := in.current.Entity.WorldTransform().Position
+	screenX, screenY := camera.WorldToScreen(pos.X, pos.Y)
+
+	width, _, err := textRenderer.MeasureText(in.current.Prompt)
+	if err != nil {
+		return err
+	}
+	return textRenderer.DrawText(in.current.Prompt, screenX-width/2, screenY+offsetY, color)
+}
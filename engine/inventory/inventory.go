@@ -0,0 +1,128 @@
+// Package inventory provides item containers with stack counts and change events.
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Errors returned by Inventory operations.
+var (
+	ErrInsufficientItems = errors.New("insufficient items")
+	ErrInventoryFull     = errors.New("inventory full")
+	ErrInvalidQuantity   = errors.New("invalid quantity")
+)
+
+// ItemStack is an item ID with a quantity.
+type ItemStack struct {
+	ID       string // Item identifier
+	Quantity int    // Number of items
+}
+
+// ChangeCallback is called when an item count changes.
+type ChangeCallback func(itemID string, oldCount, newCount int)
+
+// Inventory stores item counts keyed by item ID.
+type Inventory struct {
+	counts   map[string]int
+	MaxSlots int // Maximum distinct item IDs (0 = unlimited)
+
+	// OnChanged is called after an item count changes.
+	OnChanged ChangeCallback
+}
+
+// New creates an empty, unlimited inventory.
+//
+// Example:
+//
+//	bag := inventory.New()
+//	_ = bag.Add("potion", 3)
+//	if bag.Has("potion", 1) {
+//	    _ = bag.Remove("potion", 1)
+//	}
+func New() *Inventory {
+	return &Inventory{
+		counts: make(map[string]int),
+	}
+}
+
+// Add adds items.
+//
+// Returns:
+//
+//	error: ErrInvalidQuantity if qty <= 0, ErrInventoryFull if a new slot is needed but none is free
+func (inv *Inventory) Add(itemID string, qty int) error {
+	if qty <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidQuantity, qty)
+	}
+	old, exists := inv.counts[itemID]
+	if !exists && inv.MaxSlots > 0 && len(inv.counts) >= inv.MaxSlots {
+		return fmt.Errorf("%w: cannot add %s", ErrInventoryFull, itemID)
+	}
+	inv.set(itemID, old, old+qty)
+	return nil
+}
+
+// Remove removes items.
+//
+// Returns:
+//
+//	error: ErrInvalidQuantity if qty <= 0, ErrInsufficientItems if fewer than qty are held
+func (inv *Inventory) Remove(itemID string, qty int) error {
+	if qty <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidQuantity, qty)
+	}
+	old := inv.counts[itemID]
+	if old < qty {
+		return fmt.Errorf("%w: have %d %s, need %d", ErrInsufficientItems, old, itemID, qty)
+	}
+	inv.set(itemID, old, old-qty)
+	return nil
+}
+
+// Count returns how many of an item are held.
+func (inv *Inventory) Count(itemID string) int {
+	return inv.counts[itemID]
+}
+
+// Has reports whether at least qty of an item are held.
+func (inv *Inventory) Has(itemID string, qty int) bool {
+	return inv.counts[itemID] >= qty
+}
+
+// HasAll reports whether every stack is held in the required quantity.
+func (inv *Inventory) HasAll(stacks []ItemStack) bool {
+	needed := make(map[string]int, len(stacks))
+	for _, stack := range stacks {
+		needed[stack.ID] += stack.Quantity
+	}
+	for id, qty := range needed {
+		if inv.counts[id] < qty {
+			return false
+		}
+	}
+	return true
+}
+
+// Items returns all held items sorted by ID.
+func (inv *Inventory) Items() []ItemStack {
+	items := make([]ItemStack, 0, len(inv.counts))
+	for id, qty := range inv.counts {
+		items = append(items, ItemStack{ID: id, Quantity: qty})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items
+}
+
+// set updates a count, dropping empty slots, and fires OnChanged.
+func (inv *Inventory) set(itemID string, oldCount, newCount int) {
+	if newCount == 0 {
+		delete(inv.counts, itemID)
+	} else {
+		inv.counts[itemID] = newCount
+	}
+	if inv.OnChanged != nil && oldCount != newCount {
+		inv.OnChanged(itemID, oldCount, newCount)
+	}
+}
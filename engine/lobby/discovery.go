@@ -0,0 +1,227 @@
+package lobby
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultDiscoveryPort is the UDP port used for LAN discovery.
+const DefaultDiscoveryPort = 47777
+
+// Announcement is broadcast by hosts so LAN clients can find their lobby.
+type Announcement struct {
+	Game       string `json:"game"`    // Game identifier; browsers ignore other games
+	Version    string `json:"version"` // Game/protocol version
+	LobbyID    string `json:"lobby_id"`
+	Name       string `json:"name"`
+	Port       int    `json:"port"` // Port the host accepts connections on
+	Players    int    `json:"players"`
+	MaxPlayers int    `json:"max_players"`
+	Locked     bool   `json:"locked"`
+
+	Address  string    `json:"-"` // Host IP (filled in by the browser from the packet source)
+	LastSeen time.Time `json:"-"` // When the browser last heard this lobby
+}
+
+// Announce builds an announcement from a lobby.
+func Announce(game, version string, port int, l *Lobby) Announcement {
+	return Announcement{
+		Game:       game,
+		Version:    version,
+		LobbyID:    l.ID,
+		Name:       l.Name,
+		Port:       port,
+		Players:    len(l.Players()),
+		MaxPlayers: l.MaxPlayers,
+		Locked:     l.Locked,
+	}
+}
+
+// Broadcaster periodically sends an announcement to the LAN.
+type Broadcaster struct {
+	Target   string        // Destination address (default broadcast on DefaultDiscoveryPort)
+	Interval time.Duration // Time between announcements
+
+	announce func() Announcement
+	conn     net.PacketConn
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewBroadcaster creates a broadcaster that sends announce() every second.
+//
+// Example:
+//
+//	caster := lobby.NewBroadcaster(func() lobby.Announcement {
+//	    return lobby.Announce("space-battle", "1.2", 7000, room)
+//	})
+//	if err := caster.Start(); err != nil {
+//	    log.Printf("LAN discovery unavailable: %v", err)
+//	}
+//	defer caster.Stop()
+func NewBroadcaster(announce func() Announcement) *Broadcaster {
+	return &Broadcaster{
+		Target:   fmt.Sprintf("255.255.255.255:%d", DefaultDiscoveryPort),
+		Interval: time.Second,
+		announce: announce,
+	}
+}
+
+// Start opens the socket and begins announcing in the background.
+func (b *Broadcaster) Start() error {
+	if b.conn != nil {
+		return nil
+	}
+	target, err := net.ResolveUDPAddr("udp4", b.Target)
+	if err != nil {
+		return fmt.Errorf("invalid discovery target: %w", err)
+	}
+	conn, err := net.ListenPacket("udp4", ":0")
+	if err != nil {
+		return fmt.Errorf("failed to open discovery socket: %w", err)
+	}
+	b.conn = conn
+	b.stop = make(chan struct{})
+	b.done = make(chan struct{})
+
+	go func() {
+		defer close(b.done)
+		ticker := time.NewTicker(b.Interval)
+		defer ticker.Stop()
+		for {
+			_ = b.send(target) // Lost packets are retried next tick
+			select {
+			case <-b.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop ends announcing and closes the socket.
+func (b *Broadcaster) Stop() {
+	if b.conn == nil {
+		return
+	}
+	close(b.stop)
+	<-b.done
+	_ = b.conn.Close() // Best effort cleanup
+	b.conn = nil
+}
+
+// send writes one announcement.
+func (b *Broadcaster) send(target net.Addr) error {
+	data, err := json.Marshal(b.announce())
+	if err != nil {
+		return err
+	}
+	_, err = b.conn.WriteTo(data, target)
+	return err
+}
+
+// Browser listens for LAN announcements and keeps a list of live lobbies.
+type Browser struct {
+	Game    string        // Only announcements for this game are kept ("" = any)
+	Timeout time.Duration // Lobbies not heard from within Timeout are dropped
+
+	conn    net.PacketConn
+	mu      sync.Mutex
+	lobbies map[string]Announcement
+	done    chan struct{}
+}
+
+// Browse starts listening for announcements on a UDP port.
+//
+// Example:
+//
+//	browser, err := lobby.Browse("space-battle", lobby.DefaultDiscoveryPort)
+//	if err != nil {
+//	    return err
+//	}
+//	defer browser.Close()
+//	for _, found := range browser.Lobbies() {
+//	    fmt.Printf("%s (%d/%d) at %s:%d\n", found.Name, found.Players, found.MaxPlayers, found.Address, found.Port)
+//	}
+func Browse(game string, port int) (*Browser, error) {
+	conn, err := net.ListenPacket("udp4", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for lobbies: %w", err)
+	}
+	b := &Browser{
+		Game:    game,
+		Timeout: 5 * time.Second,
+		conn:    conn,
+		lobbies: make(map[string]Announcement),
+		done:    make(chan struct{}),
+	}
+	go b.listen()
+	return b, nil
+}
+
+// Addr returns the local listening address.
+func (b *Browser) Addr() net.Addr {
+	return b.conn.LocalAddr()
+}
+
+// Lobbies returns live lobbies sorted by name.
+func (b *Browser) Lobbies() []Announcement {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	result := make([]Announcement, 0, len(b.lobbies))
+	for key, found := range b.lobbies {
+		if b.Timeout > 0 && now.Sub(found.LastSeen) > b.Timeout {
+			delete(b.lobbies, key)
+			continue
+		}
+		result = append(result, found)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].LobbyID < result[j].LobbyID
+	})
+	return result
+}
+
+// Close stops listening.
+func (b *Browser) Close() error {
+	err := b.conn.Close()
+	<-b.done
+	return err
+}
+
+// listen reads announcements until the socket closes.
+func (b *Browser) listen() {
+	defer close(b.done)
+	buffer := make([]byte, 2048)
+	for {
+		n, from, err := b.conn.ReadFrom(buffer)
+		if err != nil {
+			return
+		}
+		var found Announcement
+		if json.Unmarshal(buffer[:n], &found) != nil {
+			continue // Not ours
+		}
+		if b.Game != "" && found.Game != b.Game {
+			continue
+		}
+		if udp, ok := from.(*net.UDPAddr); ok {
+			found.Address = udp.IP.String()
+		}
+		found.LastSeen = time.Now()
+
+		b.mu.Lock()
+		b.lobbies[found.Address+"/"+found.LobbyID] = found
+		b.mu.Unlock()
+	}
+}
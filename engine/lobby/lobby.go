@@ -0,0 +1,302 @@
+// Package lobby provides multiplayer lobby state (player slots, ready checks,
+// host migration) and UDP LAN discovery.
+//
+// Lobby state is transport-agnostic: the host owns the authoritative Lobby
+// and sends State snapshots to clients over whatever connection the game uses;
+// clients apply them with ApplyState.
+package lobby
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Lobby errors.
+var (
+	ErrLobbyFull     = errors.New("lobby is full")
+	ErrLobbyLocked   = errors.New("lobby is locked")
+	ErrAlreadyJoined = errors.New("player already in lobby")
+	ErrNotInLobby    = errors.New("player not in lobby")
+	ErrNotHost       = errors.New("only the host can do that")
+)
+
+// PlayerID uniquely identifies a player (e.g. a network peer ID).
+type PlayerID string
+
+// Slot is a seat in the lobby.
+type Slot struct {
+	Index  int      `json:"index"`
+	Player PlayerID `json:"player"` // Empty when the slot is open
+	Name   string   `json:"name"`
+	Ready  bool     `json:"ready"`
+	Team   int      `json:"team"`
+}
+
+// Open reports whether the slot has no player.
+func (s *Slot) Open() bool {
+	return s.Player == ""
+}
+
+// State is a serializable snapshot of a lobby for syncing clients.
+type State struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Host       PlayerID `json:"host"`
+	MaxPlayers int      `json:"max_players"`
+	Locked     bool     `json:"locked"`
+	Slots      []Slot   `json:"slots"`
+}
+
+// HostPolicy picks the next host from the remaining players when the host leaves.
+type HostPolicy func(candidates []*Slot) PlayerID
+
+// LowestSlot is the default HostPolicy: the player in the lowest slot becomes host.
+func LowestSlot(candidates []*Slot) PlayerID {
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0].Player
+}
+
+// Lobby tracks players, slots, and readiness before a match starts.
+type Lobby struct {
+	ID         string
+	Name       string
+	Host       PlayerID
+	MaxPlayers int
+	MinPlayers int  // Players required for AllReady (default 2)
+	Locked     bool // Locked lobbies reject joins (e.g. match starting)
+	Slots      []*Slot
+
+	// Host migration policy (nil = LowestSlot)
+	ChooseHost HostPolicy
+
+	OnJoin         func(slot *Slot)
+	OnLeave        func(slot Slot)
+	OnReadyChanged func(slot *Slot)
+	OnHostChanged  func(previous, next PlayerID) // Use to hand over authority (e.g. re-host the session)
+	OnAllReady     func()
+}
+
+// NewLobby creates a lobby with the host seated in slot 0.
+//
+// Parameters:
+//
+//	maxPlayers: Number of slots, including the host's; 0 or less gives a
+//	            lobby with no slots (MaxPlayers 0) that every Join finds full
+//
+// Example:
+//
+//	room := lobby.NewLobby("room-1", "Friday Night", "alice", "Alice", 4)
+//	room.OnAllReady = func() { startCountdown() }
+//	room.Join("bob", "Bob")
+//	room.SetReady("bob", true)
+//	broadcast(room.State())
+func NewLobby(id, name string, host PlayerID, hostName string, maxPlayers int) *Lobby {
+	maxPlayers = max(maxPlayers, 0)
+	l := &Lobby{
+		ID:         id,
+		Name:       name,
+		Host:       host,
+		MaxPlayers: maxPlayers,
+		MinPlayers: 2,
+		Slots:      make([]*Slot, maxPlayers),
+	}
+	for i := range l.Slots {
+		l.Slots[i] = &Slot{Index: i}
+	}
+	if maxPlayers > 0 {
+		l.Slots[0].Player = host
+		l.Slots[0].Name = hostName
+	}
+	return l
+}
+
+// Join seats a player in the first open slot.
+func (l *Lobby) Join(id PlayerID, name string) (*Slot, error) {
+	if l.Locked {
+		return nil, ErrLobbyLocked
+	}
+	if l.Slot(id) != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyJoined, id)
+	}
+	for _, slot := range l.Slots {
+		if slot.Open() {
+			slot.Player, slot.Name, slot.Ready = id, name, false
+			if l.OnJoin != nil {
+				l.OnJoin(slot)
+			}
+			return slot, nil
+		}
+	}
+	return nil, ErrLobbyFull
+}
+
+// Leave removes a player, migrating the host if needed.
+func (l *Lobby) Leave(id PlayerID) error {
+	slot := l.Slot(id)
+	if slot == nil {
+		return fmt.Errorf("%w: %s", ErrNotInLobby, id)
+	}
+	left := *slot
+	*slot = Slot{Index: slot.Index, Team: slot.Team}
+	if l.OnLeave != nil {
+		l.OnLeave(left)
+	}
+	if id == l.Host {
+		l.migrateHost()
+	}
+	return nil
+}
+
+// Kick removes a player on behalf of the host.
+func (l *Lobby) Kick(by, target PlayerID) error {
+	if by != l.Host {
+		return ErrNotHost
+	}
+	return l.Leave(target)
+}
+
+// SetReady sets a player's ready flag and fires OnAllReady when everyone is ready.
+func (l *Lobby) SetReady(id PlayerID, ready bool) error {
+	slot := l.Slot(id)
+	if slot == nil {
+		return fmt.Errorf("%w: %s", ErrNotInLobby, id)
+	}
+	if slot.Ready == ready {
+		return nil
+	}
+	slot.Ready = ready
+	if l.OnReadyChanged != nil {
+		l.OnReadyChanged(slot)
+	}
+	if ready && l.AllReady() && l.OnAllReady != nil {
+		l.OnAllReady()
+	}
+	return nil
+}
+
+// SetTeam assigns a player to a team.
+func (l *Lobby) SetTeam(id PlayerID, team int) error {
+	slot := l.Slot(id)
+	if slot == nil {
+		return fmt.Errorf("%w: %s", ErrNotInLobby, id)
+	}
+	slot.Team = team
+	return nil
+}
+
+// Slot returns a player's slot (nil if not in the lobby).
+func (l *Lobby) Slot(id PlayerID) *Slot {
+	if id == "" {
+		return nil
+	}
+	for _, slot := range l.Slots {
+		if slot.Player == id {
+			return slot
+		}
+	}
+	return nil
+}
+
+// Players returns the occupied slots in slot order.
+func (l *Lobby) Players() []*Slot {
+	players := make([]*Slot, 0, len(l.Slots))
+	for _, slot := range l.Slots {
+		if !slot.Open() {
+			players = append(players, slot)
+		}
+	}
+	return players
+}
+
+// Full reports whether every slot is taken.
+func (l *Lobby) Full() bool {
+	return len(l.Players()) >= len(l.Slots)
+}
+
+// AllReady reports whether at least MinPlayers are seated and all are ready.
+func (l *Lobby) AllReady() bool {
+	players := l.Players()
+	if len(players) < max(l.MinPlayers, 1) {
+		return false
+	}
+	for _, slot := range players {
+		if !slot.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// State returns a snapshot for sending to clients.
+func (l *Lobby) State() State {
+	state := State{
+		ID:         l.ID,
+		Name:       l.Name,
+		Host:       l.Host,
+		MaxPlayers: l.MaxPlayers,
+		Locked:     l.Locked,
+		Slots:      make([]Slot, len(l.Slots)),
+	}
+	for i, slot := range l.Slots {
+		state.Slots[i] = *slot
+	}
+	return state
+}
+
+// ApplyState replaces the lobby with a host snapshot (client side).
+//
+// Callbacks fire for joins, leaves, ready changes, and host changes implied
+// by the difference, so client UI can react the same way as the host's.
+func (l *Lobby) ApplyState(state State) {
+	previous := l.State()
+	previousHost := l.Host
+
+	l.ID, l.Name, l.Host, l.MaxPlayers, l.Locked = state.ID, state.Name, state.Host, state.MaxPlayers, state.Locked
+	l.Slots = make([]*Slot, len(state.Slots))
+	for i := range state.Slots {
+		slot := state.Slots[i]
+		l.Slots[i] = &slot
+	}
+
+	was := make(map[PlayerID]Slot)
+	for _, slot := range previous.Slots {
+		if !slot.Open() {
+			was[slot.Player] = slot
+		}
+	}
+	for _, slot := range l.Players() {
+		old, existed := was[slot.Player]
+		delete(was, slot.Player)
+		switch {
+		case !existed && l.OnJoin != nil:
+			l.OnJoin(slot)
+		case existed && old.Ready != slot.Ready && l.OnReadyChanged != nil:
+			l.OnReadyChanged(slot)
+		}
+	}
+	if l.OnLeave != nil {
+		for _, slot := range previous.Slots {
+			if _, gone := was[slot.Player]; gone {
+				l.OnLeave(slot)
+			}
+		}
+	}
+	if previousHost != l.Host && l.OnHostChanged != nil {
+		l.OnHostChanged(previousHost, l.Host)
+	}
+}
+
+// migrateHost picks a new host from the remaining players.
+func (l *Lobby) migrateHost() {
+	previous := l.Host
+	choose := l.ChooseHost
+	if choose == nil {
+		choose = LowestSlot
+	}
+	l.Host = choose(l.Players())
+	if l.OnHostChanged != nil {
+		l.OnHostChanged(previous, l.Host)
+	}
+}
@@ -0,0 +1,345 @@
+// Package match3 provides a grid puzzle kit: swap validation, match finding,
+// gravity and refill, cascade scoring, and an animated board controller.
+package match3
+
+import (
+	"math/rand"
+)
+
+// Empty marks a cell with no tile.
+const Empty = -1
+
+// Cell is a board coordinate (Y = 0 is the top row).
+type Cell struct {
+	X, Y int
+}
+
+// Adjacent reports whether two cells share an edge.
+func (c Cell) Adjacent(other Cell) bool {
+	dx, dy := c.X-other.X, c.Y-other.Y
+	return dx*dx+dy*dy == 1
+}
+
+// Match is a horizontal or vertical run of three or more tiles of one kind.
+type Match struct {
+	Kind  int
+	Cells []Cell
+}
+
+// Fall describes a tile moved down by gravity.
+type Fall struct {
+	From, To Cell
+}
+
+// Spawn describes a new tile created by refill.
+type Spawn struct {
+	Cell Cell
+	Kind int
+	Drop int // Rows above the board the tile starts (for drop-in animations)
+}
+
+// Board is the tile grid and the puzzle rules; it has no rendering state.
+type Board struct {
+	Cols, Rows int
+	Kinds      int // Number of tile kinds (0..Kinds-1)
+
+	cells []int
+	rng   *rand.Rand
+}
+
+// NewBoard creates a board filled with random tiles and no initial matches.
+//
+// Parameters:
+//
+//	cols, rows: Board size (negative sizes are treated as 0)
+//	kinds: Number of tile kinds (at least 3 to avoid forced matches;
+//	       values below 1 are treated as 1)
+//	rng: Random source (nil = seeded from the global source); seed it for replays
+//
+// Example:
+//
+//	board := match3.NewBoard(8, 8, 6, rand.New(rand.NewSource(seed)))
+//	if board.CanSwap(a, b) {
+//	    board.Swap(a, b)
+//	    steps := board.Resolve(match3.DefaultScore)
+//	}
+func NewBoard(cols, rows, kinds int, rng *rand.Rand) *Board {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+	cols, rows, kinds = max(cols, 0), max(rows, 0), max(kinds, 1)
+	b := &Board{
+		Cols:  cols,
+		Rows:  rows,
+		Kinds: kinds,
+		cells: make([]int, cols*rows),
+		rng:   rng,
+	}
+	b.fill()
+	return b
+}
+
+// InBounds reports whether a cell is on the board.
+func (b *Board) InBounds(cell Cell) bool {
+	return cell.X >= 0 && cell.Y >= 0 && cell.X < b.Cols && cell.Y < b.Rows
+}
+
+// At returns the tile kind at a cell (Empty if out of bounds or cleared).
+func (b *Board) At(cell Cell) int {
+	if !b.InBounds(cell) {
+		return Empty
+	}
+	return b.cells[cell.Y*b.Cols+cell.X]
+}
+
+// Set places a tile kind at a cell (e.g. for authored levels or tests).
+func (b *Board) Set(cell Cell, kind int) {
+	if b.InBounds(cell) {
+		b.cells[cell.Y*b.Cols+cell.X] = kind
+	}
+}
+
+// Swap exchanges two tiles without validation.
+func (b *Board) Swap(a, c Cell) {
+	ka, kc := b.At(a), b.At(c)
+	b.Set(a, kc)
+	b.Set(c, ka)
+}
+
+// CanSwap reports whether swapping two adjacent cells creates a match.
+func (b *Board) CanSwap(a, c Cell) bool {
+	if !b.InBounds(a) || !b.InBounds(c) || !a.Adjacent(c) || b.At(a) == b.At(c) {
+		return false
+	}
+	b.Swap(a, c)
+	ok := b.matchesAt(a) || b.matchesAt(c)
+	b.Swap(a, c)
+	return ok
+}
+
+// HasMoves reports whether any valid swap exists.
+func (b *Board) HasMoves() bool {
+	return len(b.Hint()) == 2
+}
+
+// Hint returns one valid swap as two cells (nil if the board is stuck).
+func (b *Board) Hint() []Cell {
+	for y := 0; y < b.Rows; y++ {
+		for x := 0; x < b.Cols; x++ {
+			cell := Cell{X: x, Y: y}
+			for _, next := range []Cell{{X: x + 1, Y: y}, {X: x, Y: y + 1}} {
+				if b.CanSwap(cell, next) {
+					return []Cell{cell, next}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// FindMatches returns every horizontal and vertical run of three or more.
+//
+// A tile in both a horizontal and vertical run (L or T shapes) appears in
+// both matches.
+func (b *Board) FindMatches() []Match {
+	matches := make([]Match, 0)
+	scan := func(length, lines int, cellAt func(line, i int) Cell) {
+		for line := 0; line < lines; line++ {
+			start := 0
+			for i := 1; i <= length; i++ {
+				kind := b.At(cellAt(line, start))
+				if i < length && kind != Empty && b.At(cellAt(line, i)) == kind {
+					continue
+				}
+				if kind != Empty && i-start >= 3 {
+					match := Match{Kind: kind}
+					for j := start; j < i; j++ {
+						match.Cells = append(match.Cells, cellAt(line, j))
+					}
+					matches = append(matches, match)
+				}
+				start = i
+			}
+		}
+	}
+	scan(b.Cols, b.Rows, func(row, i int) Cell { return Cell{X: i, Y: row} })
+	scan(b.Rows, b.Cols, func(col, i int) Cell { return Cell{X: col, Y: i} })
+	return matches
+}
+
+// Clear empties the cells of matches.
+//
+// Returns:
+//
+//	int: Number of distinct cells cleared
+func (b *Board) Clear(matches []Match) int {
+	cleared := 0
+	for _, match := range matches {
+		for _, cell := range match.Cells {
+			if b.At(cell) != Empty {
+				b.Set(cell, Empty)
+				cleared++
+			}
+		}
+	}
+	return cleared
+}
+
+// Collapse drops tiles into empty cells below them.
+//
+// Returns:
+//
+//	[]Fall: Tiles that moved (bottom-most first per column)
+func (b *Board) Collapse() []Fall {
+	falls := make([]Fall, 0)
+	for x := 0; x < b.Cols; x++ {
+		target := b.Rows - 1
+		for y := b.Rows - 1; y >= 0; y-- {
+			kind := b.At(Cell{X: x, Y: y})
+			if kind == Empty {
+				continue
+			}
+			if y != target {
+				b.Set(Cell{X: x, Y: target}, kind)
+				b.Set(Cell{X: x, Y: y}, Empty)
+				falls = append(falls, Fall{From: Cell{X: x, Y: y}, To: Cell{X: x, Y: target}})
+			}
+			target--
+		}
+	}
+	return falls
+}
+
+// Refill fills empty cells with random tiles.
+//
+// Returns:
+//
+//	[]Spawn: New tiles with how many rows above the board each should drop from
+func (b *Board) Refill() []Spawn {
+	spawns := make([]Spawn, 0)
+	for x := 0; x < b.Cols; x++ {
+		empty := 0
+		for y := 0; y < b.Rows && b.At(Cell{X: x, Y: y}) == Empty; y++ {
+			empty++
+		}
+		for y := 0; y < empty; y++ {
+			kind := b.rng.Intn(b.Kinds)
+			b.Set(Cell{X: x, Y: y}, kind)
+			spawns = append(spawns, Spawn{Cell: Cell{X: x, Y: y}, Kind: kind, Drop: empty})
+		}
+	}
+	return spawns
+}
+
+// Shuffle rerolls the board until it has no matches and at least one move.
+func (b *Board) Shuffle() {
+	b.fill()
+}
+
+// ScoreFunc scores one cascade step.
+//
+// Parameters:
+//
+//	matches: Matches cleared in this step
+//	chain: Cascade depth (1 = the player's swap, 2+ = cascades)
+type ScoreFunc func(matches []Match, chain int) int
+
+// DefaultScore awards 10 points per tile, +10 per tile beyond three in a run,
+// multiplied by the cascade depth.
+func DefaultScore(matches []Match, chain int) int {
+	score := 0
+	for _, match := range matches {
+		score += 10 * len(match.Cells)
+		if extra := len(match.Cells) - 3; extra > 0 {
+			score += 10 * extra
+		}
+	}
+	return score * chain
+}
+
+// Step is one cascade iteration produced by Resolve.
+type Step struct {
+	Chain   int
+	Matches []Match
+	Falls   []Fall
+	Spawns  []Spawn
+	Score   int
+}
+
+// Resolve clears matches, collapses, and refills until the board is stable.
+//
+// Returns:
+//
+//	[]Step: One entry per cascade (empty if there were no matches)
+func (b *Board) Resolve(score ScoreFunc) []Step {
+	if score == nil {
+		score = DefaultScore
+	}
+	steps := make([]Step, 0)
+	for chain := 1; ; chain++ {
+		step, ok := b.ResolveStep(chain, score)
+		if !ok {
+			return steps
+		}
+		steps = append(steps, step)
+	}
+}
+
+// ResolveStep performs a single clear/collapse/refill iteration.
+//
+// Returns:
+//
+//	Step: What happened (for animation)
+//	bool: False if there were no matches
+func (b *Board) ResolveStep(chain int, score ScoreFunc) (Step, bool) {
+	matches := b.FindMatches()
+	if len(matches) == 0 {
+		return Step{}, false
+	}
+	if score == nil {
+		score = DefaultScore
+	}
+	step := Step{Chain: chain, Matches: matches, Score: score(matches, chain)}
+	b.Clear(matches)
+	step.Falls = b.Collapse()
+	step.Spawns = b.Refill()
+	return step, true
+}
+
+// fill rolls random tiles, avoiding initial matches, until a move exists.
+func (b *Board) fill() {
+	for attempt := 0; attempt < 100; attempt++ {
+		for y := 0; y < b.Rows; y++ {
+			for x := 0; x < b.Cols; x++ {
+				cell := Cell{X: x, Y: y}
+				kind := b.rng.Intn(b.Kinds)
+				for tries := 0; tries < b.Kinds; tries++ {
+					b.Set(cell, kind)
+					if !b.matchesAt(cell) {
+						break
+					}
+					kind = (kind + 1) % b.Kinds
+				}
+			}
+		}
+		if len(b.FindMatches()) == 0 && b.HasMoves() {
+			return
+		}
+	}
+}
+
+// matchesAt reports whether a cell is part of a run of three.
+func (b *Board) matchesAt(cell Cell) bool {
+	kind := b.At(cell)
+	if kind == Empty {
+		return false
+	}
+	count := func(dx, dy int) int {
+		n := 0
+		for c := (Cell{X: cell.X + dx, Y: cell.Y + dy}); b.At(c) == kind; c = (Cell{X: c.X + dx, Y: c.Y + dy}) {
+			n++
+		}
+		return n
+	}
+	return count(-1, 0)+count(1, 0) >= 2 || count(0, -1)+count(0, 1) >= 2
+}
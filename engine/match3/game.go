@@ -0,0 +1,343 @@
+package match3
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TileFactory creates the entity for a tile kind (sprite, collider, etc.).
+type TileFactory func(kind int) *core.Entity
+
+// phase is the controller's animation state.
+type phase int
+
+const (
+	phaseIdle     phase = iota // Waiting for input
+	phaseSwap                  // Swapped tiles moving
+	phaseSwapBack              // Invalid swap reverting
+	phaseClear                 // Matched tiles shrinking
+	phaseFall                  // Tiles falling and refilling
+)
+
+// tween eases an entity's position and scale over time.
+type tween struct {
+	entity             *core.Entity
+	fromPos, toPos     gamemath.Vector2
+	fromScale, toScale float64
+	duration, elapsed  float64
+}
+
+// Game is a Behavior that drives a Board with tile entities and tweened animations.
+//
+// Swaps animate, invalid swaps bounce back, matches shrink away, and tiles fall
+// into place before cascades are checked. Input is ignored while animating.
+type Game struct {
+	Board    *Board
+	Scene    *core.Scene
+	Origin   gamemath.Vector2 // World position of cell (0, 0)'s top-left corner
+	CellSize float64
+	NewTile  TileFactory
+	Score    ScoreFunc
+
+	SwapTime  float64 // Seconds for a swap animation
+	ClearTime float64 // Seconds for matched tiles to shrink
+	FallSpeed float64 // Cells per second for falling tiles
+
+	OnScore  func(points, chain int) // Called for each cascade step
+	OnSettle func()                  // Called when the board comes to rest
+
+	Total int // Accumulated score
+
+	tiles    []*core.Entity
+	tweens   []*tween
+	phase    phase
+	chain    int
+	pending  []Cell // Swap in progress
+	selected *Cell
+}
+
+// NewGame creates a controller and spawns tile entities for the board.
+//
+// Example:
+//
+//	game := match3.NewGame(board, scene, gamemath.Vector2{X: 100, Y: 50}, 64, func(kind int) *core.Entity {
+//	    return &core.Entity{Active: true, Sprite: gems[kind], Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}}
+//	})
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: game})
+//	// On click:
+//	if cell, ok := game.CellAt(worldPos); ok {
+//	    game.Select(cell)
+//	}
+func NewGame(board *Board, scene *core.Scene, origin gamemath.Vector2, cellSize float64, newTile TileFactory) *Game {
+	g := &Game{
+		Board:     board,
+		Scene:     scene,
+		Origin:    origin,
+		CellSize:  cellSize,
+		NewTile:   newTile,
+		Score:     DefaultScore,
+		SwapTime:  0.15,
+		ClearTime: 0.15,
+		FallSpeed: 12,
+		tiles:     make([]*core.Entity, board.Cols*board.Rows),
+		tweens:    make([]*tween, 0),
+	}
+	g.rebuild()
+	return g
+}
+
+// CellAt converts a world position to a board cell.
+func (g *Game) CellAt(position gamemath.Vector2) (Cell, bool) {
+	cell := Cell{
+		X: int(math.Floor((position.X - g.Origin.X) / g.CellSize)),
+		Y: int(math.Floor((position.Y - g.Origin.Y) / g.CellSize)),
+	}
+	return cell, g.Board.InBounds(cell)
+}
+
+// CellCenter returns the world position of a cell's center.
+func (g *Game) CellCenter(cell Cell) gamemath.Vector2 {
+	return gamemath.Vector2{
+		X: g.Origin.X + (float64(cell.X)+0.5)*g.CellSize,
+		Y: g.Origin.Y + (float64(cell.Y)+0.5)*g.CellSize,
+	}
+}
+
+// Tile returns the entity displaying a cell (nil while empty).
+func (g *Game) Tile(cell Cell) *core.Entity {
+	if !g.Board.InBounds(cell) {
+		return nil
+	}
+	return g.tiles[g.index(cell)]
+}
+
+// Busy reports whether an animation is playing (input is ignored).
+func (g *Game) Busy() bool {
+	return g.phase != phaseIdle
+}
+
+// Selected returns the selected cell, if any.
+func (g *Game) Selected() (Cell, bool) {
+	if g.selected == nil {
+		return Cell{}, false
+	}
+	return *g.selected, true
+}
+
+// Select handles a click: the first click selects, a click on an adjacent cell swaps.
+func (g *Game) Select(cell Cell) {
+	if g.Busy() || !g.Board.InBounds(cell) {
+		return
+	}
+	if g.selected != nil && g.selected.Adjacent(cell) {
+		from := *g.selected
+		g.selected = nil
+		g.TrySwap(from, cell)
+		return
+	}
+	g.selected = &cell
+}
+
+// TrySwap animates a swap; invalid swaps animate back.
+//
+// Returns:
+//
+//	bool: True if the swap creates a match
+func (g *Game) TrySwap(a, b Cell) bool {
+	if g.Busy() || !g.Board.InBounds(a) || !g.Board.InBounds(b) || !a.Adjacent(b) {
+		return false
+	}
+	valid := g.Board.CanSwap(a, b)
+	g.swapTiles(a, b)
+	g.pending = []Cell{a, b}
+	g.phase = phaseSwapBack
+	if valid {
+		g.Board.Swap(a, b)
+		g.phase = phaseSwap
+	}
+	return valid
+}
+
+// Update advances tweens and the cascade state machine (implements core.Behavior).
+func (g *Game) Update(_ *core.Entity, dt float64) {
+	if g.advanceTweens(dt) {
+		return
+	}
+
+	switch g.phase {
+	case phaseSwap:
+		g.chain = 0
+		g.beginClear()
+	case phaseSwapBack:
+		g.swapTiles(g.pending[0], g.pending[1])
+		g.pending = nil
+		g.phase = phaseFall // Settle after the bounce
+	case phaseClear:
+		g.beginFall()
+	case phaseFall:
+		if !g.beginClear() {
+			g.settle()
+		}
+	}
+}
+
+// beginClear scores and shrinks matched tiles; returns false if nothing matched.
+func (g *Game) beginClear() bool {
+	matches := g.Board.FindMatches()
+	if len(matches) == 0 {
+		return false
+	}
+
+	g.chain++
+	score := g.Score
+	if score == nil {
+		score = DefaultScore
+	}
+	points := score(matches, g.chain)
+	g.Total += points
+	if g.OnScore != nil {
+		g.OnScore(points, g.chain)
+	}
+
+	for _, match := range matches {
+		for _, cell := range match.Cells {
+			if tile := g.tiles[g.index(cell)]; tile != nil {
+				g.tween(tile, tile.Transform.Position, 0, g.ClearTime)
+			}
+		}
+	}
+	g.Board.Clear(matches)
+	g.phase = phaseClear
+	return true
+}
+
+// beginFall removes cleared tiles, collapses, refills, and animates the drops.
+func (g *Game) beginFall() {
+	for i, tile := range g.tiles {
+		if g.Board.cells[i] == Empty && tile != nil {
+			g.Scene.RemoveEntity(tile.ID)
+			g.tiles[i] = nil
+		}
+	}
+
+	for _, fall := range g.Board.Collapse() {
+		tile := g.tiles[g.index(fall.From)]
+		g.tiles[g.index(fall.From)] = nil
+		g.tiles[g.index(fall.To)] = tile
+		if tile != nil {
+			g.tween(tile, g.CellCenter(fall.To), 1, g.fallTime(fall.To.Y-fall.From.Y))
+		}
+	}
+
+	for _, spawn := range g.Board.Refill() {
+		tile := g.spawnTile(spawn.Cell, spawn.Kind)
+		if tile == nil {
+			continue
+		}
+		start := g.CellCenter(Cell{X: spawn.Cell.X, Y: spawn.Cell.Y - spawn.Drop})
+		tile.Transform.Position = start
+		g.tween(tile, g.CellCenter(spawn.Cell), 1, g.fallTime(spawn.Drop))
+	}
+	g.phase = phaseFall
+}
+
+// settle returns to idle, reshuffling if no moves remain.
+func (g *Game) settle() {
+	g.phase = phaseIdle
+	if !g.Board.HasMoves() {
+		g.Board.Shuffle()
+		g.rebuild()
+	}
+	if g.OnSettle != nil {
+		g.OnSettle()
+	}
+}
+
+// rebuild replaces all tile entities to match the board.
+func (g *Game) rebuild() {
+	for i, tile := range g.tiles {
+		if tile != nil {
+			g.Scene.RemoveEntity(tile.ID)
+			g.tiles[i] = nil
+		}
+	}
+	for y := 0; y < g.Board.Rows; y++ {
+		for x := 0; x < g.Board.Cols; x++ {
+			cell := Cell{X: x, Y: y}
+			g.spawnTile(cell, g.Board.At(cell))
+		}
+	}
+}
+
+// spawnTile creates and places a tile entity.
+func (g *Game) spawnTile(cell Cell, kind int) *core.Entity {
+	if g.NewTile == nil || kind == Empty {
+		return nil
+	}
+	tile := g.NewTile(kind)
+	if tile == nil {
+		return nil
+	}
+	tile.Transform.Position = g.CellCenter(cell)
+	g.Scene.AddEntity(tile)
+	g.tiles[g.index(cell)] = tile
+	return tile
+}
+
+// swapTiles exchanges two tile entities and tweens them to their new cells.
+func (g *Game) swapTiles(a, b Cell) {
+	ia, ib := g.index(a), g.index(b)
+	g.tiles[ia], g.tiles[ib] = g.tiles[ib], g.tiles[ia]
+	for _, cell := range []Cell{a, b} {
+		if tile := g.tiles[g.index(cell)]; tile != nil {
+			g.tween(tile, g.CellCenter(cell), 1, g.SwapTime)
+		}
+	}
+}
+
+// tween starts easing an entity to a position and uniform scale.
+func (g *Game) tween(entity *core.Entity, to gamemath.Vector2, scale, duration float64) {
+	g.tweens = append(g.tweens, &tween{
+		entity:    entity,
+		fromPos:   entity.Transform.Position,
+		toPos:     to,
+		fromScale: entity.Transform.Scale.X,
+		toScale:   scale,
+		duration:  duration,
+	})
+}
+
+// advanceTweens steps all tweens and reports whether any are still running.
+func (g *Game) advanceTweens(dt float64) bool {
+	running := g.tweens[:0]
+	for _, t := range g.tweens {
+		t.elapsed += dt
+		progress := 1.0
+		if t.duration > 0 {
+			progress = math.Min(t.elapsed/t.duration, 1)
+		}
+		eased := 1 - (1-progress)*(1-progress) // Ease-out quad
+		t.entity.Transform.Position = t.fromPos.Add(t.toPos.Sub(t.fromPos).Scale(eased))
+		scale := t.fromScale + (t.toScale-t.fromScale)*eased
+		t.entity.Transform.Scale = gamemath.Vector2{X: scale, Y: scale}
+		if progress < 1 {
+			running = append(running, t)
+		}
+	}
+	g.tweens = running
+	return len(g.tweens) > 0
+}
+
+// fallTime returns the duration of a fall of n cells.
+func (g *Game) fallTime(cells int) float64 {
+	if g.FallSpeed <= 0 {
+		return 0
+	}
+	return float64(cells) / g.FallSpeed
+}
+
+func (g *Game) index(cell Cell) int {
+	return cell.Y*g.Board.Cols + cell.X
+}
@@ -0,0 +1,298 @@
+package math
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ErrEmptyCurve is returned when a curve is loaded without keyframes.
+var ErrEmptyCurve = errors.New("animation curve has no keyframes")
+
+// CurveInterpolation is how a curve moves from one keyframe to the next.
+type CurveInterpolation int
+
+const (
+	// CurveLinear interpolates in a straight line.
+	CurveLinear CurveInterpolation = iota
+	// CurveConstant holds the value until the next key (steps).
+	CurveConstant
+	// CurveSmooth eases through keys with automatic (Catmull-Rom) tangents;
+	// the first and last keys are flat.
+	CurveSmooth
+	// CurveCubic uses the keys' explicit OutTangent and InTangent.
+	CurveCubic
+)
+
+// curveInterpolationNames maps modes to their data names.
+var curveInterpolationNames = map[CurveInterpolation]string{
+	CurveLinear:   "linear",
+	CurveConstant: "constant",
+	CurveSmooth:   "smooth",
+	CurveCubic:    "cubic",
+}
+
+// String returns the mode's data name.
+func (i CurveInterpolation) String() string {
+	if name, ok := curveInterpolationNames[i]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalText writes the mode's data name.
+func (i CurveInterpolation) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText parses a data name ("linear", "constant", "smooth", "cubic").
+func (i *CurveInterpolation) UnmarshalText(text []byte) error {
+	for mode, name := range curveInterpolationNames {
+		if name == string(text) {
+			*i = mode
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown curve interpolation %q", text)
+}
+
+// CurveWrap is how a curve is evaluated outside its first and last keys.
+type CurveWrap int
+
+const (
+	// CurveClamp holds the first and last values.
+	CurveClamp CurveWrap = iota
+	// CurveLoop repeats the curve.
+	CurveLoop
+	// CurvePingPong repeats the curve, alternating direction.
+	CurvePingPong
+)
+
+// curveWrapNames maps wrap modes to their data names.
+var curveWrapNames = map[CurveWrap]string{
+	CurveClamp:    "clamp",
+	CurveLoop:     "loop",
+	CurvePingPong: "ping_pong",
+}
+
+// String returns the wrap mode's data name.
+func (w CurveWrap) String() string {
+	if name, ok := curveWrapNames[w]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalText writes the wrap mode's data name.
+func (w CurveWrap) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText parses a data name ("clamp", "loop", "ping_pong").
+func (w *CurveWrap) UnmarshalText(text []byte) error {
+	for mode, name := range curveWrapNames {
+		if name == string(text) {
+			*w = mode
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown curve wrap %q", text)
+}
+
+// Keyframe is a value at a point in time.
+type Keyframe struct {
+	Time          float64            `json:"time"`
+	Value         float64            `json:"value"`
+	Interpolation CurveInterpolation `json:"interpolation"`         // Toward the next key
+	InTangent     float64            `json:"in_tangent,omitempty"`  // Slope arriving (CurveCubic, value/time)
+	OutTangent    float64            `json:"out_tangent,omitempty"` // Slope leaving (CurveCubic, value/time)
+}
+
+// AnimationCurve maps time to a value through keyframes.
+//
+// Curves describe shapes that designers tune as data rather than code:
+// tween easing, camera shake envelopes, music fades, and difficulty ramps.
+// Keys must stay sorted by time; use AddKey or NewAnimationCurve rather than
+// appending to Keys directly.
+//
+// Data format:
+//
+//	{
+//	  "wrap": "clamp",
+//	  "keys": [
+//	    {"time": 0, "value": 0, "interpolation": "smooth"},
+//	    {"time": 60, "value": 1.5, "interpolation": "linear"},
+//	    {"time": 300, "value": 3}
+//	  ]
+//	}
+type AnimationCurve struct {
+	Keys []Keyframe `json:"keys"`
+	Wrap CurveWrap  `json:"wrap"`
+}
+
+// NewAnimationCurve creates a curve from keyframes in any order.
+//
+// Example:
+//
+//	// Shake strength: sharp attack, smooth decay
+//	envelope := gamemath.NewAnimationCurve(
+//	    gamemath.Keyframe{Time: 0, Value: 0},
+//	    gamemath.Keyframe{Time: 0.05, Value: 1, Interpolation: gamemath.CurveSmooth},
+//	    gamemath.Keyframe{Time: 0.5, Value: 0},
+//	)
+//	strength := envelope.Evaluate(elapsed)
+func NewAnimationCurve(keys ...Keyframe) *AnimationCurve {
+	curve := &AnimationCurve{Keys: append([]Keyframe(nil), keys...)}
+	curve.sort()
+	return curve
+}
+
+// LinearCurve creates a straight line from (t0, v0) to (t1, v1), clamped outside.
+func LinearCurve(t0, v0, t1, v1 float64) *AnimationCurve {
+	return NewAnimationCurve(Keyframe{Time: t0, Value: v0}, Keyframe{Time: t1, Value: v1})
+}
+
+// EaseInOutCurve creates an S-curve from (t0, v0) to (t1, v1), clamped outside.
+func EaseInOutCurve(t0, v0, t1, v1 float64) *AnimationCurve {
+	return NewAnimationCurve(
+		Keyframe{Time: t0, Value: v0, Interpolation: CurveSmooth},
+		Keyframe{Time: t1, Value: v1, Interpolation: CurveSmooth},
+	)
+}
+
+// ParseAnimationCurve decodes a curve from JSON (see AnimationCurve).
+//
+// Returns:
+//
+//	*AnimationCurve: Curve with keys sorted by time
+//	error: Non-nil for malformed JSON, unknown mode names, or no keys
+func ParseAnimationCurve(data []byte) (*AnimationCurve, error) {
+	var curve AnimationCurve
+	if err := json.Unmarshal(data, &curve); err != nil {
+		return nil, fmt.Errorf("failed to decode animation curve: %w", err)
+	}
+	if len(curve.Keys) == 0 {
+		return nil, ErrEmptyCurve
+	}
+	curve.sort()
+	return &curve, nil
+}
+
+// AddKey inserts a keyframe, keeping keys sorted (a key at the same time is replaced).
+func (c *AnimationCurve) AddKey(key Keyframe) {
+	i := sort.Search(len(c.Keys), func(i int) bool { return c.Keys[i].Time >= key.Time })
+	if i < len(c.Keys) && c.Keys[i].Time == key.Time {
+		c.Keys[i] = key
+		return
+	}
+	c.Keys = append(c.Keys, Keyframe{})
+	copy(c.Keys[i+1:], c.Keys[i:])
+	c.Keys[i] = key
+}
+
+// Start returns the time of the first key (0 for an empty curve).
+func (c *AnimationCurve) Start() float64 {
+	if len(c.Keys) == 0 {
+		return 0
+	}
+	return c.Keys[0].Time
+}
+
+// End returns the time of the last key (0 for an empty curve).
+func (c *AnimationCurve) End() float64 {
+	if len(c.Keys) == 0 {
+		return 0
+	}
+	return c.Keys[len(c.Keys)-1].Time
+}
+
+// Evaluate returns the curve's value at time t
+//
+// Parameters:
+//
+//	t: Time (wrapped by Wrap outside the key range)
+//
+// Returns:
+//
+//	float64: Interpolated value (0 for an empty curve)
+func (c *AnimationCurve) Evaluate(t float64) float64 {
+	switch len(c.Keys) {
+	case 0:
+		return 0
+	case 1:
+		return c.Keys[0].Value
+	}
+	t = c.wrap(t)
+	last := len(c.Keys) - 1
+	if t <= c.Keys[0].Time {
+		return c.Keys[0].Value
+	}
+	if t >= c.Keys[last].Time {
+		return c.Keys[last].Value
+	}
+
+	// First key after t; the segment is [i-1, i]
+	i := sort.Search(len(c.Keys), func(i int) bool { return c.Keys[i].Time > t })
+	a, b := c.Keys[i-1], c.Keys[i]
+	span := b.Time - a.Time
+	if span <= 0 {
+		return b.Value
+	}
+	u := (t - a.Time) / span
+
+	switch a.Interpolation {
+	case CurveConstant:
+		return a.Value
+	case CurveSmooth:
+		return hermite(a.Value, b.Value, c.autoTangent(i-1)*span, c.autoTangent(i)*span, u)
+	case CurveCubic:
+		return hermite(a.Value, b.Value, a.OutTangent*span, b.InTangent*span, u)
+	}
+	return a.Value + (b.Value-a.Value)*u
+}
+
+// wrap maps t into the key range according to Wrap.
+func (c *AnimationCurve) wrap(t float64) float64 {
+	start, end := c.Start(), c.End()
+	length := end - start
+	if c.Wrap == CurveClamp || length <= 0 || (t >= start && t <= end) {
+		return t
+	}
+	offset := math.Mod(t-start, length)
+	if offset < 0 {
+		offset += length
+	}
+	if c.Wrap == CurvePingPong {
+		cycle := math.Floor((t - start) / length)
+		if int64(cycle)%2 != 0 {
+			offset = length - offset
+		}
+	}
+	return start + offset
+}
+
+// autoTangent returns the Catmull-Rom slope at key i (flat at the ends).
+func (c *AnimationCurve) autoTangent(i int) float64 {
+	if i <= 0 || i >= len(c.Keys)-1 {
+		return 0
+	}
+	prev, next := c.Keys[i-1], c.Keys[i+1]
+	if next.Time == prev.Time {
+		return 0
+	}
+	return (next.Value - prev.Value) / (next.Time - prev.Time)
+}
+
+// sort orders keys by time, keeping the order of keys at equal times.
+func (c *AnimationCurve) sort() {
+	sort.SliceStable(c.Keys, func(i, j int) bool { return c.Keys[i].Time < c.Keys[j].Time })
+}
+
+// hermite evaluates a cubic Hermite segment with tangents scaled to the segment.
+func hermite(p0, p1, m0, m1, u float64) float64 {
+	u2 := u * u
+	u3 := u2 * u
+	return (2*u3-3*u2+1)*p0 + (u3-2*u2+u)*m0 + (-2*u3+3*u2)*p1 + (u3-u2)*m1
+}
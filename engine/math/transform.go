@@ -17,3 +17,46 @@ func (t *Transform) Translate(dx, dy float64) {
 func (t *Transform) Rotate(degrees float64) {
 	t.Rotation += degrees
 }
+
+// TransformPoint converts a point from this transform's local space to the
+// space the transform is in (scale, then rotate, then translate).
+func (t Transform) TransformPoint(local Vector2) Vector2 {
+	scaled := Vector2{X: local.X * t.Scale.X, Y: local.Y * t.Scale.Y}
+	return t.Position.Add(scaled.Rotate(t.Rotation))
+}
+
+// InverseTransformPoint converts a point into this transform's local space
+// (the inverse of TransformPoint). Axes with zero scale map to 0.
+func (t Transform) InverseTransformPoint(point Vector2) Vector2 {
+	local := point.Sub(t.Position).Rotate(-t.Rotation)
+	if t.Scale.X != 0 {
+		local.X /= t.Scale.X
+	} else {
+		local.X = 0
+	}
+	if t.Scale.Y != 0 {
+		local.Y /= t.Scale.Y
+	} else {
+		local.Y = 0
+	}
+	return local
+}
+
+// Compose returns a child transform expressed in this transform's space:
+// the child's position is transformed as a point, rotations add, and scales
+// multiply.
+//
+// Example:
+//
+//	// Turret mounted 20 units in front of a rotated ship
+//	world := ship.Compose(gamemath.Transform{
+//	    Position: gamemath.Vector2{X: 20},
+//	    Scale:    gamemath.Vector2{X: 1, Y: 1},
+//	})
+func (t Transform) Compose(child Transform) Transform {
+	return Transform{
+		Position: t.TransformPoint(child.Position),
+		Rotation: t.Rotation + child.Rotation,
+		Scale:    Vector2{X: t.Scale.X * child.Scale.X, Y: t.Scale.Y * child.Scale.Y},
+	}
+}
@@ -50,9 +50,27 @@ func (v Vector2) Normalize() Vector2 {
 	}
 }
 
+// Dot returns the dot product of v and other.
+func (v Vector2) Dot(other Vector2) float64 {
+	return v.X*other.X + v.Y*other.Y
+}
+
 // Distance returns the distance between v and other.
 func (v Vector2) Distance(other Vector2) float64 {
 	dx := v.X - other.X
 	dy := v.Y - other.Y
 	return math.Sqrt(dx*dx + dy*dy)
 }
+
+// Rotate returns the vector rotated by the given angle in degrees
+// (positive = clockwise on screen, matching Transform.Rotation).
+func (v Vector2) Rotate(degrees float64) Vector2 {
+	if degrees == 0 {
+		return v
+	}
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+	return Vector2{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
@@ -0,0 +1,94 @@
+// Package observable provides values that notify subscribers when they
+// change, and HUD widgets that bind to them.
+//
+// A bound label re-formats and re-rasterizes its text only when the value
+// changes, instead of every frame:
+//
+//	score := observable.NewValue(0)
+//	label := observable.NewLabel(font, 10, 10, gamemath.White)
+//	observable.BindLabel(label, score, func(v int) string { return fmt.Sprintf("Score: %d", v) })
+//	engine.SetRenderUICallback(func() { _ = label.Draw(engine.Renderer()) })
+//
+//	score.Set(score.Get() + 100) // Label updates on the next Draw
+package observable
+
+// Value holds a value and notifies subscribers when it changes.
+//
+// Values are not safe for concurrent use; set them from the game thread.
+type Value[T comparable] struct {
+	value       T
+	subscribers []subscriber[T]
+	nextID      int
+}
+
+// subscriber is a registered change callback.
+type subscriber[T comparable] struct {
+	id       int
+	callback func(old, value T)
+}
+
+// NewValue creates a value.
+func NewValue[T comparable](initial T) *Value[T] {
+	return &Value[T]{value: initial}
+}
+
+// Get returns the current value.
+func (v *Value[T]) Get() T {
+	return v.value
+}
+
+// Set changes the value, notifying subscribers if it differs.
+func (v *Value[T]) Set(value T) {
+	if value == v.value {
+		return
+	}
+	old := v.value
+	v.value = value
+	subscribers := v.subscribers // Changes made by callbacks apply next time
+	for _, s := range subscribers {
+		s.callback(old, value)
+	}
+}
+
+// Update sets the value from a function of the current value.
+//
+// Example:
+//
+//	health.Update(func(hp int) int { return max(hp-damage, 0) })
+func (v *Value[T]) Update(change func(T) T) {
+	v.Set(change(v.value))
+}
+
+// Subscribe registers a change callback.
+//
+// Parameters:
+//
+//	callback: Called with the previous and new value after each change
+//
+// Returns:
+//
+//	func(): Call to unsubscribe
+func (v *Value[T]) Subscribe(callback func(old, value T)) func() {
+	v.nextID++
+	id := v.nextID
+	v.subscribers = append(v.subscribers, subscriber[T]{id: id, callback: callback})
+	return func() {
+		for i, s := range v.subscribers {
+			if s.id == id {
+				v.subscribers = append(v.subscribers[:i:i], v.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Observe calls callback with the current value now and after every change.
+func (v *Value[T]) Observe(callback func(value T)) func() {
+	callback(v.value)
+	return v.Subscribe(func(_, value T) { callback(value) })
+}
+
+// Number is a numeric type a Bar can display.
+type Number interface {
+	~int | ~int32 | ~int64 | ~float32 | ~float64
+}
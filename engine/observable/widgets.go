@@ -0,0 +1,165 @@
+package observable
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Label is screen-space text that keeps its rendered texture until the text changes.
+type Label struct {
+	Font  *graphics.Font
+	X, Y  int // Top-left corner in screen pixels
+	Color gamemath.Color
+
+	text     string
+	texture  *sdl.Texture
+	width    int32
+	height   int32
+	dirty    bool
+	rendered int // Number of times the text was rasterized
+}
+
+// NewLabel creates an empty label.
+func NewLabel(font *graphics.Font, x, y int, color gamemath.Color) *Label {
+	return &Label{Font: font, X: x, Y: y, Color: color}
+}
+
+// SetText changes the text; the texture is rebuilt on the next Draw.
+func (l *Label) SetText(text string) {
+	if text == l.text {
+		return
+	}
+	l.text = text
+	l.dirty = true
+}
+
+// Text returns the current text.
+func (l *Label) Text() string {
+	return l.text
+}
+
+// Rendered returns how many times the text has been rasterized.
+func (l *Label) Rendered() int {
+	return l.rendered
+}
+
+// Draw renders the label, rasterizing the text only if it changed.
+func (l *Label) Draw(renderer *graphics.Renderer) error {
+	if l.dirty || l.texture == nil && l.text != "" {
+		l.release()
+		l.dirty = false
+		if l.text != "" && l.Font != nil {
+			texture, width, height, err := l.Font.RenderText(renderer.GetSDLRenderer(), l.text, l.Color)
+			if err != nil {
+				return err
+			}
+			l.texture, l.width, l.height = texture, width, height
+			l.rendered++
+		}
+	}
+	if l.texture == nil {
+		return nil
+	}
+	dst := sdl.Rect{X: int32(l.X), Y: int32(l.Y), W: l.width, H: l.height}
+	return renderer.GetSDLRenderer().Copy(l.texture, nil, &dst)
+}
+
+// Destroy releases the cached texture.
+func (l *Label) Destroy() {
+	l.release()
+}
+
+func (l *Label) release() {
+	if l.texture != nil {
+		_ = l.texture.Destroy() // Best effort cleanup
+		l.texture = nil
+	}
+}
+
+// BindLabel keeps a label's text in sync with a value.
+//
+// Parameters:
+//
+//	label: Label to update
+//	value: Source value
+//	format: Converts the value to text (called only when it changes)
+//
+// Returns:
+//
+//	func(): Call to unbind
+func BindLabel[T comparable](label *Label, value *Value[T], format func(T) string) func() {
+	return value.Observe(func(v T) { label.SetText(format(v)) })
+}
+
+// Bar is a screen-space fill bar (health, stamina, progress).
+type Bar struct {
+	Bounds     gamemath.Rectangle // Screen pixels
+	Fill       gamemath.Color
+	Background gamemath.Color
+	Border     gamemath.Color // Outline color (zero alpha = none)
+
+	fraction float64
+}
+
+// NewBar creates an empty bar.
+//
+// Example:
+//
+//	health := observable.NewValue(100)
+//	bar := observable.NewBar(gamemath.Rectangle{X: 10, Y: 40, Width: 200, Height: 12}, gamemath.Red)
+//	observable.BindBar(bar, health, 100)
+func NewBar(bounds gamemath.Rectangle, fill gamemath.Color) *Bar {
+	return &Bar{
+		Bounds:     bounds,
+		Fill:       fill,
+		Background: gamemath.Color{R: 0, G: 0, B: 0, A: 160},
+	}
+}
+
+// SetFraction sets the filled portion, clamped to 0-1.
+func (b *Bar) SetFraction(fraction float64) {
+	b.fraction = math.Max(0, math.Min(1, fraction))
+}
+
+// Fraction returns the filled portion (0-1).
+func (b *Bar) Fraction() float64 {
+	return b.fraction
+}
+
+// Draw renders the bar.
+func (b *Bar) Draw(renderer *graphics.Renderer) error {
+	if b.Background.A > 0 {
+		if err := renderer.FillRect(b.Bounds, b.Background); err != nil {
+			return err
+		}
+	}
+	if b.fraction > 0 {
+		fill := b.Bounds
+		fill.Width *= b.fraction
+		if err := renderer.FillRect(fill, b.Fill); err != nil {
+			return err
+		}
+	}
+	if b.Border.A > 0 {
+		return renderer.DrawRect(b.Bounds, b.Border)
+	}
+	return nil
+}
+
+// BindBar keeps a bar's fill in sync with a value out of a maximum.
+//
+// Returns:
+//
+//	func(): Call to unbind
+func BindBar[T Number](bar *Bar, value *Value[T], maximum T) func() {
+	return value.Observe(func(v T) {
+		if maximum == 0 {
+			bar.SetFraction(0)
+			return
+		}
+		bar.SetFraction(float64(v) / float64(maximum))
+	})
+}
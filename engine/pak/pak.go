@@ -0,0 +1,438 @@
+// Package pak reads and writes packed asset archives.
+//
+// A pak is a single file holding many assets with an index, optional deflate
+// compression, and optional per-entry sealing (see the secure package). An
+// Archive implements fs.FS, so it can be mounted on graphics.AssetManager in
+// place of loose files on disk.
+//
+// Layout:
+//
+//	"GGPAK1" | entry data... | index | index offset (u64) | "GGPAK1"
+//
+// Index: entry count (u32), then per entry: name length (u16), name,
+// offset (u64), stored size (u64), size (u64), flags (u8), CRC-32 (u32).
+// Integers are little-endian.
+package pak
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dshills/gogame/engine/secure"
+)
+
+// Pak errors.
+var (
+	ErrInvalidPak = errors.New("not a pak file")
+	ErrCorrupt    = errors.New("pak entry is corrupt")
+	ErrDuplicate  = errors.New("duplicate pak entry")
+	ErrSealed     = errors.New("pak entry is sealed and no sealer is set")
+)
+
+const (
+	magic      = "GGPAK1"
+	footerSize = 8 + len(magic)
+	maxNameLen = math.MaxUint16 // Names are stored with a u16 length
+)
+
+// Entry flags.
+const (
+	flagCompressed = 1 << iota
+	flagSealed
+)
+
+// Entry describes one file in an archive.
+type Entry struct {
+	Name       string // Slash-separated path (e.g. "sprites/player.png")
+	Size       int64  // Original size in bytes
+	StoredSize int64  // Size in the archive after compression/sealing
+	Compressed bool
+	Sealed     bool
+
+	offset int64
+	crc    uint32
+}
+
+// Writer builds a pak file.
+type Writer struct {
+	Compress bool          // Deflate entries that shrink (default true)
+	Sealer   secure.Sealer // Optional; seals every entry
+
+	w       io.Writer
+	offset  int64
+	entries []Entry
+	names   map[string]bool
+	closed  bool
+}
+
+// NewWriter starts a pak on w.
+//
+// Example:
+//
+//	file, _ := os.Create("game.pak")
+//	pw, err := pak.NewWriter(file)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := pw.AddDir("assets"); err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := pw.Close(); err != nil {
+//	    log.Fatal(err)
+//	}
+//	_ = file.Close()
+func NewWriter(w io.Writer) (*Writer, error) {
+	if _, err := io.WriteString(w, magic); err != nil {
+		return nil, fmt.Errorf("failed to write pak header: %w", err)
+	}
+	return &Writer{
+		Compress: true,
+		w:        w,
+		offset:   int64(len(magic)),
+		entries:  make([]Entry, 0),
+		names:    make(map[string]bool),
+	}, nil
+}
+
+// Add stores data under a slash-separated name (at most 65535 bytes).
+func (pw *Writer) Add(name string, data []byte) error {
+	name = Clean(name)
+	if !fs.ValidPath(name) || name == "." {
+		return fmt.Errorf("invalid pak entry name: %q", name)
+	}
+	if len(name) > maxNameLen {
+		return fmt.Errorf("pak entry name too long: %d bytes (max %d)", len(name), maxNameLen)
+	}
+	if pw.names[name] {
+		return fmt.Errorf("%w: %s", ErrDuplicate, name)
+	}
+
+	entry := Entry{Name: name, Size: int64(len(data)), crc: crc32.ChecksumIEEE(data)}
+	stored := data
+	if pw.Compress {
+		compressed, err := deflate(data)
+		if err != nil {
+			return err
+		}
+		if len(compressed) < len(data) {
+			stored = compressed
+			entry.Compressed = true
+		}
+	}
+	if pw.Sealer != nil {
+		sealed, err := pw.Sealer.Seal(stored)
+		if err != nil {
+			return fmt.Errorf("failed to seal pak entry %s: %w", name, err)
+		}
+		stored = sealed
+		entry.Sealed = true
+	}
+
+	if _, err := pw.w.Write(stored); err != nil {
+		return fmt.Errorf("failed to write pak entry %s: %w", name, err)
+	}
+	entry.offset = pw.offset
+	entry.StoredSize = int64(len(stored))
+	pw.offset += entry.StoredSize
+	pw.entries = append(pw.entries, entry)
+	pw.names[name] = true
+	return nil
+}
+
+// AddFile stores a file from disk under name.
+func (pw *Writer) AddFile(name, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read asset: %w", err)
+	}
+	return pw.Add(name, data)
+}
+
+// AddDir stores every file under root, named by their path relative to root.
+func (pw *Writer) AddDir(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return pw.AddFile(filepath.ToSlash(rel), path)
+	})
+}
+
+// Close writes the index and footer. It does not close the underlying writer.
+func (pw *Writer) Close() error {
+	if pw.closed {
+		return nil
+	}
+	pw.closed = true
+
+	var index bytes.Buffer
+	le := binary.LittleEndian
+	index.Write(le.AppendUint32(nil, uint32(len(pw.entries))))
+	for _, entry := range pw.entries {
+		index.Write(le.AppendUint16(nil, uint16(len(entry.Name))))
+		index.WriteString(entry.Name)
+		index.Write(le.AppendUint64(nil, uint64(entry.offset)))
+		index.Write(le.AppendUint64(nil, uint64(entry.StoredSize)))
+		index.Write(le.AppendUint64(nil, uint64(entry.Size)))
+		index.WriteByte(entry.flags())
+		index.Write(le.AppendUint32(nil, entry.crc))
+	}
+	index.Write(le.AppendUint64(nil, uint64(pw.offset)))
+	index.WriteString(magic)
+
+	if _, err := pw.w.Write(index.Bytes()); err != nil {
+		return fmt.Errorf("failed to write pak index: %w", err)
+	}
+	return nil
+}
+
+// Archive is an open pak file. It implements fs.FS and fs.ReadFileFS.
+type Archive struct {
+	Sealer secure.Sealer // Required to read sealed entries
+
+	r       io.ReaderAt
+	closer  io.Closer
+	entries map[string]*Entry
+	names   []string
+}
+
+// Open opens a pak file from disk.
+//
+// Example:
+//
+//	archive, err := pak.Open("game.pak")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer archive.Close()
+//	assets.Mount(archive)
+//	texture, err := assets.LoadTexture("sprites/player.png")
+func Open(path string) (*Archive, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pak: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close() // Best effort cleanup
+		return nil, fmt.Errorf("failed to stat pak: %w", err)
+	}
+	archive, err := NewReader(file, info.Size())
+	if err != nil {
+		_ = file.Close() // Best effort cleanup
+		return nil, err
+	}
+	archive.closer = file
+	return archive, nil
+}
+
+// NewReader reads a pak's index from r (size is the total pak size).
+func NewReader(r io.ReaderAt, size int64) (*Archive, error) {
+	if size < int64(len(magic)+footerSize) {
+		return nil, ErrInvalidPak
+	}
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-int64(footerSize)); err != nil {
+		return nil, fmt.Errorf("failed to read pak footer: %w", err)
+	}
+	if string(footer[8:]) != magic {
+		return nil, ErrInvalidPak
+	}
+	indexOffset := int64(binary.LittleEndian.Uint64(footer))
+	indexEnd := size - int64(footerSize)
+	if indexOffset < int64(len(magic)) || indexOffset > indexEnd {
+		return nil, fmt.Errorf("%w: bad index offset", ErrInvalidPak)
+	}
+	index := make([]byte, indexEnd-indexOffset)
+	if _, err := r.ReadAt(index, indexOffset); err != nil {
+		return nil, fmt.Errorf("failed to read pak index: %w", err)
+	}
+
+	archive := &Archive{r: r, entries: make(map[string]*Entry)}
+	if err := archive.parseIndex(index, indexOffset); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// parseIndex decodes the index block.
+func (a *Archive) parseIndex(index []byte, dataEnd int64) error {
+	le := binary.LittleEndian
+	invalid := fmt.Errorf("%w: truncated index", ErrInvalidPak)
+	if len(index) < 4 {
+		return invalid
+	}
+	count := int(le.Uint32(index))
+	index = index[4:]
+	for i := 0; i < count; i++ {
+		if len(index) < 2 {
+			return invalid
+		}
+		nameLen := int(le.Uint16(index))
+		if len(index) < 2+nameLen+29 {
+			return invalid
+		}
+		name := string(index[2 : 2+nameLen])
+		fields := index[2+nameLen:]
+		entry := &Entry{
+			Name:       name,
+			offset:     int64(le.Uint64(fields)),
+			StoredSize: int64(le.Uint64(fields[8:])),
+			Size:       int64(le.Uint64(fields[16:])),
+			Compressed: fields[24]&flagCompressed != 0,
+			Sealed:     fields[24]&flagSealed != 0,
+			crc:        le.Uint32(fields[25:]),
+		}
+		if entry.offset < int64(len(magic)) || entry.offset > dataEnd || entry.StoredSize < 0 ||
+			entry.Size < 0 || entry.StoredSize > dataEnd-entry.offset {
+			return fmt.Errorf("%w: entry %s out of range", ErrInvalidPak, name)
+		}
+		a.entries[name] = entry
+		a.names = append(a.names, name)
+		index = fields[29:]
+	}
+	sort.Strings(a.names)
+	return nil
+}
+
+// Close closes the underlying file (if opened with Open).
+func (a *Archive) Close() error {
+	if a.closer == nil {
+		return nil
+	}
+	return a.closer.Close()
+}
+
+// Entries returns all entries sorted by name.
+func (a *Archive) Entries() []Entry {
+	entries := make([]Entry, len(a.names))
+	for i, name := range a.names {
+		entries[i] = *a.entries[name]
+	}
+	return entries
+}
+
+// Contains reports whether the archive holds name.
+func (a *Archive) Contains(name string) bool {
+	_, ok := a.entries[Clean(name)]
+	return ok
+}
+
+// ReadFile returns an entry's contents, decompressed and verified.
+func (a *Archive) ReadFile(name string) ([]byte, error) {
+	name = Clean(name)
+	entry, ok := a.entries[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+
+	data := make([]byte, entry.StoredSize)
+	if _, err := a.r.ReadAt(data, entry.offset); err != nil {
+		return nil, fmt.Errorf("failed to read pak entry %s: %w", name, err)
+	}
+	if entry.Sealed {
+		if a.Sealer == nil {
+			return nil, fmt.Errorf("%w: %s", ErrSealed, name)
+		}
+		opened, err := a.Sealer.Open(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open pak entry %s: %w", name, err)
+		}
+		data = opened
+	}
+	if entry.Compressed {
+		inflated, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(data)), entry.Size+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrCorrupt, name, err)
+		}
+		data = inflated
+	}
+	if int64(len(data)) != entry.Size || crc32.ChecksumIEEE(data) != entry.crc {
+		return nil, fmt.Errorf("%w: %s", ErrCorrupt, name)
+	}
+	return data, nil
+}
+
+// Open opens an entry for reading (implements fs.FS).
+func (a *Archive) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	data, err := a.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return &file{Reader: bytes.NewReader(data), info: fileInfo{name: path.Base(name), size: int64(len(data))}}, nil
+}
+
+// Clean normalizes an asset path to pak form ("./assets\\a.png" -> "assets/a.png").
+func Clean(name string) string {
+	name = path.Clean(strings.ReplaceAll(name, "\\", "/"))
+	return strings.TrimPrefix(name, "/")
+}
+
+func (e Entry) flags() byte {
+	var flags byte
+	if e.Compressed {
+		flags |= flagCompressed
+	}
+	if e.Sealed {
+		flags |= flagSealed
+	}
+	return flags
+}
+
+// deflate compresses data at the best compression level.
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compressor: %w", err)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress pak entry: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress pak entry: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// file is an open archive entry.
+type file struct {
+	*bytes.Reader
+	info fileInfo
+}
+
+func (f *file) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *file) Close() error               { return nil }
+
+// fileInfo describes an archive entry.
+type fileInfo struct {
+	name string
+	size int64
+}
+
+func (fi fileInfo) Name() string       { return fi.name }
+func (fi fileInfo) Size() int64        { return fi.size }
+func (fi fileInfo) Mode() fs.FileMode  { return 0o444 }
+func (fi fileInfo) ModTime() time.Time { return time.Time{} }
+func (fi fileInfo) IsDir() bool        { return false }
+func (fi fileInfo) Sys() any           { return nil }
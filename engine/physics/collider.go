@@ -5,13 +5,18 @@ import (
 	gamemath "github.com/dshills/gogame/engine/math"
 )
 
-// Collider provides AABB collision detection with layer masks.
+// Collider provides collision detection with layer masks.
+//
+// By default a collider is the axis-aligned Bounds box, which ignores
+// rotation. Set Shape to collide as a circle or rotated box instead.
 type Collider struct {
-	Bounds         gamemath.Rectangle // Local bounds (relative to entity)
+	Bounds         gamemath.Rectangle // Local bounds (relative to entity; unused when Shape is set)
+	Shape          ColliderShape      // Optional Circle or OrientedBox (nil = Bounds box)
 	Offset         gamemath.Vector2   // Offset from entity position
 	IsTrigger      bool               // If true, collisions don't block movement
 	CollisionLayer int                // Which layer this collider is on (bit position)
 	CollisionMask  int                // Which layers this collider can collide with (bitmask)
+	Material       *Material          // Optional surface properties (nil = DefaultMaterial)
 }
 
 // NewCollider creates a collider with centered bounds.
@@ -56,13 +61,18 @@ func NewCollider(width, height float64) *Collider {
 //
 // Note:
 //
-//	Currently ignores rotation. Supports position, scale, and offset.
+//	The Bounds box ignores rotation. For a Shape, this is the AABB
+//	enclosing the (possibly rotated) shape.
 //
 // Example:
 //
 //	worldBounds := collider.GetWorldBounds(entity.Transform)
 //	if worldBounds.Contains(point) { ... }
 func (c *Collider) GetWorldBounds(transform gamemath.Transform) gamemath.Rectangle {
+	if c.Shape != nil {
+		return c.Shape.WorldBounds(transform, c.Offset)
+	}
+
 	// Apply scale to bounds
 	scaledWidth := c.Bounds.Width * transform.Scale.X
 	scaledHeight := c.Bounds.Height * transform.Scale.Y
@@ -107,10 +117,50 @@ func (c *Collider) Intersects(other *Collider, thisTransform, otherTransform gam
 		return false // Layers incompatible
 	}
 
-	// Get world bounds
-	thisBounds := c.GetWorldBounds(thisTransform)
-	otherBounds := other.GetWorldBounds(otherTransform)
+	if c.Shape == nil && other.Shape == nil {
+		// AABB intersection test
+		return c.GetWorldBounds(thisTransform).Intersects(other.GetWorldBounds(otherTransform))
+	}
+	_, ok := c.Contact(other, thisTransform, otherTransform)
+	return ok
+}
+
+// Contact tests shape overlap (ignoring layers) and measures the penetration.
+//
+// Parameters:
+//
+//	other: Other collider to test
+//	thisTransform: This entity's transform
+//	otherTransform: Other entity's transform
+//
+// Returns:
+//
+//	Contact: Normal pointing from other toward this collider, with depth and point
+//	bool: True if the shapes overlap (touching doesn't count)
+//
+// Example:
+//
+//	if contact, ok := ball.Collider.Contact(paddle.Collider, ball.Transform, paddle.Transform); ok {
+//	    ball.Transform.Position = ball.Transform.Position.Add(contact.Normal.Scale(contact.Depth))
+//	}
+func (c *Collider) Contact(other *Collider, thisTransform, otherTransform gamemath.Transform) (Contact, bool) {
+	return collide(c.solid(thisTransform), other.solid(otherTransform))
+}
+
+// Outline returns the collider's world-space boundary as a closed polygon.
+//
+// Returns:
+//
+//	[]gamemath.Vector2: Box corners, or points around a circle (the first
+//	                    point is not repeated at the end)
+func (c *Collider) Outline(transform gamemath.Transform) []gamemath.Vector2 {
+	return c.solid(transform).outline()
+}
 
-	// AABB intersection test
-	return thisBounds.Intersects(otherBounds)
+// solid returns the collider's world geometry.
+func (c *Collider) solid(transform gamemath.Transform) solid {
+	if c.Shape != nil {
+		return c.Shape.solid(transform, c.Offset)
+	}
+	return rectSolid(c.GetWorldBounds(transform))
 }
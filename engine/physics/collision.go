@@ -16,6 +16,7 @@ type Entity interface {
 type CollisionPair struct {
 	EntityA Entity
 	EntityB Entity
+	Contact Contact // Normal points from EntityB toward EntityA
 }
 
 // DetectCollisions performs O(n²) broad-phase collision detection.
@@ -64,9 +65,11 @@ func DetectCollisions(entities []Entity) []CollisionPair {
 			colliderB := entityB.GetCollider()
 
 			if colliderA.Intersects(colliderB, entityA.GetTransform(), entityB.GetTransform()) {
+				contact, _ := colliderA.Contact(colliderB, entityA.GetTransform(), entityB.GetTransform())
 				collisions = append(collisions, CollisionPair{
 					EntityA: entityA,
 					EntityB: entityB,
+					Contact: contact,
 				})
 			}
 		}
@@ -0,0 +1,107 @@
+package physics
+
+import (
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Contact describes how two overlapping boxes touch.
+type Contact struct {
+	Normal gamemath.Vector2 // Unit axis to push the first box out of the second
+	Depth  float64          // Overlap along Normal (the minimum translation distance)
+	Point  gamemath.Vector2 // Center of the overlap region
+}
+
+// Penetration computes the minimum translation vector separating a from b.
+//
+// Parameters:
+//
+//	a, b: World-space boxes (expected to overlap)
+//
+// Returns:
+//
+//	Contact: Normal pointing from b toward a along the axis of least
+//	         overlap, with the overlap depth (zero Contact if they don't overlap)
+//
+// Example:
+//
+//	contact := physics.Penetration(playerBounds, wallBounds)
+//	player.Transform.Position = player.Transform.Position.Add(contact.Normal.Scale(contact.Depth))
+func Penetration(a, b gamemath.Rectangle) Contact {
+	left := math.Max(a.X, b.X)
+	right := math.Min(a.X+a.Width, b.X+b.Width)
+	top := math.Max(a.Y, b.Y)
+	bottom := math.Min(a.Y+a.Height, b.Y+b.Height)
+	overlapX, overlapY := right-left, bottom-top
+	if overlapX <= 0 || overlapY <= 0 {
+		return Contact{}
+	}
+
+	contact := Contact{Point: gamemath.Vector2{X: (left + right) / 2, Y: (top + bottom) / 2}}
+	centerA, centerB := a.Center(), b.Center()
+	if overlapX < overlapY {
+		contact.Depth = overlapX
+		contact.Normal = gamemath.Vector2{X: sign(centerA.X - centerB.X)}
+	} else {
+		contact.Depth = overlapY
+		contact.Normal = gamemath.Vector2{Y: sign(centerA.Y - centerB.Y)}
+	}
+	return contact
+}
+
+// Separate pushes two overlapping bodies apart and removes the velocity
+// driving them together.
+//
+// Parameters:
+//
+//	bodyA, transformA: First object (nil body = immovable)
+//	bodyB, transformB: Second object (nil body = immovable)
+//	contact: Penetration of A into B (Normal points from B toward A)
+//	restitution: Bounciness of the impact (0 = stop, 1 = full bounce)
+//
+// Returns:
+//
+//	bool: False if neither object can move
+//
+// Behavior:
+//   - Only dynamic bodies move; static, kinematic, and body-less objects
+//     act as immovable walls
+//   - Two dynamic bodies share the push in proportion to inverse mass
+//   - Velocity into the contact is reflected by restitution
+func Separate(bodyA *RigidBody, transformA *gamemath.Transform, bodyB *RigidBody, transformB *gamemath.Transform, contact Contact, restitution float64) bool {
+	var inverseA, inverseB float64
+	if bodyA != nil {
+		inverseA = bodyA.InverseMass()
+	}
+	if bodyB != nil {
+		inverseB = bodyB.InverseMass()
+	}
+	total := inverseA + inverseB
+	if total == 0 || contact.Depth <= 0 {
+		return false
+	}
+
+	normal := contact.Normal
+	if inverseA > 0 {
+		transformA.Position = transformA.Position.Add(normal.Scale(contact.Depth * inverseA / total))
+		if along := bodyA.Velocity.Dot(normal); along < 0 {
+			bodyA.Velocity = bodyA.Velocity.Sub(normal.Scale((1 + restitution) * along))
+		}
+	}
+	if inverseB > 0 {
+		transformB.Position = transformB.Position.Sub(normal.Scale(contact.Depth * inverseB / total))
+		if along := bodyB.Velocity.Dot(normal); along > 0 {
+			bodyB.Velocity = bodyB.Velocity.Sub(normal.Scale((1 + restitution) * along))
+		}
+	}
+	return true
+}
+
+// sign returns -1 for negative values and 1 otherwise.
+func sign(v float64) float64 {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
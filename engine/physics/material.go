@@ -0,0 +1,24 @@
+package physics
+
+// Material describes surface properties used by movement and collision response.
+type Material struct {
+	Name        string  // Identifier for data files and debugging
+	Friction    float64 // Grip multiplier (1 = normal, <1 = slippery)
+	Restitution float64 // Bounciness (0 = no bounce, 1 = perfectly elastic)
+}
+
+// Common materials.
+var (
+	DefaultMaterial = &Material{Name: "default", Friction: 1, Restitution: 0}
+	Ice             = &Material{Name: "ice", Friction: 0.1, Restitution: 0.05}
+	Mud             = &Material{Name: "mud", Friction: 0.6, Restitution: 0}
+	Rubber          = &Material{Name: "rubber", Friction: 1.2, Restitution: 0.8}
+)
+
+// MaterialOf returns a collider's material, or DefaultMaterial if none is set.
+func MaterialOf(collider *Collider) *Material {
+	if collider == nil || collider.Material == nil {
+		return DefaultMaterial
+	}
+	return collider.Material
+}
@@ -0,0 +1,203 @@
+package physics
+
+import (
+	"math"
+	"sort"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// AllLayers is a layer mask that matches every collision layer.
+const AllLayers = 0xFFFFFFFF
+
+// RaycastHit describes where a ray struck a collider.
+type RaycastHit struct {
+	Entity   Entity
+	Point    gamemath.Vector2 // World position of the hit
+	Normal   gamemath.Vector2 // Surface normal at the hit (unit, facing the ray)
+	Distance float64          // Distance from the ray origin
+}
+
+// Raycast finds the closest collider hit by a ray.
+//
+// Parameters:
+//
+//	entities: Entities to test
+//	origin: Ray start in world space
+//	direction: Ray direction (normalized internally)
+//	maxDist: Maximum distance to test
+//	layerMask: Bitmask of collision layers to hit (AllLayers = any)
+//
+// Returns:
+//
+//	RaycastHit: Closest hit
+//	bool: False if nothing was hit
+//
+// Behavior:
+//   - Inactive entities, triggers, and colliders on layers outside
+//     layerMask are ignored
+//   - Colliders containing the origin are ignored, so a ray cast from
+//     inside an entity doesn't hit the entity itself
+//
+// Example:
+//
+//	// Ground check below the player
+//	down := gamemath.Vector2{X: 0, Y: 1}
+//	if hit, ok := physics.Raycast(entities, feet, down, 4, groundMask); ok {
+//	    grounded = hit.Normal.Y < 0
+//	}
+func Raycast(entities []Entity, origin, direction gamemath.Vector2, maxDist float64, layerMask int) (RaycastHit, bool) {
+	hits := RaycastAll(entities, origin, direction, maxDist, layerMask)
+	if len(hits) == 0 {
+		return RaycastHit{}, false
+	}
+	return hits[0], true
+}
+
+// RaycastAll returns every collider hit by a ray, nearest first.
+//
+// Parameters and filtering are the same as Raycast.
+//
+// Example:
+//
+//	// Piercing shot damages everything along the line
+//	for _, hit := range physics.RaycastAll(entities, muzzle, aim, 800, enemyMask) {
+//	    damage(hit.Entity)
+//	}
+func RaycastAll(entities []Entity, origin, direction gamemath.Vector2, maxDist float64, layerMask int) []RaycastHit {
+	direction = direction.Normalize()
+	if direction == (gamemath.Vector2{}) || maxDist <= 0 {
+		return nil
+	}
+
+	hits := make([]RaycastHit, 0)
+	for _, entity := range entities {
+		collider := queryCollider(entity, layerMask)
+		if collider == nil {
+			continue
+		}
+		distance, normal, ok := raySolid(origin, direction, collider.solid(entity.GetTransform()))
+		if !ok || distance > maxDist {
+			continue
+		}
+		hits = append(hits, RaycastHit{
+			Entity:   entity,
+			Point:    origin.Add(direction.Scale(distance)),
+			Normal:   normal,
+			Distance: distance,
+		})
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Distance < hits[j].Distance })
+	return hits
+}
+
+// OverlapBox returns entities whose colliders overlap a world rectangle.
+//
+// Parameters:
+//
+//	entities: Entities to test
+//	box: World-space rectangle
+//	layerMask: Bitmask of collision layers to include (AllLayers = any)
+//
+// Returns:
+//
+//	[]Entity: Overlapping entities in input order (triggers excluded)
+//
+// Example:
+//
+//	// Melee swing in front of the player
+//	hits := physics.OverlapBox(entities, swingRect, enemyMask)
+func OverlapBox(entities []Entity, box gamemath.Rectangle, layerMask int) []Entity {
+	return overlap(entities, rectSolid(box), layerMask)
+}
+
+// OverlapCircle returns entities whose colliders overlap a world circle.
+//
+// Parameters:
+//
+//	entities: Entities to test
+//	center: Circle center in world space
+//	radius: Circle radius
+//	layerMask: Bitmask of collision layers to include (AllLayers = any)
+//
+// Returns:
+//
+//	[]Entity: Overlapping entities in input order (triggers excluded)
+//
+// Example:
+//
+//	// Explosion damage
+//	for _, entity := range physics.OverlapCircle(entities, blast, 96, physics.AllLayers) {
+//	    damage(entity)
+//	}
+func OverlapCircle(entities []Entity, center gamemath.Vector2, radius float64, layerMask int) []Entity {
+	return overlap(entities, solid{circle: true, center: center, radius: radius}, layerMask)
+}
+
+// overlap returns entities whose colliders overlap a query shape.
+func overlap(entities []Entity, query solid, layerMask int) []Entity {
+	found := make([]Entity, 0)
+	for _, entity := range entities {
+		collider := queryCollider(entity, layerMask)
+		if collider == nil {
+			continue
+		}
+		if _, ok := collide(collider.solid(entity.GetTransform()), query); ok {
+			found = append(found, entity)
+		}
+	}
+	return found
+}
+
+// queryCollider returns an entity's collider if queries should consider it.
+func queryCollider(entity Entity, layerMask int) *Collider {
+	if !entity.IsActive() {
+		return nil
+	}
+	collider := entity.GetCollider()
+	if collider == nil || collider.IsTrigger || layerMask&(1<<collider.CollisionLayer) == 0 {
+		return nil
+	}
+	return collider
+}
+
+// rayBox intersects a ray with a box using the slab method.
+func rayBox(origin, direction gamemath.Vector2, box gamemath.Rectangle) (distance float64, normal gamemath.Vector2, ok bool) {
+	if box.Contains(origin.X, origin.Y) {
+		return 0, gamemath.Vector2{}, false
+	}
+	tMin, tMax := math.Inf(-1), math.Inf(1)
+	var entryNormal gamemath.Vector2
+
+	slabs := [2]struct {
+		origin, dir, min, max float64
+		axis                  gamemath.Vector2
+	}{
+		{origin.X, direction.X, box.X, box.X + box.Width, gamemath.Vector2{X: 1}},
+		{origin.Y, direction.Y, box.Y, box.Y + box.Height, gamemath.Vector2{Y: 1}},
+	}
+	for _, slab := range slabs {
+		if slab.dir == 0 {
+			if slab.origin < slab.min || slab.origin > slab.max {
+				return 0, gamemath.Vector2{}, false
+			}
+			continue
+		}
+		near := (slab.min - slab.origin) / slab.dir
+		far := (slab.max - slab.origin) / slab.dir
+		n := slab.axis.Scale(-1) // Entering through the min face
+		if near > far {
+			near, far = far, near
+			n = slab.axis
+		}
+		if near > tMin {
+			tMin = near
+			entryNormal = n
+		}
+		tMax = math.Min(tMax, far)
+	}
+	if tMin > tMax || tMin < 0 {
+		return 0, gamemath.Vector2{}, false
+	}
+	return tMin, entryNormal, true
+}
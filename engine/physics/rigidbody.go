@@ -0,0 +1,177 @@
+package physics
+
+import (
+	"fmt"
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// BodyType selects how a rigid body moves.
+type BodyType int
+
+const (
+	// BodyDynamic bodies are moved by velocity, forces, and gravity.
+	BodyDynamic BodyType = iota
+	// BodyKinematic bodies are moved by velocity only (moving platforms,
+	// scripted doors); forces, gravity, and drag are ignored.
+	BodyKinematic
+	// BodyStatic bodies never move (walls, floors).
+	BodyStatic
+)
+
+// String returns the body type name.
+func (t BodyType) String() string {
+	switch t {
+	case BodyDynamic:
+		return "dynamic"
+	case BodyKinematic:
+		return "kinematic"
+	case BodyStatic:
+		return "static"
+	}
+	return "unknown"
+}
+
+// ParseBodyType converts a name written by BodyType.String back to a type.
+func ParseBodyType(name string) (BodyType, error) {
+	for _, t := range []BodyType{BodyDynamic, BodyKinematic, BodyStatic} {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return BodyDynamic, fmt.Errorf("unknown body type %q", name)
+}
+
+// RigidBody gives an entity velocity-based movement.
+//
+// Scenes integrate every active entity's body once per fixed update, after
+// behaviors run and before collision detection, so behaviors steer bodies
+// by setting Velocity or applying forces rather than moving the transform.
+type RigidBody struct {
+	Type         BodyType
+	Velocity     gamemath.Vector2 // World units per second
+	Acceleration gamemath.Vector2 // Constant acceleration (thrust, wind), added to gravity
+	Mass         float64          // Used by ApplyForce/ApplyImpulse (<= 0 treated as 1)
+	Drag         float64          // Linear damping per second (0 = none)
+	GravityScale float64          // Multiplier on scene gravity (0 = floats)
+	SleepSpeed   float64          // Speed below which the body is resting (0 = never sleeps)
+
+	force    gamemath.Vector2 // Forces applied since the last step
+	restTime float64          // Seconds spent below SleepSpeed
+}
+
+// SleepDelay is how long a body must rest before it counts as sleeping.
+const SleepDelay = 0.5
+
+// NewRigidBody creates a dynamic body affected by gravity.
+//
+// Parameters:
+//
+//	mass: Body mass (<= 0 treated as 1)
+//
+// Returns:
+//
+//	*RigidBody: Dynamic body with GravityScale 1 and no drag
+//
+// Example:
+//
+//	player.Body = physics.NewRigidBody(1)
+//	player.Body.Drag = 2
+//	scene.SetGravity(gamemath.Vector2{X: 0, Y: 980})
+func NewRigidBody(mass float64) *RigidBody {
+	return &RigidBody{
+		Type:         BodyDynamic,
+		Mass:         mass,
+		GravityScale: 1,
+	}
+}
+
+// InverseMass returns 1/mass, or 0 for bodies that aren't dynamic.
+func (b *RigidBody) InverseMass() float64 {
+	if b.Type != BodyDynamic {
+		return 0
+	}
+	if b.Mass <= 0 {
+		return 1
+	}
+	return 1 / b.Mass
+}
+
+// ApplyForce adds a force for the next step (dynamic bodies only).
+//
+// Example:
+//
+//	if input.KeyHeld(input.KeyArrowUp) {
+//	    ship.Body.ApplyForce(heading.Scale(thrust))
+//	}
+func (b *RigidBody) ApplyForce(force gamemath.Vector2) {
+	b.force = b.force.Add(force)
+}
+
+// ApplyImpulse changes velocity immediately by impulse/mass (dynamic bodies only).
+//
+// Example:
+//
+//	if input.ActionPressed(input.ActionJump) && grounded {
+//	    player.Body.ApplyImpulse(gamemath.Vector2{X: 0, Y: -400})
+//	}
+func (b *RigidBody) ApplyImpulse(impulse gamemath.Vector2) {
+	b.restTime = 0
+	b.Velocity = b.Velocity.Add(impulse.Scale(b.InverseMass()))
+}
+
+// Sleeping reports whether the body has stayed below SleepSpeed for
+// SleepDelay seconds.
+//
+// Sleep is a resting state for debug views and gameplay queries (settled
+// crates, finished rolls); sleeping bodies are still integrated, so gravity
+// keeps them in contact with the ground.
+func (b *RigidBody) Sleeping() bool {
+	return b.SleepSpeed > 0 && b.restTime >= SleepDelay
+}
+
+// Wake clears the resting timer.
+func (b *RigidBody) Wake() {
+	b.restTime = 0
+}
+
+// Integrate advances the body one step and moves the transform.
+//
+// Parameters:
+//
+//	transform: Transform to move
+//	gravity: Scene gravity (world units per second squared)
+//	dt: Step length in seconds
+//
+// Behavior:
+//   - Static bodies don't move and keep zero velocity
+//   - Kinematic bodies move by Velocity only
+//   - Dynamic bodies accelerate by gravity*GravityScale + Acceleration +
+//     force/mass, are damped by Drag, then move (semi-implicit Euler)
+//   - Accumulated forces are cleared after each step
+func (b *RigidBody) Integrate(transform *gamemath.Transform, gravity gamemath.Vector2, dt float64) {
+	// Rest is measured on the velocity left by last step's collision response
+	if b.SleepSpeed > 0 && b.Velocity.Length() < b.SleepSpeed && b.force == (gamemath.Vector2{}) {
+		b.restTime += dt
+	} else {
+		b.restTime = 0
+	}
+
+	switch b.Type {
+	case BodyStatic:
+		b.Velocity = gamemath.Vector2{}
+		b.force = gamemath.Vector2{}
+		return
+	case BodyDynamic:
+		acceleration := gravity.Scale(b.GravityScale).
+			Add(b.Acceleration).
+			Add(b.force.Scale(b.InverseMass()))
+		b.Velocity = b.Velocity.Add(acceleration.Scale(dt))
+		if b.Drag > 0 {
+			b.Velocity = b.Velocity.Scale(math.Exp(-b.Drag * dt))
+		}
+	}
+	b.force = gamemath.Vector2{}
+	transform.Position = transform.Position.Add(b.Velocity.Scale(dt))
+}
@@ -0,0 +1,323 @@
+package physics
+
+import (
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// circleSegments is the number of edges used to outline a circle.
+const circleSegments = 24
+
+// ColliderShape is the geometry of a collider.
+//
+// Assign one to Collider.Shape to collide as a circle or a box that rotates
+// with the entity; a nil Shape keeps the axis-aligned Bounds box. Shapes are
+// positioned at the entity position plus Collider.Offset (rotated and scaled
+// with the transform).
+//
+// The shape set is closed (Circle and OrientedBox) because every pair needs
+// a dedicated intersection test.
+type ColliderShape interface {
+	// WorldBounds returns the world-space AABB enclosing the shape.
+	WorldBounds(transform gamemath.Transform, offset gamemath.Vector2) gamemath.Rectangle
+
+	// solid returns the shape's world geometry for intersection tests.
+	solid(transform gamemath.Transform, offset gamemath.Vector2) solid
+}
+
+// Circle is a circular collider shape centered on the collider origin.
+type Circle struct {
+	Radius float64 // Scaled by the larger of |Scale.X| and |Scale.Y|
+}
+
+// OrientedBox is a box collider shape centered on the collider origin that
+// rotates with Transform.Rotation.
+type OrientedBox struct {
+	Width  float64
+	Height float64
+}
+
+// NewCircleCollider creates a collider with a circle shape.
+//
+// Parameters:
+//
+//	radius: Circle radius
+//
+// Returns:
+//
+//	*Collider: New collider on layer 0, colliding with all layers
+//
+// Example:
+//
+//	ball.Collider = physics.NewCircleCollider(8)
+func NewCircleCollider(radius float64) *Collider {
+	collider := NewCollider(radius*2, radius*2)
+	collider.Shape = Circle{Radius: radius}
+	return collider
+}
+
+// NewOrientedBoxCollider creates a collider with a box shape that rotates
+// with the entity.
+//
+// Example:
+//
+//	plank.Collider = physics.NewOrientedBoxCollider(120, 12)
+//	plank.Transform.Rotation = 30
+func NewOrientedBoxCollider(width, height float64) *Collider {
+	collider := NewCollider(width, height)
+	collider.Shape = OrientedBox{Width: width, Height: height}
+	return collider
+}
+
+// WorldBounds returns the square enclosing the circle.
+func (c Circle) WorldBounds(transform gamemath.Transform, offset gamemath.Vector2) gamemath.Rectangle {
+	return c.solid(transform, offset).bounds()
+}
+
+// WorldBounds returns the AABB enclosing the rotated box.
+func (b OrientedBox) WorldBounds(transform gamemath.Transform, offset gamemath.Vector2) gamemath.Rectangle {
+	return b.solid(transform, offset).bounds()
+}
+
+func (c Circle) solid(transform gamemath.Transform, offset gamemath.Vector2) solid {
+	scale := math.Max(math.Abs(transform.Scale.X), math.Abs(transform.Scale.Y))
+	return solid{
+		circle: true,
+		center: shapeCenter(transform, offset),
+		radius: c.Radius * scale,
+	}
+}
+
+func (b OrientedBox) solid(transform gamemath.Transform, offset gamemath.Vector2) solid {
+	axisX, axisY := rotationAxes(transform.Rotation)
+	return solid{
+		center: shapeCenter(transform, offset),
+		half:   gamemath.Vector2{X: b.Width / 2 * math.Abs(transform.Scale.X), Y: b.Height / 2 * math.Abs(transform.Scale.Y)},
+		axisX:  axisX,
+		axisY:  axisY,
+	}
+}
+
+// solid is a shape in world space: a circle, or a box with orthonormal axes.
+type solid struct {
+	circle bool
+	center gamemath.Vector2
+	radius float64          // Circles
+	half   gamemath.Vector2 // Boxes: half extents along axisX and axisY
+	axisX  gamemath.Vector2
+	axisY  gamemath.Vector2
+}
+
+// rectSolid converts an axis-aligned rectangle to a box solid.
+func rectSolid(rect gamemath.Rectangle) solid {
+	return solid{
+		center: rect.Center(),
+		half:   gamemath.Vector2{X: rect.Width / 2, Y: rect.Height / 2},
+		axisX:  gamemath.Vector2{X: 1},
+		axisY:  gamemath.Vector2{Y: 1},
+	}
+}
+
+// aligned reports whether the solid is an unrotated box.
+func (s solid) aligned() bool {
+	return !s.circle && s.axisX == gamemath.Vector2{X: 1}
+}
+
+// rect returns an aligned box as a rectangle.
+func (s solid) rect() gamemath.Rectangle {
+	return gamemath.Rectangle{X: s.center.X - s.half.X, Y: s.center.Y - s.half.Y, Width: s.half.X * 2, Height: s.half.Y * 2}
+}
+
+// bounds returns the AABB enclosing the solid.
+func (s solid) bounds() gamemath.Rectangle {
+	if s.circle {
+		return gamemath.Rectangle{X: s.center.X - s.radius, Y: s.center.Y - s.radius, Width: s.radius * 2, Height: s.radius * 2}
+	}
+	extentX := s.half.X*math.Abs(s.axisX.X) + s.half.Y*math.Abs(s.axisY.X)
+	extentY := s.half.X*math.Abs(s.axisX.Y) + s.half.Y*math.Abs(s.axisY.Y)
+	return gamemath.Rectangle{X: s.center.X - extentX, Y: s.center.Y - extentY, Width: extentX * 2, Height: extentY * 2}
+}
+
+// outline returns the solid's boundary as a closed polygon (first point not repeated).
+func (s solid) outline() []gamemath.Vector2 {
+	if s.circle {
+		points := make([]gamemath.Vector2, circleSegments)
+		for i := range points {
+			angle := 2 * math.Pi * float64(i) / circleSegments
+			points[i] = s.center.Add(gamemath.Vector2{X: math.Cos(angle), Y: math.Sin(angle)}.Scale(s.radius))
+		}
+		return points
+	}
+	x, y := s.axisX.Scale(s.half.X), s.axisY.Scale(s.half.Y)
+	return []gamemath.Vector2{
+		s.center.Sub(x).Sub(y),
+		s.center.Add(x).Sub(y),
+		s.center.Add(x).Add(y),
+		s.center.Sub(x).Add(y),
+	}
+}
+
+// toLocal expresses a world vector in the box's axes.
+func (s solid) toLocal(v gamemath.Vector2) gamemath.Vector2 {
+	return gamemath.Vector2{X: v.Dot(s.axisX), Y: v.Dot(s.axisY)}
+}
+
+// toWorld converts a vector in the box's axes to world space.
+func (s solid) toWorld(v gamemath.Vector2) gamemath.Vector2 {
+	return s.axisX.Scale(v.X).Add(s.axisY.Scale(v.Y))
+}
+
+// support returns the box corner furthest along dir.
+func (s solid) support(dir gamemath.Vector2) gamemath.Vector2 {
+	return s.center.
+		Add(s.axisX.Scale(s.half.X * sign(dir.Dot(s.axisX)))).
+		Add(s.axisY.Scale(s.half.Y * sign(dir.Dot(s.axisY))))
+}
+
+// projectedRadius returns the box's half width projected onto axis.
+func (s solid) projectedRadius(axis gamemath.Vector2) float64 {
+	return s.half.X*math.Abs(s.axisX.Dot(axis)) + s.half.Y*math.Abs(s.axisY.Dot(axis))
+}
+
+// collide tests two solids for overlap.
+//
+// The Contact normal points from b toward a, matching Penetration.
+// Touching shapes (zero depth) don't overlap.
+func collide(a, b solid) (Contact, bool) {
+	switch {
+	case a.circle && b.circle:
+		return collideCircles(a, b)
+	case a.circle:
+		return collideCircleBox(a, b)
+	case b.circle:
+		contact, ok := collideCircleBox(b, a)
+		contact.Normal = contact.Normal.Scale(-1)
+		return contact, ok
+	case a.aligned() && b.aligned():
+		contact := Penetration(a.rect(), b.rect())
+		return contact, contact.Depth > 0
+	}
+	return collideBoxes(a, b)
+}
+
+// collideCircles tests circle a against circle b.
+func collideCircles(a, b solid) (Contact, bool) {
+	delta := a.center.Sub(b.center)
+	distance := delta.Length()
+	depth := a.radius + b.radius - distance
+	if depth <= 0 {
+		return Contact{}, false
+	}
+	normal := gamemath.Vector2{Y: -1} // Concentric: push a up
+	if distance > 0 {
+		normal = delta.Scale(1 / distance)
+	}
+	point := b.center.Add(normal.Scale(b.radius - depth/2))
+	return Contact{Normal: normal, Depth: depth, Point: point}, true
+}
+
+// collideCircleBox tests circle a against box b.
+func collideCircleBox(a, b solid) (Contact, bool) {
+	local := b.toLocal(a.center.Sub(b.center))
+	closest := gamemath.Vector2{
+		X: math.Max(-b.half.X, math.Min(local.X, b.half.X)),
+		Y: math.Max(-b.half.Y, math.Min(local.Y, b.half.Y)),
+	}
+
+	if closest != local {
+		// Center outside the box: push along the closest point
+		diff := local.Sub(closest)
+		distance := diff.Length()
+		if distance >= a.radius {
+			return Contact{}, false
+		}
+		return Contact{
+			Normal: b.toWorld(diff.Scale(1 / distance)),
+			Depth:  a.radius - distance,
+			Point:  b.center.Add(b.toWorld(closest)),
+		}, true
+	}
+
+	// Center inside the box: push out through the nearest face
+	var normal gamemath.Vector2
+	var depth float64
+	if dx, dy := b.half.X-math.Abs(local.X), b.half.Y-math.Abs(local.Y); dx < dy {
+		normal = gamemath.Vector2{X: sign(local.X)}
+		depth = dx + a.radius
+		closest.X = b.half.X * normal.X
+	} else {
+		normal = gamemath.Vector2{Y: sign(local.Y)}
+		depth = dy + a.radius
+		closest.Y = b.half.Y * normal.Y
+	}
+	return Contact{Normal: b.toWorld(normal), Depth: depth, Point: b.center.Add(b.toWorld(closest))}, true
+}
+
+// collideBoxes tests two oriented boxes with the separating axis theorem.
+func collideBoxes(a, b solid) (Contact, bool) {
+	delta := a.center.Sub(b.center)
+	best := Contact{Depth: math.Inf(1)}
+	for _, axis := range [4]gamemath.Vector2{a.axisX, a.axisY, b.axisX, b.axisY} {
+		distance := delta.Dot(axis)
+		depth := a.projectedRadius(axis) + b.projectedRadius(axis) - math.Abs(distance)
+		if depth <= 0 {
+			return Contact{}, false // Separating axis found
+		}
+		if depth < best.Depth {
+			best.Depth = depth
+			best.Normal = axis.Scale(sign(distance))
+		}
+	}
+	// Approximate the contact point from a's deepest corner
+	deepest := a.support(best.Normal.Scale(-1))
+	best.Point = deepest.Add(best.Normal.Scale(best.Depth / 2))
+	return best, true
+}
+
+// rayCircle intersects a ray with a circle, ignoring circles containing the origin.
+func rayCircle(origin, direction gamemath.Vector2, circle solid) (distance float64, normal gamemath.Vector2, ok bool) {
+	toOrigin := origin.Sub(circle.center)
+	c := toOrigin.Dot(toOrigin) - circle.radius*circle.radius
+	if c <= 0 {
+		return 0, gamemath.Vector2{}, false
+	}
+	b := toOrigin.Dot(direction)
+	discriminant := b*b - c
+	if b > 0 || discriminant < 0 {
+		return 0, gamemath.Vector2{}, false
+	}
+	distance = -b - math.Sqrt(discriminant)
+	normal = origin.Add(direction.Scale(distance)).Sub(circle.center).Normalize()
+	return distance, normal, true
+}
+
+// raySolid intersects a ray with any solid.
+func raySolid(origin, direction gamemath.Vector2, s solid) (distance float64, normal gamemath.Vector2, ok bool) {
+	if s.circle {
+		return rayCircle(origin, direction, s)
+	}
+	if s.aligned() {
+		return rayBox(origin, direction, s.rect())
+	}
+	// Cast in the box's frame, where it is axis-aligned
+	local := gamemath.Rectangle{X: -s.half.X, Y: -s.half.Y, Width: s.half.X * 2, Height: s.half.Y * 2}
+	distance, normal, ok = rayBox(s.toLocal(origin.Sub(s.center)), s.toLocal(direction), local)
+	return distance, s.toWorld(normal), ok
+}
+
+// shapeCenter returns the world position of a shape's center.
+func shapeCenter(transform gamemath.Transform, offset gamemath.Vector2) gamemath.Vector2 {
+	scaled := gamemath.Vector2{X: offset.X * transform.Scale.X, Y: offset.Y * transform.Scale.Y}
+	axisX, axisY := rotationAxes(transform.Rotation)
+	return transform.Position.Add(axisX.Scale(scaled.X)).Add(axisY.Scale(scaled.Y))
+}
+
+// rotationAxes returns the local X and Y axes for a rotation in degrees.
+func rotationAxes(degrees float64) (axisX, axisY gamemath.Vector2) {
+	if degrees == 0 {
+		return gamemath.Vector2{X: 1}, gamemath.Vector2{Y: 1}
+	}
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+	return gamemath.Vector2{X: cos, Y: sin}, gamemath.Vector2{X: -sin, Y: cos}
+}
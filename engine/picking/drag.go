@@ -0,0 +1,161 @@
+package picking
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// DragCallback is called during drag events.
+// Parameters:
+//   - entity: The entity being dragged
+//   - worldPos: Current cursor position in world space
+type DragCallback func(entity *core.Entity, worldPos gamemath.Vector2)
+
+// Draggable marks an entity as something that can be dragged with the mouse.
+type Draggable struct {
+	Entity      *core.Entity // Entity to drag
+	Enabled     bool         // Disabled draggables are never picked
+	MoveEntity  bool         // If true, the controller moves the entity with the cursor
+	OnDragStart DragCallback // Called when the drag begins
+	OnDrag      DragCallback // Called every frame while dragging
+	OnDrop      DragCallback // Called when the mouse button is released
+}
+
+// NewDraggable creates an enabled draggable that follows the cursor.
+func NewDraggable(entity *core.Entity) *Draggable {
+	return &Draggable{
+		Entity:     entity,
+		Enabled:    true,
+		MoveEntity: true,
+	}
+}
+
+// DragController is a Behavior that routes mouse input to registered draggables.
+//
+// Attach it to any always-active entity (for example a scene manager entity),
+// or call Update manually once per frame.
+type DragController struct {
+	Input  *input.InputManager // Input manager used for mouse state
+	Camera *graphics.Camera    // Camera used for screen to world conversion
+	Button input.KeyCode       // Mouse button that drags (default KeyMouseLeft)
+
+	draggables []*Draggable
+	active     *Draggable
+	grabOffset gamemath.Vector2 // Entity position minus cursor position at drag start
+}
+
+// NewDragController creates a drag controller using the left mouse button.
+//
+// Example:
+//
+//	drag := picking.NewDragController(engine.Input(), scene.Camera())
+//	manager := &core.Entity{Active: true, Behavior: drag}
+//	scene.AddEntity(manager)
+//	drag.Add(picking.NewDraggable(card))
+func NewDragController(inputMgr *input.InputManager, camera *graphics.Camera) *DragController {
+	return &DragController{
+		Input:      inputMgr,
+		Camera:     camera,
+		Button:     input.KeyMouseLeft,
+		draggables: make([]*Draggable, 0),
+	}
+}
+
+// Add registers a draggable.
+func (dc *DragController) Add(draggable *Draggable) {
+	dc.draggables = append(dc.draggables, draggable)
+}
+
+// Remove unregisters a draggable, cancelling its drag if active.
+func (dc *DragController) Remove(draggable *Draggable) {
+	for i, d := range dc.draggables {
+		if d == draggable {
+			dc.draggables = append(dc.draggables[:i], dc.draggables[i+1:]...)
+			break
+		}
+	}
+	if dc.active == draggable {
+		dc.active = nil
+	}
+}
+
+// Dragging returns the draggable currently being dragged, or nil.
+func (dc *DragController) Dragging() *Draggable {
+	return dc.active
+}
+
+// Update processes mouse input for one frame.
+func (dc *DragController) Update(_ *core.Entity, _ float64) {
+	if dc.Input == nil || dc.Camera == nil {
+		return
+	}
+	mouseX, mouseY := dc.Input.MousePosition()
+	cursor := ScreenToWorld(dc.Camera, mouseX, mouseY)
+
+	switch {
+	case dc.active == nil && dc.Input.KeyPressed(dc.Button):
+		dc.BeginDrag(cursor)
+	case dc.active != nil && dc.Input.KeyReleased(dc.Button):
+		dc.EndDrag(cursor)
+	case dc.active != nil:
+		dc.DragTo(cursor)
+	}
+}
+
+// BeginDrag starts dragging the topmost draggable under a world position.
+//
+// Returns:
+//
+//	bool: True if a draggable was picked
+func (dc *DragController) BeginDrag(cursor gamemath.Vector2) bool {
+	var best *Draggable
+	for _, d := range dc.draggables {
+		if !d.Enabled || d.Entity == nil || !d.Entity.Active {
+			continue
+		}
+		if !HitBounds(d.Entity).Contains(cursor.X, cursor.Y) {
+			continue
+		}
+		if best == nil || d.Entity.Layer >= best.Entity.Layer {
+			best = d
+		}
+	}
+	if best == nil {
+		return false
+	}
+
+	dc.active = best
+	dc.grabOffset = best.Entity.WorldTransform().Position.Sub(cursor)
+	if best.OnDragStart != nil {
+		best.OnDragStart(best.Entity, cursor)
+	}
+	return true
+}
+
+// DragTo moves the active draggable to follow a world position.
+func (dc *DragController) DragTo(cursor gamemath.Vector2) {
+	if dc.active == nil {
+		return
+	}
+	if dc.active.MoveEntity {
+		dc.active.Entity.SetWorldPosition(cursor.Add(dc.grabOffset))
+	}
+	if dc.active.OnDrag != nil {
+		dc.active.OnDrag(dc.active.Entity, cursor)
+	}
+}
+
+// EndDrag drops the active draggable at a world position.
+func (dc *DragController) EndDrag(cursor gamemath.Vector2) {
+	if dc.active == nil {
+		return
+	}
+	dc.DragTo(cursor)
+	dropped := dc.active
+	dc.active = nil
+	if dropped.OnDrop != nil {
+		dropped.OnDrop(dropped.Entity, cursor)
+	}
+}
@@ -0,0 +1,124 @@
+// Package picking provides mouse picking (screen to world hit testing) and drag-and-drop helpers.
+package picking
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// HitBounds returns the world-space rectangle used for hit testing an entity.
+//
+// Returns:
+//
+//	gamemath.Rectangle: Collider bounds if the entity has a collider, otherwise
+//	the scaled sprite rectangle centered on the entity (zero rect if neither)
+//
+// Example:
+//
+//	if picking.HitBounds(card).Contains(worldX, worldY) {
+//	    highlight(card)
+//	}
+func HitBounds(entity *core.Entity) gamemath.Rectangle {
+	if entity.Collider != nil {
+		return entity.GetBounds()
+	}
+
+	if entity.Sprite != nil {
+		transform := entity.WorldTransform()
+		width := entity.Sprite.SourceRect.Width * transform.Scale.X
+		height := entity.Sprite.SourceRect.Height * transform.Scale.Y
+		return gamemath.Rectangle{
+			X:      transform.Position.X - width/2,
+			Y:      transform.Position.Y - height/2,
+			Width:  width,
+			Height: height,
+		}
+	}
+
+	return entity.GetBounds()
+}
+
+// PickWorld returns the topmost active entity whose hit bounds contain a world point.
+//
+// Parameters:
+//
+//	entities: Candidate entities (e.g. scene.GetAllEntities())
+//	worldX, worldY: World coordinates
+//
+// Returns:
+//
+//	*core.Entity: Entity with the highest Layer (latest added wins ties), or nil
+func PickWorld(entities []*core.Entity, worldX, worldY float64) *core.Entity {
+	var best *core.Entity
+	for _, entity := range entities {
+		if !entity.Active {
+			continue
+		}
+		if !HitBounds(entity).Contains(worldX, worldY) {
+			continue
+		}
+		if best == nil || entity.Layer >= best.Layer {
+			best = entity
+		}
+	}
+	return best
+}
+
+// Pick returns the topmost entity under a screen position in a scene.
+//
+// Parameters:
+//
+//	scene: Scene to search (its camera is used for the screen to world transform)
+//	screenX, screenY: Screen pixel coordinates (e.g. from InputManager.MousePosition)
+//
+// Returns:
+//
+//	*core.Entity: Topmost entity under the cursor, or nil
+//
+// Example:
+//
+//	mouseX, mouseY := engine.Input().MousePosition()
+//	if entity := picking.Pick(scene, int(mouseX), int(mouseY)); entity != nil {
+//	    fmt.Printf("Clicked entity %d\n", entity.ID)
+//	}
+func Pick(scene *core.Scene, screenX, screenY int) *core.Entity {
+	var best *core.Entity
+	for _, entity := range PickAll(scene, screenX, screenY) {
+		if best == nil || entity.Layer >= best.Layer {
+			best = entity
+		}
+	}
+	return best
+}
+
+// PickAll returns all active entities under a screen position in a scene.
+//
+// Each entity is tested with its layer's camera (parallax and screen-space
+// layers), and entities on hidden or locked layers are skipped.
+func PickAll(scene *core.Scene, screenX, screenY int) []*core.Entity {
+	points := make(map[int]gamemath.Vector2)
+	result := make([]*core.Entity, 0)
+	for _, entity := range scene.GetAllEntities() {
+		if !entity.Active || !scene.LayerPickable(entity.Layer) {
+			continue
+		}
+		point, ok := points[entity.Layer]
+		if !ok {
+			x, y := scene.LayerCamera(entity.Layer).ScreenToWorld(screenX, screenY)
+			point = gamemath.Vector2{X: x, Y: y}
+			points[entity.Layer] = point
+		}
+		if HitBounds(entity).Contains(point.X, point.Y) {
+			result = append(result, entity)
+		}
+	}
+	return result
+}
+
+// ScreenToWorld converts a screen position to world coordinates using a camera.
+// It accepts the int32 coordinates returned by InputManager.MousePosition.
+func ScreenToWorld(camera *graphics.Camera, screenX, screenY int32) gamemath.Vector2 {
+	x, y := camera.ScreenToWorld(int(screenX), int(screenY))
+	return gamemath.Vector2{X: x, Y: y}
+}
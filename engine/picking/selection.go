@@ -0,0 +1,248 @@
+package picking
+
+import (
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// SelectionSet is an ordered set of selected entities keyed by entity ID.
+type SelectionSet struct {
+	entities map[uint64]*core.Entity
+
+	// OnChanged is called after any modification to the set.
+	OnChanged func(set *SelectionSet)
+}
+
+// NewSelectionSet creates an empty selection set.
+func NewSelectionSet() *SelectionSet {
+	return &SelectionSet{
+		entities: make(map[uint64]*core.Entity),
+	}
+}
+
+// Add adds entities to the selection.
+func (s *SelectionSet) Add(entities ...*core.Entity) {
+	for _, entity := range entities {
+		s.entities[entity.ID] = entity
+	}
+	s.changed()
+}
+
+// Remove removes entities from the selection.
+func (s *SelectionSet) Remove(entities ...*core.Entity) {
+	for _, entity := range entities {
+		delete(s.entities, entity.ID)
+	}
+	s.changed()
+}
+
+// Toggle selects unselected entities and deselects selected ones.
+func (s *SelectionSet) Toggle(entities ...*core.Entity) {
+	for _, entity := range entities {
+		if _, selected := s.entities[entity.ID]; selected {
+			delete(s.entities, entity.ID)
+		} else {
+			s.entities[entity.ID] = entity
+		}
+	}
+	s.changed()
+}
+
+// Set replaces the selection with the given entities.
+func (s *SelectionSet) Set(entities ...*core.Entity) {
+	s.entities = make(map[uint64]*core.Entity, len(entities))
+	s.Add(entities...)
+}
+
+// Clear empties the selection.
+func (s *SelectionSet) Clear() {
+	s.entities = make(map[uint64]*core.Entity)
+	s.changed()
+}
+
+// Contains reports whether an entity is selected.
+func (s *SelectionSet) Contains(entity *core.Entity) bool {
+	_, selected := s.entities[entity.ID]
+	return selected
+}
+
+// Len returns the number of selected entities.
+func (s *SelectionSet) Len() int {
+	return len(s.entities)
+}
+
+// Entities returns the selected entities sorted by ID.
+func (s *SelectionSet) Entities() []*core.Entity {
+	result := make([]*core.Entity, 0, len(s.entities))
+	for _, entity := range s.entities {
+		result = append(result, entity)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func (s *SelectionSet) changed() {
+	if s.OnChanged != nil {
+		s.OnChanged(s)
+	}
+}
+
+// SelectionMode controls how a box selection combines with the existing selection.
+type SelectionMode int
+
+const (
+	// SelectReplace replaces the selection with the boxed entities.
+	SelectReplace SelectionMode = iota
+	// SelectAdd adds the boxed entities to the selection.
+	SelectAdd
+	// SelectToggle toggles each boxed entity.
+	SelectToggle
+)
+
+// BoxSelector is a Behavior implementing RTS-style rubber-band selection.
+//
+// Dragging with the selection button draws a screen-space rectangle; on release
+// entities intersecting the rectangle are applied to Selection. Holding
+// AddModifier adds to the selection, ToggleModifier toggles.
+type BoxSelector struct {
+	Input          *input.InputManager // Input manager used for mouse state
+	Scene          *core.Scene         // Scene queried on release
+	Selection      *SelectionSet       // Selection receiving results
+	Button         input.KeyCode       // Mouse button (default KeyMouseLeft)
+	AddModifier    input.KeyCode       // Modifier for SelectAdd (default KeyShift)
+	ToggleModifier input.KeyCode       // Modifier for SelectToggle (default KeyCtrl)
+	Filter         func(entity *core.Entity) bool
+	BoxColor       gamemath.Color // Outline color of the rubber band
+	FillColor      gamemath.Color // Fill color of the rubber band
+
+	dragging bool
+	startX   int32
+	startY   int32
+	endX     int32
+	endY     int32
+}
+
+// NewBoxSelector creates a box selector with default bindings.
+//
+// Example:
+//
+//	selection := picking.NewSelectionSet()
+//	selector := picking.NewBoxSelector(engine.Input(), scene, selection)
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: selector})
+//	engine.SetRenderUICallback(func() { _ = selector.Render(engine.Renderer()) })
+func NewBoxSelector(inputMgr *input.InputManager, scene *core.Scene, selection *SelectionSet) *BoxSelector {
+	return &BoxSelector{
+		Input:          inputMgr,
+		Scene:          scene,
+		Selection:      selection,
+		Button:         input.KeyMouseLeft,
+		AddModifier:    input.KeyShift,
+		ToggleModifier: input.KeyCtrl,
+		BoxColor:       gamemath.Color{R: 0, G: 255, B: 0, A: 255},
+		FillColor:      gamemath.Color{R: 0, G: 255, B: 0, A: 48},
+	}
+}
+
+// Update tracks the rubber band and applies the selection on release.
+func (bs *BoxSelector) Update(_ *core.Entity, _ float64) {
+	if bs.Input == nil {
+		return
+	}
+	mouseX, mouseY := bs.Input.MousePosition()
+
+	switch {
+	case bs.Input.KeyPressed(bs.Button):
+		bs.dragging = true
+		bs.startX, bs.startY = mouseX, mouseY
+		bs.endX, bs.endY = mouseX, mouseY
+	case bs.dragging && bs.Input.KeyReleased(bs.Button):
+		bs.endX, bs.endY = mouseX, mouseY
+		bs.dragging = false
+		bs.apply(bs.mode())
+	case bs.dragging:
+		bs.endX, bs.endY = mouseX, mouseY
+	}
+}
+
+// Dragging reports whether a rubber band is currently active.
+func (bs *BoxSelector) Dragging() bool {
+	return bs.dragging
+}
+
+// ScreenRect returns the current rubber band in screen space (normalized).
+func (bs *BoxSelector) ScreenRect() gamemath.Rectangle {
+	return normalizedRect(float64(bs.startX), float64(bs.startY), float64(bs.endX), float64(bs.endY))
+}
+
+// WorldRect returns the current rubber band in world space using the scene camera.
+func (bs *BoxSelector) WorldRect() gamemath.Rectangle {
+	camera := bs.Scene.Camera()
+	x1, y1 := camera.ScreenToWorld(int(bs.startX), int(bs.startY))
+	x2, y2 := camera.ScreenToWorld(int(bs.endX), int(bs.endY))
+	return normalizedRect(x1, y1, x2, y2)
+}
+
+// Render draws the rubber band rectangle (call from the UI render callback).
+func (bs *BoxSelector) Render(renderer *graphics.Renderer) error {
+	if !bs.dragging {
+		return nil
+	}
+	rect := bs.ScreenRect()
+	if err := renderer.FillRect(rect, bs.FillColor); err != nil {
+		return err
+	}
+	return renderer.DrawRect(rect, bs.BoxColor)
+}
+
+// SelectInRect queries entities intersecting a world rectangle and applies them.
+func (bs *BoxSelector) SelectInRect(rect gamemath.Rectangle, mode SelectionMode) {
+	candidates := bs.Scene.GetEntitiesInRect(rect)
+	hits := make([]*core.Entity, 0, len(candidates))
+	for _, entity := range candidates {
+		if bs.Filter == nil || bs.Filter(entity) {
+			hits = append(hits, entity)
+		}
+	}
+
+	switch mode {
+	case SelectAdd:
+		bs.Selection.Add(hits...)
+	case SelectToggle:
+		bs.Selection.Toggle(hits...)
+	default:
+		bs.Selection.Set(hits...)
+	}
+}
+
+func (bs *BoxSelector) apply(mode SelectionMode) {
+	if bs.Scene == nil || bs.Selection == nil {
+		return
+	}
+	bs.SelectInRect(bs.WorldRect(), mode)
+}
+
+func (bs *BoxSelector) mode() SelectionMode {
+	switch {
+	case bs.Input.KeyHeld(bs.ToggleModifier):
+		return SelectToggle
+	case bs.Input.KeyHeld(bs.AddModifier):
+		return SelectAdd
+	default:
+		return SelectReplace
+	}
+}
+
+// normalizedRect builds a rectangle from two corners in any order.
+func normalizedRect(x1, y1, x2, y2 float64) gamemath.Rectangle {
+	if x2 < x1 {
+		x1, x2 = x2, x1
+	}
+	if y2 < y1 {
+		y1, y2 = y2, y1
+	}
+	return gamemath.Rectangle{X: x1, Y: y1, Width: x2 - x1, Height: y2 - y1}
+}
@@ -0,0 +1,115 @@
+// Package remote provides an optional debug HTTP endpoint exposing engine
+// stats as JSON and running console commands on the game thread.
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownCommand is returned when a console command isn't registered.
+var ErrUnknownCommand = errors.New("unknown command")
+
+// CommandFunc runs a console command with its arguments and returns output text.
+type CommandFunc func(args []string) (string, error)
+
+// commandEntry is a registered command.
+type commandEntry struct {
+	help string
+	run  CommandFunc
+}
+
+// Console is a registry of named debug commands.
+type Console struct {
+	commands map[string]commandEntry
+}
+
+// NewConsole creates a console with the built-in "help" command.
+//
+// Example:
+//
+//	console := remote.NewConsole()
+//	console.Register("god", "Toggle invulnerability", func(args []string) (string, error) {
+//	    player.God = !player.God
+//	    return fmt.Sprintf("god mode %v", player.God), nil
+//	})
+func NewConsole() *Console {
+	c := &Console{commands: make(map[string]commandEntry)}
+	c.Register("help", "List commands", func([]string) (string, error) {
+		return c.Help(), nil
+	})
+	return c
+}
+
+// Register adds or replaces a command.
+func (c *Console) Register(name, help string, run CommandFunc) {
+	c.commands[strings.ToLower(name)] = commandEntry{help: help, run: run}
+}
+
+// Unregister removes a command.
+func (c *Console) Unregister(name string) {
+	delete(c.commands, strings.ToLower(name))
+}
+
+// Commands returns registered command names sorted alphabetically.
+func (c *Console) Commands() []string {
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Help returns one "name - help" line per command.
+func (c *Console) Help() string {
+	var b strings.Builder
+	for _, name := range c.Commands() {
+		fmt.Fprintf(&b, "%s - %s\n", name, c.commands[name].help)
+	}
+	return b.String()
+}
+
+// Execute parses and runs a command line ("spawn orc 3").
+//
+// Arguments are split on whitespace; double quotes group words ("say \"hello world\"").
+func (c *Console) Execute(line string) (string, error) {
+	fields := splitCommand(line)
+	if len(fields) == 0 {
+		return "", nil
+	}
+	entry, ok := c.commands[strings.ToLower(fields[0])]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownCommand, fields[0])
+	}
+	return entry.run(fields[1:])
+}
+
+// splitCommand splits a line on whitespace, honoring double quotes.
+func splitCommand(line string) []string {
+	fields := make([]string, 0)
+	var current strings.Builder
+	quoted, hasField := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			hasField = true
+		case !quoted && (r == ' ' || r == '\t' || r == '\n' || r == '\r'):
+			if hasField {
+				fields = append(fields, current.String())
+				current.Reset()
+				hasField = false
+			}
+		default:
+			current.WriteRune(r)
+			hasField = true
+		}
+	}
+	if hasField {
+		fields = append(fields, current.String())
+	}
+	return fields
+}
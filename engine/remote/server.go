@@ -0,0 +1,327 @@
+package remote
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// StatsFunc gathers stats on the game thread; the result is encoded as JSON.
+type StatsFunc func() any
+
+// request is an HTTP call waiting for the game thread.
+type request struct {
+	command string // Empty = stats request
+	reply   chan response
+}
+
+// response is the game thread's answer.
+type response struct {
+	Output string `json:"output,omitempty"`
+	Error  string `json:"error,omitempty"`
+	Stats  any    `json:"-"`
+}
+
+// Server is a debug HTTP endpoint and a Behavior that services it.
+//
+// HTTP handlers run on their own goroutines, so they never touch game state
+// directly: each request is queued and answered during Update on the game
+// thread. Endpoints:
+//
+//	GET  /stats     JSON from the Stats function
+//	GET  /commands  Console help ("name - help" lines) as JSON
+//	POST /console   Run the request body as a console command (400 if the
+//	                body is empty or the command fails)
+//
+// The server is for development; set Token on anything reachable from an
+// untrusted network. Requests sent by web pages (with an Origin header from
+// another site) are refused, and without a Token so are requests whose Host
+// isn't the server's address or a loopback name, which blocks DNS rebinding.
+type Server struct {
+	Console *Console
+	Stats   StatsFunc
+	Token   string        // Optional; requests must send "Authorization: Bearer <Token>"
+	Timeout time.Duration // How long a request waits for the game thread
+
+	requests chan request
+	listener net.Listener
+	addr     string // Listening address, for Host checks
+	http     *http.Server
+}
+
+// NewServer creates a server with a fresh console.
+//
+// Example:
+//
+//	debug := remote.NewServer(remote.EngineStats(engine))
+//	if err := debug.Start("127.0.0.1:6060"); err != nil {
+//	    log.Printf("remote console disabled: %v", err)
+//	}
+//	defer debug.Stop()
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: debug})
+//
+//	// From another machine or a dashboard:
+//	//   curl http://host:6060/stats
+//	//   curl -d "spawn orc 3" http://host:6060/console
+func NewServer(stats StatsFunc) *Server {
+	return &Server{
+		Console:  NewConsole(),
+		Stats:    stats,
+		Timeout:  2 * time.Second,
+		requests: make(chan request, 16),
+	}
+}
+
+// Start listens on an address ("127.0.0.1:6060", ":0" for any free port).
+func (s *Server) Start(addr string) error {
+	if s.listener != nil {
+		return nil
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to start remote console: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/stats", s.handleStats)
+	mux.HandleFunc("/commands", s.handleCommands)
+	mux.HandleFunc("/console", s.handleConsole)
+
+	s.listener, s.addr = listener, listener.Addr().String()
+	s.http = &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		_ = s.http.Serve(listener) // Returns when Stop closes the server
+	}()
+	return nil
+}
+
+// Addr returns the listening address (nil if not started).
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Stop shuts the server down.
+func (s *Server) Stop() error {
+	if s.http == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := s.http.Shutdown(ctx)
+	s.http, s.listener = nil, nil
+	return err
+}
+
+// Update answers queued requests on the game thread (implements core.Behavior).
+func (s *Server) Update(_ *core.Entity, _ float64) {
+	s.Poll()
+}
+
+// Poll answers all queued requests. Call once per frame if not using Update.
+func (s *Server) Poll() {
+	for {
+		select {
+		case req := <-s.requests:
+			req.reply <- s.serve(req)
+		default:
+			return
+		}
+	}
+}
+
+// serve runs one request.
+func (s *Server) serve(req request) response {
+	if req.command == "" {
+		if s.Stats == nil {
+			return response{Stats: map[string]any{}}
+		}
+		return response{Stats: s.Stats()}
+	}
+	output, err := s.Console.Execute(req.command)
+	if err != nil {
+		return response{Output: output, Error: err.Error()}
+	}
+	return response{Output: output}
+}
+
+// dispatch queues a request and waits for the game thread.
+func (s *Server) dispatch(command string) (response, error) {
+	req := request{command: command, reply: make(chan response, 1)}
+	timeout := time.After(s.Timeout)
+	select {
+	case s.requests <- req:
+	case <-timeout:
+		return response{}, errors.New("game thread busy")
+	}
+	select {
+	case res := <-req.reply:
+		return res, nil
+	case <-timeout:
+		return response{}, errors.New("game thread not responding (is the server updated each frame?)")
+	}
+}
+
+// authorized checks the request's origin and host, then the bearer token.
+func (s *Server) authorized(w http.ResponseWriter, r *http.Request) bool {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if u, err := url.Parse(origin); err != nil || u.Host != r.Host {
+			http.Error(w, "cross-origin requests are not allowed", http.StatusForbidden)
+			return false
+		}
+	}
+	if s.Token == "" {
+		if !s.localHost(r.Host) {
+			http.Error(w, "unexpected host", http.StatusForbidden)
+			return false
+		}
+		return true
+	}
+	want := "Bearer " + s.Token
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(want)) == 1 {
+		return true
+	}
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+	return false
+}
+
+// localHost reports whether a Host header names this server: its listening
+// address, or a loopback name on its port.
+func (s *Server) localHost(host string) bool {
+	if host == s.addr {
+		return true
+	}
+	name, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return false
+	}
+	_, listenPort, err := net.SplitHostPort(s.addr)
+	if err != nil || port != listenPort {
+		return false
+	}
+	if name == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(name)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(w, r) {
+		return
+	}
+	res, err := s.dispatch("")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, http.StatusOK, res.Stats)
+}
+
+func (s *Server) handleCommands(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(w, r) {
+		return
+	}
+	res, err := s.dispatch("help")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) handleConsole(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(w, r) {
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "POST a command line", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 64*1024))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(string(body)) == "" {
+		http.Error(w, "empty command line", http.StatusBadRequest)
+		return
+	}
+	res, err := s.dispatch(string(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	status := http.StatusOK
+	if res.Error != "" {
+		status = http.StatusBadRequest
+	}
+	writeJSON(w, status, res)
+}
+
+// writeJSON encodes a value as the response body with a status code.
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value) // Client disconnects are not actionable
+}
+
+// Stats is the JSON document produced by EngineStats.
+type Stats struct {
+	FPS       float64           `json:"fps"`
+	FrameTime FrameTime         `json:"frame_time_ms"`
+	Scene     *core.SceneStats  `json:"scene,omitempty"`
+	Profile   map[string]Timing `json:"profile"`
+}
+
+// FrameTime summarizes frame durations in milliseconds.
+type FrameTime struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+	Avg float64 `json:"avg"`
+}
+
+// Timing summarizes a profiler section in milliseconds.
+type Timing struct {
+	Last  float64 `json:"last"`
+	Avg   float64 `json:"avg"`
+	Max   float64 `json:"max"`
+	Calls int     `json:"calls"`
+}
+
+// EngineStats returns a StatsFunc reporting FPS, frame times, scene counts, and profiler sections.
+func EngineStats(engine *core.Engine) StatsFunc {
+	return func() any {
+		minFrame, maxFrame, avgFrame := engine.FrameTimeStats()
+		stats := Stats{
+			FPS:       engine.GetFPS(),
+			FrameTime: FrameTime{Min: minFrame * 1000, Max: maxFrame * 1000, Avg: avgFrame * 1000},
+			Profile:   make(map[string]Timing),
+		}
+		if scene := engine.GetScene(); scene != nil {
+			sceneStats := scene.Stats()
+			stats.Scene = &sceneStats
+		}
+		for _, sample := range engine.Profiler().Samples() {
+			stats.Profile[sample.Name] = Timing{
+				Last:  float64(sample.Last.Microseconds()) / 1000,
+				Avg:   float64(sample.Avg.Microseconds()) / 1000,
+				Max:   float64(sample.Max.Microseconds()) / 1000,
+				Calls: sample.Calls,
+			}
+		}
+		return stats
+	}
+}
@@ -0,0 +1,399 @@
+package remote
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// EntitySnapshot is the debug-relevant state of one entity.
+type EntitySnapshot struct {
+	ID       uint64  `json:"id"`
+	Active   bool    `json:"active"`
+	Layer    int     `json:"layer"`
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+	Rotation float64 `json:"rotation"`
+	ScaleX   float64 `json:"scale_x"`
+	ScaleY   float64 `json:"scale_y"`
+	Sprite   bool    `json:"sprite"`
+	Collider bool    `json:"collider"`
+	Behavior string  `json:"behavior,omitempty"` // Go type of the behavior
+}
+
+// Snapshot is a point-in-time dump of a scene, sorted by entity ID.
+type Snapshot struct {
+	Name     string           `json:"name"`
+	Frame    uint64           `json:"frame"`
+	Entities []EntitySnapshot `json:"entities"`
+}
+
+// TakeSnapshot records every entity in a scene.
+//
+// Example:
+//
+//	before := remote.TakeSnapshot(scene, "before", 100)
+//	scene.Update(dt)
+//	after := remote.TakeSnapshot(scene, "after", 101)
+//	fmt.Print(remote.DiffSnapshots(before, after))
+func TakeSnapshot(scene *core.Scene, name string, frame uint64) Snapshot {
+	snapshot := Snapshot{Name: name, Frame: frame, Entities: make([]EntitySnapshot, 0)}
+	if scene == nil {
+		return snapshot
+	}
+	for _, entity := range scene.GetAllEntities() {
+		state := EntitySnapshot{
+			ID:       entity.ID,
+			Active:   entity.Active,
+			Layer:    entity.Layer,
+			X:        entity.Transform.Position.X,
+			Y:        entity.Transform.Position.Y,
+			Rotation: entity.Transform.Rotation,
+			ScaleX:   entity.Transform.Scale.X,
+			ScaleY:   entity.Transform.Scale.Y,
+			Sprite:   entity.Sprite != nil,
+			Collider: entity.Collider != nil,
+		}
+		if entity.Behavior != nil {
+			state.Behavior = fmt.Sprintf("%T", entity.Behavior)
+		}
+		snapshot.Entities = append(snapshot.Entities, state)
+	}
+	sort.Slice(snapshot.Entities, func(i, j int) bool { return snapshot.Entities[i].ID < snapshot.Entities[j].ID })
+	return snapshot
+}
+
+// Active returns the number of active entities.
+func (s Snapshot) Active() int {
+	count := 0
+	for _, entity := range s.Entities {
+		if entity.Active {
+			count++
+		}
+	}
+	return count
+}
+
+// WriteText writes one line per entity in a grep-friendly format.
+func (s Snapshot) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "snapshot %q frame %d: %d entities (%d active)\n", s.Name, s.Frame, len(s.Entities), s.Active()); err != nil {
+		return err
+	}
+	for _, e := range s.Entities {
+		if _, err := fmt.Fprintf(w, "#%d active=%t layer=%d pos=(%.2f, %.2f) rot=%.2f scale=(%.2f, %.2f) sprite=%t collider=%t behavior=%s\n",
+			e.ID, e.Active, e.Layer, e.X, e.Y, e.Rotation, e.ScaleX, e.ScaleY, e.Sprite, e.Collider, e.Behavior); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns the text dump.
+func (s Snapshot) String() string {
+	var b strings.Builder
+	_ = s.WriteText(&b) // strings.Builder never fails
+	return b.String()
+}
+
+// SaveFile writes the snapshot as indented JSON.
+func (s Snapshot) SaveFile(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads a snapshot written by SaveFile.
+func LoadSnapshot(path string) (Snapshot, error) {
+	var snapshot Snapshot
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return snapshot, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return snapshot, fmt.Errorf("failed to decode snapshot: %s: %w", path, err)
+	}
+	return snapshot, nil
+}
+
+// FieldChange is one changed entity field.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// EntityChange lists the changed fields of an entity present in both snapshots.
+type EntityChange struct {
+	ID      uint64        `json:"id"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// SnapshotDiff is the difference between two snapshots.
+type SnapshotDiff struct {
+	Before, After string `json:"-"` // Snapshot names
+
+	CountBefore  int              `json:"count_before"`
+	CountAfter   int              `json:"count_after"`
+	ActiveBefore int              `json:"active_before"`
+	ActiveAfter  int              `json:"active_after"`
+	Added        []EntitySnapshot `json:"added"`
+	Removed      []EntitySnapshot `json:"removed"`
+	Changed      []EntityChange   `json:"changed"`
+}
+
+// Empty reports whether nothing changed.
+func (d SnapshotDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffSnapshots compares two snapshots entity by entity (matched by ID).
+func DiffSnapshots(before, after Snapshot) SnapshotDiff {
+	diff := SnapshotDiff{
+		Before:       before.Name,
+		After:        after.Name,
+		CountBefore:  len(before.Entities),
+		CountAfter:   len(after.Entities),
+		ActiveBefore: before.Active(),
+		ActiveAfter:  after.Active(),
+		Added:        make([]EntitySnapshot, 0),
+		Removed:      make([]EntitySnapshot, 0),
+		Changed:      make([]EntityChange, 0),
+	}
+	old := make(map[uint64]EntitySnapshot, len(before.Entities))
+	for _, entity := range before.Entities {
+		old[entity.ID] = entity
+	}
+	seen := make(map[uint64]bool, len(after.Entities))
+	for _, entity := range after.Entities {
+		seen[entity.ID] = true
+		previous, ok := old[entity.ID]
+		if !ok {
+			diff.Added = append(diff.Added, entity)
+			continue
+		}
+		if changes := diffEntity(previous, entity); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, EntityChange{ID: entity.ID, Changes: changes})
+		}
+	}
+	for _, entity := range before.Entities {
+		if !seen[entity.ID] {
+			diff.Removed = append(diff.Removed, entity)
+		}
+	}
+	return diff
+}
+
+// diffEntity compares fields of the same entity.
+func diffEntity(a, b EntitySnapshot) []FieldChange {
+	changes := make([]FieldChange, 0)
+	add := func(field, before, after string) {
+		if before != after {
+			changes = append(changes, FieldChange{Field: field, Before: before, After: after})
+		}
+	}
+	num := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	add("active", strconv.FormatBool(a.Active), strconv.FormatBool(b.Active))
+	add("layer", strconv.Itoa(a.Layer), strconv.Itoa(b.Layer))
+	add("x", num(a.X), num(b.X))
+	add("y", num(a.Y), num(b.Y))
+	add("rotation", num(a.Rotation), num(b.Rotation))
+	add("scale_x", num(a.ScaleX), num(b.ScaleX))
+	add("scale_y", num(a.ScaleY), num(b.ScaleY))
+	add("sprite", strconv.FormatBool(a.Sprite), strconv.FormatBool(b.Sprite))
+	add("collider", strconv.FormatBool(a.Collider), strconv.FormatBool(b.Collider))
+	add("behavior", a.Behavior, b.Behavior)
+	return changes
+}
+
+// String formats the diff for a console ("+" added, "-" removed, "~" changed).
+func (d SnapshotDiff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s -> %s: entities %d -> %d, active %d -> %d\n", d.Before, d.After, d.CountBefore, d.CountAfter, d.ActiveBefore, d.ActiveAfter)
+	for _, e := range d.Added {
+		fmt.Fprintf(&b, "+ #%d pos=(%.2f, %.2f) active=%t behavior=%s\n", e.ID, e.X, e.Y, e.Active, e.Behavior)
+	}
+	for _, e := range d.Removed {
+		fmt.Fprintf(&b, "- #%d pos=(%.2f, %.2f) active=%t behavior=%s\n", e.ID, e.X, e.Y, e.Active, e.Behavior)
+	}
+	for _, change := range d.Changed {
+		fmt.Fprintf(&b, "~ #%d", change.ID)
+		for _, field := range change.Changes {
+			fmt.Fprintf(&b, " %s: %s -> %s", field.Field, field.Before, field.After)
+		}
+		b.WriteString("\n")
+	}
+	if d.Empty() {
+		b.WriteString("no changes\n")
+	}
+	return b.String()
+}
+
+// Snapshots stores named scene snapshots and registers console commands for them.
+//
+// Add it to the scene so it can count frames and capture frame ranges:
+//
+//	snapshot <name>          capture the scene now
+//	snapshot-frames <n>      capture each of the next n frames as "f<frame>"
+//	snapshot-diff <a> <b>    diff two stored snapshots
+//	snapshot-dump <name>     print a stored snapshot
+//	snapshot-save <name>     write a stored snapshot to Dir as JSON
+//	snapshot-list            list stored snapshots
+type Snapshots struct {
+	Scene func() *core.Scene // Scene to capture (e.g. engine.GetScene)
+	Dir   string             // Directory for snapshot-save (default ".")
+	Limit int                // Maximum stored snapshots; oldest are dropped (default 64)
+
+	snapshots map[string]Snapshot
+	order     []string
+	frame     uint64
+	capturing int // Frames left to capture for snapshot-frames
+}
+
+// NewSnapshots creates a snapshot store and registers its commands on a console.
+//
+// Example:
+//
+//	debug := remote.NewServer(remote.EngineStats(engine))
+//	snapshots := remote.NewSnapshots(debug.Console, engine.GetScene)
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: snapshots})
+//
+//	// curl -d "snapshot-frames 2" http://host:6060/console
+//	// curl -d "snapshot-diff f100 f101" http://host:6060/console
+func NewSnapshots(console *Console, scene func() *core.Scene) *Snapshots {
+	s := &Snapshots{
+		Scene:     scene,
+		Dir:       ".",
+		Limit:     64,
+		snapshots: make(map[string]Snapshot),
+	}
+	if console != nil {
+		s.Register(console)
+	}
+	return s
+}
+
+// Register adds the snapshot commands to a console.
+func (s *Snapshots) Register(console *Console) {
+	console.Register("snapshot", "Capture the scene: snapshot <name>", func(args []string) (string, error) {
+		if len(args) != 1 {
+			return "", fmt.Errorf("usage: snapshot <name>")
+		}
+		snapshot := s.Capture(args[0])
+		return fmt.Sprintf("captured %q: %d entities at frame %d", snapshot.Name, len(snapshot.Entities), snapshot.Frame), nil
+	})
+	console.Register("snapshot-frames", "Capture the next n frames as f<frame>: snapshot-frames <n>", func(args []string) (string, error) {
+		if len(args) != 1 {
+			return "", fmt.Errorf("usage: snapshot-frames <n>")
+		}
+		count, err := strconv.Atoi(args[0])
+		if err != nil || count <= 0 {
+			return "", fmt.Errorf("invalid frame count: %s", args[0])
+		}
+		s.capturing = count
+		return fmt.Sprintf("capturing frames %d-%d", s.frame+1, s.frame+uint64(count)), nil
+	})
+	console.Register("snapshot-diff", "Diff two snapshots: snapshot-diff <a> <b>", func(args []string) (string, error) {
+		if len(args) != 2 {
+			return "", fmt.Errorf("usage: snapshot-diff <a> <b>")
+		}
+		before, err := s.lookup(args[0])
+		if err != nil {
+			return "", err
+		}
+		after, err := s.lookup(args[1])
+		if err != nil {
+			return "", err
+		}
+		return DiffSnapshots(before, after).String(), nil
+	})
+	console.Register("snapshot-dump", "Print a snapshot: snapshot-dump <name>", func(args []string) (string, error) {
+		if len(args) != 1 {
+			return "", fmt.Errorf("usage: snapshot-dump <name>")
+		}
+		snapshot, err := s.lookup(args[0])
+		if err != nil {
+			return "", err
+		}
+		return snapshot.String(), nil
+	})
+	console.Register("snapshot-save", "Write a snapshot to disk as JSON: snapshot-save <name>", func(args []string) (string, error) {
+		if len(args) != 1 {
+			return "", fmt.Errorf("usage: snapshot-save <name>")
+		}
+		snapshot, err := s.lookup(args[0])
+		if err != nil {
+			return "", err
+		}
+		path := filepath.Join(s.Dir, snapshot.Name+".snapshot.json")
+		if err := snapshot.SaveFile(path); err != nil {
+			return "", err
+		}
+		return "wrote " + path, nil
+	})
+	console.Register("snapshot-list", "List stored snapshots", func([]string) (string, error) {
+		var b strings.Builder
+		for _, name := range s.order {
+			snapshot := s.snapshots[name]
+			fmt.Fprintf(&b, "%s frame=%d entities=%d\n", name, snapshot.Frame, len(snapshot.Entities))
+		}
+		return b.String(), nil
+	})
+}
+
+// Capture snapshots the scene under a name (replacing any previous one).
+func (s *Snapshots) Capture(name string) Snapshot {
+	var scene *core.Scene
+	if s.Scene != nil {
+		scene = s.Scene()
+	}
+	snapshot := TakeSnapshot(scene, name, s.frame)
+	if _, exists := s.snapshots[name]; !exists {
+		s.order = append(s.order, name)
+	}
+	s.snapshots[name] = snapshot
+	if s.Limit > 0 && len(s.order) > s.Limit {
+		delete(s.snapshots, s.order[0])
+		s.order = s.order[1:]
+	}
+	return snapshot
+}
+
+// Get returns a stored snapshot.
+func (s *Snapshots) Get(name string) (Snapshot, bool) {
+	snapshot, ok := s.snapshots[name]
+	return snapshot, ok
+}
+
+// Frame returns the number of updates seen.
+func (s *Snapshots) Frame() uint64 {
+	return s.frame
+}
+
+// Update counts frames and captures pending snapshot-frames requests (implements core.Behavior).
+func (s *Snapshots) Update(_ *core.Entity, _ float64) {
+	s.frame++
+	if s.capturing > 0 {
+		s.capturing--
+		s.Capture("f" + strconv.FormatUint(s.frame, 10))
+	}
+}
+
+func (s *Snapshots) lookup(name string) (Snapshot, error) {
+	snapshot, ok := s.snapshots[name]
+	if !ok {
+		return snapshot, fmt.Errorf("no snapshot named %q", name)
+	}
+	return snapshot, nil
+}
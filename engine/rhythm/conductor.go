@@ -0,0 +1,165 @@
+// Package rhythm provides music-synchronized timing: a conductor that follows
+// the audio clock and emits beat events, timing judgment against charted
+// notes, and input latency calibration.
+package rhythm
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// Clock reports the audio playback position in seconds.
+//
+// Implement it with the audio backend's playback cursor (samples played /
+// sample rate). Audio clocks often advance in buffer-sized steps, so the
+// conductor interpolates between readings with frame time.
+type Clock interface {
+	Position() float64
+}
+
+// ClockFunc adapts a function to the Clock interface.
+type ClockFunc func() float64
+
+// Position returns the function's result.
+func (f ClockFunc) Position() float64 {
+	return f()
+}
+
+// Conductor is a Behavior that tracks song position from an audio clock.
+//
+// Song position is the audio clock minus Offset (the time of beat 0 in the
+// file) minus AudioLatency (time from the mixer to the speakers). Between clock
+// updates the position advances by frame dt; when the clock moves it is
+// blended back in, snapping if drift exceeds MaxDrift. Position never moves
+// backwards, so beat events fire exactly once in order.
+type Conductor struct {
+	Clock           Clock
+	BPM             float64 // Tempo in beats per minute
+	Offset          float64 // Seconds into the audio where beat 0 falls
+	AudioLatency    float64 // Output latency in seconds (heard later than played)
+	InputLatency    float64 // Input latency in seconds (pressed earlier than reported)
+	BeatsPerMeasure int     // Beats per measure for OnMeasure (e.g. 4)
+	MaxDrift        float64 // Drift in seconds that forces a resync
+
+	OnBeat    func(beat int)    // Called once per whole beat
+	OnMeasure func(measure int) // Called on the first beat of each measure
+
+	position  float64
+	lastClock float64
+	lastBeat  int
+	started   bool
+}
+
+// NewConductor creates a conductor in 4/4 time.
+//
+// Example:
+//
+//	conductor := rhythm.NewConductor(rhythm.ClockFunc(music.Position), 128)
+//	conductor.Offset = 0.12 // Silence before the first beat
+//	conductor.OnBeat = func(beat int) { speaker.Pulse() }
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: conductor})
+func NewConductor(clock Clock, bpm float64) *Conductor {
+	return &Conductor{
+		Clock:           clock,
+		BPM:             bpm,
+		BeatsPerMeasure: 4,
+		MaxDrift:        0.05,
+		lastBeat:        math.MinInt32,
+	}
+}
+
+// SecondsPerBeat returns the beat length in seconds.
+func (c *Conductor) SecondsPerBeat() float64 {
+	if c.BPM <= 0 {
+		return 0
+	}
+	return 60 / c.BPM
+}
+
+// Position returns the song position in seconds (0 = beat 0 heard).
+func (c *Conductor) Position() float64 {
+	return c.position
+}
+
+// Beat returns the fractional beat at the current position.
+func (c *Conductor) Beat() float64 {
+	return c.BeatAt(c.position)
+}
+
+// BeatAt converts a song position in seconds to a fractional beat.
+func (c *Conductor) BeatAt(position float64) float64 {
+	spb := c.SecondsPerBeat()
+	if spb == 0 {
+		return 0
+	}
+	return position / spb
+}
+
+// TimeOf converts a beat (fractional allowed) to a song position in seconds.
+func (c *Conductor) TimeOf(beat float64) float64 {
+	return beat * c.SecondsPerBeat()
+}
+
+// InputTime returns the song position at which an input pressed now actually happened.
+func (c *Conductor) InputTime() float64 {
+	return c.position - c.InputLatency
+}
+
+// Reset restarts tracking (e.g. after seeking or restarting the song).
+func (c *Conductor) Reset() {
+	c.started = false
+	c.lastBeat = math.MinInt32
+}
+
+// Update advances the song position and fires beat events (implements core.Behavior).
+func (c *Conductor) Update(_ *core.Entity, dt float64) {
+	if c.Clock == nil {
+		return
+	}
+
+	clock := c.Clock.Position()
+	target := clock - c.Offset - c.AudioLatency
+	switch {
+	case !c.started:
+		c.position = target
+		c.lastClock = clock
+		c.started = true
+		c.lastBeat = int(math.Floor(c.Beat())) - 1
+	case clock != c.lastClock:
+		// Fresh clock reading: interpolate forward, then correct toward it
+		c.lastClock = clock
+		predicted := c.position + dt
+		if math.Abs(predicted-target) > c.MaxDrift {
+			predicted = target
+		} else {
+			predicted += (target - predicted) * 0.5
+		}
+		c.position = math.Max(c.position, predicted)
+	default:
+		// Stale clock reading: extrapolate with frame time, but not past
+		// MaxDrift so a paused or stalled stream holds the position
+		c.position = math.Max(c.position, math.Min(c.position+dt, target+c.MaxDrift))
+	}
+
+	c.fireBeats()
+}
+
+// fireBeats emits each whole beat crossed since the last update.
+func (c *Conductor) fireBeats() {
+	current := int(math.Floor(c.Beat()))
+	for beat := c.lastBeat + 1; beat <= current; beat++ {
+		if beat < 0 {
+			continue
+		}
+		if c.OnBeat != nil {
+			c.OnBeat(beat)
+		}
+		if c.OnMeasure != nil && c.BeatsPerMeasure > 0 && beat%c.BeatsPerMeasure == 0 {
+			c.OnMeasure(beat / c.BeatsPerMeasure)
+		}
+	}
+	if current > c.lastBeat {
+		c.lastBeat = current
+	}
+}
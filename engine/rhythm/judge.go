@@ -0,0 +1,229 @@
+package rhythm
+
+import (
+	"math"
+	"sort"
+)
+
+// Grade is a timing judgment result.
+type Grade int
+
+const (
+	Miss Grade = iota
+	Good
+	Great
+	Perfect
+)
+
+// String returns the grade name.
+func (g Grade) String() string {
+	switch g {
+	case Perfect:
+		return "perfect"
+	case Great:
+		return "great"
+	case Good:
+		return "good"
+	default:
+		return "miss"
+	}
+}
+
+// Windows are the maximum absolute timing errors in seconds for each grade.
+type Windows struct {
+	Perfect float64
+	Great   float64
+	Good    float64
+}
+
+// DefaultWindows are typical rhythm game timing windows.
+var DefaultWindows = Windows{Perfect: 0.045, Great: 0.090, Good: 0.135}
+
+// Judge grades a timing error in seconds (negative = early).
+func (w Windows) Judge(offset float64) Grade {
+	abs := math.Abs(offset)
+	switch {
+	case abs <= w.Perfect:
+		return Perfect
+	case abs <= w.Great:
+		return Great
+	case abs <= w.Good:
+		return Good
+	default:
+		return Miss
+	}
+}
+
+// Note is a charted input.
+type Note struct {
+	Beat float64 // Beat the note lands on
+	Lane int     // Input lane (button/column)
+}
+
+// Judgment is the result of hitting or missing a note.
+type Judgment struct {
+	Note   Note
+	Grade  Grade
+	Offset float64 // Seconds the input was off (negative = early)
+}
+
+// Track matches inputs against a chart using a conductor's timeline.
+//
+// Call Hit when a lane's button is pressed and Update once per frame so notes
+// that scroll past the Good window are judged as misses.
+type Track struct {
+	Conductor *Conductor
+	Windows   Windows
+
+	OnJudge func(judgment Judgment) // Called for every hit and miss
+
+	notes  []Note
+	judged []bool
+	next   int // First note that may still be pending
+}
+
+// NewTrack creates a track for a chart (notes are sorted by beat).
+//
+// Example:
+//
+//	track := rhythm.NewTrack(conductor, chart)
+//	track.OnJudge = func(j rhythm.Judgment) { showGrade(j.Grade) }
+//	if engine.Input().KeyPressed(input.KeyD) {
+//	    track.Hit(0)
+//	}
+func NewTrack(conductor *Conductor, notes []Note) *Track {
+	sorted := append([]Note(nil), notes...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Beat < sorted[j].Beat })
+	return &Track{
+		Conductor: conductor,
+		Windows:   DefaultWindows,
+		notes:     sorted,
+		judged:    make([]bool, len(sorted)),
+	}
+}
+
+// Notes returns the chart sorted by beat.
+func (t *Track) Notes() []Note {
+	return t.notes
+}
+
+// Hit judges a press on a lane against the nearest pending note.
+//
+// The input time is corrected by the conductor's InputLatency.
+//
+// Returns:
+//
+//	Judgment: Result of the hit
+//	bool: False if no pending note on the lane was within the Good window (stray press)
+func (t *Track) Hit(lane int) (Judgment, bool) {
+	return t.HitAt(lane, t.Conductor.InputTime())
+}
+
+// HitAt judges a press at an explicit song time (e.g. from timestamped input events).
+func (t *Track) HitAt(lane int, time float64) (Judgment, bool) {
+	best := -1
+	bestOffset := math.Inf(1)
+	for i := t.next; i < len(t.notes); i++ {
+		if t.judged[i] || t.notes[i].Lane != lane {
+			continue
+		}
+		offset := time - t.Conductor.TimeOf(t.notes[i].Beat)
+		if offset < -t.Windows.Good {
+			break // Later notes are even further away
+		}
+		if math.Abs(offset) < math.Abs(bestOffset) {
+			best, bestOffset = i, offset
+		}
+	}
+	if best < 0 || math.Abs(bestOffset) > t.Windows.Good {
+		return Judgment{}, false
+	}
+
+	judgment := Judgment{Note: t.notes[best], Grade: t.Windows.Judge(bestOffset), Offset: bestOffset}
+	t.judge(best, judgment)
+	return judgment, true
+}
+
+// Update judges notes that passed the Good window as misses.
+func (t *Track) Update() {
+	now := t.Conductor.InputTime()
+	for i := t.next; i < len(t.notes); i++ {
+		noteTime := t.Conductor.TimeOf(t.notes[i].Beat)
+		if now-noteTime <= t.Windows.Good {
+			break
+		}
+		if !t.judged[i] {
+			t.judge(i, Judgment{Note: t.notes[i], Grade: Miss, Offset: now - noteTime})
+		}
+	}
+}
+
+// Done reports whether every note has been judged.
+func (t *Track) Done() bool {
+	return t.next >= len(t.notes)
+}
+
+// judge records a judgment and advances past leading judged notes.
+func (t *Track) judge(i int, judgment Judgment) {
+	t.judged[i] = true
+	for t.next < len(t.notes) && t.judged[t.next] {
+		t.next++
+	}
+	if t.OnJudge != nil {
+		t.OnJudge(judgment)
+	}
+}
+
+// Calibrator estimates input latency from taps along to a steady beat.
+//
+// Run a calibration screen that plays a metronome, call Tap on each press,
+// then apply Latency to Conductor.InputLatency.
+type Calibrator struct {
+	Conductor *Conductor
+	offsets   []float64
+}
+
+// NewCalibrator creates a calibrator for a conductor.
+func NewCalibrator(conductor *Conductor) *Calibrator {
+	return &Calibrator{Conductor: conductor, offsets: make([]float64, 0)}
+}
+
+// Tap records a press against the nearest beat (uncorrected for latency).
+func (c *Calibrator) Tap() {
+	c.TapAt(c.Conductor.Position())
+}
+
+// TapAt records a press at an explicit song time.
+func (c *Calibrator) TapAt(time float64) {
+	beat := math.Round(c.Conductor.BeatAt(time))
+	c.offsets = append(c.offsets, time-c.Conductor.TimeOf(beat))
+}
+
+// Samples returns the number of recorded taps.
+func (c *Calibrator) Samples() int {
+	return len(c.offsets)
+}
+
+// Latency returns the median tap offset in seconds (positive = inputs arrive late).
+func (c *Calibrator) Latency() float64 {
+	if len(c.offsets) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), c.offsets...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// Apply sets the conductor's InputLatency from the recorded taps.
+func (c *Calibrator) Apply() {
+	c.Conductor.InputLatency = c.Latency()
+}
+
+// Reset discards recorded taps.
+func (c *Calibrator) Reset() {
+	c.offsets = c.offsets[:0]
+}
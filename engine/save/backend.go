@@ -0,0 +1,272 @@
+package save
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Backend errors.
+var (
+	ErrNotFound = errors.New("save not found")
+	ErrConflict = errors.New("save conflict")
+)
+
+// Meta describes a stored save slot.
+type Meta struct {
+	Slot     string    // Slot name (e.g. "slot1", "autosave")
+	Modified time.Time // Last write time reported by the backend
+	Size     int64     // Payload size in bytes
+	Revision string    // Opaque backend revision (ETag, Steam timestamp...); empty if unsupported
+}
+
+// Backend stores save payloads by slot name.
+//
+// Implementations exist for the local filesystem (LocalBackend) and HTTP
+// (HTTPBackend); platform stores such as Steam Cloud implement the same four
+// methods with their SDK. Payloads are opaque bytes (usually Schema.Encode output).
+type Backend interface {
+	Read(ctx context.Context, slot string) ([]byte, Meta, error)
+	Write(ctx context.Context, slot string, data []byte) (Meta, error)
+	Delete(ctx context.Context, slot string) error
+	List(ctx context.Context) ([]Meta, error)
+}
+
+// LocalBackend stores each slot as a file in a directory.
+type LocalBackend struct {
+	Dir string
+	Ext string // File extension (default ".sav")
+}
+
+// NewLocalBackend creates a filesystem backend rooted at dir.
+func NewLocalBackend(dir string) *LocalBackend {
+	return &LocalBackend{Dir: dir, Ext: ".sav"}
+}
+
+// Read loads a slot file.
+func (b *LocalBackend) Read(_ context.Context, slot string) ([]byte, Meta, error) {
+	path := b.path(slot)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, Meta{}, fmt.Errorf("%w: %s", ErrNotFound, slot)
+	}
+	if err != nil {
+		return nil, Meta{}, fmt.Errorf("failed to read save: %w", err)
+	}
+	meta, err := b.stat(slot)
+	return data, meta, err
+}
+
+// Write stores a slot file atomically.
+func (b *LocalBackend) Write(_ context.Context, slot string, data []byte) (Meta, error) {
+	if err := WriteFileAtomic(b.path(slot), data); err != nil {
+		return Meta{}, err
+	}
+	return b.stat(slot)
+}
+
+// Delete removes a slot file (missing slots are not an error).
+func (b *LocalBackend) Delete(_ context.Context, slot string) error {
+	if err := os.Remove(b.path(slot)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete save: %w", err)
+	}
+	return nil
+}
+
+// List returns all slots sorted by name.
+func (b *LocalBackend) List(_ context.Context) ([]Meta, error) {
+	entries, err := os.ReadDir(b.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Meta{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saves: %w", err)
+	}
+	metas := make([]Meta, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, b.ext()) {
+			continue
+		}
+		meta, err := b.stat(strings.TrimSuffix(name, b.ext()))
+		if err != nil {
+			return nil, err
+		}
+		metas = append(metas, meta)
+	}
+	sort.Slice(metas, func(i, j int) bool { return metas[i].Slot < metas[j].Slot })
+	return metas, nil
+}
+
+func (b *LocalBackend) stat(slot string) (Meta, error) {
+	info, err := os.Stat(b.path(slot))
+	if err != nil {
+		return Meta{}, fmt.Errorf("failed to stat save: %w", err)
+	}
+	return Meta{
+		Slot:     slot,
+		Modified: info.ModTime(),
+		Size:     info.Size(),
+		Revision: strconv.FormatInt(info.ModTime().UnixNano(), 10),
+	}, nil
+}
+
+func (b *LocalBackend) path(slot string) string {
+	return filepath.Join(b.Dir, filepath.Base(slot)+b.ext())
+}
+
+func (b *LocalBackend) ext() string {
+	if b.Ext == "" {
+		return ".sav"
+	}
+	return b.Ext
+}
+
+// HTTPBackend stores saves on a custom HTTP service.
+//
+// Protocol (relative to BaseURL):
+//
+//	GET    /saves          JSON array of {"slot", "modified", "size", "revision"}
+//	GET    /saves/{slot}   Payload; ETag and Last-Modified headers
+//	PUT    /saves/{slot}   Store payload; responds with ETag
+//	DELETE /saves/{slot}   Remove
+type HTTPBackend struct {
+	BaseURL string
+	Headers map[string]string // Auth headers (e.g. "Authorization": "Bearer ...")
+	Client  *http.Client
+}
+
+// NewHTTPBackend creates an HTTP backend with a 15 second timeout.
+func NewHTTPBackend(baseURL string) *HTTPBackend {
+	return &HTTPBackend{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Headers: make(map[string]string),
+		Client:  &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Read downloads a slot.
+func (b *HTTPBackend) Read(ctx context.Context, slot string) ([]byte, Meta, error) {
+	resp, err := b.do(ctx, http.MethodGet, "/saves/"+url.PathEscape(slot), nil)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+	defer func() { _ = resp.Body.Close() }() // Body fully read below
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, Meta{}, fmt.Errorf("%w: %s", ErrNotFound, slot)
+	}
+	if err := httpStatus(resp); err != nil {
+		return nil, Meta{}, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Meta{}, fmt.Errorf("failed to download save: %w", err)
+	}
+	return data, responseMeta(slot, int64(len(data)), resp), nil
+}
+
+// Write uploads a slot.
+func (b *HTTPBackend) Write(ctx context.Context, slot string, data []byte) (Meta, error) {
+	resp, err := b.do(ctx, http.MethodPut, "/saves/"+url.PathEscape(slot), data)
+	if err != nil {
+		return Meta{}, err
+	}
+	_ = resp.Body.Close() // Response body is not used
+	if resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusPreconditionFailed {
+		return Meta{}, fmt.Errorf("%w: %s", ErrConflict, slot)
+	}
+	if err := httpStatus(resp); err != nil {
+		return Meta{}, err
+	}
+	meta := responseMeta(slot, int64(len(data)), resp)
+	if meta.Modified.IsZero() {
+		meta.Modified = time.Now()
+	}
+	return meta, nil
+}
+
+// Delete removes a slot.
+func (b *HTTPBackend) Delete(ctx context.Context, slot string) error {
+	resp, err := b.do(ctx, http.MethodDelete, "/saves/"+url.PathEscape(slot), nil)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close() // Response body is not used
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return httpStatus(resp)
+}
+
+// List returns the slots stored on the service.
+func (b *HTTPBackend) List(ctx context.Context) ([]Meta, error) {
+	resp, err := b.do(ctx, http.MethodGet, "/saves", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }() // Body fully read below
+	if err := httpStatus(resp); err != nil {
+		return nil, err
+	}
+	var listed []struct {
+		Slot     string    `json:"slot"`
+		Modified time.Time `json:"modified"`
+		Size     int64     `json:"size"`
+		Revision string    `json:"revision"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&listed); err != nil {
+		return nil, fmt.Errorf("failed to decode save list: %w", err)
+	}
+	metas := make([]Meta, len(listed))
+	for i, item := range listed {
+		metas[i] = Meta{Slot: item.Slot, Modified: item.Modified, Size: item.Size, Revision: item.Revision}
+	}
+	return metas, nil
+}
+
+func (b *HTTPBackend) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, b.BaseURL+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create save request: %w", err)
+	}
+	for k, v := range b.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("save request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// httpStatus converts non-2xx responses to errors.
+func httpStatus(resp *http.Response) error {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("save service returned %s", resp.Status)
+	}
+	return nil
+}
+
+// responseMeta reads ETag and Last-Modified headers.
+func responseMeta(slot string, size int64, resp *http.Response) Meta {
+	meta := Meta{Slot: slot, Size: size, Revision: resp.Header.Get("ETag")}
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		meta.Modified = modified
+	}
+	return meta
+}
@@ -0,0 +1,265 @@
+// Package save provides versioned save files with registered schema migrations.
+//
+// Saves are JSON documents wrapped in an envelope carrying the schema version.
+// When the game's structures change, bump the schema version and register a
+// migration that upgrades the previous version's raw JSON; old saves are then
+// upgraded step by step on load.
+//
+// Storage is pluggable through Backend (local files, HTTP, or a platform
+// cloud such as Steam), and Sync reconciles a local and remote backend with
+// a conflict resolution hook.
+package save
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dshills/gogame/engine/secure"
+)
+
+// Save errors.
+var (
+	ErrNewerVersion     = errors.New("save was written by a newer version")
+	ErrMissingMigration = errors.New("no migration registered")
+)
+
+// Envelope is the on-disk save wrapper.
+type Envelope struct {
+	Version int             `json:"version"`  // Schema version of Data
+	SavedAt time.Time       `json:"saved_at"` // When the save was written
+	Data    json.RawMessage `json:"data"`     // Game save payload
+}
+
+// Document is a save payload decoded as generic JSON (objects are map[string]any).
+type Document = map[string]any
+
+// Migration upgrades a document from one schema version to the next.
+type Migration func(doc Document) (Document, error)
+
+// Schema describes the current save version and how to upgrade older saves.
+type Schema struct {
+	Version    int           // Current schema version written by Encode
+	Sealer     secure.Sealer // Optional encryption or signing (nil = plain JSON)
+	AllowPlain bool          // Accept unsealed saves when Sealer is set (players upgrading from plain saves)
+
+	migrations map[int]Migration
+}
+
+// NewSchema creates a schema at a version with no migrations.
+//
+// Example:
+//
+//	schema := save.NewSchema(3)
+//	schema.Register(1, func(doc save.Document) (save.Document, error) {
+//	    doc["gold"] = doc["coins"] // v2 renamed coins to gold
+//	    delete(doc, "coins")
+//	    return doc, nil
+//	})
+//	schema.Register(2, func(doc save.Document) (save.Document, error) {
+//	    doc["inventory"] = []any{} // v3 added inventory
+//	    return doc, nil
+//	})
+//	var progress Progress
+//	if _, err := schema.LoadFile("slot1.json", &progress); err != nil {
+//	    log.Printf("failed to load save: %v", err)
+//	}
+func NewSchema(version int) *Schema {
+	return &Schema{
+		Version:    version,
+		migrations: make(map[int]Migration),
+	}
+}
+
+// Register adds the migration from version from to from+1.
+func (s *Schema) Register(from int, migration Migration) {
+	s.migrations[from] = migration
+}
+
+// Encode writes a value as a save at the current version.
+func (s *Schema) Encode(w io.Writer, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode save data: %w", err)
+	}
+	envelope := Envelope{Version: s.Version, SavedAt: time.Now().UTC(), Data: data}
+	if s.Sealer == nil {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(envelope); err != nil {
+			return fmt.Errorf("failed to write save: %w", err)
+		}
+		return nil
+	}
+
+	plain, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode save: %w", err)
+	}
+	sealed, err := s.Sealer.Seal(plain)
+	if err != nil {
+		return fmt.Errorf("failed to seal save: %w", err)
+	}
+	if _, err := w.Write(sealed); err != nil {
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+	return nil
+}
+
+// Decode reads a save, migrates it to the current version, and unmarshals it into out.
+//
+// Saves without an envelope (written before versioning was added) are treated
+// as version 0 payloads.
+//
+// Returns:
+//
+//	int: Version the save was written with (before migration)
+//	error: ErrNewerVersion, ErrMissingMigration, secure.ErrTampered, or a decode/migration error
+func (s *Schema) Decode(r io.Reader, out any) (int, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read save: %w", err)
+	}
+	if s.Sealer != nil {
+		opened, err := s.Sealer.Open(raw)
+		switch {
+		case err == nil:
+			raw = opened
+		case errors.Is(err, secure.ErrNotSealed) && s.AllowPlain:
+			// Plain save from before sealing was enabled
+		default:
+			return 0, fmt.Errorf("failed to open save: %w", err)
+		}
+	}
+	version, data, err := unwrap(raw)
+	if err != nil {
+		return 0, err
+	}
+	if version > s.Version {
+		return version, fmt.Errorf("%w: save v%d, game v%d", ErrNewerVersion, version, s.Version)
+	}
+
+	if version < s.Version {
+		data, err = s.Migrate(data, version)
+		if err != nil {
+			return version, err
+		}
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return version, fmt.Errorf("failed to decode save data: %w", err)
+	}
+	return version, nil
+}
+
+// Migrate upgrades raw payload JSON from a version to the current version.
+func (s *Schema) Migrate(data []byte, from int) ([]byte, error) {
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode save for migration: %w", err)
+	}
+	for version := from; version < s.Version; version++ {
+		migration, ok := s.migrations[version]
+		if !ok {
+			return nil, fmt.Errorf("%w: v%d to v%d", ErrMissingMigration, version, version+1)
+		}
+		upgraded, err := migration(doc)
+		if err != nil {
+			return nil, fmt.Errorf("migration v%d to v%d failed: %w", version, version+1, err)
+		}
+		doc = upgraded
+	}
+	migrated, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode migrated save: %w", err)
+	}
+	return migrated, nil
+}
+
+// SaveFile writes a save atomically (temp file + rename) so crashes never leave a torn save.
+func (s *Schema) SaveFile(path string, value any) error {
+	var buf bytes.Buffer
+	if err := s.Encode(&buf, value); err != nil {
+		return err
+	}
+	return WriteFileAtomic(path, buf.Bytes())
+}
+
+// LoadFile reads and migrates a save file into out.
+func (s *Schema) LoadFile(path string, out any) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open save: %w", err)
+	}
+	defer func() { _ = file.Close() }() // Read-only; close errors are not actionable
+	return s.Decode(file, out)
+}
+
+// SaveTo encodes a value and writes it to a backend slot.
+func (s *Schema) SaveTo(ctx context.Context, backend Backend, slot string, value any) error {
+	var buf bytes.Buffer
+	if err := s.Encode(&buf, value); err != nil {
+		return err
+	}
+	_, err := backend.Write(ctx, slot, buf.Bytes())
+	return err
+}
+
+// LoadFrom reads a backend slot and migrates it into out.
+func (s *Schema) LoadFrom(ctx context.Context, backend Backend, slot string, out any) (int, error) {
+	data, _, err := backend.Read(ctx, slot)
+	if err != nil {
+		return 0, err
+	}
+	return s.Decode(bytes.NewReader(data), out)
+}
+
+// WriteFileAtomic writes data to a temporary file in the same directory and renames it into place.
+func WriteFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create save directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp save: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()           // Best effort cleanup
+		_ = os.Remove(tmp.Name()) // Best effort cleanup
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name()) // Best effort cleanup
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name()) // Best effort cleanup
+		return fmt.Errorf("failed to replace save: %w", err)
+	}
+	return nil
+}
+
+// unwrap extracts the version and payload, accepting unversioned legacy saves.
+func unwrap(raw []byte) (int, []byte, error) {
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse save: %w", err)
+	}
+	_, hasVersion := probe["version"]
+	_, hasData := probe["data"]
+	if !hasVersion || !hasData {
+		return 0, raw, nil // Legacy save: the whole document is the payload
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse save envelope: %w", err)
+	}
+	return envelope.Version, envelope.Data, nil
+}
@@ -0,0 +1,238 @@
+package save
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Action is what a sync did for one slot.
+type Action int
+
+const (
+	ActionNone     Action = iota // Both sides already match
+	ActionUpload                 // Local copy was written to remote
+	ActionDownload               // Remote copy was written to local
+	ActionSkipped                // Conflict resolver chose to leave both sides alone
+)
+
+// String returns the action name.
+func (a Action) String() string {
+	switch a {
+	case ActionUpload:
+		return "upload"
+	case ActionDownload:
+		return "download"
+	case ActionSkipped:
+		return "skipped"
+	default:
+		return "none"
+	}
+}
+
+// Resolution is a conflict resolver's decision.
+type Resolution int
+
+const (
+	KeepLocal  Resolution = iota // Overwrite remote with the local save
+	KeepRemote                   // Overwrite local with the remote save
+	KeepBoth                     // Leave both untouched (e.g. ask the player later)
+)
+
+// Conflict describes a slot changed on both sides since the last sync.
+type Conflict struct {
+	Slot       string
+	Local      Meta
+	Remote     Meta
+	LocalData  []byte
+	RemoteData []byte
+}
+
+// Resolver decides how to settle a conflict. Returning an error aborts the slot's sync.
+type Resolver func(conflict Conflict) (Resolution, error)
+
+// NewestWins keeps whichever side was modified most recently (local on ties).
+func NewestWins(conflict Conflict) (Resolution, error) {
+	if conflict.Remote.Modified.After(conflict.Local.Modified) {
+		return KeepRemote, nil
+	}
+	return KeepLocal, nil
+}
+
+// SyncRecord is the revision pair seen at the last successful sync of a slot.
+type SyncRecord struct {
+	Local  string `json:"local"`
+	Remote string `json:"remote"`
+}
+
+// SyncResult reports the outcome for one slot.
+type SyncResult struct {
+	Slot   string
+	Action Action
+	Err    error
+}
+
+// Sync reconciles a local backend with a remote one (Steam Cloud, HTTP...).
+//
+// A slot changed on only one side since the last sync is copied to the
+// other; a slot changed on both sides is a conflict passed to Resolve.
+// Records holds the revisions seen at the last sync and should be persisted
+// between sessions (it is JSON-encodable) so offline edits are detected.
+type Sync struct {
+	Local   Backend
+	Remote  Backend
+	Resolve Resolver              // Conflict hook (default NewestWins)
+	Records map[string]SyncRecord // Last synced revisions by slot
+
+	// OnConflict is called after a conflict is resolved (for logging/UI)
+	OnConflict func(conflict Conflict, resolution Resolution)
+}
+
+// NewSync creates a sync between two backends using NewestWins.
+//
+// Example:
+//
+//	cloud := save.NewHTTPBackend("https://saves.example.com/v1/players/" + id)
+//	cloud.Headers["Authorization"] = "Bearer " + token
+//	sync := save.NewSync(save.NewLocalBackend(saveDir), cloud)
+//	sync.Resolve = func(c save.Conflict) (save.Resolution, error) {
+//	    return askPlayer(c) // Show both timestamps and let the player pick
+//	}
+//	results, err := sync.SyncAll(ctx)
+//	if err != nil {
+//	    log.Printf("cloud sync unavailable: %v", err) // Play offline
+//	}
+//	for _, result := range results {
+//	    if result.Err != nil {
+//	        log.Printf("cloud sync %s: %v", result.Slot, result.Err)
+//	    }
+//	}
+func NewSync(local, remote Backend) *Sync {
+	return &Sync{
+		Local:   local,
+		Remote:  remote,
+		Resolve: NewestWins,
+		Records: make(map[string]SyncRecord),
+	}
+}
+
+// SyncAll syncs every slot present on either side.
+func (s *Sync) SyncAll(ctx context.Context) ([]SyncResult, error) {
+	slots, err := s.slots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]SyncResult, 0, len(slots))
+	for _, slot := range slots {
+		action, err := s.SyncSlot(ctx, slot)
+		results = append(results, SyncResult{Slot: slot, Action: action, Err: err})
+	}
+	return results, nil
+}
+
+// SyncSlot syncs a single slot.
+func (s *Sync) SyncSlot(ctx context.Context, slot string) (Action, error) {
+	localData, localMeta, localErr := s.Local.Read(ctx, slot)
+	if localErr != nil && !errors.Is(localErr, ErrNotFound) {
+		return ActionNone, localErr
+	}
+	remoteData, remoteMeta, remoteErr := s.Remote.Read(ctx, slot)
+	if remoteErr != nil && !errors.Is(remoteErr, ErrNotFound) {
+		return ActionNone, remoteErr
+	}
+	hasLocal, hasRemote := localErr == nil, remoteErr == nil
+
+	switch {
+	case !hasLocal && !hasRemote:
+		delete(s.Records, slot)
+		return ActionNone, nil
+	case hasLocal && !hasRemote:
+		return s.upload(ctx, slot, localData, localMeta)
+	case !hasLocal && hasRemote:
+		return s.download(ctx, slot, remoteData, remoteMeta)
+	}
+
+	if bytes.Equal(localData, remoteData) {
+		s.Records[slot] = SyncRecord{Local: localMeta.Revision, Remote: remoteMeta.Revision}
+		return ActionNone, nil
+	}
+
+	record, known := s.Records[slot]
+	localChanged := !known || localMeta.Revision == "" || record.Local != localMeta.Revision
+	remoteChanged := !known || remoteMeta.Revision == "" || record.Remote != remoteMeta.Revision
+	switch {
+	case localChanged && !remoteChanged:
+		return s.upload(ctx, slot, localData, localMeta)
+	case remoteChanged && !localChanged:
+		return s.download(ctx, slot, remoteData, remoteMeta)
+	}
+
+	conflict := Conflict{
+		Slot:       slot,
+		Local:      localMeta,
+		Remote:     remoteMeta,
+		LocalData:  localData,
+		RemoteData: remoteData,
+	}
+	resolve := s.Resolve
+	if resolve == nil {
+		resolve = NewestWins
+	}
+	resolution, err := resolve(conflict)
+	if err != nil {
+		return ActionNone, fmt.Errorf("failed to resolve save conflict: %w", err)
+	}
+	if s.OnConflict != nil {
+		s.OnConflict(conflict, resolution)
+	}
+	switch resolution {
+	case KeepLocal:
+		return s.upload(ctx, slot, localData, localMeta)
+	case KeepRemote:
+		return s.download(ctx, slot, remoteData, remoteMeta)
+	default:
+		return ActionSkipped, nil
+	}
+}
+
+// upload writes the local copy to the remote backend.
+func (s *Sync) upload(ctx context.Context, slot string, data []byte, local Meta) (Action, error) {
+	remote, err := s.Remote.Write(ctx, slot, data)
+	if err != nil {
+		return ActionNone, fmt.Errorf("failed to upload save: %w", err)
+	}
+	s.Records[slot] = SyncRecord{Local: local.Revision, Remote: remote.Revision}
+	return ActionUpload, nil
+}
+
+// download writes the remote copy to the local backend.
+func (s *Sync) download(ctx context.Context, slot string, data []byte, remote Meta) (Action, error) {
+	local, err := s.Local.Write(ctx, slot, data)
+	if err != nil {
+		return ActionNone, fmt.Errorf("failed to download save: %w", err)
+	}
+	s.Records[slot] = SyncRecord{Local: local.Revision, Remote: remote.Revision}
+	return ActionDownload, nil
+}
+
+// slots returns the union of slot names on both sides, local order first.
+func (s *Sync) slots(ctx context.Context) ([]string, error) {
+	local, err := s.Local.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := s.Remote.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(local)+len(remote))
+	slots := make([]string, 0, len(local)+len(remote))
+	for _, meta := range append(local, remote...) {
+		if !seen[meta.Slot] {
+			seen[meta.Slot] = true
+			slots = append(slots, meta.Slot)
+		}
+	}
+	return slots, nil
+}
@@ -0,0 +1,149 @@
+// Package secure provides optional encryption and signing for save files and
+// packed assets.
+//
+// These deter casual tampering (editing a save in a text editor, swapping a
+// sprite in a shipped pack); the key ships with the game, so they are not a
+// defense against a determined attacker with a debugger.
+package secure
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// Errors returned when opening sealed data.
+var (
+	ErrTampered   = errors.New("data was modified or key is wrong")
+	ErrNotSealed  = errors.New("data is not sealed")
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// Sealer protects data and verifies it when reopened.
+type Sealer interface {
+	Seal(plain []byte) ([]byte, error)
+	Open(sealed []byte) ([]byte, error)
+}
+
+// Format magic prefixes so mismatched sealers fail clearly.
+var (
+	aesMagic  = []byte("GGAE1")
+	hmacMagic = []byte("GGHS1")
+)
+
+// AES encrypts and authenticates data with AES-GCM.
+//
+// Sealed layout: magic | nonce | ciphertext+tag.
+type AES struct {
+	aead cipher.AEAD
+}
+
+// NewAES creates an AES-GCM sealer from a 16, 24, or 32 byte key.
+//
+// Example:
+//
+//	sealer, err := secure.NewAES(secure.DeriveKey("my-game", "saves"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	schema := save.NewSchema(2)
+//	schema.Sealer = sealer
+func NewAES(key []byte) (*AES, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES-GCM: %w", err)
+	}
+	return &AES{aead: aead}, nil
+}
+
+// Seal encrypts plain with a random nonce.
+func (a *AES) Seal(plain []byte) ([]byte, error) {
+	nonce := make([]byte, a.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	out := make([]byte, 0, len(aesMagic)+len(nonce)+len(plain)+a.aead.Overhead())
+	out = append(out, aesMagic...)
+	out = append(out, nonce...)
+	return a.aead.Seal(out, nonce, plain, aesMagic), nil
+}
+
+// Open decrypts and verifies sealed data.
+func (a *AES) Open(sealed []byte) ([]byte, error) {
+	if !bytes.HasPrefix(sealed, aesMagic) {
+		return nil, ErrNotSealed
+	}
+	body := sealed[len(aesMagic):]
+	if len(body) < a.aead.NonceSize()+a.aead.Overhead() {
+		return nil, ErrTampered
+	}
+	nonce, ciphertext := body[:a.aead.NonceSize()], body[a.aead.NonceSize():]
+	plain, err := a.aead.Open(nil, nonce, ciphertext, aesMagic)
+	if err != nil {
+		return nil, ErrTampered
+	}
+	return plain, nil
+}
+
+// HMAC signs data with HMAC-SHA256, leaving it readable.
+//
+// Sealed layout: magic | 32-byte signature | plain. Useful when saves should
+// stay human-readable for debugging but edits must be detected.
+type HMAC struct {
+	key []byte
+}
+
+// NewHMAC creates a signing sealer. The key must not be empty.
+func NewHMAC(key []byte) (*HMAC, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: empty HMAC key", ErrInvalidKey)
+	}
+	return &HMAC{key: append([]byte(nil), key...)}, nil
+}
+
+// Seal prefixes plain with its signature.
+func (h *HMAC) Seal(plain []byte) ([]byte, error) {
+	out := make([]byte, 0, len(hmacMagic)+sha256.Size+len(plain))
+	out = append(out, hmacMagic...)
+	out = append(out, h.sign(plain)...)
+	return append(out, plain...), nil
+}
+
+// Open verifies the signature and returns the payload.
+func (h *HMAC) Open(sealed []byte) ([]byte, error) {
+	if !bytes.HasPrefix(sealed, hmacMagic) {
+		return nil, ErrNotSealed
+	}
+	body := sealed[len(hmacMagic):]
+	if len(body) < sha256.Size {
+		return nil, ErrTampered
+	}
+	signature, plain := body[:sha256.Size], body[sha256.Size:]
+	if !hmac.Equal(signature, h.sign(plain)) {
+		return nil, ErrTampered
+	}
+	return append([]byte(nil), plain...), nil
+}
+
+func (h *HMAC) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// DeriveKey derives a 32-byte key from a secret and a purpose label, so saves
+// and assets use different keys from one embedded secret.
+func DeriveKey(secret, purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,230 @@
+// Package skilltree provides data-driven upgrade graphs with prerequisites, costs, and unlock callbacks.
+package skilltree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Errors returned by tree operations.
+var (
+	ErrUnknownNode         = errors.New("unknown skill node")
+	ErrAlreadyUnlocked     = errors.New("skill already unlocked")
+	ErrPrerequisitesNotMet = errors.New("prerequisites not met")
+	ErrNotEnoughPoints     = errors.New("not enough skill points")
+	ErrCycle               = errors.New("skill tree contains a cycle")
+)
+
+// Node is a single upgrade in the tree.
+type Node struct {
+	ID            string           `json:"id"`
+	Name          string           `json:"name"`
+	Description   string           `json:"description,omitempty"`
+	Cost          int              `json:"cost"`
+	Prerequisites []string         `json:"prerequisites,omitempty"`
+	Position      gamemath.Vector2 `json:"position"` // Layout position for the tree view (in view units)
+}
+
+// UnlockCallback is called when a node is unlocked.
+type UnlockCallback func(node *Node)
+
+// Tree is a graph of upgrade nodes and the player's unlock state.
+type Tree struct {
+	Points int // Spendable skill points
+
+	nodes     []*Node
+	index     map[string]*Node
+	unlocked  map[string]bool
+	callbacks map[string][]UnlockCallback
+
+	// OnUnlock is called after any node is unlocked.
+	OnUnlock UnlockCallback
+}
+
+// NewTree creates a tree from nodes.
+//
+// Returns:
+//
+//	*Tree: Tree with no unlocked nodes and zero points
+//	error: Non-nil if a prerequisite references an unknown node or the graph has a cycle
+func NewTree(nodes ...*Node) (*Tree, error) {
+	t := &Tree{
+		nodes:     nodes,
+		index:     make(map[string]*Node, len(nodes)),
+		unlocked:  make(map[string]bool),
+		callbacks: make(map[string][]UnlockCallback),
+	}
+	for _, node := range nodes {
+		t.index[node.ID] = node
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// LoadTree reads a tree definition from JSON.
+//
+// Format:
+//
+//	{"nodes": [
+//	    {"id": "dash", "name": "Dash", "cost": 1, "position": {"X": 0, "Y": 0}},
+//	    {"id": "double_dash", "name": "Double Dash", "cost": 2, "prerequisites": ["dash"], "position": {"X": 0, "Y": 1}}
+//	]}
+//
+// Example:
+//
+//	file, _ := os.Open("skills.json")
+//	tree, err := skilltree.LoadTree(file)
+func LoadTree(r io.Reader) (*Tree, error) {
+	var def struct {
+		Nodes []*Node `json:"nodes"`
+	}
+	if err := json.NewDecoder(r).Decode(&def); err != nil {
+		return nil, fmt.Errorf("failed to decode skill tree: %w", err)
+	}
+	return NewTree(def.Nodes...)
+}
+
+// Nodes returns all nodes in definition order.
+func (t *Tree) Nodes() []*Node {
+	return t.nodes
+}
+
+// Get returns a node by ID, or nil.
+func (t *Tree) Get(id string) *Node {
+	return t.index[id]
+}
+
+// IsUnlocked reports whether a node is unlocked.
+func (t *Tree) IsUnlocked(id string) bool {
+	return t.unlocked[id]
+}
+
+// Unlocked returns the IDs of unlocked nodes in definition order.
+func (t *Tree) Unlocked() []string {
+	ids := make([]string, 0, len(t.unlocked))
+	for _, node := range t.nodes {
+		if t.unlocked[node.ID] {
+			ids = append(ids, node.ID)
+		}
+	}
+	return ids
+}
+
+// OnNodeUnlock registers a callback for a specific node.
+//
+// Example:
+//
+//	tree.OnNodeUnlock("double_jump", func(*skilltree.Node) { player.MaxJumps = 2 })
+func (t *Tree) OnNodeUnlock(id string, callback UnlockCallback) {
+	t.callbacks[id] = append(t.callbacks[id], callback)
+}
+
+// CanUnlock reports why a node cannot be unlocked (nil if it can).
+func (t *Tree) CanUnlock(id string) error {
+	node := t.index[id]
+	if node == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownNode, id)
+	}
+	if t.unlocked[id] {
+		return fmt.Errorf("%w: %s", ErrAlreadyUnlocked, id)
+	}
+	for _, prereq := range node.Prerequisites {
+		if !t.unlocked[prereq] {
+			return fmt.Errorf("%w: %s requires %s", ErrPrerequisitesNotMet, id, prereq)
+		}
+	}
+	if node.Cost > t.Points {
+		return fmt.Errorf("%w: %s costs %d, have %d", ErrNotEnoughPoints, id, node.Cost, t.Points)
+	}
+	return nil
+}
+
+// Available reports whether all prerequisites of a locked node are met (ignoring cost).
+func (t *Tree) Available(id string) bool {
+	node := t.index[id]
+	if node == nil || t.unlocked[id] {
+		return false
+	}
+	for _, prereq := range node.Prerequisites {
+		if !t.unlocked[prereq] {
+			return false
+		}
+	}
+	return true
+}
+
+// Unlock spends points and unlocks a node, firing its callbacks.
+func (t *Tree) Unlock(id string) error {
+	if err := t.CanUnlock(id); err != nil {
+		return err
+	}
+	node := t.index[id]
+	t.Points -= node.Cost
+	t.unlocked[id] = true
+
+	for _, callback := range t.callbacks[id] {
+		callback(node)
+	}
+	if t.OnUnlock != nil {
+		t.OnUnlock(node)
+	}
+	return nil
+}
+
+// Restore marks nodes unlocked without spending points or firing callbacks (for loading saves).
+func (t *Tree) Restore(ids ...string) error {
+	for _, id := range ids {
+		if t.index[id] == nil {
+			return fmt.Errorf("%w: %s", ErrUnknownNode, id)
+		}
+		t.unlocked[id] = true
+	}
+	return nil
+}
+
+// validate checks prerequisite references and rejects cycles.
+func (t *Tree) validate() error {
+	for _, node := range t.nodes {
+		for _, prereq := range node.Prerequisites {
+			if t.index[prereq] == nil {
+				return fmt.Errorf("%w: %s (prerequisite of %s)", ErrUnknownNode, prereq, node.ID)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(t.nodes))
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("%w at %s", ErrCycle, id)
+		case done:
+			return nil
+		}
+		state[id] = visiting
+		for _, prereq := range t.index[id].Prerequisites {
+			if err := visit(prereq); err != nil {
+				return err
+			}
+		}
+		state[id] = done
+		return nil
+	}
+	for _, node := range t.nodes {
+		if err := visit(node.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package skilltree
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// View is a screen-space widget that renders a tree and handles navigation.
+//
+// Node positions are multiplied by Spacing and offset by Origin. Arrow keys move
+// the selection to the nearest node in that direction, ActionConfirm unlocks,
+// and clicking a node selects it.
+type View struct {
+	Tree     *Tree
+	Origin   gamemath.Vector2 // Screen position of node (0, 0)
+	Spacing  gamemath.Vector2 // Screen pixels per position unit
+	NodeSize float64          // Node square size in pixels
+	Selected string           // Selected node ID
+
+	LockedColor    gamemath.Color
+	AvailableColor gamemath.Color
+	UnlockedColor  gamemath.Color
+	SelectedColor  gamemath.Color
+	LineColor      gamemath.Color
+	TextColor      gamemath.Color
+
+	// OnUnlockFailed is called when the player tries to unlock a node that can't be unlocked.
+	OnUnlockFailed func(node *Node, err error)
+}
+
+// NewView creates a tree view with default colors, selecting the first node.
+//
+// Example:
+//
+//	view := skilltree.NewView(tree, gamemath.Vector2{X: 100, Y: 100})
+//	// In a behavior: view.HandleInput(engine.Input())
+//	engine.SetRenderUICallback(func() { _ = view.Render(engine.Renderer(), textRenderer) })
+func NewView(tree *Tree, origin gamemath.Vector2) *View {
+	v := &View{
+		Tree:           tree,
+		Origin:         origin,
+		Spacing:        gamemath.Vector2{X: 96, Y: 96},
+		NodeSize:       48,
+		LockedColor:    gamemath.Color{R: 60, G: 60, B: 60, A: 255},
+		AvailableColor: gamemath.Color{R: 200, G: 160, B: 40, A: 255},
+		UnlockedColor:  gamemath.Color{R: 60, G: 180, B: 80, A: 255},
+		SelectedColor:  gamemath.White,
+		LineColor:      gamemath.Color{R: 150, G: 150, B: 150, A: 255},
+		TextColor:      gamemath.White,
+	}
+	if nodes := tree.Nodes(); len(nodes) > 0 {
+		v.Selected = nodes[0].ID
+	}
+	return v
+}
+
+// NodeRect returns the screen rectangle of a node.
+func (v *View) NodeRect(node *Node) gamemath.Rectangle {
+	cx := v.Origin.X + node.Position.X*v.Spacing.X
+	cy := v.Origin.Y + node.Position.Y*v.Spacing.Y
+	return gamemath.Rectangle{
+		X:      cx - v.NodeSize/2,
+		Y:      cy - v.NodeSize/2,
+		Width:  v.NodeSize,
+		Height: v.NodeSize,
+	}
+}
+
+// NodeAt returns the node under a screen position, or nil.
+func (v *View) NodeAt(screenX, screenY float64) *Node {
+	for _, node := range v.Tree.Nodes() {
+		if v.NodeRect(node).Contains(screenX, screenY) {
+			return node
+		}
+	}
+	return nil
+}
+
+// Move selects the nearest node in a direction (dx, dy are -1, 0, or 1).
+//
+// Returns:
+//
+//	bool: True if the selection changed
+func (v *View) Move(dx, dy float64) bool {
+	current := v.Tree.Get(v.Selected)
+	if current == nil {
+		return false
+	}
+	dir := gamemath.Vector2{X: dx, Y: dy}.Normalize()
+
+	var best *Node
+	bestScore := math.MaxFloat64
+	for _, node := range v.Tree.Nodes() {
+		if node == current {
+			continue
+		}
+		offset := node.Position.Sub(current.Position)
+		along := offset.Dot(dir)
+		if along <= 0 {
+			continue
+		}
+		// Prefer nodes straight ahead: penalize sideways distance
+		sideways := math.Abs(offset.X*dir.Y - offset.Y*dir.X)
+		score := along + sideways*2
+		if score < bestScore {
+			best = node
+			bestScore = score
+		}
+	}
+	if best == nil {
+		return false
+	}
+	v.Selected = best.ID
+	return true
+}
+
+// Activate attempts to unlock the selected node.
+func (v *View) Activate() error {
+	err := v.Tree.Unlock(v.Selected)
+	if err != nil && v.OnUnlockFailed != nil {
+		v.OnUnlockFailed(v.Tree.Get(v.Selected), err)
+	}
+	return err
+}
+
+// HandleInput processes arrow keys, ActionConfirm, and mouse clicks.
+func (v *View) HandleInput(im *input.InputManager) {
+	switch {
+	case im.KeyPressed(input.KeyArrowUp):
+		v.Move(0, -1)
+	case im.KeyPressed(input.KeyArrowDown):
+		v.Move(0, 1)
+	case im.KeyPressed(input.KeyArrowLeft):
+		v.Move(-1, 0)
+	case im.KeyPressed(input.KeyArrowRight):
+		v.Move(1, 0)
+	}
+
+	if im.ActionPressed(input.ActionConfirm) {
+		_ = v.Activate() // Reported through OnUnlockFailed
+	}
+
+	if im.KeyPressed(input.KeyMouseLeft) {
+		mouseX, mouseY := im.MousePosition()
+		if node := v.NodeAt(float64(mouseX), float64(mouseY)); node != nil {
+			if node.ID == v.Selected {
+				_ = v.Activate() // Reported through OnUnlockFailed
+			}
+			v.Selected = node.ID
+		}
+	}
+}
+
+// Render draws connections, nodes, and the selected node's name.
+//
+// Parameters:
+//
+//	renderer: Renderer to draw with
+//	textRenderer: Optional text renderer for node names (nil to skip labels)
+func (v *View) Render(renderer *graphics.Renderer, textRenderer *graphics.TextRenderer) error {
+	for _, node := range v.Tree.Nodes() {
+		to := v.NodeRect(node).Center()
+		for _, prereq := range node.Prerequisites {
+			from := v.NodeRect(v.Tree.Get(prereq)).Center()
+			if err := renderer.DrawLine(from.X, from.Y, to.X, to.Y, v.LineColor); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, node := range v.Tree.Nodes() {
+		rect := v.NodeRect(node)
+		if err := renderer.FillRect(rect, v.nodeColor(node)); err != nil {
+			return err
+		}
+		if node.ID == v.Selected {
+			if err := renderer.DrawRect(rect, v.SelectedColor); err != nil {
+				return err
+			}
+		}
+	}
+
+	selected := v.Tree.Get(v.Selected)
+	if textRenderer == nil || selected == nil {
+		return nil
+	}
+	rect := v.NodeRect(selected)
+	return textRenderer.DrawText(selected.Name, int(rect.X), int(rect.Y+rect.Height+4), v.TextColor)
+}
+
+func (v *View) nodeColor(node *Node) gamemath.Color {
+	switch {
+	case v.Tree.IsUnlocked(node.ID):
+		return v.UnlockedColor
+	case v.Tree.Available(node.ID):
+		return v.AvailableColor
+	default:
+		return v.LockedColor
+	}
+}
@@ -0,0 +1,289 @@
+// Package soak plays a scene headlessly for a long simulated session with
+// randomized input, sampling memory, entity counts, and errors so leaks and
+// long-session bugs show up in minutes of wall time instead of hours of play.
+//
+// Example:
+//
+//	runner := soak.New(scene, soak.Config{
+//	    Duration: 4 * time.Hour, // Simulated; runs as fast as the CPU allows
+//	    Input:    inputMgr,
+//	    Keys:     []input.KeyCode{input.KeyW, input.KeyA, input.KeyS, input.KeyD, input.KeySpace},
+//	})
+//	report, err := runner.Run(ctx)
+//	if err != nil || !report.OK() {
+//	    t.Fatalf("soak failed: %v %v", err, report.Problems)
+//	}
+package soak
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gogametest"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// maxKeptErrors bounds the errors kept in a Report (all are counted).
+const maxKeptErrors = 20
+
+// Config describes a soak run.
+type Config struct {
+	Duration    time.Duration // Simulated play time
+	Step        float64       // Fixed update dt in seconds (0 = 1/60)
+	Seed        int64         // Random input seed (runs with the same seed replay the same input)
+	SampleEvery time.Duration // Simulated time between samples (0 = 1 minute)
+	Warmup      time.Duration // Simulated time before the baseline sample (caches, pools fill up)
+
+	Input       *input.InputManager // Manager the game reads (nil = no injected input)
+	Keys        []input.KeyCode     // Keys and buttons pressed and released at random
+	ToggleEvery float64             // Average seconds between state changes of each key (0 = 0.5)
+	MouseArea   gamemath.Rectangle  // Window area the mouse wanders in (zero size = mouse untouched)
+
+	Render bool // Render every step through a recording renderer to exercise draw code
+
+	MaxHeapGrowth   uint64 // Heap growth over the baseline reported as a problem (0 = unchecked)
+	MaxEntityGrowth int    // Entity count growth over the baseline reported as a problem (0 = unchecked)
+
+	OnSample func(sample Sample) // Called after each sample (e.g. progress logging)
+}
+
+// Sample is the state of the run at one point in simulated time.
+type Sample struct {
+	Elapsed    time.Duration  // Simulated time
+	Steps      uint64         // Updates run so far
+	Entities   int            // Entities in the scene
+	Tags       map[string]int // Entities per tag (untagged under "")
+	HeapBytes  uint64         // Live heap after a GC
+	Goroutines int
+	Errors     int // Errors so far
+}
+
+// Report summarizes a soak run.
+type Report struct {
+	Steps        uint64
+	Elapsed      time.Duration // Simulated time covered
+	WallTime     time.Duration // Real time taken
+	Samples      []Sample
+	Baseline     Sample         // First sample at or after Warmup
+	HeapGrowth   int64          // Final heap minus baseline heap
+	EntityGrowth int            // Final entity count minus baseline
+	TagGrowth    map[string]int // Per-tag entity growth (only tags that changed)
+	ErrorCount   int
+	Errors       []error  // First errors recorded
+	Problems     []string // Thresholds exceeded and panics
+}
+
+// OK reports whether the run finished without errors or problems.
+func (r *Report) OK() bool {
+	return r.ErrorCount == 0 && len(r.Problems) == 0
+}
+
+// Runner plays a scene for a soak test.
+type Runner struct {
+	scene    *core.Scene
+	config   Config
+	rng      *rand.Rand
+	renderer *gogametest.FakeRenderer
+	report   Report
+}
+
+// New creates a runner.
+//
+// Parameters:
+//
+//	scene: Scene to play (Update and optionally Render are called directly;
+//	       no window or audio device is needed)
+//	config: Run settings
+func New(scene *core.Scene, config Config) *Runner {
+	if config.Step <= 0 {
+		config.Step = 1.0 / 60
+	}
+	if config.SampleEvery <= 0 {
+		config.SampleEvery = time.Minute
+	}
+	if config.ToggleEvery <= 0 {
+		config.ToggleEvery = 0.5
+	}
+	runner := &Runner{
+		scene:  scene,
+		config: config,
+		rng:    rand.New(rand.NewSource(config.Seed)),
+	}
+	if config.Render {
+		runner.renderer = gogametest.NewFakeRenderer()
+	}
+	return runner
+}
+
+// RecordError counts an error found by game code during the run, e.g. a
+// failed save or an invariant check in a behavior.
+func (r *Runner) RecordError(err error) {
+	if err == nil {
+		return
+	}
+	r.report.ErrorCount++
+	if len(r.report.Errors) < maxKeptErrors {
+		r.report.Errors = append(r.report.Errors, err)
+	}
+}
+
+// Run plays the scene until Duration of simulated time has passed.
+//
+// Returns:
+//
+//	*Report: Samples, growth, and errors (also returned on failure)
+//	error: Context cancellation, or a panic from scene code (the run stops
+//	       because the scene may be left half-updated)
+//
+// Behavior:
+//   - Each step injects random input, updates the scene, optionally
+//     renders it, and ends the input frame, as the engine loop does
+//   - Render errors are counted and the run continues
+//   - A sample is taken at the start, every SampleEvery, and at the end
+func (r *Runner) Run(ctx context.Context) (report *Report, err error) {
+	start := time.Now()
+	var elapsed, nextSample time.Duration
+	haveBaseline := false
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("soak: panic at step %d: %v", r.report.Steps, recovered)
+			r.RecordError(err)
+			r.report.Problems = append(r.report.Problems, err.Error())
+		}
+		r.finish(elapsed, time.Since(start))
+		report = &r.report
+	}()
+
+	for {
+		if elapsed >= nextSample || elapsed >= r.config.Duration {
+			sample := r.sample(elapsed)
+			if !haveBaseline && elapsed >= r.config.Warmup {
+				r.report.Baseline = sample
+				haveBaseline = true
+			}
+			nextSample += r.config.SampleEvery
+		}
+		if elapsed >= r.config.Duration {
+			return nil, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r.step()
+		// From the step count so rounding doesn't accumulate over hours
+		elapsed = time.Duration(math.Round(float64(r.report.Steps) * r.config.Step * float64(time.Second)))
+	}
+}
+
+// step runs one fixed update with random input.
+func (r *Runner) step() {
+	r.injectInput()
+	r.scene.Update(r.config.Step)
+	if r.renderer != nil {
+		r.renderer.Reset()
+		r.RecordError(r.scene.Render(r.renderer.Renderer))
+	}
+	if r.config.Input != nil {
+		r.config.Input.Update()
+	}
+	r.report.Steps++
+}
+
+// injectInput toggles keys and moves the mouse at random.
+func (r *Runner) injectInput() {
+	in := r.config.Input
+	if in == nil {
+		return
+	}
+	chance := r.config.Step / r.config.ToggleEvery
+	for _, key := range r.config.Keys {
+		if r.rng.Float64() < chance {
+			in.InjectKey(key, !in.KeyHeld(key))
+		}
+	}
+	area := r.config.MouseArea
+	if area.Width > 0 && area.Height > 0 && r.rng.Float64() < chance {
+		x := int32(area.X + r.rng.Float64()*area.Width)
+		y := int32(area.Y + r.rng.Float64()*area.Height)
+		button := input.KeyNone
+		if r.rng.Intn(2) == 0 {
+			button = input.KeyMouseLeft
+		}
+		in.InjectMouse(x, y, button)
+	}
+}
+
+// sample records the current state.
+func (r *Runner) sample(elapsed time.Duration) Sample {
+	runtime.GC()
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	entities := r.scene.GetAllEntities()
+	tags := make(map[string]int)
+	for _, entity := range entities {
+		if len(entity.Tags) == 0 {
+			tags[""]++
+		}
+		for _, tag := range entity.Tags {
+			tags[tag]++
+		}
+	}
+	sample := Sample{
+		Elapsed:    elapsed,
+		Steps:      r.report.Steps,
+		Entities:   len(entities),
+		Tags:       tags,
+		HeapBytes:  mem.HeapAlloc,
+		Goroutines: runtime.NumGoroutine(),
+		Errors:     r.report.ErrorCount,
+	}
+	r.report.Samples = append(r.report.Samples, sample)
+	if r.config.OnSample != nil {
+		r.config.OnSample(sample)
+	}
+	return sample
+}
+
+// finish computes growth against the baseline and checks thresholds.
+func (r *Runner) finish(elapsed, wall time.Duration) {
+	r.report.Elapsed = elapsed
+	r.report.WallTime = wall
+	if len(r.report.Samples) == 0 {
+		return
+	}
+	baseline := r.report.Baseline
+	if baseline.Tags == nil {
+		baseline = r.report.Samples[0] // Ended before warmup
+	}
+	last := r.report.Samples[len(r.report.Samples)-1]
+	r.report.HeapGrowth = int64(last.HeapBytes) - int64(baseline.HeapBytes)
+	r.report.EntityGrowth = last.Entities - baseline.Entities
+	r.report.TagGrowth = make(map[string]int)
+	for tag, count := range last.Tags {
+		if growth := count - baseline.Tags[tag]; growth != 0 {
+			r.report.TagGrowth[tag] = growth
+		}
+	}
+	for tag, count := range baseline.Tags {
+		if _, ok := last.Tags[tag]; !ok {
+			r.report.TagGrowth[tag] = -count
+		}
+	}
+
+	if r.config.MaxHeapGrowth > 0 && r.report.HeapGrowth > int64(r.config.MaxHeapGrowth) {
+		r.report.Problems = append(r.report.Problems,
+			fmt.Sprintf("heap grew %d bytes (limit %d)", r.report.HeapGrowth, r.config.MaxHeapGrowth))
+	}
+	if r.config.MaxEntityGrowth > 0 && r.report.EntityGrowth > r.config.MaxEntityGrowth {
+		r.report.Problems = append(r.report.Problems,
+			fmt.Sprintf("entity count grew by %d (limit %d)", r.report.EntityGrowth, r.config.MaxEntityGrowth))
+	}
+}
@@ -0,0 +1,253 @@
+// Package stats provides RPG-style attributes with base values, timed modifiers, and derived stats.
+package stats
+
+import (
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// ModifierType controls how a modifier is applied to a stat.
+//
+// Final value = (base + sum(Flat)) * (1 + sum(PercentAdd)) * product(1 + PercentMult).
+type ModifierType int
+
+const (
+	// Flat adds a fixed amount to the base value.
+	Flat ModifierType = iota
+	// PercentAdd percentages are summed together before being applied (0.1 = +10%).
+	PercentAdd
+	// PercentMult percentages are each applied multiplicatively (0.1 = x1.1).
+	PercentMult
+)
+
+// StackPolicy controls how modifiers sharing a Source combine.
+type StackPolicy int
+
+const (
+	// StackAlways lets every modifier apply independently.
+	StackAlways StackPolicy = iota
+	// StackRefresh replaces an existing modifier from the same source (resetting duration).
+	StackRefresh
+	// StackHighest keeps only the strongest modifier from the same source.
+	StackHighest
+)
+
+// Modifier alters a stat's value, optionally for a limited time.
+type Modifier struct {
+	Stat     string       // Stat name this modifier applies to
+	Type     ModifierType // How the value is applied
+	Value    float64      // Amount (flat units or fraction for percentages)
+	Source   string       // Origin (e.g. "sword_of_fire", "haste_spell") used for stacking/removal
+	Stacking StackPolicy  // How modifiers from the same source combine
+	Duration float64      // Lifetime in seconds (0 = permanent)
+
+	remaining float64
+}
+
+// ChangeCallback is called when a stat's final value changes.
+type ChangeCallback func(stat string, oldValue, newValue float64)
+
+// DerivedFunc computes a derived stat from other stats.
+type DerivedFunc func(s *Stats) float64
+
+// Stats holds base values, active modifiers, and derived stat formulas.
+//
+// Stats implements core.Behavior so timed modifiers expire automatically when
+// attached to an entity; it can also be ticked manually with Tick.
+type Stats struct {
+	base      map[string]float64
+	modifiers []*Modifier
+	derived   map[string]DerivedFunc
+	cache     map[string]float64 // Last computed final values (for change events)
+
+	// OnChanged is called when a stat's final value changes.
+	OnChanged ChangeCallback
+}
+
+// New creates an empty stats container.
+//
+// Example:
+//
+//	s := stats.New()
+//	s.SetBase("strength", 10)
+//	s.SetDerived("attack", func(s *stats.Stats) float64 { return s.Get("strength") * 2 })
+//	s.AddModifier(&stats.Modifier{Stat: "strength", Type: stats.Flat, Value: 5, Source: "ring"})
+func New() *Stats {
+	return &Stats{
+		base:      make(map[string]float64),
+		modifiers: make([]*Modifier, 0),
+		derived:   make(map[string]DerivedFunc),
+		cache:     make(map[string]float64),
+	}
+}
+
+// SetBase sets the base (unmodified) value of a stat.
+func (s *Stats) SetBase(stat string, value float64) {
+	s.base[stat] = value
+	s.refresh()
+}
+
+// Base returns the base value of a stat (0 if unset).
+func (s *Stats) Base(stat string) float64 {
+	return s.base[stat]
+}
+
+// SetDerived registers a stat computed from other stats.
+//
+// Derived stats may themselves be modified; modifiers are applied to the formula result.
+func (s *Stats) SetDerived(stat string, fn DerivedFunc) {
+	s.derived[stat] = fn
+	s.refresh()
+}
+
+// Get returns the final value of a stat after modifiers.
+func (s *Stats) Get(stat string) float64 {
+	base := s.base[stat]
+	if fn, ok := s.derived[stat]; ok {
+		base = fn(s)
+	}
+
+	flat, percentAdd, percentMult := 0.0, 0.0, 1.0
+	for _, m := range s.modifiers {
+		if m.Stat != stat {
+			continue
+		}
+		switch m.Type {
+		case Flat:
+			flat += m.Value
+		case PercentAdd:
+			percentAdd += m.Value
+		case PercentMult:
+			percentMult *= 1 + m.Value
+		}
+	}
+	return (base + flat) * (1 + percentAdd) * percentMult
+}
+
+// AddModifier applies a modifier, honoring its stacking policy.
+//
+// Returns:
+//
+//	bool: False if the modifier was rejected (a stronger StackHighest modifier exists)
+func (s *Stats) AddModifier(m *Modifier) bool {
+	m.remaining = m.Duration
+
+	if m.Source != "" && m.Stacking != StackAlways {
+		for i, existing := range s.modifiers {
+			if existing.Source != m.Source || existing.Stat != m.Stat || existing.Type != m.Type {
+				continue
+			}
+			if m.Stacking == StackHighest && existing.Value >= m.Value {
+				return false
+			}
+			s.modifiers[i] = m
+			s.refresh()
+			return true
+		}
+	}
+
+	s.modifiers = append(s.modifiers, m)
+	s.refresh()
+	return true
+}
+
+// RemoveModifier removes a specific modifier.
+func (s *Stats) RemoveModifier(m *Modifier) {
+	for i, existing := range s.modifiers {
+		if existing == m {
+			s.modifiers = append(s.modifiers[:i], s.modifiers[i+1:]...)
+			s.refresh()
+			return
+		}
+	}
+}
+
+// RemoveSource removes all modifiers from a source (e.g. when unequipping an item).
+//
+// Returns:
+//
+//	int: Number of modifiers removed
+func (s *Stats) RemoveSource(source string) int {
+	kept := s.modifiers[:0]
+	removed := 0
+	for _, m := range s.modifiers {
+		if m.Source == source {
+			removed++
+			continue
+		}
+		kept = append(kept, m)
+	}
+	s.modifiers = kept
+	if removed > 0 {
+		s.refresh()
+	}
+	return removed
+}
+
+// Modifiers returns the active modifiers.
+func (s *Stats) Modifiers() []*Modifier {
+	return s.modifiers
+}
+
+// Remaining returns the seconds left on a timed modifier (0 for permanent).
+func (m *Modifier) Remaining() float64 {
+	return m.remaining
+}
+
+// Tick advances modifier durations and removes expired modifiers.
+func (s *Stats) Tick(dt float64) {
+	kept := s.modifiers[:0]
+	expired := false
+	for _, m := range s.modifiers {
+		if m.Duration > 0 {
+			m.remaining -= dt
+			if m.remaining <= 0 {
+				expired = true
+				continue
+			}
+		}
+		kept = append(kept, m)
+	}
+	s.modifiers = kept
+	if expired {
+		s.refresh()
+	}
+}
+
+// Update implements core.Behavior by ticking modifier durations.
+func (s *Stats) Update(_ *core.Entity, dt float64) {
+	s.Tick(dt)
+}
+
+// Names returns all known stat names (base and derived), sorted.
+func (s *Stats) Names() []string {
+	seen := make(map[string]bool)
+	for name := range s.base {
+		seen[name] = true
+	}
+	for name := range s.derived {
+		seen[name] = true
+	}
+	for _, m := range s.modifiers {
+		seen[m.Stat] = true
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// refresh recomputes all stats and fires change events for values that changed.
+func (s *Stats) refresh() {
+	for _, name := range s.Names() {
+		value := s.Get(name)
+		old, known := s.cache[name]
+		s.cache[name] = value
+		if s.OnChanged != nil && (!known || old != value) {
+			s.OnChanged(name, old, value)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Package status provides timed status effects (poison, stun, speed boost) with stacking and visual hooks.
+package status
+
+import (
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// StackPolicy controls what happens when an active effect is applied again.
+type StackPolicy int
+
+const (
+	// Refresh resets the remaining duration (default).
+	Refresh StackPolicy = iota
+	// Intensify adds a stack (up to MaxStacks) and resets the duration.
+	Intensify
+	// Extend adds the full duration to the remaining time.
+	Extend
+	// Ignore keeps the existing effect unchanged.
+	Ignore
+)
+
+// EffectCallback is called on effect lifecycle events.
+type EffectCallback func(entity *core.Entity, effect *Effect)
+
+// EffectType defines a kind of status effect.
+type EffectType struct {
+	ID           string      // Unique identifier (e.g. "poison")
+	Duration     float64     // Lifetime in seconds (0 = until removed)
+	TickInterval float64     // Seconds between OnTick calls (0 = no ticks)
+	Stacking     StackPolicy // Behavior when reapplied while active
+	MaxStacks    int         // Cap for Intensify (0 = unlimited)
+
+	OnApply  EffectCallback // Called when first applied
+	OnStack  EffectCallback // Called when reapplied while active
+	OnTick   EffectCallback // Called every TickInterval seconds
+	OnExpire EffectCallback // Called when duration runs out
+	OnRemove EffectCallback // Called whenever the effect ends (expired or removed)
+
+	// Visual hooks
+	Tint         *gamemath.Color                           // Sprite tint while active (nil = none)
+	AttachVisual func(entity *core.Entity) (detach func()) // Attach particles/overlays; detach is called on removal
+}
+
+// Effect is an active instance of an EffectType on an entity.
+type Effect struct {
+	Type      *EffectType
+	Remaining float64 // Seconds left (ignored when Type.Duration is 0)
+	Stacks    int     // Current stack count (starts at 1)
+	Elapsed   float64 // Seconds since first applied
+
+	tickTimer float64
+	detach    func()
+	order     int
+}
+
+// Effects is a Behavior that manages the status effects on one entity.
+//
+// Combine it with other behaviors using core.Behaviors so effects tick every
+// scene update.
+type Effects struct {
+	active    map[string]*Effect
+	entity    *core.Entity
+	baseTint  gamemath.Color
+	tinted    bool
+	nextOrder int
+}
+
+// NewEffects creates an effect container for an entity.
+//
+// Example:
+//
+//	poison := &status.EffectType{ID: "poison", Duration: 5, TickInterval: 1,
+//	    OnTick: func(e *core.Entity, fx *status.Effect) { health.Damage(2 * fx.Stacks) },
+//	    Stacking: status.Intensify, MaxStacks: 3, Tint: &gamemath.Green}
+//	effects := status.NewEffects(enemy)
+//	enemy.Behavior = core.Behaviors{enemyAI, effects}
+//	effects.Apply(poison)
+func NewEffects(entity *core.Entity) *Effects {
+	return &Effects{
+		active: make(map[string]*Effect),
+		entity: entity,
+	}
+}
+
+// Apply applies an effect type, honoring its stacking policy.
+//
+// Returns:
+//
+//	*Effect: The active effect instance
+func (fx *Effects) Apply(effectType *EffectType) *Effect {
+	if existing, ok := fx.active[effectType.ID]; ok {
+		switch effectType.Stacking {
+		case Ignore:
+			return existing
+		case Intensify:
+			if effectType.MaxStacks == 0 || existing.Stacks < effectType.MaxStacks {
+				existing.Stacks++
+			}
+			existing.Remaining = effectType.Duration
+		case Extend:
+			existing.Remaining += effectType.Duration
+		default:
+			existing.Remaining = effectType.Duration
+		}
+		if effectType.OnStack != nil {
+			effectType.OnStack(fx.entity, existing)
+		}
+		return existing
+	}
+
+	effect := &Effect{
+		Type:      effectType,
+		Remaining: effectType.Duration,
+		Stacks:    1,
+		order:     fx.nextOrder,
+	}
+	fx.nextOrder++
+	fx.active[effectType.ID] = effect
+
+	if effectType.AttachVisual != nil {
+		effect.detach = effectType.AttachVisual(fx.entity)
+	}
+	if effectType.OnApply != nil {
+		effectType.OnApply(fx.entity, effect)
+	}
+	fx.updateTint()
+	return effect
+}
+
+// Remove ends an effect early. No-op if not active.
+func (fx *Effects) Remove(id string) {
+	if effect, ok := fx.active[id]; ok {
+		fx.end(effect, false)
+	}
+}
+
+// Clear removes all effects.
+func (fx *Effects) Clear() {
+	for _, effect := range fx.Active() {
+		fx.end(effect, false)
+	}
+}
+
+// Has reports whether an effect is active (e.g. Has("stun") to skip input).
+func (fx *Effects) Has(id string) bool {
+	_, ok := fx.active[id]
+	return ok
+}
+
+// Get returns an active effect, or nil.
+func (fx *Effects) Get(id string) *Effect {
+	return fx.active[id]
+}
+
+// Active returns active effects in application order.
+func (fx *Effects) Active() []*Effect {
+	result := make([]*Effect, 0, len(fx.active))
+	for _, effect := range fx.active {
+		result = append(result, effect)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].order < result[j].order })
+	return result
+}
+
+// Update advances durations and ticks, expiring finished effects.
+//
+// Effects removed by another effect's callback during the update stop
+// immediately and don't tick or expire.
+func (fx *Effects) Update(entity *core.Entity, dt float64) {
+	fx.entity = entity
+	for _, effect := range fx.Active() {
+		if !fx.isActive(effect) {
+			continue
+		}
+		effect.Elapsed += dt
+
+		if effect.Type.TickInterval > 0 && effect.Type.OnTick != nil {
+			effect.tickTimer += dt
+			for effect.tickTimer >= effect.Type.TickInterval {
+				effect.tickTimer -= effect.Type.TickInterval
+				effect.Type.OnTick(entity, effect)
+				if !fx.isActive(effect) {
+					break
+				}
+			}
+			if !fx.isActive(effect) {
+				continue
+			}
+		}
+
+		if effect.Type.Duration > 0 {
+			effect.Remaining -= dt
+			if effect.Remaining <= 0 {
+				fx.end(effect, true)
+			}
+		}
+	}
+}
+
+// isActive reports whether an effect instance is still applied (not
+// removed, and not replaced by a later application of its type).
+func (fx *Effects) isActive(effect *Effect) bool {
+	return fx.active[effect.Type.ID] == effect
+}
+
+// end removes an effect and fires its callbacks. Ending an effect that is
+// no longer active does nothing.
+func (fx *Effects) end(effect *Effect, expired bool) {
+	if !fx.isActive(effect) {
+		return
+	}
+	delete(fx.active, effect.Type.ID)
+	if effect.detach != nil {
+		effect.detach()
+	}
+	if expired && effect.Type.OnExpire != nil {
+		effect.Type.OnExpire(fx.entity, effect)
+	}
+	if effect.Type.OnRemove != nil {
+		effect.Type.OnRemove(fx.entity, effect)
+	}
+	fx.updateTint()
+}
+
+// updateTint applies the most recently applied tint, restoring the original when none remain.
+func (fx *Effects) updateTint() {
+	if fx.entity == nil || fx.entity.Sprite == nil {
+		return
+	}
+
+	var tint *gamemath.Color
+	for _, effect := range fx.Active() {
+		if effect.Type.Tint != nil {
+			tint = effect.Type.Tint
+		}
+	}
+
+	switch {
+	case tint != nil && !fx.tinted:
+		fx.baseTint = fx.entity.Sprite.Color
+		fx.tinted = true
+		fx.entity.Sprite.SetColor(*tint)
+	case tint != nil:
+		fx.entity.Sprite.SetColor(*tint)
+	case fx.tinted:
+		fx.entity.Sprite.SetColor(fx.baseTint)
+		fx.tinted = false
+	}
+}
@@ -0,0 +1,283 @@
+// Package terrain provides bitmap-based destructible terrain with pixel collision queries.
+package terrain
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Terrain is a Behavior holding a destructible bitmap and the entity that displays it.
+//
+// A pixel is solid when its alpha is non-zero. Carving clears pixels and marks
+// the affected rectangle dirty; Update uploads only the dirty rectangle to the
+// texture once per frame.
+type Terrain struct {
+	Position gamemath.Vector2 // World position of the top-left pixel
+
+	pixels  *image.RGBA
+	dirty   image.Rectangle
+	texture *graphics.Texture
+	entity  *core.Entity
+}
+
+// New creates terrain from an image.
+//
+// Parameters:
+//
+//	renderer: Renderer for the display texture (nil = collision only, e.g. servers/tests)
+//	img: Terrain image (transparent pixels are empty)
+//	position: World position of the image's top-left corner
+//	z: Render layer for the terrain entity
+//
+// Returns:
+//
+//	*Terrain: Terrain ready to add to a scene via Entity()
+//	error: Non-nil if the texture can't be created
+//
+// Example:
+//
+//	level, _ := os.Open("assets/island.png")
+//	img, _, _ := image.Decode(level)
+//	ground, err := terrain.New(engine.Renderer(), img, gamemath.Vector2{}, 0)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	scene.AddEntity(ground.Entity())
+//	ground.CarveCircle(explosion.Position, 40)
+func New(renderer *graphics.Renderer, img image.Image, position gamemath.Vector2, z int) (*Terrain, error) {
+	bounds := img.Bounds()
+	pixels := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(pixels, pixels.Bounds(), img, bounds.Min, draw.Src)
+
+	t := &Terrain{
+		Position: position,
+		pixels:   pixels,
+		dirty:    pixels.Bounds(),
+	}
+	t.entity = &core.Entity{
+		Active:    true,
+		Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}},
+		Behavior:  t,
+		Layer:     z,
+	}
+	t.syncEntity()
+
+	if renderer != nil {
+		texture, err := renderer.NewStreamingTexture(bounds.Dx(), bounds.Dy())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create terrain texture: %w", err)
+		}
+		t.texture = texture
+		t.entity.Sprite = graphics.NewSprite(texture)
+		if err := t.flush(); err != nil {
+			_ = texture.Destroy() // Best effort cleanup
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Entity returns the entity that displays the terrain. Add it to the scene.
+func (t *Terrain) Entity() *core.Entity {
+	return t.entity
+}
+
+// Width returns the terrain width in pixels.
+func (t *Terrain) Width() int {
+	return t.pixels.Rect.Dx()
+}
+
+// Height returns the terrain height in pixels.
+func (t *Terrain) Height() int {
+	return t.pixels.Rect.Dy()
+}
+
+// Bounds returns the terrain's world rectangle.
+func (t *Terrain) Bounds() gamemath.Rectangle {
+	return gamemath.Rectangle{X: t.Position.X, Y: t.Position.Y, Width: float64(t.Width()), Height: float64(t.Height())}
+}
+
+// Solid reports whether the terrain is solid at a world point.
+func (t *Terrain) Solid(x, y float64) bool {
+	px, py := t.toPixel(x, y)
+	if !(image.Point{X: px, Y: py}).In(t.pixels.Rect) {
+		return false
+	}
+	return t.pixels.Pix[t.pixels.PixOffset(px, py)+3] != 0
+}
+
+// OverlapsRect reports whether any solid pixel lies inside a world rectangle.
+//
+// Example:
+//
+//	if ground.OverlapsRect(player.GetBounds()) {
+//	    player.Transform.Position.Y -= 1 // Step out of the ground
+//	}
+func (t *Terrain) OverlapsRect(rect gamemath.Rectangle) bool {
+	area := t.pixelRect(rect)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		row := t.pixels.Pix[t.pixels.PixOffset(area.Min.X, y):t.pixels.PixOffset(area.Max.X, y)]
+		for i := 3; i < len(row); i += 4 {
+			if row[i] != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SurfaceBelow returns the world Y of the first solid pixel at or below (x, y).
+//
+// Returns:
+//
+//	float64: Surface height
+//	bool: False if there is no terrain below the point
+func (t *Terrain) SurfaceBelow(x, y float64) (float64, bool) {
+	px, py := t.toPixel(x, y)
+	if px < 0 || px >= t.Width() {
+		return 0, false
+	}
+	for ; py < t.Height(); py++ {
+		if py >= 0 && t.pixels.Pix[t.pixels.PixOffset(px, py)+3] != 0 {
+			return t.Position.Y + float64(py), true
+		}
+	}
+	return 0, false
+}
+
+// CarveCircle removes terrain inside a world-space circle.
+//
+// Returns:
+//
+//	int: Number of solid pixels removed
+func (t *Terrain) CarveCircle(center gamemath.Vector2, radius float64) int {
+	return t.paintCircle(center, radius, color.RGBA{})
+}
+
+// CarveRect removes terrain inside a world rectangle.
+//
+// Returns:
+//
+//	int: Number of solid pixels removed
+func (t *Terrain) CarveRect(rect gamemath.Rectangle) int {
+	area := t.pixelRect(rect)
+	removed := 0
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			if t.setPixel(x, y, color.RGBA{}) {
+				removed++
+			}
+		}
+	}
+	t.markDirty(area)
+	return removed
+}
+
+// FillCircle adds solid terrain of a color inside a world-space circle (e.g. dirt bombs).
+func (t *Terrain) FillCircle(center gamemath.Vector2, radius float64, fill color.RGBA) {
+	if fill.A == 0 {
+		fill.A = 255
+	}
+	t.paintCircle(center, radius, fill)
+}
+
+// Update uploads the dirty region to the texture (implements core.Behavior).
+func (t *Terrain) Update(_ *core.Entity, _ float64) {
+	t.syncEntity()
+	_ = t.flush() // Upload failures are retried next frame (region stays dirty)
+}
+
+// Image returns the terrain bitmap (modify via the carve/fill methods to keep the texture in sync).
+func (t *Terrain) Image() *image.RGBA {
+	return t.pixels
+}
+
+// Destroy releases the terrain texture.
+func (t *Terrain) Destroy() {
+	if t.texture != nil {
+		_ = t.texture.Destroy() // Best effort cleanup
+		t.texture = nil
+	}
+	t.entity.Sprite = nil
+}
+
+// paintCircle sets pixels within a circle and returns how many changed solidity.
+func (t *Terrain) paintCircle(center gamemath.Vector2, radius float64, fill color.RGBA) int {
+	cx, cy := center.X-t.Position.X, center.Y-t.Position.Y
+	area := image.Rect(
+		int(math.Floor(cx-radius)), int(math.Floor(cy-radius)),
+		int(math.Ceil(cx+radius))+1, int(math.Ceil(cy+radius))+1,
+	).Intersect(t.pixels.Rect)
+
+	changed := 0
+	radiusSq := radius * radius
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		dy := float64(y) + 0.5 - cy
+		for x := area.Min.X; x < area.Max.X; x++ {
+			dx := float64(x) + 0.5 - cx
+			if dx*dx+dy*dy <= radiusSq && t.setPixel(x, y, fill) {
+				changed++
+			}
+		}
+	}
+	t.markDirty(area)
+	return changed
+}
+
+// setPixel writes a pixel and reports whether its solidity changed.
+func (t *Terrain) setPixel(x, y int, c color.RGBA) bool {
+	i := t.pixels.PixOffset(x, y)
+	wasSolid := t.pixels.Pix[i+3] != 0
+	t.pixels.Pix[i], t.pixels.Pix[i+1], t.pixels.Pix[i+2], t.pixels.Pix[i+3] = c.R, c.G, c.B, c.A
+	return wasSolid != (c.A != 0)
+}
+
+// markDirty grows the region pending upload.
+func (t *Terrain) markDirty(area image.Rectangle) {
+	if area.Empty() {
+		return
+	}
+	t.dirty = t.dirty.Union(area)
+}
+
+// flush uploads the dirty region to the texture.
+func (t *Terrain) flush() error {
+	if t.texture == nil || t.dirty.Empty() {
+		t.dirty = image.Rectangle{}
+		return nil
+	}
+
+	if err := t.texture.UpdateFromImage(t.pixels, t.dirty); err != nil {
+		return fmt.Errorf("failed to upload terrain region: %w", err)
+	}
+	t.dirty = image.Rectangle{}
+	return nil
+}
+
+// syncEntity centers the display entity over the bitmap.
+func (t *Terrain) syncEntity() {
+	t.entity.Transform.Position = gamemath.Vector2{
+		X: t.Position.X + float64(t.Width())/2,
+		Y: t.Position.Y + float64(t.Height())/2,
+	}
+}
+
+// toPixel converts world coordinates to pixel coordinates.
+func (t *Terrain) toPixel(x, y float64) (int, int) {
+	return int(math.Floor(x - t.Position.X)), int(math.Floor(y - t.Position.Y))
+}
+
+// pixelRect converts a world rectangle to a clipped pixel rectangle.
+func (t *Terrain) pixelRect(rect gamemath.Rectangle) image.Rectangle {
+	minX, minY := t.toPixel(rect.X, rect.Y)
+	maxX := int(math.Ceil(rect.X + rect.Width - t.Position.X))
+	maxY := int(math.Ceil(rect.Y + rect.Height - t.Position.Y))
+	return image.Rect(minX, minY, maxX, maxY).Intersect(t.pixels.Rect)
+}
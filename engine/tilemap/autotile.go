@@ -0,0 +1,235 @@
+package tilemap
+
+import "sort"
+
+// Neighbor bits of an autotile mask, clockwise from north.
+const (
+	North     uint8 = 1 << iota // Cell above
+	NorthEast                   // Cell above-right (8-bit mode only)
+	East                        // Cell to the right
+	SouthEast                   // Cell below-right (8-bit mode only)
+	South                       // Cell below
+	SouthWest                   // Cell below-left (8-bit mode only)
+	West                        // Cell to the left
+	NorthWest                   // Cell above-left (8-bit mode only)
+)
+
+// AutotileMode selects which neighbors pick a terrain tile.
+type AutotileMode int
+
+const (
+	// Autotile4 uses the four edge neighbors (16 variants).
+	Autotile4 AutotileMode = iota
+	// Autotile8 uses edges and corners, where a corner only counts when both
+	// edges beside it match (the 47-variant "blob" set).
+	Autotile8
+)
+
+// Terrain is a set of tile variants chosen by which neighbors share the terrain.
+type Terrain struct {
+	Name        string
+	Mode        AutotileMode
+	Tiles       map[uint8]GID // Variant for each neighbor mask (flags allowed)
+	Default     GID           // Variant for masks missing from Tiles
+	EdgesMatch  bool          // Cells outside the layer count as this terrain
+	memberships map[uint32]bool
+}
+
+// NewTerrain creates a terrain with no variants.
+func NewTerrain(name string, mode AutotileMode) *Terrain {
+	return &Terrain{
+		Name:        name,
+		Mode:        mode,
+		Tiles:       make(map[uint8]GID),
+		memberships: make(map[uint32]bool),
+	}
+}
+
+// NewTerrain4 creates a 4-bit terrain from 16 consecutive tiles.
+//
+// Tile firstGID+i is the variant for edge bits i, with bit 0 = north,
+// 1 = east, 2 = south, 3 = west (the common 16-tile sheet order).
+//
+// Example:
+//
+//	water := tilemap.NewTerrain4("water", 33)
+//	level.AddTerrain(water)
+func NewTerrain4(name string, firstGID uint32) *Terrain {
+	terrain := NewTerrain(name, Autotile4)
+	edges := []uint8{North, East, South, West}
+	for i := 0; i < 16; i++ {
+		var mask uint8
+		for bit, edge := range edges {
+			if i&(1<<bit) != 0 {
+				mask |= edge
+			}
+		}
+		terrain.Set(mask, GID(firstGID+uint32(i)))
+	}
+	terrain.Default = GID(firstGID + 15)
+	return terrain
+}
+
+// NewTerrain8 creates a blob terrain from 47 consecutive tiles, one per
+// BlobMasks entry in order.
+func NewTerrain8(name string, firstGID uint32) *Terrain {
+	terrain := NewTerrain(name, Autotile8)
+	for i, mask := range BlobMasks() {
+		terrain.Set(mask, GID(firstGID+uint32(i)))
+	}
+	terrain.Default = GID(firstGID + 46) // Fully surrounded
+	return terrain
+}
+
+// Set assigns the variant for a neighbor mask.
+func (t *Terrain) Set(mask uint8, tile GID) {
+	t.Tiles[mask] = tile
+	t.memberships[tile.ID()] = true
+}
+
+// Contains reports whether a tile ID (flags stripped) is one of the terrain's variants.
+func (t *Terrain) Contains(id uint32) bool {
+	return id != 0 && (t.memberships[id] || t.Default.ID() == id)
+}
+
+// TileFor returns the variant for a neighbor mask.
+func (t *Terrain) TileFor(mask uint8) GID {
+	if tile, ok := t.Tiles[t.reduce(mask)]; ok {
+		return tile
+	}
+	return t.Default
+}
+
+// reduce drops the neighbor bits the terrain's mode ignores.
+func (t *Terrain) reduce(mask uint8) uint8 {
+	if t.Mode == Autotile4 {
+		return mask & (North | East | South | West)
+	}
+	return reduceBlob(mask)
+}
+
+// reduceBlob clears corners whose adjacent edges are not both set.
+func reduceBlob(mask uint8) uint8 {
+	corners := []struct{ corner, a, b uint8 }{
+		{NorthEast, North, East},
+		{SouthEast, South, East},
+		{SouthWest, South, West},
+		{NorthWest, North, West},
+	}
+	for _, c := range corners {
+		if mask&c.a == 0 || mask&c.b == 0 {
+			mask &^= c.corner
+		}
+	}
+	return mask
+}
+
+// BlobMasks returns the 47 distinct 8-bit masks in ascending order.
+func BlobMasks() []uint8 {
+	seen := make(map[uint8]bool)
+	masks := make([]uint8, 0, 47)
+	for m := 0; m < 256; m++ {
+		mask := reduceBlob(uint8(m))
+		if !seen[mask] {
+			seen[mask] = true
+			masks = append(masks, mask)
+		}
+	}
+	sort.Slice(masks, func(i, j int) bool { return masks[i] < masks[j] })
+	return masks
+}
+
+// neighborOffsets lists cell offsets in mask bit order.
+var neighborOffsets = [8][2]int{
+	{0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1},
+}
+
+// Mask returns the neighbor mask of a cell for a terrain.
+func (t *Terrain) Mask(layer *Layer, x, y int) uint8 {
+	var mask uint8
+	for bit, offset := range neighborOffsets {
+		nx, ny := x+offset[0], y+offset[1]
+		if nx < 0 || ny < 0 || nx >= layer.Width || ny >= layer.Height {
+			if t.EdgesMatch {
+				mask |= 1 << bit
+			}
+			continue
+		}
+		if t.Contains(layer.Tiles[ny*layer.Width+nx].ID()) {
+			mask |= 1 << bit
+		}
+	}
+	return t.reduce(mask)
+}
+
+// AddTerrain registers a terrain for autotiling.
+func (m *Map) AddTerrain(terrain *Terrain) {
+	m.Terrains = append(m.Terrains, terrain)
+}
+
+// TerrainAt returns the terrain of a cell's tile, or nil.
+func (m *Map) TerrainAt(layer *Layer, x, y int) *Terrain {
+	if x < 0 || y < 0 || x >= layer.Width || y >= layer.Height {
+		return nil
+	}
+	id := layer.Tiles[y*layer.Width+x].ID()
+	for _, terrain := range m.Terrains {
+		if terrain.Contains(id) {
+			return terrain
+		}
+	}
+	return nil
+}
+
+// Autotile picks the variant of every terrain cell in a layer. Call it
+// after loading or generating a level; cells only need any tile of their
+// terrain (such as Default) beforehand.
+func (m *Map) Autotile(layer *Layer) {
+	// Swapping variants never changes which terrain a cell belongs to, so
+	// cells can be resolved in place
+	for y := 0; y < layer.Height; y++ {
+		for x := 0; x < layer.Width; x++ {
+			if terrain := m.TerrainAt(layer, x, y); terrain != nil {
+				layer.Tiles[y*layer.Width+x] = terrain.TileFor(terrain.Mask(layer, x, y))
+			}
+		}
+	}
+}
+
+// AutotileAround re-picks the variants of a cell and its eight neighbors,
+// for terrain changed at runtime.
+func (m *Map) AutotileAround(layer *Layer, x, y int) {
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			cx, cy := x+dx, y+dy
+			if terrain := m.TerrainAt(layer, cx, cy); terrain != nil {
+				layer.Tiles[cy*layer.Width+cx] = terrain.TileFor(terrain.Mask(layer, cx, cy))
+			}
+		}
+	}
+}
+
+// PaintTerrain places terrain in a cell (nil clears it) and updates the
+// surrounding variants. It works on any layer; layers from AddLayer also
+// get SetTile's collision and dirty-chunk updates.
+//
+// Example:
+//
+//	// Dig out the cell under the cursor
+//	cx, cy := level.CellAt(mouseWorldX, mouseWorldY)
+//	level.PaintTerrain(ground, cx, cy, nil)
+func (m *Map) PaintTerrain(layer *Layer, x, y int, terrain *Terrain) {
+	if x < 0 || y < 0 || x >= layer.Width || y >= layer.Height {
+		return
+	}
+	var tile GID
+	if terrain != nil {
+		tile = terrain.Default
+	}
+	if layer.owner == m {
+		layer.SetTile(x, y, tile)
+		return
+	}
+	layer.Tiles[y*layer.Width+x] = tile
+	m.AutotileAround(layer, x, y)
+}
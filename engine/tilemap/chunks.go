@@ -0,0 +1,199 @@
+package tilemap
+
+import (
+	"fmt"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ChunkCache draws tile layers as baked chunk textures.
+//
+// Each ChunkSize×ChunkSize block of a layer is rendered once into a texture
+// and then drawn as a single quad, so draw calls scale with the number of
+// visible chunks instead of visible tiles. Chunks are baked lazily when they
+// first come into view and re-baked after Layer.SetTile or FillRect marks
+// them dirty. Writes straight to Layer.Tiles need Invalidate.
+//
+// Tiles that overhang their cell (oversized tiles) are clipped at chunk
+// edges; use Map.Render for layers that rely on overhang.
+type ChunkCache struct {
+	Map *Map
+
+	chunks map[*Layer]map[Chunk]*graphics.Texture // nil texture = empty chunk
+	baked  int                                    // Chunks baked since creation
+}
+
+// NewChunkCache creates an empty cache for a map.
+//
+// Example:
+//
+//	chunks := tilemap.NewChunkCache(level)
+//	defer chunks.Destroy()
+//	engine.SetRenderUICallback(func() { _ = chunks.Render(engine.Renderer(), scene.Camera()) })
+func NewChunkCache(m *Map) *ChunkCache {
+	return &ChunkCache{
+		Map:    m,
+		chunks: make(map[*Layer]map[Chunk]*graphics.Texture),
+	}
+}
+
+// Render draws all visible layers.
+func (c *ChunkCache) Render(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	for _, layer := range c.Map.Layers {
+		if err := c.RenderLayer(renderer, camera, layer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenderLayer draws one layer's visible chunks, baking any that are missing or dirty.
+func (c *ChunkCache) RenderLayer(renderer *graphics.Renderer, camera *graphics.Camera, layer *Layer) error {
+	m := c.Map
+	if !layer.Visible || layer.Opacity <= 0 || m.TileWidth <= 0 || m.TileHeight <= 0 {
+		return nil
+	}
+	chunks := c.chunks[layer]
+	if chunks == nil {
+		chunks = make(map[Chunk]*graphics.Texture)
+		c.chunks[layer] = chunks
+	}
+	for _, chunk := range layer.DirtyChunks() {
+		c.drop(chunks, chunk)
+	}
+	layer.ClearDirty()
+
+	size := m.chunkSize()
+	chunkW, chunkH := float64(size*m.TileWidth), float64(size*m.TileHeight)
+	origin := m.Position.Add(layer.Offset)
+	minX, minY, maxX, maxY := m.visibleCells(camera, origin, layer)
+	if minX > maxX || minY > maxY {
+		return nil
+	}
+
+	sprite := &graphics.Sprite{
+		Color:      gamemath.White,
+		Alpha:      layer.Opacity,
+		SourceRect: gamemath.Rectangle{Width: chunkW, Height: chunkH},
+	}
+	transform := gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}
+	for cy := minY / size; cy <= maxY/size; cy++ {
+		for cx := minX / size; cx <= maxX/size; cx++ {
+			chunk := Chunk{X: cx, Y: cy}
+			texture, ok := chunks[chunk]
+			if !ok {
+				var err error
+				if texture, err = c.bake(renderer, layer, chunk); err != nil {
+					return err
+				}
+				chunks[chunk] = texture
+			}
+			if texture == nil {
+				continue
+			}
+			sprite.Texture = texture
+			transform.Position = gamemath.Vector2{
+				X: origin.X + float64(cx)*chunkW + chunkW/2,
+				Y: origin.Y + float64(cy)*chunkH + chunkH/2,
+			}
+			if err := renderer.DrawSprite(sprite, transform, camera); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Invalidate drops baked chunks covering a cell rectangle of a layer.
+func (c *ChunkCache) Invalidate(layer *Layer, x, y, width, height int) {
+	layer.markDirty(x, y, width, height, c.Map.chunkSize())
+}
+
+// InvalidateAll drops every baked chunk (after changing tilesets or ChunkSize).
+func (c *ChunkCache) InvalidateAll() {
+	for layer, chunks := range c.chunks {
+		for chunk := range chunks {
+			c.drop(chunks, chunk)
+		}
+		delete(c.chunks, layer)
+	}
+}
+
+// Baked returns the number of chunk bakes performed (a re-bake counts again).
+func (c *ChunkCache) Baked() int {
+	return c.baked
+}
+
+// Destroy releases all chunk textures.
+func (c *ChunkCache) Destroy() {
+	c.InvalidateAll()
+}
+
+// drop releases one chunk's texture.
+func (c *ChunkCache) drop(chunks map[Chunk]*graphics.Texture, chunk Chunk) {
+	if texture := chunks[chunk]; texture != nil {
+		_ = texture.Destroy() // Best effort cleanup
+	}
+	delete(chunks, chunk)
+}
+
+// bake renders a chunk into a new texture (nil if the chunk has no tiles).
+func (c *ChunkCache) bake(renderer *graphics.Renderer, layer *Layer, chunk Chunk) (*graphics.Texture, error) {
+	m := c.Map
+	size := m.chunkSize()
+	minX, minY := chunk.X*size, chunk.Y*size
+	maxX, maxY := min(minX+size, layer.Width), min(minY+size, layer.Height)
+
+	empty := true
+	for y := minY; y < maxY && empty; y++ {
+		for x := minX; x < maxX; x++ {
+			if !layer.Tiles[y*layer.Width+x].Empty() {
+				empty = false
+				break
+			}
+		}
+	}
+	c.baked++
+	if empty {
+		return nil, nil
+	}
+
+	width, height := size*m.TileWidth, size*m.TileHeight
+	texture, err := renderer.NewRenderTarget(width, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bake tile chunk: %w", err)
+	}
+
+	// Draw into the chunk, then restore whatever target was active (such as
+	// the post-processing buffer)
+	sdlRenderer := renderer.GetSDLRenderer()
+	previous := sdlRenderer.GetRenderTarget()
+	if err := renderer.SetRenderTarget(texture); err != nil {
+		_ = texture.Destroy() // Best effort cleanup
+		return nil, err
+	}
+	defer func() { _ = sdlRenderer.SetRenderTarget(previous) }() // Best effort restore
+	if err := renderer.Clear(gamemath.Color{}); err != nil {
+		_ = texture.Destroy() // Best effort cleanup
+		return nil, err
+	}
+
+	// A camera whose view is exactly this chunk, in layer-local coordinates
+	camera := graphics.NewCamera()
+	camera.SetScreenSize(width, height)
+	camera.Position = gamemath.Vector2{
+		X: float64(minX*m.TileWidth + width/2),
+		Y: float64(minY*m.TileHeight + height/2),
+	}
+	sprite := &graphics.Sprite{Color: gamemath.White, Alpha: 1}
+	for y := minY; y < maxY; y++ {
+		for x := minX; x < maxX; x++ {
+			if err := m.drawTile(renderer, camera, sprite, gamemath.Vector2{}, layer.Tiles[y*layer.Width+x], x, y); err != nil {
+				_ = texture.Destroy() // Best effort cleanup
+				return nil, err
+			}
+		}
+	}
+	return texture, nil
+}
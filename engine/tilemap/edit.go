@@ -0,0 +1,184 @@
+package tilemap
+
+import (
+	"math"
+	"sort"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// DefaultChunkSize is the chunk edge in tiles used for dirty tracking.
+const DefaultChunkSize = 32
+
+// Chunk identifies a ChunkSize×ChunkSize block of cells.
+type Chunk struct {
+	X, Y int // Chunk column and row
+}
+
+// GetTile returns the tile in a cell (0 outside the layer).
+func (l *Layer) GetTile(x, y int) GID {
+	if !l.inBounds(x, y) {
+		return 0
+	}
+	return l.Tiles[y*l.Width+x]
+}
+
+// SetTile changes one cell.
+//
+// On a layer created by Map.AddLayer, the change also re-picks autotile
+// variants around the cell, updates collision (for Collision layers),
+// marks the affected chunks dirty, and calls Map.OnTileChanged.
+//
+// Returns:
+//
+//	bool: False if the cell is outside the layer
+//
+// Example:
+//
+//	// Terraria-style digging
+//	cx, cy := level.CellAt(worldX, worldY)
+//	if !ground.GetTile(cx, cy).Empty() {
+//	    ground.SetTile(cx, cy, 0)
+//	}
+func (l *Layer) SetTile(x, y int, tile GID) bool {
+	if !l.inBounds(x, y) {
+		return false
+	}
+	old := l.Tiles[y*l.Width+x]
+	l.Tiles[y*l.Width+x] = tile
+	if l.owner != nil {
+		l.owner.tilesChanged(l, x, y, 1, 1)
+		if l.owner.OnTileChanged != nil && old != l.Tiles[y*l.Width+x] {
+			l.owner.OnTileChanged(l, x, y, old, l.Tiles[y*l.Width+x])
+		}
+	}
+	return true
+}
+
+// FillRect sets every cell in a rectangle of cells, clipped to the layer,
+// with the same updates as SetTile (OnTileChanged is not called per cell).
+func (l *Layer) FillRect(x, y, width, height int, tile GID) {
+	minX, minY := max(x, 0), max(y, 0)
+	maxX, maxY := min(x+width, l.Width), min(y+height, l.Height)
+	if minX >= maxX || minY >= maxY {
+		return
+	}
+	for cy := minY; cy < maxY; cy++ {
+		for cx := minX; cx < maxX; cx++ {
+			l.Tiles[cy*l.Width+cx] = tile
+		}
+	}
+	if l.owner != nil {
+		l.owner.tilesChanged(l, minX, minY, maxX-minX, maxY-minY)
+	}
+}
+
+// DirtyChunks returns the chunks changed since the last ClearDirty, in row order.
+func (l *Layer) DirtyChunks() []Chunk {
+	chunks := make([]Chunk, 0, len(l.dirty))
+	for chunk := range l.dirty {
+		chunks = append(chunks, chunk)
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		if chunks[i].Y != chunks[j].Y {
+			return chunks[i].Y < chunks[j].Y
+		}
+		return chunks[i].X < chunks[j].X
+	})
+	return chunks
+}
+
+// ClearDirty forgets changed chunks (after re-rendering them).
+func (l *Layer) ClearDirty() {
+	clear(l.dirty)
+}
+
+// inBounds reports whether a cell lies inside the layer.
+func (l *Layer) inBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < l.Width && y < l.Height
+}
+
+// markDirty marks the chunks overlapping a cell rectangle (clipped to the layer).
+func (l *Layer) markDirty(x, y, width, height, chunkSize int) {
+	minX, minY := max(x, 0), max(y, 0)
+	maxX, maxY := min(x+width, l.Width)-1, min(y+height, l.Height)-1
+	if minX > maxX || minY > maxY {
+		return
+	}
+	if l.dirty == nil {
+		l.dirty = make(map[Chunk]struct{})
+	}
+	for cy := minY / chunkSize; cy <= maxY/chunkSize; cy++ {
+		for cx := minX / chunkSize; cx <= maxX/chunkSize; cx++ {
+			l.dirty[Chunk{X: cx, Y: cy}] = struct{}{}
+		}
+	}
+}
+
+// chunkSize returns the dirty-tracking chunk size.
+func (m *Map) chunkSize() int {
+	if m.ChunkSize > 0 {
+		return m.ChunkSize
+	}
+	return DefaultChunkSize
+}
+
+// tilesChanged re-autotiles and marks dirty a changed cell rectangle.
+func (m *Map) tilesChanged(layer *Layer, x, y, width, height int) {
+	if len(m.Terrains) > 0 {
+		for cy := y - 1; cy <= y+height; cy++ {
+			for cx := x - 1; cx <= x+width; cx++ {
+				if terrain := m.TerrainAt(layer, cx, cy); terrain != nil {
+					layer.Tiles[cy*layer.Width+cx] = terrain.TileFor(terrain.Mask(layer, cx, cy))
+				}
+			}
+		}
+	}
+	// Neighbors may have switched variants, so their chunks are dirty too
+	layer.markDirty(x-1, y-1, width+2, height+2, m.chunkSize())
+}
+
+// Solid reports whether a cell holds a tile on any Collision layer
+// (hidden layers included).
+func (m *Map) Solid(x, y int) bool {
+	for _, layer := range m.Layers {
+		if layer.Collision && !layer.GetTile(x, y).Empty() {
+			return true
+		}
+	}
+	return false
+}
+
+// SolidAt reports whether a world position is inside a solid cell.
+func (m *Map) SolidAt(x, y float64) bool {
+	cx, cy := m.CellAt(x, y)
+	return m.Solid(cx, cy)
+}
+
+// Overlaps reports whether a world rectangle touches any solid cell.
+//
+// Example:
+//
+//	bounds := player.Collider.GetWorldBounds(player.Transform)
+//	if level.Overlaps(bounds) {
+//	    player.Transform.Position = previous // Blocked by terrain
+//	}
+func (m *Map) Overlaps(rect gamemath.Rectangle) bool {
+	if m.TileWidth <= 0 || m.TileHeight <= 0 || rect.Width <= 0 || rect.Height <= 0 {
+		return false
+	}
+	minX, minY := m.CellAt(rect.X, rect.Y)
+	minX, minY = max(minX, 0), max(minY, 0)
+	// A rectangle ending exactly on a cell edge does not touch the next cell
+	maxX := int(math.Ceil((rect.X+rect.Width-m.Position.X)/float64(m.TileWidth))) - 1
+	maxY := int(math.Ceil((rect.Y+rect.Height-m.Position.Y)/float64(m.TileHeight))) - 1
+	maxX, maxY = min(maxX, m.Width-1), min(maxY, m.Height-1)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			if m.Solid(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package tilemap
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// ObjectLayer is a Tiled object group: spawn points, triggers, and
+// placed sprites.
+type ObjectLayer struct {
+	Name       string
+	Visible    bool
+	Offset     gamemath.Vector2 // Already applied to object positions
+	Properties map[string]string
+	Objects    []*Object
+}
+
+// Object is a Tiled object in map pixels.
+//
+// Rectangles, points, and ellipses have X, Y at their top-left corner; tile
+// objects (GID set) have X, Y at their bottom-left corner, as in Tiled.
+type Object struct {
+	ID         int
+	Name       string
+	Class      string // Tiled "class" (or "type" in older files)
+	X, Y       float64
+	Width      float64
+	Height     float64
+	Rotation   float64 // Degrees clockwise
+	GID        GID     // Tile for tile objects (0 = shape)
+	Visible    bool
+	Point      bool
+	Ellipse    bool
+	Properties map[string]string
+}
+
+// Center returns the object's center in map pixels.
+func (o *Object) Center() gamemath.Vector2 {
+	if o.GID != 0 {
+		return gamemath.Vector2{X: o.X + o.Width/2, Y: o.Y - o.Height/2}
+	}
+	return gamemath.Vector2{X: o.X + o.Width/2, Y: o.Y + o.Height/2}
+}
+
+// ObjectLayer returns an object layer by name, or nil.
+func (m *Map) ObjectLayer(name string) *ObjectLayer {
+	for _, layer := range m.ObjectLayers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// ObjectEntity builds an entity for an object: positioned at the object's
+// center, with a sprite for tile objects and a trigger collider for shapes
+// with a size.
+func (m *Map) ObjectEntity(object *Object) *core.Entity {
+	entity := &core.Entity{
+		Active: object.Visible,
+		Transform: gamemath.Transform{
+			Position: m.Position.Add(object.Center()),
+			Rotation: object.Rotation,
+			Scale:    gamemath.Vector2{X: 1, Y: 1},
+		},
+	}
+	if object.GID != 0 {
+		if tileset := m.TilesetFor(object.GID.ID()); tileset != nil {
+			sprite := &graphics.Sprite{
+				Texture:    tileset.Texture,
+				SourceRect: tileset.SourceRect(object.GID.ID()),
+				Color:      gamemath.White,
+				Alpha:      1,
+			}
+			var rotation float64
+			rotation, sprite.FlipH, sprite.FlipV = object.GID.Orientation()
+			entity.Transform.Rotation += rotation
+			if sprite.SourceRect.Width > 0 && sprite.SourceRect.Height > 0 {
+				entity.Transform.Scale = gamemath.Vector2{
+					X: object.Width / sprite.SourceRect.Width,
+					Y: object.Height / sprite.SourceRect.Height,
+				}
+			}
+			entity.Sprite = sprite
+		}
+	} else if object.Width > 0 && object.Height > 0 {
+		entity.Collider = physics.NewCollider(object.Width, object.Height)
+		entity.Collider.IsTrigger = true
+	}
+	return entity
+}
+
+// SpawnObjects adds an entity for every object in every object layer.
+//
+// Parameters:
+//
+//	scene: Scene to add entities to
+//	configure: Optional hook to customize each entity (attach behaviors,
+//	           make colliders solid); return false to skip the object
+//
+// Returns:
+//
+//	map[int]*core.Entity: Spawned entities keyed by Tiled object ID
+//
+// Example:
+//
+//	entities := level.SpawnObjects(scene, func(object *tilemap.Object, entity *core.Entity) bool {
+//	    switch object.Class {
+//	    case "enemy":
+//	        entity.Behavior = &Patrol{Speed: 40}
+//	    case "spawn":
+//	        player.Transform.Position = entity.Transform.Position
+//	        return false
+//	    }
+//	    return true
+//	})
+func (m *Map) SpawnObjects(scene *core.Scene, configure func(object *Object, entity *core.Entity) bool) map[int]*core.Entity {
+	spawned := make(map[int]*core.Entity)
+	for _, layer := range m.ObjectLayers {
+		for _, object := range layer.Objects {
+			entity := m.ObjectEntity(object)
+			if configure != nil && !configure(object, entity) {
+				continue
+			}
+			scene.AddEntity(entity)
+			spawned[object.ID] = entity
+		}
+	}
+	return spawned
+}
+
+// BuildColliders adds static collider entities covering the solid cells of
+// every Collision layer, merging cells into as few rectangles as possible.
+//
+// Runs of solid cells in a row are merged horizontally, and identical runs
+// in consecutive rows are merged vertically, so a solid floor or wall
+// becomes a single collider.
+//
+// Returns:
+//
+//	[]*core.Entity: Collider entities added to the scene
+func (m *Map) BuildColliders(scene *core.Scene) []*core.Entity {
+	entities := make([]*core.Entity, 0)
+	for _, rect := range m.SolidRects() {
+		entity := &core.Entity{
+			Active: true,
+			Transform: gamemath.Transform{
+				Position: gamemath.Vector2{X: rect.X + rect.Width/2, Y: rect.Y + rect.Height/2},
+				Scale:    gamemath.Vector2{X: 1, Y: 1},
+			},
+			Collider: physics.NewCollider(rect.Width, rect.Height),
+		}
+		scene.AddEntity(entity)
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+// SolidRects returns world rectangles covering all solid cells (see BuildColliders).
+func (m *Map) SolidRects() []gamemath.Rectangle {
+	type run struct{ x0, x1 int }
+	rects := make([]gamemath.Rectangle, 0)
+	open := make(map[run]int) // Run in the previous row -> index in rects
+	for y := 0; y < m.Height; y++ {
+		next := make(map[run]int)
+		for x := 0; x < m.Width; {
+			if !m.Solid(x, y) {
+				x++
+				continue
+			}
+			start := x
+			for x < m.Width && m.Solid(x, y) {
+				x++
+			}
+			r := run{start, x}
+			if index, ok := open[r]; ok {
+				rects[index].Height += float64(m.TileHeight)
+				next[r] = index
+				continue
+			}
+			rects = append(rects, gamemath.Rectangle{
+				X:      m.Position.X + float64(start*m.TileWidth),
+				Y:      m.Position.Y + float64(y*m.TileHeight),
+				Width:  float64((x - start) * m.TileWidth),
+				Height: float64(m.TileHeight),
+			})
+			next[r] = len(rects) - 1
+		}
+		open = next
+	}
+	return rects
+}
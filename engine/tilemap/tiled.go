@@ -0,0 +1,551 @@
+package tilemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ErrUnsupportedMap is returned for Tiled features the loader does not handle
+// (non-orthogonal or infinite maps, zstd compression).
+var ErrUnsupportedMap = errors.New("unsupported Tiled map")
+
+// Load reads a Tiled map, choosing the format by extension (.tmx = XML,
+// .tmj/.json = JSON).
+//
+// Parameters:
+//
+//	path: Map file
+//	assets: Loads tileset images (nil = placeholder textures that keep the
+//	        image path, for tools and tests without a renderer)
+//
+// Returns:
+//
+//	*Map: Map with tilesets, tile layers (in file order, groups flattened),
+//	      and object layers
+//	error: Non-nil if the file, a tileset, or an image cannot be loaded
+//
+// Behavior:
+//   - External tilesets (.tsx, .tsj, .json) are resolved relative to the map
+//   - Tile layer data may be CSV, XML tiles, or base64 (raw, zlib, gzip)
+//   - Layers named "collision" or with a true "collision" property get
+//     Layer.Collision set
+//
+// Example:
+//
+//	level, err := tilemap.Load("levels/forest.tmx", engine.Assets())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	chunks := tilemap.NewChunkCache(level)
+//	level.BuildColliders(scene)
+//	level.SpawnObjects(scene, nil)
+func Load(path string, assets *graphics.AssetManager) (*Map, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open map: %w", err)
+	}
+	defer file.Close()
+
+	dir := filepath.Dir(path)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".tmx":
+		return DecodeTMX(file, dir, assets)
+	default:
+		return DecodeTiledJSON(file, dir, assets)
+	}
+}
+
+// tiledProperty is a custom property in either format.
+type tiledProperty struct {
+	Name  string `xml:"name,attr" json:"name"`
+	Value string `xml:"value,attr" json:"-"`
+	Text  string `xml:",chardata" json:"-"` // Multi-line string values
+	Raw   any    `xml:"-" json:"value"`
+}
+
+// tiledProperties converts a property list to a string map.
+func tiledProperties(list []tiledProperty) map[string]string {
+	properties := make(map[string]string, len(list))
+	for _, p := range list {
+		switch {
+		case p.Raw != nil:
+			properties[p.Name] = fmt.Sprint(p.Raw)
+		case p.Value == "" && strings.TrimSpace(p.Text) != "":
+			properties[p.Name] = p.Text
+		default:
+			properties[p.Name] = p.Value
+		}
+	}
+	return properties
+}
+
+// ---- TMX (XML) ----
+
+type tmxMap struct {
+	Orientation string          `xml:"orientation,attr"`
+	Width       int             `xml:"width,attr"`
+	Height      int             `xml:"height,attr"`
+	TileWidth   int             `xml:"tilewidth,attr"`
+	TileHeight  int             `xml:"tileheight,attr"`
+	Infinite    int             `xml:"infinite,attr"`
+	Properties  []tiledProperty `xml:"properties>property"`
+	Tilesets    []tmxTileset    `xml:"tileset"`
+	Items       []tmxItem       `xml:",any"` // Layers, object groups, and groups in file order
+}
+
+type tmxTileset struct {
+	FirstGID   uint32 `xml:"firstgid,attr"`
+	Source     string `xml:"source,attr"`
+	Name       string `xml:"name,attr"`
+	TileWidth  int    `xml:"tilewidth,attr"`
+	TileHeight int    `xml:"tileheight,attr"`
+	Spacing    int    `xml:"spacing,attr"`
+	Margin     int    `xml:"margin,attr"`
+	TileCount  int    `xml:"tilecount,attr"`
+	Columns    int    `xml:"columns,attr"`
+	Image      struct {
+		Source string `xml:"source,attr"`
+		Width  int    `xml:"width,attr"`
+		Height int    `xml:"height,attr"`
+	} `xml:"image"`
+}
+
+type tmxItem struct {
+	XMLName    xml.Name
+	Name       string          `xml:"name,attr"`
+	Visible    *int            `xml:"visible,attr"`
+	Opacity    *float64        `xml:"opacity,attr"`
+	OffsetX    float64         `xml:"offsetx,attr"`
+	OffsetY    float64         `xml:"offsety,attr"`
+	Width      int             `xml:"width,attr"`
+	Height     int             `xml:"height,attr"`
+	Properties []tiledProperty `xml:"properties>property"`
+	Data       struct {
+		Encoding    string `xml:"encoding,attr"`
+		Compression string `xml:"compression,attr"`
+		Text        string `xml:",chardata"`
+		Tiles       []struct {
+			GID uint32 `xml:"gid,attr"`
+		} `xml:"tile"`
+	} `xml:"data"`
+	Objects []tmxObject `xml:"object"`
+	Items   []tmxItem   `xml:",any"` // Children of a group
+}
+
+type tmxObject struct {
+	ID         int             `xml:"id,attr"`
+	Name       string          `xml:"name,attr"`
+	Type       string          `xml:"type,attr"`
+	Class      string          `xml:"class,attr"`
+	X          float64         `xml:"x,attr"`
+	Y          float64         `xml:"y,attr"`
+	Width      float64         `xml:"width,attr"`
+	Height     float64         `xml:"height,attr"`
+	Rotation   float64         `xml:"rotation,attr"`
+	GID        uint32          `xml:"gid,attr"`
+	Visible    *int            `xml:"visible,attr"`
+	Properties []tiledProperty `xml:"properties>property"`
+	Point      *struct{}       `xml:"point"`
+	Ellipse    *struct{}       `xml:"ellipse"`
+}
+
+// DecodeTMX reads a TMX map; dir resolves relative tileset and image paths.
+func DecodeTMX(r io.Reader, dir string, assets *graphics.AssetManager) (*Map, error) {
+	var doc tmxMap
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode TMX: %w", err)
+	}
+	m, err := newTiledMap(doc.Orientation, doc.Infinite != 0, doc.Width, doc.Height, doc.TileWidth, doc.TileHeight)
+	if err != nil {
+		return nil, err
+	}
+	m.Properties = tiledProperties(doc.Properties)
+
+	for _, ts := range doc.Tilesets {
+		tileset, err := loadTMXTileset(ts, dir, assets)
+		if err != nil {
+			return nil, err
+		}
+		m.AddTileset(tileset)
+	}
+	if err := m.addTMXItems(doc.Items, gamemath.Vector2{}, true, 1); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// addTMXItems adds layers in order, flattening groups into their children.
+func (m *Map) addTMXItems(items []tmxItem, offset gamemath.Vector2, visible bool, opacity float64) error {
+	for _, item := range items {
+		itemVisible := visible && (item.Visible == nil || *item.Visible != 0)
+		itemOpacity := opacity
+		if item.Opacity != nil {
+			itemOpacity *= *item.Opacity
+		}
+		itemOffset := offset.Add(gamemath.Vector2{X: item.OffsetX, Y: item.OffsetY})
+
+		switch item.XMLName.Local {
+		case "layer":
+			tiles, err := decodeTMXData(item)
+			if err != nil {
+				return fmt.Errorf("layer %q: %w", item.Name, err)
+			}
+			m.addTiledLayer(item.Name, tiles, itemVisible, itemOpacity, itemOffset, tiledProperties(item.Properties))
+		case "objectgroup":
+			layer := &ObjectLayer{Name: item.Name, Visible: itemVisible, Offset: itemOffset, Properties: tiledProperties(item.Properties)}
+			for _, o := range item.Objects {
+				class := o.Class
+				if class == "" {
+					class = o.Type
+				}
+				layer.Objects = append(layer.Objects, &Object{
+					ID: o.ID, Name: o.Name, Class: class,
+					X: o.X + itemOffset.X, Y: o.Y + itemOffset.Y, Width: o.Width, Height: o.Height,
+					Rotation: o.Rotation, GID: GID(o.GID), Visible: o.Visible == nil || *o.Visible != 0,
+					Point: o.Point != nil, Ellipse: o.Ellipse != nil,
+					Properties: tiledProperties(o.Properties),
+				})
+			}
+			m.ObjectLayers = append(m.ObjectLayers, layer)
+		case "group":
+			if err := m.addTMXItems(item.Items, itemOffset, itemVisible, itemOpacity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeTMXData decodes a layer's <data> element.
+func decodeTMXData(item tmxItem) ([]GID, error) {
+	data := item.Data
+	switch data.Encoding {
+	case "csv":
+		return parseCSVTiles(data.Text)
+	case "base64":
+		return decodeBase64Tiles(data.Text, data.Compression)
+	case "":
+		tiles := make([]GID, len(data.Tiles))
+		for i, tile := range data.Tiles {
+			tiles[i] = GID(tile.GID)
+		}
+		return tiles, nil
+	default:
+		return nil, fmt.Errorf("%w: encoding %q", ErrUnsupportedMap, data.Encoding)
+	}
+}
+
+// loadTMXTileset loads an inline or external (.tsx/.tsj) tileset.
+func loadTMXTileset(ts tmxTileset, dir string, assets *graphics.AssetManager) (*Tileset, error) {
+	if ts.Source == "" {
+		return newTiledTileset(ts, dir, assets)
+	}
+	path := filepath.Join(dir, ts.Source)
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".tsj" || ext == ".json" {
+		return loadJSONTilesetFile(path, ts.FirstGID, assets)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tileset: %w", err)
+	}
+	defer file.Close()
+	var external tmxTileset
+	if err := xml.NewDecoder(file).Decode(&external); err != nil {
+		return nil, fmt.Errorf("failed to decode tileset %s: %w", ts.Source, err)
+	}
+	external.FirstGID = ts.FirstGID
+	return newTiledTileset(external, filepath.Dir(path), assets)
+}
+
+// ---- JSON ----
+
+type jsonMap struct {
+	Orientation string          `json:"orientation"`
+	Width       int             `json:"width"`
+	Height      int             `json:"height"`
+	TileWidth   int             `json:"tilewidth"`
+	TileHeight  int             `json:"tileheight"`
+	Infinite    bool            `json:"infinite"`
+	Properties  []tiledProperty `json:"properties"`
+	Tilesets    []jsonTileset   `json:"tilesets"`
+	Layers      []jsonLayer     `json:"layers"`
+}
+
+type jsonTileset struct {
+	FirstGID    uint32 `json:"firstgid"`
+	Source      string `json:"source"`
+	Name        string `json:"name"`
+	TileWidth   int    `json:"tilewidth"`
+	TileHeight  int    `json:"tileheight"`
+	Spacing     int    `json:"spacing"`
+	Margin      int    `json:"margin"`
+	TileCount   int    `json:"tilecount"`
+	Columns     int    `json:"columns"`
+	Image       string `json:"image"`
+	ImageWidth  int    `json:"imagewidth"`
+	ImageHeight int    `json:"imageheight"`
+}
+
+type jsonLayer struct {
+	Type        string          `json:"type"`
+	Name        string          `json:"name"`
+	Visible     *bool           `json:"visible"`
+	Opacity     *float64        `json:"opacity"`
+	OffsetX     float64         `json:"offsetx"`
+	OffsetY     float64         `json:"offsety"`
+	Encoding    string          `json:"encoding"`
+	Compression string          `json:"compression"`
+	Data        json.RawMessage `json:"data"` // Array of GIDs or base64 string
+	Properties  []tiledProperty `json:"properties"`
+	Objects     []struct {
+		ID         int             `json:"id"`
+		Name       string          `json:"name"`
+		Type       string          `json:"type"`
+		Class      string          `json:"class"`
+		X          float64         `json:"x"`
+		Y          float64         `json:"y"`
+		Width      float64         `json:"width"`
+		Height     float64         `json:"height"`
+		Rotation   float64         `json:"rotation"`
+		GID        uint32          `json:"gid"`
+		Visible    *bool           `json:"visible"`
+		Point      bool            `json:"point"`
+		Ellipse    bool            `json:"ellipse"`
+		Properties []tiledProperty `json:"properties"`
+	} `json:"objects"`
+	Layers []jsonLayer `json:"layers"` // Children of a group
+}
+
+// DecodeTiledJSON reads a Tiled JSON map; dir resolves relative tileset and image paths.
+func DecodeTiledJSON(r io.Reader, dir string, assets *graphics.AssetManager) (*Map, error) {
+	var doc jsonMap
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode Tiled JSON: %w", err)
+	}
+	m, err := newTiledMap(doc.Orientation, doc.Infinite, doc.Width, doc.Height, doc.TileWidth, doc.TileHeight)
+	if err != nil {
+		return nil, err
+	}
+	m.Properties = tiledProperties(doc.Properties)
+
+	for _, ts := range doc.Tilesets {
+		var tileset *Tileset
+		switch ext := strings.ToLower(filepath.Ext(ts.Source)); {
+		case ts.Source == "":
+			tileset, err = newTiledTileset(jsonToTMXTileset(ts), dir, assets)
+		case ext == ".tsx":
+			tileset, err = loadTMXTileset(tmxTileset{FirstGID: ts.FirstGID, Source: ts.Source}, dir, assets)
+		default:
+			tileset, err = loadJSONTilesetFile(filepath.Join(dir, ts.Source), ts.FirstGID, assets)
+		}
+		if err != nil {
+			return nil, err
+		}
+		m.AddTileset(tileset)
+	}
+	if err := m.addJSONLayers(doc.Layers, gamemath.Vector2{}, true, 1); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// addJSONLayers adds layers in order, flattening groups into their children.
+func (m *Map) addJSONLayers(layers []jsonLayer, offset gamemath.Vector2, visible bool, opacity float64) error {
+	for _, l := range layers {
+		layerVisible := visible && (l.Visible == nil || *l.Visible)
+		layerOpacity := opacity
+		if l.Opacity != nil {
+			layerOpacity *= *l.Opacity
+		}
+		layerOffset := offset.Add(gamemath.Vector2{X: l.OffsetX, Y: l.OffsetY})
+
+		switch l.Type {
+		case "tilelayer":
+			tiles, err := decodeJSONData(l)
+			if err != nil {
+				return fmt.Errorf("layer %q: %w", l.Name, err)
+			}
+			m.addTiledLayer(l.Name, tiles, layerVisible, layerOpacity, layerOffset, tiledProperties(l.Properties))
+		case "objectgroup":
+			layer := &ObjectLayer{Name: l.Name, Visible: layerVisible, Offset: layerOffset, Properties: tiledProperties(l.Properties)}
+			for _, o := range l.Objects {
+				class := o.Class
+				if class == "" {
+					class = o.Type
+				}
+				layer.Objects = append(layer.Objects, &Object{
+					ID: o.ID, Name: o.Name, Class: class,
+					X: o.X + layerOffset.X, Y: o.Y + layerOffset.Y, Width: o.Width, Height: o.Height,
+					Rotation: o.Rotation, GID: GID(o.GID), Visible: o.Visible == nil || *o.Visible,
+					Point: o.Point, Ellipse: o.Ellipse,
+					Properties: tiledProperties(o.Properties),
+				})
+			}
+			m.ObjectLayers = append(m.ObjectLayers, layer)
+		case "group":
+			if err := m.addJSONLayers(l.Layers, layerOffset, layerVisible, layerOpacity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeJSONData decodes a tile layer's data array or base64 string.
+func decodeJSONData(l jsonLayer) ([]GID, error) {
+	if l.Encoding == "base64" {
+		var text string
+		if err := json.Unmarshal(l.Data, &text); err != nil {
+			return nil, fmt.Errorf("invalid base64 layer data: %w", err)
+		}
+		return decodeBase64Tiles(text, l.Compression)
+	}
+	var ids []uint32
+	if err := json.Unmarshal(l.Data, &ids); err != nil {
+		return nil, fmt.Errorf("invalid layer data: %w", err)
+	}
+	tiles := make([]GID, len(ids))
+	for i, id := range ids {
+		tiles[i] = GID(id)
+	}
+	return tiles, nil
+}
+
+// loadJSONTilesetFile loads an external JSON tileset.
+func loadJSONTilesetFile(path string, firstGID uint32, assets *graphics.AssetManager) (*Tileset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tileset: %w", err)
+	}
+	var ts jsonTileset
+	if err := json.Unmarshal(data, &ts); err != nil {
+		return nil, fmt.Errorf("failed to decode tileset %s: %w", path, err)
+	}
+	ts.FirstGID = firstGID
+	return newTiledTileset(jsonToTMXTileset(ts), filepath.Dir(path), assets)
+}
+
+func jsonToTMXTileset(ts jsonTileset) tmxTileset {
+	out := tmxTileset{
+		FirstGID: ts.FirstGID, Name: ts.Name,
+		TileWidth: ts.TileWidth, TileHeight: ts.TileHeight,
+		Spacing: ts.Spacing, Margin: ts.Margin, TileCount: ts.TileCount, Columns: ts.Columns,
+	}
+	out.Image.Source, out.Image.Width, out.Image.Height = ts.Image, ts.ImageWidth, ts.ImageHeight
+	return out
+}
+
+// ---- Shared ----
+
+// newTiledMap validates map settings and creates an empty map.
+func newTiledMap(orientation string, infinite bool, width, height, tileWidth, tileHeight int) (*Map, error) {
+	if orientation != "" && orientation != "orthogonal" {
+		return nil, fmt.Errorf("%w: %s orientation", ErrUnsupportedMap, orientation)
+	}
+	if infinite {
+		return nil, fmt.Errorf("%w: infinite maps", ErrUnsupportedMap)
+	}
+	if width <= 0 || height <= 0 || tileWidth <= 0 || tileHeight <= 0 {
+		return nil, fmt.Errorf("invalid map size %dx%d with %dx%d tiles", width, height, tileWidth, tileHeight)
+	}
+	return NewMap(width, height, tileWidth, tileHeight), nil
+}
+
+// addTiledLayer appends a decoded tile layer.
+func (m *Map) addTiledLayer(name string, tiles []GID, visible bool, opacity float64, offset gamemath.Vector2, properties map[string]string) {
+	layer := m.AddLayer(name)
+	copy(layer.Tiles, tiles)
+	layer.Visible = visible
+	layer.Opacity = opacity
+	layer.Offset = offset
+	layer.Properties = properties
+	layer.Collision = strings.EqualFold(name, "collision") || properties["collision"] == "true"
+}
+
+// newTiledTileset creates a tileset and loads its image.
+func newTiledTileset(ts tmxTileset, dir string, assets *graphics.AssetManager) (*Tileset, error) {
+	if ts.Image.Source == "" {
+		return nil, fmt.Errorf("%w: tileset %q has no single image (image collections are not supported)", ErrUnsupportedMap, ts.Name)
+	}
+	path := filepath.Join(dir, ts.Image.Source)
+	var texture *graphics.Texture
+	if assets != nil {
+		var err error
+		if texture, err = assets.LoadTexture(path); err != nil {
+			return nil, fmt.Errorf("failed to load tileset %q: %w", ts.Name, err)
+		}
+	} else {
+		texture = graphics.NewTexture(nil, ts.Image.Width, ts.Image.Height, path)
+	}
+	tileset := NewTileset(ts.Name, ts.FirstGID, texture, ts.TileWidth, ts.TileHeight)
+	tileset.Columns = ts.Columns
+	tileset.TileCount = ts.TileCount
+	tileset.Margin = ts.Margin
+	tileset.Spacing = ts.Spacing
+	return tileset, nil
+}
+
+// parseCSVTiles parses comma-separated GIDs.
+func parseCSVTiles(text string) ([]GID, error) {
+	fields := strings.Split(strings.TrimSpace(text), ",")
+	tiles := make([]GID, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tile %q: %w", field, err)
+		}
+		tiles = append(tiles, GID(id))
+	}
+	return tiles, nil
+}
+
+// decodeBase64Tiles decodes little-endian uint32 GIDs, optionally compressed.
+func decodeBase64Tiles(text, compression string) ([]GID, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 tile data: %w", err)
+	}
+	var reader io.Reader
+	switch compression {
+	case "":
+		reader = bytes.NewReader(raw)
+	case "zlib":
+		if reader, err = zlib.NewReader(bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("invalid zlib tile data: %w", err)
+		}
+	case "gzip":
+		if reader, err = gzip.NewReader(bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("invalid gzip tile data: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s compression", ErrUnsupportedMap, compression)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress tile data: %w", err)
+	}
+	tiles := make([]GID, len(data)/4)
+	for i := range tiles {
+		tiles[i] = GID(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return tiles, nil
+}
@@ -0,0 +1,320 @@
+// Package tilemap provides grid tile layers drawn from tileset textures:
+// Tiled (.tmx/.json) loading with flip flags and object layers, autotiling,
+// runtime editing, chunk-baked rendering, and static colliders.
+package tilemap
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// GID is a global tile ID as stored by Tiled: the tile index across all
+// tilesets in the low bits and flip flags in the high bits. 0 = empty.
+type GID uint32
+
+// Tiled flip flags stored in the high bits of a GID.
+const (
+	FlipHorizontal GID = 0x80000000
+	FlipVertical   GID = 0x40000000
+	FlipDiagonal   GID = 0x20000000 // Swap x and y (transpose), applied before H and V
+	RotateHex120   GID = 0x10000000 // Hexagonal maps only; ignored by the renderer
+
+	flagMask = FlipHorizontal | FlipVertical | FlipDiagonal | RotateHex120
+)
+
+// ID returns the tile ID without flip flags.
+func (g GID) ID() uint32 {
+	return uint32(g &^ flagMask)
+}
+
+// Flags returns only the flip flags.
+func (g GID) Flags() GID {
+	return g & flagMask
+}
+
+// Empty reports whether the cell has no tile.
+func (g GID) Empty() bool {
+	return g.ID() == 0
+}
+
+// Orientation converts flip flags into a rotation plus horizontal/vertical
+// flips as applied by SDL (flip in texture space, then rotate clockwise).
+//
+// Tiled applies the diagonal flip first, then horizontal, then vertical; a
+// diagonal flip is a transpose, which SDL expresses as a 90° rotation with a
+// mirror. The combinations Tiled's rotate buttons produce are:
+//
+//	D+H = rotate 90° clockwise
+//	D+V = rotate 90° counterclockwise
+//	H+V = rotate 180° (as two flips)
+//
+// Returns:
+//
+//	rotation: Degrees clockwise (0, 90, or -90)
+//	flipH, flipV: Mirror the tile before rotating
+func (g GID) Orientation() (rotation float64, flipH, flipV bool) {
+	h, v := g&FlipHorizontal != 0, g&FlipVertical != 0
+	if g&FlipDiagonal == 0 {
+		return 0, h, v
+	}
+	switch {
+	case h && v:
+		return 90, true, false
+	case h:
+		return 90, false, false
+	case v:
+		return -90, false, false
+	default:
+		return 90, false, true
+	}
+}
+
+// Tileset is a texture cut into equally sized tiles.
+type Tileset struct {
+	Name       string
+	FirstGID   uint32            // GID of the first tile (Tiled assigns these per map)
+	Texture    *graphics.Texture // Tileset image
+	TileWidth  int
+	TileHeight int
+	Columns    int // Tiles per row (0 = derive from texture width)
+	TileCount  int // Tiles in the set (0 = derive from texture size)
+	Margin     int // Pixels around the image edge
+	Spacing    int // Pixels between tiles
+}
+
+// NewTileset creates a tileset covering a whole texture.
+//
+// Example:
+//
+//	texture, _ := engine.Assets().LoadTexture("tiles/dungeon.png")
+//	tiles := tilemap.NewTileset("dungeon", 1, texture, 16, 16)
+func NewTileset(name string, firstGID uint32, texture *graphics.Texture, tileWidth, tileHeight int) *Tileset {
+	return &Tileset{
+		Name:       name,
+		FirstGID:   firstGID,
+		Texture:    texture,
+		TileWidth:  tileWidth,
+		TileHeight: tileHeight,
+	}
+}
+
+// columns returns the tiles per row.
+func (t *Tileset) columns() int {
+	if t.Columns > 0 {
+		return t.Columns
+	}
+	if t.Texture == nil || t.TileWidth <= 0 {
+		return 0
+	}
+	return (t.Texture.Width - 2*t.Margin + t.Spacing) / (t.TileWidth + t.Spacing)
+}
+
+// Count returns the number of tiles in the set.
+func (t *Tileset) Count() int {
+	if t.TileCount > 0 {
+		return t.TileCount
+	}
+	columns := t.columns()
+	if columns == 0 || t.Texture == nil || t.TileHeight <= 0 {
+		return 0
+	}
+	rows := (t.Texture.Height - 2*t.Margin + t.Spacing) / (t.TileHeight + t.Spacing)
+	return columns * rows
+}
+
+// Contains reports whether a tile ID (flags stripped) belongs to this set.
+func (t *Tileset) Contains(id uint32) bool {
+	return id >= t.FirstGID && id < t.FirstGID+uint32(t.Count())
+}
+
+// SourceRect returns the texture region of a tile ID (flags stripped).
+func (t *Tileset) SourceRect(id uint32) gamemath.Rectangle {
+	columns := t.columns()
+	if columns == 0 {
+		return gamemath.Rectangle{}
+	}
+	local := int(id - t.FirstGID)
+	return gamemath.Rectangle{
+		X:      float64(t.Margin + (local%columns)*(t.TileWidth+t.Spacing)),
+		Y:      float64(t.Margin + (local/columns)*(t.TileHeight+t.Spacing)),
+		Width:  float64(t.TileWidth),
+		Height: float64(t.TileHeight),
+	}
+}
+
+// Layer is a grid of tiles.
+type Layer struct {
+	Name       string
+	Width      int   // Columns
+	Height     int   // Rows
+	Tiles      []GID // Row-major, len = Width*Height
+	Visible    bool
+	Opacity    float64           // 0-1
+	Offset     gamemath.Vector2  // Pixel offset of the layer
+	Collision  bool              // Non-empty tiles are solid (see Map.Solid)
+	Properties map[string]string // Custom properties from Tiled
+
+	owner *Map // Map that autotiles and tracks edits (set by AddLayer)
+	dirty map[Chunk]struct{}
+}
+
+// Map is a set of tile layers sharing a grid and tilesets.
+type Map struct {
+	Width        int              // Columns
+	Height       int              // Rows
+	TileWidth    int              // Grid cell width in pixels
+	TileHeight   int              // Grid cell height in pixels
+	Position     gamemath.Vector2 // World position of the top-left corner
+	Tilesets     []*Tileset
+	Layers       []*Layer
+	Terrains     []*Terrain        // Autotile terrains, matched in order
+	ObjectLayers []*ObjectLayer    // Object groups from Tiled, in file order
+	Properties   map[string]string // Custom map properties from Tiled
+	ChunkSize    int               // Chunk edge in tiles for dirty tracking (0 = DefaultChunkSize)
+
+	// OnTileChanged is called after Layer.SetTile changes a cell
+	OnTileChanged func(layer *Layer, x, y int, old, tile GID)
+}
+
+// NewMap creates an empty map.
+//
+// Example:
+//
+//	level := tilemap.NewMap(100, 40, 16, 16)
+//	level.AddTileset(tiles)
+//	ground := level.AddLayer("ground")
+//	ground.Tiles[5*level.Width+3] = 7 | tilemap.FlipHorizontal
+//	engine.SetRenderUICallback(func() { _ = level.Render(engine.Renderer(), scene.Camera()) })
+func NewMap(width, height, tileWidth, tileHeight int) *Map {
+	return &Map{
+		Width:      width,
+		Height:     height,
+		TileWidth:  tileWidth,
+		TileHeight: tileHeight,
+		Tilesets:   make([]*Tileset, 0),
+		Layers:     make([]*Layer, 0),
+		Terrains:   make([]*Terrain, 0),
+	}
+}
+
+// AddTileset registers a tileset.
+func (m *Map) AddTileset(tileset *Tileset) {
+	m.Tilesets = append(m.Tilesets, tileset)
+}
+
+// AddLayer appends an empty, visible layer (drawn above earlier layers).
+func (m *Map) AddLayer(name string) *Layer {
+	layer := &Layer{
+		Name:    name,
+		Width:   m.Width,
+		Height:  m.Height,
+		Tiles:   make([]GID, m.Width*m.Height),
+		Visible: true,
+		Opacity: 1,
+		owner:   m,
+	}
+	m.Layers = append(m.Layers, layer)
+	return layer
+}
+
+// Layer returns a layer by name, or nil.
+func (m *Map) Layer(name string) *Layer {
+	for _, layer := range m.Layers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// TilesetFor returns the tileset containing a tile ID (flags stripped), or nil.
+func (m *Map) TilesetFor(id uint32) *Tileset {
+	var best *Tileset
+	for _, tileset := range m.Tilesets {
+		if id >= tileset.FirstGID && (best == nil || tileset.FirstGID > best.FirstGID) {
+			best = tileset
+		}
+	}
+	if best == nil || !best.Contains(id) {
+		return nil
+	}
+	return best
+}
+
+// CellAt returns the grid cell containing a world position (may be outside the map).
+func (m *Map) CellAt(x, y float64) (cx, cy int) {
+	if m.TileWidth <= 0 || m.TileHeight <= 0 {
+		return 0, 0
+	}
+	cx = int(math.Floor((x - m.Position.X) / float64(m.TileWidth)))
+	cy = int(math.Floor((y - m.Position.Y) / float64(m.TileHeight)))
+	return cx, cy
+}
+
+// Render draws all visible layers, culled to the camera view.
+//
+// Flipped and rotated tiles are drawn with SDL flips and 90° rotations
+// around the tile center, so rotated tiles should be square.
+func (m *Map) Render(renderer *graphics.Renderer, camera *graphics.Camera) error {
+	for _, layer := range m.Layers {
+		if err := m.RenderLayer(renderer, camera, layer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenderLayer draws one layer, culled to the camera view.
+func (m *Map) RenderLayer(renderer *graphics.Renderer, camera *graphics.Camera, layer *Layer) error {
+	if !layer.Visible || layer.Opacity <= 0 || m.TileWidth <= 0 || m.TileHeight <= 0 {
+		return nil
+	}
+	origin := m.Position.Add(layer.Offset)
+	minX, minY, maxX, maxY := m.visibleCells(camera, origin, layer)
+
+	sprite := &graphics.Sprite{Color: gamemath.White, Alpha: layer.Opacity}
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			if err := m.drawTile(renderer, camera, sprite, origin, layer.Tiles[y*layer.Width+x], x, y); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// drawTile draws one cell's tile with the layer origin at world origin.
+func (m *Map) drawTile(renderer *graphics.Renderer, camera *graphics.Camera, sprite *graphics.Sprite, origin gamemath.Vector2, gid GID, x, y int) error {
+	if gid.Empty() {
+		return nil
+	}
+	tileset := m.TilesetFor(gid.ID())
+	if tileset == nil {
+		return nil
+	}
+	transform := gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}
+	sprite.Texture = tileset.Texture
+	sprite.SourceRect = tileset.SourceRect(gid.ID())
+	transform.Rotation, sprite.FlipH, sprite.FlipV = gid.Orientation()
+	// Tiles larger than the grid are anchored bottom-left, as in Tiled
+	transform.Position = gamemath.Vector2{
+		X: origin.X + float64(x*m.TileWidth) + sprite.SourceRect.Width/2,
+		Y: origin.Y + float64((y+1)*m.TileHeight) - sprite.SourceRect.Height/2,
+	}
+	return renderer.DrawSprite(sprite, transform, camera)
+}
+
+// visibleCells returns the inclusive cell range overlapping the camera view.
+func (m *Map) visibleCells(camera *graphics.Camera, origin gamemath.Vector2, layer *Layer) (minX, minY, maxX, maxY int) {
+	screenW, screenH := camera.ScreenSize()
+	left, top := camera.ScreenToWorld(0, 0)
+	right, bottom := camera.ScreenToWorld(screenW, screenH)
+	// One cell of slack covers oversized tiles hanging into view
+	minX = max(0, int(math.Floor((left-origin.X)/float64(m.TileWidth)))-1)
+	minY = max(0, int(math.Floor((top-origin.Y)/float64(m.TileHeight))))
+	maxX = min(layer.Width-1, int(math.Floor((right-origin.X)/float64(m.TileWidth))))
+	maxY = min(layer.Height-1, int(math.Floor((bottom-origin.Y)/float64(m.TileHeight)))+1)
+	return minX, minY, maxX, maxY
+}
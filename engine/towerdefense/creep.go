@@ -0,0 +1,112 @@
+package towerdefense
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Creep is a Behavior that walks an entity along a path of waypoints.
+type Creep struct {
+	Health    float64
+	MaxHealth float64
+	Speed     float64 // Pixels per second
+	Reward    int     // Currency granted on kill (read by the game)
+
+	Velocity gamemath.Vector2 // Current velocity (used by towers to lead shots)
+
+	entity   *core.Entity
+	path     []gamemath.Vector2
+	waypoint int
+	leaked   bool
+}
+
+// NewCreep attaches a creep behavior to an entity.
+//
+// Example:
+//
+//	spawn := func() *towerdefense.Creep {
+//	    entity := &core.Entity{Active: true, Sprite: orcSprite, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}}
+//	    return towerdefense.NewCreep(entity, 100, 60)
+//	}
+func NewCreep(entity *core.Entity, health, speed float64) *Creep {
+	c := &Creep{
+		Health:    health,
+		MaxHealth: health,
+		Speed:     speed,
+		entity:    entity,
+	}
+	entity.Behavior = c
+	return c
+}
+
+// Entity returns the creep's entity.
+func (c *Creep) Entity() *core.Entity {
+	return c.entity
+}
+
+// SetPath replaces the waypoints the creep follows (first waypoint is the next target).
+func (c *Creep) SetPath(path []gamemath.Vector2) {
+	c.path = path
+	c.waypoint = 0
+}
+
+// Damage reduces health and reports whether the hit killed the creep.
+func (c *Creep) Damage(amount float64) bool {
+	if !c.Alive() {
+		return false
+	}
+	c.Health -= amount
+	return c.Health <= 0
+}
+
+// Alive reports whether the creep has health left.
+func (c *Creep) Alive() bool {
+	return c.Health > 0
+}
+
+// Leaked reports whether the creep reached the end of its path.
+func (c *Creep) Leaked() bool {
+	return c.leaked
+}
+
+// Remaining returns the path distance left to the goal (smaller = further along).
+func (c *Creep) Remaining() float64 {
+	if c.waypoint >= len(c.path) {
+		return 0
+	}
+	remaining := c.entity.Transform.Position.Distance(c.path[c.waypoint])
+	for i := c.waypoint; i+1 < len(c.path); i++ {
+		remaining += c.path[i].Distance(c.path[i+1])
+	}
+	return remaining
+}
+
+// Update moves the creep toward its next waypoint (implements core.Behavior).
+func (c *Creep) Update(entity *core.Entity, dt float64) {
+	if !c.Alive() || c.leaked {
+		c.Velocity = gamemath.Vector2{}
+		return
+	}
+
+	step := c.Speed * dt
+	position := entity.Transform.Position
+	for step > 0 && c.waypoint < len(c.path) {
+		target := c.path[c.waypoint]
+		distance := position.Distance(target)
+		if distance > step {
+			position = position.Add(target.Sub(position).Scale(step / distance))
+			break
+		}
+		position = target
+		step -= distance
+		c.waypoint++
+	}
+
+	if dt > 0 {
+		c.Velocity = position.Sub(entity.Transform.Position).Scale(1 / dt)
+	}
+	entity.Transform.Position = position
+	if len(c.path) > 0 && c.waypoint >= len(c.path) {
+		c.leaked = true
+	}
+}
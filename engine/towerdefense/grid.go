@@ -0,0 +1,272 @@
+// Package towerdefense provides a tower defense kit: a build grid with path validation,
+// creeps that follow the path, targeting towers, and wave spawning.
+package towerdefense
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Placement errors.
+var (
+	ErrOutOfBounds  = errors.New("cell out of bounds")
+	ErrNotBuildable = errors.New("cell not buildable")
+	ErrOccupied     = errors.New("cell occupied")
+	ErrBlocksPath   = errors.New("placement blocks the path")
+)
+
+// Cell is a grid coordinate.
+type Cell struct {
+	X, Y int
+}
+
+// Grid is a build grid with a spawn and goal; creeps walk the shortest open path.
+//
+// Towers occupy cells. A placement is rejected if it would leave no path from
+// Spawn to Goal, so players can maze but never wall off the creeps.
+type Grid struct {
+	Cols, Rows int
+	CellSize   float64
+	Origin     gamemath.Vector2 // World position of cell (0, 0)'s top-left corner
+	Spawn      Cell
+	Goal       Cell
+
+	blocked   []bool // Not buildable and not walkable (walls, water)
+	unbuild   []bool // Walkable but not buildable (the road)
+	occupants []*Tower
+	path      []Cell
+	version   int // Incremented whenever walkability changes
+}
+
+// NewGrid creates an open grid.
+//
+// Example:
+//
+//	grid := towerdefense.NewGrid(20, 12, 32, gamemath.Vector2{})
+//	grid.Spawn = towerdefense.Cell{X: 0, Y: 6}
+//	grid.Goal = towerdefense.Cell{X: 19, Y: 6}
+//	err := grid.Build(towerdefense.Cell{X: 5, Y: 5}, tower)
+func NewGrid(cols, rows int, cellSize float64, origin gamemath.Vector2) *Grid {
+	return &Grid{
+		Cols:      cols,
+		Rows:      rows,
+		CellSize:  cellSize,
+		Origin:    origin,
+		blocked:   make([]bool, cols*rows),
+		unbuild:   make([]bool, cols*rows),
+		occupants: make([]*Tower, cols*rows),
+	}
+}
+
+// InBounds reports whether a cell is on the grid.
+func (g *Grid) InBounds(cell Cell) bool {
+	return cell.X >= 0 && cell.Y >= 0 && cell.X < g.Cols && cell.Y < g.Rows
+}
+
+// SetBlocked marks a cell as a wall (neither walkable nor buildable).
+func (g *Grid) SetBlocked(cell Cell, blocked bool) {
+	if g.InBounds(cell) {
+		g.blocked[g.index(cell)] = blocked
+		g.invalidate()
+	}
+}
+
+// SetBuildable controls whether towers may be placed on a walkable cell.
+func (g *Grid) SetBuildable(cell Cell, buildable bool) {
+	if g.InBounds(cell) {
+		g.unbuild[g.index(cell)] = !buildable
+	}
+}
+
+// CanBuild validates a placement.
+//
+// Returns:
+//
+//	error: nil if the cell is free, buildable, and leaves a path open;
+//	otherwise ErrOutOfBounds, ErrNotBuildable, ErrOccupied, or ErrBlocksPath
+func (g *Grid) CanBuild(cell Cell) error {
+	if !g.InBounds(cell) {
+		return fmt.Errorf("%w: %v", ErrOutOfBounds, cell)
+	}
+	i := g.index(cell)
+	if g.blocked[i] || g.unbuild[i] || cell == g.Spawn || cell == g.Goal {
+		return fmt.Errorf("%w: %v", ErrNotBuildable, cell)
+	}
+	if g.occupants[i] != nil {
+		return fmt.Errorf("%w: %v", ErrOccupied, cell)
+	}
+
+	g.occupants[i] = &Tower{} // Tentatively occupy
+	path := g.findPath(g.Spawn)
+	g.occupants[i] = nil
+	if path == nil {
+		return fmt.Errorf("%w: %v", ErrBlocksPath, cell)
+	}
+	return nil
+}
+
+// Build places a tower on a cell and positions its entity at the cell center.
+func (g *Grid) Build(cell Cell, tower *Tower) error {
+	if err := g.CanBuild(cell); err != nil {
+		return err
+	}
+	g.occupants[g.index(cell)] = tower
+	if tower.Entity != nil {
+		tower.Entity.Transform.Position = g.CellCenter(cell)
+	}
+	g.invalidate()
+	return nil
+}
+
+// Remove removes the tower on a cell (e.g. selling) and returns it.
+func (g *Grid) Remove(cell Cell) *Tower {
+	if !g.InBounds(cell) {
+		return nil
+	}
+	i := g.index(cell)
+	tower := g.occupants[i]
+	g.occupants[i] = nil
+	g.invalidate()
+	return tower
+}
+
+// TowerAt returns the tower on a cell, or nil.
+func (g *Grid) TowerAt(cell Cell) *Tower {
+	if !g.InBounds(cell) {
+		return nil
+	}
+	return g.occupants[g.index(cell)]
+}
+
+// CellAt converts a world position to a cell.
+func (g *Grid) CellAt(position gamemath.Vector2) (Cell, bool) {
+	cell := Cell{
+		X: int(math.Floor((position.X - g.Origin.X) / g.CellSize)),
+		Y: int(math.Floor((position.Y - g.Origin.Y) / g.CellSize)),
+	}
+	return cell, g.InBounds(cell)
+}
+
+// CellCenter returns the world position of a cell's center.
+func (g *Grid) CellCenter(cell Cell) gamemath.Vector2 {
+	return gamemath.Vector2{
+		X: g.Origin.X + (float64(cell.X)+0.5)*g.CellSize,
+		Y: g.Origin.Y + (float64(cell.Y)+0.5)*g.CellSize,
+	}
+}
+
+// Path returns the current shortest path from Spawn to Goal (nil if none).
+func (g *Grid) Path() []Cell {
+	if g.path == nil {
+		g.path = g.findPath(g.Spawn)
+	}
+	return g.path
+}
+
+// Version returns a counter that changes whenever towers or walls change the path.
+// Compare against a stored value to detect when creeps need rerouting.
+func (g *Grid) Version() int {
+	return g.version
+}
+
+// WorldPath returns the current path as world-space waypoints at cell centers.
+func (g *Grid) WorldPath() []gamemath.Vector2 {
+	return g.toWorld(g.Path())
+}
+
+// WorldPathFrom returns world-space waypoints from a position to Goal (nil if unreachable).
+//
+// Used to reroute creeps already on the field after a tower is built.
+func (g *Grid) WorldPathFrom(position gamemath.Vector2) []gamemath.Vector2 {
+	cell, ok := g.CellAt(position)
+	if !ok {
+		return nil
+	}
+	return g.toWorld(g.findPath(cell))
+}
+
+// toWorld converts cells to cell-center waypoints.
+func (g *Grid) toWorld(cells []Cell) []gamemath.Vector2 {
+	if cells == nil {
+		return nil
+	}
+	waypoints := make([]gamemath.Vector2, len(cells))
+	for i, cell := range cells {
+		waypoints[i] = g.CellCenter(cell)
+	}
+	return waypoints
+}
+
+// RenderPlacement outlines a cell in green if buildable, red otherwise (build cursor).
+func (g *Grid) RenderPlacement(renderer *graphics.Renderer, camera *graphics.Camera, cell Cell) error {
+	color := gamemath.Green
+	if g.CanBuild(cell) != nil {
+		color = gamemath.Red
+	}
+	topLeft := gamemath.Vector2{X: g.Origin.X + float64(cell.X)*g.CellSize, Y: g.Origin.Y + float64(cell.Y)*g.CellSize}
+	x, y := camera.WorldToScreen(topLeft.X, topLeft.Y)
+	size := g.CellSize * camera.Zoom
+	return renderer.DrawRect(gamemath.Rectangle{X: float64(x), Y: float64(y), Width: size, Height: size}, color)
+}
+
+// invalidate drops the cached path after a walkability change.
+func (g *Grid) invalidate() {
+	g.path = nil
+	g.version++
+}
+
+// findPath runs a breadth-first search over walkable, unoccupied cells.
+func (g *Grid) findPath(from Cell) []Cell {
+	if !g.InBounds(from) || !g.InBounds(g.Goal) {
+		return nil
+	}
+
+	previous := make([]int, g.Cols*g.Rows)
+	for i := range previous {
+		previous[i] = -1
+	}
+	start, goal := g.index(from), g.index(g.Goal)
+	previous[start] = start
+	queue := []Cell{from}
+	directions := []Cell{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if g.index(current) == goal {
+			break
+		}
+		for _, d := range directions {
+			next := Cell{X: current.X + d.X, Y: current.Y + d.Y}
+			if !g.InBounds(next) {
+				continue
+			}
+			i := g.index(next)
+			if previous[i] != -1 || g.blocked[i] || g.occupants[i] != nil {
+				continue
+			}
+			previous[i] = g.index(current)
+			queue = append(queue, next)
+		}
+	}
+
+	if previous[goal] == -1 {
+		return nil
+	}
+	path := []Cell{}
+	for i := goal; ; i = previous[i] {
+		path = append([]Cell{{X: i % g.Cols, Y: i / g.Cols}}, path...)
+		if i == start {
+			break
+		}
+	}
+	return path
+}
+
+func (g *Grid) index(cell Cell) int {
+	return cell.Y*g.Cols + cell.X
+}
@@ -0,0 +1,162 @@
+package towerdefense
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/ballistics"
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TargetStrategy selects which creep in range a tower shoots.
+type TargetStrategy int
+
+const (
+	TargetFirst     TargetStrategy = iota // Closest to the goal
+	TargetLast                            // Furthest from the goal
+	TargetClosest                         // Nearest to the tower
+	TargetStrongest                       // Most remaining health
+)
+
+// rangeSegments is the number of line segments used to draw a range circle.
+const rangeSegments = 48
+
+// Tower is a Behavior that picks targets in range and fires at them.
+//
+// If OnFire is nil the tower applies Damage instantly (hitscan). Otherwise
+// OnFire receives the lead-corrected aim point so the game can spawn a
+// projectile travelling at ProjectileSpeed.
+type Tower struct {
+	Entity          *core.Entity
+	Range           float64        // Targeting radius in pixels
+	FireRate        float64        // Shots per second
+	Damage          float64        // Damage per hitscan shot
+	ProjectileSpeed float64        // Used to lead moving targets (0 = aim at current position)
+	Strategy        TargetStrategy // Target selection
+	Rotate          bool           // Turn the entity to face the target
+
+	// Creeps supplies the candidate targets (e.g. WaveSpawner.Creeps)
+	Creeps func() []*Creep
+
+	// OnFire is called for each shot with the target and aim point
+	OnFire func(tower *Tower, target *Creep, aim gamemath.Vector2)
+
+	target   *Creep
+	cooldown float64
+}
+
+// NewTower creates a tower attached to an entity.
+//
+// Example:
+//
+//	tower := towerdefense.NewTower(&core.Entity{Active: true, Sprite: turret}, 120, 2, spawner.Creeps)
+//	tower.Strategy = towerdefense.TargetStrongest
+//	if err := grid.Build(cell, tower); err == nil {
+//	    scene.AddEntity(tower.Entity)
+//	}
+func NewTower(entity *core.Entity, rangeRadius, fireRate float64, creeps func() []*Creep) *Tower {
+	t := &Tower{
+		Entity:   entity,
+		Range:    rangeRadius,
+		FireRate: fireRate,
+		Damage:   10,
+		Strategy: TargetFirst,
+		Rotate:   true,
+		Creeps:   creeps,
+	}
+	if entity != nil {
+		entity.Behavior = t
+	}
+	return t
+}
+
+// Target returns the creep currently targeted (nil if none).
+func (t *Tower) Target() *Creep {
+	return t.target
+}
+
+// InRange reports whether a creep is alive and within the tower's range.
+func (t *Tower) InRange(creep *Creep) bool {
+	return creep != nil && creep.Alive() && !creep.Leaked() &&
+		t.Entity.Transform.Position.Distance(creep.Entity().Transform.Position) <= t.Range
+}
+
+// SelectTarget picks the best creep in range according to Strategy.
+func (t *Tower) SelectTarget(creeps []*Creep) *Creep {
+	var best *Creep
+	bestScore := math.Inf(-1)
+	for _, creep := range creeps {
+		if !t.InRange(creep) {
+			continue
+		}
+		var score float64
+		switch t.Strategy {
+		case TargetFirst:
+			score = -creep.Remaining()
+		case TargetLast:
+			score = creep.Remaining()
+		case TargetClosest:
+			score = -t.Entity.Transform.Position.Distance(creep.Entity().Transform.Position)
+		case TargetStrongest:
+			score = creep.Health
+		}
+		if score > bestScore {
+			best, bestScore = creep, score
+		}
+	}
+	return best
+}
+
+// Update retargets, aims, and fires when the cooldown allows (implements core.Behavior).
+func (t *Tower) Update(entity *core.Entity, dt float64) {
+	if t.cooldown > 0 {
+		t.cooldown -= dt
+	}
+	if t.Creeps == nil {
+		return
+	}
+
+	t.target = t.SelectTarget(t.Creeps())
+	if t.target == nil {
+		return
+	}
+
+	origin := entity.Transform.Position
+	aim := t.target.Entity().Transform.Position
+	if t.ProjectileSpeed > 0 {
+		aim, _ = ballistics.LeadTarget(origin, aim, t.target.Velocity, t.ProjectileSpeed)
+	}
+	if t.Rotate {
+		entity.Transform.Rotation = math.Atan2(aim.Y-origin.Y, aim.X-origin.X) * 180 / math.Pi
+	}
+
+	if t.cooldown > 0 || t.FireRate <= 0 {
+		return
+	}
+	t.cooldown = 1 / t.FireRate
+	if t.OnFire != nil {
+		t.OnFire(t, t.target, aim)
+	} else {
+		t.target.Damage(t.Damage)
+	}
+}
+
+// RenderRange draws the tower's range as a circle outline (e.g. while placing or selected).
+func (t *Tower) RenderRange(renderer *graphics.Renderer, camera *graphics.Camera, color gamemath.Color) error {
+	return DrawRange(renderer, camera, t.Entity.Transform.Position, t.Range, color)
+}
+
+// DrawRange draws a range circle around a world position (e.g. a build cursor preview).
+func DrawRange(renderer *graphics.Renderer, camera *graphics.Camera, center gamemath.Vector2, radius float64, color gamemath.Color) error {
+	prevX, prevY := camera.WorldToScreen(center.X+radius, center.Y)
+	for i := 1; i <= rangeSegments; i++ {
+		angle := 2 * math.Pi * float64(i) / rangeSegments
+		x, y := camera.WorldToScreen(center.X+radius*math.Cos(angle), center.Y+radius*math.Sin(angle))
+		if err := renderer.DrawLine(float64(prevX), float64(prevY), float64(x), float64(y), color); err != nil {
+			return err
+		}
+		prevX, prevY = x, y
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package towerdefense
+
+import (
+	"github.com/dshills/gogame/engine/core"
+)
+
+// Group is a batch of identical creeps within a wave.
+type Group struct {
+	Count    int           // Number of creeps
+	Delay    float64       // Seconds after the wave starts before the first spawn
+	Interval float64       // Seconds between spawns
+	Spawn    func() *Creep // Creates a creep (entity is added to the scene by the spawner)
+}
+
+// Wave is a set of groups spawned concurrently.
+type Wave struct {
+	Groups []Group
+}
+
+// WaveSpawner is a Behavior that spawns waves onto a grid's path and tracks live creeps.
+//
+// Creeps are routed along Grid.WorldPath when spawned and rerouted from their
+// current cell whenever the grid's path changes. Dead and leaked creeps are
+// removed from the scene.
+type WaveSpawner struct {
+	Scene *core.Scene
+	Grid  *Grid
+	Waves []Wave
+
+	OnWaveStart   func(wave int)     // Called when a wave begins
+	OnWaveCleared func(wave int)     // Called when every creep of a wave is gone
+	OnKill        func(creep *Creep) // Called when a creep dies
+	OnLeak        func(creep *Creep) // Called when a creep reaches the goal
+
+	creeps      []*Creep
+	wave        int
+	elapsed     float64
+	spawned     []int
+	spawning    bool
+	gridVersion int
+}
+
+// NewWaveSpawner creates a spawner. Add it to the scene on an entity, then call StartNextWave.
+//
+// Example:
+//
+//	spawner := towerdefense.NewWaveSpawner(scene, grid, waves...)
+//	spawner.OnLeak = func(c *towerdefense.Creep) { lives-- }
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: spawner})
+//	spawner.StartNextWave()
+func NewWaveSpawner(scene *core.Scene, grid *Grid, waves ...Wave) *WaveSpawner {
+	return &WaveSpawner{
+		Scene:  scene,
+		Grid:   grid,
+		Waves:  waves,
+		creeps: make([]*Creep, 0),
+		wave:   -1,
+	}
+}
+
+// StartNextWave begins the next wave.
+//
+// Returns:
+//
+//	bool: False if all waves have been started
+func (w *WaveSpawner) StartNextWave() bool {
+	if w.wave+1 >= len(w.Waves) {
+		return false
+	}
+	w.wave++
+	w.elapsed = 0
+	w.spawned = make([]int, len(w.Waves[w.wave].Groups))
+	w.spawning = true
+	if w.OnWaveStart != nil {
+		w.OnWaveStart(w.wave)
+	}
+	return true
+}
+
+// Wave returns the index of the current wave (-1 before the first wave).
+func (w *WaveSpawner) Wave() int {
+	return w.wave
+}
+
+// Active reports whether the current wave is still spawning or has live creeps.
+func (w *WaveSpawner) Active() bool {
+	return w.spawning || len(w.creeps) > 0
+}
+
+// Finished reports whether every wave has been started and cleared.
+func (w *WaveSpawner) Finished() bool {
+	return w.wave == len(w.Waves)-1 && !w.Active()
+}
+
+// Creeps returns the live creeps (suitable for Tower.Creeps).
+func (w *WaveSpawner) Creeps() []*Creep {
+	return w.creeps
+}
+
+// Update spawns due creeps, reroutes on path changes, and removes finished creeps (implements core.Behavior).
+func (w *WaveSpawner) Update(_ *core.Entity, dt float64) {
+	if w.spawning {
+		w.elapsed += dt
+		w.spawnDue()
+	}
+
+	if version := w.Grid.Version(); version != w.gridVersion {
+		w.gridVersion = version
+		for _, creep := range w.creeps {
+			if path := w.Grid.WorldPathFrom(creep.Entity().Transform.Position); path != nil {
+				creep.SetPath(path)
+			}
+		}
+	}
+
+	wasActive := w.Active()
+	remaining := w.creeps[:0]
+	for _, creep := range w.creeps {
+		switch {
+		case !creep.Alive():
+			w.remove(creep)
+			if w.OnKill != nil {
+				w.OnKill(creep)
+			}
+		case creep.Leaked():
+			w.remove(creep)
+			if w.OnLeak != nil {
+				w.OnLeak(creep)
+			}
+		default:
+			remaining = append(remaining, creep)
+		}
+	}
+	w.creeps = remaining
+
+	if wasActive && !w.Active() && w.OnWaveCleared != nil {
+		w.OnWaveCleared(w.wave)
+	}
+}
+
+// spawnDue spawns every creep whose scheduled time has passed.
+func (w *WaveSpawner) spawnDue() {
+	done := true
+	for i, group := range w.Waves[w.wave].Groups {
+		for w.spawned[i] < group.Count && w.elapsed >= group.Delay+float64(w.spawned[i])*group.Interval {
+			w.spawned[i]++
+			w.spawn(group)
+		}
+		if w.spawned[i] < group.Count {
+			done = false
+		}
+	}
+	w.spawning = !done
+}
+
+// spawn creates a creep at the start of the path and adds it to the scene.
+func (w *WaveSpawner) spawn(group Group) {
+	if group.Spawn == nil {
+		return
+	}
+	creep := group.Spawn()
+	if creep == nil {
+		return
+	}
+	path := w.Grid.WorldPath()
+	if len(path) > 0 {
+		creep.Entity().Transform.Position = path[0]
+		creep.SetPath(path[1:])
+	}
+	w.Scene.AddEntity(creep.Entity())
+	w.creeps = append(w.creeps, creep)
+}
+
+// remove takes a creep's entity out of the scene.
+func (w *WaveSpawner) remove(creep *Creep) {
+	w.Scene.RemoveEntity(creep.Entity().ID)
+}
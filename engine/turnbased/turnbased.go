@@ -0,0 +1,219 @@
+// Package turnbased provides a turn manager with initiative order, action points, and validated actions.
+package turnbased
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// Errors returned by TurnManager.Perform.
+var (
+	ErrNoActiveTurn          = errors.New("no active turn")
+	ErrNotEnoughActionPoints = errors.New("not enough action points")
+)
+
+// Participant is a combatant taking part in turn order.
+type Participant struct {
+	Entity          *core.Entity // Entity acting in combat (optional)
+	Name            string       // Display name
+	Initiative      int          // Higher acts earlier in each round
+	ActionPoints    int          // Remaining points this turn
+	MaxActionPoints int          // Points restored at the start of each turn
+	Team            int          // Team identifier for game logic
+
+	order int // Insertion order for stable initiative ties
+}
+
+// Context is passed to actions during validation and execution.
+type Context struct {
+	Manager *TurnManager // Turn manager running the action
+	Actor   *Participant // Participant whose turn it is
+	Round   int          // Current round number (starting at 1)
+}
+
+// Action is a unit of work a participant performs on its turn.
+//
+// Actions are validated before execution; a validation error aborts the action
+// without spending action points.
+//
+// Example:
+//
+//	type AttackAction struct{ Target *turnbased.Participant }
+//
+//	func (a *AttackAction) Cost() int { return 2 }
+//	func (a *AttackAction) Validate(ctx *turnbased.Context) error {
+//	    if a.Target.Team == ctx.Actor.Team {
+//	        return errors.New("cannot attack ally")
+//	    }
+//	    return nil
+//	}
+//	func (a *AttackAction) Execute(ctx *turnbased.Context) error {
+//	    damage(a.Target, 10)
+//	    return nil
+//	}
+type Action interface {
+	// Cost returns the action point cost of the action.
+	Cost() int
+	// Validate checks whether the action is legal in the current context.
+	Validate(ctx *Context) error
+	// Execute applies the action's effects.
+	Execute(ctx *Context) error
+}
+
+// TurnManager runs initiative-ordered rounds of turns.
+type TurnManager struct {
+	participants []*Participant
+	current      int
+	round        int
+	nextOrder    int
+	started      bool
+
+	// Turn events (optional)
+	OnRoundStart func(round int)
+	OnTurnStart  func(p *Participant)
+	OnTurnEnd    func(p *Participant)
+	OnAction     func(p *Participant, action Action)
+}
+
+// NewTurnManager creates an empty turn manager.
+func NewTurnManager() *TurnManager {
+	return &TurnManager{
+		participants: make([]*Participant, 0),
+	}
+}
+
+// Add adds a participant.
+//
+// Participants added mid-round act at the end of the current round and are
+// sorted into initiative order from the next round.
+func (tm *TurnManager) Add(p *Participant) {
+	p.order = tm.nextOrder
+	tm.nextOrder++
+	tm.participants = append(tm.participants, p)
+	if !tm.started {
+		tm.sortByInitiative()
+	}
+}
+
+// Remove removes a participant (e.g. a defeated combatant).
+//
+// If the removed participant is currently acting, the turn passes to the next participant.
+func (tm *TurnManager) Remove(p *Participant) {
+	for i, other := range tm.participants {
+		if other != p {
+			continue
+		}
+		wasCurrent := tm.started && i == tm.current
+		tm.participants = append(tm.participants[:i], tm.participants[i+1:]...)
+		if i < tm.current {
+			tm.current--
+		}
+		if wasCurrent && len(tm.participants) > 0 {
+			tm.current--
+			tm.advance()
+		}
+		return
+	}
+}
+
+// Participants returns participants in turn order.
+func (tm *TurnManager) Participants() []*Participant {
+	return tm.participants
+}
+
+// Start begins round 1 with the highest initiative participant.
+func (tm *TurnManager) Start() {
+	tm.sortByInitiative()
+	tm.started = true
+	tm.round = 0
+	tm.current = -1
+	tm.advance()
+}
+
+// Round returns the current round number (0 before Start).
+func (tm *TurnManager) Round() int {
+	return tm.round
+}
+
+// Current returns the participant whose turn it is, or nil.
+func (tm *TurnManager) Current() *Participant {
+	if !tm.started || tm.current < 0 || tm.current >= len(tm.participants) {
+		return nil
+	}
+	return tm.participants[tm.current]
+}
+
+// Perform validates and executes an action for the current participant.
+//
+// Returns:
+//
+//	error: ErrNoActiveTurn, ErrNotEnoughActionPoints, or a wrapped validation/execution error
+func (tm *TurnManager) Perform(action Action) error {
+	actor := tm.Current()
+	if actor == nil {
+		return ErrNoActiveTurn
+	}
+	if action.Cost() > actor.ActionPoints {
+		return fmt.Errorf("%w: need %d, have %d", ErrNotEnoughActionPoints, action.Cost(), actor.ActionPoints)
+	}
+
+	ctx := &Context{Manager: tm, Actor: actor, Round: tm.round}
+	if err := action.Validate(ctx); err != nil {
+		return fmt.Errorf("invalid action: %w", err)
+	}
+	if err := action.Execute(ctx); err != nil {
+		return fmt.Errorf("action failed: %w", err)
+	}
+
+	actor.ActionPoints -= action.Cost()
+	if tm.OnAction != nil {
+		tm.OnAction(actor, action)
+	}
+	return nil
+}
+
+// EndTurn ends the current participant's turn and starts the next one.
+func (tm *TurnManager) EndTurn() {
+	if actor := tm.Current(); actor != nil && tm.OnTurnEnd != nil {
+		tm.OnTurnEnd(actor)
+	}
+	tm.advance()
+}
+
+// advance moves to the next participant, starting a new round when needed.
+func (tm *TurnManager) advance() {
+	if len(tm.participants) == 0 {
+		tm.current = -1
+		return
+	}
+
+	tm.current++
+	if tm.current >= len(tm.participants) || tm.round == 0 {
+		tm.sortByInitiative()
+		tm.current = 0
+		tm.round++
+		if tm.OnRoundStart != nil {
+			tm.OnRoundStart(tm.round)
+		}
+	}
+
+	actor := tm.participants[tm.current]
+	actor.ActionPoints = actor.MaxActionPoints
+	if tm.OnTurnStart != nil {
+		tm.OnTurnStart(actor)
+	}
+}
+
+// sortByInitiative orders participants by initiative (descending), ties by insertion order.
+func (tm *TurnManager) sortByInitiative() {
+	sort.SliceStable(tm.participants, func(i, j int) bool {
+		a, b := tm.participants[i], tm.participants[j]
+		if a.Initiative != b.Initiative {
+			return a.Initiative > b.Initiative
+		}
+		return a.order < b.order
+	})
+}
@@ -0,0 +1,414 @@
+// Package tutorial provides contextual hints gated on game conditions, with
+// persistent completion tracking and world/UI target highlighting.
+package tutorial
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"sort"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/glyphs"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/save"
+)
+
+// Hint is one tutorial prompt.
+type Hint struct {
+	ID       string // Stable identifier used for persistence
+	Text     string // Prompt text; {action} placeholders expand through Prompts
+	Priority int    // Higher wins when several hints are eligible
+
+	// When returns true while the hint is relevant (e.g. "a ladder is in
+	// reach"). Nil means always relevant.
+	When func() bool
+
+	// Failures is how many Fail calls are needed before the hint appears
+	// (0 = show the first time When is true).
+	Failures int
+
+	// CompleteOn completes the hint when the action is pressed while it is
+	// shown (ActionNone = complete via Complete only).
+	CompleteOn input.Action
+
+	// Duration auto-dismisses the hint after this many seconds without
+	// completing it (0 = stay until completed or no longer relevant).
+	Duration float64
+
+	// Cooldown is the delay in seconds before a dismissed hint may show again.
+	Cooldown float64
+
+	Target Target // Optional highlight
+}
+
+// Target is something a hint points at.
+type Target struct {
+	Entity *core.Entity        // World target (uses collider bounds if present)
+	Screen *gamemath.Rectangle // UI target in screen pixels
+}
+
+// Progress is the persistent tutorial state.
+type Progress struct {
+	Completed map[string]bool `json:"completed"`
+	Failures  map[string]int  `json:"failures"`
+}
+
+// progressSchema versions the progress file.
+var progressSchema = save.NewSchema(1)
+
+// Tutorial picks and displays at most one hint at a time.
+//
+// Each frame the highest-priority hint that is not completed, whose When
+// condition holds, whose failure threshold is met, and which is not cooling
+// down becomes current. A hint that stops being relevant is hidden without
+// completing, so it returns the next time its condition holds.
+type Tutorial struct {
+	Input   *input.InputManager // Polled for CompleteOn actions (optional)
+	Prompts *glyphs.Service     // Expands {action} placeholders (optional)
+	Enabled bool                // Disable to suppress hints (e.g. a "hints off" option)
+
+	// OnShow and OnHide are called when the current hint changes.
+	OnShow func(hint *Hint)
+	OnHide func(hint *Hint, completed bool)
+
+	hints    []*Hint
+	progress Progress
+	current  *Hint
+	shown    float64            // Seconds the current hint has been visible
+	cooldown map[string]float64 // Remaining cooldown per hint ID
+	time     float64            // Accumulated time for highlight pulsing
+}
+
+// New creates an enabled tutorial with no progress.
+//
+// Example:
+//
+//	tut := tutorial.New(engine.Input())
+//	tut.Prompts = glyphs.NewService(engine.Input())
+//	tut.Add(&tutorial.Hint{
+//	    ID:         "climb",
+//	    Text:       "Press {up} to climb",
+//	    When:       func() bool { return player.NearLadder() },
+//	    CompleteOn: input.ActionMoveUp,
+//	    Target:     tutorial.Target{Entity: ladder},
+//	})
+//	tut.Add(&tutorial.Hint{ID: "dash-jump", Text: "Hold {attack} while jumping to dash", Failures: 3})
+//	_ = tut.LoadProgress("tutorial.json")
+//	scene.AddEntity(&core.Entity{Active: true, Behavior: tut})
+func New(inputMgr *input.InputManager) *Tutorial {
+	return &Tutorial{
+		Input:    inputMgr,
+		Enabled:  true,
+		hints:    make([]*Hint, 0),
+		progress: Progress{Completed: make(map[string]bool), Failures: make(map[string]int)},
+		cooldown: make(map[string]float64),
+	}
+}
+
+// Add registers a hint.
+func (t *Tutorial) Add(hint *Hint) {
+	t.hints = append(t.hints, hint)
+	sort.SliceStable(t.hints, func(i, j int) bool { return t.hints[i].Priority > t.hints[j].Priority })
+}
+
+// Current returns the hint being shown (nil if none).
+func (t *Tutorial) Current() *Hint {
+	return t.current
+}
+
+// Text returns the current hint's text with action placeholders expanded.
+func (t *Tutorial) Text() string {
+	if t.current == nil {
+		return ""
+	}
+	if t.Prompts != nil {
+		return t.Prompts.Expand(t.current.Text)
+	}
+	return t.current.Text
+}
+
+// Fail records a failure for a hint (e.g. the player fell in the pit again).
+func (t *Tutorial) Fail(id string) {
+	t.progress.Failures[id]++
+}
+
+// Complete marks a hint done; it will never show again.
+func (t *Tutorial) Complete(id string) {
+	t.progress.Completed[id] = true
+	if t.current != nil && t.current.ID == id {
+		t.hide(true)
+	}
+}
+
+// Completed reports whether a hint has been completed.
+func (t *Tutorial) Completed(id string) bool {
+	return t.progress.Completed[id]
+}
+
+// Dismiss hides the current hint without completing it (Cooldown applies).
+func (t *Tutorial) Dismiss() {
+	if t.current != nil {
+		t.hide(false)
+	}
+}
+
+// Reset clears all progress (e.g. a "replay tutorial" option).
+func (t *Tutorial) Reset() {
+	t.Restore(Progress{})
+}
+
+// Progress returns a copy of the persistent state.
+func (t *Tutorial) Progress() Progress {
+	progress := Progress{
+		Completed: make(map[string]bool, len(t.progress.Completed)),
+		Failures:  make(map[string]int, len(t.progress.Failures)),
+	}
+	for id, done := range t.progress.Completed {
+		progress.Completed[id] = done
+	}
+	for id, count := range t.progress.Failures {
+		progress.Failures[id] = count
+	}
+	return progress
+}
+
+// Restore replaces the persistent state.
+func (t *Tutorial) Restore(progress Progress) {
+	t.progress = Progress{Completed: make(map[string]bool), Failures: make(map[string]int)}
+	for id, done := range progress.Completed {
+		t.progress.Completed[id] = done
+	}
+	for id, count := range progress.Failures {
+		t.progress.Failures[id] = count
+	}
+	if t.current != nil && t.progress.Completed[t.current.ID] {
+		t.hide(true)
+	}
+}
+
+// SaveProgress writes completion state to a file.
+func (t *Tutorial) SaveProgress(path string) error {
+	if err := progressSchema.SaveFile(path, t.Progress()); err != nil {
+		return fmt.Errorf("failed to save tutorial progress: %w", err)
+	}
+	return nil
+}
+
+// LoadProgress reads completion state from a file. A missing file is not an
+// error (first launch).
+func (t *Tutorial) LoadProgress(path string) error {
+	var progress Progress
+	if _, err := progressSchema.LoadFile(path, &progress); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to load tutorial progress: %w", err)
+	}
+	t.Restore(progress)
+	return nil
+}
+
+// Update selects, times out, and completes hints (implements core.Behavior).
+func (t *Tutorial) Update(_ *core.Entity, dt float64) {
+	t.time += dt
+	for id, remaining := range t.cooldown {
+		if remaining -= dt; remaining <= 0 {
+			delete(t.cooldown, id)
+		} else {
+			t.cooldown[id] = remaining
+		}
+	}
+
+	if t.current != nil {
+		hint := t.current
+		t.shown += dt
+		switch {
+		case !t.Enabled || !t.relevant(hint):
+			t.current = nil // Not dismissed: no cooldown, reappears when relevant
+			if t.OnHide != nil {
+				t.OnHide(hint, false)
+			}
+		case hint.CompleteOn != input.ActionNone && t.Input != nil && t.Input.ActionPressed(hint.CompleteOn):
+			t.Complete(hint.ID)
+		case hint.Duration > 0 && t.shown >= hint.Duration:
+			t.hide(false)
+		}
+	}
+
+	if t.current != nil || !t.Enabled {
+		return
+	}
+	for _, hint := range t.hints {
+		if t.eligible(hint) {
+			t.current, t.shown = hint, 0
+			if t.OnShow != nil {
+				t.OnShow(hint)
+			}
+			return
+		}
+	}
+}
+
+// eligible reports whether a hint may become current.
+func (t *Tutorial) eligible(hint *Hint) bool {
+	if t.progress.Completed[hint.ID] || t.cooldown[hint.ID] > 0 {
+		return false
+	}
+	if t.progress.Failures[hint.ID] < hint.Failures {
+		return false
+	}
+	return t.relevant(hint)
+}
+
+// relevant evaluates a hint's condition.
+func (t *Tutorial) relevant(hint *Hint) bool {
+	return hint.When == nil || hint.When()
+}
+
+// hide clears the current hint, starting its cooldown unless completed.
+func (t *Tutorial) hide(completed bool) {
+	hint := t.current
+	t.current = nil
+	if !completed && hint.Cooldown > 0 {
+		t.cooldown[hint.ID] = hint.Cooldown
+	}
+	if t.OnHide != nil {
+		t.OnHide(hint, completed)
+	}
+}
+
+// Render draws the current hint's highlight and its text panel.
+//
+// World targets get a pulsing glow around their bounds, or an arrow at the
+// screen edge pointing toward them when off screen. Screen targets always
+// get the glow. The text is centred near the bottom of the screen.
+//
+// Parameters:
+//
+//	renderer: Renderer for shapes
+//	textRenderer: Text renderer for the prompt (nil = highlight only)
+//	camera: Camera used to project world targets
+//	color: Highlight and text color
+//
+// Example:
+//
+//	engine.SetRenderUICallback(func() {
+//	    _ = tut.Render(engine.Renderer(), textRenderer, scene.Camera(), gamemath.Color{R: 255, G: 220, B: 80, A: 255})
+//	})
+func (t *Tutorial) Render(renderer *graphics.Renderer, textRenderer *graphics.TextRenderer, camera *graphics.Camera, color gamemath.Color) error {
+	if t.current == nil {
+		return nil
+	}
+	screenW, screenH := camera.ScreenSize()
+
+	if bounds, ok := t.targetBounds(camera); ok {
+		onScreen := bounds.X+bounds.Width >= 0 && bounds.Y+bounds.Height >= 0 &&
+			bounds.X <= float64(screenW) && bounds.Y <= float64(screenH)
+		var err error
+		if onScreen {
+			err = t.drawGlow(renderer, bounds, color)
+		} else {
+			center := bounds.Center()
+			err = drawEdgeArrow(renderer, center.X, center.Y, float64(screenW), float64(screenH), color)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	text := t.Text()
+	if textRenderer == nil || text == "" {
+		return nil
+	}
+	width, height, err := textRenderer.MeasureText(text)
+	if err != nil {
+		return err
+	}
+	x, y := (screenW-width)/2, screenH-height-48
+	panel := gamemath.Rectangle{X: float64(x - 12), Y: float64(y - 8), Width: float64(width + 24), Height: float64(height + 16)}
+	if err := renderer.FillRect(panel, gamemath.Color{R: 0, G: 0, B: 0, A: 170}); err != nil {
+		return err
+	}
+	return textRenderer.DrawText(text, x, y, color)
+}
+
+// targetBounds returns the current target in screen space.
+func (t *Tutorial) targetBounds(camera *graphics.Camera) (gamemath.Rectangle, bool) {
+	target := t.current.Target
+	if target.Screen != nil {
+		return *target.Screen, true
+	}
+	entity := target.Entity
+	if entity == nil || !entity.Active {
+		return gamemath.Rectangle{}, false
+	}
+	position := entity.WorldTransform().Position
+	world := gamemath.Rectangle{X: position.X - 16, Y: position.Y - 16, Width: 32, Height: 32}
+	if entity.Collider != nil {
+		world = entity.GetBounds()
+	}
+	left, top := camera.WorldToScreen(world.X, world.Y)
+	right, bottom := camera.WorldToScreen(world.X+world.Width, world.Y+world.Height)
+	return gamemath.Rectangle{X: float64(left), Y: float64(top), Width: float64(right - left), Height: float64(bottom - top)}, true
+}
+
+// drawGlow draws nested outlines whose spread and alpha pulse over time.
+func (t *Tutorial) drawGlow(renderer *graphics.Renderer, bounds gamemath.Rectangle, color gamemath.Color) error {
+	pulse := 0.5 + 0.5*math.Sin(t.time*2*math.Pi)
+	for ring := 0; ring < 4; ring++ {
+		spread := 3 + float64(ring)*2 + pulse*3
+		glow := color
+		glow.A = uint8(float64(color.A) * (1 - float64(ring)/4) * (0.5 + 0.5*pulse))
+		rect := gamemath.Rectangle{
+			X:      bounds.X - spread,
+			Y:      bounds.Y - spread,
+			Width:  bounds.Width + spread*2,
+			Height: bounds.Height + spread*2,
+		}
+		if err := renderer.DrawRect(rect, glow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawEdgeArrow draws an arrow inside the screen edge pointing at (x, y).
+func drawEdgeArrow(renderer *graphics.Renderer, x, y, screenW, screenH float64, color gamemath.Color) error {
+	const margin, size = 32.0, 16.0
+	cx, cy := screenW/2, screenH/2
+	dx, dy := x-cx, y-cy
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+	dx, dy = dx/length, dy/length
+
+	// Scale the direction until it reaches the inset screen rectangle
+	scale := math.MaxFloat64
+	if dx != 0 {
+		scale = math.Min(scale, (cx-margin)/math.Abs(dx))
+	}
+	if dy != 0 {
+		scale = math.Min(scale, (cy-margin)/math.Abs(dy))
+	}
+	tipX, tipY := cx+dx*scale, cy+dy*scale
+	baseX, baseY := tipX-dx*size, tipY-dy*size
+	sideX, sideY := -dy*size/2, dx*size/2
+
+	lines := [][4]float64{
+		{tipX, tipY, baseX + sideX, baseY + sideY},
+		{tipX, tipY, baseX - sideX, baseY - sideY},
+		{baseX + sideX, baseY + sideY, baseX - sideX, baseY - sideY},
+		{tipX, tipY, tipX - dx*size*2, tipY - dy*size*2},
+	}
+	for _, l := range lines {
+		if err := renderer.DrawLine(l[0], l[1], l[2], l[3], color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package tween
+
+import (
+	"math"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Easing maps linear progress (0-1) to eased progress. Eased values may
+// overshoot 0-1 (elastic), but every easing returns 0 at 0 and 1 at 1.
+type Easing func(t float64) float64
+
+// Linear moves at a constant rate.
+func Linear(t float64) float64 {
+	return t
+}
+
+// QuadIn starts slow and accelerates.
+func QuadIn(t float64) float64 {
+	return t * t
+}
+
+// QuadOut starts fast and decelerates.
+func QuadOut(t float64) float64 {
+	return t * (2 - t)
+}
+
+// QuadInOut accelerates, then decelerates.
+func QuadInOut(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// CubicIn starts slower than QuadIn and accelerates harder.
+func CubicIn(t float64) float64 {
+	return t * t * t
+}
+
+// CubicOut starts fast and settles gently.
+func CubicOut(t float64) float64 {
+	u := t - 1
+	return u*u*u + 1
+}
+
+// CubicInOut accelerates, then decelerates, more sharply than QuadInOut.
+func CubicInOut(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	u := 2*t - 2
+	return 0.5*u*u*u + 1
+}
+
+// ElasticIn winds up with growing oscillation before snapping to the end.
+func ElasticIn(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return -math.Pow(2, 10*t-10) * math.Sin((t*10-10.75)*(2*math.Pi/3))
+}
+
+// ElasticOut overshoots the end and springs back (popping UI, pickups).
+func ElasticOut(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return math.Pow(2, -10*t)*math.Sin((t*10-0.75)*(2*math.Pi/3)) + 1
+}
+
+// ElasticInOut oscillates out of the start and into the end.
+func ElasticInOut(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	const c = 2 * math.Pi / 4.5
+	if t < 0.5 {
+		return -math.Pow(2, 20*t-10) * math.Sin((20*t-11.125)*c) / 2
+	}
+	return math.Pow(2, -20*t+10)*math.Sin((20*t-11.125)*c)/2 + 1
+}
+
+// BounceOut bounces against the end like a dropped ball.
+func BounceOut(t float64) float64 {
+	const n, d = 7.5625, 2.75
+	switch {
+	case t < 1/d:
+		return n * t * t
+	case t < 2/d:
+		t -= 1.5 / d
+		return n*t*t + 0.75
+	case t < 2.5/d:
+		t -= 2.25 / d
+		return n*t*t + 0.9375
+	}
+	t -= 2.625 / d
+	return n*t*t + 0.984375
+}
+
+// BounceIn bounces off the start before leaving it.
+func BounceIn(t float64) float64 {
+	return 1 - BounceOut(1-t)
+}
+
+// BounceInOut bounces off the start and against the end.
+func BounceInOut(t float64) float64 {
+	if t < 0.5 {
+		return (1 - BounceOut(1-2*t)) / 2
+	}
+	return (1 + BounceOut(2*t-1)) / 2
+}
+
+// Curve uses an animation curve as an easing, with its key range mapped
+// to 0-1, so designers can shape easing as data.
+//
+// Example:
+//
+//	curve, _ := gamemath.ParseAnimationCurve(data)
+//	manager.Tween(&door.Transform.Position.Y, 120, time.Second, tween.Curve(curve))
+func Curve(curve *gamemath.AnimationCurve) Easing {
+	start, end := curve.Start(), curve.End()
+	return func(t float64) float64 {
+		return curve.Evaluate(start + t*(end-start))
+	}
+}
@@ -0,0 +1,293 @@
+// Package tween animates values over time with easing: sliding menus,
+// fading sprites, popping pickups, and camera moves, without hand-written
+// timers in every behavior.
+//
+// Example:
+//
+//	tweens := tween.Attach(scene) // Advanced by the scene's fixed update
+//	tweens.Sequence(
+//	    tween.Vector(&panel.Transform.Position, gamemath.Vector2{X: 400, Y: 300}, 400*time.Millisecond, tween.CubicOut),
+//	    tween.Wait(2*time.Second),
+//	    tween.Float(&panel.Sprite.Alpha, 0, 300*time.Millisecond, tween.QuadIn),
+//	    tween.Call(func() { scene.RemoveEntity(panel.ID) }),
+//	)
+package tween
+
+import (
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// timeEpsilon absorbs floating-point drift when summing fixed steps.
+const timeEpsilon = 1e-9
+
+// Tween moves a value toward a target over a duration.
+//
+// Tweens are built unstarted by Float, Vector, Color, Alpha, Wait, and Call,
+// and run by a Manager. The start value is read when the tween begins
+// (after its delay and any tweens before it in a sequence), so a sequence
+// can move the same value several times.
+type Tween struct {
+	duration   float64 // Seconds
+	delay      float64 // Seconds left before starting
+	elapsed    float64
+	easing     Easing
+	begin      func()          // Reads start values
+	apply      func(p float64) // Sets the value at eased progress p
+	onComplete func()
+	next       *Tween // Started when this one completes
+	started    bool
+	done       bool
+	stopped    bool
+}
+
+// newTween creates an unstarted tween.
+func newTween(duration time.Duration, easing Easing, begin func(), apply func(p float64)) *Tween {
+	if easing == nil {
+		easing = Linear
+	}
+	return &Tween{duration: max(duration.Seconds(), 0), easing: easing, begin: begin, apply: apply}
+}
+
+// Float tweens a float64 (position component, alpha, volume, zoom).
+func Float(target *float64, to float64, duration time.Duration, easing Easing) *Tween {
+	var from float64
+	return newTween(duration, easing,
+		func() { from = *target },
+		func(p float64) { *target = from + (to-from)*p })
+}
+
+// Vector tweens a Vector2 (positions, scales).
+func Vector(target *gamemath.Vector2, to gamemath.Vector2, duration time.Duration, easing Easing) *Tween {
+	var from gamemath.Vector2
+	return newTween(duration, easing,
+		func() { from = *target },
+		func(p float64) { *target = from.Add(to.Sub(from).Scale(p)) })
+}
+
+// Color tweens all four channels of a color (tints, flashes, fades).
+func Color(target *gamemath.Color, to gamemath.Color, duration time.Duration, easing Easing) *Tween {
+	var from gamemath.Color
+	return newTween(duration, easing,
+		func() { from = *target },
+		func(p float64) {
+			*target = gamemath.Color{
+				R: lerpChannel(from.R, to.R, p),
+				G: lerpChannel(from.G, to.G, p),
+				B: lerpChannel(from.B, to.B, p),
+				A: lerpChannel(from.A, to.A, p),
+			}
+		})
+}
+
+// Alpha tweens only a color's alpha channel (e.g. text fades).
+func Alpha(target *gamemath.Color, to uint8, duration time.Duration, easing Easing) *Tween {
+	var from uint8
+	return newTween(duration, easing,
+		func() { from = target.A },
+		func(p float64) { target.A = lerpChannel(from, to, p) })
+}
+
+// Wait creates a tween that changes nothing, for pauses in sequences.
+func Wait(duration time.Duration) *Tween {
+	return newTween(duration, Linear, nil, nil)
+}
+
+// Call creates an instant tween that calls fn, for actions in sequences.
+func Call(fn func()) *Tween {
+	return Wait(0).OnComplete(fn)
+}
+
+// lerpChannel interpolates a color channel, clamping overshoot.
+func lerpChannel(from, to uint8, p float64) uint8 {
+	value := float64(from) + (float64(to)-float64(from))*p
+	return uint8(min(max(value+0.5, 0), 255))
+}
+
+// Delay waits before starting the tween.
+func (t *Tween) Delay(d time.Duration) *Tween {
+	t.delay = max(d.Seconds(), 0)
+	return t
+}
+
+// OnComplete sets a callback for when the tween reaches its target.
+func (t *Tween) OnComplete(fn func()) *Tween {
+	t.onComplete = fn
+	return t
+}
+
+// Then queues a tween to start when this one (and anything already
+// queued after it) completes
+//
+// Returns:
+//
+//	*Tween: next, so chains read in order
+//
+// Example:
+//
+//	tweens.Start(tween.Float(&y, 100, time.Second, tween.QuadOut)).
+//	    Then(tween.Float(&y, 0, time.Second, tween.BounceOut))
+func (t *Tween) Then(next *Tween) *Tween {
+	last := t
+	for last.next != nil {
+		last = last.next
+	}
+	last.next = next
+	return next
+}
+
+// Stop halts the tween where it is; tweens queued after it don't start.
+// Stopping a finished tween of a sequence stops the rest of the sequence.
+func (t *Tween) Stop() {
+	for s := t; s != nil; s = s.next {
+		s.stopped = true
+	}
+}
+
+// Done reports whether the tween reached its target.
+func (t *Tween) Done() bool {
+	return t.done
+}
+
+// advance moves the tween forward, returning time left over after it
+// completes (0 while running).
+func (t *Tween) advance(dt float64) float64 {
+	if t.delay > 0 {
+		if dt < t.delay {
+			t.delay -= dt
+			return 0
+		}
+		dt -= t.delay
+		t.delay = 0
+	}
+	if !t.started {
+		t.started = true
+		if t.begin != nil {
+			t.begin()
+		}
+	}
+	t.elapsed += dt
+	if t.elapsed+timeEpsilon >= t.duration {
+		if t.apply != nil {
+			t.apply(1)
+		}
+		t.done = true
+		return t.elapsed - t.duration
+	}
+	if t.apply != nil {
+		t.apply(t.easing(t.elapsed / t.duration))
+	}
+	return 0
+}
+
+// Manager runs tweens.
+type Manager struct {
+	active []*Tween
+}
+
+// New creates a manager advanced by calling Tick (or by attaching it to an
+// entity as a core.Behavior).
+func New() *Manager {
+	return &Manager{}
+}
+
+// Attach creates a manager advanced by a scene's fixed update.
+//
+// Tweens freeze while the engine is paused and stop with the scene.
+func Attach(scene *core.Scene) *Manager {
+	m := New()
+	scene.Scheduler().OnUpdate(m.Tick)
+	return m
+}
+
+// Start runs a tween (and anything queued after it with Then).
+func (m *Manager) Start(t *Tween) *Tween {
+	m.active = append(m.active, t)
+	return t
+}
+
+// Tween starts a float tween (see Float).
+//
+// Example:
+//
+//	tweens.Tween(&camera.Zoom, 2, 500*time.Millisecond, tween.QuadInOut)
+func (m *Manager) Tween(target *float64, to float64, duration time.Duration, easing Easing) *Tween {
+	return m.Start(Float(target, to, duration, easing))
+}
+
+// Sequence chains tweens to run one after another and starts the first
+//
+// Returns:
+//
+//	*Tween: The first tween (Stop it to cancel the rest)
+func (m *Manager) Sequence(tweens ...*Tween) *Tween {
+	if len(tweens) == 0 {
+		return nil
+	}
+	for i := 1; i < len(tweens); i++ {
+		tweens[i-1].next = tweens[i]
+	}
+	return m.Start(tweens[0])
+}
+
+// Tick advances every running tween by dt seconds
+//
+// Behavior:
+//   - A tween that completes mid-step hands the rest of the step to the
+//     next tween in its sequence
+//   - Tweens started during Tick (e.g. from OnComplete) first advance on
+//     the next Tick
+func (m *Manager) Tick(dt float64) {
+	count := len(m.active)
+	for i := 0; i < count; i++ {
+		t := m.active[i]
+		remaining := dt
+		for t != nil && !t.stopped {
+			remaining = t.advance(remaining)
+			if !t.done {
+				break
+			}
+			if t.onComplete != nil {
+				t.onComplete()
+			}
+			t = t.next
+		}
+		m.active[i] = t
+	}
+
+	live := m.active[:0]
+	for _, t := range m.active {
+		if t != nil && !t.stopped {
+			live = append(live, t)
+		}
+	}
+	clear(m.active[len(live):])
+	m.active = live
+}
+
+// Update implements core.Behavior by advancing tweens.
+func (m *Manager) Update(_ *core.Entity, dt float64) {
+	m.Tick(dt)
+}
+
+// Len returns the number of running tweens (a sequence counts once).
+func (m *Manager) Len() int {
+	count := 0
+	for _, t := range m.active {
+		if t != nil && !t.stopped {
+			count++
+		}
+	}
+	return count
+}
+
+// StopAll stops every running tween (they are dropped on the next Tick).
+func (m *Manager) StopAll() {
+	for _, t := range m.active {
+		if t != nil {
+			t.Stop()
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Button is a clickable box with centered text.
+type Button struct {
+	Element
+	Text          string
+	Font          *graphics.Font
+	TextColor     gamemath.Color
+	Color         gamemath.Color // Idle background
+	HoverColor    gamemath.Color // Background under the pointer
+	PressedColor  gamemath.Color // Background while held
+	DisabledColor gamemath.Color // Background when Disabled
+	BorderColor   gamemath.Color // Outline (Transparent = none)
+	Disabled      bool           // Ignores the pointer and never clicks
+	OnClick       func()
+
+	hovered bool
+	pressed bool
+	text    textCache
+}
+
+// NewButton creates a 120x32 button.
+//
+// Parameters:
+//
+//	text: Caption
+//	font: Caption font (nil = no caption)
+//	onClick: Called when the button is clicked (may be nil)
+//
+// Example:
+//
+//	quit := ui.NewButton("Quit", font, engine.Stop)
+//	quit.Anchor = ui.AnchorCenter
+//	engine.UI().Add(quit)
+func NewButton(text string, font *graphics.Font, onClick func()) *Button {
+	return &Button{
+		Element:       Element{Size: gamemath.Vector2{X: 120, Y: 32}},
+		Text:          text,
+		Font:          font,
+		TextColor:     gamemath.White,
+		Color:         gamemath.Color{R: 60, G: 70, B: 90, A: 255},
+		HoverColor:    gamemath.Color{R: 80, G: 95, B: 125, A: 255},
+		PressedColor:  gamemath.Color{R: 40, G: 50, B: 70, A: 255},
+		DisabledColor: gamemath.Color{R: 60, G: 60, B: 60, A: 160},
+		BorderColor:   gamemath.Color{R: 255, G: 255, B: 255, A: 90},
+		OnClick:       onClick,
+	}
+}
+
+// Hovered reports whether the pointer is over the button.
+func (b *Button) Hovered() bool {
+	return b.hovered && !b.Disabled
+}
+
+// Pressed reports whether the button is being held down.
+func (b *Button) Pressed() bool {
+	return b.pressed && !b.Disabled
+}
+
+// OnPointer updates hover and press state and fires OnClick.
+func (b *Button) OnPointer(event PointerEvent) {
+	switch event {
+	case PointerEnter:
+		b.hovered = true
+	case PointerLeave:
+		b.hovered = false
+	case PointerDown:
+		b.pressed = true
+	case PointerUp:
+		b.pressed = false
+	case PointerClick:
+		if !b.Disabled && b.OnClick != nil {
+			b.OnClick()
+		}
+	}
+}
+
+// BackgroundColor returns the background for the current state.
+func (b *Button) BackgroundColor() gamemath.Color {
+	switch {
+	case b.Disabled:
+		return b.DisabledColor
+	case b.Pressed():
+		return b.PressedColor
+	case b.Hovered():
+		return b.HoverColor
+	}
+	return b.Color
+}
+
+// Draw draws the background, border, and centered caption.
+func (b *Button) Draw(renderer *graphics.Renderer) error {
+	if err := renderer.FillRect(b.rect, b.BackgroundColor()); err != nil {
+		return err
+	}
+	if b.BorderColor.A > 0 {
+		if err := renderer.DrawRect(b.rect, b.BorderColor); err != nil {
+			return err
+		}
+	}
+	size := measureText(b.Font, b.Text)
+	x := b.rect.X + (b.rect.Width-size.X)/2
+	y := b.rect.Y + (b.rect.Height-size.Y)/2
+	return b.text.draw(renderer, b.Font, b.Text, b.TextColor, x, y)
+}
+
+// Destroy releases the cached caption texture.
+func (b *Button) Destroy() {
+	b.text.destroy()
+}
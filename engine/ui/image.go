@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Image draws a texture (or a region of one) stretched to its rectangle.
+type Image struct {
+	Element
+	Texture *graphics.Texture
+	Source  gamemath.Rectangle // Texture region (zero = whole texture)
+	Tint    gamemath.Color     // Color and alpha modulation (White = unchanged)
+}
+
+// NewImage creates an image sized to its texture.
+//
+// Example:
+//
+//	portrait := ui.NewImage(assets.MustLoad("ui/portrait.png"))
+//	portrait.Source = gamemath.Rectangle{Width: 64, Height: 64} // First frame
+//	portrait.Size = gamemath.Vector2{X: 64, Y: 64}
+func NewImage(texture *graphics.Texture) *Image {
+	image := &Image{Texture: texture, Tint: gamemath.White}
+	if texture != nil {
+		image.Size = gamemath.Vector2{X: float64(texture.Width), Y: float64(texture.Height)}
+	}
+	return image
+}
+
+// Draw copies the texture into the image rectangle.
+func (i *Image) Draw(renderer *graphics.Renderer) error {
+	if i.Texture == nil || i.Texture.GetSDLTexture() == nil {
+		return nil
+	}
+	texture := i.Texture.GetSDLTexture()
+	if err := texture.SetColorMod(i.Tint.R, i.Tint.G, i.Tint.B); err != nil {
+		return fmt.Errorf("failed to set color mod: %w", err)
+	}
+	if err := texture.SetAlphaMod(i.Tint.A); err != nil {
+		return fmt.Errorf("failed to set alpha mod: %w", err)
+	}
+	var src *sdl.Rect
+	if i.Source != (gamemath.Rectangle{}) {
+		src = &sdl.Rect{X: int32(i.Source.X), Y: int32(i.Source.Y), W: int32(i.Source.Width), H: int32(i.Source.Height)}
+	}
+	dst := sdl.Rect{X: int32(i.rect.X), Y: int32(i.rect.Y), W: int32(i.rect.Width), H: int32(i.rect.Height)}
+	if err := renderer.GetSDLRenderer().Copy(texture, src, &dst); err != nil {
+		return fmt.Errorf("failed to draw image: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Label draws a line of text.
+type Label struct {
+	Element
+	Text  string
+	Font  *graphics.Font
+	Color gamemath.Color
+
+	text textCache
+}
+
+// NewLabel creates a white label sized to its text.
+//
+// Example:
+//
+//	score := ui.NewLabel("Score: 0", font)
+//	score.Offset = gamemath.Vector2{X: 10, Y: 10}
+//	engine.UI().Add(score)
+//	// Later: score.Text = fmt.Sprintf("Score: %d", points)
+func NewLabel(text string, font *graphics.Font) *Label {
+	return &Label{Text: text, Font: font, Color: gamemath.White}
+}
+
+// Draw draws the text at the label's top-left corner.
+func (l *Label) Draw(renderer *graphics.Renderer) error {
+	return l.text.draw(renderer, l.Font, l.Text, l.Color, l.rect.X, l.rect.Y)
+}
+
+// measure returns Size, or the text size when Size is zero.
+func (l *Label) measure() gamemath.Vector2 {
+	if l.Size != (gamemath.Vector2{}) {
+		return l.Size
+	}
+	return measureText(l.Font, l.Text)
+}
+
+// Destroy releases the cached text texture.
+func (l *Label) Destroy() {
+	l.text.destroy()
+}
+
+// measureText returns the rendered size of text (zero without a font).
+func measureText(font *graphics.Font, text string) gamemath.Vector2 {
+	if font == nil || text == "" {
+		return gamemath.Vector2{}
+	}
+	width, height, err := font.MeasureText(text)
+	if err != nil {
+		return gamemath.Vector2{}
+	}
+	return gamemath.Vector2{X: float64(width), Y: float64(height)}
+}
+
+// textCache keeps a widget's rasterized text until the text or font
+// changes. Text is rendered white and tinted when drawn, so color changes
+// (hover states, fades) don't re-render.
+type textCache struct {
+	texture       *sdl.Texture
+	width, height int32
+	text          string
+	font          *graphics.Font
+}
+
+// draw draws text with its top-left corner at x, y.
+func (c *textCache) draw(renderer *graphics.Renderer, font *graphics.Font, text string, color gamemath.Color, x, y float64) error {
+	if font == nil || text == "" {
+		return nil
+	}
+	if c.texture == nil || c.text != text || c.font != font {
+		c.destroy()
+		texture, width, height, err := font.RenderText(renderer.GetSDLRenderer(), text, gamemath.White)
+		if err != nil {
+			return err
+		}
+		c.texture, c.width, c.height, c.text, c.font = texture, width, height, text, font
+	}
+	_ = c.texture.SetColorMod(color.R, color.G, color.B) // Best effort tint
+	_ = c.texture.SetAlphaMod(color.A)                   // Best effort tint
+	dst := sdl.Rect{X: int32(x), Y: int32(y), W: c.width, H: c.height}
+	return renderer.GetSDLRenderer().Copy(c.texture, nil, &dst)
+}
+
+// destroy releases the cached texture.
+func (c *textCache) destroy() {
+	if c.texture != nil {
+		_ = c.texture.Destroy() // Best effort cleanup
+		c.texture = nil
+	}
+}
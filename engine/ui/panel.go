@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Layout arranges a panel's children.
+type Layout int
+
+const (
+	// LayoutNone places each child by its own Anchor and Offset.
+	LayoutNone Layout = iota
+	// LayoutVertical stacks children top to bottom.
+	LayoutVertical
+	// LayoutHorizontal stacks children left to right.
+	LayoutHorizontal
+)
+
+// Panel is a rectangle that groups and optionally stacks its children.
+//
+// In a stacking layout, children are placed one after another inside the
+// padding (their Offset nudges them; Anchor is ignored). A stacking panel
+// with zero Size grows to fit its children; a zero width or height alone
+// is fitted on that axis.
+type Panel struct {
+	Element
+	Color       gamemath.Color // Background (Transparent = none)
+	BorderColor gamemath.Color // Outline (Transparent = none)
+	Layout      Layout
+	Padding     float64 // Inset from the edges to the children
+	Spacing     float64 // Gap between stacked children
+}
+
+// NewPanel creates a translucent dark panel.
+//
+// Parameters:
+//
+//	width, height: Size in pixels (0 = fit children when stacking)
+func NewPanel(width, height float64) *Panel {
+	return &Panel{
+		Element:     Element{Size: gamemath.Vector2{X: width, Y: height}},
+		Color:       gamemath.Color{R: 20, G: 20, B: 30, A: 200},
+		BorderColor: gamemath.Color{R: 255, G: 255, B: 255, A: 60},
+		Padding:     8,
+		Spacing:     6,
+	}
+}
+
+// Draw fills and outlines the panel.
+func (p *Panel) Draw(renderer *graphics.Renderer) error {
+	if p.Color.A > 0 {
+		if err := renderer.FillRect(p.rect, p.Color); err != nil {
+			return err
+		}
+	}
+	if p.BorderColor.A > 0 {
+		return renderer.DrawRect(p.rect, p.BorderColor)
+	}
+	return nil
+}
+
+// measure returns the panel size with zero axes fitted to the stacked
+// children.
+func (p *Panel) measure() gamemath.Vector2 {
+	size := p.Size
+	if p.Layout == LayoutNone || (size.X > 0 && size.Y > 0) {
+		return size
+	}
+	var along, across float64
+	count := 0
+	for _, child := range p.children {
+		if child.Base().Hidden {
+			continue
+		}
+		childSize := sizeOf(child)
+		main, cross := childSize.Y, childSize.X
+		if p.Layout == LayoutHorizontal {
+			main, cross = childSize.X, childSize.Y
+		}
+		along += main
+		across = max(across, cross)
+		count++
+	}
+	if count > 1 {
+		along += p.Spacing * float64(count-1)
+	}
+	fitted := gamemath.Vector2{X: across, Y: along}
+	if p.Layout == LayoutHorizontal {
+		fitted = gamemath.Vector2{X: along, Y: across}
+	}
+	if size.X <= 0 {
+		size.X = fitted.X + p.Padding*2
+	}
+	if size.Y <= 0 {
+		size.Y = fitted.Y + p.Padding*2
+	}
+	return size
+}
+
+// stack places visible children one after another.
+func (p *Panel) stack() {
+	cursor := gamemath.Vector2{X: p.rect.X + p.Padding, Y: p.rect.Y + p.Padding}
+	for _, child := range p.children {
+		element := child.Base()
+		if element.Hidden {
+			continue
+		}
+		size := sizeOf(child)
+		element.rect = gamemath.Rectangle{
+			X:      cursor.X + element.Offset.X,
+			Y:      cursor.Y + element.Offset.Y,
+			Width:  size.X,
+			Height: size.Y,
+		}
+		layoutChildren(child)
+		if p.Layout == LayoutHorizontal {
+			cursor.X += size.X + p.Spacing
+		} else {
+			cursor.Y += size.Y + p.Spacing
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// ProgressBar shows a value between 0 and 1 as a partly filled bar.
+type ProgressBar struct {
+	Element
+	Value           float64        // Fill amount, clamped to [0, 1] when drawn
+	Vertical        bool           // Fill bottom to top instead of left to right
+	Color           gamemath.Color // Filled portion
+	BackgroundColor gamemath.Color // Empty portion
+	BorderColor     gamemath.Color // Outline (Transparent = none)
+}
+
+// NewProgressBar creates an empty green bar.
+//
+// Example:
+//
+//	health := ui.NewProgressBar(200, 12)
+//	health.Value = float64(hp) / float64(maxHP)
+func NewProgressBar(width, height float64) *ProgressBar {
+	return &ProgressBar{
+		Element:         Element{Size: gamemath.Vector2{X: width, Y: height}},
+		Color:           gamemath.Color{R: 80, G: 200, B: 90, A: 255},
+		BackgroundColor: gamemath.Color{R: 30, G: 30, B: 30, A: 200},
+		BorderColor:     gamemath.Color{R: 255, G: 255, B: 255, A: 90},
+	}
+}
+
+// Fraction returns Value clamped to [0, 1].
+func (p *ProgressBar) Fraction() float64 {
+	if math.IsNaN(p.Value) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, p.Value))
+}
+
+// FillRect returns the screen rectangle of the filled portion.
+func (p *ProgressBar) FillRect() gamemath.Rectangle {
+	fill := p.rect
+	if p.Vertical {
+		fill.Height = p.rect.Height * p.Fraction()
+		fill.Y = p.rect.Y + p.rect.Height - fill.Height
+	} else {
+		fill.Width = p.rect.Width * p.Fraction()
+	}
+	return fill
+}
+
+// Draw draws the background, fill, and border.
+func (p *ProgressBar) Draw(renderer *graphics.Renderer) error {
+	if err := renderer.FillRect(p.rect, p.BackgroundColor); err != nil {
+		return err
+	}
+	if fill := p.FillRect(); fill.Width > 0 && fill.Height > 0 {
+		if err := renderer.FillRect(fill, p.Color); err != nil {
+			return err
+		}
+	}
+	if p.BorderColor.A > 0 {
+		return renderer.DrawRect(p.rect, p.BorderColor)
+	}
+	return nil
+}
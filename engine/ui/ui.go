@@ -0,0 +1,132 @@
+// Package ui provides screen-space widgets: labels, buttons, panels,
+// images, and progress bars arranged in a tree.
+//
+// Widgets are anchored to their parent (the screen for top-level widgets)
+// and ignore the camera, so HUDs stay put while the world scrolls. The
+// engine lays out, routes mouse input to, and draws its UI every frame:
+//
+//	hud := ui.NewPanel(220, 0)
+//	hud.Anchor = ui.AnchorTopRight
+//	hud.Offset = gamemath.Vector2{X: -10, Y: 10}
+//	hud.Layout = ui.LayoutVertical
+//	health := ui.NewProgressBar(200, 12)
+//	hud.Add(ui.NewLabel("Health", font), health)
+//	hud.Add(ui.NewButton("Pause", font, func() { paused = true }))
+//	engine.UI().Add(hud)
+package ui
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// UI is the root of a widget tree covering the screen.
+type UI struct {
+	Element
+
+	hovered Interactive // Interactive widget under the pointer
+	pressed Interactive // Widget that received PointerDown
+	wasDown bool        // Left button state last frame
+	over    bool        // Pointer is over any visible widget
+}
+
+// New creates an empty UI.
+func New() *UI {
+	return &UI{}
+}
+
+// Layout positions every widget for a screen size.
+//
+// Parameters:
+//
+//	width, height: Screen size in pixels
+//
+// Behavior:
+//   - Called by the engine each frame; call it yourself when driving a UI
+//     outside the engine (or to read Rect() before the first frame)
+func (u *UI) Layout(width, height int) {
+	u.rect = gamemath.Rectangle{Width: float64(width), Height: float64(height)}
+	layoutChildren(u)
+}
+
+// Update routes the mouse from the input manager (see HandlePointer).
+func (u *UI) Update(inputMgr *input.InputManager) {
+	x, y := inputMgr.MousePosition()
+	u.HandlePointer(float64(x), float64(y), inputMgr.KeyHeld(input.KeyMouseLeft))
+}
+
+// HandlePointer delivers one frame of pointer state to the widgets
+//
+// Parameters:
+//
+//	x, y: Pointer position in screen pixels
+//	down: True while the left button is held
+//
+// Behavior:
+//   - The topmost Interactive widget under the pointer gets Enter/Leave
+//   - A press sends PointerDown to it; the matching release sends PointerUp
+//     to the same widget, plus PointerClick if still over it
+func (u *UI) HandlePointer(x, y float64, down bool) {
+	top, target := hitTest(u.children, x, y)
+	u.over = top != nil
+
+	if target != u.hovered {
+		if u.hovered != nil {
+			u.hovered.OnPointer(PointerLeave)
+		}
+		if target != nil {
+			target.OnPointer(PointerEnter)
+		}
+		u.hovered = target
+	}
+
+	pressed, released := down && !u.wasDown, !down && u.wasDown
+	u.wasDown = down
+	if pressed && target != nil {
+		u.pressed = target
+		target.OnPointer(PointerDown)
+	}
+	if released && u.pressed != nil {
+		widget := u.pressed
+		u.pressed = nil
+		widget.OnPointer(PointerUp)
+		if widget == target {
+			widget.OnPointer(PointerClick)
+		}
+	}
+}
+
+// WantsMouse reports whether the pointer is over a widget, so gameplay can
+// ignore clicks meant for the UI.
+//
+// Example:
+//
+//	if input.KeyPressed(input.KeyMouseLeft) && !engine.UI().WantsMouse() {
+//	    fire()
+//	}
+func (u *UI) WantsMouse() bool {
+	return u.over || u.pressed != nil
+}
+
+// Hovered returns the Interactive widget under the pointer (nil if none).
+func (u *UI) Hovered() Interactive {
+	return u.hovered
+}
+
+// Draw draws every visible widget in tree order.
+func (u *UI) Draw(renderer *graphics.Renderer) error {
+	for _, widget := range u.children {
+		if err := drawTree(renderer, widget); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Destroy releases text textures held by every widget.
+func (u *UI) Destroy() {
+	for _, widget := range u.children {
+		destroyTree(widget)
+	}
+}
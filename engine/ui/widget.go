@@ -0,0 +1,195 @@
+package ui
+
+import (
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Anchor selects the point of the parent a widget is attached to.
+//
+// The same point on the widget is placed there, then moved by Offset:
+// AnchorBottomRight with Offset (-10, -10) keeps a widget 10 pixels in from
+// the parent's bottom-right corner at any screen size.
+type Anchor int
+
+const (
+	AnchorTopLeft Anchor = iota
+	AnchorTop
+	AnchorTopRight
+	AnchorLeft
+	AnchorCenter
+	AnchorRight
+	AnchorBottomLeft
+	AnchorBottom
+	AnchorBottomRight
+)
+
+// factors returns the anchor's position within a rectangle (0, 0.5, or 1 per axis).
+func (a Anchor) factors() (fx, fy float64) {
+	return float64(a%3) / 2, float64(a/3) / 2
+}
+
+// Widget is a node in the UI tree.
+//
+// Widgets embed Element for layout and children, and draw themselves within
+// Rect(). Children are drawn after (on top of) their parent.
+type Widget interface {
+	Base() *Element
+	Draw(renderer *graphics.Renderer) error
+}
+
+// PointerEvent is a mouse interaction delivered to an Interactive widget.
+type PointerEvent int
+
+const (
+	PointerEnter PointerEvent = iota // Pointer moved over the widget
+	PointerLeave                     // Pointer moved off the widget
+	PointerDown                      // Left button pressed over the widget
+	PointerUp                        // Left button released after PointerDown (anywhere)
+	PointerClick                     // Released over the same widget that got PointerDown
+)
+
+// Interactive is a widget that receives pointer events (see Button).
+type Interactive interface {
+	Widget
+	OnPointer(event PointerEvent)
+}
+
+// measurer is a widget that computes its own size (respecting Size).
+type measurer interface {
+	measure() gamemath.Vector2
+}
+
+// destroyer is a widget holding resources released by UI.Destroy.
+type destroyer interface {
+	Destroy()
+}
+
+// Element holds the layout state and children shared by all widgets.
+type Element struct {
+	Anchor Anchor           // Attachment point on the parent
+	Offset gamemath.Vector2 // Pixels from the anchor point
+	Size   gamemath.Vector2 // Width and height in pixels (zero = widget's own size)
+	Hidden bool             // Hidden widgets and their children are skipped
+
+	children []Widget
+	rect     gamemath.Rectangle // Screen rectangle from the last layout
+}
+
+// Base returns the element itself (satisfying Widget for embedders).
+func (e *Element) Base() *Element {
+	return e
+}
+
+// Add appends children; later children are drawn on top.
+func (e *Element) Add(children ...Widget) {
+	e.children = append(e.children, children...)
+}
+
+// Remove detaches a child (its resources are not released).
+func (e *Element) Remove(child Widget) {
+	for i, c := range e.children {
+		if c == child {
+			e.children = append(e.children[:i:i], e.children[i+1:]...)
+			return
+		}
+	}
+}
+
+// Children returns the element's children in draw order.
+func (e *Element) Children() []Widget {
+	return e.children
+}
+
+// Rect returns the widget's screen rectangle as of the last UI.Layout.
+func (e *Element) Rect() gamemath.Rectangle {
+	return e.rect
+}
+
+// sizeOf returns a widget's explicit or measured size.
+func sizeOf(widget Widget) gamemath.Vector2 {
+	if m, ok := widget.(measurer); ok {
+		return m.measure()
+	}
+	return widget.Base().Size
+}
+
+// place positions a widget by its anchor within a parent rectangle, then
+// lays out its children.
+func place(widget Widget, parent gamemath.Rectangle) {
+	element := widget.Base()
+	size := sizeOf(widget)
+	fx, fy := element.Anchor.factors()
+	element.rect = gamemath.Rectangle{
+		X:      parent.X + (parent.Width-size.X)*fx + element.Offset.X,
+		Y:      parent.Y + (parent.Height-size.Y)*fy + element.Offset.Y,
+		Width:  size.X,
+		Height: size.Y,
+	}
+	layoutChildren(widget)
+}
+
+// layoutChildren places a widget's children (stacked for stacking panels).
+func layoutChildren(widget Widget) {
+	element := widget.Base()
+	if panel, ok := widget.(*Panel); ok && panel.Layout != LayoutNone {
+		panel.stack()
+		return
+	}
+	for _, child := range element.children {
+		if !child.Base().Hidden {
+			place(child, element.rect)
+		}
+	}
+}
+
+// drawTree draws a widget, then its children.
+func drawTree(renderer *graphics.Renderer, widget Widget) error {
+	if widget.Base().Hidden {
+		return nil
+	}
+	if err := widget.Draw(renderer); err != nil {
+		return err
+	}
+	for _, child := range widget.Base().children {
+		if err := drawTree(renderer, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hitTest returns the topmost visible widget containing a point and the
+// topmost Interactive widget on that path.
+func hitTest(widgets []Widget, x, y float64) (top Widget, interactive Interactive) {
+	for i := len(widgets) - 1; i >= 0; i-- {
+		widget := widgets[i]
+		element := widget.Base()
+		if element.Hidden {
+			continue
+		}
+		// Children draw on top of their parent, so test them first
+		if top, interactive = hitTest(element.children, x, y); top != nil {
+			if interactive == nil {
+				interactive, _ = widget.(Interactive)
+			}
+			return top, interactive
+		}
+		rect := element.rect
+		if x >= rect.X && x < rect.X+rect.Width && y >= rect.Y && y < rect.Y+rect.Height {
+			interactive, _ = widget.(Interactive)
+			return widget, interactive
+		}
+	}
+	return nil, nil
+}
+
+// destroyTree releases resources held by a widget and its children.
+func destroyTree(widget Widget) {
+	for _, child := range widget.Base().children {
+		destroyTree(child)
+	}
+	if d, ok := widget.(destroyer); ok {
+		d.Destroy()
+	}
+}
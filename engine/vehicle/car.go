@@ -0,0 +1,183 @@
+// Package vehicle provides an arcade top-down car controller.
+package vehicle
+
+import (
+	"math"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// SurfaceFunc returns the ground material at a world position (e.g. from a tilemap).
+type SurfaceFunc func(position gamemath.Vector2) *physics.Material
+
+// Car is a Behavior implementing arcade top-down driving.
+//
+// The entity's Transform.Rotation is the heading in degrees (0 = facing +X).
+// Velocity is split into forward and sideways parts each step; sideways
+// velocity is removed by grip, so lowering grip (handbrake, ice) lets the car
+// slide into drifts.
+type Car struct {
+	// Tuning
+	MaxSpeed     float64 // Top forward speed in pixels per second
+	MaxReverse   float64 // Top reverse speed in pixels per second
+	Acceleration float64 // Forward acceleration in pixels per second squared
+	BrakeForce   float64 // Deceleration when braking in pixels per second squared
+	Drag         float64 // Rolling resistance per second (fraction of speed)
+	TurnRate     float64 // Degrees per second at or above TurnSpeed
+	TurnSpeed    float64 // Speed at which full turn rate is reached
+	Grip         float64 // Sideways velocity removed per second (higher = less sliding)
+	DriftGrip    float64 // Grip while the handbrake is held
+	DriftAngle   float64 // Slip threshold in pixels per second for Drifting()
+
+	// Input (optional; leave nil and call SetControls for AI/network cars)
+	Input            *input.InputManager
+	AccelerateAction input.Action
+	BrakeAction      input.Action
+	LeftAction       input.Action
+	RightAction      input.Action
+	HandbrakeAction  input.Action
+
+	// Surface reports the ground material under the car (nil = DefaultMaterial)
+	Surface SurfaceFunc
+
+	Velocity gamemath.Vector2 // Current world velocity
+
+	throttle  float64
+	steer     float64
+	handbrake bool
+	slip      float64
+}
+
+// NewCar creates a car with arcade defaults bound to the movement actions.
+//
+// Example:
+//
+//	car := vehicle.NewCar(engine.Input())
+//	car.Surface = func(pos gamemath.Vector2) *physics.Material { return track.MaterialAt(pos) }
+//	player.Behavior = car
+func NewCar(im *input.InputManager) *Car {
+	return &Car{
+		MaxSpeed:         400,
+		MaxReverse:       120,
+		Acceleration:     300,
+		BrakeForce:       600,
+		Drag:             0.5,
+		TurnRate:         180,
+		TurnSpeed:        120,
+		Grip:             8,
+		DriftGrip:        1.5,
+		DriftAngle:       60,
+		Input:            im,
+		AccelerateAction: input.ActionMoveUp,
+		BrakeAction:      input.ActionMoveDown,
+		LeftAction:       input.ActionMoveLeft,
+		RightAction:      input.ActionMoveRight,
+		HandbrakeAction:  input.ActionJump,
+	}
+}
+
+// SetControls sets driving input directly.
+//
+// Parameters:
+//
+//	throttle: -1 (brake/reverse) to 1 (full throttle)
+//	steer: -1 (left) to 1 (right)
+//	handbrake: True to reduce grip for drifting
+func (c *Car) SetControls(throttle, steer float64, handbrake bool) {
+	c.throttle = clamp(throttle, -1, 1)
+	c.steer = clamp(steer, -1, 1)
+	c.handbrake = handbrake
+}
+
+// Speed returns the signed forward speed.
+func (c *Car) Speed(entity *core.Entity) float64 {
+	return c.Velocity.Dot(heading(entity.Transform.Rotation))
+}
+
+// Drifting reports whether the car slid sideways faster than DriftAngle last step.
+func (c *Car) Drifting() bool {
+	return c.slip > c.DriftAngle
+}
+
+// Update applies controls and integrates the car (implements core.Behavior).
+func (c *Car) Update(entity *core.Entity, dt float64) {
+	if c.Input != nil {
+		c.readInput()
+	}
+
+	friction := physics.DefaultMaterial.Friction
+	if c.Surface != nil {
+		if material := c.Surface(entity.Transform.Position); material != nil {
+			friction = material.Friction
+		}
+	}
+
+	forward := heading(entity.Transform.Rotation)
+	speed := c.Velocity.Dot(forward)
+	lateral := c.Velocity.Sub(forward.Scale(speed))
+
+	// Throttle, brake, and reverse (traction limited by surface friction)
+	switch {
+	case c.throttle > 0:
+		speed += c.Acceleration * c.throttle * math.Min(friction, 1) * dt
+	case c.throttle < 0 && speed > 0:
+		speed = math.Max(0, speed+c.BrakeForce*c.throttle*math.Min(friction, 1)*dt)
+	case c.throttle < 0:
+		speed += c.Acceleration * c.throttle * math.Min(friction, 1) * dt
+	}
+	speed -= speed * c.Drag * dt
+	speed = clamp(speed, -c.MaxReverse, c.MaxSpeed)
+
+	// Steering scales with speed so the car can't spin in place
+	if c.steer != 0 && c.TurnSpeed > 0 {
+		factor := math.Min(math.Abs(speed)/c.TurnSpeed, 1)
+		direction := 1.0
+		if speed < 0 {
+			direction = -1
+		}
+		entity.Transform.Rotation += c.steer * c.TurnRate * factor * direction * dt
+		forward = heading(entity.Transform.Rotation)
+	}
+
+	// Grip removes sideways velocity; handbrake and slippery surfaces keep more of it
+	grip := c.Grip
+	if c.handbrake {
+		grip = c.DriftGrip
+	}
+	lateral = lateral.Scale(math.Max(0, 1-grip*friction*dt))
+	c.slip = lateral.Length()
+
+	c.Velocity = forward.Scale(speed).Add(lateral)
+	entity.Transform.Position = entity.Transform.Position.Add(c.Velocity.Scale(dt))
+}
+
+// readInput converts held actions to controls.
+func (c *Car) readInput() {
+	throttle, steer := 0.0, 0.0
+	if c.Input.ActionHeld(c.AccelerateAction) {
+		throttle++
+	}
+	if c.Input.ActionHeld(c.BrakeAction) {
+		throttle--
+	}
+	if c.Input.ActionHeld(c.LeftAction) {
+		steer--
+	}
+	if c.Input.ActionHeld(c.RightAction) {
+		steer++
+	}
+	c.SetControls(throttle, steer, c.Input.ActionHeld(c.HandbrakeAction))
+}
+
+// heading returns the unit forward vector for a rotation in degrees.
+func heading(degrees float64) gamemath.Vector2 {
+	radians := degrees * math.Pi / 180
+	return gamemath.Vector2{X: math.Cos(radians), Y: math.Sin(radians)}
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
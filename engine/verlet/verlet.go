@@ -0,0 +1,227 @@
+// Package verlet provides verlet-integrated ropes and cloth (point masses and distance constraints).
+package verlet
+
+import (
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// Point is a simulated point mass.
+type Point struct {
+	Position gamemath.Vector2 // Current world position
+	Previous gamemath.Vector2 // Position last step (velocity is implicit)
+	Pinned   bool             // Pinned points don't move
+}
+
+// Constraint keeps two points at a fixed distance.
+type Constraint struct {
+	A, B      int     // Point indices
+	Length    float64 // Rest length
+	Stiffness float64 // Correction per iteration (0..1, 1 = rigid)
+	Broken    bool    // Broken constraints are ignored (see TearLength)
+}
+
+// Anchor pins a point to an entity so it follows the entity every step.
+type Anchor struct {
+	Point  int
+	Entity *core.Entity
+	Offset gamemath.Vector2 // Offset from the entity position
+}
+
+// Body is a Behavior simulating a set of points and constraints.
+type Body struct {
+	Points      []*Point
+	Constraints []*Constraint
+	Anchors     []Anchor
+	Gravity     gamemath.Vector2 // Acceleration in pixels per second squared
+	Damping     float64          // Velocity retained per step (e.g. 0.99)
+	Iterations  int              // Constraint solver passes per step (more = stiffer)
+	TearLength  float64          // Stretch ratio that breaks constraints (0 = never tear)
+
+	// Collide is called for each unpinned point after solving (e.g. push out of terrain).
+	Collide func(point *Point)
+}
+
+// NewBody creates an empty body with default gravity, damping, and iterations.
+func NewBody() *Body {
+	return &Body{
+		Points:      make([]*Point, 0),
+		Constraints: make([]*Constraint, 0),
+		Gravity:     gamemath.Vector2{X: 0, Y: 980},
+		Damping:     0.99,
+		Iterations:  8,
+	}
+}
+
+// AddPoint adds a point and returns its index.
+func (b *Body) AddPoint(position gamemath.Vector2, pinned bool) int {
+	b.Points = append(b.Points, &Point{Position: position, Previous: position, Pinned: pinned})
+	return len(b.Points) - 1
+}
+
+// Connect adds a rigid constraint at the points' current distance.
+func (b *Body) Connect(a, c int) *Constraint {
+	constraint := &Constraint{
+		A:         a,
+		B:         c,
+		Length:    b.Points[a].Position.Distance(b.Points[c].Position),
+		Stiffness: 1,
+	}
+	b.Constraints = append(b.Constraints, constraint)
+	return constraint
+}
+
+// NewRope creates a rope from start to end with the first point pinned.
+//
+// Parameters:
+//
+//	start, end: Rope endpoints in world space
+//	segments: Number of segments (points = segments + 1)
+//
+// Example:
+//
+//	rope := verlet.NewRope(hookPos, playerPos, 20)
+//	rope.Anchors = append(rope.Anchors, verlet.Anchor{Point: len(rope.Points) - 1, Entity: player})
+//	ropeEntity := &core.Entity{Active: true, Behavior: rope}
+//	scene.AddEntity(ropeEntity)
+//	// In render callback:
+//	rope.Render(engine.Renderer(), scene.Camera(), gamemath.White)
+func NewRope(start, end gamemath.Vector2, segments int) *Body {
+	if segments < 1 {
+		segments = 1
+	}
+	body := NewBody()
+	for i := 0; i <= segments; i++ {
+		t := float64(i) / float64(segments)
+		body.AddPoint(gamemath.Vector2{
+			X: start.X + (end.X-start.X)*t,
+			Y: start.Y + (end.Y-start.Y)*t,
+		}, i == 0)
+		if i > 0 {
+			body.Connect(i-1, i)
+		}
+	}
+	return body
+}
+
+// NewCloth creates a grid of points hanging from its pinned top row.
+//
+// Parameters:
+//
+//	origin: Top-left world position
+//	cols, rows: Grid size in points
+//	spacing: Distance between neighboring points
+//
+// Returns:
+//
+//	*Body: Cloth with point index row*cols + col
+func NewCloth(origin gamemath.Vector2, cols, rows int, spacing float64) *Body {
+	body := NewBody()
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			index := body.AddPoint(gamemath.Vector2{
+				X: origin.X + float64(col)*spacing,
+				Y: origin.Y + float64(row)*spacing,
+			}, row == 0)
+			if col > 0 {
+				body.Connect(index-1, index)
+			}
+			if row > 0 {
+				body.Connect(index-cols, index)
+			}
+		}
+	}
+	return body
+}
+
+// Update integrates points and solves constraints (implements core.Behavior).
+func (b *Body) Update(_ *core.Entity, dt float64) {
+	b.Step(dt)
+}
+
+// Step advances the simulation by dt seconds.
+func (b *Body) Step(dt float64) {
+	for _, anchor := range b.Anchors {
+		if anchor.Entity != nil && anchor.Point < len(b.Points) {
+			point := b.Points[anchor.Point]
+			point.Position = anchor.Entity.Transform.Position.Add(anchor.Offset)
+			point.Previous = point.Position
+			point.Pinned = true
+		}
+	}
+
+	accel := b.Gravity.Scale(dt * dt)
+	for _, point := range b.Points {
+		if point.Pinned {
+			point.Previous = point.Position
+			continue
+		}
+		velocity := point.Position.Sub(point.Previous).Scale(b.Damping)
+		point.Previous = point.Position
+		point.Position = point.Position.Add(velocity).Add(accel)
+	}
+
+	iterations := b.Iterations
+	if iterations < 1 {
+		iterations = 1
+	}
+	for i := 0; i < iterations; i++ {
+		for _, constraint := range b.Constraints {
+			b.solve(constraint)
+		}
+	}
+
+	if b.Collide != nil {
+		for _, point := range b.Points {
+			if !point.Pinned {
+				b.Collide(point)
+			}
+		}
+	}
+}
+
+// Render draws each intact constraint as a line.
+func (b *Body) Render(renderer *graphics.Renderer, camera *graphics.Camera, color gamemath.Color) error {
+	for _, constraint := range b.Constraints {
+		if constraint.Broken {
+			continue
+		}
+		ax, ay := camera.WorldToScreen(b.Points[constraint.A].Position.X, b.Points[constraint.A].Position.Y)
+		bx, by := camera.WorldToScreen(b.Points[constraint.B].Position.X, b.Points[constraint.B].Position.Y)
+		if err := renderer.DrawLine(float64(ax), float64(ay), float64(bx), float64(by), color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// solve moves a constraint's points toward their rest length.
+func (b *Body) solve(constraint *Constraint) {
+	if constraint.Broken {
+		return
+	}
+	pa, pb := b.Points[constraint.A], b.Points[constraint.B]
+	delta := pb.Position.Sub(pa.Position)
+	dist := delta.Length()
+	if dist == 0 {
+		return
+	}
+	if b.TearLength > 0 && dist > constraint.Length*b.TearLength {
+		constraint.Broken = true
+		return
+	}
+
+	diff := (dist - constraint.Length) / dist * constraint.Stiffness
+	switch {
+	case pa.Pinned && pb.Pinned:
+	case pa.Pinned:
+		pb.Position = pb.Position.Sub(delta.Scale(diff))
+	case pb.Pinned:
+		pa.Position = pa.Position.Add(delta.Scale(diff))
+	default:
+		half := delta.Scale(diff / 2)
+		pa.Position = pa.Position.Add(half)
+		pb.Position = pb.Position.Sub(half)
+	}
+}
@@ -34,13 +34,6 @@ func (pc *PlayerController) Update(entity *core.Entity, dt float64) {
 	}
 }
 
-// Global counters for collision events
-var (
-	enterCount = 0
-	stayCount  = 0
-	exitCount  = 0
-)
-
 func main() {
 	// CRITICAL: SDL requires running on the main OS thread
 	runtime.LockOSThread()
@@ -103,8 +96,8 @@ func main() {
 	}
 
 	// Setup collision callbacks on player
-	player.OnCollisionEnter = func(self, other *core.Entity) {
-		enterCount++
+	player.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
+		enterCount := scene.Blackboard().AddInt("enter_count", 1)
 		log.Printf("🟢 ENTER: Player collided with entity %d (Total enters: %d)", other.ID, enterCount)
 
 		// Change player color when entering collision
@@ -113,16 +106,16 @@ func main() {
 		}
 	}
 
-	player.OnCollisionStay = func(self, other *core.Entity) {
-		stayCount++
+	player.OnCollisionStay = func(self, other *core.Entity, _ core.CollisionInfo) {
+		stayCount := scene.Blackboard().AddInt("stay_count", 1)
 		// Log every 30th frame to avoid spam
 		if stayCount%30 == 0 {
 			log.Printf("🟡 STAY: Player still colliding with entity %d (Total stays: %d)", other.ID, stayCount)
 		}
 	}
 
-	player.OnCollisionExit = func(self, other *core.Entity) {
-		exitCount++
+	player.OnCollisionExit = func(self, other *core.Entity, _ core.CollisionInfo) {
+		exitCount := scene.Blackboard().AddInt("exit_count", 1)
 		log.Printf("🔴 EXIT: Player stopped colliding with entity %d (Total exits: %d)", other.ID, exitCount)
 
 		// Restore player color when exiting collision
@@ -151,7 +144,7 @@ func main() {
 	}
 
 	// Setup collision callbacks on target
-	target.OnCollisionEnter = func(self, other *core.Entity) {
+	target.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
 		log.Printf("🎯 TARGET: Detected player entering collision zone")
 		// Make target pulse when hit
 		if self.Sprite != nil {
@@ -159,14 +152,14 @@ func main() {
 		}
 	}
 
-	target.OnCollisionStay = func(self, other *core.Entity) {
+	target.OnCollisionStay = func(self, other *core.Entity, _ core.CollisionInfo) {
 		// Keep target semi-transparent while colliding
 		if self.Sprite != nil {
 			self.Sprite.Alpha = 0.5
 		}
 	}
 
-	target.OnCollisionExit = func(self, other *core.Entity) {
+	target.OnCollisionExit = func(self, other *core.Entity, _ core.CollisionInfo) {
 		log.Printf("🎯 TARGET: Player left collision zone")
 		// Restore target alpha
 		if self.Sprite != nil {
@@ -201,11 +194,11 @@ func main() {
 
 		// Walls also have callbacks
 		wallID := i + 1
-		wall.OnCollisionEnter = func(self, other *core.Entity) {
+		wall.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
 			log.Printf("🧱 WALL %d: Collision started", wallID)
 		}
 
-		wall.OnCollisionExit = func(self, other *core.Entity) {
+		wall.OnCollisionExit = func(self, other *core.Entity, _ core.CollisionInfo) {
 			log.Printf("🧱 WALL %d: Collision ended", wallID)
 		}
 
@@ -232,8 +225,9 @@ func main() {
 	log.Println()
 	log.Println("═══════════════════════════════════════════════════════════")
 	log.Println("Final Statistics:")
-	log.Printf("  OnCollisionEnter called: %d times\n", enterCount)
-	log.Printf("  OnCollisionStay called:  %d times\n", stayCount)
-	log.Printf("  OnCollisionExit called:  %d times\n", exitCount)
+	counts := scene.Blackboard()
+	log.Printf("  OnCollisionEnter called: %d times\n", counts.Int("enter_count", 0))
+	log.Printf("  OnCollisionStay called:  %d times\n", counts.Int("stay_count", 0))
+	log.Printf("  OnCollisionExit called:  %d times\n", counts.Int("exit_count", 0))
 	log.Println("═══════════════════════════════════════════════════════════")
 }
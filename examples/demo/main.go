@@ -4,11 +4,8 @@ package main
 import (
 	"fmt"
 	"image"
-	"image/color"
-	"image/png"
 	"log"
 	"math"
-	"os"
 	"runtime"
 
 	"github.com/dshills/gogame/engine/core"
@@ -18,55 +15,16 @@ import (
 	"github.com/dshills/gogame/engine/physics"
 )
 
-// generateTestTextures creates simple colored PNG files for the demo.
-func generateTestTextures() error {
-	textures := map[string]color.RGBA{
-		"examples/demo/assets/player.png":      {R: 100, G: 200, B: 255, A: 255}, // Light blue
-		"examples/demo/assets/enemy.png":       {R: 200, G: 50, B: 50, A: 255},   // Red
-		"examples/demo/assets/collectible.png": {R: 255, G: 215, B: 0, A: 255},   // Gold
-		"examples/demo/assets/wall.png":        {R: 100, G: 100, B: 100, A: 255}, // Gray
-	}
-
-	for path, col := range textures {
-		// Skip if file already exists
-		if _, err := os.Stat(path); err == nil {
-			continue
-		}
-
-		// Create a 32x32 image
-		img := image.NewRGBA(image.Rect(0, 0, 32, 32))
-
-		// Fill with color (with a border for visual interest)
-		for y := 0; y < 32; y++ {
-			for x := 0; x < 32; x++ {
-				// Create a border
-				if x < 2 || x >= 30 || y < 2 || y >= 30 {
-					img.Set(x, y, color.RGBA{R: 0, G: 0, B: 0, A: 255}) // Black border
-				} else {
-					img.Set(x, y, col)
-				}
+// placeholderTile returns a 32x32 tile of a color with a black border.
+func placeholderTile(col gamemath.Color) func() image.Image {
+	return func() image.Image {
+		return graphics.GenerateImage(32, 32, func(x, y int) gamemath.Color {
+			if x < 2 || x >= 30 || y < 2 || y >= 30 {
+				return gamemath.Black
 			}
-		}
-
-		// Save as PNG
-		file, err := os.Create(path)
-		if err != nil {
-			return fmt.Errorf("failed to create texture file %s: %w", path, err)
-		}
-
-		if err := png.Encode(file, img); err != nil {
-			_ = file.Close()
-			return fmt.Errorf("failed to encode PNG %s: %w", path, err)
-		}
-
-		if err := file.Close(); err != nil {
-			return fmt.Errorf("failed to close texture file %s: %w", path, err)
-		}
-
-		fmt.Printf("  Generated texture: %s\n", path)
+			return col
+		})
 	}
-
-	return nil
 }
 
 // PlayerController demonstrates input handling with WASD movement.
@@ -183,16 +141,6 @@ func main() {
 	fmt.Println("  Avoid the red patrolling enemies!")
 	fmt.Println()
 
-	// Create test assets directory
-	if err := os.MkdirAll("examples/demo/assets", 0755); err != nil {
-		log.Fatal("Failed to create assets directory:", err)
-	}
-
-	// Generate test textures
-	if err := generateTestTextures(); err != nil {
-		log.Fatal("Failed to generate test textures:", err)
-	}
-
 	// Create engine
 	engine, err := core.NewEngine("gogame Feature Demo - All Systems", 800, 600, false)
 	if err != nil {
@@ -214,10 +162,10 @@ func main() {
 
 	// Load textures (demonstrates asset management with reference counting)
 	assets := engine.Assets()
-	playerTexture, _ := assets.LoadTexture("examples/demo/assets/player.png")
-	enemyTexture, _ := assets.LoadTexture("examples/demo/assets/enemy.png")
-	collectibleTexture, _ := assets.LoadTexture("examples/demo/assets/collectible.png")
-	wallTexture, _ := assets.LoadTexture("examples/demo/assets/wall.png")
+	playerTexture, _ := assets.GenerateTexture("player", placeholderTile(gamemath.Color{R: 100, G: 200, B: 255, A: 255}))         // Light blue
+	enemyTexture, _ := assets.GenerateTexture("enemy", placeholderTile(gamemath.Color{R: 200, G: 50, B: 50, A: 255}))             // Red
+	collectibleTexture, _ := assets.GenerateTexture("collectible", placeholderTile(gamemath.Color{R: 255, G: 215, B: 0, A: 255})) // Gold
+	wallTexture, _ := assets.GenerateTexture("wall", placeholderTile(gamemath.Color{R: 100, G: 100, B: 100, A: 255}))             // Gray
 
 	// Create player entity (Layer 0 - Player)
 	playerController := &PlayerController{
@@ -3,12 +3,14 @@ package main
 
 import (
 	"fmt"
+	"image"
 	"log"
 	"math/rand"
 	"runtime"
 	"time"
 
 	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/difficulty"
 	"github.com/dshills/gogame/engine/graphics"
 	"github.com/dshills/gogame/engine/input"
 	gamemath "github.com/dshills/gogame/engine/math"
@@ -25,7 +27,7 @@ const (
 	EnemySpeed    = 100.0
 	ShootCooldown = 0.25 // Seconds between shots
 
-	EnemySpawnInterval = 1.5  // Seconds between enemy spawns
+	EnemySpawnInterval = 1.5  // Seconds between enemy spawns at neutral performance
 	StarSpawnInterval  = 0.1  // Seconds between star spawns
 	MaxStars           = 50   // Maximum number of background stars
 	StarSpeed          = 50.0 // Pixels per second
@@ -61,6 +63,7 @@ type Game struct {
 	starSpawnTimer      float64
 	statusUpdateTimer   float64
 	gameTime            float64
+	difficulty          *difficulty.Manager
 	playerTexture       *graphics.Texture
 	enemyTexture        *graphics.Texture
 	bulletTexture       *graphics.Texture
@@ -124,7 +127,7 @@ type EnemyBehavior struct {
 
 func (eb *EnemyBehavior) Update(entity *core.Entity, dt float64) {
 	// Move down
-	entity.Transform.Position.Y += EnemySpeed * dt
+	entity.Transform.Position.Y += EnemySpeed * eb.game.difficulty.Get(difficulty.EnemySpeed) * dt
 
 	// Remove if off screen (enemy escaped)
 	if entity.Transform.Position.Y > ScreenHeight+50 {
@@ -187,10 +190,46 @@ func NewGame(engine *core.Engine) *Game {
 		enemySpawnTimer:     0,
 		starSpawnTimer:      0,
 		gameTime:            0,
+		difficulty:          newDifficulty(),
 		playerStartPosition: gamemath.Vector2{X: ScreenWidth / 2, Y: ScreenHeight - 100},
 	}
 }
 
+// newDifficulty ramps enemy speed over time and tightens spawns for players
+// who are destroying enemies.
+func newDifficulty() *difficulty.Manager {
+	diff := difficulty.New()
+	diff.Define(difficulty.EnemySpeed, difficulty.ByTime, gamemath.NewAnimationCurve(
+		gamemath.Keyframe{Time: 0, Value: 1, Interpolation: gamemath.CurveSmooth},
+		gamemath.Keyframe{Time: 180, Value: 2.5},
+	))
+	diff.Define(difficulty.SpawnInterval, difficulty.ByPerformance, gamemath.NewAnimationCurve(
+		gamemath.Keyframe{Time: 0, Value: 2.5},
+		gamemath.Keyframe{Time: 0.5, Value: EnemySpawnInterval},
+		gamemath.Keyframe{Time: 1, Value: 0.6},
+	))
+	return diff
+}
+
+// shipImage draws a 32x32 ship: a filled triangle pointing up or down.
+func shipImage(col gamemath.Color, up bool) image.Image {
+	const size, topY, baseY = 32, 4, 28
+	return graphics.GenerateImage(size, size, func(x, y int) gamemath.Color {
+		if y < topY || y > baseY {
+			return gamemath.Transparent
+		}
+		progress := float64(y-topY) / float64(baseY-topY)
+		if !up {
+			progress = 1 - progress
+		}
+		halfWidth := int(progress * float64(size/2-4))
+		if x >= size/2-halfWidth && x <= size/2+halfWidth {
+			return col
+		}
+		return gamemath.Transparent
+	})
+}
+
 // Initialize sets up the game
 func (g *Game) Initialize() error {
 	log.Println("╔═══════════════════════════════════════════════════════════╗")
@@ -236,27 +275,35 @@ func (g *Game) Initialize() error {
 	sdlRenderer := g.engine.Renderer().GetSDLRenderer()
 	g.textRenderer = graphics.NewTextRenderer(sdlRenderer, font)
 
-	// Load textures
+	// Generate textures (no asset files needed)
 	assets := g.engine.Assets()
 
-	g.playerTexture, err = assets.LoadTexture("examples/space-battle/assets/player.png")
+	g.playerTexture, err = assets.GenerateTexture("player", func() image.Image {
+		return shipImage(gamemath.Color{R: 100, G: 150, B: 255, A: 255}, true) // Blue, pointing up
+	})
 	if err != nil {
-		return fmt.Errorf("failed to load player texture: %v", err)
+		return fmt.Errorf("failed to create player texture: %v", err)
 	}
 
-	g.enemyTexture, err = assets.LoadTexture("examples/space-battle/assets/enemy.png")
+	g.enemyTexture, err = assets.GenerateTexture("enemy", func() image.Image {
+		return shipImage(gamemath.Color{R: 255, G: 100, B: 100, A: 255}, false) // Red, pointing down
+	})
 	if err != nil {
-		return fmt.Errorf("failed to load enemy texture: %v", err)
+		return fmt.Errorf("failed to create enemy texture: %v", err)
 	}
 
-	g.bulletTexture, err = assets.LoadTexture("examples/space-battle/assets/bullet.png")
+	g.bulletTexture, err = assets.GenerateTexture("bullet", func() image.Image {
+		return graphics.SolidImage(8, 16, gamemath.Color{R: 255, G: 255, B: 100, A: 255})
+	})
 	if err != nil {
-		return fmt.Errorf("failed to load bullet texture: %v", err)
+		return fmt.Errorf("failed to create bullet texture: %v", err)
 	}
 
-	g.starTexture, err = assets.LoadTexture("examples/space-battle/assets/star.png")
+	g.starTexture, err = assets.GenerateTexture("star", func() image.Image {
+		return graphics.SolidImage(4, 4, gamemath.White)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to load star texture: %v", err)
+		return fmt.Errorf("failed to create star texture: %v", err)
 	}
 
 	// Create game manager entity (invisible, just runs game logic)
@@ -329,7 +376,7 @@ func (g *Game) createPlayer() {
 	g.player.Collider.CollisionMask = (1 << CollisionLayerEnemy) // Collide with enemies only (bitmask 0x02)
 
 	// Collision callbacks
-	g.player.OnCollisionEnter = func(self, other *core.Entity) {
+	g.player.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
 		// Check if collided with enemy
 		if other.Collider != nil && other.Collider.CollisionLayer == CollisionLayerEnemy {
 			g.onPlayerHit()
@@ -369,7 +416,7 @@ func (g *Game) tryShoot() {
 	bullet.Collider.CollisionMask = (1 << CollisionLayerEnemy) // Collide with enemies only (bitmask 0x02)
 
 	// Collision callback
-	bullet.OnCollisionEnter = func(self, other *core.Entity) {
+	bullet.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
 		// Check if hit enemy
 		if other.Collider != nil && other.Collider.CollisionLayer == CollisionLayerEnemy {
 			g.onEnemyHit(other)
@@ -435,6 +482,7 @@ func (g *Game) spawnStar(y float64) {
 // onEnemyHit is called when an enemy is hit by a bullet
 func (g *Game) onEnemyHit(enemy *core.Entity) {
 	g.score += 10
+	g.difficulty.RecordPerformance(1)
 
 	// Visual feedback - flash white
 	if enemy.Sprite != nil {
@@ -448,6 +496,7 @@ func (g *Game) onEnemyHit(enemy *core.Entity) {
 // onEnemyEscaped is called when an enemy passes the player
 func (g *Game) onEnemyEscaped(enemy *core.Entity) {
 	g.escapedEnemies++
+	g.difficulty.RecordPerformance(0)
 	g.removeEnemy(enemy)
 
 	log.Printf("⚠ Enemy escaped! Score: %d | Escaped: %d/3", g.score, g.escapedEnemies)
@@ -550,8 +599,9 @@ func (gmb *GameManagerBehavior) Update(entity *core.Entity, dt float64) {
 	}
 
 	// Spawn enemies
+	g.difficulty.Tick(dt)
 	g.enemySpawnTimer += dt
-	if g.enemySpawnTimer >= EnemySpawnInterval {
+	if g.enemySpawnTimer >= g.difficulty.Get(difficulty.SpawnInterval) {
 		g.enemySpawnTimer = 0
 		g.spawnEnemy()
 	}
@@ -601,6 +651,7 @@ func (g *Game) restart() {
 	g.gameTime = 0
 	g.lastShot = 0
 	g.enemySpawnTimer = 0
+	g.difficulty.Reset()
 
 	log.Println("Game restarted! Good luck!")
 }
@@ -2,4 +2,7 @@ module github.com/dshills/gogame
 
 go 1.25.3
 
-require github.com/veandco/go-sdl2 v0.4.40
+require (
+	github.com/veandco/go-sdl2 v0.4.40
+	gopkg.in/yaml.v3 v3.0.1
+)
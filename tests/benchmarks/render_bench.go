@@ -64,7 +64,7 @@ func BenchmarkRendering100Sprites(b *testing.B) {
 	// Benchmark rendering
 	for i := 0; i < b.N; i++ {
 		// Simulate one frame render
-		scene.Render(engine.Renderer(), camera)
+		_ = scene.Render(engine.Renderer())
 	}
 }
 
@@ -112,6 +112,6 @@ func BenchmarkRenderingWithTextures(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		scene.Render(engine.Renderer(), camera)
+		_ = scene.Render(engine.Renderer())
 	}
 }
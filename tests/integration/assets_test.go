@@ -1,7 +1,6 @@
 package integration
 
 import (
-	"runtime"
 	"testing"
 
 	"github.com/dshills/gogame/engine/core"
@@ -9,22 +8,18 @@ import (
 	gamemath "github.com/dshills/gogame/engine/math"
 )
 
+// playerTexture is an example asset, relative to this package.
+const playerTexture = "../../examples/assets/player.png"
+
 // TestTextureLoadingInGameLoop tests loading textures during game loop.
 func TestTextureLoadingInGameLoop(t *testing.T) {
-	runtime.LockOSThread()
-	defer runtime.UnlockOSThread()
-
-	engine, err := core.NewEngine("Asset Test", 800, 600, false)
-	if err != nil {
-		t.Fatalf("Failed to create engine: %v", err)
-	}
+	engine := core.NewHeadlessEngine()
 	defer engine.Shutdown()
 
 	// Try to load test texture
-	texture, err := engine.Assets().LoadTexture("examples/assets/player.png")
+	texture, err := engine.Assets().LoadTexture(playerTexture)
 	if err != nil {
-		t.Skip("Test texture not available, skipping")
-		return
+		t.Fatalf("Failed to load test texture: %v", err)
 	}
 
 	// Create sprite with texture
@@ -44,24 +39,19 @@ func TestTextureLoadingInGameLoop(t *testing.T) {
 	scene.AddEntity(entity)
 
 	// Verify rendering doesn't crash
-	scene.Render(engine.Renderer(), camera)
+	if err := scene.Render(engine.Renderer()); err != nil {
+		t.Errorf("Render failed: %v", err)
+	}
 }
 
 // TestMultipleSpritesSameTexture tests texture sharing.
 func TestMultipleSpritesSameTexture(t *testing.T) {
-	runtime.LockOSThread()
-	defer runtime.UnlockOSThread()
-
-	engine, err := core.NewEngine("Texture Sharing", 800, 600, false)
-	if err != nil {
-		t.Fatalf("Failed to create engine: %v", err)
-	}
+	engine := core.NewHeadlessEngine()
 	defer engine.Shutdown()
 
-	texture, err := engine.Assets().LoadTexture("examples/assets/player.png")
+	texture, err := engine.Assets().LoadTexture(playerTexture)
 	if err != nil {
-		t.Skip("Test texture not available")
-		return
+		t.Fatalf("Failed to load test texture: %v", err)
 	}
 
 	// Create multiple sprites with same texture
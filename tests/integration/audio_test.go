@@ -0,0 +1,33 @@
+package integration
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/dshills/gogame/engine/audio"
+	"github.com/dshills/gogame/engine/core"
+)
+
+// TestEngineWithoutAudioDriver verifies the engine starts silently when
+// SDL has no usable audio driver.
+func TestEngineWithoutAudioDriver(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	t.Setenv("SDL_AUDIODRIVER", "no-such-driver")
+
+	engine, err := core.NewEngine("Audio Test", 320, 240, false)
+	if err != nil {
+		t.Fatalf("Expected the engine to start without audio, got %v", err)
+	}
+	defer engine.Shutdown()
+
+	if engine.Audio().Enabled() {
+		t.Fatal("Expected audio disabled with an invalid driver")
+	}
+	if err := engine.Audio().PlayMusic("music/town.ogg", true, 0); !errors.Is(err, audio.ErrDeviceClosed) {
+		t.Errorf("Expected ErrDeviceClosed from the silent manager, got %v", err)
+	}
+	engine.Audio().Update(1.0 / 60)
+	engine.Step(1)
+}
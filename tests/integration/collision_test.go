@@ -23,12 +23,12 @@ func TestCollisionCallbacks(t *testing.T) {
 
 	entity1 := &core.Entity{
 		Active:    true,
-		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100, Y: 100}},
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100, Y: 100}, Scale: gamemath.Vector2{X: 1, Y: 1}},
 		Collider:  collider1,
-		OnCollisionEnter: func(self, other *core.Entity) {
+		OnCollisionEnter: func(self, other *core.Entity, _ core.CollisionInfo) {
 			enterCalled = true
 		},
-		OnCollisionExit: func(self, other *core.Entity) {
+		OnCollisionExit: func(self, other *core.Entity, _ core.CollisionInfo) {
 			exitCalled = true
 		},
 		Layer: 0,
@@ -42,7 +42,7 @@ func TestCollisionCallbacks(t *testing.T) {
 
 	entity2 := &core.Entity{
 		Active:    true,
-		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 200, Y: 200}},
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 200, Y: 200}, Scale: gamemath.Vector2{X: 1, Y: 1}},
 		Collider:  collider2,
 		Layer:     0,
 	}
@@ -86,9 +86,9 @@ func TestCollisionStay(t *testing.T) {
 
 	entity1 := &core.Entity{
 		Active:    true,
-		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100, Y: 100}},
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100, Y: 100}, Scale: gamemath.Vector2{X: 1, Y: 1}},
 		Collider:  collider1,
-		OnCollisionStay: func(self, other *core.Entity) {
+		OnCollisionStay: func(self, other *core.Entity, _ core.CollisionInfo) {
 			stayCount++
 		},
 		Layer: 0,
@@ -101,7 +101,7 @@ func TestCollisionStay(t *testing.T) {
 
 	entity2 := &core.Entity{
 		Active:    true,
-		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 110, Y: 100}},
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 110, Y: 100}, Scale: gamemath.Vector2{X: 1, Y: 1}},
 		Collider:  collider2,
 		Layer:     0,
 	}
@@ -112,8 +112,8 @@ func TestCollisionStay(t *testing.T) {
 		scene.Update(0.016)
 	}
 
-	// OnCollisionStay should be called multiple times
-	if stayCount < 5 {
-		t.Errorf("Expected at least 5 OnCollisionStay calls, got %d", stayCount)
+	// OnCollisionStay follows Enter: every update after the first
+	if stayCount != 4 {
+		t.Errorf("Expected 4 OnCollisionStay calls, got %d", stayCount)
 	}
 }
@@ -0,0 +1,15 @@
+package integration
+
+import (
+	"os"
+	"testing"
+)
+
+// TestMain runs the suite on SDL's dummy video driver when there is no
+// display, so windowed engine tests also run in CI.
+func TestMain(m *testing.M) {
+	if os.Getenv("SDL_VIDEODRIVER") == "" && os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		_ = os.Setenv("SDL_VIDEODRIVER", "dummy")
+	}
+	os.Exit(m.Run())
+}
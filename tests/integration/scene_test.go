@@ -79,54 +79,51 @@ func TestEntityLifecycle(t *testing.T) {
 	}
 }
 
+// selfRemovingBehavior removes its entity after a number of updates.
+type selfRemovingBehavior struct {
+	scene       *core.Scene
+	removeAfter int
+	updateCount int
+}
+
+func (b *selfRemovingBehavior) Update(e *core.Entity, _ float64) {
+	b.updateCount++
+	if b.updateCount >= b.removeAfter {
+		b.scene.RemoveEntity(e.ID)
+	}
+}
+
 // TestEntityAddRemoveDuringUpdate tests entity lifecycle during update loop.
 func TestEntityAddRemoveDuringUpdate(t *testing.T) {
 	scene := core.NewScene()
 
-	// Add initial entity
+	// Add an entity whose behavior removes it on its third update
+	behavior := &selfRemovingBehavior{scene: scene, removeAfter: 3}
 	entity1 := &core.Entity{
 		Active:    true,
 		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 0, Y: 0}},
+		Behavior:  behavior,
 		Layer:     0,
 	}
 	id1 := scene.AddEntity(entity1)
 
-	// Create behavior that removes itself
-	type selfRemovingBehavior struct {
-		scene       *core.Scene
-		removeAfter int
-		updateCount int
-	}
-	behavior := &selfRemovingBehavior{
-		scene:       scene,
-		removeAfter: 3,
-		updateCount: 0,
-	}
-
-	// Implementation (inline for test)
-	updateFunc := func(e *core.Entity, dt float64) {
-		behavior.updateCount++
-		if behavior.updateCount >= behavior.removeAfter {
-			behavior.scene.RemoveEntity(e.ID)
-		}
-	}
-
-	// Add behavior (would need proper interface implementation)
-	// For this test, we'll manually trigger removal
-
-	// Update 3 times
-	for i := 0; i < 3; i++ {
+	// Removal requested during an update is deferred to the end of it
+	for i := 0; i < 2; i++ {
 		scene.Update(0.016)
 	}
-
-	// Manually remove after updates
-	scene.RemoveEntity(id1)
-	scene.Update(0.016) // Process removal
+	if scene.GetEntity(id1) == nil {
+		t.Fatal("Expected entity to remain before its third update")
+	}
+	scene.Update(0.016)
 
 	// Verify entity is removed
 	if scene.GetEntity(id1) != nil {
 		t.Error("Expected entity to be removed after updates")
 	}
+	scene.Update(0.016)
+	if behavior.updateCount != 3 {
+		t.Errorf("Expected no updates after removal, got %d", behavior.updateCount)
+	}
 }
 
 // TestSceneQueryByPosition tests spatial queries (if implemented).
@@ -159,7 +156,7 @@ func TestSceneQueryByPosition(t *testing.T) {
 	// This test verifies the query exists but may return empty without colliders
 	// In practice, entities need colliders for spatial queries
 
-	entities := scene.GetEntitiesAt(gamemath.Vector2{X: 100, Y: 100})
+	entities := scene.GetEntitiesAt(100, 100)
 	// Without colliders, this may return empty - that's OK for this test
 	// The important part is the method exists and doesn't crash
 
@@ -191,18 +188,19 @@ func TestMultipleScenes(t *testing.T) {
 	}
 	id2 := scene2.AddEntity(entity2)
 
-	// Verify entities are in correct scenes
-	if scene1.GetEntity(id1) == nil {
+	// Verify entities are in correct scenes (each scene numbers its own
+	// entities, so both IDs may be equal)
+	if scene1.GetEntity(id1) != entity1 {
 		t.Error("Entity1 should be in scene1")
 	}
-	if scene1.GetEntity(id2) != nil {
+	if scene1.GetEntity(id2) == entity2 {
 		t.Error("Entity2 should not be in scene1")
 	}
 
-	if scene2.GetEntity(id2) == nil {
+	if scene2.GetEntity(id2) != entity2 {
 		t.Error("Entity2 should be in scene2")
 	}
-	if scene2.GetEntity(id1) != nil {
+	if scene2.GetEntity(id1) == entity1 {
 		t.Error("Entity1 should not be in scene2")
 	}
 }
@@ -0,0 +1,110 @@
+package integration
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// TestWindowControls verifies runtime window changes and the resize callback.
+func TestWindowControls(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	engine, err := core.NewEngine("Window Test", 800, 600, false)
+	if err != nil {
+		t.Fatalf("Failed to create engine: %v", err)
+	}
+	defer engine.Shutdown()
+
+	scene := core.NewScene()
+	engine.SetScene(scene)
+
+	var resizes [][2]int
+	engine.SetResizeCallback(func(width, height int) {
+		resizes = append(resizes, [2]int{width, height})
+	})
+
+	if err := engine.SetWindowSize(640, 480); err != nil {
+		t.Fatalf("SetWindowSize failed: %v", err)
+	}
+	if engine.Width() != 640 || engine.Height() != 480 {
+		t.Errorf("Expected 640x480, got %dx%d", engine.Width(), engine.Height())
+	}
+	if width, height := scene.Camera().ScreenSize(); width != 640 || height != 480 {
+		t.Errorf("Expected camera resized to 640x480, got %dx%d", width, height)
+	}
+	if len(resizes) != 1 || resizes[0] != [2]int{640, 480} {
+		t.Errorf("Expected one resize callback, got %v", resizes)
+	}
+	if err := engine.SetWindowSize(640, 480); err != nil || len(resizes) != 1 {
+		t.Error("Expected no callback when the size doesn't change")
+	}
+	if err := engine.SetWindowSize(0, 480); !errors.Is(err, core.ErrInvalidWindowSize) {
+		t.Errorf("Expected ErrInvalidWindowSize, got %v", err)
+	}
+
+	// A logical size fixes the render size whatever the window does
+	if err := engine.SetLogicalSize(320, 180); err != nil {
+		t.Fatalf("SetLogicalSize failed: %v", err)
+	}
+	if err := engine.SetWindowSize(1000, 500); err != nil {
+		t.Fatalf("SetWindowSize failed: %v", err)
+	}
+	if width, height := scene.Camera().ScreenSize(); width != 320 || height != 180 || engine.Width() != 320 {
+		t.Errorf("Expected cameras at the logical 320x180, got %dx%d", width, height)
+	}
+	if width, height := engine.WindowSize(); width != 1000 || height != 500 {
+		t.Errorf("Expected a 1000x500 window, got %dx%d", width, height)
+	}
+	if err := engine.SetLogicalSize(320, 0); !errors.Is(err, core.ErrInvalidWindowSize) {
+		t.Errorf("Expected ErrInvalidWindowSize for a half-zero size, got %v", err)
+	}
+	if err := engine.SetLogicalSize(0, 0); err != nil || engine.Width() != 1000 {
+		t.Errorf("Expected to follow the window again, got width %d (%v)", engine.Width(), err)
+	}
+	if err := engine.SetWindowSize(640, 480); err != nil {
+		t.Fatal(err)
+	}
+
+	engine.SetResizable(true)
+	if err := engine.SetFullscreen(true); err != nil {
+		t.Logf("Fullscreen unavailable: %v", err)
+	} else if err := engine.SetFullscreen(false); err != nil {
+		t.Errorf("Expected to leave fullscreen, got %v", err)
+	}
+	if engine.Fullscreen() {
+		t.Error("Expected windowed mode")
+	}
+
+	if err := engine.SetVSync(false); err != nil {
+		t.Logf("VSync can't change at runtime with this SDL: %v", err)
+	}
+
+	iconPath := filepath.Join(t.TempDir(), "icon.png")
+	icon := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	for i := range 16 * 16 {
+		icon.Set(i%16, i/16, color.NRGBA{G: 200, A: 255})
+	}
+	file, err := os.Create(iconPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(file, icon); err != nil {
+		t.Fatal(err)
+	}
+	_ = file.Close()
+	if err := engine.SetWindowIcon(iconPath); err != nil {
+		t.Errorf("SetWindowIcon failed: %v", err)
+	}
+	if err := engine.SetWindowIcon("missing.png"); err == nil {
+		t.Error("Expected an error for a missing icon")
+	}
+}
@@ -0,0 +1,128 @@
+package unit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/dshills/gogame/engine/analytics"
+)
+
+// memoryBackend records batches and can be told to fail.
+type memoryBackend struct {
+	mu      sync.Mutex
+	batches [][]analytics.Event
+	fail    bool
+}
+
+func (m *memoryBackend) Send(events []analytics.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail {
+		return errors.New("offline")
+	}
+	m.batches = append(m.batches, events)
+	return nil
+}
+
+// TestAnalyticsBatchingAndRetry tests batching, common props, and re-queuing failed sends.
+func TestAnalyticsBatchingAndRetry(t *testing.T) {
+	backend := &memoryBackend{fail: true}
+	var tracker analytics.Tracker = analytics.NewClient(backend)
+	client := tracker.(*analytics.Client)
+	client.Common = analytics.Props{"build": "1.0"}
+
+	client.TrackDeath("spikes", 10, 20, nil)
+	client.TrackLevelComplete("1-1", 42, analytics.Props{"stars": 2})
+	client.BatchSize = 3 // Set after tracking so no background send starts
+	if err := client.Flush(); err == nil {
+		t.Fatal("Expected flush to fail while offline")
+	}
+	if client.Queued() != 3 {
+		t.Fatalf("Expected failed batch re-queued (3 events), got %d", client.Queued())
+	}
+
+	backend.fail = false
+	if err := client.Flush(); err != nil {
+		t.Fatalf("Expected flush to succeed, got %v", err)
+	}
+	if err := client.EndSession(); err != nil {
+		t.Fatalf("Expected flush to succeed, got %v", err)
+	}
+	if len(backend.batches) != 2 || len(backend.batches[0]) != 3 || len(backend.batches[1]) != 1 {
+		t.Fatalf("Expected batches of 3 and 1, got %v", backend.batches)
+	}
+
+	events := append(backend.batches[0], backend.batches[1]...)
+	// session_start is recorded by NewClient, before Common is set
+	names := []string{analytics.EventSessionStart, analytics.EventDeath, analytics.EventLevelComplete, analytics.EventSessionEnd}
+	for i, name := range names {
+		if events[i].Name != name || events[i].Session != client.Session() || (i > 0 && events[i].Props["build"] != "1.0") {
+			t.Errorf("Expected event %d to be %s with session and common props, got %+v", i, name, events[i])
+		}
+	}
+	if events[2].Props["level"] != "1-1" || events[2].Props["stars"] != 2 {
+		t.Errorf("Expected level props merged, got %v", events[2].Props)
+	}
+}
+
+// TestAnalyticsFileBackend tests JSON lines output.
+func TestAnalyticsFileBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	client := analytics.NewClient(analytics.NewFileBackend(path))
+	client.TrackEvent("shop_open", analytics.Props{"gold": 50})
+	if err := client.Flush(); err != nil {
+		t.Fatalf("Expected flush to succeed, got %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = file.Close() }()
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event analytics.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Expected JSON line, got %q", scanner.Text())
+		}
+		lines++
+	}
+	if lines != 2 {
+		t.Errorf("Expected session_start and shop_open lines, got %d", lines)
+	}
+}
+
+// TestAnalyticsHTTPBackend tests posting batches and treating errors as failures.
+func TestAnalyticsHTTPBackend(t *testing.T) {
+	var received []analytics.Event
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "k" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	backend := analytics.NewHTTPBackend(server.URL)
+	backend.Headers["X-Api-Key"] = "k"
+	events := []analytics.Event{{Name: "a"}, {Name: "b"}}
+	if err := backend.Send(events); err != nil || len(received) != 2 {
+		t.Errorf("Expected 2 events posted, got %d (%v)", len(received), err)
+	}
+
+	status = http.StatusInternalServerError
+	if err := backend.Send(events); err == nil {
+		t.Error("Expected server error to fail the send")
+	}
+}
@@ -0,0 +1,102 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestParseAtlasFormats tests TexturePacker hash, array, and built-in atlas formats.
+func TestParseAtlasFormats(t *testing.T) {
+	hash := `{
+		"frames": {
+			"player_idle_0.png": {"frame": {"x": 0, "y": 0, "w": 32, "h": 32}, "rotated": false, "trimmed": false,
+				"spriteSourceSize": {"x": 0, "y": 0, "w": 32, "h": 32}, "sourceSize": {"w": 32, "h": 32}},
+			"coin.png": {"frame": {"x": 32, "y": 0, "w": 10, "h": 12}, "rotated": false, "trimmed": true,
+				"spriteSourceSize": {"x": 4, "y": 2, "w": 10, "h": 12}, "sourceSize": {"w": 16, "h": 16}}
+		},
+		"meta": {"image": "sheet.png"}
+	}`
+	atlas, err := graphics.ParseAtlas([]byte(hash))
+	if err != nil {
+		t.Fatalf("ParseAtlas failed: %v", err)
+	}
+	if atlas.Image != "sheet.png" || len(atlas.Regions) != 2 {
+		t.Fatalf("Expected sheet.png with 2 regions, got %q with %d", atlas.Image, len(atlas.Regions))
+	}
+	idle, ok := atlas.Region("player_idle_0")
+	if !ok || idle.Rect != (gamemath.Rectangle{Width: 32, Height: 32}) || idle.Pivot != (gamemath.Vector2{}) {
+		t.Errorf("Expected untrimmed 32x32 region at the origin, got %+v", idle)
+	}
+	// Trimmed 10x12 at (4,2) in a 16x16 source: its center (9,8) sits 1px right of the source center (8,8)
+	coin := atlas.Regions["coin"]
+	if coin.Pivot != (gamemath.Vector2{X: -1, Y: 0}) {
+		t.Errorf("Expected trim pivot (-1, 0), got %v", coin.Pivot)
+	}
+	if names := atlas.Names(); len(names) != 2 || names[0] != "coin" {
+		t.Errorf("Expected sorted names, got %v", names)
+	}
+
+	array := `{"frames": [{"filename": "run_0", "frame": {"x": 0, "y": 16, "w": 16, "h": 16}, "pivot": {"x": 0.5, "y": 1}}],
+		"meta": {"image": "run.png"}}`
+	atlas, err = graphics.ParseAtlas([]byte(array))
+	if err != nil {
+		t.Fatalf("ParseAtlas failed: %v", err)
+	}
+	if run := atlas.Regions["run_0"]; run.Rect.Y != 16 || run.Pivot != (gamemath.Vector2{X: 0, Y: 8}) {
+		t.Errorf("Expected bottom-center pivot (0, 8), got %+v", run)
+	}
+
+	simple := `{"image": "tiles.png", "regions": {"grass": [0, 0, 16, 16], "water": [16, 0, 16, 16]}}`
+	atlas, err = graphics.ParseAtlas([]byte(simple))
+	if err != nil {
+		t.Fatalf("ParseAtlas failed: %v", err)
+	}
+	if water := atlas.Regions["water"]; water.Rect.X != 16 || water.Rect.Width != 16 {
+		t.Errorf("Expected water at x=16, got %+v", water)
+	}
+
+	rotated := `{"frames": {"a": {"frame": {"x": 0, "y": 0, "w": 4, "h": 4}, "rotated": true}}, "meta": {"image": "a.png"}}`
+	if _, err := graphics.ParseAtlas([]byte(rotated)); !errors.Is(err, graphics.ErrInvalidAtlas) {
+		t.Errorf("Expected ErrInvalidAtlas for rotated frames, got %v", err)
+	}
+	if _, err := graphics.ParseAtlas([]byte(`{"regions": {}}`)); !errors.Is(err, graphics.ErrInvalidAtlas) {
+		t.Errorf("Expected ErrInvalidAtlas without an image, got %v", err)
+	}
+}
+
+// TestLoadAtlas tests loading an atlas and its image and creating sprites from regions.
+func TestLoadAtlas(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	assets.Mount(fstest.MapFS{
+		"sprites/sheet.json": {Data: []byte(`{"image": "sheet.png", "regions": {"player_idle_0": [0, 0, 8, 8], "enemy": [8, 0, 8, 8]}}`)},
+		"sprites/sheet.png":  {Data: encodePNG(t, 16, 8)},
+	})
+
+	atlas, err := assets.LoadAtlas("sprites/sheet.json")
+	if err != nil {
+		t.Fatalf("LoadAtlas failed: %v", err)
+	}
+	if atlas.Texture == nil || atlas.Texture.Path != "sprites/sheet.png" || atlas.Texture.Width != 16 {
+		t.Fatalf("Expected sheet.png loaded beside the atlas, got %+v", atlas.Texture)
+	}
+
+	player := graphics.NewSpriteFromAtlas(atlas, "player_idle_0")
+	enemy := graphics.NewSpriteFromAtlas(atlas, "enemy")
+	if player == nil || enemy == nil {
+		t.Fatal("Expected sprites for both regions")
+	}
+	if enemy.SourceRect != (gamemath.Rectangle{X: 8, Width: 8, Height: 8}) || enemy.Texture != player.Texture {
+		t.Errorf("Expected enemy region on the shared texture, got %v", enemy.SourceRect)
+	}
+	if graphics.NewSpriteFromAtlas(atlas, "missing") != nil {
+		t.Error("Expected nil for an unknown region")
+	}
+
+	if _, err := assets.LoadAtlas("sprites/missing.json"); err == nil {
+		t.Error("Expected an error for a missing atlas file")
+	}
+}
@@ -0,0 +1,414 @@
+package unit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dshills/gogame/engine/audio"
+	"github.com/dshills/gogame/engine/audio/world"
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+	"github.com/veandco/go-sdl2/mix"
+)
+
+// makeTestWAV builds a 16-bit PCM WAV whose samples count up from 0.
+func makeTestWAV(frames, channels int, extraChunk bool) []byte {
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+	dataSize := frames * channels * 2
+	extra := 0
+	if extraChunk {
+		extra = 8 + 3 + 1 // Odd-sized chunk plus pad byte
+	}
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, le, uint32(36+extra+dataSize))
+	buf.WriteString("WAVEfmt ")
+	_ = binary.Write(&buf, le, uint32(16))
+	_ = binary.Write(&buf, le, uint16(1))
+	_ = binary.Write(&buf, le, uint16(channels))
+	_ = binary.Write(&buf, le, uint32(8000))
+	_ = binary.Write(&buf, le, uint32(8000*channels*2))
+	_ = binary.Write(&buf, le, uint16(channels*2))
+	_ = binary.Write(&buf, le, uint16(16))
+	if extraChunk {
+		buf.WriteString("LIST")
+		_ = binary.Write(&buf, le, uint32(3))
+		buf.Write([]byte{1, 2, 3, 0})
+	}
+	buf.WriteString("data")
+	_ = binary.Write(&buf, le, uint32(dataSize))
+	for i := 0; i < frames*channels; i++ {
+		_ = binary.Write(&buf, le, int16(i))
+	}
+	return buf.Bytes()
+}
+
+// readAllSamples drains a stream in small reads.
+func readAllSamples(t *testing.T, stream audio.Stream, limit int) []int16 {
+	t.Helper()
+	out := make([]int16, 0)
+	buf := make([]int16, 6)
+	for len(out) < limit {
+		n, err := stream.Read(buf)
+		out = append(out, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read failed: %v", err)
+		}
+	}
+	return out
+}
+
+// TestWAVStream tests incremental decoding and seeking.
+func TestWAVStream(t *testing.T) {
+	stream, err := audio.NewWAVStream(bytes.NewReader(makeTestWAV(10, 2, true)))
+	if err != nil {
+		t.Fatalf("NewWAVStream failed: %v", err)
+	}
+	if stream.Format() != (audio.Format{SampleRate: 8000, Channels: 2}) || stream.Frames() != 10 {
+		t.Fatalf("Expected 8000 Hz stereo with 10 frames, got %+v/%d", stream.Format(), stream.Frames())
+	}
+
+	samples := readAllSamples(t, stream, 1000)
+	if len(samples) != 20 || samples[0] != 0 || samples[19] != 19 {
+		t.Fatalf("Expected samples 0..19, got %v", samples)
+	}
+
+	if err := stream.SeekFrame(7); err != nil {
+		t.Fatalf("SeekFrame failed: %v", err)
+	}
+	buf := make([]int16, 2)
+	if n, _ := stream.Read(buf); n != 2 || buf[0] != 14 {
+		t.Errorf("Expected frame 7 to start at sample 14, got %v", buf[:n])
+	}
+	if err := stream.SeekFrame(11); !errors.Is(err, audio.ErrSeekOutOfRange) {
+		t.Errorf("Expected ErrSeekOutOfRange, got %v", err)
+	}
+
+	if _, err := audio.NewWAVStream(bytes.NewReader([]byte("OggS not a wav file"))); !errors.Is(err, audio.ErrUnsupportedFormat) {
+		t.Errorf("Expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+// TestLoopStream tests an intro followed by a seamless loop section.
+func TestLoopStream(t *testing.T) {
+	source, _ := audio.NewWAVStream(bytes.NewReader(makeTestWAV(8, 1, false)))
+	loop := audio.NewLoopStream(source, 3, 6) // Intro 0-2, loop 3-5
+
+	samples := readAllSamples(t, loop, 12)
+	expected := []int16{0, 1, 2, 3, 4, 5, 3, 4, 5, 3, 4, 5}
+	if len(samples) < len(expected) {
+		t.Fatalf("Expected at least %d samples, got %v", len(expected), samples)
+	}
+	for i, want := range expected {
+		if samples[i] != want {
+			t.Fatalf("Expected %v, got %v", expected, samples[:len(expected)])
+		}
+	}
+
+	// Without looping the stream ends at LoopEnd
+	_ = loop.SeekFrame(0)
+	loop.Loop = false
+	if samples := readAllSamples(t, loop, 100); len(samples) != 6 {
+		t.Errorf("Expected 6 samples without looping, got %v", samples)
+	}
+}
+
+// TestLowPassFade tests that the filter passes audio when inactive and muffles it when active.
+func TestLowPassFade(t *testing.T) {
+	filter := audio.NewLowPass(8000, 200)
+	filter.SetFadeTime(0)
+
+	alternating := func() []int16 {
+		samples := make([]int16, 200)
+		for i := range samples {
+			samples[i] = 10000
+			if i%2 == 1 {
+				samples[i] = -10000
+			}
+		}
+		return samples
+	}
+
+	dry := alternating()
+	filter.Process(dry, 1)
+	if dry[199] != -10000 {
+		t.Errorf("Expected inactive filter to pass audio unchanged, got %d", dry[199])
+	}
+
+	filter.SetActive(true)
+	wet := alternating()
+	filter.Process(wet, 1)
+	if wet[199] < -3000 || wet[199] > 3000 {
+		t.Errorf("Expected high frequency to be attenuated, got %d", wet[199])
+	}
+}
+
+// TestReverbTail tests that reverb produces a tail after an impulse.
+func TestReverbTail(t *testing.T) {
+	reverb := audio.NewReverb(8000)
+	silent := make([]int16, 800)
+	reverb.Process(silent, 1)
+	if silent[0] != 0 {
+		t.Fatal("Expected dry reverb to leave silence untouched")
+	}
+
+	reverb.SetParams(audio.ReverbParams{Wet: 1, Decay: 0.8, RoomSize: 1})
+	for i := 0; i < 10; i++ { // Let wet ramp up
+		reverb.Process(make([]int16, 800), 1)
+	}
+	samples := make([]int16, 800)
+	samples[0] = 20000
+	reverb.Process(samples, 1)
+	tail := 0
+	for _, sample := range samples[1:] {
+		if sample != 0 {
+			tail++
+		}
+	}
+	if tail == 0 {
+		t.Error("Expected echoes after the impulse")
+	}
+}
+
+// TestReverbZones tests switching reverb as the listener moves between trigger volumes.
+func TestReverbZones(t *testing.T) {
+	reverb := audio.NewReverb(8000)
+	listener := &core.Entity{Active: true}
+	zones := world.NewReverbZones(reverb, listener)
+
+	newZone := func(x, size float64, params audio.ReverbParams, priority int) *world.ReverbZone {
+		collider := physics.NewCollider(size, size)
+		collider.IsTrigger = true
+		entity := &core.Entity{Active: true, Collider: collider}
+		entity.Transform.Position = gamemath.Vector2{X: x}
+		entity.Transform.Scale = gamemath.Vector2{X: 1, Y: 1}
+		return &world.ReverbZone{Entity: entity, Params: params, Priority: priority}
+	}
+	cave := newZone(0, 100, audio.ReverbCave, 0)
+	pool := newZone(10, 20, audio.ReverbRoom, 1)
+	zones.Add(cave)
+	zones.Add(pool)
+
+	var entered []*world.ReverbZone
+	zones.OnEnter = func(zone *world.ReverbZone) { entered = append(entered, zone) }
+
+	listener.Transform.Position = gamemath.Vector2{X: -30}
+	zones.Update(nil, 0.016)
+	if zones.Current() != cave || reverb.Params() != audio.ReverbCave {
+		t.Errorf("Expected cave reverb, got %+v", reverb.Params())
+	}
+
+	listener.Transform.Position = gamemath.Vector2{X: 12}
+	zones.Update(nil, 0.016)
+	if zones.Current() != pool {
+		t.Error("Expected higher priority zone to win where zones overlap")
+	}
+
+	listener.Transform.Position = gamemath.Vector2{X: 500}
+	zones.Update(nil, 0.016)
+	if zones.Current() != nil || reverb.Params() != audio.ReverbNone {
+		t.Errorf("Expected default reverb outside zones, got %+v", reverb.Params())
+	}
+	if len(entered) != 3 || entered[2] != nil {
+		t.Errorf("Expected 3 zone changes ending with nil, got %d", len(entered))
+	}
+}
+
+// TestDucker tests music ducking with overlapping dialogue.
+func TestDucker(t *testing.T) {
+	ducker := world.NewDucker()
+	var volume float64
+	ducker.Apply = func(v float64) { volume = v }
+
+	ducker.Begin()
+	ducker.Begin()
+	for i := 0; i < 20; i++ {
+		ducker.Update(nil, 0.016)
+	}
+	if volume != 0.3 {
+		t.Errorf("Expected ducked volume 0.3, got %f", volume)
+	}
+
+	ducker.End()
+	ducker.Update(nil, 0.1)
+	if volume != 0.3 {
+		t.Errorf("Expected music to stay ducked while a line is active, got %f", volume)
+	}
+
+	ducker.End()
+	ducker.Update(nil, 0.3)
+	if volume <= 0.3 || volume >= 1 {
+		t.Errorf("Expected volume to be recovering, got %f", volume)
+	}
+	ducker.Update(nil, 1)
+	if volume != 1 || ducker.Ducked() {
+		t.Errorf("Expected full volume after release, got %f", volume)
+	}
+}
+
+// TestDopplerFactor tests pitch shift for approaching and receding sources.
+func TestDopplerFactor(t *testing.T) {
+	listener := gamemath.Vector2{X: 0, Y: 0}
+	still := gamemath.Vector2{}
+	source := gamemath.Vector2{X: 100, Y: 0}
+
+	if f := world.DopplerFactor(source, still, listener, still, 1000); f != 1 {
+		t.Errorf("Expected no shift when stationary, got %f", f)
+	}
+	approaching := world.DopplerFactor(source, gamemath.Vector2{X: -200}, listener, still, 1000)
+	if approaching <= 1.2 || approaching >= 1.3 {
+		t.Errorf("Expected pitch 1.25 for approaching source, got %f", approaching)
+	}
+	receding := world.DopplerFactor(source, gamemath.Vector2{X: 200}, listener, still, 1000)
+	if receding >= 1 {
+		t.Errorf("Expected lower pitch for receding source, got %f", receding)
+	}
+	listenerMoving := world.DopplerFactor(source, still, listener, gamemath.Vector2{X: 100}, 1000)
+	if listenerMoving <= 1 {
+		t.Errorf("Expected higher pitch when listener moves toward source, got %f", listenerMoving)
+	}
+	if world.Attenuation(50, 100) != 0.5 || world.Attenuation(150, 100) != 0 {
+		t.Error("Expected linear attenuation to zero at range")
+	}
+}
+
+// TestEmittersTracking tests gain, pan, and doppler tracking as entities move.
+func TestEmittersTracking(t *testing.T) {
+	listener := &core.Entity{Active: true}
+	car := &core.Entity{Active: true}
+	car.Transform.Position = gamemath.Vector2{X: 300}
+
+	emitters := world.NewEmitters(listener)
+	var startErr error
+	emitters.OnError = func(err error) { startErr = err }
+	clip := &audio.Clip{Format: audio.Format{SampleRate: 8000, Channels: 1}, Samples: make([]int16, 100)}
+	siren := emitters.Add(&world.Emitter{Entity: car, Clip: clip, Loop: true, Volume: 1, Range: 600, Doppler: true})
+
+	emitters.Update(nil, 0.1)
+	if siren.Gain() != 0.5 || siren.Pan() <= 0 || siren.Pitch() != 1 {
+		t.Errorf("Expected gain 0.5, right pan, pitch 1; got %f %f %f", siren.Gain(), siren.Pan(), siren.Pitch())
+	}
+
+	car.Transform.Position.X = 250 // Approaching at 500 units/s
+	emitters.Update(nil, 0.1)
+	if siren.Pitch() <= 1 {
+		t.Errorf("Expected raised pitch while approaching, got %f", siren.Pitch())
+	}
+
+	car.Transform.Position.X = 2000 // Out of range
+	emitters.Update(nil, 0.1)
+	if siren.Gain() != 0 {
+		t.Errorf("Expected silence out of range, got %f", siren.Gain())
+	}
+	if startErr == nil {
+		t.Error("Expected a start error without an open audio device")
+	}
+
+	emitters.Remove(siren)
+	if len(emitters.Emitters()) != 0 || siren.Playing() {
+		t.Error("Expected emitter to be removed and stopped")
+	}
+}
+
+// TestAudioManagerWithoutDevice tests that a closed manager fails safely.
+func TestAudioManagerWithoutDevice(t *testing.T) {
+	manager := audio.NewAudioManager()
+	if manager.Enabled() {
+		t.Fatal("Expected new manager to have no device open")
+	}
+	if _, err := manager.LoadSound("sfx/jump.wav"); !errors.Is(err, audio.ErrDeviceClosed) {
+		t.Errorf("Expected ErrDeviceClosed from LoadSound, got %v", err)
+	}
+	if _, err := manager.PlaySound(nil, 1, 0); !errors.Is(err, audio.ErrDeviceClosed) {
+		t.Errorf("Expected ErrDeviceClosed from PlaySound, got %v", err)
+	}
+	if err := manager.PlayMusic("music/theme.ogg", true, 1); !errors.Is(err, audio.ErrDeviceClosed) {
+		t.Errorf("Expected ErrDeviceClosed from PlayMusic, got %v", err)
+	}
+	manager.Update(0.016)
+	manager.StopMusic(1)
+	if manager.Music() != "" {
+		t.Errorf("Expected no current music, got %q", manager.Music())
+	}
+	manager.Close()
+}
+
+// TestAudioManagerMusicTrackCrossfade tests that music SDL_mixer must
+// convert streams as a Track, and that two tracks on the single music
+// stream fade out then in.
+func TestAudioManagerMusicTrackCrossfade(t *testing.T) {
+	t.Setenv("SDL_AUDIODRIVER", "dummy")
+	manager := audio.NewAudioManager()
+	if err := manager.Open(audio.DefaultFrequency, audio.DefaultChannels); err != nil {
+		t.Skipf("No dummy audio device: %v", err)
+	}
+	defer manager.Close()
+
+	// 8kHz WAVs can't stream through StreamPlayer, so they take the Track path
+	dir := t.TempDir()
+	town := filepath.Join(dir, "town.wav")
+	dungeon := filepath.Join(dir, "dungeon.wav")
+	for _, path := range []string{town, dungeon} {
+		if err := os.WriteFile(path, makeTestWAV(8000, 1, false), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := manager.PlayMusic(town, true, 0); err != nil {
+		t.Fatalf("PlayMusic failed: %v", err)
+	}
+	if !mix.PlayingMusic() || mix.VolumeMusic(-1) != mix.MAX_VOLUME {
+		t.Fatalf("Expected the track on the music stream at full volume, got %d", mix.VolumeMusic(-1))
+	}
+
+	if err := manager.PlayMusic(dungeon, true, 1); err != nil {
+		t.Fatalf("PlayMusic failed: %v", err)
+	}
+	if manager.Music() != dungeon {
+		t.Errorf("Expected %s current, got %q", dungeon, manager.Music())
+	}
+	manager.Update(0.25)
+	if volume := mix.VolumeMusic(-1); volume != mix.MAX_VOLUME/2 {
+		t.Errorf("Expected the old track half faded out, got volume %d", volume)
+	}
+	manager.Update(0.25) // Old track gone; the new one starts silent
+	manager.Update(0.25)
+	if volume := mix.VolumeMusic(-1); !mix.PlayingMusic() || volume != mix.MAX_VOLUME/2 {
+		t.Errorf("Expected the new track half faded in, got volume %d", volume)
+	}
+	manager.Update(0.25)
+	if volume := mix.VolumeMusic(-1); volume != mix.MAX_VOLUME {
+		t.Errorf("Expected the new track at full volume after the crossfade, got %d", volume)
+	}
+
+	manager.StopMusic(0)
+	if mix.PlayingMusic() || manager.Music() != "" {
+		t.Error("Expected StopMusic to halt the music stream")
+	}
+}
+
+// TestPanGains tests equal-power panning with full volume at center.
+func TestPanGains(t *testing.T) {
+	if left, right := audio.PanGains(0); left != 255 || right != 255 {
+		t.Errorf("Expected full volume on both sides at center, got %d %d", left, right)
+	}
+	if left, right := audio.PanGains(-1); left != 255 || right != 0 {
+		t.Errorf("Expected hard left, got %d %d", left, right)
+	}
+	if left, right := audio.PanGains(2); left != 0 || right != 255 {
+		t.Errorf("Expected pan clamped to hard right, got %d %d", left, right)
+	}
+	left, right := audio.PanGains(0.5)
+	if left >= right || left == 0 {
+		t.Errorf("Expected right-leaning pan with some left signal, got %d %d", left, right)
+	}
+}
@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/tilemap"
+)
+
+// TestBlobMasks tests that 8-bit reduction yields the 47-variant blob set.
+func TestBlobMasks(t *testing.T) {
+	masks := tilemap.BlobMasks()
+	if len(masks) != 47 {
+		t.Fatalf("Expected 47 blob masks, got %d", len(masks))
+	}
+	if masks[0] != 0 || masks[46] != 255 {
+		t.Errorf("Expected masks from 0 to 255, got %d to %d", masks[0], masks[46])
+	}
+	terrain := tilemap.NewTerrain8("grass", 1)
+	if terrain.TileFor(tilemap.NorthEast) != terrain.TileFor(0) {
+		t.Error("Expected a lone corner to be ignored without its edges")
+	}
+}
+
+// TestAutotileLoadAndPaint tests resolving a layer and updating variants after edits.
+func TestAutotileLoadAndPaint(t *testing.T) {
+	level := tilemap.NewMap(5, 3, 16, 16)
+	water := tilemap.NewTerrain4("water", 100)
+	level.AddTerrain(water)
+	layer := level.AddLayer("ground")
+
+	// A horizontal strip of water across the middle row
+	for x := 1; x <= 3; x++ {
+		layer.Tiles[1*5+x] = water.Default
+	}
+	level.Autotile(layer)
+
+	// Bits: north 1, east 2, south 4, west 8
+	if got := layer.Tiles[1*5+1]; got != 102 {
+		t.Errorf("Expected left end to connect east (102), got %d", got)
+	}
+	if got := layer.Tiles[1*5+2]; got != 110 {
+		t.Errorf("Expected middle to connect east and west (110), got %d", got)
+	}
+	if got := layer.Tiles[1*5+3]; got != 108 {
+		t.Errorf("Expected right end to connect west (108), got %d", got)
+	}
+	if !layer.Tiles[0].Empty() {
+		t.Error("Expected empty cells to stay empty")
+	}
+
+	level.PaintTerrain(layer, 2, 0, water)
+	if got := layer.Tiles[1*5+2]; got != 111 {
+		t.Errorf("Expected middle to gain a north connection (111), got %d", got)
+	}
+	if got := layer.Tiles[0*5+2]; got != 104 {
+		t.Errorf("Expected painted cell to connect south (104), got %d", got)
+	}
+
+	level.PaintTerrain(layer, 2, 1, nil)
+	if got := layer.Tiles[1*5+1]; got != 100 {
+		t.Errorf("Expected left end isolated after digging (100), got %d", got)
+	}
+	if got := layer.Tiles[0*5+2]; got != 100 {
+		t.Errorf("Expected painted cell isolated after digging (100), got %d", got)
+	}
+}
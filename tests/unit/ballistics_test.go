@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/ballistics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestLaunchVelocitiesHitTarget tests that both solutions land on the target.
+func TestLaunchVelocitiesHitTarget(t *testing.T) {
+	from := gamemath.Vector2{X: 0, Y: 0}
+	to := gamemath.Vector2{X: 300, Y: 50}
+	gravity := gamemath.Vector2{Y: 980}
+
+	low, high, ok := ballistics.LaunchVelocities(from, to, 700, gravity.Y)
+	if !ok {
+		t.Fatal("Expected target to be in range")
+	}
+	if high.Y >= low.Y {
+		t.Errorf("Expected lob to launch more steeply upward, got low %v high %v", low, high)
+	}
+
+	for _, velocity := range []gamemath.Vector2{low, high} {
+		flight := to.X / velocity.X
+		landing := ballistics.PositionAt(from, velocity, gravity, flight)
+		if math.Abs(landing.Y-to.Y) > 0.01 {
+			t.Errorf("Expected trajectory %v to reach y=%f, got %f", velocity, to.Y, landing.Y)
+		}
+	}
+
+	if _, _, ok := ballistics.LaunchVelocities(from, gamemath.Vector2{X: 5000}, 100, 980); ok {
+		t.Error("Expected far target to be out of range")
+	}
+}
+
+// TestLeadTarget tests interception of a moving target.
+func TestLeadTarget(t *testing.T) {
+	shooter := gamemath.Vector2{}
+	target := gamemath.Vector2{X: 100, Y: 0}
+	velocity := gamemath.Vector2{X: 0, Y: 50}
+
+	aim, ok := ballistics.LeadTarget(shooter, target, velocity, 200)
+	if !ok {
+		t.Fatal("Expected intercept to exist")
+	}
+	tHit, _ := ballistics.InterceptTime(shooter, target, velocity, 200)
+	bullet := aim.Normalize().Scale(200 * tHit)
+	enemy := target.Add(velocity.Scale(tHit))
+	if bullet.Distance(enemy) > 0.001 {
+		t.Errorf("Expected bullet to meet enemy, got %v vs %v", bullet, enemy)
+	}
+
+	if _, ok := ballistics.InterceptTime(shooter, target, gamemath.Vector2{X: 500}, 200); ok {
+		t.Error("Expected no intercept for a faster fleeing target")
+	}
+}
+
+// TestPredictArcStopsOnHit tests arc sampling with a ground predicate.
+func TestPredictArcStopsOnHit(t *testing.T) {
+	ground := func(p gamemath.Vector2) bool { return p.Y >= 0 }
+	points, hit := ballistics.PredictArc(gamemath.Vector2{Y: -1}, gamemath.Vector2{X: 100, Y: -300}, gamemath.Vector2{Y: 980}, 0.05, 100, ground)
+	if !hit {
+		t.Fatal("Expected arc to hit the ground")
+	}
+	if last := points[len(points)-1]; last.Y < 0 || last.X <= 0 {
+		t.Errorf("Expected impact point past launch on the ground, got %v", last)
+	}
+}
+
+// TestPredictArcNegativeSteps tests that a negative step count returns
+// only the launch point.
+func TestPredictArcNegativeSteps(t *testing.T) {
+	from := gamemath.Vector2{X: 5, Y: 7}
+	points, hit := ballistics.PredictArc(from, gamemath.Vector2{X: 100}, gamemath.Vector2{Y: 980}, 0.05, -10, nil)
+	if hit || len(points) != 1 || points[0] != from {
+		t.Errorf("Expected only the launch point, got %v (hit %v)", points, hit)
+	}
+}
@@ -0,0 +1,67 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// TestBlackboardTypedAccess tests typed reads with defaults.
+func TestBlackboardTypedAccess(t *testing.T) {
+	board := core.NewBlackboard()
+	board.Set("wave", 3)
+	board.Set("speed", 1.5)
+	board.Set("name", "cave")
+	board.Set("boss", true)
+
+	if board.Int("wave", 0) != 3 || board.Float("wave", 0) != 3 {
+		t.Error("Expected int value readable as int and float")
+	}
+	if board.Float("speed", 0) != 1.5 || board.String("name", "") != "cave" || !board.Bool("boss", false) {
+		t.Error("Expected typed values to round-trip")
+	}
+	if board.Int("name", -1) != -1 || board.String("missing", "none") != "none" {
+		t.Error("Expected defaults for mistyped and missing keys")
+	}
+	if board.AddInt("coins", 5) != 5 || board.AddInt("coins", 2) != 7 {
+		t.Error("Expected AddInt to count from zero")
+	}
+	if keys := board.Keys(); len(keys) != 5 || keys[0] != "boss" {
+		t.Errorf("Expected 5 sorted keys, got %v", keys)
+	}
+}
+
+// TestBlackboardWatch tests change notifications and unsubscribing.
+func TestBlackboardWatch(t *testing.T) {
+	board := core.NewScene().Blackboard()
+	var scoreChanges, allChanges int
+	var lastOld, lastValue any
+	stop := board.Watch("score", func(_ string, old, value any) {
+		scoreChanges++
+		lastOld, lastValue = old, value
+	})
+	board.Watch("", func(string, any, any) { allChanges++ })
+
+	board.Set("score", 10)
+	board.Set("score", 10) // Unchanged: no notification
+	board.Set("score", 20)
+	board.Set("lives", 3)
+	if scoreChanges != 2 || lastOld != 10 || lastValue != 20 {
+		t.Errorf("Expected 2 score changes ending 10->20, got %d (%v->%v)", scoreChanges, lastOld, lastValue)
+	}
+	if allChanges != 3 {
+		t.Errorf("Expected wildcard watcher to see 3 changes, got %d", allChanges)
+	}
+
+	board.Set("items", []string{"key"})
+	board.Set("items", []string{"key"}) // Slices can't be compared, so always notify
+	if allChanges != 5 {
+		t.Errorf("Expected uncomparable values to notify every Set, got %d", allChanges)
+	}
+
+	stop()
+	board.Delete("score")
+	if scoreChanges != 2 || board.Has("score") {
+		t.Error("Expected stopped watcher to miss the delete")
+	}
+}
@@ -0,0 +1,314 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gogametest"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestCameraFollow_FrameRateIndependent tests that following at different
+// step rates reaches the same position after the same elapsed time.
+func TestCameraFollow_FrameRateIndependent(t *testing.T) {
+	positions := make([]float64, 0, 3)
+	for _, rate := range []int{30, 60, 144} {
+		camera := graphics.NewCamera()
+		dt := 1.0 / float64(rate)
+		for i := 0; i < rate/2; i++ { // Half a second
+			camera.Follow(100, 0, 0.25, dt)
+		}
+		positions = append(positions, camera.Position.X)
+	}
+
+	want := 100 * (1 - math.Exp(-0.5/0.25))
+	for i, got := range positions {
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("Expected position %.6f at rate %d, got %.6f", want, []int{30, 60, 144}[i], got)
+		}
+	}
+}
+
+// TestCameraFollow_Snap tests that a zero smooth time locks to the target.
+func TestCameraFollow_Snap(t *testing.T) {
+	camera := graphics.NewCamera()
+	camera.Follow(40, -20, 0, 0.016)
+	if camera.Position.X != 40 || camera.Position.Y != -20 {
+		t.Errorf("Expected camera at (40, -20), got (%v, %v)", camera.Position.X, camera.Position.Y)
+	}
+	if f := graphics.SmoothingFactor(0.5, 0); f != 0 {
+		t.Errorf("Expected no movement for zero dt, got factor %v", f)
+	}
+}
+
+// lateOrderBehavior records the entity position seen in each phase.
+type lateOrderBehavior struct {
+	speed  float64
+	phases []string
+	lateX  float64
+}
+
+func (b *lateOrderBehavior) Update(entity *core.Entity, dt float64) {
+	b.phases = append(b.phases, "update")
+	entity.Transform.Position.X += b.speed * dt
+}
+
+func (b *lateOrderBehavior) LateUpdate(entity *core.Entity, dt float64) {
+	b.phases = append(b.phases, "late")
+	b.lateX = entity.Transform.Position.X
+}
+
+// TestSceneLateUpdate tests that LateUpdate runs after every entity's Update
+// and that CameraFollow inside Behaviors tracks the final position.
+func TestSceneLateUpdate(t *testing.T) {
+	scene := core.NewScene()
+	first := &lateOrderBehavior{}
+	mover := &lateOrderBehavior{speed: 100}
+	scene.AddEntity(&core.Entity{Active: true, Behavior: first})
+	scene.AddEntity(&core.Entity{
+		Active:    true,
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 0, Y: 10}},
+		Behavior: core.Behaviors{
+			mover,
+			&core.CameraFollow{Camera: scene.Camera()},
+		},
+	})
+
+	scene.Update(0.5)
+
+	if got := first.phases; len(got) != 2 || got[0] != "update" || got[1] != "late" {
+		t.Errorf("Expected [update late], got %v", got)
+	}
+	if mover.lateX != 50 {
+		t.Errorf("Expected LateUpdate to see X=50, got %v", mover.lateX)
+	}
+	if pos := scene.Camera().Position; pos.X != 50 || pos.Y != 10 {
+		t.Errorf("Expected camera at (50, 10), got (%v, %v)", pos.X, pos.Y)
+	}
+}
+
+// TestCameraZoomToFit tests that a rectangle is centered and scaled to the
+// limiting axis, with padding.
+func TestCameraZoomToFit(t *testing.T) {
+	camera := graphics.NewCamera() // 800x600
+	camera.ZoomToFit(gamemath.Rectangle{X: 100, Y: 100, Width: 400, Height: 100}, 0)
+	if camera.Position.X != 300 || camera.Position.Y != 150 {
+		t.Errorf("Expected camera at (300, 150), got (%v, %v)", camera.Position.X, camera.Position.Y)
+	}
+	if camera.Zoom != 2 {
+		t.Errorf("Expected zoom 2 (width-limited), got %v", camera.Zoom)
+	}
+
+	camera.ZoomToFit(gamemath.Rectangle{Width: 400, Height: 100}, 100)
+	if camera.Zoom != 1.5 {
+		t.Errorf("Expected zoom 1.5 with padding, got %v", camera.Zoom)
+	}
+
+	camera.MaxZoom = 1.2
+	camera.ZoomToFit(gamemath.Rectangle{Width: 10, Height: 10}, 0)
+	if camera.Zoom != 1.2 {
+		t.Errorf("Expected zoom clamped to 1.2, got %v", camera.Zoom)
+	}
+}
+
+// TestCameraFocusOn tests framing several entities at once.
+func TestCameraFocusOn(t *testing.T) {
+	camera := graphics.NewCamera()
+	if camera.FocusOn(0) {
+		t.Error("Expected FocusOn without targets to return false")
+	}
+
+	a := &core.Entity{Active: true, Transform: gamemath.Transform{Position: gamemath.Vector2{X: -200, Y: 0}}}
+	b := &core.Entity{Active: true, Transform: gamemath.Transform{Position: gamemath.Vector2{X: 200, Y: 100}}}
+	if !camera.FocusOn(0, a, b) {
+		t.Fatal("Expected FocusOn to frame targets")
+	}
+	if camera.Position.X != 0 || camera.Position.Y != 50 {
+		t.Errorf("Expected camera at (0, 50), got (%v, %v)", camera.Position.X, camera.Position.Y)
+	}
+	if camera.Zoom != 2 {
+		t.Errorf("Expected zoom 2 to fit 400 units across 800 pixels, got %v", camera.Zoom)
+	}
+}
+
+// TestCameraBounds tests that the view is clamped to world bounds.
+func TestCameraBounds(t *testing.T) {
+	camera := graphics.NewCamera() // 800x600 screen
+	camera.Bounds = gamemath.Rectangle{Width: 2000, Height: 400}
+
+	camera.Follow(-500, 0, 0, 0.016)
+	if camera.Position.X != 400 {
+		t.Errorf("Expected X clamped to half the view width (400), got %v", camera.Position.X)
+	}
+	if camera.Position.Y != 200 {
+		t.Errorf("Expected Y centered on bounds shorter than the view (200), got %v", camera.Position.Y)
+	}
+
+	camera.Position.X = 5000
+	camera.Update(0.016)
+	if camera.Position.X != 1600 {
+		t.Errorf("Expected Update to clamp X to 1600, got %v", camera.Position.X)
+	}
+
+	camera.Zoom = 2 // View is now 400x300
+	camera.Position.Y = 0
+	camera.ClampToBounds()
+	if camera.Position.Y != 150 {
+		t.Errorf("Expected Y clamped to 150 at zoom 2, got %v", camera.Position.Y)
+	}
+}
+
+// TestCameraFollowDeadzone tests that small target movements don't scroll the view.
+func TestCameraFollowDeadzone(t *testing.T) {
+	camera := graphics.NewCamera()
+	deadzone := gamemath.Vector2{X: 100, Y: 60}
+
+	camera.FollowDeadzone(40, -25, deadzone, 0, 0.016)
+	if camera.Position != (gamemath.Vector2{}) {
+		t.Errorf("Expected no movement inside the deadzone, got %+v", camera.Position)
+	}
+
+	camera.FollowDeadzone(80, -50, deadzone, 0, 0.016)
+	if camera.Position != (gamemath.Vector2{X: 30, Y: -20}) {
+		t.Errorf("Expected target brought to the deadzone edge (30, -20), got %+v", camera.Position)
+	}
+}
+
+// TestCameraShake tests that shake offsets the view, decays, and leaves Position alone.
+func TestCameraShake(t *testing.T) {
+	camera := graphics.NewCamera()
+	camera.Shake(10, 0.5)
+	camera.Shake(2, 1) // Weaker shake is ignored
+	if camera.ShakeStrength() != 10 {
+		t.Fatalf("Expected strength 10, got %v", camera.ShakeStrength())
+	}
+
+	moved := false
+	for i := 0; i < 10; i++ {
+		camera.Update(0.02)
+		if camera.ViewPosition() != camera.Position {
+			moved = true
+		}
+		if offset := camera.ViewPosition().Length(); offset > 10 {
+			t.Errorf("Expected offset within magnitude, got %v", offset)
+		}
+	}
+	if !moved || camera.Position != (gamemath.Vector2{}) {
+		t.Errorf("Expected only the view to shake, moved=%v position=%+v", moved, camera.Position)
+	}
+	if got := camera.ShakeStrength(); math.Abs(got-10*0.6*0.6) > 1e-9 {
+		t.Errorf("Expected quadratic decay to 3.6 at 40%%, got %v", got)
+	}
+
+	camera.ShakeEnvelope = gamemath.LinearCurve(0, 1, 1, 1) // Constant strength
+	if got := camera.ShakeStrength(); got != 10 {
+		t.Errorf("Expected envelope to set strength 10, got %v", got)
+	}
+
+	for i := 0; i < 20; i++ {
+		camera.Update(0.02)
+	}
+	if camera.ShakeStrength() != 0 || camera.ViewPosition() != camera.Position {
+		t.Error("Expected shake to end after its duration")
+	}
+}
+
+// TestCameraShakeSkipsScreenSpaceLayers tests that HUD layers don't shake.
+func TestCameraShakeSkipsScreenSpaceLayers(t *testing.T) {
+	scene := core.NewScene()
+	scene.AddLayer("hud", 100).ScreenSpace = true
+	scene.Camera().Shake(20, 1)
+	scene.Update(0.05)
+
+	hud := scene.LayerCamera(100)
+	if hud.ShakeStrength() != 0 || hud.ViewPosition() != hud.Position {
+		t.Error("Expected screen-space camera without shake")
+	}
+	if world := scene.Camera(); world.ViewPosition() == world.Position {
+		t.Error("Expected world camera view to shake")
+	}
+}
+
+// TestCameraViewport tests viewport sizing and viewport-relative coordinates.
+func TestCameraViewport(t *testing.T) {
+	camera := graphics.NewCamera() // 800x600 window
+	camera.Viewport = gamemath.Rectangle{X: 0.5, Width: 0.5, Height: 1}
+
+	rect := camera.ViewportRect()
+	if rect != (gamemath.Rectangle{X: 400, Width: 400, Height: 600}) {
+		t.Errorf("Expected right-half viewport, got %+v", rect)
+	}
+	if w, h := camera.ScreenSize(); w != 400 || h != 600 {
+		t.Errorf("Expected screen size 400x600, got %dx%d", w, h)
+	}
+	if x, y := camera.WorldToScreen(0, 0); x != 200 || y != 300 {
+		t.Errorf("Expected camera center at viewport (200, 300), got (%d, %d)", x, y)
+	}
+
+	x, y, ok := camera.WindowToViewport(600, 300)
+	if !ok || x != 200 || y != 300 {
+		t.Errorf("Expected window (600, 300) at viewport (200, 300), got (%d, %d, %v)", x, y, ok)
+	}
+	if _, _, ok := camera.WindowToViewport(100, 300); ok {
+		t.Error("Expected left half to be outside the viewport")
+	}
+}
+
+// TestSceneSplitScreenRender tests that each camera draws the scene into its viewport.
+func TestSceneSplitScreenRender(t *testing.T) {
+	const hudLayer = 10
+	scene := core.NewScene()
+	scene.AddLayer("hud", hudLayer).ScreenSpace = true
+	texture := graphics.NewTexture(nil, 16, 16, "")
+	scene.AddEntity(&core.Entity{Active: true, Sprite: graphics.NewSprite(texture)})
+	scene.AddEntity(&core.Entity{Active: true, Layer: hudLayer, Sprite: graphics.NewSprite(texture)})
+
+	scene.Camera().Viewport = gamemath.Rectangle{Width: 0.5, Height: 1}
+	right := graphics.NewCamera()
+	right.Viewport = gamemath.Rectangle{X: 0.5, Width: 0.5, Height: 1}
+	right.HiddenLayers = []int{hudLayer}
+	scene.AddCamera(right)
+	scene.AddCamera(right) // Duplicate ignored
+
+	if len(scene.Cameras()) != 2 {
+		t.Fatalf("Expected 2 cameras, got %d", len(scene.Cameras()))
+	}
+	if scene.CameraAt(700, 100) != right || scene.CameraAt(100, 100) != scene.Camera() {
+		t.Error("Expected CameraAt to find the camera under each half")
+	}
+
+	renderer := gogametest.NewFakeRenderer()
+	if err := scene.Render(renderer.Renderer); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	kinds := make([]graphics.DrawKind, 0)
+	for _, call := range renderer.Calls() {
+		kinds = append(kinds, call.Kind)
+	}
+	expected := []graphics.DrawKind{
+		graphics.DrawKindViewport, graphics.DrawKindSprite, graphics.DrawKindSprite, // Left: world + HUD
+		graphics.DrawKindViewport, graphics.DrawKindSprite, // Right: world only
+		graphics.DrawKindViewport, // Restore
+	}
+	if len(kinds) != len(expected) {
+		t.Fatalf("Expected draw sequence %v, got %v", expected, kinds)
+	}
+	for i := range expected {
+		if kinds[i] != expected[i] {
+			t.Fatalf("Expected draw sequence %v, got %v", expected, kinds)
+		}
+	}
+	if vp := renderer.Calls()[3].Screen; vp.X != 400 || vp.Width != 400 {
+		t.Errorf("Expected right viewport at X 400 width 400, got %+v", vp)
+	}
+	if sprite := renderer.Calls()[4].Screen; sprite.X+sprite.Width/2 != 200 {
+		t.Errorf("Expected sprite centered in the viewport, got %+v", sprite)
+	}
+
+	scene.RemoveCamera(right)
+	if len(scene.Cameras()) != 1 {
+		t.Error("Expected RemoveCamera to leave only the main camera")
+	}
+}
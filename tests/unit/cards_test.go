@@ -0,0 +1,150 @@
+package unit
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dshills/gogame/engine/cards"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// newTestCard creates a 40x60 card.
+func newTestCard(name string) *cards.Card {
+	front := &graphics.Sprite{SourceRect: gamemath.Rectangle{Width: 40, Height: 60}, Alpha: 1}
+	back := &graphics.Sprite{SourceRect: gamemath.Rectangle{Width: 40, Height: 60}, Alpha: 1}
+	return cards.NewCard(name, nil, front, back)
+}
+
+// TestPileOperations tests drawing, dealing, moving, and reshuffling between piles.
+func TestPileOperations(t *testing.T) {
+	deck := cards.NewPile("deck")
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		deck.Push(newTestCard(name))
+	}
+	hand := cards.NewPile("hand")
+	hand.MaxSize = 3
+	discard := cards.NewPile("discard")
+
+	if top := deck.Top(); top == nil || top.Name != "e" {
+		t.Fatalf("Expected e on top, got %v", top)
+	}
+	if moved := cards.Deal(deck, hand, 5); moved != 3 {
+		t.Errorf("Expected deal to stop at hand size 3, moved %d", moved)
+	}
+	if deck.Len() != 2 || !hand.Full() {
+		t.Errorf("Expected 2 cards left in deck and a full hand, got %d/%d", deck.Len(), hand.Len())
+	}
+
+	card := hand.Cards()[0]
+	if !cards.Move(card, hand, discard) || hand.Contains(card) || discard.Top() != card {
+		t.Error("Expected card to move from hand to discard")
+	}
+	if cards.Move(card, hand, discard) {
+		t.Error("Expected move of a card not in the pile to fail")
+	}
+
+	card.SetFaceUp(true)
+	cards.Reshuffle(discard, deck, rand.New(rand.NewSource(1)))
+	if deck.Len() != 3 || discard.Len() != 0 || card.FaceUp() {
+		t.Errorf("Expected discard shuffled face down into deck, got deck %d discard %d", deck.Len(), discard.Len())
+	}
+}
+
+// TestPileShuffleDeterministic tests that a seeded shuffle is reproducible.
+func TestPileShuffleDeterministic(t *testing.T) {
+	build := func() *cards.Pile {
+		pile := cards.NewPile("deck")
+		for i := 0; i < 20; i++ {
+			pile.Push(newTestCard(string(rune('a' + i))))
+		}
+		pile.Shuffle(rand.New(rand.NewSource(42)))
+		return pile
+	}
+	first, second := build(), build()
+	for i := range first.Cards() {
+		if first.Cards()[i].Name != second.Cards()[i].Name {
+			t.Fatalf("Expected identical order at %d, got %s and %s", i, first.Cards()[i].Name, second.Cards()[i].Name)
+		}
+	}
+}
+
+// TestCardFaceSwapsSprite tests flipping between front and back sprites.
+func TestCardFaceSwapsSprite(t *testing.T) {
+	card := newTestCard("a")
+	if card.Entity.Sprite != card.Back {
+		t.Error("Expected new card to show its back")
+	}
+	card.Flip()
+	if !card.FaceUp() || card.Entity.Sprite != card.Front {
+		t.Error("Expected flipped card to show its front")
+	}
+}
+
+// TestHandLayoutFan tests that slots are symmetric around the center.
+func TestHandLayoutFan(t *testing.T) {
+	layout := cards.HandLayout{Center: gamemath.Vector2{X: 200, Y: 300}, Spacing: 50, MaxWidth: 80, FanAngle: 20, Curve: 10, Scale: 1}
+
+	left, middle, right := layout.Slot(0, 3), layout.Slot(1, 3), layout.Slot(2, 3)
+	if middle.Position != layout.Center || middle.Rotation != 0 {
+		t.Errorf("Expected middle card at center unrotated, got %v", middle)
+	}
+	if left.Position.X != 160 || right.Position.X != 240 {
+		t.Errorf("Expected spacing squeezed to fit MaxWidth, got %f and %f", left.Position.X, right.Position.X)
+	}
+	if left.Rotation != -10 || right.Rotation != 10 || left.Position.Y != 310 {
+		t.Errorf("Expected outer cards fanned and dropped, got %v and %v", left, right)
+	}
+}
+
+// TestTableHoverAndPlay tests hover zoom and dropping a card on a zone.
+func TestTableHoverAndPlay(t *testing.T) {
+	hand := cards.NewPile("hand")
+	board := cards.NewPile("board")
+	table := cards.NewTable(nil, nil, hand)
+	table.Layout = cards.HandLayout{Center: gamemath.Vector2{X: 200, Y: 300}, Spacing: 50, Scale: 1, Layer: 10}
+	table.Zones = append(table.Zones, &cards.DropZone{
+		Name:   "board",
+		Bounds: gamemath.Rectangle{X: 0, Y: 0, Width: 400, Height: 100},
+		Accept: func(card *cards.Card) bool { return card.Name != "curse" },
+		Target: board,
+	})
+
+	played := ""
+	table.OnPlay = func(card *cards.Card, zone *cards.DropZone) { played = card.Name + "@" + zone.Name }
+
+	strike, curse := newTestCard("strike"), newTestCard("curse")
+	table.AddCard(strike)
+	table.AddCard(curse)
+	table.Arrange(0)
+	if !strike.FaceUp() || strike.Entity.Transform.Position.X != 175 {
+		t.Fatalf("Expected strike face up in the left slot, got %v", strike.Entity.Transform.Position)
+	}
+
+	if table.HoverAt(gamemath.Vector2{X: 170, Y: 300}) != strike {
+		t.Fatal("Expected strike to be hovered")
+	}
+	table.Arrange(0)
+	if strike.Entity.Transform.Scale.X != table.HoverScale || strike.Entity.Layer <= curse.Entity.Layer {
+		t.Errorf("Expected hovered card zoomed and on top, got scale %f layer %d", strike.Entity.Transform.Scale.X, strike.Entity.Layer)
+	}
+
+	// Rejected drop: card stays in hand and slides back
+	table.HoverAt(gamemath.Vector2{})
+	table.Arrange(0)
+	grab := curse.Entity.Transform.Position
+	table.Drag.BeginDrag(grab)
+	table.Drag.EndDrag(gamemath.Vector2{X: 200, Y: 50})
+	if !hand.Contains(curse) || played != "" {
+		t.Error("Expected zone to reject the curse")
+	}
+
+	grab = strike.Entity.Transform.Position
+	if !table.Drag.BeginDrag(grab) || table.Dragged() != strike {
+		t.Fatal("Expected strike to be dragged")
+	}
+	table.Drag.EndDrag(gamemath.Vector2{X: 100, Y: 50})
+	if hand.Contains(strike) || board.Top() != strike || played != "strike@board" {
+		t.Errorf("Expected strike played onto the board, got %q", played)
+	}
+}
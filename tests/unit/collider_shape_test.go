@@ -0,0 +1,112 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// shapeTransform returns a unit-scale transform at (x, y) rotated by degrees.
+func shapeTransform(x, y, degrees float64) gamemath.Transform {
+	return gamemath.Transform{Position: gamemath.Vector2{X: x, Y: y}, Rotation: degrees, Scale: gamemath.Vector2{X: 1, Y: 1}}
+}
+
+// TestShapeWorldBounds tests bounds of circles and rotated boxes.
+func TestShapeWorldBounds(t *testing.T) {
+	circle := physics.NewCircleCollider(10)
+	circle.Offset = gamemath.Vector2{X: 5}
+	transform := shapeTransform(100, 0, 90)
+	transform.Scale = gamemath.Vector2{X: 2, Y: 1}
+	bounds := circle.GetWorldBounds(transform)
+	// Offset (5, 0) scaled to (10, 0), rotated 90° to (0, 10); radius scaled by 2
+	if math.Abs(bounds.X-80) > 1e-9 || math.Abs(bounds.Y+10) > 1e-9 || bounds.Width != 40 {
+		t.Errorf("Expected circle bounds (80, -10, 40, 40), got %+v", bounds)
+	}
+
+	box := physics.NewOrientedBoxCollider(20, 10)
+	bounds = box.GetWorldBounds(shapeTransform(0, 0, 90))
+	if math.Abs(bounds.Width-10) > 1e-9 || math.Abs(bounds.Height-20) > 1e-9 {
+		t.Errorf("Expected 90° box bounds 10x20, got %vx%v", bounds.Width, bounds.Height)
+	}
+	bounds = box.GetWorldBounds(shapeTransform(0, 0, 45))
+	if want := 30 / math.Sqrt2; math.Abs(bounds.Width-want) > 1e-9 {
+		t.Errorf("Expected 45° box width %v, got %v", want, bounds.Width)
+	}
+}
+
+// TestShapeContacts tests circle and oriented box intersection tests.
+func TestShapeContacts(t *testing.T) {
+	circleA := physics.NewCircleCollider(10)
+	circleB := physics.NewCircleCollider(10)
+	contact, ok := circleA.Contact(circleB, shapeTransform(15, 0, 0), shapeTransform(0, 0, 0))
+	if !ok || math.Abs(contact.Depth-5) > 1e-9 || contact.Normal != (gamemath.Vector2{X: 1}) {
+		t.Errorf("Expected circles to overlap by 5 along +X, got %+v", contact)
+	}
+	if _, ok := circleA.Contact(circleB, shapeTransform(20, 0, 0), shapeTransform(0, 0, 0)); ok {
+		t.Error("Expected touching circles not to overlap")
+	}
+
+	// A circle near a box corner: inside the AABB, outside the circle's reach
+	box := physics.NewCollider(20, 20)
+	if _, ok := circleA.Contact(box, shapeTransform(18, 18, 0), shapeTransform(0, 0, 0)); ok {
+		t.Error("Expected circle off the box corner not to overlap")
+	}
+	contact, ok = box.Contact(circleA, shapeTransform(0, 0, 0), shapeTransform(0, 16, 0))
+	if !ok || math.Abs(contact.Depth-4) > 1e-9 || contact.Normal != (gamemath.Vector2{Y: -1}) {
+		t.Errorf("Expected box pushed up by 4 out of the circle below, got %+v", contact)
+	}
+
+	// A 45° diamond reaches sqrt(2)*10 ≈ 14.1 along X, its AABB would reach further
+	diamond := physics.NewOrientedBoxCollider(20, 20)
+	probe := physics.NewCollider(4, 4)
+	if _, ok := diamond.Contact(probe, shapeTransform(0, 0, 45), shapeTransform(12, 0, 0)); !ok {
+		t.Error("Expected probe at the diamond tip to overlap")
+	}
+	if _, ok := diamond.Contact(probe, shapeTransform(0, 0, 45), shapeTransform(12, 12, 0)); ok {
+		t.Error("Expected probe beside the diamond (inside its AABB) not to overlap")
+	}
+	contact, ok = probe.Contact(diamond, shapeTransform(15, 0, 0), shapeTransform(0, 0, 45))
+	if !ok || contact.Normal.X <= 0 || contact.Depth <= 0 {
+		t.Errorf("Expected probe pushed away from the diamond along +X, got %+v", contact)
+	}
+}
+
+// TestSceneShapeCollisionAndQueries tests shapes in the scene pipeline and queries.
+func TestSceneShapeCollisionAndQueries(t *testing.T) {
+	scene := core.NewScene()
+	ball := newSolidBox(0, 14)
+	ball.Collider = physics.NewCircleCollider(5)
+	ball.Body = physics.NewRigidBody(1)
+	floor := newSolidBox(0, 20)
+	floor.Collider = physics.NewOrientedBoxCollider(40, 4) // Top edge at y=18
+	scene.AddEntity(ball)
+	scene.AddEntity(floor)
+
+	entered := false
+	ball.OnCollisionEnter = func(self, other *core.Entity, info core.CollisionInfo) {
+		entered = other == floor && info.Normal == (gamemath.Vector2{Y: -1})
+	}
+	scene.Update(0)
+	if !entered {
+		t.Fatal("Expected circle-box enter callback with normal pointing up")
+	}
+	if math.Abs(ball.Transform.Position.Y-13) > 1e-9 {
+		t.Errorf("Expected ball resolved to rest on the floor at y=13, got %v", ball.Transform.Position.Y)
+	}
+
+	hit, ok := scene.Raycast(gamemath.Vector2{X: -50, Y: 13}, gamemath.Vector2{X: 1}, 100, physics.AllLayers)
+	if !ok || hit.Entity != ball || math.Abs(hit.Point.X+5) > 1e-9 || hit.Normal != (gamemath.Vector2{X: -1}) {
+		t.Errorf("Expected ray to hit the ball's left edge, got %+v", hit)
+	}
+	floor.Transform.Rotation = 90 // Now a 4x40 post spanning y=0..40
+	hit, ok = scene.Raycast(gamemath.Vector2{X: -50, Y: 30}, gamemath.Vector2{X: 1}, 100, physics.AllLayers)
+	if !ok || hit.Entity != floor || math.Abs(hit.Point.X+2) > 1e-9 || math.Abs(hit.Normal.X+1) > 1e-9 {
+		t.Errorf("Expected ray to hit the rotated post at x=-2, got %+v", hit)
+	}
+	if found := scene.OverlapCircle(gamemath.Vector2{X: 8, Y: 13}, 2, physics.AllLayers); len(found) != 0 {
+		t.Errorf("Expected query circle beside the ball to miss, got %d", len(found))
+	}
+}
@@ -0,0 +1,98 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// newSolidBox creates an active entity with a 10x10 collider.
+func newSolidBox(x, y float64) *core.Entity {
+	return &core.Entity{
+		Active:    true,
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: x, Y: y}, Scale: gamemath.Vector2{X: 1, Y: 1}},
+		Collider:  physics.NewCollider(10, 10),
+	}
+}
+
+// TestPenetration tests the minimum translation vector between boxes.
+func TestPenetration(t *testing.T) {
+	a := gamemath.Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	b := gamemath.Rectangle{X: 8, Y: 2, Width: 10, Height: 10}
+	contact := physics.Penetration(a, b)
+	if contact.Depth != 2 || contact.Normal != (gamemath.Vector2{X: -1}) {
+		t.Errorf("Expected depth 2 along -X, got %v along %v", contact.Depth, contact.Normal)
+	}
+	if contact.Point != (gamemath.Vector2{X: 9, Y: 6}) {
+		t.Errorf("Expected contact point (9, 6), got %v", contact.Point)
+	}
+	if c := physics.Penetration(a, gamemath.Rectangle{X: 20, Width: 5, Height: 5}); c.Depth != 0 {
+		t.Errorf("Expected no penetration for separate boxes, got %v", c.Depth)
+	}
+}
+
+// TestCollisionResolutionStopsAtWall tests that a dynamic body is pushed
+// out of a body-less wall and its velocity into the wall is removed.
+func TestCollisionResolutionStopsAtWall(t *testing.T) {
+	scene := core.NewScene()
+	player := newSolidBox(0, 0)
+	player.Body = physics.NewRigidBody(1)
+	player.Body.GravityScale = 0
+	player.Body.Velocity = gamemath.Vector2{X: 100, Y: 0}
+	wall := newSolidBox(12, 0)
+	scene.AddEntity(player)
+	scene.AddEntity(wall)
+
+	var info core.CollisionInfo
+	player.OnCollisionEnter = func(_, _ *core.Entity, i core.CollisionInfo) { info = i }
+
+	scene.Update(0.05) // Moves to x=5, overlapping the wall by 3
+
+	if math.Abs(player.Transform.Position.X-2) > 1e-9 {
+		t.Errorf("Expected player pushed back to x=2, got %v", player.Transform.Position.X)
+	}
+	if wall.Transform.Position.X != 12 {
+		t.Errorf("Expected wall to stay at x=12, got %v", wall.Transform.Position.X)
+	}
+	if player.Body.Velocity.X != 0 {
+		t.Errorf("Expected velocity into the wall removed, got %v", player.Body.Velocity.X)
+	}
+	if info.Normal != (gamemath.Vector2{X: -1}) || math.Abs(info.Depth-3) > 1e-9 || info.Trigger {
+		t.Errorf("Expected normal (-1, 0) with depth 3, got %v depth %v", info.Normal, info.Depth)
+	}
+}
+
+// TestCollisionResolutionSharesByMass tests two dynamic bodies splitting the
+// push by inverse mass, and triggers not being resolved.
+func TestCollisionResolutionSharesByMass(t *testing.T) {
+	scene := core.NewScene()
+	light := newSolidBox(0, 0)
+	light.Body = physics.NewRigidBody(1)
+	heavy := newSolidBox(7, 0)
+	heavy.Body = physics.NewRigidBody(2)
+	scene.AddEntity(light)
+	scene.AddEntity(heavy)
+
+	var heavyInfo core.CollisionInfo
+	heavy.OnCollisionEnter = func(_, _ *core.Entity, i core.CollisionInfo) { heavyInfo = i }
+	scene.Update(0)
+
+	if math.Abs(light.Transform.Position.X+2) > 1e-9 || math.Abs(heavy.Transform.Position.X-8) > 1e-9 {
+		t.Errorf("Expected light at -2 and heavy at 8, got %v and %v", light.Transform.Position.X, heavy.Transform.Position.X)
+	}
+	if heavyInfo.Normal != (gamemath.Vector2{X: 1}) {
+		t.Errorf("Expected heavy's normal to point away from light, got %v", heavyInfo.Normal)
+	}
+
+	sensor := newSolidBox(-2, 0)
+	sensor.Collider.IsTrigger = true
+	sensor.Body = physics.NewRigidBody(1)
+	scene.AddEntity(sensor)
+	scene.Update(0)
+	if sensor.Transform.Position.X != -2 {
+		t.Errorf("Expected trigger not to be pushed, got x=%v", sensor.Transform.Position.X)
+	}
+}
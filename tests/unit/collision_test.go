@@ -21,12 +21,12 @@ func TestAABBIntersection(t *testing.T) {
 
 	// Create entities
 	entity1 := &core.Entity{
-		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100, Y: 100}},
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100, Y: 100}, Scale: gamemath.Vector2{X: 1, Y: 1}},
 		Collider:  collider1,
 	}
 
 	entity2 := &core.Entity{
-		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 120, Y: 100}},
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 120, Y: 100}, Scale: gamemath.Vector2{X: 1, Y: 1}},
 		Collider:  collider2,
 	}
 
@@ -0,0 +1,122 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/combo"
+	"github.com/dshills/gogame/engine/input"
+)
+
+// TestComboQuarterCircle tests motion recognition and consumption.
+func TestComboQuarterCircle(t *testing.T) {
+	buffer := combo.NewBuffer(nil, input.ActionAttack)
+	fireball := combo.QuarterCircleForward("fireball", input.ActionAttack)
+
+	buffer.Push(combo.Neutral)
+	buffer.Push(combo.Down)
+	buffer.Push(combo.Down)
+	buffer.Push(combo.DownForward)
+	buffer.Push(combo.Forward)
+	buffer.Push(combo.Forward, input.ActionAttack)
+
+	if !buffer.Consume(fireball) {
+		t.Fatal("Expected fireball to be recognized")
+	}
+	if buffer.Check(fireball) {
+		t.Error("Expected consumed input not to trigger again")
+	}
+
+	// Missing the diagonal: no fireball.
+	buffer.Clear()
+	buffer.Push(combo.Down)
+	buffer.Push(combo.Forward, input.ActionAttack)
+	if buffer.Check(fireball) {
+		t.Error("Expected motion without diagonal to fail")
+	}
+}
+
+// TestComboBufferedPress tests leniency and facing mirroring.
+func TestComboBufferedPress(t *testing.T) {
+	buffer := combo.NewBuffer(nil, input.ActionAttack)
+	buffer.FacingLeft = true
+	fireball := combo.QuarterCircleForward("fireball", input.ActionAttack)
+
+	// Facing left, forward is the absolute left side (4 -> 6).
+	buffer.Push(combo.Down)
+	buffer.Push(combo.DownBack)
+	buffer.Push(combo.Back, input.ActionAttack)
+	for i := 0; i < 5; i++ {
+		buffer.Push(combo.Neutral)
+	}
+	if !buffer.Check(fireball) {
+		t.Fatal("Expected mirrored, buffered fireball within leniency")
+	}
+
+	for i := 0; i < buffer.Leniency; i++ {
+		buffer.Push(combo.Neutral)
+	}
+	if buffer.Check(fireball) {
+		t.Error("Expected buffered input to expire after leniency")
+	}
+}
+
+// TestComboDoubleTapPriority tests double-tap windows and priority-ordered detection.
+func TestComboDoubleTapPriority(t *testing.T) {
+	buffer := combo.NewBuffer(nil, input.ActionAttack)
+	dash := combo.DoubleTap("dash", combo.Forward)
+	buffer.Commands = []*combo.Command{dash, combo.QuarterCircleForward("fireball", input.ActionAttack)}
+
+	buffer.Push(combo.Forward)
+	for i := 0; i < 12; i++ {
+		buffer.Push(combo.Neutral)
+	}
+	buffer.Push(combo.Forward)
+	if buffer.Detect() != nil {
+		t.Error("Expected slow double-tap to fail")
+	}
+
+	buffer.Push(combo.Neutral)
+	buffer.Push(combo.Forward)
+	if got := buffer.Detect(); got != dash {
+		t.Errorf("Expected dash, got %v", got)
+	}
+}
+
+// TestComboSharedPrefix tests that a press consumed by one combo can't
+// start or continue another combo sharing its prefix.
+func TestComboSharedPrefix(t *testing.T) {
+	buffer := combo.NewBuffer(nil, input.ActionAttack)
+	jab := &combo.Command{Name: "jab", Steps: []combo.Step{{Button: input.ActionAttack}}, Priority: 1}
+	oneTwo := &combo.Command{
+		Name: "one-two",
+		Steps: []combo.Step{
+			{Button: input.ActionAttack},
+			{Button: input.ActionAttack, MaxGap: 8},
+		},
+		Priority: 2,
+	}
+	buffer.Commands = []*combo.Command{jab, oneTwo}
+
+	buffer.Push(combo.Neutral, input.ActionAttack)
+	if got := buffer.Detect(); got != jab {
+		t.Fatalf("Expected jab from the first press, got %v", got)
+	}
+	buffer.Push(combo.Neutral, input.ActionAttack)
+	if buffer.Check(oneTwo) {
+		t.Error("Expected the press used by jab not to start one-two")
+	}
+	if got := buffer.Detect(); got != jab {
+		t.Errorf("Expected the second press to be another jab, got %v", got)
+	}
+
+	// Unconsumed presses still chain: the higher-priority combo wins
+	buffer.Clear()
+	buffer.Push(combo.Neutral, input.ActionAttack)
+	buffer.Push(combo.Neutral, input.ActionAttack)
+	if got := buffer.Detect(); got != oneTwo {
+		t.Fatalf("Expected one-two from two buffered presses, got %v", got)
+	}
+	if buffer.Check(jab) {
+		t.Error("Expected presses used by one-two not to also trigger jab")
+	}
+}
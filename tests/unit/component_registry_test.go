@@ -0,0 +1,137 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// registeredPatrol is a behavior persisted through the default registry.
+type registeredPatrol struct {
+	Speed    float64   `json:"speed"`
+	Waypoint []float64 `json:"waypoint,omitempty"`
+	Steps    int       `json:"-"`
+	OnArrive func()
+	traveled float64
+}
+
+func (p *registeredPatrol) Update(_ *core.Entity, dt float64) {
+	p.traveled += p.Speed * dt
+}
+
+// registeredArmor is a data component with an untagged field and an
+// embedded struct.
+type registeredArmor struct {
+	registeredResist
+	Value int
+}
+
+type registeredResist struct {
+	Fire float64 `json:"fire"`
+}
+
+func init() {
+	core.RegisterBehavior[registeredPatrol]("RegisteredPatrol")
+	core.RegisterComponentType[registeredArmor](core.DefaultComponentRegistry(), "RegisteredArmor")
+}
+
+// TestRegisteredComponentsRoundTrip tests saving and loading registered
+// components with their field values.
+func TestRegisteredComponentsRoundTrip(t *testing.T) {
+	scene := core.NewScene()
+	entity := &core.Entity{Active: true, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}}
+	entity.AddComponent(&registeredPatrol{Speed: 40, Waypoint: []float64{1, 2}, Steps: 9, OnArrive: func() {}})
+	entity.AddComponent(&mockBehavior{}) // Unregistered: not saved
+	entity.AddComponent(&registeredArmor{registeredResist: registeredResist{Fire: 0.5}, Value: 3})
+	scene.AddEntity(entity)
+
+	var first bytes.Buffer
+	if err := scene.Save(&first); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if !strings.Contains(first.String(), `"type": "RegisteredPatrol"`) || strings.Contains(first.String(), "Steps") {
+		t.Errorf("Expected the patrol saved without its json:\"-\" field:\n%s", first.String())
+	}
+
+	loaded, err := core.LoadScene(bytes.NewReader(first.Bytes()), nil)
+	if err != nil {
+		t.Fatalf("LoadScene failed: %v", err)
+	}
+	restored := loaded.GetEntity(entity.ID)
+	patrol, ok := core.GetComponent[*registeredPatrol](restored)
+	if !ok || patrol.Speed != 40 || len(patrol.Waypoint) != 2 || patrol.Steps != 0 {
+		t.Errorf("Expected patrol with speed 40 and waypoint, got %+v", patrol)
+	}
+	if armor, ok := core.GetComponent[*registeredArmor](restored); !ok || armor.Fire != 0.5 || armor.Value != 3 {
+		t.Errorf("Expected armor with fire 0.5 and value 3, got %+v", armor)
+	}
+	if core.HasComponent[*mockBehavior](restored) {
+		t.Error("Expected unregistered behavior to be left out")
+	}
+
+	var second bytes.Buffer
+	if err := loaded.Save(&second); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if first.String() != second.String() {
+		t.Error("Expected byte-identical output after a round trip")
+	}
+
+	prefab := core.NewPrefab("guard", entity)
+	a, _ := prefab.Instantiate(scene, gamemath.Vector2{}, nil)
+	b, _ := prefab.Instantiate(scene, gamemath.Vector2{}, nil)
+	pa, _ := core.GetComponent[*registeredPatrol](a)
+	pb, _ := core.GetComponent[*registeredPatrol](b)
+	if pa == nil || pa == pb || pa.Speed != 40 {
+		t.Error("Expected each prefab instance to get its own patrol")
+	}
+
+	badScene := strings.Replace(first.String(), "RegisteredPatrol", "MissingPatrol", 1)
+	if _, err := core.LoadScene(strings.NewReader(badScene), nil); !errors.Is(err, core.ErrUnknownComponent) {
+		t.Errorf("Expected ErrUnknownComponent for an unregistered name, got %v", err)
+	}
+}
+
+// TestComponentFields tests listing and editing fields by serialized name.
+func TestComponentFields(t *testing.T) {
+	armor := &registeredArmor{Value: 2}
+	fields := core.ComponentFields(armor)
+	if len(fields) != 2 || fields[0].Name != "fire" || fields[1].Name != "Value" {
+		t.Fatalf("Expected fields [fire Value], got %v", fields)
+	}
+	if names := core.ComponentFields(&registeredPatrol{}); len(names) != 2 {
+		t.Errorf("Expected funcs and json:\"-\" fields skipped, got %v", names)
+	}
+	if core.ComponentFields(registeredArmor{}) != nil {
+		t.Error("Expected no fields for a non-pointer")
+	}
+
+	if err := core.SetComponentField(armor, "Value", 7.9); err != nil || armor.Value != 7 {
+		t.Errorf("Expected number conversion to 7, got %d (%v)", armor.Value, err)
+	}
+	if err := core.SetComponentField(armor, "fire", 0.25); err != nil || armor.Fire != 0.25 {
+		t.Errorf("Expected embedded field set, got %v (%v)", armor.Fire, err)
+	}
+	if err := core.SetComponentField(armor, "fire", "hot"); !errors.Is(err, core.ErrComponentField) {
+		t.Errorf("Expected ErrComponentField for a string, got %v", err)
+	}
+	if err := core.SetComponentField(armor, "ice", 1.0); !errors.Is(err, core.ErrComponentField) {
+		t.Errorf("Expected ErrComponentField for an unknown field, got %v", err)
+	}
+	if value, ok := core.ComponentFieldValue(armor, "Value"); !ok || value != 7 {
+		t.Errorf("Expected Value 7, got %v", value)
+	}
+
+	registry := core.DefaultComponentRegistry()
+	if name, ok := registry.NameOf(armor); !ok || name != "RegisteredArmor" {
+		t.Errorf("Expected RegisteredArmor, got %q", name)
+	}
+	built, err := registry.Build("RegisteredPatrol", core.ComponentParams{"speed": 12.0})
+	if patrol, ok := built.(*registeredPatrol); err != nil || !ok || patrol.Speed != 12 {
+		t.Errorf("Expected scripting-style build by name, got %v (%v)", built, err)
+	}
+}
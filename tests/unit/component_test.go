@@ -0,0 +1,102 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// healthComponent is plain component data.
+type healthComponent struct {
+	HP int
+}
+
+// orderBehavior records its name when updated.
+type orderBehavior struct {
+	name string
+	log  *[]string
+}
+
+func (b *orderBehavior) Update(_ *core.Entity, _ float64) {
+	*b.log = append(*b.log, b.name)
+}
+
+// TestComponentBuiltIns tests that built-in fields act as components.
+func TestComponentBuiltIns(t *testing.T) {
+	entity := &core.Entity{Active: true, Collider: physics.NewCollider(8, 8)}
+	if collider, ok := core.GetComponent[*physics.Collider](entity); !ok || collider != entity.Collider {
+		t.Error("Expected Collider field to be found as a component")
+	}
+
+	sprite := &graphics.Sprite{}
+	entity.AddComponent(sprite)
+	if entity.Sprite != sprite {
+		t.Error("Expected AddComponent to set the Sprite field")
+	}
+	if !entity.RemoveComponent(sprite) || entity.Sprite != nil {
+		t.Error("Expected RemoveComponent to clear the Sprite field")
+	}
+	if entity.RemoveComponent(&graphics.Sprite{}) {
+		t.Error("Expected removing an unattached sprite to fail")
+	}
+	if core.HasComponent[*physics.RigidBody](entity) {
+		t.Error("Expected no rigid body component")
+	}
+}
+
+// TestComponentCustomData tests attaching, finding, and removing custom components.
+func TestComponentCustomData(t *testing.T) {
+	scene := core.NewScene()
+	enemy := &core.Entity{Active: true}
+	health := &healthComponent{HP: 30}
+	enemy.AddComponent(health)
+	scene.AddEntity(enemy)
+	scene.AddEntity(&core.Entity{Active: true})
+
+	found, ok := core.GetComponent[*healthComponent](enemy)
+	if !ok || found != health {
+		t.Fatal("Expected to find the health component")
+	}
+	found.HP -= 10
+	if health.HP != 20 {
+		t.Errorf("Expected HP 20 through the component pointer, got %d", health.HP)
+	}
+	if with := core.EntitiesWith[*healthComponent](scene); len(with) != 1 || with[0] != enemy {
+		t.Errorf("Expected only the enemy to have health, got %d entities", len(with))
+	}
+
+	if !enemy.RemoveComponent(health) || core.HasComponent[*healthComponent](enemy) {
+		t.Error("Expected health component removed")
+	}
+}
+
+// TestComponentBehaviorOrder tests that behavior components update in the order added.
+func TestComponentBehaviorOrder(t *testing.T) {
+	var log []string
+	first := &orderBehavior{name: "first", log: &log}
+	second := &orderBehavior{name: "second", log: &log}
+	third := &orderBehavior{name: "third", log: &log}
+
+	entity := &core.Entity{Active: true, Behavior: first}
+	entity.AddComponent(second)
+	entity.AddComponent(third)
+	entity.Update(0.016)
+	if len(log) != 3 || log[0] != "first" || log[1] != "second" || log[2] != "third" {
+		t.Errorf("Expected first, second, third, got %v", log)
+	}
+
+	if behaviors := core.GetComponents[core.Behavior](entity); len(behaviors) != 3 {
+		t.Errorf("Expected 3 behavior components, got %d", len(behaviors))
+	}
+
+	log = log[:0]
+	if !entity.RemoveComponent(second) {
+		t.Fatal("Expected behavior component removed")
+	}
+	entity.Update(0.016)
+	if len(log) != 2 || log[1] != "third" {
+		t.Errorf("Expected first, third, got %v", log)
+	}
+}
@@ -0,0 +1,101 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/crafting"
+	"github.com/dshills/gogame/engine/inventory"
+)
+
+func newTestRecipeBook() *crafting.RecipeBook {
+	return crafting.NewRecipeBook(
+		&crafting.Recipe{
+			ID:      "plank",
+			Inputs:  []inventory.ItemStack{{ID: "log", Quantity: 1}},
+			Outputs: []inventory.ItemStack{{ID: "plank", Quantity: 4}},
+		},
+		&crafting.Recipe{
+			ID:           "sword",
+			Inputs:       []inventory.ItemStack{{ID: "iron", Quantity: 3}, {ID: "plank", Quantity: 1}},
+			Outputs:      []inventory.ItemStack{{ID: "sword", Quantity: 1}},
+			Station:      "forge",
+			Requirements: []string{"smithing"},
+		},
+	)
+}
+
+// TestCraftConsumesAndProduces tests a basic craft.
+func TestCraftConsumesAndProduces(t *testing.T) {
+	book := newTestRecipeBook()
+	bag := inventory.New()
+	_ = bag.Add("log", 3)
+
+	crafted := 0
+	book.OnCraft = func(_ *crafting.Recipe, times int) { crafted += times }
+
+	if err := book.Craft("plank", bag, crafting.Context{}, 2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if bag.Count("log") != 1 || bag.Count("plank") != 8 {
+		t.Errorf("Expected 1 log and 8 planks, got %d and %d", bag.Count("log"), bag.Count("plank"))
+	}
+	if crafted != 2 {
+		t.Errorf("Expected craft event for 2 crafts, got %d", crafted)
+	}
+	if n := book.MaxCraftable(book.Get("plank"), bag, crafting.Context{}); n != 1 {
+		t.Errorf("Expected 1 more craftable plank batch, got %d", n)
+	}
+}
+
+// TestCraftRequirements tests station, tag, and ingredient checks.
+func TestCraftRequirements(t *testing.T) {
+	book := newTestRecipeBook()
+	bag := inventory.New()
+	_ = bag.Add("iron", 3)
+	_ = bag.Add("plank", 1)
+
+	tests := []struct {
+		name string
+		ctx  crafting.Context
+		want error
+	}{
+		{"no station", crafting.Context{}, crafting.ErrWrongStation},
+		{"missing skill", crafting.Context{Station: "forge"}, crafting.ErrMissingRequirement},
+		{"ok", crafting.Context{Station: "forge", Tags: []string{"smithing"}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := book.Check(book.Get("sword"), bag, tt.ctx, 1)
+			if !errors.Is(err, tt.want) && !(err == nil && tt.want == nil) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	ctx := crafting.Context{Station: "forge", Tags: []string{"smithing"}}
+	if err := book.Craft("sword", bag, ctx, 2); !errors.Is(err, crafting.ErrMissingIngredients) {
+		t.Errorf("Expected ErrMissingIngredients, got %v", err)
+	}
+	if len(book.Craftable(bag, ctx)) != 1 {
+		t.Error("Expected only the sword to be craftable")
+	}
+}
+
+// TestCraftRollbackWhenFull tests that a failed output leaves the inventory unchanged.
+func TestCraftRollbackWhenFull(t *testing.T) {
+	book := newTestRecipeBook()
+	bag := inventory.New()
+	bag.MaxSlots = 2
+	_ = bag.Add("log", 2)
+	_ = bag.Add("stone", 1)
+
+	// Consuming 1 log keeps the log slot, so the plank needs a third slot
+	if err := book.Craft("plank", bag, crafting.Context{}, 1); !errors.Is(err, crafting.ErrOutputsDoNotFit) {
+		t.Fatalf("Expected ErrOutputsDoNotFit, got %v", err)
+	}
+	if bag.Count("log") != 2 || bag.Count("plank") != 0 {
+		t.Error("Expected inventory to be rolled back")
+	}
+}
@@ -0,0 +1,114 @@
+package unit
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestAnimationCurveInterpolation verifies each interpolation mode.
+func TestAnimationCurveInterpolation(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     gamemath.CurveInterpolation
+		at       float64
+		expected float64
+	}{
+		{"linear midpoint", gamemath.CurveLinear, 1, 5},
+		{"constant holds", gamemath.CurveConstant, 1.9, 0},
+		{"smooth midpoint", gamemath.CurveSmooth, 1, 5},
+		{"smooth eases in", gamemath.CurveSmooth, 0.5, 1.5625},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			curve := gamemath.NewAnimationCurve(
+				gamemath.Keyframe{Time: 2, Value: 10},
+				gamemath.Keyframe{Time: 0, Value: 0, Interpolation: tt.mode},
+			)
+			if got := curve.Evaluate(tt.at); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v at t=%v, got %v", tt.expected, tt.at, got)
+			}
+		})
+	}
+
+	cubic := gamemath.NewAnimationCurve(
+		gamemath.Keyframe{Time: 0, Value: 0, Interpolation: gamemath.CurveCubic, OutTangent: 1},
+		gamemath.Keyframe{Time: 1, Value: 1, InTangent: 1},
+	)
+	if got := cubic.Evaluate(0.25); math.Abs(got-0.25) > 1e-9 {
+		t.Errorf("Expected cubic with unit tangents to be a line, got %v", got)
+	}
+}
+
+// TestAnimationCurveWrap verifies clamp, loop, and ping-pong evaluation.
+func TestAnimationCurveWrap(t *testing.T) {
+	curve := gamemath.LinearCurve(0, 0, 1, 1)
+	if curve.Evaluate(-1) != 0 || curve.Evaluate(5) != 1 {
+		t.Error("Expected clamped curve to hold its end values")
+	}
+
+	curve.Wrap = gamemath.CurveLoop
+	if got := curve.Evaluate(2.25); math.Abs(got-0.25) > 1e-9 {
+		t.Errorf("Expected looped value 0.25, got %v", got)
+	}
+	if got := curve.Evaluate(-0.25); math.Abs(got-0.75) > 1e-9 {
+		t.Errorf("Expected looped value 0.75 before start, got %v", got)
+	}
+
+	curve.Wrap = gamemath.CurvePingPong
+	if got := curve.Evaluate(1.25); math.Abs(got-0.75) > 1e-9 {
+		t.Errorf("Expected ping-pong value 0.75, got %v", got)
+	}
+}
+
+// TestAnimationCurveAddKey verifies keys stay sorted and same-time keys are replaced.
+func TestAnimationCurveAddKey(t *testing.T) {
+	curve := gamemath.NewAnimationCurve()
+	if curve.Evaluate(3) != 0 {
+		t.Error("Expected empty curve to evaluate to 0")
+	}
+	curve.AddKey(gamemath.Keyframe{Time: 4, Value: 8})
+	curve.AddKey(gamemath.Keyframe{Time: 0, Value: 0})
+	curve.AddKey(gamemath.Keyframe{Time: 2, Value: 1})
+	curve.AddKey(gamemath.Keyframe{Time: 2, Value: 2})
+
+	if len(curve.Keys) != 3 {
+		t.Fatalf("Expected 3 keys, got %d", len(curve.Keys))
+	}
+	if curve.Start() != 0 || curve.End() != 4 {
+		t.Errorf("Expected range 0-4, got %v-%v", curve.Start(), curve.End())
+	}
+	if got := curve.Evaluate(3); got != 5 {
+		t.Errorf("Expected 5 between replaced key and end, got %v", got)
+	}
+}
+
+// TestParseAnimationCurve verifies loading a curve from JSON.
+func TestParseAnimationCurve(t *testing.T) {
+	data := []byte(`{
+		"wrap": "loop",
+		"keys": [
+			{"time": 10, "value": 3},
+			{"time": 0, "value": 1, "interpolation": "constant"}
+		]
+	}`)
+	curve, err := gamemath.ParseAnimationCurve(data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if curve.Wrap != gamemath.CurveLoop {
+		t.Errorf("Expected loop wrap, got %v", curve.Wrap)
+	}
+	if curve.Keys[0].Interpolation != gamemath.CurveConstant || curve.Evaluate(5) != 1 {
+		t.Error("Expected sorted keys with constant interpolation")
+	}
+
+	if _, err := gamemath.ParseAnimationCurve([]byte(`{"keys": []}`)); !errors.Is(err, gamemath.ErrEmptyCurve) {
+		t.Errorf("Expected ErrEmptyCurve, got %v", err)
+	}
+	if _, err := gamemath.ParseAnimationCurve([]byte(`{"keys": [{"interpolation": "bouncy"}]}`)); err == nil {
+		t.Error("Expected error for unknown interpolation")
+	}
+}
@@ -0,0 +1,112 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gogametest"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// raycastingBehavior casts one ray per update.
+type raycastingBehavior struct {
+	scene *core.Scene
+	dir   gamemath.Vector2
+}
+
+func (b *raycastingBehavior) Update(entity *core.Entity, dt float64) {
+	b.scene.Raycast(entity.Transform.Position, b.dir, 200, physics.AllLayers)
+}
+
+// TestDebugDrawLayers tests colliders, triggers, velocities, and raycasts are drawn.
+func TestDebugDrawLayers(t *testing.T) {
+	scene := core.NewScene()
+	debugDraw := core.NewDebugDraw()
+	debugDraw.ShowGrid = false
+	scene.SetDebugDraw(debugDraw)
+
+	wall := newSolidBox(100, 0)
+	scene.AddEntity(wall)
+	trigger := newSolidBox(0, 100)
+	trigger.Collider.IsTrigger = true
+	scene.AddEntity(trigger)
+	mover := &core.Entity{Active: true, Body: physics.NewRigidBody(1)}
+	mover.Body.Velocity = gamemath.Vector2{X: 50}
+	mover.Body.GravityScale = 0
+	scene.AddEntity(mover)
+	scene.AddEntity(&core.Entity{Active: true, Behavior: &raycastingBehavior{scene: scene, dir: gamemath.Vector2{X: 1}}})
+
+	renderer := gogametest.NewFakeRenderer()
+	scene.Update(1.0 / 60)
+	if err := debugDraw.Draw(renderer.Renderer, scene); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	if len(renderer.Calls()) != 0 {
+		t.Fatal("Expected nothing drawn while disabled")
+	}
+
+	debugDraw.Enabled = true
+	scene.Update(1.0 / 60)
+	if err := debugDraw.Draw(renderer.Renderer, scene); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	if rects := renderer.CallsOf(graphics.DrawKindRect); len(rects) != 1 || rects[0].Color != debugDraw.ColliderColor {
+		t.Errorf("Expected one collider AABB, got %d", len(rects))
+	}
+	fills := renderer.CallsOf(graphics.DrawKindFillRect)
+	if len(fills) != 2 || fills[0].Color != debugDraw.TriggerColor || fills[1].Color != debugDraw.RayHitColor {
+		t.Errorf("Expected a trigger zone and a ray hit marker, got %d fills", len(fills))
+	}
+	velocityLines, rayLines := 0, 0
+	for _, line := range renderer.CallsOf(graphics.DrawKindLine) {
+		switch line.Color {
+		case debugDraw.VelocityColor:
+			velocityLines++
+		case debugDraw.RayHitColor:
+			rayLines++
+		}
+	}
+	if velocityLines != 3 {
+		t.Errorf("Expected a velocity arrow (3 lines), got %d", velocityLines)
+	}
+	if rayLines != 4 {
+		t.Errorf("Expected a hit ray plus normal arrow (4 lines), got %d", rayLines)
+	}
+}
+
+// TestDebugDrawToggleKey tests the runtime toggle key.
+func TestDebugDrawToggleKey(t *testing.T) {
+	debugDraw := core.NewDebugDraw()
+	in := gogametest.NewFakeInput()
+
+	in.SetKeyState(input.KeyF3, true)
+	debugDraw.HandleInput(in.InputManager)
+	if !debugDraw.Enabled {
+		t.Error("Expected F3 to enable debug draw")
+	}
+	in.NextFrame()
+	debugDraw.HandleInput(in.InputManager)
+	if !debugDraw.Enabled {
+		t.Error("Expected holding F3 not to toggle again")
+	}
+}
+
+// TestDebugDrawGrid tests grid lines cover the view.
+func TestDebugDrawGrid(t *testing.T) {
+	scene := core.NewScene() // 800x600 view centered on the origin
+	debugDraw := core.NewDebugDraw()
+	debugDraw.Enabled = true
+	debugDraw.GridSize = 100
+
+	renderer := gogametest.NewFakeRenderer()
+	if err := debugDraw.Draw(renderer.Renderer, scene); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	// X from -400 to 400 (9 lines), Y from -300 to 300 (7 lines)
+	if lines := len(renderer.CallsOf(graphics.DrawKindLine)); lines != 16 {
+		t.Errorf("Expected 16 grid lines, got %d", lines)
+	}
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/debug"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestDebugGridLines tests visible line placement and classification.
+func TestDebugGridLines(t *testing.T) {
+	camera := graphics.NewCamera() // 800x600 centered on origin: x -400..400, y -300..300
+	grid := debug.NewGrid(100)
+	grid.MajorEvery = 2
+
+	vertical, horizontal := 0, 0
+	kinds := make(map[float64]debug.GridLineKind)
+	for _, line := range grid.Lines(camera) {
+		if line.Vertical {
+			vertical++
+			kinds[line.World] = line.Kind
+		} else {
+			horizontal++
+		}
+	}
+	if vertical != 9 || horizontal != 7 {
+		t.Errorf("Expected 9 vertical and 7 horizontal lines, got %d and %d", vertical, horizontal)
+	}
+	if kinds[0] != debug.GridAxis || kinds[200] != debug.GridMajor || kinds[-100] != debug.GridMinor {
+		t.Errorf("Expected axis at 0, major at 200, minor at -100, got %v", kinds)
+	}
+}
+
+// TestDebugGridThinsWhenZoomedOut tests that on-screen spacing never drops
+// below MinPixelSpacing.
+func TestDebugGridThinsWhenZoomedOut(t *testing.T) {
+	camera := graphics.NewCamera()
+	grid := debug.NewGrid(16) // Major every 4 cells
+	if step := grid.Step(camera); step != 16 {
+		t.Errorf("Expected full grid at zoom 1, got step %v", step)
+	}
+
+	camera.Zoom = 0.25 // 4px cells
+	if step := grid.Step(camera); step != 64 {
+		t.Errorf("Expected major lines only (64), got step %v", step)
+	}
+
+	camera.Zoom = 0.01
+	step := grid.Step(camera)
+	if step*camera.Zoom < grid.MinPixelSpacing {
+		t.Errorf("Expected on-screen spacing >= %v, got %v", grid.MinPixelSpacing, step*camera.Zoom)
+	}
+}
+
+// TestDebugOverlayToggle tests that a disabled overlay draws nothing.
+func TestDebugOverlayToggle(t *testing.T) {
+	overlay := debug.NewOverlay()
+	ruler := &debug.Ruler{From: gamemath.Vector2{X: 0, Y: 0}, To: gamemath.Vector2{X: 30, Y: 40}}
+	overlay.Add(ruler)
+	if ruler.Length() != 50 {
+		t.Errorf("Expected ruler length 50, got %v", ruler.Length())
+	}
+
+	overlay.Toggle()
+	if err := overlay.Draw(nil, graphics.NewCamera()); err != nil {
+		t.Errorf("Expected disabled overlay to skip drawing, got %v", err)
+	}
+	overlay.Remove(ruler)
+	if len(overlay.Drawers()) != 0 {
+		t.Errorf("Expected ruler removed, got %d drawers", len(overlay.Drawers()))
+	}
+}
@@ -0,0 +1,71 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/debug"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// TestRigidBodySleeping tests that a body sleeps after resting for
+// SleepDelay and wakes on an impulse.
+func TestRigidBodySleeping(t *testing.T) {
+	body := physics.NewRigidBody(1)
+	body.SleepSpeed = 1
+	var transform gamemath.Transform
+
+	for i := 0; i < 4; i++ {
+		body.Integrate(&transform, gamemath.Vector2{}, 0.1)
+	}
+	if body.Sleeping() {
+		t.Error("Expected body awake before SleepDelay")
+	}
+	body.Integrate(&transform, gamemath.Vector2{}, 0.1)
+	if !body.Sleeping() {
+		t.Error("Expected body asleep after resting for SleepDelay")
+	}
+
+	body.ApplyImpulse(gamemath.Vector2{X: 10})
+	if body.Sleeping() {
+		t.Error("Expected impulse to wake the body")
+	}
+}
+
+// TestDebugPhysicsColors tests body state colors and that contacts are
+// recorded for the debug view.
+func TestDebugPhysicsColors(t *testing.T) {
+	scene := core.NewScene()
+	view := debug.NewPhysics(scene)
+
+	wall := newSolidBox(0, 0)
+	mover := newSolidBox(5, 0)
+	mover.Body = physics.NewRigidBody(1)
+	platform := newSolidBox(100, 0)
+	platform.Body = &physics.RigidBody{Type: physics.BodyKinematic}
+	scene.AddEntity(wall)
+	scene.AddEntity(mover)
+	scene.AddEntity(platform)
+
+	if view.BodyColor(wall) != view.StaticColor || view.BodyColor(mover) != view.DynamicColor || view.BodyColor(platform) != view.KinematicColor {
+		t.Error("Expected static, dynamic, and kinematic colors")
+	}
+
+	scene.Update(0)
+	contacts := scene.Contacts()
+	if len(contacts) != 1 || contacts[0].A != wall || contacts[0].B != mover {
+		t.Fatalf("Expected one wall/mover contact, got %d", len(contacts))
+	}
+	if contacts[0].Info.Normal != (gamemath.Vector2{X: -1}) {
+		t.Errorf("Expected normal pointing from mover toward wall, got %v", contacts[0].Info.Normal)
+	}
+
+	mover.Body.SleepSpeed = 1
+	for i := 0; i < 10; i++ {
+		scene.Update(0.1)
+	}
+	if view.BodyColor(mover) != view.SleepingColor {
+		t.Error("Expected resting body drawn with the sleeping color")
+	}
+}
@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/decals"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestDecalCapFadesOldest tests that exceeding the cap fades and removes the oldest decals.
+func TestDecalCapFadesOldest(t *testing.T) {
+	layer, err := decals.New(nil, gamemath.Rectangle{Width: 100, Height: 100}, -10)
+	if err != nil {
+		t.Fatalf("Expected layer, got %v", err)
+	}
+	layer.MaxDecals = 2
+	layer.FadeTime = 0.5
+
+	splat := &graphics.Sprite{Alpha: 1}
+	first := layer.Stamp(splat, gamemath.Vector2{X: 10, Y: 10}, 0, 1)
+	layer.Stamp(splat, gamemath.Vector2{X: 20, Y: 20}, 0, 1)
+	layer.Stamp(splat, gamemath.Vector2{X: 30, Y: 30}, 0, 1)
+
+	if !first.Fading() {
+		t.Fatal("Expected oldest decal to start fading past the cap")
+	}
+	if layer.Len() != 3 {
+		t.Errorf("Expected fading decal to remain until faded, got %d decals", layer.Len())
+	}
+
+	layer.Update(layer.Entity(), 0.6)
+	if layer.Len() != 2 || layer.Decals()[0] == first {
+		t.Errorf("Expected oldest decal removed after fade, got %d decals", layer.Len())
+	}
+
+	if layer.Stamp(splat, gamemath.Vector2{X: 500, Y: 500}, 0, 1) != nil {
+		t.Error("Expected stamps outside the region to be ignored")
+	}
+}
+
+// TestDecalLifetime tests lifetime-based fading.
+func TestDecalLifetime(t *testing.T) {
+	layer, _ := decals.New(nil, gamemath.Rectangle{Width: 100, Height: 100}, -10)
+	layer.Lifetime = 2
+	layer.FadeTime = 0
+
+	layer.Stamp(&graphics.Sprite{Alpha: 1}, gamemath.Vector2{X: 50, Y: 50}, 45, 2)
+	layer.Update(layer.Entity(), 1)
+	if layer.Len() != 1 {
+		t.Fatal("Expected decal to persist before its lifetime")
+	}
+	layer.Update(layer.Entity(), 1.5)
+	if layer.Len() != 0 {
+		t.Errorf("Expected decal removed after lifetime, got %d", layer.Len())
+	}
+}
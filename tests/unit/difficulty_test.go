@@ -0,0 +1,99 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/difficulty"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestDifficultyByTime verifies time-driven parameters ramp as the manager ticks.
+func TestDifficultyByTime(t *testing.T) {
+	diff := difficulty.New()
+	diff.Define(difficulty.EnemySpeed, difficulty.ByTime, gamemath.LinearCurve(0, 1, 100, 3))
+
+	if got := diff.Get(difficulty.EnemySpeed); got != 1 {
+		t.Errorf("Expected speed 1 at start, got %v", got)
+	}
+	for i := 0; i < 50; i++ {
+		diff.Update(nil, 1)
+	}
+	if got := diff.Get(difficulty.EnemySpeed); !almostEqual(got, 2, 1e-9) {
+		t.Errorf("Expected speed 2 after 50s, got %v", got)
+	}
+
+	diff.Reset()
+	if diff.Elapsed() != 0 || diff.Get(difficulty.EnemySpeed) != 1 {
+		t.Error("Expected Reset to return to time 0")
+	}
+
+	if _, err := diff.Value("missing"); !errors.Is(err, difficulty.ErrUnknownParameter) {
+		t.Errorf("Expected ErrUnknownParameter, got %v", err)
+	}
+}
+
+// TestDifficultyByPerformance verifies performance samples move the rating and parameters.
+func TestDifficultyByPerformance(t *testing.T) {
+	diff := difficulty.New()
+	diff.Smoothing = 0.5
+	diff.Define(difficulty.SpawnInterval, difficulty.ByPerformance, gamemath.LinearCurve(0, 2, 1, 1))
+
+	if got := diff.Get(difficulty.SpawnInterval); got != 1.5 {
+		t.Errorf("Expected interval 1.5 at neutral performance, got %v", got)
+	}
+	diff.RecordPerformance(1)
+	if got := diff.Performance(); got != 0.75 {
+		t.Errorf("Expected performance 0.75, got %v", got)
+	}
+	if got := diff.Get(difficulty.SpawnInterval); got != 1.25 {
+		t.Errorf("Expected interval 1.25, got %v", got)
+	}
+
+	diff.Tick(1000)
+	if got := diff.Get(difficulty.SpawnInterval); got != 1.25 {
+		t.Errorf("Expected performance parameter to ignore time, got %v", got)
+	}
+
+	diff.SetPerformance(-3)
+	if diff.Performance() != 0 {
+		t.Errorf("Expected performance clamped to 0, got %v", diff.Performance())
+	}
+}
+
+// TestDifficultyLoad verifies parameters load from JSON.
+func TestDifficultyLoad(t *testing.T) {
+	diff := difficulty.New()
+	data := []byte(`{
+		"enemy_speed": {
+			"input": "time",
+			"curve": {"keys": [{"time": 60, "value": 2}, {"time": 0, "value": 1}]}
+		},
+		"spawn_interval": {
+			"input": "performance",
+			"curve": {"keys": [{"time": 0, "value": 3}]}
+		}
+	}`)
+	if err := diff.Load(data); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if names := diff.Names(); len(names) != 2 || names[0] != difficulty.EnemySpeed {
+		t.Errorf("Expected two sorted names, got %v", names)
+	}
+	diff.Tick(30)
+	if got := diff.Get(difficulty.EnemySpeed); got != 1.5 {
+		t.Errorf("Expected speed 1.5 at 30s, got %v", got)
+	}
+
+	bad := []byte(`{"enemy_speed": {"input": "luck", "curve": {"keys": [{"time": 0, "value": 1}]}}}`)
+	if err := diff.Load(bad); err == nil {
+		t.Error("Expected error for unknown input")
+	}
+	empty := []byte(`{"boss_health": {"input": "time"}}`)
+	if err := diff.Load(empty); !errors.Is(err, gamemath.ErrEmptyCurve) {
+		t.Errorf("Expected ErrEmptyCurve, got %v", err)
+	}
+	if diff.Has("boss_health") {
+		t.Error("Expected failed load to define nothing")
+	}
+}
@@ -0,0 +1,56 @@
+package unit
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestShockwaveDisplacement tests ring displacement, fade, and expiry.
+func TestShockwaveDisplacement(t *testing.T) {
+	distortion := graphics.NewDistortion(nil)
+	distortion.AddShockwave(gamemath.Vector2{X: 50, Y: 50}, 100, 10, 4, 1)
+	distortion.Update(0.2) // Radius 20
+
+	dx, dy := distortion.Displacement(70, 50)
+	if dx <= 0 || dy != 0 {
+		t.Errorf("Expected outward displacement on the ring, got (%f, %f)", dx, dy)
+	}
+	if dx, dy := distortion.Displacement(50, 60); dx != 0 || dy != 0 {
+		t.Errorf("Expected no displacement inside the ring, got (%f, %f)", dx, dy)
+	}
+
+	distortion.Update(1)
+	if len(distortion.Shockwaves()) != 0 {
+		t.Error("Expected shockwave to expire after its duration")
+	}
+}
+
+// TestDistortionApply tests that only pixels near emitters change.
+func TestDistortionApply(t *testing.T) {
+	frame := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 40; y++ {
+			frame.SetRGBA(x, y, color.RGBA{R: uint8(x * 6), A: 255})
+		}
+	}
+
+	distortion := graphics.NewDistortion(nil)
+	distortion.AddHeatHaze(&graphics.HeatHaze{
+		Region:    gamemath.Rectangle{X: 0, Y: 0, Width: 20, Height: 20},
+		Amplitude: 3,
+		Frequency: 0.25,
+	})
+	distortion.Update(0)
+	distortion.Apply(frame)
+
+	if got := frame.RGBAAt(10, 1).R; got == 60 {
+		t.Error("Expected pixel inside haze to be displaced")
+	}
+	if got := frame.RGBAAt(30, 30).R; got != 180 {
+		t.Errorf("Expected pixel outside haze unchanged, got %d", got)
+	}
+}
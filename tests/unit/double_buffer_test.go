@@ -0,0 +1,87 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// chaseBehavior moves its entity to one unit right of a target and optionally
+// shoves the target (a write to another entity).
+type chaseBehavior struct {
+	target *core.Entity
+	shove  bool
+}
+
+func (b *chaseBehavior) Update(entity *core.Entity, dt float64) {
+	entity.Transform.Position.X = b.target.Transform.Position.X + 1
+	if b.shove {
+		b.target.Transform.Position.Y = 99
+	}
+}
+
+// stepBehavior moves its entity 10 units right per update.
+type stepBehavior struct{}
+
+func (stepBehavior) Update(entity *core.Entity, dt float64) {
+	entity.Transform.Position.X += 10
+}
+
+// buildChase creates a leader and chaser, adding the leader first if leaderFirst.
+func buildChase(leaderFirst, doubleBuffered bool) (leader, chaser *core.Entity, scene *core.Scene) {
+	scene = core.NewScene()
+	scene.SetDoubleBuffered(doubleBuffered)
+	leader = &core.Entity{Active: true, Behavior: stepBehavior{}}
+	chaser = &core.Entity{Active: true, Behavior: &chaseBehavior{target: leader}}
+	if leaderFirst {
+		scene.AddEntity(leader)
+		scene.AddEntity(chaser)
+	} else {
+		scene.AddEntity(chaser)
+		scene.AddEntity(leader)
+	}
+	return leader, chaser, scene
+}
+
+// TestSceneDoubleBufferedOrderIndependent tests that reads see last frame regardless of insertion order.
+func TestSceneDoubleBufferedOrderIndependent(t *testing.T) {
+	// Without double buffering the result depends on order
+	_, chaser, scene := buildChase(true, false)
+	scene.Update(0.016)
+	if chaser.Transform.Position.X != 11 {
+		t.Fatalf("Expected single-buffered chaser to see the new leader position, got %v", chaser.Transform.Position.X)
+	}
+
+	for _, leaderFirst := range []bool{true, false} {
+		leader, chaser, scene := buildChase(leaderFirst, true)
+		scene.Update(0.016)
+		if chaser.Transform.Position.X != 1 || leader.Transform.Position.X != 10 {
+			t.Errorf("Expected chaser at 1 and leader at 10 (leaderFirst=%v), got %v and %v",
+				leaderFirst, chaser.Transform.Position.X, leader.Transform.Position.X)
+		}
+		scene.Update(0.016)
+		if chaser.Transform.Position.X != 11 {
+			t.Errorf("Expected chaser to see last frame's leader at 10, got %v", chaser.Transform.Position.X)
+		}
+	}
+}
+
+// TestSceneDoubleBufferedDiscardsForeignWrites tests that only an entity's own writes survive.
+func TestSceneDoubleBufferedDiscardsForeignWrites(t *testing.T) {
+	for _, leaderFirst := range []bool{true, false} {
+		leader, chaser, scene := buildChase(leaderFirst, true)
+		chaser.Behavior.(*chaseBehavior).shove = true
+		idle := &core.Entity{Active: true, Transform: gamemath.Transform{Position: gamemath.Vector2{X: 5}}}
+		scene.AddEntity(idle)
+		chaser.Behavior = core.Behaviors{chaser.Behavior, &chaseBehavior{target: idle, shove: true}}
+		scene.Update(0.016)
+
+		if leader.Transform.Position.Y != 0 || idle.Transform.Position.Y != 0 {
+			t.Errorf("Expected writes to other entities to be discarded (leaderFirst=%v)", leaderFirst)
+		}
+		if chaser.Transform.Position.X != 6 {
+			t.Errorf("Expected chaser's own last write to be kept, got %v", chaser.Transform.Position.X)
+		}
+	}
+}
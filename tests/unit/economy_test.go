@@ -0,0 +1,145 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/economy"
+	"github.com/dshills/gogame/engine/inventory"
+)
+
+func newTestShop() *economy.Shop {
+	return economy.NewShop(economy.NewCatalog(
+		&economy.CatalogItem{
+			ID:        "potion",
+			BuyPrice:  economy.Price{Currency: "gold", Amount: 10},
+			SellPrice: economy.Price{Currency: "gold", Amount: 4},
+			Stock:     economy.Unlimited,
+		},
+		&economy.CatalogItem{
+			ID:       "sword",
+			BuyPrice: economy.Price{Currency: "gold", Amount: 50},
+			Stock:    1,
+		},
+	))
+}
+
+// TestShopBuy tests purchasing with funds, stock, and events.
+func TestShopBuy(t *testing.T) {
+	shop := newTestShop()
+	wallet := economy.NewWallet()
+	_ = wallet.Deposit("gold", 100)
+	bag := inventory.New()
+
+	var lastTx economy.Transaction
+	shop.OnTransaction = func(tx economy.Transaction) { lastTx = tx }
+
+	if err := shop.Buy(wallet, bag, "potion", 3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if wallet.Balance("gold") != 70 || bag.Count("potion") != 3 {
+		t.Errorf("Expected 70 gold and 3 potions, got %d gold and %d potions", wallet.Balance("gold"), bag.Count("potion"))
+	}
+	if lastTx.Kind != economy.Buy || lastTx.Total.Amount != 30 {
+		t.Errorf("Expected buy transaction of 30, got %+v", lastTx)
+	}
+
+	if err := shop.Buy(wallet, bag, "sword", 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := shop.Buy(wallet, bag, "sword", 1); !errors.Is(err, economy.ErrOutOfStock) {
+		t.Errorf("Expected ErrOutOfStock, got %v", err)
+	}
+	if err := shop.Buy(wallet, bag, "potion", 5); !errors.Is(err, economy.ErrInsufficientFunds) {
+		t.Errorf("Expected ErrInsufficientFunds, got %v", err)
+	}
+	if bag.Count("potion") != 3 || wallet.Balance("gold") != 20 {
+		t.Error("Expected failed purchase to leave state unchanged")
+	}
+}
+
+// TestShopBuyFailureFiresNoChanges tests that a rejected purchase leaves
+// the wallet and bag untouched without change callbacks.
+func TestShopBuyFailureFiresNoChanges(t *testing.T) {
+	shop := newTestShop()
+	wallet := economy.NewWallet()
+	_ = wallet.Deposit("gold", 100)
+	bag := inventory.New()
+	bag.MaxSlots = 1
+	_ = bag.Add("herb", 1)
+
+	walletChanges, bagChanges := 0, 0
+	wallet.OnChanged = func(string, int64, int64) { walletChanges++ }
+	bag.OnChanged = func(string, int, int) { bagChanges++ }
+
+	if err := shop.Buy(wallet, bag, "potion", 1); !errors.Is(err, inventory.ErrInventoryFull) {
+		t.Errorf("Expected ErrInventoryFull, got %v", err)
+	}
+	if walletChanges != 0 || bagChanges != 0 || wallet.Balance("gold") != 100 {
+		t.Errorf("Expected no changes, got %d wallet and %d bag callbacks", walletChanges, bagChanges)
+	}
+
+	bag.MaxSlots = 0
+	if err := shop.Buy(wallet, bag, "potion", 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if walletChanges != 1 || bagChanges != 1 {
+		t.Errorf("Expected one callback each, got %d wallet and %d bag", walletChanges, bagChanges)
+	}
+
+	if err := shop.Buy(wallet, bag, "potion", 1<<62); !errors.Is(err, economy.ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount for an overflowing total, got %v", err)
+	}
+	if walletChanges != 1 || bagChanges != 1 {
+		t.Errorf("Expected the overflow to change nothing, got %d wallet and %d bag callbacks", walletChanges, bagChanges)
+	}
+}
+
+// TestShopSell tests selling items back to the shop.
+func TestShopSell(t *testing.T) {
+	shop := newTestShop()
+	wallet := economy.NewWallet()
+	bag := inventory.New()
+	_ = bag.Add("potion", 2)
+
+	if err := shop.Sell(wallet, bag, "potion", 2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if wallet.Balance("gold") != 8 || bag.Count("potion") != 0 {
+		t.Errorf("Expected 8 gold and no potions, got %d gold", wallet.Balance("gold"))
+	}
+
+	if err := shop.Sell(wallet, bag, "potion", 1); !errors.Is(err, inventory.ErrInsufficientItems) {
+		t.Errorf("Expected ErrInsufficientItems, got %v", err)
+	}
+	if err := shop.Sell(wallet, bag, "sword", 1); !errors.Is(err, economy.ErrNotPurchased) {
+		t.Errorf("Expected ErrNotPurchased, got %v", err)
+	}
+
+	// A total that overflows is rejected before the items are taken
+	transactions := 0
+	shop.OnTransaction = func(economy.Transaction) { transactions++ }
+	_ = bag.Add("potion", 1<<62)
+	if err := shop.Sell(wallet, bag, "potion", 1<<62); !errors.Is(err, economy.ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount for an overflowing total, got %v", err)
+	}
+	if bag.Count("potion") != 1<<62 || wallet.Balance("gold") != 8 || transactions != 0 {
+		t.Errorf("Expected the overflow to change nothing, got %d potions, %d gold, %d transactions",
+			bag.Count("potion"), wallet.Balance("gold"), transactions)
+	}
+}
+
+// TestWalletBinding tests UI bindings on wallet balances.
+func TestWalletBinding(t *testing.T) {
+	wallet := economy.NewWallet()
+	var shown []int64
+	wallet.Bind("gems", func(_ string, balance int64) { shown = append(shown, balance) })
+
+	_ = wallet.Deposit("gems", 5)
+	_ = wallet.Withdraw("gems", 2)
+	_ = wallet.Deposit("gold", 1) // Different currency, no update
+
+	if len(shown) != 3 || shown[0] != 0 || shown[1] != 5 || shown[2] != 3 {
+		t.Errorf("Expected binding updates [0 5 3], got %v", shown)
+	}
+}
@@ -0,0 +1,158 @@
+package unit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// definedHealth is a plain data component built from definition params.
+type definedHealth struct {
+	HP  int `json:"hp"`
+	Max int `json:"max"`
+}
+
+// newDefinitionRegistry registers a behavior and a data component.
+func newDefinitionRegistry() *core.ComponentRegistry {
+	registry := core.NewComponentRegistry()
+	registry.Register("Patrol", func(params core.ComponentParams) (any, error) {
+		return &patrolBehavior{speed: params.Float("speed", 50)}, nil
+	})
+	registry.Register("Health", func(params core.ComponentParams) (any, error) {
+		health := &definedHealth{}
+		if err := params.Decode(health); err != nil {
+			return nil, err
+		}
+		if health.HP == 0 {
+			health.HP = health.Max
+		}
+		return health, nil
+	})
+	return registry
+}
+
+const goblinYAML = `
+- name: goblin
+  entity:
+    layer: 2
+    sprite: {texture: sprites/goblin.png}
+    collider: {bounds: {x: -8, y: -8, width: 16, height: 16}}
+    body: {}
+    tags: [enemy]
+  properties: {gold: 5}
+  components:
+    - type: Patrol
+      params: {speed: 80}
+    - type: Health
+      params: {max: 30}
+- name: coin
+  entity:
+    tags: [item]
+`
+
+// TestEntityDefinitionsFromYAML tests parsing defaults and instantiating
+// components built from the registry.
+func TestEntityDefinitionsFromYAML(t *testing.T) {
+	definitions, err := core.ParseEntityDefinitions([]byte(goblinYAML))
+	if err != nil {
+		t.Fatalf("ParseEntityDefinitions failed: %v", err)
+	}
+	if len(definitions) != 2 || definitions[0].Name != "goblin" || definitions[1].Name != "coin" {
+		t.Fatalf("Expected goblin and coin, got %d definitions", len(definitions))
+	}
+
+	goblin := definitions[0].Entity
+	if !goblin.Active || goblin.ScaleX != 1 || goblin.ScaleY != 1 {
+		t.Errorf("Expected active entity with scale 1, got %v %v/%v", goblin.Active, goblin.ScaleX, goblin.ScaleY)
+	}
+	if goblin.Sprite.Alpha != 1 || goblin.Sprite.Color.A != 255 {
+		t.Errorf("Expected opaque untinted sprite, got %+v", goblin.Sprite)
+	}
+	if goblin.Collider.Mask != 0xFFFFFFFF || goblin.Collider.Bounds.Width != 16 {
+		t.Errorf("Expected collider defaults with 16px bounds, got %+v", goblin.Collider)
+	}
+	if goblin.Body.Type != "dynamic" || goblin.Body.Mass != 1 || goblin.Body.GravityScale != 1 {
+		t.Errorf("Expected dynamic body defaults, got %+v", goblin.Body)
+	}
+	if definitions[1].Entity.Sprite != nil {
+		t.Error("Expected no sprite when none is defined")
+	}
+
+	prefab, err := definitions[0].Prefab(newDefinitionRegistry())
+	if err != nil {
+		t.Fatalf("Prefab failed: %v", err)
+	}
+	scene := core.NewScene()
+	first, err := prefab.Instantiate(scene, gamemath.Vector2{X: 10}, nil)
+	if err != nil {
+		t.Fatalf("Instantiate failed: %v", err)
+	}
+	second, _ := prefab.Instantiate(scene, gamemath.Vector2{X: 20}, nil)
+
+	patrol, ok := first.Behavior.(*patrolBehavior)
+	if !ok || patrol.speed != 80 {
+		t.Errorf("Expected Patrol behavior with speed 80, got %v", first.Behavior)
+	}
+	health, ok := core.GetComponent[*definedHealth](first)
+	if !ok || health.HP != 30 || health.Max != 30 {
+		t.Errorf("Expected Health 30/30, got %+v", health)
+	}
+	if other, _ := core.GetComponent[*definedHealth](second); other == health {
+		t.Error("Expected each instance to get its own components")
+	}
+	if first.Layer != 2 || !first.HasTag("enemy") || first.Prefab().Property("gold", 0) != 5 {
+		t.Errorf("Expected layer, tag, and property from the file")
+	}
+}
+
+// TestEntityDefinitionFromJSON tests that a single JSON object parses.
+func TestEntityDefinitionFromJSON(t *testing.T) {
+	data := `{"name": "potion", "entity": {"layer": 1}, "components": [{"type": "Health", "params": {"hp": 5, "max": 10}}]}`
+	definitions, err := core.ParseEntityDefinitions([]byte(data))
+	if err != nil || len(definitions) != 1 {
+		t.Fatalf("Expected one definition, got %d (%v)", len(definitions), err)
+	}
+	if params := definitions[0].Components[0].Params; params.Int("hp", 0) != 5 || params.Int("missing", 7) != 7 {
+		t.Errorf("Expected typed param access with fallback, got %v", params)
+	}
+	if _, err := definitions[0].Prefab(newDefinitionRegistry()); err != nil {
+		t.Errorf("Prefab failed: %v", err)
+	}
+}
+
+// TestEntityDefinitionErrors tests rejecting malformed files and unknown
+// components or parameters.
+func TestEntityDefinitionErrors(t *testing.T) {
+	invalid := map[string]string{
+		"unknown field":  "name: a\nentity: {layr: 2}",
+		"missing name":   "entity: {layer: 2}",
+		"duplicate name": "- name: a\n- name: a",
+		"not a mapping":  "- 42",
+		"malformed":      "name: [a",
+	}
+	for label, data := range invalid {
+		if _, err := core.ParseEntityDefinitions([]byte(data)); !errors.Is(err, core.ErrInvalidDefinition) {
+			t.Errorf("Expected ErrInvalidDefinition for %s, got %v", label, err)
+		}
+	}
+
+	registry := newDefinitionRegistry()
+	definitions, err := core.LoadEntityDefinitions(strings.NewReader("name: a\ncomponents: [{type: Flying}]"))
+	if err != nil {
+		t.Fatalf("LoadEntityDefinitions failed: %v", err)
+	}
+	if _, err := definitions[0].Prefab(registry); !errors.Is(err, core.ErrUnknownComponent) {
+		t.Errorf("Expected ErrUnknownComponent, got %v", err)
+	}
+
+	definitions, _ = core.ParseEntityDefinitions([]byte("name: a\ncomponents: [{type: Health, params: {maxx: 3}}]"))
+	if _, err := definitions[0].Prefab(registry); err == nil {
+		t.Error("Expected a misspelled param to fail the build")
+	}
+	if names := registry.Names(); len(names) != 2 || names[0] != "Health" || !registry.Registered("Patrol") {
+		t.Errorf("Expected sorted names [Health Patrol], got %v", names)
+	}
+}
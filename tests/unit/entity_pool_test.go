@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// newBulletPool creates a pool of plain entities counting creations.
+func newBulletPool(scene *core.Scene, created *int) *core.EntityPool {
+	return core.NewEntityPool(scene, func() *core.Entity {
+		*created++
+		return &core.Entity{}
+	})
+}
+
+// removingBehavior removes an entity on its first update.
+type removingBehavior struct {
+	scene  *core.Scene
+	target *core.Entity
+}
+
+func (b *removingBehavior) Update(_ *core.Entity, _ float64) {
+	b.scene.RemoveEntity(b.target.ID)
+}
+
+// TestEntityPoolReuse tests that released entities are reactivated in place.
+func TestEntityPoolReuse(t *testing.T) {
+	scene := core.NewScene()
+	created, resets := 0, 0
+	pool := newBulletPool(scene, &created)
+	pool.Reset = func(e *core.Entity) {
+		resets++
+		e.Transform.Position.X = 0
+	}
+
+	first := pool.Acquire()
+	if !first.Active || first.ID == 0 || scene.GetEntity(first.ID) != first {
+		t.Fatal("Expected acquired entity active and in the scene")
+	}
+	first.Transform.Position.X = 50
+	pool.Release(first)
+	if first.Active || pool.Free() != 1 || pool.InUse() != 0 {
+		t.Errorf("Expected released entity inactive and free, got active=%v free=%d", first.Active, pool.Free())
+	}
+	pool.Release(first) // No-op when already released
+	if pool.Free() != 1 {
+		t.Errorf("Expected double release ignored, got %d free", pool.Free())
+	}
+
+	again := pool.Acquire()
+	if again != first || again.ID != first.ID {
+		t.Error("Expected the released entity reused with the same ID")
+	}
+	if created != 1 || resets != 2 || again.Transform.Position.X != 0 {
+		t.Errorf("Expected 1 creation and Reset per acquire, got %d and %d", created, resets)
+	}
+	if len(scene.GetAllEntities()) != 1 {
+		t.Errorf("Expected 1 entity in the scene, got %d", len(scene.GetAllEntities()))
+	}
+}
+
+// TestEntityPoolRemoveReleases tests that removing a pooled entity returns it to the pool.
+func TestEntityPoolRemoveReleases(t *testing.T) {
+	scene := core.NewScene()
+	created := 0
+	pool := newBulletPool(scene, &created)
+	removed := 0
+	scene.OnEntityRemoved(func(*core.Entity) { removed++ })
+
+	bullet := pool.Acquire()
+	scene.AddEntity(&core.Entity{Active: true, Behavior: &removingBehavior{scene: scene, target: bullet}}) // e.g. on hit
+	scene.Update(1.0 / 60)
+
+	if bullet.Active || pool.Free() != 1 || removed != 0 {
+		t.Errorf("Expected bullet released instead of removed, got active=%v free=%d removed=%d", bullet.Active, pool.Free(), removed)
+	}
+	if scene.GetEntity(bullet.ID) != bullet {
+		t.Error("Expected released bullet to stay in the scene")
+	}
+
+	// A pooled child is released when its ordinary parent is removed
+	parent := &core.Entity{Active: true}
+	scene.AddEntity(parent)
+	child := pool.Acquire()
+	if err := parent.AddChild(child); err != nil {
+		t.Fatal(err)
+	}
+	scene.RemoveEntity(parent.ID)
+	scene.Update(1.0 / 60)
+	if child.Active || child.Parent() != nil || scene.GetEntity(child.ID) != child {
+		t.Error("Expected pooled child detached and released")
+	}
+	if removed != 1 {
+		t.Errorf("Expected only the parent removed, got %d removals", removed)
+	}
+}
+
+// TestEntityPoolLimitPrewarmTrim tests capacity limits, prewarming, and trimming.
+func TestEntityPoolLimitPrewarmTrim(t *testing.T) {
+	scene := core.NewScene()
+	created := 0
+	pool := newBulletPool(scene, &created)
+	pool.Limit = 3
+
+	pool.Prewarm(5)
+	if created != 3 || pool.Free() != 3 {
+		t.Errorf("Expected prewarm capped at 3, got %d created", created)
+	}
+	for _, entity := range scene.GetAllEntities() {
+		if entity.Active {
+			t.Error("Expected prewarmed entities inactive")
+		}
+	}
+
+	a, b, c := pool.Acquire(), pool.Acquire(), pool.Acquire()
+	if a == nil || b == nil || c == nil || pool.Acquire() != nil {
+		t.Error("Expected 3 acquires, then nil at the limit")
+	}
+	pool.Release(a)
+	pool.Release(b)
+	pool.Trim(1)
+	scene.Update(1.0 / 60)
+	if pool.Free() != 1 || len(scene.GetAllEntities()) != 2 {
+		t.Errorf("Expected 1 free and 2 entities after trim, got %d and %d", pool.Free(), len(scene.GetAllEntities()))
+	}
+	if pool.Acquire() == nil || pool.Acquire() == nil {
+		t.Error("Expected trimmed capacity to be available again")
+	}
+}
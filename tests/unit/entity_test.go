@@ -153,3 +153,47 @@ func almostEqual(a, b, tolerance float64) bool {
 	}
 	return diff < tolerance
 }
+
+// healthBehavior handles "damage" messages.
+type healthBehavior struct {
+	hp       int
+	received []string
+}
+
+func (h *healthBehavior) Update(entity *core.Entity, dt float64) {}
+
+func (h *healthBehavior) HandleMessage(entity *core.Entity, name string, payload any) {
+	h.received = append(h.received, name)
+	if name == "damage" {
+		h.hp -= payload.(int)
+	}
+}
+
+// TestEntitySendMessage tests delivery to handlers, Behaviors lists, and inactive entities.
+func TestEntitySendMessage(t *testing.T) {
+	first, second := &healthBehavior{hp: 100}, &healthBehavior{hp: 50}
+	entity := &core.Entity{
+		Active:   true,
+		Behavior: core.Behaviors{&mockBehavior{}, first, core.Behaviors{second}},
+	}
+
+	if !entity.SendMessage("damage", 30) {
+		t.Fatal("Expected message to be handled")
+	}
+	if first.hp != 70 || second.hp != 20 {
+		t.Errorf("Expected every handler to take damage, got %d and %d", first.hp, second.hp)
+	}
+	entity.SendMessage("heal", nil)
+	if len(first.received) != 2 || first.received[1] != "heal" {
+		t.Errorf("Expected unknown messages to reach handlers too, got %v", first.received)
+	}
+
+	plain := &core.Entity{Active: true, Behavior: &mockBehavior{}}
+	if plain.SendMessage("damage", 1) {
+		t.Error("Expected entity without handlers to report unhandled")
+	}
+	entity.Active = false
+	if entity.SendMessage("damage", 30) || first.hp != 70 {
+		t.Error("Expected inactive entity to ignore messages")
+	}
+}
@@ -0,0 +1,140 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/framedata"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+const testFrameData = `{"animations": {
+	"idle":  {"frames": 4, "boxes": [
+		{"kind": "hurt", "start": 0, "end": 3, "x": -10, "y": -10, "w": 20, "h": 20}
+	]},
+	"punch": {"frames": 4, "boxes": [
+		{"kind": "hurt", "start": 0, "end": 3, "x": -10, "y": -10, "w": 20, "h": 20},
+		{"kind": "hit", "start": 2, "end": 3, "x": 10, "y": -5, "w": 30, "h": 10, "damage": 7}
+	]}
+}}`
+
+func newFighter(x float64) *core.Entity {
+	return &core.Entity{
+		Active: true,
+		Transform: gamemath.Transform{
+			Position: gamemath.Vector2{X: x, Y: 0},
+			Scale:    gamemath.Vector2{X: 1, Y: 1},
+		},
+	}
+}
+
+// TestFrameDataLoad tests parsing and frame activation ranges.
+func TestFrameDataLoad(t *testing.T) {
+	data, err := framedata.Load(strings.NewReader(testFrameData))
+	if err != nil {
+		t.Fatalf("Expected frame data to load, got %v", err)
+	}
+
+	punch := data.Get("punch")
+	if got := len(punch.ActiveBoxes(0)); got != 1 {
+		t.Errorf("Expected 1 box on frame 0, got %d", got)
+	}
+	if got := len(punch.ActiveBoxes(2)); got != 2 {
+		t.Errorf("Expected 2 boxes on frame 2, got %d", got)
+	}
+
+	flipped := punch.Boxes[1].Rect(true)
+	if flipped.X != -40 {
+		t.Errorf("Expected mirrored hitbox at x=-40, got %f", flipped.X)
+	}
+
+	_, err = framedata.Load(strings.NewReader(`{"animations": {"bad": {"boxes": [{"kind": "hit", "start": 3, "end": 1}]}}}`))
+	if err == nil {
+		t.Error("Expected error for end frame before start")
+	}
+}
+
+// TestFrameDataDriverHits tests hitboxes activating on their frames and hitting once per play.
+func TestFrameDataDriverHits(t *testing.T) {
+	data, err := framedata.Load(strings.NewReader(testFrameData))
+	if err != nil {
+		t.Fatalf("Expected frame data to load, got %v", err)
+	}
+	scene := core.NewScene()
+
+	attacker := newFighter(0)
+	attackerFrames := framedata.NewFrameCounter(10)
+	attackerDriver := framedata.NewDriver(data, attackerFrames, scene)
+	attacker.Behavior = core.Behaviors{attackerFrames, attackerDriver}
+	scene.AddEntity(attacker)
+
+	defender := newFighter(45)
+	defenderFrames := framedata.NewFrameCounter(10)
+	defenderFrames.Play("idle")
+	defender.Behavior = core.Behaviors{defenderFrames, framedata.NewDriver(data, defenderFrames, scene)}
+	scene.AddEntity(defender)
+
+	var hits []framedata.HitEvent
+	attackerDriver.OnHit = func(hit framedata.HitEvent) { hits = append(hits, hit) }
+
+	attackerFrames.Play("punch")
+	scene.Update(0.1) // frame 1: no hitbox yet
+	if len(hits) != 0 {
+		t.Fatalf("Expected no hits before active frames, got %d", len(hits))
+	}
+
+	scene.Update(0.1) // frame 2: hitbox active
+	scene.Update(0.1) // frame 3: still overlapping, no repeat
+	if len(hits) != 1 {
+		t.Fatalf("Expected exactly 1 hit, got %d", len(hits))
+	}
+	if hits[0].Defender != defender || hits[0].Hitbox.Damage != 7 {
+		t.Errorf("Expected hit on defender for 7 damage, got %+v", hits[0])
+	}
+
+	// Facing left: the hitbox mirrors away from the defender.
+	attacker.Sprite = &graphics.Sprite{FlipH: true}
+	attackerFrames.Play("punch")
+	for i := 0; i < 4; i++ {
+		scene.Update(0.1)
+	}
+	if len(hits) != 1 {
+		t.Errorf("Expected mirrored hitbox to miss, got %d hits", len(hits))
+	}
+}
+
+// TestFrameDataDriverParentedOwner tests boxes following the owner's world position.
+func TestFrameDataDriverParentedOwner(t *testing.T) {
+	data, err := framedata.Load(strings.NewReader(testFrameData))
+	if err != nil {
+		t.Fatalf("Expected frame data to load, got %v", err)
+	}
+	scene := core.NewScene()
+
+	rig := newFighter(100)
+	attacker := newFighter(0)
+	_ = rig.AddChild(attacker) // Fresh entities can't form a cycle
+	attackerFrames := framedata.NewFrameCounter(10)
+	attackerDriver := framedata.NewDriver(data, attackerFrames, scene)
+	attacker.Behavior = core.Behaviors{attackerFrames, attackerDriver}
+	scene.AddEntity(rig)
+
+	defender := newFighter(145)
+	defenderFrames := framedata.NewFrameCounter(10)
+	defenderFrames.Play("idle")
+	defender.Behavior = core.Behaviors{defenderFrames, framedata.NewDriver(data, defenderFrames, scene)}
+	scene.AddEntity(defender)
+
+	var hits []framedata.HitEvent
+	attackerDriver.OnHit = func(hit framedata.HitEvent) { hits = append(hits, hit) }
+
+	attackerFrames.Play("punch")
+	for i := 0; i < 3; i++ {
+		scene.Update(0.1)
+	}
+	if len(hits) != 1 || hits[0].Defender != defender {
+		t.Errorf("Expected 1 hit on defender at world x=145, got %+v", hits)
+	}
+}
@@ -0,0 +1,226 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gizmo"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/picking"
+)
+
+// newGizmoTarget creates an entity at the world origin, which the default
+// 800x600 camera shows at screen (400, 300).
+func newGizmoTarget() *core.Entity {
+	return &core.Entity{Active: true, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}}
+}
+
+// TestGizmoHandleAt tests picking handles in each mode.
+func TestGizmoHandleAt(t *testing.T) {
+	camera := graphics.NewCamera()
+	g := gizmo.New()
+	if g.HandleAt(camera, 400, 300) != gizmo.HandleNone {
+		t.Error("Expected no handles without a target")
+	}
+	g.Target = newGizmoTarget()
+
+	moveCases := map[[2]int]gizmo.Handle{
+		{400, 300}: gizmo.HandleMoveXY,
+		{440, 302}: gizmo.HandleMoveX,
+		{398, 450}: gizmo.HandleNone, // Past the Y axis tip
+		{400, 340}: gizmo.HandleMoveY,
+		{440, 340}: gizmo.HandleNone,
+	}
+	for point, want := range moveCases {
+		if got := g.HandleAt(camera, point[0], point[1]); got != want {
+			t.Errorf("Expected handle %d at %v, got %d", want, point, got)
+		}
+	}
+
+	g.Mode = gizmo.ModeRotate
+	if g.HandleAt(camera, 400, 364) != gizmo.HandleRotate || g.HandleAt(camera, 400, 300) != gizmo.HandleNone {
+		t.Error("Expected only the ring to pick in rotate mode")
+	}
+
+	// Scale handles follow the target's rotation: X points down at 90 degrees
+	g.Mode = gizmo.ModeScale
+	g.Target.Transform.Rotation = 90
+	if got := g.HandleAt(camera, 400, 350); got != gizmo.HandleScaleX {
+		t.Errorf("Expected rotated X scale handle, got %d", got)
+	}
+	if got := g.HandleAt(camera, 400, 300); got != gizmo.HandleScaleXY {
+		t.Errorf("Expected uniform scale square, got %d", got)
+	}
+}
+
+// TestGizmoMove tests axis-constrained, snapped moves and undo data.
+func TestGizmoMove(t *testing.T) {
+	camera := graphics.NewCamera()
+	camera.Zoom = 2 // 1 screen pixel = 0.5 world units
+	g := gizmo.New()
+	g.Target = newGizmoTarget()
+	g.MoveSnap = 5
+
+	var began gizmo.Handle
+	var before gamemath.Transform
+	g.OnBegin = func(_ *core.Entity, handle gizmo.Handle) { began = handle }
+	g.OnEnd = func(_ *core.Entity, start gamemath.Transform) { before = start }
+
+	if !g.Begin(camera, 440, 300) || began != gizmo.HandleMoveX {
+		t.Fatalf("Expected X move to begin, got %d", began)
+	}
+	g.DragTo(camera, 455, 340) // +15px, +40px = +7.5, +20 world
+	if pos := g.Target.Transform.Position; pos != (gamemath.Vector2{X: 10, Y: 0}) {
+		t.Errorf("Expected X-only move snapped to 10, got %v", pos)
+	}
+	g.Target.Transform.Position.X = 99 // Moves are relative to the start, not the last frame
+	g.DragTo(camera, 455, 340)
+	if g.Target.Transform.Position.X != 10 {
+		t.Errorf("Expected repeated drag to land at 10, got %v", g.Target.Transform.Position.X)
+	}
+	g.End()
+	if g.Dragging() || before.Position != (gamemath.Vector2{}) {
+		t.Errorf("Expected OnEnd with the starting transform, got %v", before.Position)
+	}
+
+	// Children of scaled parents still follow the cursor in world space
+	parent := newGizmoTarget()
+	parent.Transform.Scale = gamemath.Vector2{X: 2, Y: 2}
+	child := newGizmoTarget()
+	if err := parent.AddChild(child); err != nil {
+		t.Fatal(err)
+	}
+	g.Target, g.MoveSnap = child, 0
+	g.Begin(camera, 400, 300)
+	g.DragTo(camera, 420, 310)
+	if world := child.WorldTransform().Position; world != (gamemath.Vector2{X: 10, Y: 5}) {
+		t.Errorf("Expected child world position (10, 5), got %v", world)
+	}
+	g.Cancel()
+	if child.Transform.Position != (gamemath.Vector2{}) {
+		t.Errorf("Expected Cancel to restore the position, got %v", child.Transform.Position)
+	}
+}
+
+// TestGizmoRotateAndScale tests unwrapped, snapped rotation and scaling.
+func TestGizmoRotateAndScale(t *testing.T) {
+	camera := graphics.NewCamera()
+	g := gizmo.New()
+	g.Target = newGizmoTarget()
+	g.Mode = gizmo.ModeRotate
+	g.RotateSnap = 15
+
+	g.Begin(camera, 464, 300)
+	for _, point := range [][2]int{{400, 364}, {336, 300}, {400, 236}} {
+		g.DragTo(camera, point[0], point[1])
+	}
+	g.DragTo(camera, 405, 236) // Just past 270, snapped back
+	if rotation := g.Target.Transform.Rotation; rotation != 270 {
+		t.Errorf("Expected unwrapped rotation 270, got %v", rotation)
+	}
+	g.End()
+
+	g.Mode = gizmo.ModeScale
+	g.Target.Transform.Rotation = 0
+	g.Begin(camera, 464, 300)
+	g.DragTo(camera, 528, 310) // One handle length outward
+	if scale := g.Target.Transform.Scale; scale != (gamemath.Vector2{X: 2, Y: 1}) {
+		t.Errorf("Expected X scale doubled, got %v", scale)
+	}
+	g.DragTo(camera, 300, 300) // Far past the origin
+	if scale := g.Target.Transform.Scale; math.Abs(scale.X) < 0.01 {
+		t.Errorf("Expected scale kept away from zero, got %v", scale)
+	}
+	g.End()
+
+	g.Target.Transform.Scale = gamemath.Vector2{X: 1, Y: 1}
+	g.Begin(camera, 400, 300)
+	g.DragTo(camera, 400+32, 300+32) // Half a handle along the diagonal each way
+	if scale := g.Target.Transform.Scale; math.Abs(scale.X-scale.Y) > 1e-9 || math.Abs(scale.X-1.707) > 0.01 {
+		t.Errorf("Expected uniform scale ~1.707, got %v", scale)
+	}
+	g.End()
+}
+
+// TestGizmoControllerPicksAndDrags tests mouse-driven picking and dragging.
+func TestGizmoControllerPicksAndDrags(t *testing.T) {
+	scene := core.NewScene()
+	target := newPickableEntity(100, 0, 0) // Screen (500, 300)
+	scene.AddEntity(target)
+
+	inputMgr := input.NewInputManager()
+	g := gizmo.New()
+	controller := gizmo.NewController(inputMgr, scene.Camera(), g)
+	controller.Scene = scene
+	controller.Selection = picking.NewSelectionSet()
+	frame := func(x, y int32, button input.KeyCode) {
+		inputMgr.InjectMouse(x, y, button)
+		controller.Update(nil, 1.0/60)
+		inputMgr.Update()
+	}
+
+	frame(505, 305, input.KeyMouseLeft)
+	frame(505, 305, input.KeyNone)
+	if g.Target != target || !controller.Selection.Contains(target) {
+		t.Fatal("Expected click to pick the entity as the target")
+	}
+
+	frame(540, 300, input.KeyNone)
+	if g.Hovered() != gizmo.HandleMoveX {
+		t.Errorf("Expected X handle hovered, got %d", g.Hovered())
+	}
+	frame(540, 300, input.KeyMouseLeft)
+	frame(560, 320, input.KeyMouseLeft)
+	frame(570, 320, input.KeyNone)
+	if pos := target.Transform.Position; pos != (gamemath.Vector2{X: 130, Y: 0}) {
+		t.Errorf("Expected drag to move the target to (130, 0), got %v", pos)
+	}
+	if g.Dragging() {
+		t.Error("Expected drag to end on release")
+	}
+
+	frame(200, 100, input.KeyMouseLeft)
+	if g.Target != nil || controller.Selection.Len() != 0 {
+		t.Error("Expected a click on empty space to clear the target")
+	}
+}
+
+// TestGizmoDrawHighlights tests drawing handles with the hovered one highlighted.
+func TestGizmoDrawHighlights(t *testing.T) {
+	var lines []graphics.DrawCall
+	renderer := graphics.NewRecordingRenderer(func(call graphics.DrawCall) {
+		if call.Kind == graphics.DrawKindLine {
+			lines = append(lines, call)
+		}
+	})
+	camera := graphics.NewCamera()
+	g := gizmo.New()
+	if err := g.Draw(renderer, camera); err != nil || len(lines) != 0 {
+		t.Fatalf("Expected nothing drawn without a target, got %d lines (%v)", len(lines), err)
+	}
+
+	g.Target = newGizmoTarget()
+	g.Hover(camera, 440, 300)
+	if err := g.Draw(renderer, camera); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	highlighted := 0
+	for _, call := range lines {
+		if call.Color == g.HighlightColor {
+			highlighted++
+		}
+	}
+	// Two axes with two-line arrowheads plus a four-line square
+	if len(lines) != 10 || highlighted != 3 {
+		t.Errorf("Expected 10 lines with 3 highlighted, got %d and %d", len(lines), highlighted)
+	}
+
+	lines = nil
+	g.Mode = gizmo.ModeRotate
+	if err := g.Draw(renderer, camera); err != nil || len(lines) != 49 {
+		t.Errorf("Expected a 48-segment ring and spoke, got %d lines (%v)", len(lines), err)
+	}
+}
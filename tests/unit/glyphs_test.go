@@ -0,0 +1,112 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/glyphs"
+	"github.com/dshills/gogame/engine/input"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// TestGlyphsFollowLastDevice tests that prompts switch with the device last pressed.
+func TestGlyphsFollowLastDevice(t *testing.T) {
+	im := input.NewInputManager()
+	im.BindAction(input.ActionJump, input.KeySpace, input.KeyPadA)
+	prompts := glyphs.NewService(im)
+
+	var changes []input.Device
+	im.SetDeviceChangedCallback(func(device input.Device) { changes = append(changes, device) })
+
+	if got := prompts.Expand("Press {jump} to climb"); got != "Press [Space] to climb" {
+		t.Errorf("Expected keyboard prompt, got %q", got)
+	}
+
+	im.ProcessControllerButtonEvent(&sdl.ControllerButtonEvent{Button: sdl.CONTROLLER_BUTTON_A, State: sdl.PRESSED})
+	if im.LastDevice() != input.DeviceGamepad {
+		t.Fatal("Expected gamepad to become the last device")
+	}
+	if !im.KeyHeld(input.KeyPadA) {
+		t.Error("Expected gamepad button to be held")
+	}
+	if got := prompts.Label(input.ActionJump); got != "[A]" {
+		t.Errorf("Expected [A], got %q", got)
+	}
+
+	im.SetGamepadStyle(input.GamepadPlayStation)
+	if got := prompts.Label(input.ActionJump); got != "[Cross]" {
+		t.Errorf("Expected [Cross], got %q", got)
+	}
+
+	im.ProcessKeyEvent(&sdl.KeyboardEvent{State: sdl.PRESSED, Keysym: sdl.Keysym{Scancode: sdl.SCANCODE_E}})
+	if got := prompts.Label(input.ActionJump); got != "[Space]" {
+		t.Errorf("Expected [Space] after keyboard use, got %q", got)
+	}
+	if len(changes) != 2 || changes[0] != input.DeviceGamepad || changes[1] != input.DeviceKeyboard {
+		t.Errorf("Expected gamepad then keyboard change, got %v", changes)
+	}
+}
+
+// TestGlyphsExpand tests placeholder expansion edge cases.
+func TestGlyphsExpand(t *testing.T) {
+	im := input.NewInputManager()
+	im.BindAction(input.ActionInteract, input.KeyE)
+	im.BindAction(input.ActionCancel, input.KeyEscape)
+	prompts := glyphs.NewService(im)
+	prompts.Name("Reload", input.ActionAttack)
+
+	cases := map[string]string{
+		"{interact} Open   {cancel} Back": "[E] Open   [Esc] Back",
+		"{RELOAD}":                        "[?]",
+		"{typo} stays":                    "{typo} stays",
+		"unclosed {interact":              "unclosed {interact",
+	}
+	for text, want := range cases {
+		if got := prompts.Expand(text); got != want {
+			t.Errorf("Expand(%q): expected %q, got %q", text, want, got)
+		}
+	}
+}
+
+// TestGlyphsNintendoLayout tests that Nintendo labels follow button position.
+func TestGlyphsNintendoLayout(t *testing.T) {
+	south := glyphs.GlyphFor(input.KeyPadA, input.GamepadNintendo)
+	if south.Label != "B" || south.Icon != "switch_south" {
+		t.Errorf("Expected bottom button labelled B, got %+v", south)
+	}
+	if style := input.GamepadStyleForName("Nintendo Switch Pro Controller"); style != input.GamepadNintendo {
+		t.Errorf("Expected Nintendo style, got %v", style)
+	}
+	if style := input.GamepadStyleForName("PS5 Controller"); style != input.GamepadPlayStation {
+		t.Errorf("Expected PlayStation style, got %v", style)
+	}
+	if style := input.GamepadStyleForName("Generic USB Gamepad"); style != input.GamepadXbox {
+		t.Errorf("Expected Xbox style fallback, got %v", style)
+	}
+}
+
+// TestGlyphAtlasCoversBindings tests that every built-in key and button has an icon.
+func TestGlyphAtlasCoversBindings(t *testing.T) {
+	img, regions := glyphs.AtlasImage()
+	bounds := img.Bounds()
+
+	keys := []input.KeyCode{
+		input.KeyA, input.KeyZ, input.Key0, input.Key9, input.KeySpace, input.KeyEscape,
+		input.KeyArrowLeft, input.KeyMouseLeft, input.KeyMouseMiddle,
+	}
+	for key := input.KeyPadA; key <= input.KeyPadDPadRight; key++ {
+		keys = append(keys, key)
+	}
+	for _, style := range []input.GamepadStyle{input.GamepadXbox, input.GamepadPlayStation, input.GamepadNintendo} {
+		for _, key := range keys {
+			glyph := glyphs.GlyphFor(key, style)
+			region, ok := regions[glyph.Icon]
+			if !ok {
+				t.Errorf("Expected icon %q for key %d", glyph.Icon, key)
+				continue
+			}
+			if region.X+region.Width > float64(bounds.Dx()) || region.Y+region.Height > float64(bounds.Dy()) {
+				t.Errorf("Expected icon %q inside atlas, got %+v", glyph.Icon, region)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gogametest"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestFakeRendererRecordsScene tests that scene rendering is recorded in layer order.
+func TestFakeRendererRecordsScene(t *testing.T) {
+	scene := core.NewScene()
+	texture := graphics.NewTexture(nil, 32, 32, "")
+
+	front := &core.Entity{Active: true, Layer: 2, Sprite: graphics.NewSprite(texture)}
+	back := &core.Entity{
+		Active:    true,
+		Layer:     1,
+		Sprite:    graphics.NewSprite(texture),
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 100}, Scale: gamemath.Vector2{X: 2, Y: 2}},
+	}
+	scene.AddEntity(front)
+	scene.AddEntity(back)
+
+	renderer := gogametest.NewFakeRenderer()
+	if err := renderer.Clear(gamemath.Color{A: 255}); err != nil {
+		t.Fatalf("Clear failed: %v", err)
+	}
+	if err := scene.Render(renderer.Renderer); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	sprites := renderer.CallsOf(graphics.DrawKindSprite)
+	if len(renderer.Calls()) != 3 || len(sprites) != 2 {
+		t.Fatalf("Expected a clear and 2 sprites, got %d calls", len(renderer.Calls()))
+	}
+	if sprites[0].Sprite != back.Sprite || sprites[1].Sprite != front.Sprite {
+		t.Error("Expected sprites drawn back to front")
+	}
+	if sprites[0].Screen.Width != 64 || sprites[0].Screen.Height != 64 {
+		t.Errorf("Expected scaled size 64x64, got %vx%v", sprites[0].Screen.Width, sprites[0].Screen.Height)
+	}
+	if cx := sprites[1].Screen.X + sprites[1].Screen.Width/2; cx != 400 {
+		t.Errorf("Expected origin centered at screen X 400, got %v", cx)
+	}
+
+	renderer.Reset()
+	if len(renderer.Calls()) != 0 {
+		t.Error("Expected no calls after Reset")
+	}
+	if _, err := renderer.NewRenderTarget(16, 16); err == nil {
+		t.Error("Expected render targets to be unavailable when recording")
+	}
+}
+
+// TestFakeClockDrivesTime tests deterministic fixed updates from a fake clock.
+func TestFakeClockDrivesTime(t *testing.T) {
+	clock := gogametest.NewFakeClock()
+	timer := core.NewTime()
+	timer.SetClock(clock.Now)
+
+	if updates, _ := timer.Tick(); updates != 0 {
+		t.Errorf("Expected 0 updates before the clock moves, got %d", updates)
+	}
+
+	clock.Advance(60 * time.Millisecond)
+	updates, dt := timer.Tick()
+	if updates != 3 {
+		t.Errorf("Expected 3 updates after 60ms, got %d", updates)
+	}
+	if !almostEqual(dt, 1.0/60, 1e-9) {
+		t.Errorf("Expected fixed dt 1/60, got %v", dt)
+	}
+}
@@ -0,0 +1,160 @@
+package unit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// countingBehavior counts updates and stops the engine after a limit.
+type countingBehavior struct {
+	engine  *core.Engine
+	updates int
+	stopAt  int
+}
+
+func (c *countingBehavior) Update(_ *core.Entity, _ float64) {
+	c.updates++
+	if c.updates == c.stopAt {
+		c.engine.Stop()
+	}
+}
+
+// TestHeadlessEngineStep tests running game logic without a window.
+func TestHeadlessEngineStep(t *testing.T) {
+	engine := core.NewHeadlessEngine()
+	defer engine.Shutdown()
+	if !engine.Headless() || engine.Audio().Enabled() {
+		t.Fatal("Expected a headless engine with audio disabled")
+	}
+
+	scene := core.NewScene()
+	counter := &countingBehavior{engine: engine}
+	body := physics.NewRigidBody(1)
+	body.Velocity = gamemath.Vector2{X: 60}
+	body.GravityScale = 0
+	entity := &core.Entity{
+		Active:    true,
+		Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}},
+		Body:      body,
+		Behavior:  counter,
+	}
+	scene.AddEntity(entity)
+	fired := false
+	scene.Scheduler().After(500*time.Millisecond, func() { fired = true })
+	engine.SetScene(scene)
+
+	engine.Step(30)
+	if counter.updates != 30 || !fired {
+		t.Errorf("Expected 30 updates and the timer fired, got %d and %v", counter.updates, fired)
+	}
+	if x := entity.Transform.Position.X; x < 29.9 || x > 30.1 {
+		t.Errorf("Expected physics to move the entity ~30 units, got %v", x)
+	}
+
+	if width, height := scene.Camera().ScreenSize(); width != 800 || height != 600 {
+		t.Errorf("Expected the default 800x600 screen, got %dx%d", width, height)
+	}
+	if err := engine.SetWindowSize(320, 240); err != nil || engine.Width() != 320 {
+		t.Errorf("Expected headless resize to 320 wide, got %d (%v)", engine.Width(), err)
+	}
+}
+
+// TestHeadlessEngineRunAndAssets tests the real-time loop and texture stubs.
+func TestHeadlessEngineRunAndAssets(t *testing.T) {
+	engine := core.NewHeadlessEngine()
+	defer engine.Shutdown()
+
+	path := filepath.Join(t.TempDir(), "enemy.png")
+	if err := os.WriteFile(path, encodePNG(t, 12, 8), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	texture, err := engine.Assets().LoadTexture(path)
+	if err != nil {
+		t.Fatalf("LoadTexture failed: %v", err)
+	}
+	if texture.Width != 12 || texture.Height != 8 || texture.GetSDLTexture() != nil {
+		t.Errorf("Expected a 12x8 texture with no GPU data, got %dx%d", texture.Width, texture.Height)
+	}
+
+	scene := core.NewScene()
+	counter := &countingBehavior{engine: engine, stopAt: 3}
+	scene.AddEntity(&core.Entity{Active: true, Behavior: counter})
+	engine.SetScene(scene)
+
+	start := time.Now()
+	if err := engine.Run(); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if counter.updates != 3 {
+		t.Errorf("Expected Run to stop after 3 updates, got %d", counter.updates)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected Run to pace updates in real time, took %v", elapsed)
+	}
+}
+
+// sceneChangeBehavior stalls its first update so the next frame catches up
+// with several updates, then changes scene on its second update.
+type sceneChangeBehavior struct {
+	engine  *core.Engine
+	change  func(*core.SceneManager)
+	updates int
+}
+
+func (s *sceneChangeBehavior) Update(_ *core.Entity, _ float64) {
+	s.updates++
+	switch s.updates {
+	case 1:
+		time.Sleep(60 * time.Millisecond) // Next frame runs 3+ updates
+	case 2:
+		s.change(s.engine.Scenes())
+		s.engine.Stop()
+	}
+}
+
+// TestEngineSceneChangeDuringCatchUp tests that catch-up updates stop when a
+// behavior pops the last scene or starts a transition.
+func TestEngineSceneChangeDuringCatchUp(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		change func(*core.SceneManager)
+	}{
+		{"pop last scene", func(m *core.SceneManager) { _ = m.Pop(core.Transition{}) }},
+		{"push with fade", func(m *core.SceneManager) {
+			_, _ = m.Push("next", core.Transition{Effect: core.TransitionFade, Duration: 1})
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			engine := core.NewHeadlessEngine()
+			defer engine.Shutdown()
+			behavior := &sceneChangeBehavior{engine: engine, change: tc.change}
+			engine.Scenes().Register("level", func() *core.Scene {
+				scene := core.NewScene()
+				scene.AddEntity(&core.Entity{Active: true, Behavior: behavior})
+				return scene
+			})
+			next := &countingBehavior{}
+			engine.Scenes().Register("next", func() *core.Scene {
+				scene := core.NewScene()
+				scene.AddEntity(&core.Entity{Active: true, Behavior: next})
+				return scene
+			})
+			if _, err := engine.Scenes().Push("level", core.Transition{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := engine.Run(); err != nil {
+				t.Fatalf("Run failed: %v", err)
+			}
+			if behavior.updates != 2 || next.updates != 0 {
+				t.Errorf("Expected no updates after the scene change, got %d and %d", behavior.updates, next.updates)
+			}
+		})
+	}
+}
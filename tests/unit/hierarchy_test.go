@@ -0,0 +1,134 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// TestHierarchyWorldTransform tests composing position, rotation, and scale with a parent.
+func TestHierarchyWorldTransform(t *testing.T) {
+	ship := &core.Entity{Active: true, Transform: gamemath.Transform{
+		Position: gamemath.Vector2{X: 100, Y: 50},
+		Rotation: 90,
+		Scale:    gamemath.Vector2{X: 2, Y: 2},
+	}}
+	turret := &core.Entity{Active: true, Transform: gamemath.Transform{
+		Position: gamemath.Vector2{X: 10},
+		Rotation: 15,
+		Scale:    gamemath.Vector2{X: 1, Y: 1},
+	}}
+	if err := ship.AddChild(turret); err != nil {
+		t.Fatalf("Expected AddChild to succeed, got %v", err)
+	}
+
+	world := turret.WorldTransform()
+	if !almostEqual(world.Position.X, 100, 1e-9) || !almostEqual(world.Position.Y, 70, 1e-9) {
+		t.Errorf("Expected world position (100, 70), got %+v", world.Position)
+	}
+	if world.Rotation != 105 || world.Scale != (gamemath.Vector2{X: 2, Y: 2}) {
+		t.Errorf("Expected rotation 105 and scale 2, got %v and %+v", world.Rotation, world.Scale)
+	}
+
+	turret.SetWorldPosition(gamemath.Vector2{X: 90, Y: 50})
+	if !almostEqual(turret.Transform.Position.X, 0, 1e-9) || !almostEqual(turret.Transform.Position.Y, 5, 1e-9) {
+		t.Errorf("Expected local position (0, 5), got %+v", turret.Transform.Position)
+	}
+
+	ship.RemoveChild(turret)
+	if turret.Parent() != nil || len(ship.Children()) != 0 {
+		t.Error("Expected RemoveChild to detach the turret")
+	}
+}
+
+// TestHierarchyRejectsCycles tests that an entity can't be parented under itself.
+func TestHierarchyRejectsCycles(t *testing.T) {
+	root, middle, leaf := &core.Entity{}, &core.Entity{}, &core.Entity{}
+	_ = root.AddChild(middle)
+	_ = middle.AddChild(leaf)
+
+	if err := leaf.AddChild(root); !errors.Is(err, core.ErrHierarchyCycle) {
+		t.Errorf("Expected ErrHierarchyCycle, got %v", err)
+	}
+	if err := root.SetParent(root); !errors.Is(err, core.ErrHierarchyCycle) {
+		t.Errorf("Expected ErrHierarchyCycle for self-parenting, got %v", err)
+	}
+
+	// Reparenting moves the entity between child lists
+	if err := leaf.SetParent(root); err != nil {
+		t.Fatalf("Expected reparent to succeed, got %v", err)
+	}
+	if len(middle.Children()) != 0 || len(root.Children()) != 2 {
+		t.Errorf("Expected leaf to move to root, got %d and %d children", len(middle.Children()), len(root.Children()))
+	}
+}
+
+// TestHierarchySceneAddRemove tests that children follow their parent in and out of a scene.
+func TestHierarchySceneAddRemove(t *testing.T) {
+	scene := core.NewScene()
+	holder := &core.Entity{Active: true}
+	ship := &core.Entity{Active: true}
+	turret := &core.Entity{Active: true}
+	muzzle := &core.Entity{Active: true}
+	_ = ship.AddChild(turret)
+	_ = turret.AddChild(muzzle)
+	_ = holder.AddChild(ship)
+
+	scene.AddEntity(holder)
+	if len(scene.GetAllEntities()) != 4 || scene.GetEntity(muzzle.ID) != muzzle {
+		t.Fatalf("Expected AddEntity to add the hierarchy, got %d entities", len(scene.GetAllEntities()))
+	}
+
+	scene.RemoveEntity(ship.ID)
+	scene.Update(0)
+	if len(scene.GetAllEntities()) != 1 {
+		t.Errorf("Expected ship subtree removed, got %d entities", len(scene.GetAllEntities()))
+	}
+	if ship.Parent() != nil || len(holder.Children()) != 0 {
+		t.Error("Expected removed ship to be detached from its parent")
+	}
+	if muzzle.Parent() != turret {
+		t.Error("Expected removed subtree to stay intact")
+	}
+}
+
+// TestHierarchyCollision tests that child colliders use world positions and
+// don't collide with their own parent.
+func TestHierarchyCollision(t *testing.T) {
+	scene := core.NewScene()
+	ship := newSolidBox(0, 0)
+	shield := newSolidBox(20, 0) // Local: world X is 120 once parented
+	_ = ship.AddChild(shield)
+	ship.Transform.Position.X = 100
+	wall := newSolidBox(125, 0)
+	wall.Body = physics.NewRigidBody(1)
+
+	selfHit := false
+	shield.OnCollisionEnter = func(_, other *core.Entity, _ core.CollisionInfo) {
+		if other == ship {
+			selfHit = true
+		}
+	}
+	scene.AddEntity(ship)
+	scene.AddEntity(wall)
+
+	if hits := scene.OverlapBox(gamemath.Rectangle{X: 118, Y: -2, Width: 4, Height: 4}, physics.AllLayers); len(hits) != 2 {
+		t.Errorf("Expected shield and wall at shield's world position, got %d", len(hits))
+	}
+
+	shield.Transform.Position.X = 5 // Overlaps the ship as well as the wall
+	wall.Transform.Position.X = 113
+	scene.Update(0)
+	if selfHit {
+		t.Error("Expected child not to collide with its parent")
+	}
+	if wall.Transform.Position.X <= 113 {
+		t.Errorf("Expected wall pushed away from the shield, got X=%v", wall.Transform.Position.X)
+	}
+	if shield.Transform.Position.X != 5 {
+		t.Errorf("Expected static shield to keep its local position, got %v", shield.Transform.Position.X)
+	}
+}
@@ -0,0 +1,88 @@
+package unit
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/dshills/gogame/engine/graphics"
+)
+
+// TestHotReloadReplacesTextureInPlace tests that changed files are reloaded
+// into the same texture, and bad files keep the old image.
+func TestHotReloadReplacesTextureInPlace(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	files := fstest.MapFS{"hero.png": {Data: encodePNG(t, 8, 4), ModTime: start}}
+	assets.Mount(files)
+
+	texture, err := assets.LoadTexture("hero.png")
+	if err != nil {
+		t.Fatalf("LoadTexture failed: %v", err)
+	}
+	sprite := graphics.NewSprite(texture)
+
+	if assets.ReloadChanged() != 0 {
+		t.Error("Expected no reloads before WatchFiles")
+	}
+	var reloads []error
+	assets.OnReload = func(reloaded *graphics.Texture, err error) {
+		if reloaded != texture {
+			t.Errorf("Expected OnReload with the cached texture, got %p", reloaded)
+		}
+		reloads = append(reloads, err)
+	}
+	assets.WatchFiles(0)
+	if n := assets.ReloadChanged(); n != 0 {
+		t.Errorf("Expected unchanged files to stay loaded, got %d reloads", n)
+	}
+
+	files["hero.png"] = &fstest.MapFile{Data: encodePNG(t, 16, 8), ModTime: start.Add(time.Second)}
+	if n := assets.ReloadChanged(); n != 1 || len(reloads) != 1 || reloads[0] != nil {
+		t.Fatalf("Expected one successful reload, got %d (%v)", n, reloads)
+	}
+	if texture.Width != 16 || texture.Height != 8 || texture.GetSDLTexture() == nil || sprite.Texture != texture {
+		t.Errorf("Expected the same texture resized to 16x8, got %dx%d", texture.Width, texture.Height)
+	}
+	if cached, _ := assets.LoadTexture("hero.png"); cached != texture {
+		t.Error("Expected the cache to keep the reloaded texture")
+	}
+
+	files["hero.png"] = &fstest.MapFile{Data: []byte("not a png"), ModTime: start.Add(2 * time.Second)}
+	if n := assets.ReloadChanged(); n != 0 || len(reloads) != 2 || reloads[1] == nil {
+		t.Errorf("Expected a reported decode error, got %d (%v)", n, reloads)
+	}
+	if texture.Width != 16 || texture.GetSDLTexture() == nil {
+		t.Error("Expected the old image to survive a bad file")
+	}
+
+	delete(files, "hero.png")
+	if n := assets.ReloadChanged(); n != 0 || len(reloads) != 2 {
+		t.Error("Expected a missing file to be skipped silently")
+	}
+
+	assets.StopWatching()
+	files["hero.png"] = &fstest.MapFile{Data: encodePNG(t, 2, 2), ModTime: start.Add(3 * time.Second)}
+	if assets.Watching() || assets.ReloadChanged() != 0 || texture.Width != 16 {
+		t.Error("Expected no reloads after StopWatching")
+	}
+}
+
+// TestHotReloadInterval tests that checks are throttled by the interval.
+func TestHotReloadInterval(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	files := fstest.MapFS{"tile.png": {Data: encodePNG(t, 4, 4), ModTime: start}}
+	assets.Mount(files)
+	texture, err := assets.LoadTexture("tile.png")
+	if err != nil {
+		t.Fatalf("LoadTexture failed: %v", err)
+	}
+
+	assets.WatchFiles(time.Hour)
+	assets.ReloadChanged() // First check runs immediately
+	files["tile.png"] = &fstest.MapFile{Data: encodePNG(t, 6, 6), ModTime: start.Add(time.Second)}
+	if n := assets.ReloadChanged(); n != 0 || texture.Width != 4 {
+		t.Errorf("Expected no check before the interval, got %d reloads", n)
+	}
+}
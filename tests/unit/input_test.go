@@ -3,6 +3,7 @@ package unit
 import (
 	"testing"
 
+	"github.com/dshills/gogame/engine/gogametest"
 	"github.com/dshills/gogame/engine/input"
 )
 
@@ -19,9 +20,9 @@ func TestActionBinding(t *testing.T) {
 
 // TestActionPressed tests ActionPressed detection.
 func TestActionPressed(t *testing.T) {
-	t.Skip("Requires SDL event simulation - needs mock InputManager")
-	inputMgr := input.NewInputManager()
+	inputMgr := gogametest.NewFakeInput()
 	inputMgr.BindAction(input.ActionJump, input.KeySpace)
+	inputMgr.SetKeyState(input.KeySpace, true)
 
 	// On first frame, key is "pressed"
 	if !inputMgr.ActionPressed(input.ActionJump) {
@@ -39,15 +40,15 @@ func TestActionPressed(t *testing.T) {
 
 // TestActionHeld tests ActionHeld detection.
 func TestActionHeld(t *testing.T) {
-	inputMgr := input.NewInputManager()
+	inputMgr := gogametest.NewFakeInput()
 	inputMgr.BindAction(input.ActionMoveUp, input.KeyW)
 
 	// Set key down
-	// inputMgr.SetKeyState(input.KeyW, true)
+	inputMgr.SetKeyState(input.KeyW, true)
 	inputMgr.Update() // Copy to previous
 
 	// Keep key down
-	// inputMgr.SetKeyState(input.KeyW, true)
+	inputMgr.SetKeyState(input.KeyW, true)
 
 	// Should be held
 	if !inputMgr.ActionHeld(input.ActionMoveUp) {
@@ -57,15 +58,15 @@ func TestActionHeld(t *testing.T) {
 
 // TestActionReleased tests ActionReleased detection.
 func TestActionReleased(t *testing.T) {
-	inputMgr := input.NewInputManager()
+	inputMgr := gogametest.NewFakeInput()
 	inputMgr.BindAction(input.ActionJump, input.KeySpace)
 
 	// Press key
-	// inputMgr.SetKeyState(input.KeySpace, true)
+	inputMgr.SetKeyState(input.KeySpace, true)
 	inputMgr.Update()
 
 	// Release key
-	// inputMgr.SetKeyState(input.KeySpace, false)
+	inputMgr.SetKeyState(input.KeySpace, false)
 
 	// Should be released
 	if !inputMgr.ActionReleased(input.ActionJump) {
@@ -82,22 +83,22 @@ func TestActionReleased(t *testing.T) {
 
 // TestMultipleKeyBindings tests multiple keys bound to same action.
 func TestMultipleKeyBindings(t *testing.T) {
-	inputMgr := input.NewInputManager()
+	inputMgr := gogametest.NewFakeInput()
 	inputMgr.BindAction(input.ActionMoveUp, input.KeyW, input.KeyArrowUp)
 
 	// Press first key
-	// inputMgr.SetKeyState(input.KeyW, true)
+	inputMgr.SetKeyState(input.KeyW, true)
 
 	if !inputMgr.ActionPressed(input.ActionMoveUp) {
 		t.Error("Expected action to work with first key")
 	}
 
 	inputMgr.Update()
-	// inputMgr.SetKeyState(input.KeyW, false)
+	inputMgr.SetKeyState(input.KeyW, false)
 	inputMgr.Update()
 
 	// Press second key
-	// inputMgr.SetKeyState(input.KeyArrowUp, true)
+	inputMgr.SetKeyState(input.KeyArrowUp, true)
 
 	if !inputMgr.ActionPressed(input.ActionMoveUp) {
 		t.Error("Expected action to work with second key")
@@ -106,10 +107,10 @@ func TestMultipleKeyBindings(t *testing.T) {
 
 // TestMousePosition tests mouse position tracking.
 func TestMousePosition(t *testing.T) {
-	inputMgr := input.NewInputManager()
+	inputMgr := gogametest.NewFakeInput()
 
 	// Set mouse position
-	// inputMgr.SetMousePosition(100, 200)
+	inputMgr.SetMousePosition(100, 200)
 
 	x, y := inputMgr.MousePosition()
 
@@ -120,14 +121,14 @@ func TestMousePosition(t *testing.T) {
 
 // TestMouseDelta tests mouse movement delta.
 func TestMouseDelta(t *testing.T) {
-	inputMgr := input.NewInputManager()
+	inputMgr := gogametest.NewFakeInput()
 
 	// Initial position
-	// inputMgr.SetMousePosition(100, 100)
+	inputMgr.SetMousePosition(100, 100)
 	inputMgr.Update()
 
 	// Move mouse
-	// inputMgr.SetMousePosition(150, 120)
+	inputMgr.SetMousePosition(150, 120)
 
 	dx, dy := inputMgr.MouseDelta()
 
@@ -135,3 +136,74 @@ func TestMouseDelta(t *testing.T) {
 		t.Errorf("Expected delta (50, 20), got (%d, %d)", dx, dy)
 	}
 }
+
+// TestFakeInputMouseButtons tests mouse buttons set through SetKeyState.
+func TestFakeInputMouseButtons(t *testing.T) {
+	inputMgr := gogametest.NewFakeInput()
+
+	inputMgr.SetKeyState(input.KeyMouseLeft, true)
+	if !inputMgr.KeyPressed(input.KeyMouseLeft) {
+		t.Error("Expected left mouse button pressed")
+	}
+
+	inputMgr.NextFrame()
+	if !inputMgr.KeyHeld(input.KeyMouseLeft) || inputMgr.KeyPressed(input.KeyMouseLeft) {
+		t.Error("Expected left mouse button held after NextFrame")
+	}
+}
+
+// TestInjectKey tests injected presses drive actions and the active device.
+func TestInjectKey(t *testing.T) {
+	inputMgr := input.NewInputManager()
+	inputMgr.BindAction(input.ActionJump, input.KeySpace, input.KeyPadA)
+
+	inputMgr.InjectKey(input.KeyPadA, true)
+	if !inputMgr.ActionPressed(input.ActionJump) {
+		t.Error("Expected injected gamepad button to trigger action")
+	}
+	if inputMgr.LastDevice() != input.DeviceGamepad {
+		t.Error("Expected gamepad to become the active device")
+	}
+
+	inputMgr.Update()
+	inputMgr.InjectKey(input.KeyPadA, false)
+	if !inputMgr.ActionReleased(input.ActionJump) {
+		t.Error("Expected injected release to release action")
+	}
+
+	inputMgr.InjectKey(input.KeySpace, true)
+	if inputMgr.LastDevice() != input.DeviceKeyboard {
+		t.Error("Expected keyboard to become the active device")
+	}
+}
+
+// TestInjectMouse tests an injected press, drag, and release gesture.
+func TestInjectMouse(t *testing.T) {
+	inputMgr := input.NewInputManager()
+
+	inputMgr.InjectMouse(10, 20, input.KeyMouseLeft)
+	if x, y := inputMgr.MousePosition(); x != 10 || y != 20 {
+		t.Errorf("Expected position (10, 20), got (%d, %d)", x, y)
+	}
+	if !inputMgr.KeyPressed(input.KeyMouseLeft) {
+		t.Error("Expected left button pressed")
+	}
+	inputMgr.Update()
+
+	inputMgr.InjectMouse(40, 25, input.KeyMouseLeft)
+	if dx, dy := inputMgr.MouseDelta(); dx != 30 || dy != 5 {
+		t.Errorf("Expected drag delta (30, 5), got (%d, %d)", dx, dy)
+	}
+	if !inputMgr.KeyHeld(input.KeyMouseLeft) || inputMgr.KeyPressed(input.KeyMouseLeft) {
+		t.Error("Expected left button held while dragging")
+	}
+	inputMgr.Update()
+
+	inputMgr.InjectMouse(40, 25, input.KeyNone)
+	if !inputMgr.KeyReleased(input.KeyMouseLeft) {
+		t.Error("Expected left button released")
+	}
+	if inputMgr.KeyHeld(input.KeyMouseRight) || inputMgr.KeyHeld(input.KeyMouseMiddle) {
+		t.Error("Expected other buttons untouched")
+	}
+}
@@ -0,0 +1,122 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/interaction"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+func newInteractionEntity(x, y float64) *core.Entity {
+	return &core.Entity{
+		Active:    true,
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: x, Y: y}},
+	}
+}
+
+// TestInteractorSelectsNearest tests nearest-in-range selection.
+func TestInteractorSelectsNearest(t *testing.T) {
+	player := newInteractionEntity(0, 0)
+	near := interaction.NewInteractable(newInteractionEntity(20, 0), "Near", 50, nil)
+	far := interaction.NewInteractable(newInteractionEntity(40, 0), "Far", 50, nil)
+	outOfRange := interaction.NewInteractable(newInteractionEntity(10, 0), "Tiny", 5, nil)
+
+	interactor := interaction.NewInteractor(nil)
+	interactor.Add(far)
+	interactor.Add(near)
+	interactor.Add(outOfRange)
+
+	interactor.Update(player, 0.016)
+
+	if interactor.Current() != near {
+		t.Fatalf("Expected nearest interactable to be selected, got %v", interactor.Current())
+	}
+
+	near.Enabled = false
+	interactor.Update(player, 0.016)
+	if interactor.Current() != far {
+		t.Error("Expected disabled interactable to be skipped")
+	}
+}
+
+// TestInteractorParentedEntities tests that distances use world positions.
+func TestInteractorParentedEntities(t *testing.T) {
+	rig := newInteractionEntity(100, 0)
+	player := newInteractionEntity(0, 0)
+	_ = rig.AddChild(player) // Fresh entities can't form a cycle
+
+	cart := newInteractionEntity(100, 0)
+	chestEntity := newInteractionEntity(20, 0)
+	_ = cart.AddChild(chestEntity) // Fresh entities can't form a cycle
+	chest := interaction.NewInteractable(chestEntity, "Chest", 50, nil)
+	sign := interaction.NewInteractable(newInteractionEntity(10, 0), "Sign", 50, nil)
+
+	interactor := interaction.NewInteractor(nil)
+	interactor.Add(sign)
+	interactor.Add(chest)
+
+	interactor.Update(player, 0.016)
+	if interactor.Current() != chest {
+		t.Errorf("Expected parented chest at world x=120 to be selected, got %v", interactor.Current())
+	}
+}
+
+// TestInteractorFacing tests the facing tolerance check.
+func TestInteractorFacing(t *testing.T) {
+	player := newInteractionEntity(0, 0)
+	player.Transform.Rotation = 0 // Facing right
+
+	behind := interaction.NewInteractable(newInteractionEntity(-20, 0), "Behind", 50, nil)
+	behind.FacingTolerance = 45
+
+	interactor := interaction.NewInteractor(nil)
+	interactor.Add(behind)
+	interactor.Update(player, 0.016)
+
+	if interactor.Current() != nil {
+		t.Error("Expected interactable behind the player to be ignored")
+	}
+
+	player.Transform.Rotation = 180 // Turn around
+	interactor.Update(player, 0.016)
+	if interactor.Current() != behind {
+		t.Error("Expected interactable to be selected when faced")
+	}
+}
+
+// TestInteractorCallbacks tests OnInteract and OnTargetChanged.
+func TestInteractorCallbacks(t *testing.T) {
+	player := newInteractionEntity(0, 0)
+	target := newInteractionEntity(10, 0)
+
+	var interactedWith *core.Entity
+	it := interaction.NewInteractable(target, "Talk", 30, func(_, other *core.Entity) {
+		interactedWith = other
+	})
+
+	changes := 0
+	interactor := interaction.NewInteractor(nil)
+	interactor.OnTargetChanged = func(_, _ *interaction.Interactable) { changes++ }
+	interactor.Add(it)
+
+	if interactor.Interact() {
+		t.Error("Expected Interact to fail before any target is selected")
+	}
+
+	interactor.Update(player, 0.016)
+	if !interactor.Interact() {
+		t.Fatal("Expected Interact to succeed with a selected target")
+	}
+	if interactedWith != target {
+		t.Error("Expected OnInteract to receive the target entity")
+	}
+
+	interactor.Remove(it)
+	if interactor.Current() != nil {
+		t.Error("Expected selection to clear when interactable is removed")
+	}
+	if changes != 2 {
+		t.Errorf("Expected 2 target changes, got %d", changes)
+	}
+}
@@ -0,0 +1,123 @@
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/lobby"
+)
+
+// TestLobbyJoinReadyAndMigrate tests slots, ready checks, and host migration.
+func TestLobbyJoinReadyAndMigrate(t *testing.T) {
+	room := lobby.NewLobby("room-1", "Test", "alice", "Alice", 3)
+	allReady, newHost := 0, lobby.PlayerID("")
+	room.OnAllReady = func() { allReady++ }
+	room.OnHostChanged = func(_, next lobby.PlayerID) { newHost = next }
+
+	if _, err := room.Join("bob", "Bob"); err != nil {
+		t.Fatalf("Expected bob to join, got %v", err)
+	}
+	if _, err := room.Join("bob", "Bob"); !errors.Is(err, lobby.ErrAlreadyJoined) {
+		t.Errorf("Expected ErrAlreadyJoined, got %v", err)
+	}
+	if _, err := room.Join("carol", "Carol"); err != nil {
+		t.Fatalf("Expected carol to join, got %v", err)
+	}
+	if _, err := room.Join("dave", "Dave"); !errors.Is(err, lobby.ErrLobbyFull) {
+		t.Errorf("Expected ErrLobbyFull, got %v", err)
+	}
+
+	for _, id := range []lobby.PlayerID{"alice", "bob"} {
+		_ = room.SetReady(id, true)
+	}
+	if room.AllReady() || allReady != 0 {
+		t.Error("Expected lobby not ready while carol is unready")
+	}
+	_ = room.SetReady("carol", true)
+	if allReady != 1 {
+		t.Errorf("Expected OnAllReady once, got %d", allReady)
+	}
+
+	if err := room.Kick("bob", "carol"); !errors.Is(err, lobby.ErrNotHost) {
+		t.Errorf("Expected ErrNotHost, got %v", err)
+	}
+	if err := room.Leave("alice"); err != nil {
+		t.Fatalf("Expected host to leave, got %v", err)
+	}
+	if room.Host != "bob" || newHost != "bob" {
+		t.Errorf("Expected host to migrate to bob, got %q", room.Host)
+	}
+	if !room.Slots[0].Open() {
+		t.Error("Expected alice's slot to be open")
+	}
+}
+
+// TestLobbyNegativeMaxPlayers tests that a negative size gives a lobby
+// with no slots instead of panicking.
+func TestLobbyNegativeMaxPlayers(t *testing.T) {
+	room := lobby.NewLobby("room-2", "Empty", "alice", "Alice", -1)
+	if room.MaxPlayers != 0 || len(room.Slots) != 0 {
+		t.Errorf("Expected no slots, got MaxPlayers %d and %d slots", room.MaxPlayers, len(room.Slots))
+	}
+	if _, err := room.Join("bob", "Bob"); !errors.Is(err, lobby.ErrLobbyFull) {
+		t.Errorf("Expected ErrLobbyFull, got %v", err)
+	}
+}
+
+// TestLobbyApplyState tests client-side sync with change callbacks.
+func TestLobbyApplyState(t *testing.T) {
+	host := lobby.NewLobby("room-1", "Test", "alice", "Alice", 4)
+	client := lobby.NewLobby("", "", "", "", 0)
+	client.ApplyState(host.State())
+
+	joined, left := []string{}, []string{}
+	client.OnJoin = func(slot *lobby.Slot) { joined = append(joined, slot.Name) }
+	client.OnLeave = func(slot lobby.Slot) { left = append(left, slot.Name) }
+
+	_, _ = host.Join("bob", "Bob")
+	client.ApplyState(host.State())
+	_ = host.Leave("bob")
+	client.ApplyState(host.State())
+
+	if len(joined) != 1 || joined[0] != "Bob" || len(left) != 1 || left[0] != "Bob" {
+		t.Errorf("Expected Bob to join then leave, got joined %v left %v", joined, left)
+	}
+	if client.Host != "alice" || len(client.Players()) != 1 {
+		t.Errorf("Expected client to mirror the host lobby, got %+v", client.State())
+	}
+}
+
+// TestLobbyLANDiscovery tests announcing and browsing over loopback UDP.
+func TestLobbyLANDiscovery(t *testing.T) {
+	browser, err := lobby.Browse("test-game", 0)
+	if err != nil {
+		t.Skipf("UDP unavailable: %v", err)
+	}
+	defer func() { _ = browser.Close() }()
+
+	room := lobby.NewLobby("room-1", "LAN Party", "alice", "Alice", 4)
+	caster := lobby.NewBroadcaster(func() lobby.Announcement {
+		return lobby.Announce("test-game", "1.0", 7000, room)
+	})
+	caster.Target = fmt.Sprintf("127.0.0.1:%d", browser.Addr().(*net.UDPAddr).Port)
+	caster.Interval = 10 * time.Millisecond
+	if err := caster.Start(); err != nil {
+		t.Skipf("UDP unavailable: %v", err)
+	}
+	defer caster.Stop()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if found := browser.Lobbies(); len(found) == 1 {
+			if found[0].Name != "LAN Party" || found[0].Port != 7000 || found[0].Address != "127.0.0.1" {
+				t.Errorf("Expected announcement details, got %+v", found[0])
+			}
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Expected lobby to be discovered")
+}
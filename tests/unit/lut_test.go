@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+)
+
+// TestColorLUTIdentity tests that the identity strip leaves colors unchanged.
+func TestColorLUTIdentity(t *testing.T) {
+	lut, err := graphics.NewColorLUT(graphics.IdentityLUTImage(16))
+	if err != nil {
+		t.Fatalf("Expected identity LUT to load, got %v", err)
+	}
+
+	for _, c := range [][3]uint8{{0, 0, 0}, {255, 255, 255}, {12, 130, 201}} {
+		r, g, b := lut.Transform(c[0], c[1], c[2])
+		if absDiff(r, c[0]) > 1 || absDiff(g, c[1]) > 1 || absDiff(b, c[2]) > 1 {
+			t.Errorf("Expected %v unchanged, got (%d, %d, %d)", c, r, g, b)
+		}
+	}
+}
+
+// TestColorLUTApply tests grading a frame with an inverting LUT and partial strength.
+func TestColorLUTApply(t *testing.T) {
+	strip := graphics.IdentityLUTImage(8)
+	bounds := strip.Bounds()
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			c := strip.RGBAAt(x, y)
+			strip.SetRGBA(x, y, color.RGBA{R: 255 - c.R, G: 255 - c.G, B: 255 - c.B, A: 255})
+		}
+	}
+	lut, err := graphics.NewColorLUT(strip)
+	if err != nil {
+		t.Fatalf("Expected LUT to load, got %v", err)
+	}
+
+	frame := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	frame.SetRGBA(0, 0, color.RGBA{R: 255, A: 200})
+	frame.SetRGBA(1, 0, color.RGBA{G: 255, B: 255, A: 255})
+
+	pp := graphics.NewPostProcessor()
+	pp.Add(lut)
+	pp.Process(frame)
+
+	if got := frame.RGBAAt(0, 0); got != (color.RGBA{R: 0, G: 255, B: 255, A: 200}) {
+		t.Errorf("Expected inverted red with alpha preserved, got %v", got)
+	}
+
+	lut.Strength = 0.5
+	if r, _, _ := lut.Transform(255, 0, 0); absDiff(r, 128) > 1 {
+		t.Errorf("Expected half-strength red near 128, got %d", r)
+	}
+}
+
+// TestColorLUTInvalid tests rejection of non-strip images.
+func TestColorLUTInvalid(t *testing.T) {
+	_, err := graphics.NewColorLUT(image.NewRGBA(image.Rect(0, 0, 100, 20)))
+	if !errors.Is(err, graphics.ErrInvalidLUT) {
+		t.Errorf("Expected ErrInvalidLUT, got %v", err)
+	}
+}
+
+func absDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,156 @@
+package unit
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/match3"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// newAuthoredBoard creates a 4x4 board where swapping (2,0) and (2,1) completes row 0.
+func newAuthoredBoard() *match3.Board {
+	board := match3.NewBoard(4, 4, 6, rand.New(rand.NewSource(7)))
+	layout := [][]int{
+		{0, 0, 1, 2},
+		{3, 4, 0, 5},
+		{1, 2, 3, 4},
+		{5, 3, 1, 0},
+	}
+	for y, row := range layout {
+		for x, kind := range row {
+			board.Set(match3.Cell{X: x, Y: y}, kind)
+		}
+	}
+	return board
+}
+
+// TestMatch3NewBoardHasNoMatches tests that generated boards start stable and playable.
+func TestMatch3NewBoardHasNoMatches(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		board := match3.NewBoard(8, 8, 5, rand.New(rand.NewSource(seed)))
+		if matches := board.FindMatches(); len(matches) != 0 {
+			t.Fatalf("Expected no initial matches for seed %d, got %v", seed, matches)
+		}
+		if !board.HasMoves() {
+			t.Fatalf("Expected a valid move for seed %d", seed)
+		}
+	}
+}
+
+// TestMatch3NewBoardClampsArguments tests that invalid sizes and kinds
+// don't panic.
+func TestMatch3NewBoardClampsArguments(t *testing.T) {
+	board := match3.NewBoard(3, 2, 0, rand.New(rand.NewSource(1)))
+	if board.Kinds != 1 || board.At(match3.Cell{X: 2, Y: 1}) != 0 {
+		t.Errorf("Expected kinds clamped to 1 with every tile kind 0, got %d kinds", board.Kinds)
+	}
+	board = match3.NewBoard(-2, 4, -5, nil)
+	if board.Cols != 0 || board.Kinds != 1 || board.InBounds(match3.Cell{}) {
+		t.Errorf("Expected an empty board for negative columns, got %dx%d", board.Cols, board.Rows)
+	}
+}
+
+// TestMatch3SwapAndMatch tests swap validation and match finding.
+func TestMatch3SwapAndMatch(t *testing.T) {
+	board := newAuthoredBoard()
+	a, b := match3.Cell{X: 2, Y: 0}, match3.Cell{X: 2, Y: 1}
+
+	if board.CanSwap(a, match3.Cell{X: 3, Y: 1}) {
+		t.Error("Expected diagonal swap to be rejected")
+	}
+	if board.CanSwap(match3.Cell{X: 0, Y: 2}, match3.Cell{X: 0, Y: 3}) {
+		t.Error("Expected swap without a match to be rejected")
+	}
+	if !board.CanSwap(a, b) {
+		t.Fatal("Expected swap completing row 0 to be valid")
+	}
+	if board.At(a) != 1 {
+		t.Error("Expected CanSwap to leave the board unchanged")
+	}
+
+	board.Swap(a, b)
+	matches := board.FindMatches()
+	if len(matches) != 1 || matches[0].Kind != 0 || len(matches[0].Cells) != 3 {
+		t.Fatalf("Expected one run of three 0s, got %v", matches)
+	}
+	if score := match3.DefaultScore(matches, 2); score != 60 {
+		t.Errorf("Expected 3 tiles x 10 x chain 2 = 60, got %d", score)
+	}
+}
+
+// TestMatch3CollapseAndRefill tests gravity and refill drop heights.
+func TestMatch3CollapseAndRefill(t *testing.T) {
+	board := newAuthoredBoard()
+	board.Set(match3.Cell{X: 1, Y: 2}, match3.Empty)
+
+	falls := board.Collapse()
+	if len(falls) != 2 || falls[0].From != (match3.Cell{X: 1, Y: 1}) || falls[0].To != (match3.Cell{X: 1, Y: 2}) {
+		t.Fatalf("Expected two tiles to fall one row, got %v", falls)
+	}
+	if board.At(match3.Cell{X: 1, Y: 2}) != 4 || board.At(match3.Cell{X: 1, Y: 0}) != match3.Empty {
+		t.Error("Expected column to shift down leaving the top empty")
+	}
+
+	spawns := board.Refill()
+	if len(spawns) != 1 || spawns[0].Cell != (match3.Cell{X: 1, Y: 0}) || spawns[0].Drop != 1 {
+		t.Errorf("Expected one spawn at the top dropping one row, got %v", spawns)
+	}
+	if board.At(match3.Cell{X: 1, Y: 0}) == match3.Empty {
+		t.Error("Expected refill to fill the empty cell")
+	}
+}
+
+// TestMatch3GameAnimatesCascade tests the animated controller through a swap and settle.
+func TestMatch3GameAnimatesCascade(t *testing.T) {
+	scene := core.NewScene()
+	board := newAuthoredBoard()
+	newTile := func(kind int) *core.Entity {
+		return &core.Entity{Active: true, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}}
+	}
+	game := match3.NewGame(board, scene, gamemath.Vector2{}, 10, newTile)
+
+	settled := 0
+	game.OnSettle = func() { settled++ }
+
+	// Invalid swap bounces back without scoring
+	if game.TrySwap(match3.Cell{X: 0, Y: 2}, match3.Cell{X: 0, Y: 3}) {
+		t.Error("Expected invalid swap to return false")
+	}
+	for i := 0; i < 100 && game.Busy(); i++ {
+		game.Update(nil, 0.05)
+	}
+	if game.Total != 0 || board.At(match3.Cell{X: 0, Y: 2}) != 1 || settled != 1 {
+		t.Fatalf("Expected board unchanged after bounce, got total %d", game.Total)
+	}
+
+	if !game.TrySwap(match3.Cell{X: 2, Y: 0}, match3.Cell{X: 2, Y: 1}) {
+		t.Fatal("Expected valid swap")
+	}
+	game.Update(nil, 0.01)
+	if !game.Busy() {
+		t.Error("Expected controller to be busy while animating")
+	}
+	for i := 0; i < 500 && game.Busy(); i++ {
+		game.Update(nil, 0.05)
+	}
+	if game.Busy() || game.Total < 30 {
+		t.Fatalf("Expected settled board with score, got busy %v total %d", game.Busy(), game.Total)
+	}
+
+	for y := 0; y < board.Rows; y++ {
+		for x := 0; x < board.Cols; x++ {
+			cell := match3.Cell{X: x, Y: y}
+			tile := game.Tile(cell)
+			if tile == nil || tile.Transform.Position != game.CellCenter(cell) || tile.Transform.Scale.X != 1 {
+				t.Fatalf("Expected tile at rest in %v", cell)
+			}
+		}
+	}
+
+	scene.Update(0)
+	if count := len(scene.GetAllEntities()); count != 16 {
+		t.Errorf("Expected cleared tiles removed from the scene (16 left), got %d", count)
+	}
+}
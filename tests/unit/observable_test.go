@@ -0,0 +1,61 @@
+package unit
+
+import (
+	"fmt"
+	"testing"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/observable"
+)
+
+// TestObservableValue tests change notification, no-op sets, and unsubscribing.
+func TestObservableValue(t *testing.T) {
+	score := observable.NewValue(0)
+	var changes []string
+	stop := score.Subscribe(func(old, value int) { changes = append(changes, fmt.Sprintf("%d->%d", old, value)) })
+
+	score.Set(10)
+	score.Set(10)
+	score.Update(func(v int) int { return v + 5 })
+	if len(changes) != 2 || changes[1] != "10->15" {
+		t.Errorf("Expected two changes ending 10->15, got %v", changes)
+	}
+
+	stop()
+	score.Set(20)
+	if len(changes) != 2 {
+		t.Error("Expected no notification after unsubscribing")
+	}
+}
+
+// TestObservableBindings tests labels and bars following bound values.
+func TestObservableBindings(t *testing.T) {
+	health := observable.NewValue(80)
+	label := observable.NewLabel(nil, 10, 10, gamemath.White)
+	formats := 0
+	observable.BindLabel(label, health, func(hp int) string {
+		formats++
+		return fmt.Sprintf("HP %d", hp)
+	})
+	bar := observable.NewBar(gamemath.Rectangle{Width: 100, Height: 10}, gamemath.Red)
+	unbind := observable.BindBar(bar, health, 100)
+
+	if label.Text() != "HP 80" || bar.Fraction() != 0.8 {
+		t.Fatalf("Expected initial binding, got %q and %f", label.Text(), bar.Fraction())
+	}
+
+	health.Set(80)
+	health.Set(150)
+	if formats != 2 || label.Text() != "HP 150" {
+		t.Errorf("Expected formatting only on change, got %d formats and %q", formats, label.Text())
+	}
+	if bar.Fraction() != 1 {
+		t.Errorf("Expected bar clamped to full, got %f", bar.Fraction())
+	}
+
+	unbind()
+	health.Set(0)
+	if bar.Fraction() != 1 {
+		t.Error("Expected unbound bar to stop following the value")
+	}
+}
@@ -0,0 +1,203 @@
+package unit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/pak"
+	"github.com/dshills/gogame/engine/secure"
+)
+
+// buildTestPak packs entries into memory and opens the result.
+func buildTestPak(t *testing.T, sealer secure.Sealer, files map[string][]byte) (*pak.Archive, []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	writer, err := pak.NewWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	writer.Sealer = sealer
+	for name, data := range files {
+		if err := writer.Add(name, data); err != nil {
+			t.Fatalf("Add(%s) failed: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	archive, err := pak.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	return archive, buf.Bytes()
+}
+
+// TestPakRoundTrip tests packing, listing, and reading entries.
+func TestPakRoundTrip(t *testing.T) {
+	repetitive := bytes.Repeat([]byte("tile"), 500)
+	archive, _ := buildTestPak(t, nil, map[string][]byte{
+		"sprites/player.png": {0x89, 'P', 'N', 'G'},
+		"./maps/level1.json": repetitive,
+	})
+
+	entries := archive.Entries()
+	if len(entries) != 2 || entries[0].Name != "maps/level1.json" || entries[1].Name != "sprites/player.png" {
+		t.Fatalf("Expected sorted cleaned names, got %+v", entries)
+	}
+	if !entries[0].Compressed || entries[0].StoredSize >= entries[0].Size {
+		t.Errorf("Expected repetitive entry to be compressed, got %+v", entries[0])
+	}
+	if entries[1].Compressed {
+		t.Error("Expected tiny entry to be stored uncompressed")
+	}
+
+	data, err := archive.ReadFile("maps/level1.json")
+	if err != nil || !bytes.Equal(data, repetitive) {
+		t.Fatalf("Expected decompressed data to match, got %d bytes (%v)", len(data), err)
+	}
+	if !archive.Contains("./sprites/player.png") {
+		t.Error("Expected Contains to normalize paths")
+	}
+	if _, err := archive.ReadFile("missing.png"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+// TestPakFS tests the archive as an fs.FS.
+func TestPakFS(t *testing.T) {
+	archive, _ := buildTestPak(t, nil, map[string][]byte{"a/b.txt": []byte("hello")})
+
+	file, err := archive.Open("a/b.txt")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+	data, _ := io.ReadAll(file)
+	info, _ := file.Stat()
+	if string(data) != "hello" || info.Name() != "b.txt" || info.Size() != 5 {
+		t.Errorf("Expected hello/b.txt/5, got %q/%s/%d", data, info.Name(), info.Size())
+	}
+
+	if data, err := fs.ReadFile(archive, "a/b.txt"); err != nil || string(data) != "hello" {
+		t.Errorf("Expected fs.ReadFile to work, got %q (%v)", data, err)
+	}
+	if _, err := archive.Open("/a/b.txt"); err == nil {
+		t.Error("Expected invalid fs path to be rejected")
+	}
+}
+
+// TestPakCorruption tests that damaged archives and entries are detected.
+func TestPakCorruption(t *testing.T) {
+	_, raw := buildTestPak(t, nil, map[string][]byte{"a.txt": []byte("hello world")})
+
+	if _, err := pak.NewReader(bytes.NewReader([]byte("not a pak at all")), 16); !errors.Is(err, pak.ErrInvalidPak) {
+		t.Errorf("Expected ErrInvalidPak, got %v", err)
+	}
+
+	damaged := append([]byte(nil), raw...)
+	damaged[8] ^= 0xff // Inside the entry data
+	archive, err := pak.NewReader(bytes.NewReader(damaged), int64(len(damaged)))
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	if _, err := archive.ReadFile("a.txt"); !errors.Is(err, pak.ErrCorrupt) {
+		t.Errorf("Expected ErrCorrupt, got %v", err)
+	}
+}
+
+// TestPakCorruptIndex tests that damaged index fields are rejected or
+// reported instead of panicking.
+func TestPakCorruptIndex(t *testing.T) {
+	_, raw := buildTestPak(t, nil, map[string][]byte{"a.txt": []byte("hello world")})
+	footer := len(raw) - 8 - len("GGPAK1")
+	fields := int(binary.LittleEndian.Uint64(raw[footer:])) + 4 + 2 + len("a.txt")
+
+	for name, at := range map[string]int{"offset": fields, "stored size": fields + 8, "size": fields + 16} {
+		damaged := append([]byte(nil), raw...)
+		binary.LittleEndian.PutUint64(damaged[at:], math.MaxUint64)
+		if _, err := pak.NewReader(bytes.NewReader(damaged), int64(len(damaged))); !errors.Is(err, pak.ErrInvalidPak) {
+			t.Errorf("Expected ErrInvalidPak for a negative %s, got %v", name, err)
+		}
+	}
+
+	// Flipping any 8 bytes of the index must fail cleanly
+	for at := footer - 8; at >= fields-8; at-- {
+		damaged := append([]byte(nil), raw...)
+		for i := range 8 {
+			damaged[at+i] ^= 0xff
+		}
+		archive, err := pak.NewReader(bytes.NewReader(damaged), int64(len(damaged)))
+		if err != nil {
+			continue
+		}
+		for _, entry := range archive.Entries() {
+			_, _ = archive.ReadFile(entry.Name)
+		}
+	}
+
+	var buf bytes.Buffer
+	writer, _ := pak.NewWriter(&buf)
+	if err := writer.Add(strings.Repeat("a", 1<<16), []byte("x")); err == nil {
+		t.Error("Expected an error for a name longer than 65535 bytes")
+	}
+	if err := writer.Add(strings.Repeat("a", 1<<16-1), []byte("x")); err != nil {
+		t.Errorf("Expected a 65535-byte name to be stored, got %v", err)
+	}
+}
+
+// TestPakSealed tests encrypted entries.
+func TestPakSealed(t *testing.T) {
+	sealer, _ := secure.NewAES(secure.DeriveKey("secret", "assets"))
+	archive, raw := buildTestPak(t, sealer, map[string][]byte{"secret.txt": []byte("the butler did it")})
+
+	if bytes.Contains(raw, []byte("butler")) {
+		t.Error("Expected sealed entry to be encrypted")
+	}
+	if _, err := archive.ReadFile("secret.txt"); !errors.Is(err, pak.ErrSealed) {
+		t.Errorf("Expected ErrSealed without a sealer, got %v", err)
+	}
+	archive.Sealer = sealer
+	if data, err := archive.ReadFile("secret.txt"); err != nil || string(data) != "the butler did it" {
+		t.Errorf("Expected decrypted entry, got %q (%v)", data, err)
+	}
+}
+
+// TestPakAddDir tests packing a directory from disk and reopening it.
+func TestPakAddDir(t *testing.T) {
+	dir := t.TempDir()
+	assets := filepath.Join(dir, "assets")
+	if err := os.MkdirAll(filepath.Join(assets, "sprites"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.WriteFile(filepath.Join(assets, "sprites", "ship.png"), []byte("ship"), 0o644)
+	_ = os.WriteFile(filepath.Join(assets, "font.ttf"), []byte("font"), 0o644)
+
+	pakPath := filepath.Join(dir, "game.pak")
+	file, _ := os.Create(pakPath)
+	writer, _ := pak.NewWriter(file)
+	if err := writer.AddDir(assets); err != nil {
+		t.Fatalf("AddDir failed: %v", err)
+	}
+	if err := writer.Add("font.ttf", nil); !errors.Is(err, pak.ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate, got %v", err)
+	}
+	_ = writer.Close()
+	_ = file.Close()
+
+	archive, err := pak.Open(pakPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = archive.Close() }()
+	if data, err := archive.ReadFile("sprites/ship.png"); err != nil || string(data) != "ship" {
+		t.Errorf("Expected ship entry, got %q (%v)", data, err)
+	}
+}
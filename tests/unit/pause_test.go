@@ -0,0 +1,51 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// menuBehavior is pause-exempt and counts updates and late updates.
+type menuBehavior struct {
+	updates, lateUpdates int
+}
+
+func (m *menuBehavior) Update(entity *core.Entity, dt float64)     { m.updates++ }
+func (m *menuBehavior) LateUpdate(entity *core.Entity, dt float64) { m.lateUpdates++ }
+func (m *menuBehavior) UpdateWhenPaused() bool                     { return true }
+
+// TestSceneUpdatePaused tests that only exempt entities and behaviors tick while paused.
+func TestSceneUpdatePaused(t *testing.T) {
+	scene := core.NewScene()
+	scene.SetGravity(gamemath.Vector2{Y: 100})
+
+	frozen := &mockBehavior{}
+	faller := &core.Entity{Active: true, Behavior: frozen, Body: physics.NewRigidBody(1)}
+	flagged := &mockBehavior{}
+	music := &core.Entity{Active: true, Behavior: flagged, UpdateWhenPaused: true}
+	menu, sibling := &menuBehavior{}, &mockBehavior{}
+	mixed := &core.Entity{Active: true, Behavior: core.Behaviors{sibling, menu}}
+	scene.AddEntity(faller)
+	scene.AddEntity(music)
+	scene.AddEntity(mixed)
+
+	scene.UpdatePaused(0.1)
+	if frozen.updateCount != 0 || faller.Transform.Position.Y != 0 {
+		t.Error("Expected non-exempt entity and its body to stay frozen")
+	}
+	if flagged.updateCount != 1 {
+		t.Errorf("Expected UpdateWhenPaused entity to update once, got %d", flagged.updateCount)
+	}
+	if menu.updates != 1 || menu.lateUpdates != 1 || sibling.updateCount != 0 {
+		t.Errorf("Expected only the PauseExempt behavior in the list to run, got menu=%d/%d sibling=%d",
+			menu.updates, menu.lateUpdates, sibling.updateCount)
+	}
+
+	scene.Update(0.1)
+	if frozen.updateCount != 1 || sibling.updateCount != 1 || faller.Transform.Position.Y == 0 {
+		t.Error("Expected everything to run when not paused")
+	}
+}
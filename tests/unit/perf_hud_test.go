@@ -0,0 +1,82 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gogametest"
+	"github.com/dshills/gogame/engine/input"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestPerfHUDLines tests recorded statistics appear in the panel text.
+func TestPerfHUDLines(t *testing.T) {
+	hud := core.NewPerfHUD()
+	hud.Record(core.PerfStats{
+		FPS:            59.7,
+		FrameMin:       0.010,
+		FrameMax:       0.020,
+		FrameAvg:       0.0165,
+		Entities:       42,
+		CollisionPairs: 7,
+		DrawCalls:      128,
+	})
+
+	stats := hud.Stats()
+	if stats.HeapBytes == 0 {
+		t.Error("Expected heap statistics on the first record")
+	}
+	text := strings.Join(hud.Lines(), "\n")
+	for _, want := range []string{"FPS 60", "10.0 / 16.5 / 20.0 ms", "Entities 42", "Pairs 7", "Draws 128", "Heap "} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in HUD text, got:\n%s", want, text)
+		}
+	}
+}
+
+// TestPerfHUDToggleKey tests F2 toggles the HUD.
+func TestPerfHUDToggleKey(t *testing.T) {
+	hud := core.NewPerfHUD()
+	in := gogametest.NewFakeInput()
+
+	in.SetKeyState(input.KeyF2, true)
+	hud.HandleInput(in.InputManager)
+	if !hud.Enabled {
+		t.Error("Expected F2 to enable the perf HUD")
+	}
+	in.NextFrame()
+	in.SetKeyState(input.KeyF2, false)
+	in.NextFrame()
+	in.SetKeyState(input.KeyF2, true)
+	hud.HandleInput(in.InputManager)
+	if hud.Enabled {
+		t.Error("Expected a second F2 press to hide the perf HUD")
+	}
+}
+
+// TestRendererDrawCalls tests the renderer counts draws until reset.
+func TestRendererDrawCalls(t *testing.T) {
+	renderer := gogametest.NewFakeRenderer()
+	rect := gamemath.Rectangle{Width: 10, Height: 10}
+	_ = renderer.DrawRect(rect, gamemath.White)
+	_ = renderer.FillRect(rect, gamemath.White)
+	_ = renderer.DrawLine(0, 0, 10, 10, gamemath.White)
+	if renderer.DrawCalls() != 3 {
+		t.Errorf("Expected 3 draw calls, got %d", renderer.DrawCalls())
+	}
+	renderer.ResetDrawCalls()
+	if renderer.DrawCalls() != 0 {
+		t.Errorf("Expected 0 draw calls after reset, got %d", renderer.DrawCalls())
+	}
+
+	// Without a font the HUD draws nothing
+	hud := core.NewPerfHUD()
+	hud.Enabled = true
+	if err := hud.Draw(renderer.Renderer, 800, 600); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	if renderer.DrawCalls() != 0 {
+		t.Errorf("Expected no HUD draws without a font, got %d", renderer.DrawCalls())
+	}
+}
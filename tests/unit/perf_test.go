@@ -0,0 +1,88 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// slowBehavior burns time in Update.
+type slowBehavior struct{ cost time.Duration }
+
+func (b *slowBehavior) Update(entity *core.Entity, dt float64) {
+	for start := time.Now(); time.Since(start) < b.cost; {
+	}
+}
+
+// TestPerfMonitorFrameBudget tests that a warning fires once after N
+// consecutive slow frames and re-arms after a fast frame.
+func TestPerfMonitorFrameBudget(t *testing.T) {
+	monitor := core.NewPerfMonitor()
+	monitor.BudgetFrames = 3
+	var warnings []core.PerfWarning
+	monitor.OnWarning = func(w core.PerfWarning) { warnings = append(warnings, w) }
+
+	slow := 20 * time.Millisecond
+	monitor.RecordFrame(slow)
+	monitor.RecordFrame(slow)
+	monitor.RecordFrame(time.Millisecond) // Streak broken
+	for i := 0; i < 10; i++ {
+		monitor.RecordFrame(slow)
+	}
+	if len(warnings) != 1 || warnings[0].Kind != core.PerfFrameBudget || warnings[0].Frames != 3 {
+		t.Fatalf("Expected one frame budget warning after 3 frames, got %+v", warnings)
+	}
+
+	monitor.RecordFrame(time.Millisecond)
+	for i := 0; i < 3; i++ {
+		monitor.RecordFrame(slow)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("Expected warning to re-arm after a fast frame, got %d warnings", len(warnings))
+	}
+}
+
+// TestPerfMonitorCollisionPairs tests the collision pair limit through a scene.
+func TestPerfMonitorCollisionPairs(t *testing.T) {
+	scene := core.NewScene()
+	monitor := core.NewPerfMonitor()
+	monitor.MaxCollisionPairs = 2
+	scene.SetPerfMonitor(monitor)
+	for i := 0; i < 3; i++ {
+		scene.AddEntity(newSolidBox(0, 0)) // 3 mutually overlapping boxes = 3 pairs
+	}
+
+	scene.Update(0)
+	scene.Update(0)
+	warnings := monitor.Warnings()
+	if len(warnings) != 1 || warnings[0].Kind != core.PerfCollisionPairs || warnings[0].Count != 3 {
+		t.Errorf("Expected one collision pair warning with count 3, got %+v", warnings)
+	}
+}
+
+// TestPerfMonitorSlowBehavior tests that sampling names the slow behavior type.
+func TestPerfMonitorSlowBehavior(t *testing.T) {
+	scene := core.NewScene()
+	monitor := core.NewPerfMonitor()
+	monitor.BehaviorBudget = time.Millisecond
+	monitor.SampleEvery = 2
+	scene.SetPerfMonitor(monitor)
+	id := scene.AddEntity(&core.Entity{
+		Active:   true,
+		Behavior: core.Behaviors{&mockBehavior{}, &slowBehavior{cost: 3 * time.Millisecond}},
+	})
+
+	scene.Update(0.016)
+	if len(monitor.Warnings()) != 0 {
+		t.Fatal("Expected the first update not to be sampled")
+	}
+	scene.Update(0.016)
+	warnings := monitor.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Expected one slow behavior warning, got %d", len(warnings))
+	}
+	if warnings[0].Culprit != "*unit.slowBehavior" || warnings[0].EntityID != id {
+		t.Errorf("Expected culprit *unit.slowBehavior on entity %d, got %q on %d", id, warnings[0].Culprit, warnings[0].EntityID)
+	}
+}
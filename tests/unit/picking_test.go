@@ -0,0 +1,184 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+	"github.com/dshills/gogame/engine/picking"
+)
+
+func newPickableEntity(x, y float64, layer int) *core.Entity {
+	return &core.Entity{
+		Active: true,
+		Transform: gamemath.Transform{
+			Position: gamemath.Vector2{X: x, Y: y},
+			Scale:    gamemath.Vector2{X: 1, Y: 1},
+		},
+		Collider: physics.NewCollider(20, 20),
+		Layer:    layer,
+	}
+}
+
+// TestPickWorldTopmost tests that picking prefers the highest layer.
+func TestPickWorldTopmost(t *testing.T) {
+	bottom := newPickableEntity(100, 100, 0)
+	top := newPickableEntity(105, 100, 2)
+	other := newPickableEntity(500, 500, 5)
+
+	entities := []*core.Entity{bottom, top, other}
+
+	if got := picking.PickWorld(entities, 102, 100); got != top {
+		t.Errorf("Expected topmost entity, got %v", got)
+	}
+	if got := picking.PickWorld(entities, 300, 300); got != nil {
+		t.Errorf("Expected no entity, got %v", got)
+	}
+
+	top.Active = false
+	if got := picking.PickWorld(entities, 102, 100); got != bottom {
+		t.Error("Expected inactive entity to be skipped")
+	}
+}
+
+// TestPickUsesCamera tests screen to world conversion through the scene camera.
+func TestPickUsesCamera(t *testing.T) {
+	scene := core.NewScene()
+	scene.Camera().Position = gamemath.Vector2{X: 400, Y: 300}
+	entity := newPickableEntity(400, 300, 0)
+	scene.AddEntity(entity)
+
+	// Camera centered at (400, 300) with 800x600 screen maps screen center to world center
+	if got := picking.Pick(scene, 400, 300); got != entity {
+		t.Error("Expected entity under screen center to be picked")
+	}
+}
+
+// TestPickLayers tests screen-space layers and locked layers during picking.
+func TestPickLayers(t *testing.T) {
+	scene := core.NewScene()
+	scene.Camera().Position = gamemath.Vector2{X: 1000, Y: 1000}
+
+	hud := scene.AddLayer("hud", 10)
+	hud.ScreenSpace = true
+	button := newPickableEntity(50, 50, hud.Z)
+	scene.AddEntity(button)
+
+	if got := picking.Pick(scene, 50, 50); got != button {
+		t.Fatalf("Expected screen-space button to be picked, got %v", got)
+	}
+
+	hud.Locked = true
+	if got := picking.Pick(scene, 50, 50); got != nil {
+		t.Errorf("Expected locked layer to be skipped, got %v", got)
+	}
+}
+
+// TestDragControllerLifecycle tests drag start, move, and drop.
+func TestDragControllerLifecycle(t *testing.T) {
+	entity := newPickableEntity(100, 100, 0)
+
+	var started, dropped bool
+	draggable := picking.NewDraggable(entity)
+	draggable.OnDragStart = func(_ *core.Entity, _ gamemath.Vector2) { started = true }
+	draggable.OnDrop = func(_ *core.Entity, _ gamemath.Vector2) { dropped = true }
+
+	dc := picking.NewDragController(nil, nil)
+	dc.Add(draggable)
+
+	if !dc.BeginDrag(gamemath.Vector2{X: 105, Y: 100}) {
+		t.Fatal("Expected drag to begin")
+	}
+	if !started {
+		t.Error("Expected OnDragStart to be called")
+	}
+
+	dc.DragTo(gamemath.Vector2{X: 205, Y: 150})
+	if entity.Transform.Position.X != 200 || entity.Transform.Position.Y != 150 {
+		t.Errorf("Expected entity to keep grab offset, got %v", entity.Transform.Position)
+	}
+
+	dc.EndDrag(gamemath.Vector2{X: 205, Y: 150})
+	if !dropped {
+		t.Error("Expected OnDrop to be called")
+	}
+	if dc.Dragging() != nil {
+		t.Error("Expected no active drag after drop")
+	}
+}
+
+// TestDragControllerParentedEntity tests dragging a child of a scaled parent in world space.
+func TestDragControllerParentedEntity(t *testing.T) {
+	parent := newPickableEntity(100, 100, 0)
+	parent.Transform.Scale = gamemath.Vector2{X: 2, Y: 2}
+	child := newPickableEntity(50, 0, 0)
+	_ = parent.AddChild(child) // Fresh entities can't form a cycle
+
+	dc := picking.NewDragController(nil, nil)
+	dc.Add(picking.NewDraggable(child))
+
+	// Child sits at world (200, 100)
+	if !dc.BeginDrag(gamemath.Vector2{X: 205, Y: 100}) {
+		t.Fatal("Expected drag to begin on the child's world position")
+	}
+
+	dc.DragTo(gamemath.Vector2{X: 305, Y: 150})
+	if world := child.WorldTransform().Position; world.X != 300 || world.Y != 150 {
+		t.Errorf("Expected child world position (300, 150), got %v", world)
+	}
+	if local := child.Transform.Position; local.X != 100 || local.Y != 25 {
+		t.Errorf("Expected child local position (100, 25), got %v", local)
+	}
+}
+
+// TestSelectionSetSemantics tests add/toggle/set behavior.
+func TestSelectionSetSemantics(t *testing.T) {
+	a := &core.Entity{ID: 1}
+	b := &core.Entity{ID: 2}
+	c := &core.Entity{ID: 3}
+
+	set := picking.NewSelectionSet()
+	set.Add(b, a)
+	if set.Len() != 2 || !set.Contains(a) || !set.Contains(b) {
+		t.Fatal("Expected a and b to be selected")
+	}
+
+	set.Toggle(b, c)
+	if set.Contains(b) || !set.Contains(c) {
+		t.Error("Expected toggle to deselect b and select c")
+	}
+
+	entities := set.Entities()
+	if len(entities) != 2 || entities[0] != a || entities[1] != c {
+		t.Errorf("Expected entities sorted by ID, got %v", entities)
+	}
+
+	set.Set(b)
+	if set.Len() != 1 || !set.Contains(b) {
+		t.Error("Expected Set to replace the selection")
+	}
+}
+
+// TestBoxSelectorSelectInRect tests region selection against a scene.
+func TestBoxSelectorSelectInRect(t *testing.T) {
+	scene := core.NewScene()
+	inside := newPickableEntity(50, 50, 0)
+	outside := newPickableEntity(500, 500, 0)
+	scene.AddEntity(inside)
+	scene.AddEntity(outside)
+
+	selection := picking.NewSelectionSet()
+	selector := picking.NewBoxSelector(nil, scene, selection)
+
+	region := gamemath.Rectangle{X: 0, Y: 0, Width: 100, Height: 100}
+	selector.SelectInRect(region, picking.SelectReplace)
+	if selection.Len() != 1 || !selection.Contains(inside) {
+		t.Errorf("Expected only the inside entity to be selected, got %d", selection.Len())
+	}
+
+	selector.SelectInRect(region, picking.SelectToggle)
+	if selection.Len() != 0 {
+		t.Error("Expected toggle to deselect the entity")
+	}
+}
@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// TestPrefabSaveLoadInstantiate tests a prefab round trip and independent instances.
+func TestPrefabSaveLoadInstantiate(t *testing.T) {
+	template := &core.Entity{
+		Active:    true,
+		Layer:     2,
+		Transform: gamemath.Transform{Position: gamemath.Vector2{X: 5, Y: 5}, Scale: gamemath.Vector2{X: 1, Y: 1}},
+		Sprite:    graphics.NewSprite(graphics.NewTexture(nil, 16, 16, "sprites/coin.png")),
+		Collider:  physics.NewCircleCollider(8),
+		Tags:      []string{"coin"},
+	}
+	template.Collider.IsTrigger = true
+
+	var buf bytes.Buffer
+	if err := core.NewPrefab("coin", template).Save(&buf); err != nil {
+		t.Fatalf("Expected prefab save to succeed, got %v", err)
+	}
+	prefab, err := core.LoadPrefab(&buf)
+	if err != nil {
+		t.Fatalf("Expected prefab load to succeed, got %v", err)
+	}
+	setups := 0
+	prefab.OnInstantiate = func(entity *core.Entity) {
+		setups++
+		entity.Behavior = &mockBehavior{}
+	}
+
+	scene := core.NewScene()
+	scene.AddEntity(&core.Entity{Active: true})
+	a, err := prefab.Instantiate(scene, gamemath.Vector2{X: 100, Y: 50}, nil)
+	if err != nil {
+		t.Fatalf("Expected instantiate to succeed, got %v", err)
+	}
+	b, _ := prefab.Instantiate(scene, gamemath.Vector2{X: 200, Y: 50}, nil)
+
+	if a.ID != 2 || b.ID != 3 || setups != 2 {
+		t.Errorf("Expected fresh IDs 2 and 3 with setup per instance, got %d, %d, %d setups", a.ID, b.ID, setups)
+	}
+	if a.Transform.Position.X != 100 || b.Transform.Position.X != 200 || a.Layer != 2 {
+		t.Error("Expected instances at their spawn positions with template layer")
+	}
+	if a.Sprite.Texture.Path != "sprites/coin.png" || a.Collider.Shape != (physics.Circle{Radius: 8}) || !a.Collider.IsTrigger {
+		t.Error("Expected sprite path and collider shape from the template")
+	}
+	a.Collider.IsTrigger = false
+	a.AddTag("collected")
+	if !b.Collider.IsTrigger || b.HasTag("collected") {
+		t.Error("Expected instances not to share components")
+	}
+	if len(scene.FindByTag("coin")) != 2 {
+		t.Error("Expected both instances tagged coin")
+	}
+}
+
+// TestPrefabLoadRejectsNewerVersion tests version checking.
+func TestPrefabLoadRejectsNewerVersion(t *testing.T) {
+	_, err := core.LoadPrefab(strings.NewReader(`{"version": 99, "name": "x", "entity": {}}`))
+	if !errors.Is(err, core.ErrSceneVersion) {
+		t.Errorf("Expected ErrSceneVersion, got %v", err)
+	}
+}
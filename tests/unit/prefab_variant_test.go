@@ -0,0 +1,115 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// patrolBehavior records the speed a prefab configured it with.
+type patrolBehavior struct {
+	speed float64
+}
+
+func (p *patrolBehavior) Update(_ *core.Entity, _ float64) {}
+
+// newEnemyPrefab creates a base prefab with a sprite, body, and speed property.
+func newEnemyPrefab() *core.Prefab {
+	template := &core.Entity{
+		Active:    true,
+		Layer:     3,
+		Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}},
+		Sprite:    graphics.NewSprite(graphics.NewTexture(nil, 16, 16, "sprites/enemy.png")),
+		Body:      physics.NewRigidBody(1),
+		Tags:      []string{"enemy"},
+	}
+	prefab := core.NewPrefab("enemy", template)
+	prefab.Properties = map[string]float64{"speed": 80, "health": 3}
+	prefab.OnInstantiate = func(e *core.Entity) {
+		e.Behavior = &patrolBehavior{speed: e.Prefab().Property("speed", 0)}
+	}
+	return prefab
+}
+
+// TestPrefabVariantOverrides tests that a variant changes only the listed
+// properties and leaves the base untouched.
+func TestPrefabVariantOverrides(t *testing.T) {
+	base := newEnemyPrefab()
+	red := gamemath.Color{R: 255, G: 60, B: 60, A: 255}
+	variant := core.NewPrefabVariant("fast_red_enemy", base).
+		Override("sprite.color", red).
+		Override("scale_x", 1.5).
+		Override("scale_y", 1.5).
+		SetProperty("speed", 240)
+
+	prefab, err := variant.Resolve(base)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	scene := core.NewScene()
+	fast, err := prefab.Instantiate(scene, gamemath.Vector2{X: 10}, nil)
+	if err != nil {
+		t.Fatalf("Instantiate failed: %v", err)
+	}
+	if fast.Sprite.Color != red || fast.Sprite.Texture.Path != "sprites/enemy.png" {
+		t.Errorf("Expected red tint on the base texture, got %v %q", fast.Sprite.Color, fast.Sprite.Texture.Path)
+	}
+	if fast.Transform.Scale != (gamemath.Vector2{X: 1.5, Y: 1.5}) || fast.Layer != 3 || !fast.HasTag("enemy") {
+		t.Errorf("Expected scale override with base layer and tags, got %v layer %d", fast.Transform.Scale, fast.Layer)
+	}
+	if behavior, ok := fast.Behavior.(*patrolBehavior); !ok || behavior.speed != 240 {
+		t.Errorf("Expected base OnInstantiate to read the variant speed, got %+v", fast.Behavior)
+	}
+	if prefab.Property("health", 0) != 3 || fast.Prefab() != prefab {
+		t.Error("Expected inherited properties and the instance to know its prefab")
+	}
+
+	slow, _ := base.Instantiate(scene, gamemath.Vector2{}, nil)
+	if slow.Sprite.Color != gamemath.White || slow.Transform.Scale.X != 1 || slow.Behavior.(*patrolBehavior).speed != 80 {
+		t.Error("Expected the base prefab unchanged by its variant")
+	}
+}
+
+// TestPrefabVariantSaveLoad tests a variant file round trip, chained
+// variants, and base checking.
+func TestPrefabVariantSaveLoad(t *testing.T) {
+	base := newEnemyPrefab()
+	var buf bytes.Buffer
+	if err := core.NewPrefabVariant("heavy_enemy", base).Override("body.mass", 10).Save(&buf); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	variant, err := core.LoadPrefabVariant(&buf)
+	if err != nil {
+		t.Fatalf("LoadPrefabVariant failed: %v", err)
+	}
+	heavy, err := variant.Resolve(base)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if heavy.Entity.Body == nil || heavy.Entity.Body.Mass != 10 || heavy.Entity.Body.Type != base.Entity.Body.Type {
+		t.Errorf("Expected body mass override with the base body type, got %+v", heavy.Entity.Body)
+	}
+
+	// Variants of variants
+	boss, err := core.NewPrefabVariant("heavy_boss", heavy).Override("layer", 9).Resolve(heavy)
+	if err != nil || boss.Entity.Layer != 9 || boss.Entity.Body.Mass != 10 {
+		t.Errorf("Expected chained overrides, got %+v (%v)", boss, err)
+	}
+
+	if _, err := variant.Resolve(boss); !errors.Is(err, core.ErrPrefabBase) {
+		t.Errorf("Expected ErrPrefabBase for the wrong base, got %v", err)
+	}
+	if _, err := core.LoadPrefabVariant(strings.NewReader(`{"version": 1, "name": "x"}`)); !errors.Is(err, core.ErrPrefabBase) {
+		t.Errorf("Expected ErrPrefabBase without a base, got %v", err)
+	}
+	bad := core.NewPrefabVariant("bad", base).Override("layer", "top")
+	if _, err := bad.Resolve(base); err == nil {
+		t.Error("Expected an error for an override of the wrong type")
+	}
+}
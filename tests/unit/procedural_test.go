@@ -0,0 +1,116 @@
+package unit
+
+import (
+	"image"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// rgbaAt returns a pixel of a generated image as a Color.
+func rgbaAt(img *image.RGBA, x, y int) gamemath.Color {
+	i := img.PixOffset(x, y)
+	return gamemath.Color{R: img.Pix[i], G: img.Pix[i+1], B: img.Pix[i+2], A: img.Pix[i+3]}
+}
+
+// TestProceduralImages tests solid, gradient, and checkerboard generators.
+func TestProceduralImages(t *testing.T) {
+	half := gamemath.Color{R: 10, G: 20, B: 30, A: 128}
+	solid := graphics.SolidImage(3, 2, half)
+	if solid.Rect.Dx() != 3 || solid.Rect.Dy() != 2 {
+		t.Fatalf("Expected 3x2 image, got %v", solid.Rect)
+	}
+	if got := rgbaAt(solid, 2, 1); got != half {
+		t.Errorf("Expected straight-alpha %v, got %v", half, got)
+	}
+
+	gradient := graphics.GradientImage(5, 1, gamemath.Black, gamemath.White, graphics.GradientHorizontal)
+	if rgbaAt(gradient, 0, 0) != gamemath.Black || rgbaAt(gradient, 4, 0) != gamemath.White {
+		t.Error("Expected gradient to start and end on its colors")
+	}
+	if got := rgbaAt(gradient, 2, 0).R; got != 128 {
+		t.Errorf("Expected mid-gradient 128, got %d", got)
+	}
+	vertical := graphics.GradientImage(1, 3, gamemath.Red, gamemath.Blue, graphics.GradientVertical)
+	if rgbaAt(vertical, 0, 0) != gamemath.Red || rgbaAt(vertical, 0, 2) != gamemath.Blue {
+		t.Error("Expected vertical gradient from top to bottom")
+	}
+	radial := graphics.GradientImage(9, 9, gamemath.White, gamemath.Black, graphics.GradientRadial)
+	if center, corner := rgbaAt(radial, 4, 4).R, rgbaAt(radial, 0, 0).R; center <= corner {
+		t.Errorf("Expected radial gradient brightest at the center, got %d vs %d", center, corner)
+	}
+
+	checker := graphics.CheckerImage(4, 4, 2, gamemath.White, gamemath.Black)
+	cases := []struct {
+		x, y int
+		want gamemath.Color
+	}{{0, 0, gamemath.White}, {1, 1, gamemath.White}, {2, 0, gamemath.Black}, {0, 2, gamemath.Black}, {3, 3, gamemath.White}}
+	for _, c := range cases {
+		if got := rgbaAt(checker, c.x, c.y); got != c.want {
+			t.Errorf("Expected %v at (%d, %d), got %v", c.want, c.x, c.y, got)
+		}
+	}
+}
+
+// TestNoiseImage tests that noise is deterministic per seed, smooth, and in range.
+func TestNoiseImage(t *testing.T) {
+	options := graphics.NoiseOptions{Scale: 8, Octaves: 3, Seed: 42, Low: gamemath.Black, High: gamemath.White}
+	a := graphics.NoiseImage(32, 32, options)
+	b := graphics.NoiseImage(32, 32, options)
+	if string(a.Pix) != string(b.Pix) {
+		t.Error("Expected the same seed to produce the same image")
+	}
+	options.Seed = 43
+	if c := graphics.NoiseImage(32, 32, options); string(a.Pix) == string(c.Pix) {
+		t.Error("Expected a different seed to produce a different image")
+	}
+
+	lowest, highest := 255, 0
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			value := int(rgbaAt(a, x, y).R)
+			lowest, highest = min(lowest, value), max(highest, value)
+			if x > 0 {
+				if step := value - int(rgbaAt(a, x-1, y).R); step > 64 || step < -64 {
+					t.Fatalf("Expected smooth noise, got a jump of %d at (%d, %d)", step, x, y)
+				}
+			}
+		}
+	}
+	if highest-lowest < 32 {
+		t.Errorf("Expected visible variation, got range %d-%d", lowest, highest)
+	}
+}
+
+// TestGenerateTextureCaching tests that generated textures are cached and ref counted by name.
+func TestGenerateTextureCaching(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	calls := 0
+	generate := func() image.Image {
+		calls++
+		return graphics.CheckerImage(8, 4, 2, gamemath.White, gamemath.Black)
+	}
+
+	first, err := assets.GenerateTexture("gen:checker", generate)
+	if err != nil {
+		t.Fatalf("GenerateTexture failed: %v", err)
+	}
+	if first.Width != 8 || first.Height != 4 {
+		t.Errorf("Expected 8x4 texture, got %dx%d", first.Width, first.Height)
+	}
+	second, err := assets.GenerateTexture("gen:checker", generate)
+	if err != nil || second != first || calls != 1 {
+		t.Errorf("Expected cached texture without regenerating, got %d calls", calls)
+	}
+
+	assets.UnloadTexture("gen:checker")
+	assets.UnloadTexture("gen:checker")
+	if _, err := assets.GenerateTexture("gen:checker", generate); err != nil || calls != 2 {
+		t.Errorf("Expected regeneration after the last unload, got %d calls", calls)
+	}
+
+	if _, err := assets.GenerateTexture("gen:empty", func() image.Image { return graphics.SolidImage(0, 0, gamemath.White) }); err == nil {
+		t.Error("Expected an error for an empty image")
+	}
+}
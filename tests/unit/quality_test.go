@@ -0,0 +1,112 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestQualityScalerHysteresis tests downgrading under load and upgrading only with headroom.
+func TestQualityScalerHysteresis(t *testing.T) {
+	quality := core.NewQualityScaler()
+	quality.DowngradeFrames = 10
+	quality.UpgradeFrames = 20
+	var changes []core.QualityLevel
+	quality.OnChange = func(level core.QualityLevel, _ core.QualitySettings) { changes = append(changes, level) }
+
+	// A single slow frame is smoothed away
+	quality.RecordFrame(100 * time.Millisecond)
+	for i := 0; i < 50; i++ {
+		quality.RecordFrame(5 * time.Millisecond)
+	}
+	if quality.Level() != core.QualityHigh {
+		t.Fatalf("Expected one spike to keep high quality, got %v", quality.Level())
+	}
+
+	for i := 0; i < 200; i++ {
+		quality.RecordFrame(25 * time.Millisecond)
+	}
+	if quality.Level() != core.QualityLow {
+		t.Fatalf("Expected sustained load to reach low quality, got %v", quality.Level())
+	}
+	if quality.Current().PostProcessing {
+		t.Error("Expected low quality to disable post-processing")
+	}
+
+	// Just under budget is not enough headroom to upgrade
+	for i := 0; i < 500; i++ {
+		quality.RecordFrame(15 * time.Millisecond)
+	}
+	if quality.Level() != core.QualityLow {
+		t.Errorf("Expected no upgrade without headroom, got %v", quality.Level())
+	}
+	for i := 0; i < 500; i++ {
+		quality.RecordFrame(4 * time.Millisecond)
+	}
+	if quality.Level() != core.QualityHigh {
+		t.Errorf("Expected upgrade back to high with headroom, got %v", quality.Level())
+	}
+	if len(changes) != 4 || changes[1] != core.QualityLow || changes[3] != core.QualityHigh {
+		t.Errorf("Expected high→medium→low→medium→high, got %v", changes)
+	}
+}
+
+// TestQualityScalerOverride tests that a user-chosen level isn't changed automatically.
+func TestQualityScalerOverride(t *testing.T) {
+	quality := core.NewQualityScaler()
+	quality.DowngradeFrames = 5
+	quality.SetOverride(core.QualityMedium)
+	for i := 0; i < 100; i++ {
+		quality.RecordFrame(50 * time.Millisecond)
+	}
+	if quality.Level() != core.QualityMedium || !quality.Overridden() {
+		t.Errorf("Expected override to hold medium, got %v", quality.Level())
+	}
+
+	quality.ClearOverride()
+	for i := 0; i < 10; i++ {
+		quality.RecordFrame(50 * time.Millisecond)
+	}
+	if quality.Level() != core.QualityLow {
+		t.Errorf("Expected automatic downgrade after clearing override, got %v", quality.Level())
+	}
+}
+
+// TestQualityFarEntityThrottle tests that far entities update less often but keep total time.
+func TestQualityFarEntityThrottle(t *testing.T) {
+	scene := core.NewScene()
+	quality := core.NewQualityScaler()
+	quality.SetOverride(core.QualityLow) // Beyond 700 units: every 4th step
+	scene.SetQualityScaler(quality)
+
+	near := &mockBehavior{}
+	far := &dtRecorder{}
+	scene.AddEntity(&core.Entity{Active: true, Behavior: near})
+	scene.AddEntity(&core.Entity{Active: true, Behavior: far, Transform: gamemath.Transform{
+		Position: gamemath.Vector2{X: 5000},
+	}})
+
+	for i := 0; i < 8; i++ {
+		scene.Update(0.25)
+	}
+	if near.updateCount != 8 {
+		t.Errorf("Expected near entity to update every step, got %d", near.updateCount)
+	}
+	// Updates on steps 2 and 6 (staggered by ID); steps 7-8 are still owed
+	if far.updates != 2 || far.total != 1.5 {
+		t.Errorf("Expected far entity to update twice with 1.5s total, got %d updates and %vs", far.updates, far.total)
+	}
+}
+
+// dtRecorder totals the dt it receives.
+type dtRecorder struct {
+	updates int
+	total   float64
+}
+
+func (r *dtRecorder) Update(_ *core.Entity, dt float64) {
+	r.updates++
+	r.total += dt
+}
@@ -0,0 +1,83 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// TestSceneRaycast tests closest-hit selection, hit point, and normal.
+func TestSceneRaycast(t *testing.T) {
+	scene := core.NewScene()
+	shooter := newSolidBox(0, 0)
+	near := newSolidBox(50, 0) // Left face at x=45
+	far := newSolidBox(100, 0)
+	scene.AddEntity(shooter)
+	scene.AddEntity(far)
+	scene.AddEntity(near)
+
+	hit, ok := scene.Raycast(gamemath.Vector2{}, gamemath.Vector2{X: 2}, 200, physics.AllLayers)
+	if !ok || hit.Entity != near {
+		t.Fatalf("Expected to hit the near box (ignoring the shooter), got %v", hit.Entity)
+	}
+	if math.Abs(hit.Distance-45) > 1e-9 || hit.Point != (gamemath.Vector2{X: 45}) {
+		t.Errorf("Expected hit at x=45, got %v at distance %v", hit.Point, hit.Distance)
+	}
+	if hit.Normal != (gamemath.Vector2{X: -1}) {
+		t.Errorf("Expected normal (-1, 0), got %v", hit.Normal)
+	}
+
+	if hits := scene.RaycastAll(gamemath.Vector2{}, gamemath.Vector2{X: 1}, 200, physics.AllLayers); len(hits) != 2 || hits[1].Entity != far {
+		t.Errorf("Expected near then far, got %d hits", len(hits))
+	}
+	if _, ok := scene.Raycast(gamemath.Vector2{}, gamemath.Vector2{X: 1}, 40, physics.AllLayers); ok {
+		t.Error("Expected no hit within 40 units")
+	}
+	if _, ok := scene.Raycast(gamemath.Vector2{}, gamemath.Vector2{Y: -1}, 200, physics.AllLayers); ok {
+		t.Error("Expected no hit upward")
+	}
+}
+
+// TestSceneRaycastFilters tests layer masks and trigger exclusion.
+func TestSceneRaycastFilters(t *testing.T) {
+	scene := core.NewScene()
+	sensor := newSolidBox(20, 0)
+	sensor.Collider.IsTrigger = true
+	ghost := newSolidBox(40, 0)
+	ghost.Collider.CollisionLayer = 3
+	wall := newSolidBox(60, 0)
+	scene.AddEntity(sensor)
+	scene.AddEntity(ghost)
+	scene.AddEntity(wall)
+
+	hit, ok := scene.Raycast(gamemath.Vector2{}, gamemath.Vector2{X: 1}, 100, 1)
+	if !ok || hit.Entity != wall {
+		t.Errorf("Expected the ray to pass the trigger and layer-3 box and hit the wall")
+	}
+	hit, _ = scene.Raycast(gamemath.Vector2{}, gamemath.Vector2{X: 1}, 100, physics.AllLayers)
+	if hit.Entity != ghost {
+		t.Errorf("Expected AllLayers to hit the layer-3 box")
+	}
+}
+
+// TestSceneOverlapQueries tests box and circle overlap queries.
+func TestSceneOverlapQueries(t *testing.T) {
+	scene := core.NewScene()
+	a := newSolidBox(0, 0)   // -5..5
+	b := newSolidBox(30, 30) // 25..35
+	scene.AddEntity(a)
+	scene.AddEntity(b)
+
+	if found := scene.OverlapBox(gamemath.Rectangle{X: 4, Y: 4, Width: 2, Height: 2}, physics.AllLayers); len(found) != 1 || found[0] != a {
+		t.Errorf("Expected box query to find a, got %d entities", len(found))
+	}
+	if found := scene.OverlapCircle(gamemath.Vector2{X: 20, Y: 20}, 7.1, physics.AllLayers); len(found) != 1 || found[0] != b {
+		t.Errorf("Expected circle to reach b's corner only, got %d entities", len(found))
+	}
+	if found := scene.OverlapCircle(gamemath.Vector2{X: 20, Y: 20}, 100, physics.AllLayers); len(found) != 2 {
+		t.Errorf("Expected large circle to find both, got %d", len(found))
+	}
+}
@@ -0,0 +1,230 @@
+package unit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/remote"
+)
+
+// TestConsoleExecute tests command parsing, quoting, and unknown commands.
+func TestConsoleExecute(t *testing.T) {
+	console := remote.NewConsole()
+	var got []string
+	console.Register("say", "Print a message", func(args []string) (string, error) {
+		got = args
+		return strings.Join(args, "|"), nil
+	})
+
+	output, err := console.Execute(`SAY "hello world" again`)
+	if err != nil || output != "hello world|again" || len(got) != 2 {
+		t.Errorf("Expected quoted argument grouping, got %q (%v)", output, err)
+	}
+	if _, err := console.Execute("nope"); !errors.Is(err, remote.ErrUnknownCommand) {
+		t.Errorf("Expected ErrUnknownCommand, got %v", err)
+	}
+	if help, _ := console.Execute("help"); !strings.Contains(help, "say - Print a message") {
+		t.Errorf("Expected help to list commands, got %q", help)
+	}
+}
+
+// TestProfilerRecord tests section timing summaries.
+func TestProfilerRecord(t *testing.T) {
+	profiler := core.NewProfiler()
+	profiler.Record("update", 2*time.Millisecond)
+	profiler.Record("render", 5*time.Millisecond)
+	profiler.Record("update", 4*time.Millisecond)
+
+	samples := profiler.Samples()
+	if len(samples) != 2 || samples[0].Name != "update" {
+		t.Fatalf("Expected sections in first-recorded order, got %v", samples)
+	}
+	update := samples[0]
+	if update.Calls != 2 || update.Last != 4*time.Millisecond || update.Max != 4*time.Millisecond {
+		t.Errorf("Expected last/max 4ms over 2 calls, got %+v", update)
+	}
+	if update.Avg <= 2*time.Millisecond || update.Avg >= 4*time.Millisecond {
+		t.Errorf("Expected smoothed average between samples, got %v", update.Avg)
+	}
+}
+
+// doRemoteRequest performs a request while the "game loop" polls the server,
+// returning the status, body, and Content-Type.
+func doRemoteRequest(server *remote.Server, req *http.Request) (int, string, string) {
+	type result struct {
+		status      int
+		body        string
+		contentType string
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			done <- result{0, err.Error(), ""}
+			return
+		}
+		defer func() { _ = resp.Body.Close() }()
+		data, _ := io.ReadAll(resp.Body)
+		done <- result{resp.StatusCode, string(data), resp.Header.Get("Content-Type")}
+	}()
+	for {
+		select {
+		case r := <-done:
+			return r.status, r.body, r.contentType
+		default:
+			server.Poll()
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+// TestRemoteServerStatsAndConsole tests HTTP requests answered on the polling thread.
+func TestRemoteServerStatsAndConsole(t *testing.T) {
+	frames := 0
+	server := remote.NewServer(func() any { return map[string]int{"frames": frames} })
+	server.Token = "secret"
+	server.Console.Register("add", "Add frames", func(args []string) (string, error) {
+		frames += len(args)
+		return fmt.Sprint(frames), nil
+	})
+	if err := server.Start("127.0.0.1:0"); err != nil {
+		t.Skipf("TCP unavailable: %v", err)
+	}
+	defer func() { _ = server.Stop() }()
+	base := "http://" + server.Addr().String()
+
+	// call performs a request while the "game loop" polls the server
+	call := func(method, path, body, token string) (int, string, string) {
+		req, _ := http.NewRequest(method, base+path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return doRemoteRequest(server, req)
+	}
+
+	if status, _, _ := call(http.MethodGet, "/stats", "", ""); status != http.StatusUnauthorized {
+		t.Errorf("Expected 401 without token, got %d", status)
+	}
+	if status, body, _ := call(http.MethodPost, "/console", "add a b c", "secret"); status != http.StatusOK || !strings.Contains(body, `"output":"3"`) {
+		t.Errorf("Expected command output 3, got %d %s", status, body)
+	}
+
+	status, body, _ := call(http.MethodGet, "/stats", "", "secret")
+	var stats map[string]int
+	if status != http.StatusOK || json.Unmarshal([]byte(body), &stats) != nil || stats["frames"] != 3 {
+		t.Errorf("Expected stats JSON with frames=3, got %d %s", status, body)
+	}
+	status, body, contentType := call(http.MethodPost, "/console", "bogus", "secret")
+	if status != http.StatusBadRequest || contentType != "application/json" || !strings.Contains(body, `"error"`) {
+		t.Errorf("Expected a 400 JSON error for unknown command, got %d %q %s", status, contentType, body)
+	}
+	if status, _, _ := call(http.MethodPost, "/console", " \n", "secret"); status != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an empty command, got %d", status)
+	}
+	if frames != 3 {
+		t.Errorf("Expected the empty command not to run, got frames=%d", frames)
+	}
+}
+
+// TestRemoteServerRejectsWebRequests tests that pages in a browser can't
+// drive a server without a token, directly or through DNS rebinding.
+func TestRemoteServerRejectsWebRequests(t *testing.T) {
+	ran := 0
+	server := remote.NewServer(nil)
+	server.Console.Register("spawn", "Spawn", func([]string) (string, error) {
+		ran++
+		return "ok", nil
+	})
+	if err := server.Start("127.0.0.1:0"); err != nil {
+		t.Skipf("TCP unavailable: %v", err)
+	}
+	defer func() { _ = server.Stop() }()
+	addr := server.Addr().String()
+	_, port, _ := net.SplitHostPort(addr)
+
+	post := func(host, origin string) int {
+		req, _ := http.NewRequest(http.MethodPost, "http://"+addr+"/console", strings.NewReader("spawn"))
+		req.Header.Set("Content-Type", "text/plain")
+		req.Host = host
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		status, _, _ := doRemoteRequest(server, req)
+		return status
+	}
+
+	if status := post(addr, "https://evil.example"); status != http.StatusForbidden {
+		t.Errorf("Expected 403 for a cross-origin page, got %d", status)
+	}
+	if status := post("evil.example:"+port, ""); status != http.StatusForbidden {
+		t.Errorf("Expected 403 for a rebound host name, got %d", status)
+	}
+	if ran != 0 {
+		t.Fatalf("Expected no command to run, ran %d", ran)
+	}
+	for _, host := range []string{addr, "localhost:" + port} {
+		if status := post(host, ""); status != http.StatusOK {
+			t.Errorf("Expected 200 for host %s, got %d", host, status)
+		}
+	}
+	if status := post(addr, "http://"+addr); status != http.StatusOK {
+		t.Errorf("Expected 200 for a same-origin page, got %d", status)
+	}
+	if ran != 3 {
+		t.Errorf("Expected 3 commands to run, ran %d", ran)
+	}
+}
+
+// TestSnapshotDiff tests scene snapshots and their diff across frames.
+func TestSnapshotDiff(t *testing.T) {
+	scene := core.NewScene()
+	mover := &core.Entity{Active: true}
+	doomed := &core.Entity{Active: true}
+	scene.AddEntity(mover)
+	scene.AddEntity(doomed)
+
+	console := remote.NewConsole()
+	snapshots := remote.NewSnapshots(console, func() *core.Scene { return scene })
+	if _, err := console.Execute("snapshot-frames 2"); err != nil {
+		t.Fatalf("Expected snapshot-frames to succeed, got %v", err)
+	}
+
+	snapshots.Update(nil, 0.016)
+	mover.Transform.Position.X = 12.5
+	scene.RemoveEntity(doomed.ID)
+	scene.Update(0.016)
+	spawned := &core.Entity{Active: false}
+	scene.AddEntity(spawned)
+	snapshots.Update(nil, 0.016)
+
+	output, err := console.Execute("snapshot-diff f1 f2")
+	if err != nil {
+		t.Fatalf("Expected diff to succeed, got %v", err)
+	}
+	for _, want := range []string{
+		"entities 2 -> 2, active 2 -> 1",
+		fmt.Sprintf("+ #%d", spawned.ID),
+		fmt.Sprintf("- #%d", doomed.ID),
+		fmt.Sprintf("~ #%d x: 0 -> 12.5", mover.ID),
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected diff to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	first, _ := snapshots.Get("f1")
+	if diff := remote.DiffSnapshots(first, first); !diff.Empty() {
+		t.Errorf("Expected identical snapshots to have an empty diff, got %+v", diff)
+	}
+	if _, err := console.Execute("snapshot-diff f1 missing"); err == nil {
+		t.Error("Expected an error for an unknown snapshot")
+	}
+}
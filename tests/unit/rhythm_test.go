@@ -0,0 +1,97 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/rhythm"
+)
+
+// TestConductorBeatsFollowClock tests beat events from a chunky audio clock.
+func TestConductorBeatsFollowClock(t *testing.T) {
+	audio := 0.0
+	conductor := rhythm.NewConductor(rhythm.ClockFunc(func() float64 { return audio }), 120) // 0.5s beats
+	beats, measures := []int{}, []int{}
+	conductor.OnBeat = func(beat int) { beats = append(beats, beat) }
+	conductor.OnMeasure = func(measure int) { measures = append(measures, measure) }
+
+	frame := 1.0 / 60
+	elapsed := 0.0
+	for i := 0; i < 140; i++ { // ~2.33 seconds
+		elapsed += frame
+		if i%3 == 0 {
+			audio = elapsed // Audio clock only updates every third frame
+		}
+		conductor.Update(nil, frame)
+	}
+
+	if len(beats) != 5 || beats[0] != 0 || beats[4] != 4 {
+		t.Errorf("Expected beats 0-4 exactly once, got %v", beats)
+	}
+	if len(measures) != 2 || measures[1] != 1 {
+		t.Errorf("Expected measures 0 and 1, got %v", measures)
+	}
+	if math.Abs(conductor.Position()-elapsed) > 0.05 {
+		t.Errorf("Expected position near %f, got %f", elapsed, conductor.Position())
+	}
+}
+
+// TestConductorHoldsWhenClockStalls tests that a paused clock doesn't run away.
+func TestConductorHoldsWhenClockStalls(t *testing.T) {
+	conductor := rhythm.NewConductor(rhythm.ClockFunc(func() float64 { return 1 }), 120)
+	for i := 0; i < 120; i++ {
+		conductor.Update(nil, 1.0/60)
+	}
+	if conductor.Position() > 1+conductor.MaxDrift {
+		t.Errorf("Expected position held near the stalled clock, got %f", conductor.Position())
+	}
+}
+
+// TestTrackJudgesHitsAndMisses tests judgment windows, latency correction, and misses.
+func TestTrackJudgesHitsAndMisses(t *testing.T) {
+	conductor := rhythm.NewConductor(nil, 60) // 1 second beats
+	conductor.InputLatency = 0.02
+	track := rhythm.NewTrack(conductor, []rhythm.Note{
+		{Beat: 2, Lane: 0},
+		{Beat: 1, Lane: 0},
+		{Beat: 3, Lane: 1},
+	})
+
+	judgments := []rhythm.Judgment{}
+	track.OnJudge = func(j rhythm.Judgment) { judgments = append(judgments, j) }
+
+	if _, ok := track.HitAt(0, 0.5); ok {
+		t.Error("Expected stray press to be ignored")
+	}
+	if j, ok := track.HitAt(0, 1.03); !ok || j.Grade != rhythm.Perfect || j.Note.Beat != 1 {
+		t.Errorf("Expected perfect on beat 1, got %v", j)
+	}
+	if j, _ := track.HitAt(0, 1.9); j.Grade != rhythm.Good || j.Offset > 0 {
+		t.Errorf("Expected early good on beat 2, got %v", j)
+	}
+
+	// Lane 1 is never pressed
+	conductor.Clock = rhythm.ClockFunc(func() float64 { return 3.5 })
+	conductor.Update(nil, 0)
+	track.Update()
+	if !track.Done() || len(judgments) != 3 || judgments[2].Grade != rhythm.Miss {
+		t.Errorf("Expected beat 3 judged a miss, got %v", judgments)
+	}
+
+	if got := rhythm.DefaultWindows.Judge(-0.08); got != rhythm.Great {
+		t.Errorf("Expected great at 80ms early, got %s", got)
+	}
+}
+
+// TestCalibratorMedianLatency tests latency estimation from taps.
+func TestCalibratorMedianLatency(t *testing.T) {
+	conductor := rhythm.NewConductor(nil, 120)
+	calibrator := rhythm.NewCalibrator(conductor)
+	for _, tap := range []float64{0.54, 1.04, 1.55, 2.03, 2.9} { // Last tap is an outlier
+		calibrator.TapAt(tap)
+	}
+	calibrator.Apply()
+	if math.Abs(conductor.InputLatency-0.04) > 1e-9 {
+		t.Errorf("Expected median latency 0.04, got %f", conductor.InputLatency)
+	}
+}
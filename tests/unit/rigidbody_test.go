@@ -0,0 +1,67 @@
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// TestRigidBodyGravity tests that a dynamic body falls under scene gravity
+// while kinematic and static bodies ignore it.
+func TestRigidBodyGravity(t *testing.T) {
+	scene := core.NewScene()
+	scene.SetGravity(gamemath.Vector2{X: 0, Y: 100})
+
+	falling := &core.Entity{Active: true, Body: physics.NewRigidBody(1)}
+	platform := &core.Entity{Active: true, Body: &physics.RigidBody{Type: physics.BodyKinematic, Velocity: gamemath.Vector2{X: 10}}}
+	wall := &core.Entity{Active: true, Body: &physics.RigidBody{Type: physics.BodyStatic, Velocity: gamemath.Vector2{X: 50}}}
+	scene.AddEntity(falling)
+	scene.AddEntity(platform)
+	scene.AddEntity(wall)
+
+	for i := 0; i < 10; i++ {
+		scene.Update(0.1)
+	}
+
+	// Semi-implicit Euler: v_n = 10n, y = sum(v_n * 0.1) = 55
+	if got := falling.Transform.Position.Y; math.Abs(got-55) > 1e-9 {
+		t.Errorf("Expected dynamic body at y=55, got %v", got)
+	}
+	if got := platform.Transform.Position; math.Abs(got.X-10) > 1e-9 || got.Y != 0 {
+		t.Errorf("Expected kinematic body at (10, 0), got %v", got)
+	}
+	if got := wall.Transform.Position; got.X != 0 || wall.Body.Velocity.X != 0 {
+		t.Errorf("Expected static body to stay put, got %v", got)
+	}
+}
+
+// TestRigidBodyForces tests forces, impulses, mass, and drag.
+func TestRigidBodyForces(t *testing.T) {
+	body := physics.NewRigidBody(2)
+	var transform gamemath.Transform
+
+	body.ApplyForce(gamemath.Vector2{X: 20})
+	body.Integrate(&transform, gamemath.Vector2{}, 1)
+	if body.Velocity.X != 10 {
+		t.Errorf("Expected force/mass acceleration to give velocity 10, got %v", body.Velocity.X)
+	}
+	body.Integrate(&transform, gamemath.Vector2{}, 1)
+	if body.Velocity.X != 10 {
+		t.Errorf("Expected forces to clear after a step, got velocity %v", body.Velocity.X)
+	}
+
+	body.ApplyImpulse(gamemath.Vector2{Y: -8})
+	if body.Velocity.Y != -4 {
+		t.Errorf("Expected impulse/mass velocity change of -4, got %v", body.Velocity.Y)
+	}
+
+	body.Velocity = gamemath.Vector2{X: 100}
+	body.Drag = 1
+	body.Integrate(&transform, gamemath.Vector2{}, 0.5)
+	if want := 100 * math.Exp(-0.5); math.Abs(body.Velocity.X-want) > 1e-9 {
+		t.Errorf("Expected drag to reduce velocity to %v, got %v", want, body.Velocity.X)
+	}
+}
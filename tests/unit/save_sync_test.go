@@ -0,0 +1,260 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/save"
+)
+
+// memorySaveBackend is an in-memory save.Backend with explicit timestamps.
+type memorySaveBackend struct {
+	data     map[string][]byte
+	meta     map[string]save.Meta
+	revision int
+	now      time.Time
+}
+
+func newMemorySaveBackend(now time.Time) *memorySaveBackend {
+	return &memorySaveBackend{data: make(map[string][]byte), meta: make(map[string]save.Meta), now: now}
+}
+
+func (m *memorySaveBackend) Read(_ context.Context, slot string) ([]byte, save.Meta, error) {
+	data, ok := m.data[slot]
+	if !ok {
+		return nil, save.Meta{}, save.ErrNotFound
+	}
+	return data, m.meta[slot], nil
+}
+
+func (m *memorySaveBackend) Write(_ context.Context, slot string, data []byte) (save.Meta, error) {
+	m.revision++
+	m.data[slot] = append([]byte(nil), data...)
+	m.meta[slot] = save.Meta{Slot: slot, Modified: m.now, Size: int64(len(data)), Revision: strconv.Itoa(m.revision)}
+	return m.meta[slot], nil
+}
+
+func (m *memorySaveBackend) Delete(_ context.Context, slot string) error {
+	delete(m.data, slot)
+	delete(m.meta, slot)
+	return nil
+}
+
+func (m *memorySaveBackend) List(_ context.Context) ([]save.Meta, error) {
+	metas := make([]save.Meta, 0, len(m.meta))
+	for _, meta := range m.meta {
+		metas = append(metas, meta)
+	}
+	return metas, nil
+}
+
+// TestLocalBackend tests writing, listing, reading, and deleting local slots.
+func TestLocalBackend(t *testing.T) {
+	ctx := context.Background()
+	backend := save.NewLocalBackend(t.TempDir())
+
+	if _, _, err := backend.Read(ctx, "slot1"); !errors.Is(err, save.ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err := backend.Write(ctx, "slot1", []byte("one")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if _, err := backend.Write(ctx, "autosave", []byte("auto")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	metas, err := backend.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(metas) != 2 || metas[0].Slot != "autosave" || metas[1].Slot != "slot1" {
+		t.Errorf("Expected [autosave slot1], got %+v", metas)
+	}
+
+	data, meta, err := backend.Read(ctx, "slot1")
+	if err != nil || string(data) != "one" {
+		t.Fatalf("Expected 'one', got %q (%v)", data, err)
+	}
+	if meta.Size != 3 || meta.Revision == "" {
+		t.Errorf("Expected size 3 and a revision, got %+v", meta)
+	}
+
+	if err := backend.Delete(ctx, "slot1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := backend.Delete(ctx, "slot1"); err != nil {
+		t.Errorf("Expected deleting a missing slot to succeed, got %v", err)
+	}
+}
+
+// TestSchemaBackendRoundTrip tests saving and loading through a backend.
+func TestSchemaBackendRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	schema := newProgressSchema()
+	backend := save.NewLocalBackend(t.TempDir())
+
+	if err := schema.SaveTo(ctx, backend, "slot1", progressV3{Gold: 9, Level: "2-1"}); err != nil {
+		t.Fatalf("SaveTo failed: %v", err)
+	}
+	var loaded progressV3
+	if _, err := schema.LoadFrom(ctx, backend, "slot1", &loaded); err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if loaded.Gold != 9 || loaded.Level != "2-1" {
+		t.Errorf("Expected gold 9 at 2-1, got %+v", loaded)
+	}
+}
+
+// TestSyncCopiesOneSidedChanges tests uploads and downloads without conflicts.
+func TestSyncCopiesOneSidedChanges(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	local, remote := newMemorySaveBackend(base), newMemorySaveBackend(base)
+	syncer := save.NewSync(local, remote)
+
+	_, _ = local.Write(ctx, "slot1", []byte("local"))
+	_, _ = remote.Write(ctx, "slot2", []byte("remote"))
+
+	results, err := syncer.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("SyncAll failed: %v", err)
+	}
+	actions := make(map[string]save.Action)
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("Sync of %s failed: %v", result.Slot, result.Err)
+		}
+		actions[result.Slot] = result.Action
+	}
+	if actions["slot1"] != save.ActionUpload || actions["slot2"] != save.ActionDownload {
+		t.Errorf("Expected slot1 upload and slot2 download, got %v", actions)
+	}
+
+	// Only the local side changes: upload without consulting the resolver
+	syncer.Resolve = func(save.Conflict) (save.Resolution, error) {
+		t.Error("Expected no conflict for a one-sided change")
+		return save.KeepBoth, nil
+	}
+	_, _ = local.Write(ctx, "slot1", []byte("local v2"))
+	action, err := syncer.SyncSlot(ctx, "slot1")
+	if err != nil || action != save.ActionUpload {
+		t.Fatalf("Expected upload, got %v (%v)", action, err)
+	}
+	if data, _, _ := remote.Read(ctx, "slot1"); string(data) != "local v2" {
+		t.Errorf("Expected remote to hold 'local v2', got %q", data)
+	}
+
+	action, _ = syncer.SyncSlot(ctx, "slot1")
+	if action != save.ActionNone {
+		t.Errorf("Expected no action once in sync, got %v", action)
+	}
+}
+
+// TestSyncConflictResolution tests that both-sided changes reach the resolver.
+func TestSyncConflictResolution(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	local, remote := newMemorySaveBackend(base), newMemorySaveBackend(base)
+	syncer := save.NewSync(local, remote)
+
+	_, _ = local.Write(ctx, "slot1", []byte("v1"))
+	if _, err := syncer.SyncSlot(ctx, "slot1"); err != nil {
+		t.Fatalf("Initial sync failed: %v", err)
+	}
+
+	// Both sides edited; remote is newer
+	_, _ = local.Write(ctx, "slot1", []byte("offline edit"))
+	remote.now = base.Add(time.Hour)
+	_, _ = remote.Write(ctx, "slot1", []byte("other device"))
+
+	var seen *save.Conflict
+	syncer.OnConflict = func(conflict save.Conflict, resolution save.Resolution) {
+		seen = &conflict
+		if resolution != save.KeepRemote {
+			t.Errorf("Expected NewestWins to keep remote, got %v", resolution)
+		}
+	}
+	action, err := syncer.SyncSlot(ctx, "slot1")
+	if err != nil || action != save.ActionDownload {
+		t.Fatalf("Expected download, got %v (%v)", action, err)
+	}
+	if seen == nil || string(seen.LocalData) != "offline edit" {
+		t.Fatalf("Expected conflict hook with local data, got %+v", seen)
+	}
+	if data, _, _ := local.Read(ctx, "slot1"); string(data) != "other device" {
+		t.Errorf("Expected local to hold 'other device', got %q", data)
+	}
+
+	// A custom resolver can defer the decision
+	_, _ = local.Write(ctx, "slot1", []byte("again"))
+	_, _ = remote.Write(ctx, "slot1", []byte("and again"))
+	syncer.OnConflict = nil
+	syncer.Resolve = func(save.Conflict) (save.Resolution, error) { return save.KeepBoth, nil }
+	if action, _ := syncer.SyncSlot(ctx, "slot1"); action != save.ActionSkipped {
+		t.Errorf("Expected skipped, got %v", action)
+	}
+}
+
+// TestSaveHTTPBackend tests the HTTP save protocol against a test server.
+func TestSaveHTTPBackend(t *testing.T) {
+	var mu sync.Mutex
+	stored := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		slot := strings.TrimPrefix(r.URL.Path, "/saves/")
+		switch {
+		case r.URL.Path == "/saves":
+			_, _ = io.WriteString(w, `[{"slot":"slot1","size":5,"revision":"r1"}]`)
+		case r.Method == http.MethodPut:
+			stored[slot], _ = io.ReadAll(r.Body)
+			w.Header().Set("ETag", `"r1"`)
+		case r.Method == http.MethodGet:
+			data, ok := stored[slot]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("ETag", `"r1"`)
+			_, _ = w.Write(data)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	backend := save.NewHTTPBackend(server.URL + "/")
+	backend.Headers["Authorization"] = "Bearer secret"
+
+	if _, _, err := backend.Read(ctx, "slot1"); !errors.Is(err, save.ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got %v", err)
+	}
+	meta, err := backend.Write(ctx, "slot1", []byte("hello"))
+	if err != nil || meta.Revision != `"r1"` {
+		t.Fatalf("Expected revision from ETag, got %+v (%v)", meta, err)
+	}
+	data, _, err := backend.Read(ctx, "slot1")
+	if err != nil || string(data) != "hello" {
+		t.Errorf("Expected 'hello', got %q (%v)", data, err)
+	}
+	metas, err := backend.List(ctx)
+	if err != nil || len(metas) != 1 || metas[0].Slot != "slot1" {
+		t.Errorf("Expected one listed slot, got %+v (%v)", metas, err)
+	}
+
+	backend.Headers["Authorization"] = "Bearer wrong"
+	if _, _, err := backend.Read(ctx, "slot1"); err == nil {
+		t.Error("Expected an error for an unauthorized request")
+	}
+}
@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/save"
+)
+
+// progressV3 is the current save structure used by the migration tests.
+type progressV3 struct {
+	Gold      int      `json:"gold"`
+	Level     string   `json:"level"`
+	Inventory []string `json:"inventory"`
+}
+
+// newProgressSchema creates a v3 schema: v2 renamed coins to gold, v3 added inventory.
+func newProgressSchema() *save.Schema {
+	schema := save.NewSchema(3)
+	schema.Register(0, func(doc save.Document) (save.Document, error) {
+		doc["level"] = "1-1" // v1 added the current level
+		return doc, nil
+	})
+	schema.Register(1, func(doc save.Document) (save.Document, error) {
+		doc["gold"] = doc["coins"]
+		delete(doc, "coins")
+		return doc, nil
+	})
+	schema.Register(2, func(doc save.Document) (save.Document, error) {
+		doc["inventory"] = []any{"sword"}
+		return doc, nil
+	})
+	return schema
+}
+
+// TestSaveRoundTrip tests writing and reading a current-version save file.
+func TestSaveRoundTrip(t *testing.T) {
+	schema := newProgressSchema()
+	path := filepath.Join(t.TempDir(), "slots", "slot1.json")
+	want := progressV3{Gold: 120, Level: "2-3", Inventory: []string{"bow"}}
+	if err := schema.SaveFile(path, want); err != nil {
+		t.Fatalf("Expected save to succeed, got %v", err)
+	}
+
+	var got progressV3
+	version, err := schema.LoadFile(path, &got)
+	if err != nil || version != 3 {
+		t.Fatalf("Expected v3 load, got v%d (%v)", version, err)
+	}
+	if got.Gold != 120 || got.Level != "2-3" || len(got.Inventory) != 1 {
+		t.Errorf("Expected round trip, got %+v", got)
+	}
+}
+
+// TestSaveMigratesOldVersions tests stepping an old envelope and a legacy save up to current.
+func TestSaveMigratesOldVersions(t *testing.T) {
+	schema := newProgressSchema()
+
+	var fromV1 progressV3
+	v1 := `{"version": 1, "saved_at": "2024-01-01T00:00:00Z", "data": {"coins": 55, "level": "3-1"}}`
+	if version, err := schema.Decode(strings.NewReader(v1), &fromV1); err != nil || version != 1 {
+		t.Fatalf("Expected v1 save to migrate, got v%d (%v)", version, err)
+	}
+	if fromV1.Gold != 55 || fromV1.Level != "3-1" || len(fromV1.Inventory) != 1 {
+		t.Errorf("Expected coins renamed and inventory added, got %+v", fromV1)
+	}
+
+	var legacy progressV3
+	if version, err := schema.Decode(strings.NewReader(`{"coins": 7}`), &legacy); err != nil || version != 0 {
+		t.Fatalf("Expected unversioned save treated as v0, got v%d (%v)", version, err)
+	}
+	if legacy.Gold != 7 || legacy.Level != "1-1" {
+		t.Errorf("Expected all migrations applied, got %+v", legacy)
+	}
+}
+
+// TestSaveVersionErrors tests newer saves and gaps in the migration chain.
+func TestSaveVersionErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := save.NewSchema(5).Encode(&buf, progressV3{}); err != nil {
+		t.Fatal(err)
+	}
+	var out progressV3
+	if _, err := newProgressSchema().Decode(&buf, &out); !errors.Is(err, save.ErrNewerVersion) {
+		t.Errorf("Expected ErrNewerVersion, got %v", err)
+	}
+
+	gapped := save.NewSchema(2)
+	gapped.Register(1, func(doc save.Document) (save.Document, error) { return doc, nil })
+	if _, err := gapped.Decode(strings.NewReader(`{"coins": 1}`), &out); !errors.Is(err, save.ErrMissingMigration) {
+		t.Errorf("Expected ErrMissingMigration, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package unit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// newRecordingScenes registers named scenes whose lifecycle hooks append to log.
+func newRecordingScenes(log *[]string, names ...string) *core.SceneManager {
+	manager := core.NewSceneManager(nil)
+	for _, name := range names {
+		name := name
+		manager.Register(name, func() *core.Scene {
+			scene := core.NewScene()
+			scene.OnEnter(func(*core.Scene) { *log = append(*log, name+":enter") })
+			scene.OnExit(func(*core.Scene) { *log = append(*log, name+":exit") })
+			scene.OnPause(func(*core.Scene) { *log = append(*log, name+":pause") })
+			scene.OnResume(func(*core.Scene) { *log = append(*log, name+":resume") })
+			return scene
+		})
+	}
+	return manager
+}
+
+// TestSceneManagerStack tests push/pop/replace semantics and hook order.
+func TestSceneManagerStack(t *testing.T) {
+	var log []string
+	manager := newRecordingScenes(&log, "title", "game", "pause")
+
+	if _, err := manager.Push("title", core.Transition{}); err != nil {
+		t.Fatalf("Expected push to succeed, got %v", err)
+	}
+	if _, err := manager.Replace("game", core.Transition{}); err != nil {
+		t.Fatalf("Expected replace to succeed, got %v", err)
+	}
+	pause, _ := manager.Push("pause", core.Transition{})
+	if manager.Current() != pause || manager.CurrentName() != "pause" || manager.Depth() != 2 {
+		t.Errorf("Expected pause on top of 2 scenes, got %q depth %d", manager.CurrentName(), manager.Depth())
+	}
+	if err := manager.Pop(core.Transition{}); err != nil {
+		t.Fatalf("Expected pop to succeed, got %v", err)
+	}
+	if manager.CurrentName() != "game" {
+		t.Errorf("Expected game after pop, got %q", manager.CurrentName())
+	}
+
+	want := "title:enter title:exit game:enter game:pause pause:enter pause:exit game:resume"
+	if got := strings.Join(log, " "); got != want {
+		t.Errorf("Expected hooks %q, got %q", want, got)
+	}
+}
+
+// TestSceneManagerErrors tests unknown names and popping an empty stack.
+func TestSceneManagerErrors(t *testing.T) {
+	manager := core.NewSceneManager(nil)
+	if _, err := manager.Push("missing", core.Transition{}); !errors.Is(err, core.ErrUnknownScene) {
+		t.Errorf("Expected ErrUnknownScene, got %v", err)
+	}
+	if err := manager.Pop(core.Transition{}); !errors.Is(err, core.ErrSceneStackEmpty) {
+		t.Errorf("Expected ErrSceneStackEmpty, got %v", err)
+	}
+}
+
+// TestSceneManagerTransition tests transition progress and that a new
+// change finishes a transition already playing.
+func TestSceneManagerTransition(t *testing.T) {
+	var changes []*core.Scene
+	manager := core.NewSceneManager(func(scene *core.Scene) { changes = append(changes, scene) })
+	manager.Register("a", core.NewScene)
+	manager.Register("b", core.NewScene)
+
+	_, _ = manager.Push("a", core.Transition{})
+	if manager.Transitioning() {
+		t.Error("Expected zero-value transition to be immediate")
+	}
+
+	fade := core.Transition{Effect: core.TransitionFade, Duration: 1}
+	b, _ := manager.Replace("b", fade)
+	if !manager.Transitioning() || manager.Progress() != 0 {
+		t.Fatalf("Expected fade to start, got progress %v", manager.Progress())
+	}
+	manager.Update(0.25)
+	if manager.Progress() != 0.25 {
+		t.Errorf("Expected progress 0.25, got %v", manager.Progress())
+	}
+	manager.Update(1)
+	if manager.Transitioning() || manager.Progress() != 1 {
+		t.Error("Expected transition to finish")
+	}
+
+	_, _ = manager.Push("a", fade)
+	_ = manager.Pop(core.Transition{Effect: core.TransitionSlideLeft, Duration: 0.5})
+	if manager.Progress() != 0 {
+		t.Errorf("Expected the pop to start a fresh transition, got progress %v", manager.Progress())
+	}
+	if len(changes) != 4 || changes[3] != b {
+		t.Errorf("Expected onChange for each change ending on b, got %d changes", len(changes))
+	}
+}
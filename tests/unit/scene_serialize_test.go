@@ -0,0 +1,121 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// buildSerializableScene creates a scene with layers, sprites, colliders, bodies, and ID gaps.
+func buildSerializableScene() *core.Scene {
+	scene := core.NewScene()
+	scene.SetBackgroundColor(gamemath.Color{R: 10, G: 20, B: 30, A: 255})
+	scene.AddLayer("hud", 100).ScreenSpace = true
+	scene.AddLayer("far", -10).Parallax = gamemath.Vector2{X: 0.3, Y: 0.25}
+
+	for i := 0; i < 6; i++ {
+		entity := &core.Entity{
+			Active: i%2 == 0,
+			Layer:  i % 3,
+			Transform: gamemath.Transform{
+				Position: gamemath.Vector2{X: float64(i) * 10.1, Y: 1.0 / 3.0},
+				Rotation: float64(i) * 15,
+				Scale:    gamemath.Vector2{X: 1, Y: 1.5},
+			},
+		}
+		if i%2 == 1 {
+			sprite := &graphics.Sprite{Texture: graphics.NewTexture(nil, 64, 64, "sprites/crate.png"), Color: gamemath.White, Alpha: 0.75, FlipH: true}
+			sprite.SetSourceRect(32, 0, 32, 32)
+			sprite.SetPivot(0, 0.5)
+			entity.Sprite = sprite
+		}
+		if i%3 == 0 {
+			entity.Collider = physics.NewCollider(16, 24)
+			entity.Collider.Material = physics.Ice
+			if i == 3 {
+				entity.Collider.Shape = physics.Circle{Radius: 9}
+				entity.Tags = []string{"crate", "breakable"}
+			}
+		}
+		if i == 4 {
+			entity.Body = physics.NewRigidBody(2.5)
+			entity.Body.Type = physics.BodyKinematic
+			entity.Body.Velocity = gamemath.Vector2{X: 12.5, Y: -3}
+		}
+		scene.AddEntity(entity)
+	}
+	_ = scene.GetEntity(6).SetParent(scene.GetEntity(1))
+	scene.RemoveEntity(2) // Leave a gap in the ID sequence
+	scene.Update(0)
+	return scene
+}
+
+// TestSceneSaveLoadSaveIdentical tests that serialization is canonical and ID-stable.
+func TestSceneSaveLoadSaveIdentical(t *testing.T) {
+	var first bytes.Buffer
+	if err := buildSerializableScene().Save(&first); err != nil {
+		t.Fatalf("Expected save to succeed, got %v", err)
+	}
+
+	loaded, err := core.LoadScene(bytes.NewReader(first.Bytes()), nil)
+	if err != nil {
+		t.Fatalf("Expected load to succeed, got %v", err)
+	}
+	var second bytes.Buffer
+	if err := loaded.Save(&second); err != nil {
+		t.Fatalf("Expected second save to succeed, got %v", err)
+	}
+	if first.String() != second.String() {
+		t.Fatalf("Expected identical output\nfirst:\n%s\nsecond:\n%s", first.String(), second.String())
+	}
+
+	if loaded.GetEntity(2) != nil {
+		t.Error("Expected removed entity ID to stay unused")
+	}
+	crate := loaded.GetEntity(4)
+	if crate == nil || crate.Sprite == nil || crate.Sprite.Texture.Path != "sprites/crate.png" {
+		t.Fatal("Expected entity 4 to keep its ID and sprite texture path")
+	}
+	if crate.Sprite.Pivot.X != -16 {
+		t.Errorf("Expected sprite pivot to round-trip, got %+v", crate.Sprite.Pivot)
+	}
+	if crate.Collider == nil || crate.Collider.Shape != (physics.Circle{Radius: 9}) {
+		t.Errorf("Expected circle collider shape to round-trip, got %+v", crate.Collider)
+	}
+	if !crate.HasTag("breakable") || len(loaded.FindByTag("crate")) != 1 {
+		t.Errorf("Expected tags to round-trip, got %v", crate.Tags)
+	}
+	if child := loaded.GetEntity(6); child.Parent() != loaded.GetEntity(1) {
+		t.Errorf("Expected entity 6 to keep parent 1, got %v", child.Parent())
+	}
+	if next := loaded.AddEntity(&core.Entity{}); next != 7 {
+		t.Errorf("Expected new entity to continue the ID sequence at 7, got %d", next)
+	}
+}
+
+// TestSceneLoadRejectsInvalidFiles tests version and duplicate ID errors.
+func TestSceneLoadRejectsInvalidFiles(t *testing.T) {
+	_, err := core.LoadScene(strings.NewReader(`{"version": 99, "entities": []}`), nil)
+	if !errors.Is(err, core.ErrSceneVersion) {
+		t.Errorf("Expected ErrSceneVersion, got %v", err)
+	}
+
+	_, err = core.LoadScene(strings.NewReader(`{"version": 1, "entities": [{"id": 3}, {"id": 3}]}`), nil)
+	if !errors.Is(err, core.ErrDuplicateEntityID) {
+		t.Errorf("Expected ErrDuplicateEntityID, got %v", err)
+	}
+
+	scene, err := core.LoadScene(strings.NewReader(`{"version": 1, "entities": [{"id": 9}, {"id": 4}]}`), nil)
+	if err != nil {
+		t.Fatalf("Expected unordered hand-edited file to load, got %v", err)
+	}
+	if entities := scene.GetAllEntities(); entities[0].ID != 4 || entities[1].ID != 9 {
+		t.Errorf("Expected entities in ID order, got %d, %d", entities[0].ID, entities[1].ID)
+	}
+}
@@ -0,0 +1,203 @@
+package unit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+)
+
+// TestSceneGetEntity_Index tests ID lookup across add and deferred removal.
+func TestSceneGetEntity_Index(t *testing.T) {
+	scene := core.NewScene()
+
+	ids := make([]uint64, 0, 100)
+	for i := 0; i < 100; i++ {
+		ids = append(ids, scene.AddEntity(&core.Entity{Active: true}))
+	}
+
+	if got := scene.GetEntity(ids[42]); got == nil || got.ID != ids[42] {
+		t.Fatalf("Expected entity %d, got %v", ids[42], got)
+	}
+
+	scene.RemoveEntity(ids[42])
+	if scene.GetEntity(ids[42]) == nil {
+		t.Error("Expected entity to remain until deferred removal runs")
+	}
+
+	scene.Update(0.016)
+	if scene.GetEntity(ids[42]) != nil {
+		t.Error("Expected removed entity lookup to return nil")
+	}
+	if scene.GetEntity(ids[43]) == nil {
+		t.Error("Expected other entities to remain indexed")
+	}
+	if scene.GetEntity(9999) != nil {
+		t.Error("Expected unknown ID to return nil")
+	}
+}
+
+// TestSceneCollisionExit tests exit callbacks after entities separate.
+func TestSceneCollisionExit(t *testing.T) {
+	scene := core.NewScene()
+
+	a := &core.Entity{Active: true, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}, Collider: physics.NewCollider(10, 10)}
+	b := &core.Entity{Active: true, Transform: gamemath.Transform{Position: gamemath.Vector2{X: 5}, Scale: gamemath.Vector2{X: 1, Y: 1}}, Collider: physics.NewCollider(10, 10)}
+	for i := 0; i < 50; i++ {
+		scene.AddEntity(&core.Entity{Active: true})
+	}
+	scene.AddEntity(a)
+	scene.AddEntity(b)
+
+	exits := 0
+	var exitedWith *core.Entity
+	a.OnCollisionExit = func(_, other *core.Entity, _ core.CollisionInfo) {
+		exits++
+		exitedWith = other
+	}
+
+	scene.Update(0.016)
+	b.Transform.Position.X = 100
+	scene.Update(0.016)
+
+	if exits != 1 || exitedWith != b {
+		t.Errorf("Expected one exit with b, got %d exits with %v", exits, exitedWith)
+	}
+}
+
+// TestSceneCollisionCallbackOrder tests that enter and exit callbacks fire in (lower ID, higher ID) order.
+func TestSceneCollisionCallbackOrder(t *testing.T) {
+	scene := core.NewScene()
+	var events []string
+	entities := make([]*core.Entity, 6)
+	for i := range entities {
+		entity := newSolidBox(float64(i), 0)
+		entity.Collider.IsTrigger = true // Overlap without being pushed apart
+		entity.OnCollisionEnter = func(self, other *core.Entity, _ core.CollisionInfo) {
+			if self.ID < other.ID {
+				events = append(events, fmt.Sprintf("enter %d-%d", self.ID, other.ID))
+			}
+		}
+		entity.OnCollisionExit = func(self, other *core.Entity, _ core.CollisionInfo) {
+			if self.ID < other.ID {
+				events = append(events, fmt.Sprintf("exit %d-%d", self.ID, other.ID))
+			}
+		}
+		entities[i] = entity
+		scene.AddEntity(entity)
+	}
+
+	var want []string
+	for _, kind := range []string{"enter", "exit"} {
+		for a := 1; a <= len(entities); a++ {
+			for b := a + 1; b <= len(entities); b++ {
+				want = append(want, fmt.Sprintf("%s %d-%d", kind, a, b))
+			}
+		}
+	}
+
+	scene.Update(0)
+	for i, entity := range entities {
+		entity.Transform.Position.X = float64(i) * 100
+	}
+	scene.Update(0)
+
+	if strings.Join(events, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected sorted callbacks\nwant: %v\ngot:  %v", want, events)
+	}
+}
+
+// TestSceneStats tests entity, layer, collider, and behavior counts.
+func TestSceneStats(t *testing.T) {
+	scene := core.NewScene()
+	behavior := &mockBehavior{}
+
+	scene.AddEntity(&core.Entity{Active: true, Layer: 1, Behavior: core.Behaviors{behavior, &mockBehavior{}}})
+	scene.AddEntity(&core.Entity{Active: false, Layer: 1})
+	trigger := physics.NewCollider(10, 10)
+	trigger.IsTrigger = true
+	scene.AddEntity(&core.Entity{Active: true, Layer: 2, Collider: trigger, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}})
+	scene.AddEntity(&core.Entity{Active: true, Layer: 2, Collider: physics.NewCollider(10, 10), Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}})
+	scene.Update(0.016)
+
+	stats := scene.Stats()
+	if stats.Entities != 4 || stats.Active != 3 || stats.Inactive != 1 {
+		t.Errorf("Expected 4 entities (3 active), got %+v", stats)
+	}
+	if stats.ByLayer[1] != 2 || stats.ByLayer[2] != 2 {
+		t.Errorf("Expected 2 entities per layer, got %v", stats.ByLayer)
+	}
+	if stats.Colliders != 2 || stats.Triggers != 1 {
+		t.Errorf("Expected 2 colliders (1 trigger), got %d and %d", stats.Colliders, stats.Triggers)
+	}
+	if stats.CollisionPairs != 1 {
+		t.Errorf("Expected 1 collision pair, got %d", stats.CollisionPairs)
+	}
+	if got := stats.BehaviorsByType["*unit.mockBehavior"]; got != 2 {
+		t.Errorf("Expected 2 mockBehavior instances, got %d (%v)", got, stats.BehaviorsByType)
+	}
+}
+
+// TestSceneLifecycleEvents tests added/removed listeners.
+func TestSceneLifecycleEvents(t *testing.T) {
+	scene := core.NewScene()
+	tracked := make(map[uint64]bool)
+
+	scene.OnEntityAdded(func(e *core.Entity) { tracked[e.ID] = true })
+	scene.OnEntityRemoved(func(e *core.Entity) {
+		if scene.GetEntity(e.ID) != nil {
+			t.Error("Expected entity to be gone from the scene during OnEntityRemoved")
+		}
+		delete(tracked, e.ID)
+	})
+
+	first := scene.AddEntity(&core.Entity{Active: true})
+	second := scene.AddEntity(&core.Entity{Active: true})
+	if !tracked[first] || !tracked[second] {
+		t.Fatalf("Expected both entities tracked, got %v", tracked)
+	}
+
+	scene.RemoveEntity(first)
+	if !tracked[first] {
+		t.Error("Expected removal event to wait for deferred removal")
+	}
+	scene.Update(0.016)
+	if tracked[first] || !tracked[second] {
+		t.Errorf("Expected only second entity tracked, got %v", tracked)
+	}
+}
+
+// TestSceneLayers tests named layer registration and per-layer cameras.
+func TestSceneLayers(t *testing.T) {
+	scene := core.NewScene()
+	scene.Camera().Position = gamemath.Vector2{X: 100, Y: 50}
+
+	far := scene.AddLayer("far", -5)
+	far.Parallax = gamemath.Vector2{X: 0.5, Y: 0.5}
+	scene.AddLayer("ui", 10).ScreenSpace = true
+
+	if scene.Layer("far") != far || scene.LayerAt(-5) != far {
+		t.Fatal("Expected lookup by name and z to find the layer")
+	}
+	if layers := scene.Layers(); len(layers) != 2 || layers[0] != far {
+		t.Errorf("Expected layers sorted by z, got %v", layers)
+	}
+
+	if got := scene.LayerCamera(-5).Position; got.X != 50 || got.Y != 25 {
+		t.Errorf("Expected parallax camera at (50, 25), got %v", got)
+	}
+	if scene.LayerCamera(0) != scene.Camera() {
+		t.Error("Expected unregistered layers to use the scene camera")
+	}
+	if x, y := scene.LayerCamera(10).WorldToScreen(20, 30); x != 20 || y != 30 {
+		t.Errorf("Expected screen-space identity transform, got (%d, %d)", x, y)
+	}
+
+	scene.AddLayer("far", 3)
+	if scene.LayerAt(-5) != nil || scene.Layer("far").Z != 3 {
+		t.Error("Expected re-adding a layer name to replace it")
+	}
+}
@@ -0,0 +1,128 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+const schedulerStep = 1.0 / 60
+
+// stepScheduler advances a scheduler by n fixed steps.
+func stepScheduler(s *core.Scheduler, n int) {
+	for i := 0; i < n; i++ {
+		s.Update(schedulerStep)
+	}
+}
+
+// TestSchedulerAfterAndEvery tests one-shot and repeating timers.
+func TestSchedulerAfterAndEvery(t *testing.T) {
+	s := core.NewScheduler()
+	fired, ticks := 0, 0
+	s.After(time.Second, func() { fired++ })
+	stop := s.Every(500*time.Millisecond, func() { ticks++ })
+
+	stepScheduler(s, 59)
+	if fired != 0 || ticks != 1 {
+		t.Errorf("Expected no After and 1 tick before 1s, got %d and %d", fired, ticks)
+	}
+	stepScheduler(s, 1)
+	if fired != 1 || ticks != 2 {
+		t.Errorf("Expected After and 2 ticks at 1s, got %d and %d", fired, ticks)
+	}
+
+	// A long update covers several intervals
+	s.Update(1.0)
+	if fired != 1 || ticks != 4 {
+		t.Errorf("Expected After once and 4 ticks, got %d and %d", fired, ticks)
+	}
+	stop()
+	stepScheduler(s, 120)
+	if ticks != 4 || s.Len() != 0 {
+		t.Errorf("Expected no ticks after stop and no tasks, got %d ticks and %d tasks", ticks, s.Len())
+	}
+}
+
+// TestSchedulerCoroutine tests scripts pause at waits and resume in order.
+func TestSchedulerCoroutine(t *testing.T) {
+	s := core.NewScheduler()
+	var steps []string
+	gate := false
+	s.Run(func(co *core.Coroutine) {
+		steps = append(steps, "start")
+		co.Yield()
+		steps = append(steps, "yielded")
+		co.Wait(500 * time.Millisecond)
+		steps = append(steps, "waited")
+		co.WaitUntil(func() bool { return gate })
+		steps = append(steps, "done")
+	})
+
+	if len(steps) != 1 {
+		t.Fatalf("Expected the script to run up to its first wait, got %v", steps)
+	}
+	stepScheduler(s, 1)
+	if len(steps) != 2 {
+		t.Fatalf("Expected yield to resume on the next update, got %v", steps)
+	}
+	stepScheduler(s, 29)
+	if len(steps) != 2 {
+		t.Fatalf("Expected the wait to last 30 updates, got %v", steps)
+	}
+	stepScheduler(s, 1)
+	stepScheduler(s, 10)
+	if len(steps) != 3 {
+		t.Fatalf("Expected the script to wait for the condition, got %v", steps)
+	}
+	gate = true
+	stepScheduler(s, 1)
+	if len(steps) != 4 || s.Len() != 0 {
+		t.Errorf("Expected the script to finish and be dropped, got %v with %d tasks", steps, s.Len())
+	}
+}
+
+// TestSchedulerCoroutineStop tests stopping a script runs its deferred calls.
+func TestSchedulerCoroutineStop(t *testing.T) {
+	s := core.NewScheduler()
+	cleaned, reached := false, false
+	stop := s.Run(func(co *core.Coroutine) {
+		defer func() { cleaned = true }()
+		co.Wait(time.Second)
+		reached = true
+	})
+	stop()
+	stepScheduler(s, 120)
+	if !cleaned || reached {
+		t.Errorf("Expected deferred cleanup without finishing, got cleaned=%v reached=%v", cleaned, reached)
+	}
+}
+
+// TestSchedulerEntityTasks tests entity tasks pause while inactive and stop on removal.
+func TestSchedulerEntityTasks(t *testing.T) {
+	scene := core.NewScene()
+	turret := &core.Entity{Active: true}
+	scene.AddEntity(turret)
+	shots := 0
+	scene.Scheduler().For(turret).Every(100*time.Millisecond, func() { shots++ })
+
+	for i := 0; i < 12; i++ {
+		scene.Update(schedulerStep)
+	}
+	if shots != 2 {
+		t.Errorf("Expected 2 shots in 0.2s, got %d", shots)
+	}
+	turret.Active = false
+	for i := 0; i < 60; i++ {
+		scene.Update(schedulerStep)
+	}
+	if shots != 2 {
+		t.Errorf("Expected no shots while inactive, got %d", shots)
+	}
+	turret.Active = true
+	scene.RemoveEntity(turret.ID)
+	scene.Update(schedulerStep)
+	if scene.Scheduler().Len() != 0 {
+		t.Errorf("Expected removal to cancel entity tasks, got %d", scene.Scheduler().Len())
+	}
+}
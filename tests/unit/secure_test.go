@@ -0,0 +1,99 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/dshills/gogame/engine/secure"
+)
+
+// TestAESRoundTrip tests encrypting and decrypting, and rejecting modified data.
+func TestAESRoundTrip(t *testing.T) {
+	sealer, err := secure.NewAES(secure.DeriveKey("secret", "saves"))
+	if err != nil {
+		t.Fatalf("NewAES failed: %v", err)
+	}
+	plain := []byte(`{"gold":100}`)
+	sealed, err := sealer.Seal(plain)
+	if err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+	if bytes.Contains(sealed, []byte("gold")) {
+		t.Error("Expected sealed data to be encrypted")
+	}
+
+	opened, err := sealer.Open(sealed)
+	if err != nil || !bytes.Equal(opened, plain) {
+		t.Fatalf("Expected %q, got %q (%v)", plain, opened, err)
+	}
+
+	sealed[len(sealed)-1] ^= 1
+	if _, err := sealer.Open(sealed); !errors.Is(err, secure.ErrTampered) {
+		t.Errorf("Expected ErrTampered, got %v", err)
+	}
+	if _, err := sealer.Open(plain); !errors.Is(err, secure.ErrNotSealed) {
+		t.Errorf("Expected ErrNotSealed, got %v", err)
+	}
+
+	other, _ := secure.NewAES(secure.DeriveKey("secret", "assets"))
+	resealed, _ := sealer.Seal(plain)
+	if _, err := other.Open(resealed); !errors.Is(err, secure.ErrTampered) {
+		t.Errorf("Expected a different key to fail, got %v", err)
+	}
+
+	if _, err := secure.NewAES([]byte("short")); !errors.Is(err, secure.ErrInvalidKey) {
+		t.Errorf("Expected ErrInvalidKey, got %v", err)
+	}
+}
+
+// TestHMACSigning tests that signed data stays readable but edits are detected.
+func TestHMACSigning(t *testing.T) {
+	sealer, err := secure.NewHMAC([]byte("key"))
+	if err != nil {
+		t.Fatalf("NewHMAC failed: %v", err)
+	}
+	sealed, _ := sealer.Seal([]byte(`{"gold":100}`))
+	if !bytes.Contains(sealed, []byte(`"gold":100`)) {
+		t.Error("Expected signed data to stay readable")
+	}
+
+	edited := bytes.Replace(sealed, []byte("100"), []byte("999"), 1)
+	if _, err := sealer.Open(edited); !errors.Is(err, secure.ErrTampered) {
+		t.Errorf("Expected ErrTampered, got %v", err)
+	}
+	if _, err := secure.NewHMAC(nil); !errors.Is(err, secure.ErrInvalidKey) {
+		t.Errorf("Expected ErrInvalidKey for an empty key, got %v", err)
+	}
+}
+
+// TestSealedSaveFile tests a schema with a sealer, including plain save upgrades.
+func TestSealedSaveFile(t *testing.T) {
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "plain.json")
+	sealedPath := filepath.Join(dir, "sealed.sav")
+
+	if err := newProgressSchema().SaveFile(plainPath, progressV3{Gold: 5, Level: "1-2"}); err != nil {
+		t.Fatalf("SaveFile failed: %v", err)
+	}
+
+	schema := newProgressSchema()
+	schema.Sealer, _ = secure.NewAES(secure.DeriveKey("secret", "saves"))
+	if err := schema.SaveFile(sealedPath, progressV3{Gold: 7, Level: "3-1"}); err != nil {
+		t.Fatalf("SaveFile failed: %v", err)
+	}
+
+	var loaded progressV3
+	if _, err := schema.LoadFile(sealedPath, &loaded); err != nil || loaded.Gold != 7 {
+		t.Fatalf("Expected gold 7, got %+v (%v)", loaded, err)
+	}
+
+	if _, err := schema.LoadFile(plainPath, &loaded); !errors.Is(err, secure.ErrNotSealed) {
+		t.Errorf("Expected plain save to be rejected, got %v", err)
+	}
+	schema.AllowPlain = true
+	if _, err := schema.LoadFile(plainPath, &loaded); err != nil || loaded.Gold != 5 {
+		t.Errorf("Expected plain save to load with AllowPlain, got %+v (%v)", loaded, err)
+	}
+}
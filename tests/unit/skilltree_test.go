@@ -0,0 +1,91 @@
+package unit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/skilltree"
+)
+
+const testSkillTreeJSON = `{"nodes": [
+	{"id": "dash", "name": "Dash", "cost": 1, "position": {"X": 0, "Y": 0}},
+	{"id": "double_dash", "name": "Double Dash", "cost": 2, "prerequisites": ["dash"], "position": {"X": 0, "Y": 1}},
+	{"id": "armor", "name": "Armor", "cost": 1, "position": {"X": 1, "Y": 0}}
+]}`
+
+// TestSkillTreeUnlock tests prerequisites, costs, and callbacks.
+func TestSkillTreeUnlock(t *testing.T) {
+	tree, err := skilltree.LoadTree(strings.NewReader(testSkillTreeJSON))
+	if err != nil {
+		t.Fatalf("Failed to load tree: %v", err)
+	}
+	tree.Points = 3
+
+	unlockedDash := false
+	tree.OnNodeUnlock("dash", func(*skilltree.Node) { unlockedDash = true })
+
+	if err := tree.Unlock("double_dash"); !errors.Is(err, skilltree.ErrPrerequisitesNotMet) {
+		t.Errorf("Expected ErrPrerequisitesNotMet, got %v", err)
+	}
+	if err := tree.Unlock("dash"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !unlockedDash {
+		t.Error("Expected dash unlock callback to fire")
+	}
+	if err := tree.Unlock("dash"); !errors.Is(err, skilltree.ErrAlreadyUnlocked) {
+		t.Errorf("Expected ErrAlreadyUnlocked, got %v", err)
+	}
+	if err := tree.Unlock("double_dash"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := tree.Unlock("armor"); !errors.Is(err, skilltree.ErrNotEnoughPoints) {
+		t.Errorf("Expected ErrNotEnoughPoints, got %v", err)
+	}
+	if tree.Points != 0 || len(tree.Unlocked()) != 2 {
+		t.Errorf("Expected 0 points and 2 unlocked nodes, got %d and %v", tree.Points, tree.Unlocked())
+	}
+}
+
+// TestSkillTreeValidation tests rejection of bad graphs.
+func TestSkillTreeValidation(t *testing.T) {
+	_, err := skilltree.NewTree(
+		&skilltree.Node{ID: "a", Prerequisites: []string{"b"}},
+		&skilltree.Node{ID: "b", Prerequisites: []string{"a"}},
+	)
+	if !errors.Is(err, skilltree.ErrCycle) {
+		t.Errorf("Expected ErrCycle, got %v", err)
+	}
+
+	_, err = skilltree.NewTree(&skilltree.Node{ID: "a", Prerequisites: []string{"missing"}})
+	if !errors.Is(err, skilltree.ErrUnknownNode) {
+		t.Errorf("Expected ErrUnknownNode, got %v", err)
+	}
+}
+
+// TestSkillTreeViewNavigation tests directional selection.
+func TestSkillTreeViewNavigation(t *testing.T) {
+	tree, _ := skilltree.LoadTree(strings.NewReader(testSkillTreeJSON))
+	view := skilltree.NewView(tree, gamemath.Vector2{X: 100, Y: 100})
+
+	if view.Selected != "dash" {
+		t.Fatalf("Expected first node selected, got %s", view.Selected)
+	}
+	if !view.Move(1, 0) || view.Selected != "armor" {
+		t.Errorf("Expected move right to select armor, got %s", view.Selected)
+	}
+	if view.Move(1, 0) {
+		t.Error("Expected no node further right")
+	}
+	view.Move(-1, 0)
+	view.Move(0, 1)
+	if view.Selected != "double_dash" {
+		t.Errorf("Expected move down to select double_dash, got %s", view.Selected)
+	}
+
+	if node := view.NodeAt(100, 100); node == nil || node.ID != "dash" {
+		t.Error("Expected NodeAt origin to hit dash")
+	}
+}
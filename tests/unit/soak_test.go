@@ -0,0 +1,105 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/input"
+	"github.com/dshills/gogame/engine/soak"
+)
+
+// leakySpawner adds a tagged entity every second and never removes them.
+type leakySpawner struct {
+	scene   *core.Scene
+	input   *input.InputManager
+	timer   float64
+	presses int
+	panicAt int // Panic on this many presses (0 = never)
+}
+
+func (b *leakySpawner) Update(_ *core.Entity, dt float64) {
+	b.timer += dt
+	if b.timer >= 1 {
+		b.timer -= 1
+		b.scene.AddEntity(&core.Entity{Active: true, Tags: []string{"bullet"}})
+	}
+	if b.input.KeyPressed(input.KeySpace) {
+		b.presses++
+		if b.presses == b.panicAt {
+			panic("boom")
+		}
+	}
+}
+
+// TestSoakRunnerDetectsEntityLeak tests sampling, tag growth, and thresholds.
+func TestSoakRunnerDetectsEntityLeak(t *testing.T) {
+	inputMgr := input.NewInputManager()
+	scene := core.NewScene()
+	spawner := &leakySpawner{scene: scene, input: inputMgr}
+	scene.AddEntity(&core.Entity{Active: true, Behavior: spawner})
+
+	samples := 0
+	runner := soak.New(scene, soak.Config{
+		Duration:        2 * time.Minute,
+		SampleEvery:     30 * time.Second,
+		Warmup:          30 * time.Second,
+		Seed:            7,
+		Input:           inputMgr,
+		Keys:            []input.KeyCode{input.KeySpace},
+		Render:          true,
+		MaxEntityGrowth: 10,
+		OnSample:        func(soak.Sample) { samples++ },
+	})
+	report, err := runner.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if report.Steps != 7200 {
+		t.Errorf("Expected 7200 steps in 2 simulated minutes, got %d", report.Steps)
+	}
+	if samples != 5 || len(report.Samples) != 5 {
+		t.Errorf("Expected 5 samples (0s..120s), got %d", len(report.Samples))
+	}
+	if report.Baseline.Elapsed != 30*time.Second {
+		t.Errorf("Expected baseline after warmup at 30s, got %v", report.Baseline.Elapsed)
+	}
+	if report.EntityGrowth < 89 || report.EntityGrowth > 91 {
+		t.Errorf("Expected ~90 leaked entities after warmup, got %d", report.EntityGrowth)
+	}
+	if report.TagGrowth["bullet"] != report.EntityGrowth {
+		t.Errorf("Expected growth attributed to the bullet tag, got %v", report.TagGrowth)
+	}
+	if report.OK() || len(report.Problems) != 1 {
+		t.Errorf("Expected one entity growth problem, got %v", report.Problems)
+	}
+	if spawner.presses == 0 {
+		t.Error("Expected random input to press space")
+	}
+}
+
+// TestSoakRunnerStopsOnPanic tests that a panic ends the run with an error.
+func TestSoakRunnerStopsOnPanic(t *testing.T) {
+	inputMgr := input.NewInputManager()
+	scene := core.NewScene()
+	scene.AddEntity(&core.Entity{Active: true, Behavior: &leakySpawner{scene: scene, input: inputMgr, panicAt: 3}})
+
+	runner := soak.New(scene, soak.Config{
+		Duration: time.Hour,
+		Seed:     1,
+		Input:    inputMgr,
+		Keys:     []input.KeyCode{input.KeySpace},
+	})
+	report, err := runner.Run(context.Background())
+	if err == nil {
+		t.Fatal("Expected panic to be returned as an error")
+	}
+	if report.ErrorCount != 1 || len(report.Problems) != 1 {
+		t.Errorf("Expected the panic recorded once, got %d errors, %v", report.ErrorCount, report.Problems)
+	}
+	if report.Elapsed >= time.Hour {
+		t.Error("Expected the run to stop early")
+	}
+}
@@ -0,0 +1,142 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// TestSceneBatchedLayer tests that batched layers group sprites by texture into one call per texture.
+func TestSceneBatchedLayer(t *testing.T) {
+	a := graphics.NewTexture(nil, 8, 8, "a.png")
+	b := graphics.NewTexture(nil, 8, 8, "b.png")
+	newScene := func(batched bool) *core.Scene {
+		scene := core.NewScene()
+		scene.AddLayer("bullets", 5).Batched = batched
+		for i, texture := range []*graphics.Texture{a, b, a, b} {
+			scene.AddEntity(&core.Entity{
+				Active:    true,
+				Transform: gamemath.Transform{Position: gamemath.Vector2{X: float64(i * 10)}, Scale: gamemath.Vector2{X: 1, Y: 1}},
+				Sprite:    graphics.NewSprite(texture),
+				Layer:     5,
+			})
+		}
+		scene.AddEntity(&core.Entity{Active: true, Sprite: graphics.NewSprite(a), Layer: 9, Transform: gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}})
+		return scene
+	}
+
+	var drawn []*graphics.Texture
+	renderer := graphics.NewRecordingRenderer(func(call graphics.DrawCall) {
+		if call.Kind == graphics.DrawKindSprite {
+			drawn = append(drawn, call.Sprite.Texture)
+		}
+	})
+
+	if err := newScene(true).Render(renderer); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	want := []*graphics.Texture{a, a, b, b, a}
+	if len(drawn) != len(want) {
+		t.Fatalf("Expected %d sprites, got %d", len(want), len(drawn))
+	}
+	for i := range want {
+		if drawn[i] != want[i] {
+			t.Errorf("Expected sprite %d from %s, got %s", i, want[i].Path, drawn[i].Path)
+		}
+	}
+	if renderer.DrawCalls() != 3 {
+		t.Errorf("Expected 3 draw calls (a, b, then the unbatched layer), got %d", renderer.DrawCalls())
+	}
+
+	// Without batching, order and per-sprite draws are kept
+	drawn = nil
+	renderer.ResetDrawCalls()
+	if err := newScene(false).Render(renderer); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if renderer.DrawCalls() != 5 || drawn[1] != b {
+		t.Errorf("Expected 5 draws in insertion order, got %d", renderer.DrawCalls())
+	}
+}
+
+// TestSpriteBatchMatchesDrawSprite tests that batched sprites render the same pixels as DrawSprite.
+func TestSpriteBatchMatchesDrawSprite(t *testing.T) {
+	render := func(draw func(renderer *graphics.Renderer, sprite *graphics.Sprite, transform gamemath.Transform, camera *graphics.Camera)) []byte {
+		target, err := sdl.CreateRGBSurface(0, 32, 32, 32, 0x000000ff, 0x0000ff00, 0x00ff0000, 0xff000000)
+		if err != nil {
+			t.Fatalf("CreateRGBSurface failed: %v", err)
+		}
+		defer target.Free()
+		sdlRenderer, err := sdl.CreateSoftwareRenderer(target)
+		if err != nil {
+			t.Fatalf("CreateSoftwareRenderer failed: %v", err)
+		}
+		defer func() { _ = sdlRenderer.Destroy() }()
+		renderer := graphics.NewRenderer(sdlRenderer)
+
+		texture, err := renderer.NewTextureFromImage(graphics.GenerateImage(4, 4, func(x, y int) gamemath.Color {
+			return gamemath.Color{R: uint8(x * 60), G: uint8(y * 60), B: 200, A: 255}
+		}))
+		if err != nil {
+			t.Fatalf("NewTextureFromImage failed: %v", err)
+		}
+		defer func() { _ = texture.Destroy() }()
+
+		sprite := graphics.NewSprite(texture)
+		sprite.FlipH = true
+		sprite.Color = gamemath.Color{R: 255, G: 128, B: 255, A: 255}
+		camera := graphics.NewCamera()
+		camera.SetScreenSize(32, 32)
+		transform := gamemath.Transform{Position: gamemath.Vector2{X: 2, Y: -1}, Rotation: 90, Scale: gamemath.Vector2{X: 4, Y: 4}}
+		draw(renderer, sprite, transform, camera)
+
+		pixels := make([]byte, len(target.Pixels()))
+		copy(pixels, target.Pixels())
+		return pixels
+	}
+
+	copied := render(func(renderer *graphics.Renderer, sprite *graphics.Sprite, transform gamemath.Transform, camera *graphics.Camera) {
+		if err := renderer.DrawSprite(sprite, transform, camera); err != nil {
+			t.Fatalf("DrawSprite failed: %v", err)
+		}
+	})
+	batched := render(func(renderer *graphics.Renderer, sprite *graphics.Sprite, transform gamemath.Transform, camera *graphics.Camera) {
+		batch := graphics.NewSpriteBatch(renderer)
+		if err := batch.Draw(sprite, transform, camera); err != nil {
+			t.Fatalf("Draw failed: %v", err)
+		}
+		if !batch.Geometry() {
+			t.Log("SDL older than 2.0.18: checking the per-sprite fallback")
+		} else if batch.Len() != 1 {
+			t.Errorf("Expected 1 pending sprite, got %d", batch.Len())
+		}
+		if err := batch.Flush(); err != nil {
+			t.Fatalf("Flush failed: %v", err)
+		}
+		if renderer.DrawCalls() != 1 || batch.Len() != 0 {
+			t.Errorf("Expected 1 draw call and an empty batch, got %d and %d", renderer.DrawCalls(), batch.Len())
+		}
+	})
+
+	mismatched, covered := 0, 0
+	for i := 0; i < len(copied); i += 4 {
+		if copied[i+3] != 0 {
+			covered++
+		}
+		for c := 0; c < 4; c++ {
+			if diff := int(copied[i+c]) - int(batched[i+c]); diff > 8 || diff < -8 {
+				mismatched++
+				break
+			}
+		}
+	}
+	if covered != 256 {
+		t.Errorf("Expected DrawSprite to cover 16x16 pixels, got %d", covered)
+	}
+	if mismatched > 0 {
+		t.Errorf("Expected batched pixels to match DrawSprite, got %d mismatches", mismatched)
+	}
+}
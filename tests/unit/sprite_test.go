@@ -0,0 +1,55 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestSpriteSetPivot tests normalized pivots relative to the source rect.
+func TestSpriteSetPivot(t *testing.T) {
+	sprite := graphics.NewSprite(graphics.NewTexture(nil, 128, 64, "door.png"))
+	sprite.SetSourceRect(0, 0, 40, 20)
+
+	if sprite.Pivot.X != 0 || sprite.Pivot.Y != 0 {
+		t.Errorf("Expected default pivot at the center, got %+v", sprite.Pivot)
+	}
+
+	sprite.SetPivot(0, 0.5) // Hinge on the left edge
+	if sprite.Pivot.X != -20 || sprite.Pivot.Y != 0 {
+		t.Errorf("Expected pivot (-20, 0), got %+v", sprite.Pivot)
+	}
+
+	sprite.SetPivot(1, 1)
+	if sprite.Pivot.X != 20 || sprite.Pivot.Y != 10 {
+		t.Errorf("Expected pivot (20, 10), got %+v", sprite.Pivot)
+	}
+}
+
+// TestTextSpriteCache tests staleness tracking and the no-font draw path.
+func TestTextSpriteCache(t *testing.T) {
+	text := graphics.NewTextSprite("Score: 0", nil)
+	if !text.Stale() {
+		t.Error("Expected a new text sprite to need rendering")
+	}
+	if w, h := text.Size(); w != 0 || h != 0 {
+		t.Errorf("Expected zero size before the first draw, got %dx%d", w, h)
+	}
+	if text.Anchor != (gamemath.Vector2{X: 0.5, Y: 0.5}) || text.Color != gamemath.White {
+		t.Error("Expected centered white text by default")
+	}
+
+	// Without a font nothing is drawn, so no renderer is needed
+	entity := &core.Entity{Active: true, Text: text}
+	if err := entity.Render(nil, graphics.NewCamera()); err != nil {
+		t.Errorf("Expected fontless text to draw nothing, got %v", err)
+	}
+
+	scene := core.NewScene()
+	scene.AddEntity(entity)
+	if stats := scene.Stats(); stats.Texts != 1 {
+		t.Errorf("Expected 1 text entity in stats, got %d", stats.Texts)
+	}
+}
@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/stats"
+)
+
+// TestStatsModifierMath tests flat, additive, and multiplicative modifiers.
+func TestStatsModifierMath(t *testing.T) {
+	s := stats.New()
+	s.SetBase("speed", 100)
+
+	s.AddModifier(&stats.Modifier{Stat: "speed", Type: stats.Flat, Value: 20})
+	s.AddModifier(&stats.Modifier{Stat: "speed", Type: stats.PercentAdd, Value: 0.1})
+	s.AddModifier(&stats.Modifier{Stat: "speed", Type: stats.PercentAdd, Value: 0.15})
+	s.AddModifier(&stats.Modifier{Stat: "speed", Type: stats.PercentMult, Value: 1.0})
+
+	// (100 + 20) * (1 + 0.25) * 2 = 300
+	if got := s.Get("speed"); !almostEqual(got, 300, 0.0001) {
+		t.Errorf("Expected speed 300, got %f", got)
+	}
+}
+
+// TestStatsDerivedAndEvents tests derived stats and change notifications.
+func TestStatsDerivedAndEvents(t *testing.T) {
+	s := stats.New()
+	s.SetBase("strength", 10)
+	s.SetDerived("attack", func(s *stats.Stats) float64 { return s.Get("strength") * 2 })
+
+	changes := make(map[string]float64)
+	s.OnChanged = func(stat string, _, newValue float64) { changes[stat] = newValue }
+
+	s.AddModifier(&stats.Modifier{Stat: "strength", Type: stats.Flat, Value: 5, Source: "ring"})
+	if got := s.Get("attack"); got != 30 {
+		t.Errorf("Expected attack 30, got %f", got)
+	}
+	if changes["attack"] != 30 || changes["strength"] != 15 {
+		t.Errorf("Expected change events for strength and attack, got %v", changes)
+	}
+
+	if removed := s.RemoveSource("ring"); removed != 1 {
+		t.Errorf("Expected 1 modifier removed, got %d", removed)
+	}
+	if changes["attack"] != 20 {
+		t.Errorf("Expected attack change back to 20, got %f", changes["attack"])
+	}
+}
+
+// TestStatsStackingAndDuration tests stacking policies and expiry.
+func TestStatsStackingAndDuration(t *testing.T) {
+	s := stats.New()
+	s.SetBase("armor", 10)
+
+	weak := &stats.Modifier{Stat: "armor", Value: 5, Source: "shield", Stacking: stats.StackHighest}
+	strong := &stats.Modifier{Stat: "armor", Value: 8, Source: "shield", Stacking: stats.StackHighest}
+	s.AddModifier(strong)
+	if s.AddModifier(weak) {
+		t.Error("Expected weaker StackHighest modifier to be rejected")
+	}
+	if got := s.Get("armor"); got != 18 {
+		t.Errorf("Expected armor 18, got %f", got)
+	}
+
+	buff := &stats.Modifier{Stat: "armor", Value: 2, Source: "spell", Stacking: stats.StackRefresh, Duration: 1}
+	s.AddModifier(buff)
+	s.AddModifier(&stats.Modifier{Stat: "armor", Value: 2, Source: "spell", Stacking: stats.StackRefresh, Duration: 1})
+	if got := s.Get("armor"); got != 20 {
+		t.Errorf("Expected refreshed buff to apply once (armor 20), got %f", got)
+	}
+
+	s.Tick(0.5)
+	if got := s.Get("armor"); got != 20 {
+		t.Errorf("Expected buff still active, got %f", got)
+	}
+	s.Tick(0.6)
+	if got := s.Get("armor"); got != 18 {
+		t.Errorf("Expected buff expired (armor 18), got %f", got)
+	}
+}
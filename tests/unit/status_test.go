@@ -0,0 +1,137 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/status"
+)
+
+// TestStatusEffectTicksAndExpiry tests tick callbacks and expiry.
+func TestStatusEffectTicksAndExpiry(t *testing.T) {
+	entity := &core.Entity{Active: true}
+	ticks, expired, detached := 0, 0, 0
+
+	poison := &status.EffectType{
+		ID:           "poison",
+		Duration:     3,
+		TickInterval: 1,
+		OnTick:       func(*core.Entity, *status.Effect) { ticks++ },
+		OnExpire:     func(*core.Entity, *status.Effect) { expired++ },
+		AttachVisual: func(*core.Entity) func() { return func() { detached++ } },
+	}
+
+	effects := status.NewEffects(entity)
+	entity.Behavior = core.Behaviors{effects}
+	effects.Apply(poison)
+
+	for i := 0; i < 4; i++ {
+		entity.Update(1.0)
+	}
+
+	if ticks != 3 {
+		t.Errorf("Expected 3 ticks, got %d", ticks)
+	}
+	if expired != 1 || detached != 1 {
+		t.Errorf("Expected expire and detach once, got %d and %d", expired, detached)
+	}
+	if effects.Has("poison") {
+		t.Error("Expected poison to have expired")
+	}
+}
+
+// TestStatusEffectStacking tests stacking policies.
+func TestStatusEffectStacking(t *testing.T) {
+	entity := &core.Entity{Active: true}
+	effects := status.NewEffects(entity)
+
+	bleed := &status.EffectType{ID: "bleed", Duration: 2, Stacking: status.Intensify, MaxStacks: 2}
+	effects.Apply(bleed)
+	effects.Apply(bleed)
+	effects.Apply(bleed)
+	if got := effects.Get("bleed").Stacks; got != 2 {
+		t.Errorf("Expected stacks capped at 2, got %d", got)
+	}
+
+	haste := &status.EffectType{ID: "haste", Duration: 2, Stacking: status.Extend}
+	effects.Apply(haste)
+	effects.Apply(haste)
+	if got := effects.Get("haste").Remaining; got != 4 {
+		t.Errorf("Expected extended duration 4, got %f", got)
+	}
+
+	stun := &status.EffectType{ID: "stun", Duration: 1, Stacking: status.Ignore}
+	effects.Apply(stun)
+	effects.Update(entity, 0.5)
+	effects.Apply(stun)
+	if got := effects.Get("stun").Remaining; got != 0.5 {
+		t.Errorf("Expected ignored reapplication, got remaining %f", got)
+	}
+}
+
+// TestStatusEffectTint tests sprite tint application and restoration.
+func TestStatusEffectTint(t *testing.T) {
+	original := gamemath.Color{R: 10, G: 20, B: 30, A: 255}
+	entity := &core.Entity{Active: true, Sprite: &graphics.Sprite{Color: original}}
+	effects := status.NewEffects(entity)
+
+	frozen := gamemath.Blue
+	effects.Apply(&status.EffectType{ID: "frozen", Tint: &frozen})
+	if entity.Sprite.Color != frozen {
+		t.Errorf("Expected frozen tint, got %v", entity.Sprite.Color)
+	}
+
+	effects.Remove("frozen")
+	if entity.Sprite.Color != original {
+		t.Errorf("Expected original color restored, got %v", entity.Sprite.Color)
+	}
+}
+
+// TestStatusEffectRemovedDuringUpdate tests that an effect removed by another
+// effect's callback, or by its own, stops ticking and ends only once.
+func TestStatusEffectRemovedDuringUpdate(t *testing.T) {
+	entity := &core.Entity{Active: true}
+	effects := status.NewEffects(entity)
+	burnTicks, burnExpired, burnRemoved, burnDetached := 0, 0, 0, 0
+
+	cleanse := &status.EffectType{
+		ID:           "cleanse",
+		Duration:     5,
+		TickInterval: 1,
+		OnTick:       func(*core.Entity, *status.Effect) { effects.Remove("burn") },
+	}
+	burn := &status.EffectType{
+		ID:           "burn",
+		Duration:     1,
+		TickInterval: 0.5,
+		OnTick:       func(*core.Entity, *status.Effect) { burnTicks++ },
+		OnExpire:     func(*core.Entity, *status.Effect) { burnExpired++ },
+		OnRemove:     func(*core.Entity, *status.Effect) { burnRemoved++ },
+		AttachVisual: func(*core.Entity) func() { return func() { burnDetached++ } },
+	}
+	effects.Apply(cleanse)
+	effects.Apply(burn)
+
+	effects.Update(entity, 1)
+	if burnTicks != 0 || burnExpired != 0 || burnRemoved != 1 || burnDetached != 1 {
+		t.Errorf("Expected burn removed once without ticking, got %d ticks, %d expired, %d removed, %d detached",
+			burnTicks, burnExpired, burnRemoved, burnDetached)
+	}
+
+	selfTicks := 0
+	shield := &status.EffectType{
+		ID:           "shield",
+		TickInterval: 0.25,
+		OnTick: func(*core.Entity, *status.Effect) {
+			selfTicks++
+			effects.Remove("shield")
+		},
+	}
+	effects.Apply(shield)
+	effects.Update(entity, 1)
+	if selfTicks != 1 || effects.Has("shield") {
+		t.Errorf("Expected one tick before the shield removed itself, got %d", selfTicks)
+	}
+}
@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/terrain"
+)
+
+// newGroundTerrain creates 100x100 terrain solid in its bottom half at world (100, 0).
+func newGroundTerrain(t *testing.T) *terrain.Terrain {
+	img := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	draw.Draw(img, image.Rect(0, 50, 100, 100), &image.Uniform{C: color.RGBA{G: 160, A: 255}}, image.Point{}, draw.Src)
+
+	ground, err := terrain.New(nil, img, gamemath.Vector2{X: 100, Y: 0}, 0)
+	if err != nil {
+		t.Fatalf("Expected terrain, got %v", err)
+	}
+	return ground
+}
+
+// TestTerrainQueries tests point, rect, and surface queries in world space.
+func TestTerrainQueries(t *testing.T) {
+	ground := newGroundTerrain(t)
+
+	if ground.Solid(150, 25) || !ground.Solid(150, 75) {
+		t.Error("Expected only the bottom half to be solid")
+	}
+	if ground.Solid(50, 75) {
+		t.Error("Expected points outside the terrain to be empty")
+	}
+	if !ground.OverlapsRect(gamemath.Rectangle{X: 140, Y: 45, Width: 10, Height: 10}) {
+		t.Error("Expected rect touching the surface to overlap")
+	}
+	if y, ok := ground.SurfaceBelow(120, 0); !ok || y != 50 {
+		t.Errorf("Expected surface at y=50, got %f (%v)", y, ok)
+	}
+}
+
+// TestTerrainCarve tests circular carving and refilling.
+func TestTerrainCarve(t *testing.T) {
+	ground := newGroundTerrain(t)
+	center := gamemath.Vector2{X: 150, Y: 60}
+
+	removed := ground.CarveCircle(center, 5)
+	if removed == 0 {
+		t.Fatal("Expected carving to remove pixels")
+	}
+	if ground.Solid(150, 60) {
+		t.Error("Expected crater center to be empty")
+	}
+	if !ground.Solid(150, 70) {
+		t.Error("Expected ground outside the crater to remain")
+	}
+	if again := ground.CarveCircle(center, 5); again != 0 {
+		t.Errorf("Expected carving the same crater to remove nothing, got %d", again)
+	}
+
+	ground.FillCircle(center, 5, color.RGBA{R: 120})
+	if !ground.Solid(150, 60) {
+		t.Error("Expected fill to restore solid terrain")
+	}
+}
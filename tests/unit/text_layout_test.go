@@ -0,0 +1,84 @@
+package unit
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/dshills/gogame/engine/graphics"
+)
+
+// monoWidth measures text at 10 pixels per character.
+func monoWidth(s string) int {
+	return 10 * utf8.RuneCountInString(s)
+}
+
+// layoutTexts returns the text of each laid-out line.
+func layoutTexts(block graphics.TextBlock) []string {
+	texts := make([]string, len(block.Lines))
+	for i, line := range block.Lines {
+		texts[i] = line.Text
+	}
+	return texts
+}
+
+// TestTextLayoutWrap tests wrapping at spaces, forced breaks, and long words.
+func TestTextLayoutWrap(t *testing.T) {
+	layout := graphics.TextLayout{Width: 100} // 10 characters per line
+	block := layout.Layout("the quick brown fox jumps\nover\nabcdefghijklmnop", 20, monoWidth)
+
+	want := []string{"the quick", "brown fox", "jumps", "over", "abcdefghij", "klmnop"}
+	got := layoutTexts(block)
+	if len(got) != len(want) {
+		t.Fatalf("Expected lines %q, got %q", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, want[i], got[i])
+		}
+		if block.Lines[i].Y != i*20 {
+			t.Errorf("Line %d: expected Y %d, got %d", i, i*20, block.Lines[i].Y)
+		}
+	}
+	if block.Width != 100 || block.Height != 120 {
+		t.Errorf("Expected a 100x120 block, got %dx%d", block.Width, block.Height)
+	}
+}
+
+// TestTextLayoutAlignAndSpacing tests alignment offsets and line spacing.
+func TestTextLayoutAlignAndSpacing(t *testing.T) {
+	text := "abcd\nab"
+	center := graphics.TextLayout{Width: 100, Align: graphics.AlignCenter, LineSpacing: 1.5}
+	block := center.Layout(text, 20, monoWidth)
+	if block.Lines[0].X != 30 || block.Lines[1].X != 40 {
+		t.Errorf("Expected centered X 30 and 40, got %d and %d", block.Lines[0].X, block.Lines[1].X)
+	}
+	if block.Lines[1].Y != 30 || block.Height != 50 {
+		t.Errorf("Expected second line at Y 30 and height 50, got %d and %d", block.Lines[1].Y, block.Height)
+	}
+
+	// Without a wrap width, lines align within the widest line
+	right := graphics.TextLayout{Align: graphics.AlignRight}
+	block = right.Layout(text, 20, monoWidth)
+	if block.Width != 40 || block.Lines[0].X != 0 || block.Lines[1].X != 20 {
+		t.Errorf("Expected width 40 with X 0 and 20, got %d with %d and %d",
+			block.Width, block.Lines[0].X, block.Lines[1].X)
+	}
+}
+
+// TestTextLayoutMaxLines tests truncation with an ellipsis that fits.
+func TestTextLayoutMaxLines(t *testing.T) {
+	layout := graphics.TextLayout{Width: 100, MaxLines: 2}
+	block := layout.Layout("the quick brown fox jumps", 20, monoWidth)
+	got := layoutTexts(block)
+	if !block.Truncated || len(got) != 2 {
+		t.Fatalf("Expected 2 truncated lines, got %q (truncated %v)", got, block.Truncated)
+	}
+	if got[1] != "brown f..." {
+		t.Errorf("Expected %q, got %q", "brown f...", got[1])
+	}
+
+	fits := layout.Layout("short", 20, monoWidth)
+	if fits.Truncated || len(fits.Lines) != 1 {
+		t.Errorf("Expected short text untouched, got %q", layoutTexts(fits))
+	}
+}
@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// newTextMask creates a mask with full coverage at the given pixels.
+func newTextMask(width, height int, pixels ...image.Point) *image.Alpha {
+	mask := image.NewAlpha(image.Rect(0, 0, width, height))
+	for _, p := range pixels {
+		mask.SetAlpha(p.X, p.Y, color.Alpha{A: 255})
+	}
+	return mask
+}
+
+// TestTextStylePadding tests the room reserved for outlines and shadows.
+func TestTextStylePadding(t *testing.T) {
+	style := graphics.TextStyle{OutlineWidth: 2, ShadowOffset: gamemath.Vector2{X: 3, Y: -1}}
+	left, top, right, bottom := style.Padding()
+	if left != 2 || top != 3 || right != 5 || bottom != 2 {
+		t.Errorf("Expected padding (2, 3, 5, 2), got (%d, %d, %d, %d)", left, top, right, bottom)
+	}
+}
+
+// TestTextStyleOutlineAndShadow tests layer placement and colors.
+func TestTextStyleOutlineAndShadow(t *testing.T) {
+	red := gamemath.Color{R: 255, A: 255}
+	black := gamemath.Color{A: 255}
+	gray := gamemath.Color{R: 100, G: 100, B: 100, A: 255}
+	style := graphics.TextStyle{
+		OutlineWidth: 1,
+		OutlineColor: black,
+		ShadowOffset: gamemath.Vector2{X: 2, Y: 2},
+		ShadowColor:  gray,
+	}
+	img := style.Stylize(newTextMask(1, 1, image.Pt(0, 0)), red)
+
+	if size := img.Bounds().Size(); size.X != 5 || size.Y != 5 {
+		t.Fatalf("Expected 5x5 image, got %v", size)
+	}
+	check := func(x, y int, want gamemath.Color) {
+		t.Helper()
+		c := img.RGBAAt(x, y)
+		if c.R != want.R || c.G != want.G || c.B != want.B || c.A != want.A {
+			t.Errorf("Expected %v at (%d, %d), got %v", want, x, y, c)
+		}
+	}
+	check(1, 1, red)              // Fill
+	check(0, 1, black)            // Outline beside the fill
+	check(1, 2, black)            // Outline below the fill
+	check(0, 0, gamemath.Color{}) // Outline is round (corner left empty)
+	check(3, 3, gray)             // Shadow under the fill, offset (2, 2)
+	check(3, 2, gray)             // Shadow of the outline
+	check(2, 2, gamemath.Color{}) // Between outline and shadow
+	check(0, 4, gamemath.Color{}) // Shadow starts at the offset
+}
+
+// TestTextStyleBoldItalic tests synthesized bold widening and italic slant.
+func TestTextStyleBoldItalic(t *testing.T) {
+	white := gamemath.White
+	mask := newTextMask(1, 3, image.Pt(0, 0), image.Pt(0, 1), image.Pt(0, 2)) // Vertical stroke
+
+	bold := graphics.TextStyle{Bold: true}.Stylize(mask, white)
+	if bold.Bounds().Dx() != 3 || bold.RGBAAt(0, 1).A == 0 || bold.RGBAAt(2, 1).A == 0 {
+		t.Errorf("Expected bold stroke 3 pixels wide, got %v", bold.Bounds())
+	}
+
+	italic := graphics.TextStyle{Italic: true}.Stylize(newTextMask(1, 6, image.Pt(0, 0), image.Pt(0, 5)), white)
+	if italic.Bounds().Dx() <= 1 {
+		t.Fatalf("Expected italic to widen the image, got %v", italic.Bounds())
+	}
+	if italic.RGBAAt(0, 5).A != 255 || italic.RGBAAt(0, 0).A == 255 {
+		t.Error("Expected the bottom row in place and the top row slanted right")
+	}
+}
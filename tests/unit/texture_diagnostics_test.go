@@ -0,0 +1,76 @@
+package unit
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+)
+
+// TestTextureDiagnosticsLeaks tests that undestroyed textures are reported with their creation site.
+func TestTextureDiagnosticsLeaks(t *testing.T) {
+	diagnostics := graphics.EnableTextureDiagnostics(nil)
+	t.Cleanup(graphics.DisableTextureDiagnostics)
+	if graphics.ActiveTextureDiagnostics() != diagnostics {
+		t.Fatal("Expected the enabled tracker to be active")
+	}
+
+	kept := graphics.NewTexture(nil, 16, 8, "hud.png")
+	freed := graphics.NewTexture(nil, 4, 4, "")
+	_ = freed.Destroy()
+	if diagnostics.LiveTextures() != 1 {
+		t.Errorf("Expected 1 live texture, got %d", diagnostics.LiveTextures())
+	}
+
+	leaks := diagnostics.Leaks()
+	if len(leaks) != 1 || leaks[0].Path != kept.Path || leaks[0].Kind != graphics.TextureLeaked {
+		t.Fatalf("Expected hud.png reported as leaked, got %v", leaks)
+	}
+	if !strings.Contains(leaks[0].Created, "TestTextureDiagnosticsLeaks") {
+		t.Errorf("Expected the creation stack to name the test, got:\n%s", leaks[0].Created)
+	}
+
+	var report strings.Builder
+	if n := diagnostics.Report(&report); n != 1 || !strings.Contains(report.String(), "texture leaked: hud.png 16x8") {
+		t.Errorf("Expected a report with 1 leak, got %d:\n%s", n, report.String())
+	}
+	_ = kept.Destroy()
+	if n := diagnostics.Report(&report); n != 0 {
+		t.Errorf("Expected a clean report after destroying, got %d issues", n)
+	}
+}
+
+// TestTextureDiagnosticsDestroyedInUse tests that destroying a texture sprites still use is reported.
+func TestTextureDiagnosticsDestroyedInUse(t *testing.T) {
+	diagnostics := graphics.EnableTextureDiagnostics(nil)
+	t.Cleanup(graphics.DisableTextureDiagnostics)
+	var reported []graphics.TextureIssue
+	diagnostics.OnIssue = func(issue graphics.TextureIssue) { reported = append(reported, issue) }
+
+	texture := graphics.NewTexture(nil, 32, 32, "enemy.png")
+	a, b := graphics.NewSprite(texture), graphics.NewSprite(texture)
+	_ = texture.Destroy()
+
+	issues := diagnostics.Issues()
+	if len(issues) != 1 || issues[0].Kind != graphics.TextureDestroyedInUse || issues[0].Sprites != 2 {
+		t.Fatalf("Expected 1 destroyed-in-use issue with 2 sprites, got %v", issues)
+	}
+	if len(reported) != 1 {
+		t.Errorf("Expected OnIssue called once, got %d", len(reported))
+	}
+	if !strings.Contains(issues[0].Destroyed, "TestTextureDiagnosticsDestroyedInUse") {
+		t.Errorf("Expected the destroy stack to name the test, got:\n%s", issues[0].Destroyed)
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(b)
+
+	// Sprites pointed elsewhere (or collected) don't count
+	other := graphics.NewTexture(nil, 8, 8, "other.png")
+	sprite := graphics.NewSprite(other)
+	sprite.Texture = nil
+	_ = other.Destroy()
+	if len(diagnostics.Issues()) != 1 {
+		t.Errorf("Expected no issue for an unreferenced texture, got %d issues", len(diagnostics.Issues()))
+	}
+}
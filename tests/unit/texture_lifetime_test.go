@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"errors"
+	"image"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+)
+
+// TestDrawSkipsDestroyedTexture tests that sprites with destroyed textures are skipped with one warning.
+func TestDrawSkipsDestroyedTexture(t *testing.T) {
+	drawn := 0
+	renderer := graphics.NewRecordingRenderer(func(call graphics.DrawCall) {
+		if call.Kind == graphics.DrawKindSprite {
+			drawn++
+		}
+	})
+	var warned []*graphics.Sprite
+	renderer.OnDestroyedTexture = func(sprite *graphics.Sprite) { warned = append(warned, sprite) }
+
+	texture := graphics.NewTexture(nil, 8, 8, "enemy.png")
+	sprite := graphics.NewSprite(texture)
+	camera := graphics.NewCamera()
+	transform := gamemath.Transform{Scale: gamemath.Vector2{X: 1, Y: 1}}
+
+	_ = renderer.DrawSprite(sprite, transform, camera)
+	if err := texture.Destroy(); err != nil {
+		t.Fatalf("Destroy failed: %v", err)
+	}
+	if err := texture.Destroy(); err != nil || !texture.Destroyed() {
+		t.Errorf("Expected a second Destroy to be a no-op, got %v", err)
+	}
+
+	for range 3 {
+		if err := renderer.DrawSprite(sprite, transform, camera); err != nil {
+			t.Fatalf("Expected destroyed texture skipped without error, got %v", err)
+		}
+	}
+	batch := graphics.NewSpriteBatch(renderer)
+	_ = batch.Draw(sprite, transform, camera)
+	_ = batch.Flush()
+
+	if drawn != 1 || renderer.DrawCalls() != 1 {
+		t.Errorf("Expected only the draw before Destroy, got %d draws", drawn)
+	}
+	if len(warned) != 1 || warned[0] != sprite {
+		t.Errorf("Expected one warning for the sprite, got %d", len(warned))
+	}
+	if err := texture.UpdateRegion(image.Rect(0, 0, 1, 1), make([]byte, 4)); !errors.Is(err, graphics.ErrTextureDestroyed) {
+		t.Errorf("Expected ErrTextureDestroyed, got %v", err)
+	}
+}
+
+// TestAssetCacheDropsDestroyedTexture tests that a cached texture destroyed directly is reloaded.
+func TestAssetCacheDropsDestroyedTexture(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	generate := func() image.Image { return graphics.SolidImage(2, 2, gamemath.White) }
+
+	first, err := assets.GenerateTexture("gen:white", generate)
+	if err != nil {
+		t.Fatalf("GenerateTexture failed: %v", err)
+	}
+	_ = first.Destroy() // Mistake: bypasses UnloadTexture
+
+	second, err := assets.GenerateTexture("gen:white", generate)
+	if err != nil {
+		t.Fatalf("GenerateTexture failed: %v", err)
+	}
+	if second == first || second.Destroyed() {
+		t.Error("Expected a fresh texture instead of the destroyed one")
+	}
+}
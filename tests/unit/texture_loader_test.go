@@ -0,0 +1,152 @@
+package unit
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// newSoftwareAssets creates an asset manager on a headless software renderer.
+func newSoftwareAssets(t *testing.T) *graphics.AssetManager {
+	t.Helper()
+	target, err := sdl.CreateRGBSurface(0, 64, 64, 32, 0x000000ff, 0x0000ff00, 0x00ff0000, 0xff000000)
+	if err != nil {
+		t.Fatalf("CreateRGBSurface failed: %v", err)
+	}
+	renderer, err := sdl.CreateSoftwareRenderer(target)
+	if err != nil {
+		t.Fatalf("CreateSoftwareRenderer failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = renderer.Destroy()
+		target.Free()
+	})
+	return graphics.NewAssetManager(renderer)
+}
+
+// encodePNG returns a solid-color PNG.
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i := range width * height {
+		img.Set(i%width, i/width, color.NRGBA{R: 200, A: 128})
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// waitForLoads processes uploads until no loads are pending.
+func waitForLoads(t *testing.T, assets *graphics.AssetManager) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for assets.PendingLoads() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected background loads to finish")
+		}
+		assets.ProcessLoads(0)
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestLoadTextureAsync tests background loads complete on ProcessLoads and share decodes.
+func TestLoadTextureAsync(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	defer assets.Destroy()
+	assets.Mount(fstest.MapFS{
+		"tiles/grass.png": {Data: encodePNG(t, 16, 16)},
+		"tiles/stone.png": {Data: encodePNG(t, 16, 16)},
+		"broken.png":      {Data: []byte("not a png")},
+	})
+
+	first := assets.LoadTextureAsync("tiles/grass.png")
+	second := assets.LoadTextureAsync("tiles/grass.png")
+	stone := assets.LoadTextureAsync("tiles/stone.png")
+	broken := assets.LoadTextureAsync("broken.png")
+	if first.Done() || assets.PendingLoads() != 3 {
+		t.Fatalf("Expected 3 pending loads before ProcessLoads, got %d", assets.PendingLoads())
+	}
+	waitForLoads(t, assets)
+
+	grass, err := first.Result()
+	if err != nil || grass == nil || grass.Width != 16 || grass.Height != 16 {
+		t.Fatalf("Expected a 16x16 texture, got %v (%v)", grass, err)
+	}
+	if shared, _ := second.Result(); shared != grass {
+		t.Error("Expected requests for one path to share a texture")
+	}
+	if texture, err := stone.Result(); err != nil || texture == nil {
+		t.Errorf("Expected the second file to load, got %v", err)
+	}
+	if _, err := broken.Result(); !broken.Done() || err == nil {
+		t.Error("Expected a decode error for the broken file")
+	}
+
+	// Two references: the texture survives one unload
+	assets.UnloadTexture("tiles/grass.png")
+	if cached, err := assets.LoadTexture("tiles/grass.png"); err != nil || cached != grass {
+		t.Errorf("Expected the cached texture after one unload, got %v (%v)", cached, err)
+	}
+}
+
+// TestLoadTextureWaitsForAsync tests a synchronous load finishes a pending background load.
+func TestLoadTextureWaitsForAsync(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	defer assets.Destroy()
+	assets.Mount(fstest.MapFS{"hero.png": {Data: encodePNG(t, 8, 4)}})
+
+	request := assets.LoadTextureAsync("hero.png")
+	texture, err := assets.LoadTexture("hero.png")
+	if err != nil {
+		t.Fatalf("LoadTexture failed: %v", err)
+	}
+	if !request.Done() || assets.PendingLoads() != 0 {
+		t.Error("Expected LoadTexture to complete the pending request")
+	}
+	if async, _ := request.Result(); async != texture || texture.Width != 8 || texture.Height != 4 {
+		t.Errorf("Expected one 8x4 texture, got %v and %v", async, texture)
+	}
+
+	// Limit uploads per call
+	assets.Mount(fstest.MapFS{"a.png": {Data: encodePNG(t, 2, 2)}, "b.png": {Data: encodePNG(t, 2, 2)}})
+	assets.LoadTextureAsync("a.png")
+	assets.LoadTextureAsync("b.png")
+	completed := 0
+	for deadline := time.Now().Add(5 * time.Second); completed == 0 && time.Now().Before(deadline); {
+		completed = assets.ProcessLoads(1)
+		time.Sleep(time.Millisecond)
+	}
+	if completed != 1 || assets.PendingLoads() != 1 {
+		t.Errorf("Expected 1 upload with 1 pending, got %d and %d", completed, assets.PendingLoads())
+	}
+}
+
+// TestLoadSurface tests decoding an image into a caller-owned surface.
+func TestLoadSurface(t *testing.T) {
+	assets := newSoftwareAssets(t)
+	assets.Mount(fstest.MapFS{"icon.png": {Data: encodePNG(t, 6, 3)}})
+
+	surface, err := assets.LoadSurface("icon.png")
+	if err != nil {
+		t.Fatalf("LoadSurface failed: %v", err)
+	}
+	defer surface.Free()
+	if surface.W != 6 || surface.H != 3 {
+		t.Errorf("Expected 6x3 surface, got %dx%d", surface.W, surface.H)
+	}
+	if pixels := surface.Pixels(); pixels[0] != 200 || pixels[3] != 128 {
+		t.Errorf("Expected straight-alpha pixels (200, 0, 0, 128), got %v", pixels[:4])
+	}
+	if _, err := assets.LoadSurface("missing.png"); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
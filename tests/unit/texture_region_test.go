@@ -0,0 +1,75 @@
+package unit
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// TestTextureUpdateRegion tests that region updates change only the region.
+func TestTextureUpdateRegion(t *testing.T) {
+	target, err := sdl.CreateRGBSurface(0, 4, 4, 32, 0x000000ff, 0x0000ff00, 0x00ff0000, 0xff000000)
+	if err != nil {
+		t.Fatalf("CreateRGBSurface failed: %v", err)
+	}
+	defer target.Free()
+	sdlRenderer, err := sdl.CreateSoftwareRenderer(target)
+	if err != nil {
+		t.Fatalf("CreateSoftwareRenderer failed: %v", err)
+	}
+	defer func() { _ = sdlRenderer.Destroy() }()
+	renderer := graphics.NewRenderer(sdlRenderer)
+
+	texture, err := renderer.NewStreamingTexture(4, 4)
+	if err != nil {
+		t.Fatalf("NewStreamingTexture failed: %v", err)
+	}
+	defer func() { _ = texture.Destroy() }()
+	_ = texture.GetSDLTexture().SetBlendMode(sdl.BLENDMODE_NONE)
+
+	// Fill the whole texture blue, then paint a red 2x1 region
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for i := range 16 {
+		img.SetRGBA(i%4, i/4, color.RGBA{B: 255, A: 255})
+	}
+	if err := texture.UpdateFromImage(img, img.Rect); err != nil {
+		t.Fatalf("UpdateFromImage failed: %v", err)
+	}
+	red := []byte{255, 0, 0, 255, 255, 0, 0, 255}
+	if err := texture.UpdateRegion(image.Rect(1, 2, 3, 3), red); err != nil {
+		t.Fatalf("UpdateRegion failed: %v", err)
+	}
+
+	if err := sdlRenderer.Copy(texture.GetSDLTexture(), nil, nil); err != nil {
+		t.Fatalf("Copy failed: %v", err)
+	}
+	pixels := target.Pixels()
+	pixelAt := func(x, y int) [4]byte {
+		i := y*int(target.Pitch) + x*4
+		return [4]byte(pixels[i : i+4])
+	}
+	if got := pixelAt(1, 2); got != [4]byte{255, 0, 0, 255} {
+		t.Errorf("Expected red inside the region, got %v", got)
+	}
+	if got := pixelAt(2, 2); got != [4]byte{255, 0, 0, 255} {
+		t.Errorf("Expected red inside the region, got %v", got)
+	}
+	if got := pixelAt(0, 2); got != [4]byte{0, 0, 255, 255} {
+		t.Errorf("Expected blue outside the region, got %v", got)
+	}
+	if got := pixelAt(1, 1); got != [4]byte{0, 0, 255, 255} {
+		t.Errorf("Expected blue outside the region, got %v", got)
+	}
+
+	// Invalid regions are rejected before touching SDL
+	if err := texture.UpdateRegion(image.Rect(3, 3, 5, 4), red); !errors.Is(err, graphics.ErrInvalidRegion) {
+		t.Errorf("Expected ErrInvalidRegion for a region outside the texture, got %v", err)
+	}
+	if err := texture.UpdateRegion(image.Rect(0, 0, 2, 2), red); !errors.Is(err, graphics.ErrInvalidRegion) {
+		t.Errorf("Expected ErrInvalidRegion for short pixel data, got %v", err)
+	}
+}
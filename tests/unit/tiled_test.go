@@ -0,0 +1,148 @@
+package unit
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/tilemap"
+)
+
+// writeTiledFile writes a file into dir and returns its path.
+func writeTiledFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+// TestLoadTMX tests external tilesets, CSV data, flip flags, groups, and objects.
+func TestLoadTMX(t *testing.T) {
+	dir := t.TempDir()
+	writeTiledFile(t, dir, "terrain.tsx", `<?xml version="1.0"?>
+<tileset name="terrain" tilewidth="16" tileheight="16" tilecount="8" columns="4">
+  <image source="terrain.png" width="64" height="32"/>
+</tileset>`)
+	path := writeTiledFile(t, dir, "level.tmx", `<?xml version="1.0"?>
+<map orientation="orthogonal" width="4" height="3" tilewidth="16" tileheight="16" infinite="0">
+  <properties><property name="music" value="forest.ogg"/></properties>
+  <tileset firstgid="1" source="terrain.tsx"/>
+  <layer name="ground" width="4" height="3">
+    <data encoding="csv">
+0,0,0,0,
+0,2147483651,0,0,
+1,1,1,1
+</data>
+  </layer>
+  <group name="hidden" visible="0" offsetx="8">
+    <layer name="collision" width="4" height="3">
+      <properties><property name="note" value="walls"/></properties>
+      <data><tile gid="0"/><tile gid="0"/><tile gid="0"/><tile gid="0"/>
+            <tile gid="0"/><tile gid="0"/><tile gid="0"/><tile gid="0"/>
+            <tile gid="5"/><tile gid="5"/><tile gid="5"/><tile gid="5"/></data>
+    </layer>
+  </group>
+  <objectgroup name="entities">
+    <object id="7" name="door" type="trigger" x="16" y="0" width="16" height="32"/>
+    <object id="8" class="chest" gid="2" x="32" y="48" width="16" height="16">
+      <properties><property name="loot" type="int" value="50"/></properties>
+    </object>
+  </objectgroup>
+</map>`)
+
+	level, err := tilemap.Load(path, nil)
+	if err != nil {
+		t.Fatalf("Expected TMX to load, got %v", err)
+	}
+	if level.Properties["music"] != "forest.ogg" || len(level.Tilesets) != 1 {
+		t.Fatalf("Expected map properties and one tileset, got %v, %d", level.Properties, len(level.Tilesets))
+	}
+	if texture := level.Tilesets[0].Texture; texture.Path != filepath.Join(dir, "terrain.png") || texture.Width != 64 {
+		t.Errorf("Expected tileset image resolved next to the tsx, got %s %d", texture.Path, texture.Width)
+	}
+
+	ground := level.Layer("ground")
+	if gid := ground.GetTile(1, 1); gid.ID() != 3 || gid&tilemap.FlipHorizontal == 0 {
+		t.Errorf("Expected flipped tile 3, got %#x", uint32(gid))
+	}
+	collision := level.Layer("collision")
+	if collision == nil || !collision.Collision || collision.Visible || collision.Offset.X != 8 {
+		t.Fatal("Expected group child layer marked collision, hidden, and offset")
+	}
+	if collision.Properties["note"] != "walls" || !level.Solid(2, 2) || level.Solid(2, 1) {
+		t.Error("Expected collision layer properties and solid bottom row")
+	}
+
+	entities := level.ObjectLayer("entities")
+	if entities == nil || len(entities.Objects) != 2 {
+		t.Fatal("Expected object layer with two objects")
+	}
+	chest := entities.Objects[1]
+	if chest.Class != "chest" || chest.Properties["loot"] != "50" || chest.Center().Y != 40 {
+		t.Errorf("Expected tile object anchored bottom-left with properties, got %+v", chest)
+	}
+
+	scene := core.NewScene()
+	spawned := level.SpawnObjects(scene, func(object *tilemap.Object, _ *core.Entity) bool { return object.Class != "trigger" })
+	if len(spawned) != 1 || spawned[8] == nil || spawned[8].Sprite == nil {
+		t.Errorf("Expected only the chest spawned with a sprite, got %d entities", len(spawned))
+	}
+	if walls := level.BuildColliders(scene); len(walls) != 1 || walls[0].Collider.Bounds.Width != 64 {
+		t.Errorf("Expected the solid row merged into one 64px collider, got %d", len(walls))
+	}
+}
+
+// TestLoadTiledJSON tests compressed base64 data and unsupported maps.
+func TestLoadTiledJSON(t *testing.T) {
+	var raw bytes.Buffer
+	for _, gid := range []uint32{1, 2, 0, 4, 4, 4} {
+		_ = binary.Write(&raw, binary.LittleEndian, gid)
+	}
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	_, _ = zw.Write(raw.Bytes())
+	_ = zw.Close()
+	data := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+	dir := t.TempDir()
+	path := writeTiledFile(t, dir, "level.tmj", fmt.Sprintf(`{
+  "orientation": "orthogonal", "width": 3, "height": 2, "tilewidth": 8, "tileheight": 8, "infinite": false,
+  "tilesets": [{"firstgid": 1, "name": "tiles", "tilewidth": 8, "tileheight": 8, "tilecount": 4, "columns": 2,
+                "image": "tiles.png", "imagewidth": 16, "imageheight": 16}],
+  "layers": [
+    {"type": "tilelayer", "name": "walls", "encoding": "base64", "compression": "zlib", "data": %q,
+     "properties": [{"name": "collision", "type": "bool", "value": true}]},
+    {"type": "objectgroup", "name": "spawns", "objects": [{"id": 1, "name": "start", "x": 4, "y": 4, "point": true}]}
+  ]
+}`, data))
+
+	level, err := tilemap.Load(path, nil)
+	if err != nil {
+		t.Fatalf("Expected JSON map to load, got %v", err)
+	}
+	walls := level.Layer("walls")
+	if walls.GetTile(1, 0) != 2 || walls.GetTile(2, 1) != 4 || !walls.Collision {
+		t.Errorf("Expected decoded tiles and collision property, got %v", walls.Tiles)
+	}
+	if rects := level.SolidRects(); len(rects) != 2 {
+		t.Errorf("Expected two solid rectangles, got %d", len(rects))
+	}
+	if start := level.ObjectLayer("spawns").Objects[0]; !start.Point || start.Name != "start" {
+		t.Errorf("Expected point object, got %+v", start)
+	}
+
+	_, err = tilemap.DecodeTiledJSON(strings.NewReader(`{"orientation": "isometric", "width": 1, "height": 1, "tilewidth": 8, "tileheight": 8}`), dir, nil)
+	if !errors.Is(err, tilemap.ErrUnsupportedMap) {
+		t.Errorf("Expected ErrUnsupportedMap for isometric maps, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/tilemap"
+)
+
+// TestChunkCacheBakesVisibleAndDirtyChunks tests lazy baking and re-baking after edits.
+func TestChunkCacheBakesVisibleAndDirtyChunks(t *testing.T) {
+	level := tilemap.NewMap(128, 128, 16, 16) // 512px chunks
+	layer := level.AddLayer("background")
+	chunks := tilemap.NewChunkCache(level)
+	defer chunks.Destroy()
+
+	camera := graphics.NewCamera()
+	camera.Position = gamemath.Vector2{X: 400, Y: 300} // View covers 0-800 x 0-600
+
+	// Empty chunks are recorded without a renderer or texture
+	if err := chunks.Render(nil, camera); err != nil {
+		t.Fatalf("Expected empty chunks to render, got %v", err)
+	}
+	if chunks.Baked() != 4 {
+		t.Fatalf("Expected 4 visible chunks baked, got %d", chunks.Baked())
+	}
+
+	_ = chunks.Render(nil, camera)
+	if chunks.Baked() != 4 {
+		t.Errorf("Expected cached chunks to be reused, got %d bakes", chunks.Baked())
+	}
+
+	layer.SetTile(40, 5, 0) // Chunk (1,0)
+	_ = chunks.Render(nil, camera)
+	if chunks.Baked() != 5 {
+		t.Errorf("Expected only the edited chunk to re-bake, got %d bakes", chunks.Baked())
+	}
+
+	chunks.Invalidate(layer, 0, 0, 1, 1)
+	camera.Position = gamemath.Vector2{X: 2000, Y: 300} // Last chunk column only
+	_ = chunks.Render(nil, camera)
+	if chunks.Baked() != 7 {
+		t.Errorf("Expected newly visible chunks baked and off-screen dirty chunk deferred, got %d bakes", chunks.Baked())
+	}
+}
@@ -0,0 +1,59 @@
+package unit
+
+import (
+	"testing"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/tilemap"
+)
+
+// TestTilemapSetTileUpdates tests collision, autotile, dirty chunks, and change callbacks.
+func TestTilemapSetTileUpdates(t *testing.T) {
+	level := tilemap.NewMap(64, 8, 16, 16)
+	dirt := tilemap.NewTerrain4("dirt", 1)
+	level.AddTerrain(dirt)
+	ground := level.AddLayer("ground")
+	ground.Collision = true
+
+	var changes int
+	level.OnTileChanged = func(layer *tilemap.Layer, x, y int, old, tile tilemap.GID) { changes++ }
+
+	ground.FillRect(0, 4, 64, 4, dirt.Default)
+	// Variant = 1 + edge bits (north 1, east 2, south 4, west 8)
+	if ground.GetTile(10, 4) != 15 || ground.GetTile(10, 5) != 16 {
+		t.Errorf("Expected surface 15 and interior 16 after fill, got %d, %d", ground.GetTile(10, 4), ground.GetTile(10, 5))
+	}
+	if !level.SolidAt(10*16+8, 4*16+1) || level.Solid(10, 3) {
+		t.Error("Expected filled cells solid and air cells open")
+	}
+	if len(ground.DirtyChunks()) != 2 {
+		t.Errorf("Expected both chunks dirty after a full-width fill, got %d", len(ground.DirtyChunks()))
+	}
+	ground.ClearDirty()
+
+	if !ground.SetTile(40, 4, 0) {
+		t.Fatal("Expected in-bounds SetTile to succeed")
+	}
+	if level.Solid(40, 4) {
+		t.Error("Expected dug cell to stop colliding")
+	}
+	if ground.GetTile(40, 5) != 15 {
+		t.Errorf("Expected cell below the hole to become a surface tile, got %d", ground.GetTile(40, 5))
+	}
+	if dirty := ground.DirtyChunks(); len(dirty) != 1 || dirty[0] != (tilemap.Chunk{X: 1, Y: 0}) {
+		t.Errorf("Expected only chunk (1,0) dirty, got %v", dirty)
+	}
+	if changes != 1 {
+		t.Errorf("Expected one change callback, got %d", changes)
+	}
+
+	if ground.SetTile(-1, 0, 1) || ground.GetTile(99, 99) != 0 {
+		t.Error("Expected out-of-bounds cells to be ignored")
+	}
+	if !level.Overlaps(gamemath.Rectangle{X: 0, Y: 60, Width: 8, Height: 8}) {
+		t.Error("Expected rectangle reaching into the ground to overlap")
+	}
+	if level.Overlaps(gamemath.Rectangle{X: 0, Y: 48, Width: 8, Height: 16}) {
+		t.Error("Expected rectangle ending on the ground surface not to overlap")
+	}
+}
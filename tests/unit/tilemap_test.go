@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/tilemap"
+)
+
+// TestTiledFlipFlags tests GID flag decoding into SDL flips and rotations.
+func TestTiledFlipFlags(t *testing.T) {
+	cases := []struct {
+		name     string
+		flags    tilemap.GID
+		rotation float64
+		flipH    bool
+		flipV    bool
+	}{
+		{"none", 0, 0, false, false},
+		{"horizontal", tilemap.FlipHorizontal, 0, true, false},
+		{"vertical", tilemap.FlipVertical, 0, false, true},
+		{"rotate 180", tilemap.FlipHorizontal | tilemap.FlipVertical, 0, true, true},
+		{"rotate 90 clockwise", tilemap.FlipDiagonal | tilemap.FlipHorizontal, 90, false, false},
+		{"rotate 90 counterclockwise", tilemap.FlipDiagonal | tilemap.FlipVertical, -90, false, false},
+		{"transpose", tilemap.FlipDiagonal, 90, false, true},
+		{"anti-transpose", tilemap.FlipDiagonal | tilemap.FlipHorizontal | tilemap.FlipVertical, 90, true, false},
+	}
+	for _, tc := range cases {
+		gid := 42 | tc.flags
+		if gid.ID() != 42 {
+			t.Errorf("%s: expected ID 42, got %d", tc.name, gid.ID())
+		}
+		rotation, flipH, flipV := gid.Orientation()
+		if rotation != tc.rotation || flipH != tc.flipH || flipV != tc.flipV {
+			t.Errorf("%s: expected (%v, %v, %v), got (%v, %v, %v)", tc.name, tc.rotation, tc.flipH, tc.flipV, rotation, flipH, flipV)
+		}
+	}
+}
+
+// TestTilesetLookup tests tileset selection by GID and source rects with margin and spacing.
+func TestTilesetLookup(t *testing.T) {
+	level := tilemap.NewMap(4, 4, 16, 16)
+	terrain := tilemap.NewTileset("terrain", 1, graphics.NewTexture(nil, 2+4*16+3*1, 2+2*16+1, "terrain.png"), 16, 16)
+	terrain.Margin, terrain.Spacing = 1, 1
+	props := tilemap.NewTileset("props", 9, graphics.NewTexture(nil, 32, 32, "props.png"), 16, 16)
+	level.AddTileset(terrain)
+	level.AddTileset(props)
+
+	if terrain.Count() != 8 {
+		t.Fatalf("Expected 8 terrain tiles, got %d", terrain.Count())
+	}
+	if got := level.TilesetFor((6 | tilemap.FlipVertical).ID()); got != terrain {
+		t.Errorf("Expected GID 6 in terrain, got %v", got)
+	}
+	if got := level.TilesetFor(10); got != props {
+		t.Errorf("Expected GID 10 in props, got %v", got)
+	}
+	if got := level.TilesetFor(13); got != nil {
+		t.Errorf("Expected GID 13 out of range, got %v", got)
+	}
+
+	rect := terrain.SourceRect(6) // Local index 5: column 1, row 1
+	if rect.X != 18 || rect.Y != 18 || rect.Width != 16 || rect.Height != 16 {
+		t.Errorf("Expected source rect at (18, 18), got %+v", rect)
+	}
+}
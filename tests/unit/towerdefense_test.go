@@ -0,0 +1,157 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/towerdefense"
+)
+
+// newCorridorGrid creates a 5x3 grid with spawn on the left and goal on the right.
+func newCorridorGrid() *towerdefense.Grid {
+	grid := towerdefense.NewGrid(5, 3, 10, gamemath.Vector2{})
+	grid.Spawn = towerdefense.Cell{X: 0, Y: 1}
+	grid.Goal = towerdefense.Cell{X: 4, Y: 1}
+	return grid
+}
+
+// newCreepAt creates a creep entity at a position.
+func newCreepAt(x, y, health float64) *towerdefense.Creep {
+	entity := &core.Entity{Active: true, Transform: gamemath.Transform{
+		Position: gamemath.Vector2{X: x, Y: y},
+		Scale:    gamemath.Vector2{X: 1, Y: 1},
+	}}
+	return towerdefense.NewCreep(entity, health, 10)
+}
+
+// TestGridPlacementKeepsPathOpen tests that placements sealing the path are rejected.
+func TestGridPlacementKeepsPathOpen(t *testing.T) {
+	grid := newCorridorGrid()
+	if len(grid.Path()) != 5 {
+		t.Fatalf("Expected straight 5-cell path, got %v", grid.Path())
+	}
+
+	wall := []towerdefense.Cell{{X: 2, Y: 0}, {X: 2, Y: 1}}
+	for _, cell := range wall {
+		if err := grid.Build(cell, &towerdefense.Tower{}); err != nil {
+			t.Fatalf("Expected %v to be buildable, got %v", cell, err)
+		}
+	}
+	if len(grid.Path()) != 7 {
+		t.Errorf("Expected path to detour around towers (7 cells), got %d", len(grid.Path()))
+	}
+
+	if err := grid.CanBuild(towerdefense.Cell{X: 2, Y: 2}); !errors.Is(err, towerdefense.ErrBlocksPath) {
+		t.Errorf("Expected ErrBlocksPath, got %v", err)
+	}
+	if err := grid.CanBuild(towerdefense.Cell{X: 2, Y: 1}); !errors.Is(err, towerdefense.ErrOccupied) {
+		t.Errorf("Expected ErrOccupied, got %v", err)
+	}
+	if err := grid.CanBuild(grid.Goal); !errors.Is(err, towerdefense.ErrNotBuildable) {
+		t.Errorf("Expected goal to be unbuildable, got %v", err)
+	}
+	if err := grid.CanBuild(towerdefense.Cell{X: 9, Y: 9}); !errors.Is(err, towerdefense.ErrOutOfBounds) {
+		t.Errorf("Expected ErrOutOfBounds, got %v", err)
+	}
+
+	version := grid.Version()
+	if grid.Remove(towerdefense.Cell{X: 2, Y: 1}) == nil {
+		t.Error("Expected removed tower to be returned")
+	}
+	if grid.Version() == version || len(grid.Path()) != 5 {
+		t.Error("Expected removal to reopen the straight path")
+	}
+}
+
+// TestTowerTargetingStrategies tests first, closest, and strongest target selection.
+func TestTowerTargetingStrategies(t *testing.T) {
+	path := []gamemath.Vector2{{X: 100, Y: 0}}
+	near := newCreepAt(10, 0, 50) // Closest to the tower, furthest from the goal
+	ahead := newCreepAt(60, 0, 20)
+	tank := newCreepAt(40, 0, 200)
+	outOfRange := newCreepAt(95, 0, 500)
+	creeps := []*towerdefense.Creep{near, ahead, tank, outOfRange}
+	for _, creep := range creeps {
+		creep.SetPath(path)
+	}
+
+	towerEntity := &core.Entity{Active: true}
+	tower := towerdefense.NewTower(towerEntity, 70, 1, nil)
+
+	cases := []struct {
+		strategy towerdefense.TargetStrategy
+		want     *towerdefense.Creep
+	}{
+		{towerdefense.TargetFirst, ahead},
+		{towerdefense.TargetLast, near},
+		{towerdefense.TargetClosest, near},
+		{towerdefense.TargetStrongest, tank},
+	}
+	for _, tc := range cases {
+		tower.Strategy = tc.strategy
+		if got := tower.SelectTarget(creeps); got != tc.want {
+			t.Errorf("Expected strategy %d to pick creep at %v, got %v", tc.strategy, tc.want.Entity().Transform.Position, got)
+		}
+	}
+}
+
+// TestTowerFiresOnCooldown tests hitscan damage and fire rate.
+func TestTowerFiresOnCooldown(t *testing.T) {
+	creep := newCreepAt(20, 0, 25)
+	towerEntity := &core.Entity{Active: true}
+	tower := towerdefense.NewTower(towerEntity, 50, 2, func() []*towerdefense.Creep {
+		return []*towerdefense.Creep{creep}
+	})
+
+	for i := 0; i < 7; i++ {
+		tower.Update(towerEntity, 0.1) // 2 shots/s: fires on the first update and again once 0.5s has elapsed
+	}
+	if creep.Health != 5 {
+		t.Errorf("Expected two hits of 10 damage, got health %f", creep.Health)
+	}
+	if towerEntity.Transform.Rotation != 0 {
+		t.Errorf("Expected tower to face +X, got %f", towerEntity.Transform.Rotation)
+	}
+}
+
+// TestWaveSpawnerSpawnsAndClears tests spawning along the path, leaking, and wave callbacks.
+func TestWaveSpawnerSpawnsAndClears(t *testing.T) {
+	scene := core.NewScene()
+	grid := newCorridorGrid()
+	spawnCreep := func() *towerdefense.Creep {
+		creep := newCreepAt(0, 0, 10)
+		creep.Speed = 100
+		return creep
+	}
+	spawner := towerdefense.NewWaveSpawner(scene, grid, towerdefense.Wave{
+		Groups: []towerdefense.Group{{Count: 2, Interval: 0.5, Spawn: spawnCreep}},
+	})
+	scene.AddEntity(&core.Entity{Active: true, Behavior: spawner})
+
+	leaks, cleared := 0, -1
+	spawner.OnLeak = func(*towerdefense.Creep) { leaks++ }
+	spawner.OnWaveCleared = func(wave int) { cleared = wave }
+
+	if !spawner.StartNextWave() {
+		t.Fatal("Expected first wave to start")
+	}
+	scene.Update(0.1)
+	if len(spawner.Creeps()) != 1 {
+		t.Fatalf("Expected 1 creep after first spawn, got %d", len(spawner.Creeps()))
+	}
+	if got := spawner.Creeps()[0].Entity().Transform.Position; got.Y != 15 {
+		t.Errorf("Expected creep to follow the path row (y=15), got %v", got)
+	}
+
+	for i := 0; i < 20 && spawner.Active(); i++ {
+		scene.Update(0.1)
+	}
+	if leaks != 2 || cleared != 0 {
+		t.Errorf("Expected 2 leaks and wave 0 cleared, got %d leaks, cleared %d", leaks, cleared)
+	}
+	if !spawner.Finished() || spawner.StartNextWave() {
+		t.Error("Expected spawner to be finished with no waves left")
+	}
+}
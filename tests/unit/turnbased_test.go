@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/turnbased"
+)
+
+// testAction is a configurable turnbased.Action.
+type testAction struct {
+	cost     int
+	invalid  error
+	executed int
+}
+
+func (a *testAction) Cost() int                           { return a.cost }
+func (a *testAction) Validate(_ *turnbased.Context) error { return a.invalid }
+func (a *testAction) Execute(_ *turnbased.Context) error  { a.executed++; return nil }
+
+// TestTurnManagerInitiativeOrder tests initiative ordering and rounds.
+func TestTurnManagerInitiativeOrder(t *testing.T) {
+	tm := turnbased.NewTurnManager()
+	slow := &turnbased.Participant{Name: "slow", Initiative: 1, MaxActionPoints: 2}
+	fast := &turnbased.Participant{Name: "fast", Initiative: 10, MaxActionPoints: 2}
+	tm.Add(slow)
+	tm.Add(fast)
+
+	rounds := 0
+	tm.OnRoundStart = func(int) { rounds++ }
+	tm.Start()
+
+	if tm.Current() != fast {
+		t.Fatalf("Expected fast participant first, got %s", tm.Current().Name)
+	}
+	tm.EndTurn()
+	if tm.Current() != slow {
+		t.Fatalf("Expected slow participant second, got %s", tm.Current().Name)
+	}
+	tm.EndTurn()
+	if tm.Current() != fast || tm.Round() != 2 || rounds != 2 {
+		t.Errorf("Expected round 2 to start with fast, got round %d", tm.Round())
+	}
+}
+
+// TestTurnManagerActionPoints tests action validation and point spending.
+func TestTurnManagerActionPoints(t *testing.T) {
+	tm := turnbased.NewTurnManager()
+	actor := &turnbased.Participant{Name: "hero", MaxActionPoints: 3}
+	tm.Add(actor)
+
+	if err := tm.Perform(&testAction{cost: 1}); !errors.Is(err, turnbased.ErrNoActiveTurn) {
+		t.Errorf("Expected ErrNoActiveTurn before Start, got %v", err)
+	}
+
+	tm.Start()
+	move := &testAction{cost: 2}
+	if err := tm.Perform(move); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if actor.ActionPoints != 1 || move.executed != 1 {
+		t.Errorf("Expected 1 AP left and one execution, got %d AP", actor.ActionPoints)
+	}
+
+	if err := tm.Perform(&testAction{cost: 2}); !errors.Is(err, turnbased.ErrNotEnoughActionPoints) {
+		t.Errorf("Expected ErrNotEnoughActionPoints, got %v", err)
+	}
+
+	errBlocked := errors.New("blocked")
+	invalid := &testAction{cost: 1, invalid: errBlocked}
+	if err := tm.Perform(invalid); !errors.Is(err, errBlocked) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if invalid.executed != 0 || actor.ActionPoints != 1 {
+		t.Error("Expected invalid action to not execute or spend points")
+	}
+
+	tm.EndTurn()
+	if actor.ActionPoints != 3 {
+		t.Errorf("Expected action points restored, got %d", actor.ActionPoints)
+	}
+}
+
+// TestTurnManagerRemoveCurrent tests removing the acting participant.
+func TestTurnManagerRemoveCurrent(t *testing.T) {
+	tm := turnbased.NewTurnManager()
+	a := &turnbased.Participant{Name: "a", Initiative: 3}
+	b := &turnbased.Participant{Name: "b", Initiative: 2}
+	c := &turnbased.Participant{Name: "c", Initiative: 1}
+	tm.Add(a)
+	tm.Add(b)
+	tm.Add(c)
+	tm.Start()
+	tm.EndTurn() // b's turn
+
+	tm.Remove(b)
+	if tm.Current() != c {
+		t.Errorf("Expected turn to pass to c, got %v", tm.Current())
+	}
+	if len(tm.Participants()) != 2 {
+		t.Errorf("Expected 2 participants, got %d", len(tm.Participants()))
+	}
+}
@@ -0,0 +1,113 @@
+package unit
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dshills/gogame/engine/glyphs"
+	"github.com/dshills/gogame/engine/input"
+	"github.com/dshills/gogame/engine/tutorial"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// TestTutorialConditionsAndCompletion tests gating, priority, and action completion.
+func TestTutorialConditionsAndCompletion(t *testing.T) {
+	im := input.NewInputManager()
+	im.BindAction(input.ActionJump, input.KeySpace)
+	tut := tutorial.New(im)
+	tut.Prompts = glyphs.NewService(im)
+
+	nearLadder := false
+	tut.Add(&tutorial.Hint{ID: "jump", Text: "Press {jump} to jump", Priority: 1, When: func() bool { return nearLadder }, CompleteOn: input.ActionJump})
+	tut.Add(&tutorial.Hint{ID: "dash", Text: "Try dashing", Priority: 5, Failures: 2})
+
+	tut.Update(nil, 0.1)
+	if tut.Current() != nil {
+		t.Fatalf("Expected no hint before conditions hold, got %q", tut.Current().ID)
+	}
+
+	nearLadder = true
+	tut.Update(nil, 0.1)
+	if tut.Current() == nil || tut.Text() != "Press [Space] to jump" {
+		t.Fatalf("Expected jump hint with expanded prompt, got %q", tut.Text())
+	}
+
+	nearLadder = false
+	tut.Update(nil, 0.1)
+	if tut.Current() != nil || tut.Completed("jump") {
+		t.Error("Expected hint hidden but not completed when condition lapses")
+	}
+
+	nearLadder = true
+	tut.Update(nil, 0.1)
+	im.ProcessKeyEvent(&sdl.KeyboardEvent{State: sdl.PRESSED, Keysym: sdl.Keysym{Scancode: sdl.SCANCODE_SPACE}})
+	tut.Update(nil, 0.1)
+	if !tut.Completed("jump") || tut.Current() != nil {
+		t.Error("Expected jump hint completed by pressing its action")
+	}
+
+	tut.Fail("dash")
+	tut.Update(nil, 0.1)
+	if tut.Current() != nil {
+		t.Error("Expected dash hint to wait for two failures")
+	}
+	tut.Fail("dash")
+	tut.Update(nil, 0.1)
+	if tut.Current() == nil || tut.Current().ID != "dash" {
+		t.Error("Expected dash hint after two failures")
+	}
+}
+
+// TestTutorialDurationCooldown tests auto-dismiss and cooldown before reshowing.
+func TestTutorialDurationCooldown(t *testing.T) {
+	tut := tutorial.New(nil)
+	tut.Add(&tutorial.Hint{ID: "map", Text: "Open the map", Duration: 1, Cooldown: 2})
+
+	tut.Update(nil, 0)
+	tut.Update(nil, 1.1)
+	if tut.Current() != nil {
+		t.Fatal("Expected hint dismissed after its duration")
+	}
+	tut.Update(nil, 1)
+	if tut.Current() != nil {
+		t.Error("Expected hint to stay hidden during cooldown")
+	}
+	tut.Update(nil, 1.5)
+	if tut.Current() == nil {
+		t.Error("Expected hint to return after cooldown")
+	}
+}
+
+// TestTutorialProgressPersistence tests saving and loading completion state.
+func TestTutorialProgressPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tutorial.json")
+
+	tut := tutorial.New(nil)
+	if err := tut.LoadProgress(path); err != nil {
+		t.Fatalf("Expected missing progress file to be ignored, got %v", err)
+	}
+	tut.Complete("intro")
+	tut.Fail("pit")
+	if err := tut.SaveProgress(path); err != nil {
+		t.Fatalf("Expected save to succeed, got %v", err)
+	}
+
+	restored := tutorial.New(nil)
+	restored.Add(&tutorial.Hint{ID: "intro", Text: "Welcome"})
+	if err := restored.LoadProgress(path); err != nil {
+		t.Fatalf("Expected load to succeed, got %v", err)
+	}
+	restored.Update(nil, 0.1)
+	if restored.Current() != nil {
+		t.Error("Expected completed hint to stay hidden after reload")
+	}
+	if restored.Progress().Failures["pit"] != 1 {
+		t.Errorf("Expected failure count 1, got %d", restored.Progress().Failures["pit"])
+	}
+
+	restored.Reset()
+	restored.Update(nil, 0.1)
+	if restored.Current() == nil {
+		t.Error("Expected hint to show again after Reset")
+	}
+}
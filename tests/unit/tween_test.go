@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/tween"
+)
+
+// TestEasingEndpoints tests every easing starts at 0 and ends at 1.
+func TestEasingEndpoints(t *testing.T) {
+	easings := map[string]tween.Easing{
+		"Linear": tween.Linear, "QuadIn": tween.QuadIn, "QuadOut": tween.QuadOut, "QuadInOut": tween.QuadInOut,
+		"CubicIn": tween.CubicIn, "CubicOut": tween.CubicOut, "CubicInOut": tween.CubicInOut,
+		"ElasticIn": tween.ElasticIn, "ElasticOut": tween.ElasticOut, "ElasticInOut": tween.ElasticInOut,
+		"BounceIn": tween.BounceIn, "BounceOut": tween.BounceOut, "BounceInOut": tween.BounceInOut,
+		"Curve": tween.Curve(gamemath.EaseInOutCurve(0, 0, 2, 1)),
+	}
+	for name, easing := range easings {
+		if !almostEqual(easing(0), 0, 1e-9) || !almostEqual(easing(1), 1, 1e-9) {
+			t.Errorf("%s: expected 0 and 1 at the ends, got %v and %v", name, easing(0), easing(1))
+		}
+	}
+	if !almostEqual(tween.QuadIn(0.5), 0.25, 1e-9) || !almostEqual(tween.CubicOut(0.5), 0.875, 1e-9) {
+		t.Errorf("Expected QuadIn(0.5)=0.25 and CubicOut(0.5)=0.875, got %v and %v", tween.QuadIn(0.5), tween.CubicOut(0.5))
+	}
+	if tween.ElasticOut(0.2) <= 1 {
+		t.Errorf("Expected ElasticOut to overshoot, got %v", tween.ElasticOut(0.2))
+	}
+}
+
+// TestTweenFloatAndColor tests values move with easing and land exactly on the target.
+func TestTweenFloatAndColor(t *testing.T) {
+	m := tween.New()
+	x := 10.0
+	color := gamemath.Color{R: 0, G: 0, B: 0, A: 255}
+	completed := false
+	m.Tween(&x, 20, time.Second, tween.Linear).OnComplete(func() { completed = true })
+	m.Start(tween.Alpha(&color, 0, time.Second, tween.Linear).Delay(500 * time.Millisecond))
+
+	m.Tick(0.5)
+	if !almostEqual(x, 15, 1e-9) || color.A != 255 {
+		t.Errorf("Expected x=15 and alpha still 255 during the delay, got %v and %d", x, color.A)
+	}
+	m.Tick(0.5)
+	if x != 20 || !completed {
+		t.Errorf("Expected x=20 and completion, got %v (completed %v)", x, completed)
+	}
+	if color.A != 128 {
+		t.Errorf("Expected alpha halfway at 128, got %d", color.A)
+	}
+	m.Tick(1)
+	if color.A != 0 || m.Len() != 0 {
+		t.Errorf("Expected alpha 0 and no running tweens, got %d and %d", color.A, m.Len())
+	}
+}
+
+// TestTweenSequence tests sequenced tweens read their start when they begin.
+func TestTweenSequence(t *testing.T) {
+	m := tween.New()
+	pos := gamemath.Vector2{}
+	calls := 0
+	first := m.Sequence(
+		tween.Vector(&pos, gamemath.Vector2{X: 100}, time.Second, tween.QuadOut),
+		tween.Wait(time.Second),
+		tween.Call(func() { calls++ }),
+		tween.Vector(&pos, gamemath.Vector2{X: 100, Y: 50}, time.Second, tween.Linear),
+	)
+
+	m.Tick(1.5) // Half a second into the wait
+	if pos.X != 100 || pos.Y != 0 || calls != 0 {
+		t.Errorf("Expected (100, 0) during the wait, got %+v (calls %d)", pos, calls)
+	}
+	m.Tick(1.0) // Through the call and half the last move
+	if calls != 1 || !almostEqual(pos.Y, 25, 1e-9) || pos.X != 100 {
+		t.Errorf("Expected the call and (100, 25), got %+v (calls %d)", pos, calls)
+	}
+
+	first.Stop()
+	m.Tick(1.0)
+	if !almostEqual(pos.Y, 25, 1e-9) || m.Len() != 0 {
+		t.Errorf("Expected Stop to halt the sequence, got %+v with %d running", pos, m.Len())
+	}
+}
+
+// TestTweenAttach tests scene-attached tweens advance with the fixed update.
+func TestTweenAttach(t *testing.T) {
+	scene := core.NewScene()
+	m := tween.Attach(scene)
+	zoom := 1.0
+	m.Tween(&zoom, 2, time.Second, tween.Linear)
+	for i := 0; i < 30; i++ {
+		scene.Update(1.0 / 60)
+	}
+	if !almostEqual(zoom, 1.5, 1e-9) {
+		t.Errorf("Expected zoom 1.5 after half a second, got %v", zoom)
+	}
+}
@@ -0,0 +1,75 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	"github.com/dshills/gogame/engine/gogametest"
+	"github.com/dshills/gogame/engine/graphics"
+	"github.com/dshills/gogame/engine/input"
+)
+
+// TestTypewriterReveal tests characters are revealed at the configured rate.
+func TestTypewriterReveal(t *testing.T) {
+	scene := core.NewScene()
+	writer := core.NewTypewriter(10)
+	var ticks []rune
+	writer.OnChar = func(ch rune) { ticks = append(ticks, ch) }
+	box := &core.Entity{Active: true, Text: graphics.NewTextSprite("Hi there", &graphics.Font{}), Behavior: writer}
+	scene.AddEntity(box)
+
+	for i := 0; i < 18; i++ { // 0.3s = 3 characters
+		scene.Update(1.0 / 60)
+	}
+	if string(ticks) != "Hit" {
+		t.Errorf("Expected ticks for %q, got %q", "Hit", string(ticks))
+	}
+	if box.Text.HiddenChars != 4 || box.Text.VisibleText() != "Hi t" {
+		t.Errorf("Expected 4 hidden and %q visible, got %d and %q", "Hi t", box.Text.HiddenChars, box.Text.VisibleText())
+	}
+
+	renderer := gogametest.NewFakeRenderer()
+	if err := scene.Render(renderer.Renderer); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if texts := renderer.CallsOf(graphics.DrawKindText); len(texts) != 1 || texts[0].Text != "Hi t" {
+		t.Errorf("Expected the visible text drawn, got %+v", texts)
+	}
+
+	for i := 0; i < 60; i++ {
+		scene.Update(1.0 / 60)
+	}
+	if !writer.Done() || box.Text.HiddenChars != 0 || len(ticks) != 7 {
+		t.Errorf("Expected the full text after 1s, got done=%v hidden=%d ticks=%d",
+			writer.Done(), box.Text.HiddenChars, len(ticks))
+	}
+}
+
+// TestTypewriterSkip tests a skip key completes the text and restarting on new text.
+func TestTypewriterSkip(t *testing.T) {
+	in := gogametest.NewFakeInput()
+	writer := core.NewTypewriter(5)
+	writer.Input, writer.SkipKeys = in.InputManager, []input.KeyCode{input.KeySpace}
+	completed := 0
+	writer.OnComplete = func(*core.Entity) { completed++ }
+	box := &core.Entity{Active: true, Text: graphics.NewTextSprite("Welcome, traveler", nil)}
+
+	writer.Update(box, 0.2)
+	if box.Text.HiddenChars != 15 {
+		t.Errorf("Expected 15 hidden characters, got %d", box.Text.HiddenChars)
+	}
+	in.SetKeyState(input.KeySpace, true)
+	writer.Update(box, 0.2)
+	in.NextFrame()
+	writer.Update(box, 0.2)
+	if !writer.Done() || box.Text.HiddenChars != 0 || completed != 1 {
+		t.Errorf("Expected skip to complete once, got done=%v hidden=%d completed=%d",
+			writer.Done(), box.Text.HiddenChars, completed)
+	}
+
+	box.Text.Text = "Next line"
+	writer.Update(box, 0.2)
+	if writer.Done() || box.Text.HiddenChars != 7 {
+		t.Errorf("Expected new text to restart, got done=%v hidden=%d", writer.Done(), box.Text.HiddenChars)
+	}
+}
@@ -0,0 +1,129 @@
+package unit
+
+import (
+	"testing"
+
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/ui"
+)
+
+// TestUIAnchoring tests anchor placement relative to the screen and parents.
+func TestUIAnchoring(t *testing.T) {
+	root := ui.New()
+	corner := ui.NewPanel(100, 50)
+	corner.Anchor = ui.AnchorBottomRight
+	corner.Offset = gamemath.Vector2{X: -10, Y: -10}
+	centered := ui.NewButton("OK", nil, nil)
+	centered.Anchor = ui.AnchorCenter
+	corner.Add(centered)
+	root.Add(corner)
+
+	root.Layout(800, 600)
+	if got := corner.Rect(); got != (gamemath.Rectangle{X: 690, Y: 540, Width: 100, Height: 50}) {
+		t.Errorf("Expected bottom-right panel at (690, 540), got %+v", got)
+	}
+	if got := centered.Rect(); got.X != 680 || got.Y != 549 {
+		t.Errorf("Expected button centered in panel at (680, 549), got %+v", got)
+	}
+
+	root.Layout(1024, 768)
+	if got := corner.Rect(); got.X != 914 || got.Y != 708 {
+		t.Errorf("Expected panel to follow the resized corner, got %+v", got)
+	}
+}
+
+// TestUIStackingPanel tests vertical stacking and fit-to-children sizing.
+func TestUIStackingPanel(t *testing.T) {
+	root := ui.New()
+	panel := ui.NewPanel(0, 0)
+	panel.Layout = ui.LayoutVertical
+	panel.Padding = 5
+	panel.Spacing = 2
+	bar := ui.NewProgressBar(200, 10)
+	hidden := ui.NewProgressBar(500, 500)
+	hidden.Hidden = true
+	button := ui.NewButton("Go", nil, nil)
+	panel.Add(bar, hidden, button)
+	root.Add(panel)
+	root.Layout(800, 600)
+
+	if got := panel.Rect(); got.Width != 210 || got.Height != 54 {
+		t.Errorf("Expected panel fitted to 210x54, got %vx%v", got.Width, got.Height)
+	}
+	if got := button.Rect(); got.X != 5 || got.Y != 17 {
+		t.Errorf("Expected button stacked below the bar at (5, 17), got %+v", got)
+	}
+
+	bar.Value = 1.5
+	if bar.FillRect().Width != 200 {
+		t.Errorf("Expected value clamped to a full bar, got %v", bar.FillRect().Width)
+	}
+	bar.Value = 0.25
+	if bar.FillRect().Width != 50 {
+		t.Errorf("Expected quarter fill of 50, got %v", bar.FillRect().Width)
+	}
+}
+
+// TestUIPointerRouting tests hover, click, drag-off, disabled, and WantsMouse.
+func TestUIPointerRouting(t *testing.T) {
+	root := ui.New()
+	clicks := 0
+	button := ui.NewButton("Fire", nil, func() { clicks++ })
+	button.Offset = gamemath.Vector2{X: 10, Y: 10} // 10..130 x 10..42
+	root.Add(button)
+	root.Layout(800, 600)
+
+	root.HandlePointer(400, 400, false)
+	if root.WantsMouse() || button.Hovered() {
+		t.Fatal("Expected pointer away from the button not to hover it")
+	}
+	root.HandlePointer(20, 20, false)
+	if !button.Hovered() || !root.WantsMouse() || root.Hovered() != button {
+		t.Error("Expected button hovered and UI to want the mouse")
+	}
+	root.HandlePointer(20, 20, true)
+	if !button.Pressed() {
+		t.Error("Expected button pressed while held")
+	}
+	root.HandlePointer(21, 20, false)
+	if clicks != 1 || button.Pressed() {
+		t.Errorf("Expected one click on release, got %d", clicks)
+	}
+
+	// Press on the button, release elsewhere: no click
+	root.HandlePointer(20, 20, true)
+	root.HandlePointer(400, 400, true)
+	if !root.WantsMouse() {
+		t.Error("Expected UI to keep the mouse while a press is held")
+	}
+	root.HandlePointer(400, 400, false)
+	if clicks != 1 || button.Hovered() {
+		t.Errorf("Expected drag-off release not to click, got %d clicks", clicks)
+	}
+
+	button.Disabled = true
+	root.HandlePointer(20, 20, true)
+	root.HandlePointer(20, 20, false)
+	if clicks != 1 {
+		t.Error("Expected disabled button not to click")
+	}
+}
+
+// TestUIChildOverInteractiveParent tests that a label inside a button routes clicks to the button.
+func TestUIChildOverInteractiveParent(t *testing.T) {
+	root := ui.New()
+	clicked := false
+	button := ui.NewButton("", nil, func() { clicked = true })
+	label := ui.NewLabel("", nil)
+	label.Size = gamemath.Vector2{X: 40, Y: 10}
+	label.Anchor = ui.AnchorCenter
+	button.Add(label)
+	root.Add(button)
+	root.Layout(800, 600)
+
+	root.HandlePointer(60, 16, true)
+	root.HandlePointer(60, 16, false)
+	if !clicked {
+		t.Error("Expected click on the label to reach the button")
+	}
+}
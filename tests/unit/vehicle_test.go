@@ -0,0 +1,83 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/physics"
+	"github.com/dshills/gogame/engine/vehicle"
+)
+
+// driveCar steps a car for a number of 60 Hz frames.
+func driveCar(car *vehicle.Car, entity *core.Entity, frames int) {
+	for i := 0; i < frames; i++ {
+		car.Update(entity, 1.0/60.0)
+	}
+}
+
+// TestCarAccelerationAndTopSpeed tests throttle, speed cap, and braking.
+func TestCarAccelerationAndTopSpeed(t *testing.T) {
+	entity := &core.Entity{}
+	car := vehicle.NewCar(nil)
+
+	car.SetControls(1, 0, false)
+	driveCar(car, entity, 600)
+	if speed := car.Speed(entity); speed > car.MaxSpeed || speed < car.MaxSpeed*0.5 {
+		t.Errorf("Expected speed near but not above max, got %f", speed)
+	}
+	if entity.Transform.Position.X <= 0 || entity.Transform.Position.Y != 0 {
+		t.Errorf("Expected car to drive along +X, got %v", entity.Transform.Position)
+	}
+
+	car.SetControls(-1, 0, false)
+	driveCar(car, entity, 60)
+	if speed := car.Speed(entity); speed > 0 {
+		t.Errorf("Expected braking to stop forward motion, got %f", speed)
+	}
+}
+
+// TestCarSteeringNeedsSpeed tests speed-dependent steering.
+func TestCarSteeringNeedsSpeed(t *testing.T) {
+	entity := &core.Entity{}
+	car := vehicle.NewCar(nil)
+
+	car.SetControls(0, 1, false)
+	driveCar(car, entity, 30)
+	if entity.Transform.Rotation != 0 {
+		t.Errorf("Expected no turning while stationary, got %f", entity.Transform.Rotation)
+	}
+
+	car.SetControls(1, 1, false)
+	driveCar(car, entity, 60)
+	if entity.Transform.Rotation <= 0 {
+		t.Error("Expected car to turn right while moving")
+	}
+}
+
+// TestCarHandbrakeDrift tests that the handbrake and slippery surfaces keep sideways slip.
+func TestCarHandbrakeDrift(t *testing.T) {
+	slip := func(handbrake bool, material *physics.Material) float64 {
+		entity := &core.Entity{}
+		car := vehicle.NewCar(nil)
+		car.Surface = func(gamemath.Vector2) *physics.Material { return material }
+		car.Velocity = gamemath.Vector2{X: 300}
+		entity.Transform.Rotation = 45 // Heading diagonally, moving along X
+		car.SetControls(0, 0, handbrake)
+		driveCar(car, entity, 10)
+		speed := car.Speed(entity)
+		heading := gamemath.Vector2{X: 0.7071, Y: 0.7071}
+		return car.Velocity.Sub(heading.Scale(speed)).Length()
+	}
+
+	gripped := slip(false, physics.DefaultMaterial)
+	drifting := slip(true, physics.DefaultMaterial)
+	icy := slip(false, physics.Ice)
+
+	if drifting <= gripped {
+		t.Errorf("Expected handbrake to keep more slip (%f) than grip (%f)", drifting, gripped)
+	}
+	if icy <= gripped {
+		t.Errorf("Expected ice to keep more slip (%f) than asphalt (%f)", icy, gripped)
+	}
+}
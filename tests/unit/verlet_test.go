@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+	gamemath "github.com/dshills/gogame/engine/math"
+	"github.com/dshills/gogame/engine/verlet"
+)
+
+// TestVerletRopeHangs tests that a pinned rope falls but keeps its length.
+func TestVerletRopeHangs(t *testing.T) {
+	rope := verlet.NewRope(gamemath.Vector2{X: 0, Y: 0}, gamemath.Vector2{X: 100, Y: 0}, 10)
+
+	for i := 0; i < 300; i++ {
+		rope.Step(1.0 / 60.0)
+	}
+
+	if rope.Points[0].Position != (gamemath.Vector2{}) {
+		t.Errorf("Expected pinned point to stay put, got %v", rope.Points[0].Position)
+	}
+	end := rope.Points[len(rope.Points)-1].Position
+	if end.Y < 90 {
+		t.Errorf("Expected rope end to hang below the pin, got %v", end)
+	}
+	if dist := end.Distance(gamemath.Vector2{}); dist > 105 {
+		t.Errorf("Expected rope not to stretch much past 100, got %f", dist)
+	}
+}
+
+// TestVerletAnchorAndTear tests entity anchors and tearing.
+func TestVerletAnchorAndTear(t *testing.T) {
+	player := &core.Entity{Transform: gamemath.Transform{Position: gamemath.Vector2{X: 50, Y: 0}}}
+	rope := verlet.NewRope(gamemath.Vector2{X: 0, Y: 0}, gamemath.Vector2{X: 50, Y: 0}, 5)
+	rope.Anchors = append(rope.Anchors, verlet.Anchor{Point: 5, Entity: player})
+	rope.TearLength = 1.5
+
+	rope.Update(nil, 1.0/60.0)
+	player.Transform.Position.X = 500
+	rope.Update(nil, 1.0/60.0)
+
+	if rope.Points[5].Position.X != 500 {
+		t.Errorf("Expected anchored point to follow the entity, got %v", rope.Points[5].Position)
+	}
+	broken := 0
+	for _, c := range rope.Constraints {
+		if c.Broken {
+			broken++
+		}
+	}
+	if broken == 0 {
+		t.Error("Expected overstretched rope to tear")
+	}
+}
+
+// TestVerletCloth tests cloth construction.
+func TestVerletCloth(t *testing.T) {
+	cloth := verlet.NewCloth(gamemath.Vector2{}, 4, 3, 10)
+	if len(cloth.Points) != 12 {
+		t.Errorf("Expected 12 points, got %d", len(cloth.Points))
+	}
+	// 3 rows * 3 horizontal + 2 * 4 vertical
+	if len(cloth.Constraints) != 17 {
+		t.Errorf("Expected 17 constraints, got %d", len(cloth.Constraints))
+	}
+}
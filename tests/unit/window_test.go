@@ -0,0 +1,115 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/gogame/engine/core"
+)
+
+// TestWindowSizeAndResizeCallback tests resizing without a window: the
+// size, camera screen sizes, and the resize callback.
+func TestWindowSizeAndResizeCallback(t *testing.T) {
+	engine := core.NewHeadlessEngine()
+	defer engine.Shutdown()
+	scene := core.NewScene()
+	engine.SetScene(scene)
+
+	var resizes [][2]int
+	engine.SetResizeCallback(func(width, height int) {
+		resizes = append(resizes, [2]int{width, height})
+	})
+
+	if err := engine.SetWindowSize(640, 480); err != nil {
+		t.Fatalf("SetWindowSize failed: %v", err)
+	}
+	if engine.Width() != 640 || engine.Height() != 480 {
+		t.Errorf("Expected 640x480, got %dx%d", engine.Width(), engine.Height())
+	}
+	if width, height := engine.WindowSize(); width != 640 || height != 480 {
+		t.Errorf("Expected a 640x480 window, got %dx%d", width, height)
+	}
+	if width, height := scene.Camera().ScreenSize(); width != 640 || height != 480 {
+		t.Errorf("Expected camera resized to 640x480, got %dx%d", width, height)
+	}
+	if len(resizes) != 1 || resizes[0] != [2]int{640, 480} {
+		t.Errorf("Expected one resize callback, got %v", resizes)
+	}
+
+	if err := engine.SetWindowSize(640, 480); err != nil || len(resizes) != 1 {
+		t.Errorf("Expected no callback when the size doesn't change, got %v (%v)", resizes, err)
+	}
+	for _, size := range [][2]int{{0, 480}, {640, 0}, {-1, 480}} {
+		if err := engine.SetWindowSize(size[0], size[1]); !errors.Is(err, core.ErrInvalidWindowSize) {
+			t.Errorf("Expected ErrInvalidWindowSize for %v, got %v", size, err)
+		}
+	}
+	if engine.Width() != 640 || len(resizes) != 1 {
+		t.Errorf("Expected invalid sizes to change nothing, got width %d and %v", engine.Width(), resizes)
+	}
+
+	// A scene set later picks up the current size
+	next := core.NewScene()
+	engine.SetScene(next)
+	if width, height := next.Camera().ScreenSize(); width != 640 || height != 480 {
+		t.Errorf("Expected the new scene's camera at 640x480, got %dx%d", width, height)
+	}
+}
+
+// TestLogicalSize tests a fixed render size that ignores window resizes.
+func TestLogicalSize(t *testing.T) {
+	engine := core.NewHeadlessEngine()
+	defer engine.Shutdown()
+	scene := core.NewScene()
+	engine.SetScene(scene)
+
+	var resizes [][2]int
+	engine.SetResizeCallback(func(width, height int) {
+		resizes = append(resizes, [2]int{width, height})
+	})
+
+	if err := engine.SetLogicalSize(320, 180); err != nil {
+		t.Fatalf("SetLogicalSize failed: %v", err)
+	}
+	if width, height := engine.LogicalSize(); width != 320 || height != 180 {
+		t.Errorf("Expected logical 320x180, got %dx%d", width, height)
+	}
+	if len(resizes) != 1 || resizes[0] != [2]int{320, 180} {
+		t.Errorf("Expected one callback for the logical size, got %v", resizes)
+	}
+
+	if err := engine.SetWindowSize(1000, 500); err != nil {
+		t.Fatalf("SetWindowSize failed: %v", err)
+	}
+	if engine.Width() != 320 || engine.Height() != 180 {
+		t.Errorf("Expected the render size to stay 320x180, got %dx%d", engine.Width(), engine.Height())
+	}
+	if width, height := scene.Camera().ScreenSize(); width != 320 || height != 180 {
+		t.Errorf("Expected cameras at the logical 320x180, got %dx%d", width, height)
+	}
+	if width, height := engine.WindowSize(); width != 1000 || height != 500 {
+		t.Errorf("Expected a 1000x500 window, got %dx%d", width, height)
+	}
+	if len(resizes) != 1 {
+		t.Errorf("Expected no callback for window resizes, got %v", resizes)
+	}
+
+	for _, size := range [][2]int{{320, 0}, {0, 180}, {-320, -180}} {
+		if err := engine.SetLogicalSize(size[0], size[1]); !errors.Is(err, core.ErrInvalidWindowSize) {
+			t.Errorf("Expected ErrInvalidWindowSize for %v, got %v", size, err)
+		}
+	}
+	if width, _ := engine.LogicalSize(); width != 320 {
+		t.Errorf("Expected invalid sizes to keep the logical size, got width %d", width)
+	}
+
+	if err := engine.SetLogicalSize(0, 0); err != nil {
+		t.Fatalf("SetLogicalSize(0, 0) failed: %v", err)
+	}
+	if engine.Width() != 1000 || engine.Height() != 500 {
+		t.Errorf("Expected to follow the 1000x500 window again, got %dx%d", engine.Width(), engine.Height())
+	}
+	if len(resizes) != 2 || resizes[1] != [2]int{1000, 500} {
+		t.Errorf("Expected a callback back to the window size, got %v", resizes)
+	}
+}